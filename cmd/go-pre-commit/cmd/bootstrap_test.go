@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// addLegacyFiles stages count files with trailing whitespace in dir
+func addLegacyFiles(t *testing.T, dir string, count int) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "legacy"), 0o750))
+	for i := range count {
+		name := filepath.Join(dir, "legacy", fmt.Sprintf("file%03d.txt", i))
+		require.NoError(t, os.WriteFile(name, []byte("legacy line   \n"), 0o600))
+	}
+	gitCmd(t, dir, "add", ".")
+}
+
+func TestRunBootstrap_FixesAndStagesWithoutBlocking(t *testing.T) {
+	dir := setupFixRepo(t)
+	addLegacyFiles(t, dir, 150)
+	builder := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date"))
+
+	var err error
+	out := captureCmdOutput(t, func() {
+		err = builder.runChecksWithConfig(RunConfig{Bootstrap: true}, nil, nil)
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, "fixed and staged 152 file(s)")
+	assert.Contains(t, out, "does not block the commit")
+
+	// Every fix is in the index, so the commit includes it
+	assert.Empty(t, gitCmd(t, dir, "diff", "--name-only"))
+	content, err := os.ReadFile(filepath.Join(dir, "legacy", "file042.txt")) //nolint:gosec // test path
+	require.NoError(t, err)
+	assert.Equal(t, "legacy line\n", string(content))
+
+	// A follow-up run on the bootstrapped files passes
+	captureCmdOutput(t, func() {
+		err = builder.runChecksWithConfig(RunConfig{}, nil, nil)
+	})
+	require.NoError(t, err)
+}
+
+func TestRunBootstrap_NothingToFix(t *testing.T) {
+	setupFixRepo(t)
+	builder := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date"))
+
+	var err error
+	out := captureCmdOutput(t, func() {
+		err = builder.runChecksWithConfig(RunConfig{Bootstrap: true, Files: []string{"clean.txt"}}, nil, nil)
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, "no fixes needed")
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/webhook"
+)
+
+// runWithProfile runs the checks of profile on clean.txt and returns the names
+// of the checks that ran, as reported to a test webhook
+func runWithProfile(t *testing.T, profile string) []string {
+	t.Helper()
+
+	payloads := make(chan webhook.RunResult, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload webhook.RunResult
+		body, _ := io.ReadAll(r.Body)
+		if json.Unmarshal(body, &payload) == nil {
+			payloads <- payload
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	builder := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date"))
+	err := builder.runChecksWithConfig(RunConfig{Files: []string{"clean.txt"}, Parallel: 1, Profile: profile, WebhookURL: server.URL}, nil, nil)
+	require.NoError(t, err)
+
+	require.Len(t, payloads, 1)
+	payload := <-payloads
+	names := make([]string, 0, len(payload.Checks))
+	for _, check := range payload.Checks {
+		names = append(names, check.Name)
+	}
+	return names
+}
+
+func TestRunChecksWithConfig_Profile(t *testing.T) {
+	setupFixRepo(t)
+	// Disabled checks still run when the selected profile lists them
+	t.Setenv("GO_PRE_COMMIT_ENABLE_WHITESPACE", "false")
+	t.Setenv("GO_PRE_COMMIT_PROFILE_TEXT_ONLY", "eof")
+
+	assert.ElementsMatch(t, []string{"whitespace", "eof"}, runWithProfile(t, "fast"))
+	assert.ElementsMatch(t, []string{"eof"}, runWithProfile(t, "text-only"))
+}
+
+func TestRunChecksWithConfig_UnknownProfile(t *testing.T) {
+	setupFixRepo(t)
+
+	builder := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date"))
+	err := builder.runChecksWithConfig(RunConfig{Files: []string{"clean.txt"}, Profile: "nightly"}, nil, nil)
+	require.ErrorIs(t, err, ErrUnknownProfile)
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
 	"testing"
@@ -10,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/mrz1836/go-pre-commit/internal/config"
 	"github.com/mrz1836/go-pre-commit/internal/output"
 	"github.com/mrz1836/go-pre-commit/internal/runner"
 	"github.com/mrz1836/go-pre-commit/internal/update"
@@ -164,6 +166,21 @@ func TestBuildRunnerOptions_CheckSelection(t *testing.T) {
 		assert.Equal(t, []string{"fmt", lintCheckName}, opts.OnlyChecks)
 	})
 
+	t.Run("offline flag", func(t *testing.T) {
+		assert.True(t, buildRunnerOptions(RunConfig{Offline: true}, nil, nil, formatter).Offline)
+		assert.False(t, buildRunnerOptions(RunConfig{}, nil, nil, formatter).Offline)
+	})
+
+	t.Run("skip missing tools flag", func(t *testing.T) {
+		assert.True(t, buildRunnerOptions(RunConfig{SkipMissingTools: true}, nil, nil, formatter).SkipMissingTools)
+		assert.False(t, buildRunnerOptions(RunConfig{}, nil, nil, formatter).SkipMissingTools)
+	})
+
+	t.Run("max failures flag", func(t *testing.T) {
+		assert.Equal(t, 2, buildRunnerOptions(RunConfig{MaxFailures: 2}, nil, nil, formatter).MaxFailures)
+		assert.Zero(t, buildRunnerOptions(RunConfig{}, nil, nil, formatter).MaxFailures)
+	})
+
 	t.Run("skip flag", func(t *testing.T) {
 		opts := buildRunnerOptions(RunConfig{SkipChecks: []string{"gitleaks"}}, nil, nil, formatter)
 		assert.Equal(t, []string{"gitleaks"}, opts.SkipChecks)
@@ -204,6 +221,76 @@ func TestBuildRunnerOptions_ProgressCallback(t *testing.T) {
 		assert.NotEmpty(t, out)
 	})
 
+	t.Run("report on failure drops passing checks", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		formatter := output.New(output.Options{ColorEnabled: false, Out: &stdout, Err: &stderr})
+		opts := buildRunnerOptions(RunConfig{ShowProgress: true, ReportOn: config.ReportOnFailure}, nil, nil, formatter)
+		require.NotNil(t, opts.ProgressCallback)
+
+		opts.ProgressCallback("fumpt", "running", time.Second)
+		opts.ProgressCallback("fumpt", "passed", time.Second)
+		opts.ProgressCallback(lintCheckName, "failed", time.Second)
+
+		combined := stdout.String() + stderr.String()
+		assert.NotContains(t, combined, "fumpt")
+		assert.Contains(t, combined, "lint check failed")
+	})
+
+	t.Run("progress bar replaces passing lines", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		formatter := output.New(output.Options{ColorEnabled: false, Out: &stdout, Err: &stderr})
+		cfg := &config.Config{}
+		cfg.UI.ProgressBar = true
+		opts := buildRunnerOptions(RunConfig{ShowProgress: true}, nil, nil, formatter)
+		finish := attachProgressBar(&opts, cfg, RunConfig{ShowProgress: true}, formatter, true)
+		require.NotNil(t, opts.PlanCallback)
+
+		opts.PlanCallback(2, 500)
+		opts.ProgressCallback("fumpt", "running", 0)
+		opts.ProgressCallback("fumpt", "passed", time.Second)
+		assert.Contains(t, stdout.String(), " 50% 1/2 checks · 500 files · fumpt")
+		assert.NotContains(t, stdout.String(), "Running fumpt")
+
+		opts.ProgressCallback(lintCheckName, "failed", time.Second)
+		finish()
+		assert.Contains(t, stdout.String()+stderr.String(), "lint check failed")
+		assert.Contains(t, stdout.String(), "100% 2/2 checks")
+		assert.Regexp(t, `\r\x1b\[K$`, stdout.String(), "the bar is erased at the end")
+	})
+
+	t.Run("percentage lines without a terminal only when --progress is explicit", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		formatter := output.New(output.Options{ColorEnabled: false, Out: &stdout, Err: &stderr})
+		cfg := &config.Config{}
+		cfg.UI.ProgressBar = true
+
+		opts := buildRunnerOptions(RunConfig{ShowProgress: true}, nil, nil, formatter)
+		attachProgressBar(&opts, cfg, RunConfig{ShowProgress: true}, formatter, false)
+		assert.Nil(t, opts.PlanCallback, "redirected output keeps a line per check")
+
+		runConfig := RunConfig{ShowProgress: true, ProgressRequested: true}
+		opts = buildRunnerOptions(runConfig, nil, nil, formatter)
+		attachProgressBar(&opts, cfg, runConfig, formatter, false)
+		require.NotNil(t, opts.PlanCallback)
+
+		opts.PlanCallback(4, 10)
+		for _, name := range []string{"fumpt", "eof", "whitespace", lintCheckName} {
+			opts.ProgressCallback(name, "running", 0)
+			opts.ProgressCallback(name, "passed", time.Second)
+		}
+		assert.Equal(t, "⏳ Checked 25% (1 of 4 checks, 10 files)\n"+
+			"⏳ Checked 50% (2 of 4 checks, 10 files)\n"+
+			"⏳ Checked 75% (3 of 4 checks, 10 files)\n"+
+			"⏳ Checked 100% (4 of 4 checks, 10 files)\n", stdout.String())
+	})
+
+	t.Run("progress bar off by default", func(t *testing.T) {
+		formatter := output.NewDefault()
+		opts := buildRunnerOptions(RunConfig{ShowProgress: true}, nil, nil, formatter)
+		attachProgressBar(&opts, &config.Config{}, RunConfig{ShowProgress: true, ProgressRequested: true}, formatter, true)
+		assert.Nil(t, opts.PlanCallback)
+	})
+
 	t.Run("callback nil when quiet", func(t *testing.T) {
 		formatter := output.NewDefault()
 		opts := buildRunnerOptions(RunConfig{ShowProgress: true, Quiet: true}, nil, nil, formatter)
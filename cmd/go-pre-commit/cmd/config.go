@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/go-pre-commit/internal/checks"
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+// ErrInvalidConfig is returned by "config validate" when the configuration has problems
+var ErrInvalidConfig = errors.New("invalid configuration")
+
+// BuildConfigCmd creates the config command
+func (cb *CommandBuilder) BuildConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect and validate the configuration",
+		Long:  `Inspect the format of the .go-pre-commit.yml configuration file and validate the configuration.`,
+	}
+
+	cmd.AddCommand(cb.buildConfigSchemaCmd())
+	cmd.AddCommand(cb.buildConfigValidateCmd())
+
+	return cmd
+}
+
+// buildConfigSchemaCmd creates the config schema command
+func (cb *CommandBuilder) buildConfigSchemaCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "schema",
+		Short: "Print the JSON Schema of .go-pre-commit.yml",
+		Long: `Print a JSON Schema describing every key of .go-pre-commit.yml, with its
+type and default, so editors can validate and complete the file.
+
+The schema is generated from the configuration structs of this build, so it
+always matches the version of go-pre-commit that prints it.`,
+		Example: `  # Save the schema next to the config file
+  go-pre-commit config schema > .go-pre-commit.schema.json
+
+  # Then point the YAML language server at it from .go-pre-commit.yml
+  # yaml-language-server: $schema=.go-pre-commit.schema.json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			schema, err := config.Schema()
+			if err != nil {
+				return err
+			}
+			if _, err = cmd.OutOrStdout().Write(schema); err != nil {
+				return fmt.Errorf("failed to write config schema: %w", err)
+			}
+			return nil
+		},
+	}
+}
+
+// buildConfigValidateCmd creates the config validate command
+func (cb *CommandBuilder) buildConfigValidateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate",
+		Short: "Check the configuration without running any checks",
+		Long: `Load the configuration the way "run" does and report every problem at once
+instead of stopping at the first.
+
+The config file is checked against the schema "config schema" prints, for
+unknown keys, unknown check names, and bad timeouts. The settings from the env
+files and the config file are then validated, including the check names each
+profile lists and the glob patterns of check settings. The command exits
+non-zero when anything is wrong, so CI can fail before a run does.`,
+		Example: `  # Fail a CI job early on a broken configuration
+  go-pre-commit config validate`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, problems := config.Check()
+			if cfg != nil {
+				problems = append(problems, profileProblems(cfg)...)
+			}
+
+			out := cmd.OutOrStdout()
+			if len(problems) == 0 {
+				_, _ = fmt.Fprintln(out, "✓ Configuration is valid")
+				return nil
+			}
+
+			_, _ = fmt.Fprintf(out, "✗ Configuration has %d problem(s):\n", len(problems))
+			for _, problem := range problems {
+				_, _ = fmt.Fprintf(out, "  - %s\n", problem)
+			}
+			return setupError(cfg, fmt.Errorf("%w: %d problem(s)", ErrInvalidConfig, len(problems)))
+		},
+	}
+}
+
+// profileProblems returns a problem for each check a profile lists that is
+// not a known check, sorted by profile
+func profileProblems(cfg *config.Config) []string {
+	known := checks.NewRegistryWithConfig(cfg).Names()
+	var problems []string
+	for _, name := range slices.Sorted(maps.Keys(cfg.Profiles)) {
+		for _, check := range cfg.Profiles[name] {
+			if !slices.Contains(known, check) {
+				problems = append(problems, fmt.Sprintf("profile %q lists unknown check %q", name, check))
+			}
+		}
+	}
+	return problems
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+func TestConfigCmd_CommandStructure(t *testing.T) {
+	cmd := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date")).BuildConfigCmd()
+
+	assert.Equal(t, "config", cmd.Name())
+	schemaCmd, _, err := cmd.Find([]string{"schema"})
+	require.NoError(t, err)
+	assert.Equal(t, "schema", schemaCmd.Name())
+	validateCmd, _, err := cmd.Find([]string{"validate"})
+	require.NoError(t, err)
+	assert.Equal(t, "validate", validateCmd.Name())
+}
+
+func TestConfigSchemaCmd(t *testing.T) {
+	cmd := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date")).BuildConfigCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"schema"})
+	require.NoError(t, cmd.Execute())
+
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(out.Bytes(), &schema))
+	assert.Equal(t, config.SchemaDraft, schema["$schema"])
+	assert.Contains(t, schema["properties"], "checks")
+
+	cmd.SetArgs([]string{"schema", "extra"})
+	require.Error(t, cmd.Execute())
+}
+
+func TestConfigValidateCmd(t *testing.T) {
+	runValidate := func(t *testing.T) (string, error) {
+		t.Helper()
+		cmd := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date")).BuildConfigCmd()
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+		cmd.SetArgs([]string{"validate"})
+		cmd.SilenceErrors = true
+		cmd.SilenceUsage = true
+		err := cmd.Execute()
+		return out.String(), err
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		dir := setupFixRepo(t)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, config.DefaultConfigFile), []byte("timeout: 300\nchecks:\n  lint:\n    timeout: 90\n"), 0o600))
+		t.Setenv("GO_PRE_COMMIT_PROFILE_QUICK", "whitespace,eof")
+
+		output, err := runValidate(t)
+		require.NoError(t, err)
+		assert.Contains(t, output, "Configuration is valid")
+	})
+
+	t.Run("every problem is reported", func(t *testing.T) {
+		dir := setupFixRepo(t)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, config.DefaultConfigFile), []byte("timout: 300\nchecks:\n  lint:\n    timeout: 0\n"), 0o600))
+		t.Setenv("GO_PRE_COMMIT_PROFILE_QUICK", "whitespace,lnit")
+		t.Setenv("GO_PRE_COMMIT_ENABLE_FORBIDDEN_IMPORTS", "true")
+		t.Setenv("GO_PRE_COMMIT_FORBIDDEN_IMPORTS", "io/ioutil,github.com/[bad")
+
+		output, err := runValidate(t)
+		require.ErrorIs(t, err, ErrInvalidConfig)
+		assert.Equal(t, 3, ExitCode(err))
+		assert.Contains(t, output, "Configuration has 4 problem(s)")
+		assert.Contains(t, output, `unknown key "timout"`)
+		assert.Contains(t, output, "checks.lint.timeout: must be at least 1 (got 0)")
+		assert.Contains(t, output, `profile "quick" lists unknown check "lnit"`)
+		assert.Contains(t, output, "GO_PRE_COMMIT_FORBIDDEN_IMPORTS has an invalid pattern 'github.com/[bad'")
+	})
+}
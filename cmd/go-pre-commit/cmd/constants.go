@@ -13,5 +13,11 @@ const (
 	colorModeNever  = "never"
 )
 
+// Output format constants
+const (
+	outputFormatText = "text"
+	outputFormatTAP  = "tap"
+)
+
 // Version constants
 const versionDev = "dev"
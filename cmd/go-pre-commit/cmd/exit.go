@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+)
+
+// Default exit codes, used when a code is not configured or the
+// configuration failed to load
+const (
+	defaultExitCodeFailure = 1
+	defaultExitCodeFixed   = 2
+	defaultExitCodeSetup   = 3
+)
+
+// ExitError carries the process exit code for a failed command
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+// Error returns the underlying error message
+func (e *ExitError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
+// ExitCode returns the process exit code for an error returned by a command:
+// 0 for nil, the code an ExitError carries, and 1 for anything else
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+	return defaultExitCodeFailure
+}
+
+// exitCodeOrDefault returns code, or fallback when code is not set
+func exitCodeOrDefault(code, fallback int) int {
+	if code == 0 {
+		return fallback
+	}
+	return code
+}
+
+// setupError marks err as a configuration or environment error that stopped
+// the run before any check could report. cfg may be nil when the
+// configuration itself failed to load.
+func setupError(cfg *config.Config, err error) error {
+	code := defaultExitCodeSetup
+	if cfg != nil {
+		code = exitCodeOrDefault(cfg.ExitCodes.Setup, defaultExitCodeSetup)
+	}
+	return &ExitError{Code: code, Err: err}
+}
+
+// noFilesError returns the error for a run with no files to check: nil, so
+// the run succeeds, unless GO_PRE_COMMIT_EMPTY_COMMIT_NOTICE is on and
+// GO_PRE_COMMIT_EXIT_CODE_NO_FILES sets a code to signal it with
+func noFilesError(cfg *config.Config) error {
+	if !cfg.UI.EmptyCommitNotice || cfg.ExitCodes.NoFiles == 0 {
+		return nil
+	}
+	return &ExitError{Code: cfg.ExitCodes.NoFiles, Err: ErrNoFilesToCheck}
+}
+
+// checksFailedError returns the error for a run with failed checks. A run
+// whose failures were all fixed in place uses the "fixed" exit code so hooks
+// and CI can tell "re-stage and commit again" from a real failure.
+func checksFailedError(cfg *config.Config, results *runner.Results) error {
+	err := fmt.Errorf("%w: %d", prerrors.ErrChecksFailed, results.BlockingFailures())
+	if results.FixesOnly() {
+		return &ExitError{Code: exitCodeOrDefault(cfg.ExitCodes.Fixed, defaultExitCodeFixed), Err: err}
+	}
+	return &ExitError{Code: exitCodeOrDefault(cfg.ExitCodes.Failure, defaultExitCodeFailure), Err: err}
+}
+
+// requiredChecksError returns the error for a run that left required checks
+// out or skipped them, or nil when every required check ran. It uses the
+// failure exit code: CI asked for those checks and did not get them.
+func requiredChecksError(cfg *config.Config, missed []runner.RequiredCheckMiss) error {
+	if len(missed) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(missed))
+	for _, miss := range missed {
+		names = append(names, miss.Name)
+	}
+	return &ExitError{
+		Code: exitCodeOrDefault(cfg.ExitCodes.Failure, defaultExitCodeFailure),
+		Err:  fmt.Errorf("%w: %s", prerrors.ErrRequiredChecksNotRun, strings.Join(names, ", ")),
+	}
+}
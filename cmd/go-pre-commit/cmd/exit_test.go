@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+)
+
+var errExitTest = errors.New("boom")
+
+func TestExitCode(t *testing.T) {
+	assert.Equal(t, 0, ExitCode(nil))
+	assert.Equal(t, 1, ExitCode(errExitTest), "plain errors exit 1")
+	assert.Equal(t, 7, ExitCode(&ExitError{Code: 7, Err: errExitTest}))
+	assert.Equal(t, 7, ExitCode(fmt.Errorf("wrapped: %w", &ExitError{Code: 7, Err: errExitTest})))
+
+	err := setupError(nil, errExitTest)
+	require.ErrorIs(t, err, errExitTest)
+	assert.Equal(t, "boom", err.Error())
+	assert.Equal(t, 3, ExitCode(err), "setup errors before config loads use the default")
+}
+
+func TestChecksFailedError(t *testing.T) {
+	cfg := &config.Config{}
+
+	fixed := &runner.Results{Failed: 2, Fixed: 2}
+	err := checksFailedError(cfg, fixed)
+	require.ErrorIs(t, err, prerrors.ErrChecksFailed)
+	assert.Equal(t, 2, ExitCode(err), "fixes only")
+
+	mixed := &runner.Results{Failed: 2, Fixed: 1}
+	assert.Equal(t, 1, ExitCode(checksFailedError(cfg, mixed)), "one failure was not fixed")
+
+	cfg.ExitCodes.Failure = 10
+	cfg.ExitCodes.Fixed = 11
+	cfg.ExitCodes.Setup = 12
+	assert.Equal(t, 11, ExitCode(checksFailedError(cfg, fixed)))
+	assert.Equal(t, 10, ExitCode(checksFailedError(cfg, mixed)))
+	assert.Equal(t, 12, ExitCode(setupError(cfg, errExitTest)))
+}
+
+func TestRunChecks_ExitCodes(t *testing.T) {
+	runFastProfile := func(t *testing.T, runConfig RunConfig) error {
+		t.Helper()
+		builder := NewCommandBuilder(NewCLIApp("test", "test-commit", "2024-01-01"))
+		builder.app.config.OutputDest = "file:" + filepath.Join(t.TempDir(), "run.log")
+		if runConfig.Profile == "" {
+			runConfig.Profile = config.ProfileFast
+		}
+		runConfig.Parallel = 1
+		return builder.runChecksWithConfig(runConfig, nil, nil)
+	}
+
+	t.Run("clean", func(t *testing.T) {
+		setupFixRepo(t)
+		assert.Equal(t, 0, ExitCode(runFastProfile(t, RunConfig{Files: []string{"clean.txt"}})))
+	})
+
+	t.Run("fixes applied", func(t *testing.T) {
+		setupFixRepo(t)
+		assert.Equal(t, 2, ExitCode(runFastProfile(t, RunConfig{Files: []string{"trailing.txt", "noeol.md"}})))
+	})
+
+	t.Run("fixes applied with a configured code", func(t *testing.T) {
+		setupFixRepo(t)
+		t.Setenv("GO_PRE_COMMIT_EXIT_CODE_FIXED", "20")
+		assert.Equal(t, 20, ExitCode(runFastProfile(t, RunConfig{Files: []string{"trailing.txt"}})))
+	})
+
+	t.Run("unfixable failure", func(t *testing.T) {
+		dir := setupFixRepo(t)
+		t.Setenv("GO_PRE_COMMIT_ENABLE_LARGE_DIFFS", "true")
+		t.Setenv("GO_PRE_COMMIT_LARGE_DIFFS_SEVERITY", "error")
+		t.Setenv("GO_PRE_COMMIT_MAX_DIFF_LINES", "1")
+		t.Setenv("GO_PRE_COMMIT_PROFILE_STRICT", "whitespace,large-diffs")
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "big.txt"), []byte("one\ntwo\nthree\n"), 0o600))
+		gitCmd(t, dir, "add", "big.txt")
+
+		err := runFastProfile(t, RunConfig{Profile: "strict", Files: []string{"big.txt", "trailing.txt"}})
+		require.ErrorIs(t, err, prerrors.ErrChecksFailed)
+		assert.Equal(t, 1, ExitCode(err), "a fix alongside an unfixable failure is still a failure")
+	})
+
+	t.Run("continue on error", func(t *testing.T) {
+		dir := setupFixRepo(t)
+		t.Setenv("GO_PRE_COMMIT_ENABLE_LARGE_DIFFS", "true")
+		t.Setenv("GO_PRE_COMMIT_LARGE_DIFFS_SEVERITY", "error")
+		t.Setenv("GO_PRE_COMMIT_MAX_DIFF_LINES", "1")
+		t.Setenv("GO_PRE_COMMIT_PROFILE_STRICT", "whitespace,large-diffs")
+		t.Setenv("GO_PRE_COMMIT_CONTINUE_ON_ERROR_CHECKS", "large-diffs")
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "big.txt"), []byte("one\ntwo\nthree\n"), 0o600))
+		gitCmd(t, dir, "add", "big.txt")
+
+		err := runFastProfile(t, RunConfig{Profile: "strict", Files: []string{"big.txt"}})
+		require.NoError(t, err, "the failure is recorded but does not fail the run")
+		assert.Equal(t, 0, ExitCode(err))
+	})
+
+	t.Run("required check not run", func(t *testing.T) {
+		setupFixRepo(t)
+		t.Setenv("GO_PRE_COMMIT_REQUIRED_CHECKS", "whitespace,gitleaks")
+		err := runFastProfile(t, RunConfig{Files: []string{"clean.txt"}})
+		require.ErrorIs(t, err, prerrors.ErrRequiredChecksNotRun)
+		assert.Contains(t, err.Error(), "gitleaks")
+		assert.NotContains(t, err.Error(), "whitespace", "whitespace ran")
+		assert.Equal(t, 1, ExitCode(err))
+
+		t.Setenv("GO_PRE_COMMIT_REQUIRED_CHECKS", "whitespace")
+		assert.Equal(t, 0, ExitCode(runFastProfile(t, RunConfig{Files: []string{"clean.txt"}})))
+	})
+
+	t.Run("setup error", func(t *testing.T) {
+		setupFixRepo(t)
+		err := runFastProfile(t, RunConfig{Profile: "nope"})
+		require.ErrorIs(t, err, ErrUnknownProfile)
+		assert.Equal(t, 3, ExitCode(err))
+
+		t.Setenv("GO_PRE_COMMIT_EXIT_CODE_SETUP", "78")
+		assert.Equal(t, 78, ExitCode(runFastProfile(t, RunConfig{Profile: "nope"})))
+	})
+
+	t.Run("no files to check", func(t *testing.T) {
+		setupFixRepo(t)
+		t.Setenv("GO_PRE_COMMIT_ENABLE_ENV_ACCESS", "true")
+		t.Setenv("GO_PRE_COMMIT_PROFILE_STRICT", "env-access")
+		t.Setenv("GO_PRE_COMMIT_EXIT_CODE_NO_FILES", "7")
+		runNoFiles := func(t *testing.T) (string, error) {
+			t.Helper()
+			logPath := filepath.Join(t.TempDir(), "run.log")
+			builder := NewCommandBuilder(NewCLIApp("test", "test-commit", "2024-01-01"))
+			builder.app.config.OutputDest = "file:" + logPath
+			err := builder.runChecksWithConfig(RunConfig{Profile: "strict", Files: []string{"clean.txt"}, Parallel: 1}, nil, nil)
+			output, readErr := os.ReadFile(logPath) //nolint:gosec // test log in a temp dir
+			require.NoError(t, readErr)
+			return string(output), err
+		}
+
+		output, err := runNoFiles(t)
+		assert.Equal(t, 0, ExitCode(err), "the exit code needs the notice")
+		assert.NotContains(t, output, "No files to check")
+
+		t.Setenv("GO_PRE_COMMIT_EMPTY_COMMIT_NOTICE", "true")
+		output, err = runNoFiles(t)
+		require.ErrorIs(t, err, ErrNoFilesToCheck)
+		assert.Equal(t, 7, ExitCode(err))
+		assert.Contains(t, output, "No files to check")
+
+		t.Setenv("GO_PRE_COMMIT_EXIT_CODE_NO_FILES", "0")
+		output, err = runNoFiles(t)
+		assert.Equal(t, 0, ExitCode(err), "the notice alone keeps the run a success")
+		assert.Contains(t, output, "No files to check")
+	})
+
+	t.Run("config error", func(t *testing.T) {
+		setupFixRepo(t)
+		t.Setenv("GO_PRE_COMMIT_EXIT_CODE_FIXED", "300")
+		err := runFastProfile(t, RunConfig{})
+		require.Error(t, err)
+		assert.Equal(t, 3, ExitCode(err), "an invalid configuration uses the default setup code")
+	})
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/go-pre-commit/internal/output"
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+	"github.com/mrz1836/go-pre-commit/internal/tools"
+)
+
+// fixCommands holds the command that fixes a check's failures locally, for
+// the checks where one exists
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var fixCommands = map[string]string{
+	"lint":        "golangci-lint run --fix ./...",
+	"fumpt":       "gofumpt -w .",
+	"mod-tidy":    "go mod tidy",
+	"go-generate": "go generate ./...",
+}
+
+// fixTools maps checks to the external tool they run, for install hints
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var fixTools = map[string]string{
+	"lint":     "golangci-lint",
+	"fumpt":    "gofumpt",
+	"gitleaks": "gitleaks",
+}
+
+// toolInstalled reports whether a tool is on PATH. It is a package variable
+// so tests do not depend on the machine's tools.
+//
+//nolint:gochecknoglobals // Injectable seam so tests can avoid PATH lookups
+var toolInstalled = tools.IsInstalled
+
+// explainFailuresFromFlags returns --explain-failures when given, and
+// otherwise whether stdout is a terminal
+func explainFailuresFromFlags(cmd *cobra.Command) (bool, error) {
+	if !cmd.Flags().Changed("explain-failures") {
+		return output.IsOutputTTY(), nil
+	}
+	return cmd.Flags().GetBool("explain-failures")
+}
+
+// displayRemediation prints a "How to Fix" section after a failing run,
+// listing for each blocking failure the command that fixes it locally, the
+// check's suggestion, and how to install a missing tool. Checks without a
+// known fix command fall back to their suggestion alone.
+func displayRemediation(formatter *output.Formatter, results []runner.CheckResult) {
+	var failed []runner.CheckResult
+	for _, result := range results {
+		if !result.Success && !result.WarnOnly {
+			failed = append(failed, result)
+		}
+	}
+	if len(failed) == 0 {
+		return
+	}
+
+	formatter.Header("How to Fix")
+	for _, result := range failed {
+		formatter.Error("━ %s ━", result.Name)
+
+		command, hasCommand := fixCommands[result.Name]
+		if hasCommand {
+			formatter.Detail("  $ %s", command)
+		}
+
+		suggestion := result.Suggestion
+		if suggestion == "" && hasCommand {
+			_, suggestion = formatter.ParseCommandError(command, result.Output)
+		}
+		if suggestion != "" {
+			formatter.SuggestAction(suggestion)
+		}
+
+		if tool, ok := fixTools[result.Name]; ok && !toolInstalled(tool) {
+			if hint := tools.InstallHint(tool); hint != "" {
+				formatter.Detail("  %s is not installed: %s", tool, hint)
+			}
+		}
+		formatter.Detail("") // Empty line for spacing
+	}
+}
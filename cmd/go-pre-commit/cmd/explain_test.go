@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/output"
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+)
+
+// fakeToolInstalled replaces the PATH lookup for the duration of the test
+func fakeToolInstalled(t *testing.T, installed func(string) bool) {
+	t.Helper()
+	original := toolInstalled
+	toolInstalled = installed
+	t.Cleanup(func() { toolInstalled = original })
+}
+
+// renderRemediation returns everything displayRemediation prints for results
+func renderRemediation(results []runner.CheckResult) string {
+	var stdout, stderr bytes.Buffer
+	formatter := output.New(output.Options{ColorEnabled: false, Out: &stdout, Err: &stderr})
+	displayRemediation(formatter, results)
+	return stdout.String() + stderr.String()
+}
+
+func TestDisplayRemediation(t *testing.T) {
+	fakeToolInstalled(t, func(string) bool { return true })
+
+	out := renderRemediation([]runner.CheckResult{
+		{Name: "whitespace", Success: true},
+		{Name: "lint", Error: "lint failed", Output: "main.go:3:1: unused variable x (unused)"},
+		{Name: "fumpt", Error: "fumpt failed", Suggestion: "Run 'gofumpt -w' on the listed files and stage the changes."},
+		{Name: "error-compare", Error: "2 comparisons", Suggestion: "Use errors.Is"},
+	})
+
+	assert.Contains(t, out, "How to Fix")
+	assert.Contains(t, out, "━ lint ━")
+	assert.Contains(t, out, "$ golangci-lint run --fix ./...")
+	assert.Contains(t, out, "Run 'golangci-lint run' to see full details", "falls back to the formatter's suggestion")
+	assert.Contains(t, out, "━ fumpt ━")
+	assert.Contains(t, out, "$ gofumpt -w .")
+	assert.Contains(t, out, "Run 'gofumpt -w' on the listed files")
+	assert.Contains(t, out, "━ error-compare ━")
+	assert.Contains(t, out, "Use errors.Is")
+	assert.NotContains(t, out, "whitespace")
+	assert.NotContains(t, out, "not installed")
+}
+
+func TestDisplayRemediation_MissingTool(t *testing.T) {
+	fakeToolInstalled(t, func(tool string) bool { return tool != "gofumpt" })
+
+	out := renderRemediation([]runner.CheckResult{
+		{Name: "lint", Error: "lint failed"},
+		{Name: "fumpt", Error: "fumpt failed"},
+	})
+	assert.Contains(t, out, "gofumpt is not installed: go install mvdan.cc/gofumpt@")
+	assert.NotContains(t, out, "golangci-lint is not installed")
+}
+
+func TestDisplayRemediation_NothingFailed(t *testing.T) {
+	out := renderRemediation([]runner.CheckResult{
+		{Name: "lint", Success: true},
+		{Name: "fumpt", Error: "fumpt failed", WarnOnly: true},
+	})
+	assert.Empty(t, out)
+}
+
+func TestExplainFailuresFromFlags(t *testing.T) {
+	for _, tt := range []struct {
+		args []string
+		want bool
+	}{
+		{[]string{"--explain-failures"}, true},
+		{[]string{"--explain-failures=false"}, false},
+		{nil, output.IsOutputTTY()},
+	} {
+		cmd := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date")).BuildRunCmd()
+		require.NoError(t, cmd.ParseFlags(tt.args))
+
+		got, err := explainFailuresFromFlags(cmd)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got, "args %v", tt.args)
+	}
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// filesFromStdin is the --files-from value that reads the list from standard input
+const filesFromStdin = "-"
+
+// readFilesFrom reads the paths of a --files-from list, one per line. Lines
+// are trimmed, and blank lines and lines starting with # are skipped.
+// filesFromStdin reads the list from stdin.
+func readFilesFrom(name string, stdin io.Reader) ([]string, error) {
+	reader := stdin
+	if name != filesFromStdin {
+		file, err := os.Open(name) //nolint:gosec // File named on the command line
+		if err != nil {
+			return nil, fmt.Errorf("failed to open --files-from list: %w", err)
+		}
+		defer func() { _ = file.Close() }()
+		reader = file
+	}
+
+	var files []string
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		files = append(files, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read --files-from list: %w", err)
+	}
+	return files, nil
+}
+
+// commandStdin returns the command's input, or os.Stdin without a command
+func commandStdin(cmd *cobra.Command) io.Reader {
+	if cmd == nil {
+		return os.Stdin
+	}
+	return cmd.InOrStdin()
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadFilesFrom(t *testing.T) {
+	list := "# files to check\n\nmain.go\r\n  internal/app/app.go  \n   \n#internal/skip.go\nREADME.md"
+
+	t.Run("file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "files.txt")
+		require.NoError(t, os.WriteFile(path, []byte(list), 0o600))
+
+		files, err := readFilesFrom(path, strings.NewReader("ignored.go\n"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"main.go", "internal/app/app.go", "README.md"}, files)
+	})
+
+	t.Run("stdin", func(t *testing.T) {
+		files, err := readFilesFrom(filesFromStdin, strings.NewReader(list))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"main.go", "internal/app/app.go", "README.md"}, files)
+	})
+
+	t.Run("only comments", func(t *testing.T) {
+		files, err := readFilesFrom(filesFromStdin, strings.NewReader("# nothing\n\n"))
+		require.NoError(t, err)
+		assert.Empty(t, files)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := readFilesFrom(filepath.Join(t.TempDir(), "missing.txt"), nil)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestRunChecksWithConfig_FilesFrom(t *testing.T) {
+	dir := setupFixRepo(t)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "generated"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "generated", "out.md"), []byte("# Out"), 0o600))
+
+	writeList := func(content string) string {
+		path := filepath.Join(t.TempDir(), "files.txt")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+	builder := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date"))
+
+	err := builder.runChecksWithConfig(RunConfig{FilesFrom: writeList("# clean only\n\nclean.txt\n"), Parallel: 1}, nil, []string{"eof"})
+	require.NoError(t, err)
+
+	err = builder.runChecksWithConfig(RunConfig{FilesFrom: writeList("clean.txt\nnoeol.md\n"), Parallel: 1}, nil, []string{"eof"})
+	require.Error(t, err, "noeol.md comes from the list")
+
+	err = builder.runChecksWithConfig(RunConfig{FilesFrom: writeList("clean.txt\ngenerated/out.md\n"), ExcludeDirs: []string{"generated"}, Parallel: 1}, nil, []string{"eof"})
+	require.NoError(t, err, "exclusions still apply to listed files")
+
+	err = builder.runChecksWithConfig(RunConfig{FilesFrom: writeList("# nothing to check\n"), Parallel: 1}, nil, []string{"eof"})
+	require.NoError(t, err, "an empty list checks nothing rather than the staged files")
+
+	err = builder.runChecksWithConfig(RunConfig{FilesFrom: filepath.Join(dir, "missing.txt"), Parallel: 1}, nil, []string{"eof"})
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
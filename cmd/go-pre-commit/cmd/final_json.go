@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+)
+
+// finalSummary is the one-line outcome --emit-final-json writes after the
+// human-readable output, for wrappers that only need the result
+type finalSummary struct {
+	Passed       int      `json:"passed"`
+	Failed       int      `json:"failed"`
+	Skipped      int      `json:"skipped"`
+	DurationMS   int64    `json:"durationMs"`
+	FailedChecks []string `json:"failedChecks"`
+}
+
+// newFinalSummary summarizes results. Failed checks are those the report
+// shows as failures, so warn-only checks are left out.
+func newFinalSummary(results *runner.Results) finalSummary {
+	summary := finalSummary{
+		Passed:       results.Passed,
+		Failed:       results.Failed,
+		Skipped:      results.Skipped,
+		DurationMS:   results.TotalDuration.Milliseconds(),
+		FailedChecks: []string{},
+	}
+	for _, result := range results.CheckResults {
+		if !result.Success && !result.Skipped && !result.WarnOnly {
+			summary.FailedChecks = append(summary.FailedChecks, result.Name)
+		}
+	}
+	return summary
+}
+
+// WriteFinalSummary writes the summary of a run made with --emit-final-json
+// as a single JSON line, and nothing for other commands. It is called after
+// the command's error is printed so the summary is the last line on stderr.
+func (app *CLIApp) WriteFinalSummary(w io.Writer) error {
+	if app.finalResults == nil {
+		return nil
+	}
+	return writeFinalJSON(w, app.finalResults)
+}
+
+// writeFinalJSON writes the summary of results to w as a single JSON line
+func writeFinalJSON(w io.Writer, results *runner.Results) error {
+	return json.NewEncoder(w).Encode(newFinalSummary(results))
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+)
+
+func TestWriteFinalJSON(t *testing.T) {
+	results := &runner.Results{
+		Passed:        1,
+		Failed:        1,
+		Skipped:       1,
+		Warned:        1,
+		TotalDuration: 1500 * time.Millisecond,
+		CheckResults: []runner.CheckResult{
+			{Name: "eof", Success: true},
+			{Name: "lint", Error: "2 issues"},
+			{Name: "mod-tidy", Success: true, Skipped: true},
+			{Name: "gitleaks", Error: "leak", WarnOnly: true},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeFinalJSON(&buf, results))
+	assert.Equal(t, 1, strings.Count(buf.String(), "\n"), "one line")
+
+	var summary map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &summary))
+	assert.Equal(t, map[string]any{
+		"passed":       float64(1),
+		"failed":       float64(1),
+		"skipped":      float64(1),
+		"durationMs":   float64(1500),
+		"failedChecks": []any{"lint"},
+	}, summary)
+
+	buf.Reset()
+	require.NoError(t, writeFinalJSON(&buf, &runner.Results{}))
+	assert.JSONEq(t, `{"passed":0,"failed":0,"skipped":0,"durationMs":0,"failedChecks":[]}`, buf.String())
+}
+
+func TestCLIAppWriteFinalSummary(t *testing.T) {
+	app := NewCLIApp("dev", "", "")
+
+	var buf bytes.Buffer
+	require.NoError(t, app.WriteFinalSummary(&buf))
+	assert.Empty(t, buf.String(), "nothing without --emit-final-json")
+
+	app.finalResults = &runner.Results{Passed: 2}
+	require.NoError(t, app.WriteFinalSummary(&buf))
+	assert.JSONEq(t, `{"passed":2,"failed":0,"skipped":0,"durationMs":0,"failedChecks":[]}`, buf.String())
+}
@@ -0,0 +1,339 @@
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/go-pre-commit/internal/checks"
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/git"
+	"github.com/mrz1836/go-pre-commit/internal/output"
+)
+
+// ErrFixFailed is returned when a fixer could not run to completion
+var ErrFixFailed = errors.New("some fixes could not be applied")
+
+// ErrFixNotConverged is returned when fixers keep rewriting a file they already
+// fixed, usually because two of them disagree on its formatting
+var ErrFixNotConverged = errors.New("fixers did not converge")
+
+// FixConfig holds configuration for the fix command
+type FixConfig struct {
+	AllFiles  bool
+	Files     []string
+	Stage     bool
+	PatchFile string // write the fixes to this patch instead of applying them
+}
+
+// fixer describes a check that can correct the issues it finds
+type fixer struct {
+	name    string
+	enabled bool
+	fixed   error // returned by the check when it rewrote files
+}
+
+// fixStep is an enabled fixer with the check that applies it
+type fixStep struct {
+	fixer
+
+	check checks.Check
+}
+
+// fixers returns the auto-fixing checks in the order they run. Whitespace and
+// EOF go first so gofumpt has the final say on Go files.
+func fixers(cfg *config.Config) []fixer {
+	return []fixer{
+		{name: "whitespace", enabled: cfg.Checks.Whitespace, fixed: prerrors.ErrWhitespaceIssues},
+		{name: "eof", enabled: cfg.Checks.EOF, fixed: prerrors.ErrEOFIssues},
+		{name: "fumpt", enabled: cfg.Checks.Fumpt, fixed: prerrors.ErrFmtIssues},
+	}
+}
+
+// BuildFixCmd creates the fix command
+func (cb *CommandBuilder) BuildFixCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fix [flags]",
+		Short: "Apply all automatic fixes",
+		Long: `Apply every automatic fix in one pass.
+
+Runs the fix-capable checks (whitespace, eof, fumpt) on staged files, or on
+all files with --all-files, and lists the files that were modified. Unlike
+"run", fix exits successfully when it changes files: it is a fixer, not a gate.
+
+With --fix-output patch:<file> the files are left as they are and the fixes
+are written to <file> as one unified diff, to review and then apply with
+"git apply <file>" from the repository root.`,
+		Example: `  # Fix staged files
+  go-pre-commit fix
+
+  # Fix every file in the repository and re-stage the changes
+  go-pre-commit fix --all-files --stage
+
+  # Write the fixes to a patch instead of applying them
+  go-pre-commit fix --all-files --fix-output patch:fixes.patch`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			fixConfig := FixConfig{}
+			var err error
+
+			fixConfig.AllFiles, err = cmd.Flags().GetBool("all-files")
+			if err != nil {
+				return err
+			}
+
+			fixConfig.Files, err = cmd.Flags().GetStringSlice("files")
+			if err != nil {
+				return err
+			}
+
+			fixConfig.Stage, err = cmd.Flags().GetBool("stage")
+			if err != nil {
+				return err
+			}
+
+			fixOutput, err := cmd.Flags().GetString("fix-output")
+			if err != nil {
+				return err
+			}
+			if fixConfig.PatchFile, err = parseFixOutput(fixOutput); err != nil {
+				return err
+			}
+			if fixConfig.PatchFile != "" && fixConfig.Stage {
+				return fmt.Errorf("%w: --stage applies fixes, a patch leaves them unapplied", ErrInvalidFixOutput)
+			}
+
+			return cb.runFix(commandContext(cmd), fixConfig)
+		},
+	}
+
+	cmd.Flags().BoolP("all-files", "a", false, "Fix all files in the repository")
+	cmd.Flags().StringSliceP("files", "f", nil, "Specific files to fix")
+	cmd.Flags().Bool("stage", false, "Stage the modified files after fixing")
+	cmd.Flags().String("fix-output", "", "Write the fixes to patch:<file> instead of applying them in place")
+
+	return cmd
+}
+
+func (cb *CommandBuilder) runFix(ctx context.Context, fixConfig FixConfig) error {
+	cfg, err := config.Load()
+	if err != nil {
+		formatter := output.NewDefault()
+		formatter.Error("Failed to load configuration: %v", err)
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	formatter, err := cb.newFormatter(cfg)
+	if err != nil {
+		output.NewDefault().Error("Invalid output destination: %v", err)
+		return err
+	}
+	defer func() { _ = formatter.Close() }()
+
+	repoRoot, err := git.FindRepositoryRoot()
+	if err != nil {
+		formatter.Error("Failed to find git repository: %v", err)
+		return fmt.Errorf("failed to find git repository: %w", err)
+	}
+
+	files, err := selectFilesToCheck(RunConfig{AllFiles: fixConfig.AllFiles, Files: fixConfig.Files}, cfg, repoRoot, formatter)
+	if err != nil {
+		return err
+	}
+	files = git.NewFileClassifier(cfg).FilterExcluded(files)
+	if len(files) == 0 {
+		formatter.Info("No files to fix")
+		return nil
+	}
+
+	if fixConfig.PatchFile != "" {
+		return writeFixPatch(ctx, cfg, repoRoot, files, fixConfig.PatchFile, formatter)
+	}
+
+	modified, failures, convergeErr := applyFixes(ctx, cfg, repoRoot, files)
+	if ctx.Err() != nil {
+		formatter.Warning("Interrupted; some files may not have been fixed")
+		return ErrInterrupted
+	}
+
+	if len(modified) == 0 {
+		formatter.Info("No fixes needed")
+	} else {
+		formatter.Success("Fixed %d file(s):", len(modified))
+		for _, file := range modified {
+			formatter.Detail("%s", file)
+		}
+
+		if fixConfig.Stage {
+			if err = git.NewRepositoryWithConfig(repoRoot, cfg).StageFiles(ctx, modified); err != nil {
+				formatter.Error("Failed to stage fixed files: %v", err)
+				return err
+			}
+			formatter.Info("Staged %d fixed file(s)", len(modified))
+		}
+	}
+
+	return reportFixProblems(formatter, failures, convergeErr)
+}
+
+// reportFixProblems reports the fixers that failed and fixers that did not
+// converge, returning the error fix exits with
+func reportFixProblems(formatter *output.Formatter, failures []string, convergeErr error) error {
+	if len(failures) > 0 {
+		for _, failure := range failures {
+			formatter.Error("%s", failure)
+		}
+		return fmt.Errorf("%w: %s", ErrFixFailed, strings.Join(failures, "; "))
+	}
+	if convergeErr != nil {
+		formatter.Error("%v", convergeErr)
+		formatter.Info("Disable one of the competing fixers or align their settings")
+		return convergeErr
+	}
+	return nil
+}
+
+// applyFixes runs every enabled fixer over files, one after another so they
+// never rewrite the same file concurrently. It returns the files whose content
+// changed, a description of each fixer that failed for another reason, and
+// an ErrFixNotConverged error when the fixers do not settle on a result.
+func applyFixes(ctx context.Context, cfg *config.Config, repoRoot string, files []string) ([]string, []string, error) {
+	return runFixSteps(ctx, repoRoot, files, fixSteps(cfg, ""))
+}
+
+// fixSteps returns the enabled fixers with the checks that apply them. A
+// non-empty root is the repository root the checks use instead of git's.
+func fixSteps(cfg *config.Config, root string) []fixStep {
+	// Staging is decided by the fix command, not by the checks
+	fixCfg := *cfg
+	fixCfg.CheckBehaviors.WhitespaceAutoStage = false
+	fixCfg.CheckBehaviors.EOFAutoStage = false
+	fixCfg.CheckBehaviors.FumptAutoStage = false
+	registry := checks.NewRegistryWithConfig(&fixCfg)
+	if root != "" {
+		registry.SharedContext().SetRepoRoot(root)
+	}
+
+	var steps []fixStep
+	for _, f := range fixers(&fixCfg) {
+		if check, ok := registry.Get(f.name); f.enabled && ok {
+			steps = append(steps, fixStep{fixer: f, check: check})
+		}
+	}
+	return steps
+}
+
+// runFixSteps applies the steps to files, then runs them once more over the
+// files they changed. Fixers that agree leave those files alone the second
+// time; a file that is rewritten again is reported with the steps that
+// rewrote it, since repeating the fix would only make it oscillate.
+func runFixSteps(ctx context.Context, repoRoot string, files []string, steps []fixStep) ([]string, []string, error) {
+	before := fileDigests(repoRoot, files)
+	_, failures := runFixPass(ctx, repoRoot, files, steps)
+	modified := changedFiles(before, fileDigests(repoRoot, files))
+	if len(modified) == 0 || ctx.Err() != nil {
+		return modified, failures, nil
+	}
+
+	// Failures from the second pass repeat the first pass's
+	rewrittenBy, _ := runFixPass(ctx, repoRoot, modified, steps)
+	if len(rewrittenBy) == 0 {
+		return modified, failures, nil
+	}
+
+	unsettled := make([]string, 0, len(rewrittenBy))
+	for file := range rewrittenBy {
+		unsettled = append(unsettled, file)
+	}
+	slices.Sort(unsettled)
+
+	details := make([]string, 0, len(unsettled))
+	for _, file := range unsettled {
+		details = append(details, fmt.Sprintf("%s (rewritten by %s)", file, strings.Join(rewrittenBy[file], ", ")))
+	}
+	modified = changedFiles(before, fileDigests(repoRoot, files))
+	return modified, failures, fmt.Errorf("%w: %s", ErrFixNotConverged, strings.Join(details, "; "))
+}
+
+// runFixPass runs each step over files in order. It returns the names of the
+// steps that changed each file and a description of each step that failed.
+func runFixPass(ctx context.Context, repoRoot string, files []string, steps []fixStep) (map[string][]string, []string) {
+	changedBy := make(map[string][]string)
+	var failures []string
+	for _, step := range steps {
+		targets := step.check.FilterFiles(files)
+		if len(targets) == 0 {
+			continue
+		}
+
+		before := fileDigests(repoRoot, targets)
+		if err := step.check.Run(ctx, targets); err != nil && !isFixedResult(err, step.fixed) {
+			failures = append(failures, fmt.Sprintf("%s: %v", step.name, err))
+		}
+		for _, file := range changedFiles(before, fileDigests(repoRoot, targets)) {
+			changedBy[file] = append(changedBy[file], step.name)
+		}
+	}
+	return changedBy, failures
+}
+
+// changedFiles returns, sorted, the files whose digest differs between the
+// two snapshots
+func changedFiles(before, after map[string]string) []string {
+	var changed []string
+	for file, digest := range after {
+		if before[file] != digest {
+			changed = append(changed, file)
+		}
+	}
+	slices.Sort(changed)
+	return changed
+}
+
+// isFixedResult reports whether err only signals that the check rewrote files.
+// Errors that wrap the sentinel with extra detail describe real failures.
+func isFixedResult(err, fixed error) bool {
+	if err == fixed { //nolint:errorlint // an exact match separates "fixed" from wrapped failures
+		return true
+	}
+	var checkErr *prerrors.CheckError
+	return errors.As(err, &checkErr) && checkErr.Err == fixed //nolint:errorlint // see above
+}
+
+// fileDigests returns a content hash for each readable file
+func fileDigests(repoRoot string, files []string) map[string]string {
+	digests := make(map[string]string, len(files))
+	for _, file := range files {
+		path := file
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(repoRoot, path)
+		}
+		if digest, err := fileDigest(path); err == nil {
+			digests[file] = digest
+		}
+	}
+	return digests
+}
+
+// fileDigest hashes a file without loading it into memory
+func fileDigest(path string) (string, error) {
+	f, err := os.Open(path) //nolint:gosec // path comes from the git file list
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
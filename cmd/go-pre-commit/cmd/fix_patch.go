@@ -0,0 +1,268 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	"github.com/mrz1836/go-pre-commit/internal/output"
+)
+
+// ErrInvalidFixOutput is returned for --fix-output values other than patch:<file>
+var ErrInvalidFixOutput = errors.New("invalid --fix-output")
+
+// fixOutputPatch prefixes the --fix-output value that writes fixes to a patch
+const fixOutputPatch = "patch:"
+
+// noNewlineMarker follows a diff line that ends its file without a newline
+const noNewlineMarker = "\\ No newline at end of file\n"
+
+// fixPreview is the outcome of running the fixers without keeping their changes
+type fixPreview struct {
+	patch       []byte
+	modified    []string
+	failures    []string
+	convergeErr error
+}
+
+// parseFixOutput returns the patch file of a --fix-output value, or "" for
+// the default of fixing files in place
+func parseFixOutput(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	file, ok := strings.CutPrefix(value, fixOutputPatch)
+	if !ok || strings.TrimSpace(file) == "" {
+		return "", fmt.Errorf("%w %q: use patch:<file>", ErrInvalidFixOutput, value)
+	}
+	return file, nil
+}
+
+// writeFixPatch handles fix --fix-output patch:<file>: it writes what the
+// fixers would change to patchFile as one unified diff and leaves the files
+// as they were. No patch is written when nothing needs fixing.
+func writeFixPatch(ctx context.Context, cfg *config.Config, repoRoot string, files []string, patchFile string, formatter *output.Formatter) error {
+	preview, err := previewFixes(ctx, cfg, repoRoot, files)
+	if err != nil {
+		formatter.Error("Failed to preview fixes: %v", err)
+		return err
+	}
+	if ctx.Err() != nil {
+		formatter.Warning("Interrupted; no patch was written")
+		return ErrInterrupted
+	}
+
+	if len(preview.modified) == 0 {
+		formatter.Info("No fixes needed; %s was not written", patchFile)
+	} else {
+		if err = os.WriteFile(patchFile, preview.patch, 0o600); err != nil {
+			formatter.Error("Failed to write %s: %v", patchFile, err)
+			return err
+		}
+		formatter.Success("Wrote fixes for %d file(s) to %s:", len(preview.modified), patchFile)
+		for _, file := range preview.modified {
+			formatter.Detail("%s", file)
+		}
+		if abs, absErr := filepath.Abs(patchFile); absErr == nil {
+			patchFile = abs
+		}
+		formatter.Info("Review it, then apply it from the repository root with: git apply %s", patchFile)
+	}
+
+	return reportFixProblems(formatter, preview.failures, preview.convergeErr)
+}
+
+// previewFixes runs the fixers like applyFixes, but over copies of the files
+// in a scratch workspace laid out like the repository, and records the
+// changes to each modified file as a unified diff. The working tree is never
+// written. The error reports a workspace that could not be set up and files
+// that could not be diffed.
+func previewFixes(ctx context.Context, cfg *config.Config, repoRoot string, files []string) (*fixPreview, error) {
+	files = repoRelativeFiles(repoRoot, files)
+	originals := readFileContents(repoRoot, fixTargets(files, fixSteps(cfg, "")))
+
+	scratch, err := os.MkdirTemp(cfg.Performance.TempDir, "go-pre-commit-fix-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a scratch copy of the files: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(scratch) }()
+	if err = copyFixInputs(repoRoot, scratch, originals); err != nil {
+		return nil, err
+	}
+
+	// The checks resolve files against the working directory and their
+	// repository root, so both point at the copy while the fixers run
+	preview := &fixPreview{}
+	err = inDir(scratch, func() {
+		preview.modified, preview.failures, preview.convergeErr = runFixSteps(ctx, scratch, files, fixSteps(cfg, scratch))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var patch bytes.Buffer
+	var errs []error
+	for _, file := range preview.modified {
+		fixed, err := os.ReadFile(filepath.Join(scratch, file)) //nolint:gosec // path is inside the scratch copy
+		if err == nil {
+			err = writeFileDiff(&patch, filepath.ToSlash(file), originals[file], fixed)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", file, err))
+		}
+	}
+
+	preview.patch = patch.Bytes()
+	return preview, errors.Join(errs...)
+}
+
+// fixContextFiles are files outside the fix targets that the fixers read:
+// gofumpt takes the module path and Go version from them
+//
+//nolint:gochecknoglobals // Read-only list
+var fixContextFiles = []string{"go.mod", ".golangci.json", ".golangci.yml", ".golangci.yaml"}
+
+// copyFixInputs writes each file's content under scratch at its repository
+// path, along with the context files of its directory and every directory
+// above it up to the repository root
+func copyFixInputs(repoRoot, scratch string, contents map[string][]byte) error {
+	copied := make(map[string]bool)
+	write := func(file string, content []byte) error {
+		path := filepath.Join(scratch, file)
+		if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+			return err
+		}
+		return os.WriteFile(path, content, 0o600)
+	}
+
+	for file, content := range contents {
+		if err := write(file, content); err != nil {
+			return fmt.Errorf("failed to copy %s: %w", file, err)
+		}
+		copied[file] = true
+	}
+	for file := range contents {
+		for dir := filepath.Dir(file); ; dir = filepath.Dir(dir) {
+			for _, name := range fixContextFiles {
+				contextFile := filepath.Join(dir, name)
+				if copied[contextFile] {
+					continue
+				}
+				copied[contextFile] = true
+				content, err := os.ReadFile(filepath.Join(repoRoot, contextFile)) //nolint:gosec // path is inside the repository
+				if err != nil {
+					continue
+				}
+				if err = write(contextFile, content); err != nil {
+					return fmt.Errorf("failed to copy %s: %w", contextFile, err)
+				}
+			}
+			if dir == "." {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// repoRelativeFiles returns files relative to the repository root, leaving
+// out absolute paths outside it
+func repoRelativeFiles(repoRoot string, files []string) []string {
+	relative := make([]string, 0, len(files))
+	for _, file := range files {
+		if filepath.IsAbs(file) {
+			rel, err := filepath.Rel(repoRoot, file)
+			if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+				continue
+			}
+			file = rel
+		}
+		relative = append(relative, filepath.Clean(file))
+	}
+	return relative
+}
+
+// inDir runs fn with dir as the working directory, restoring the previous
+// one afterwards
+func inDir(dir string, fn func()) error {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	if err = os.Chdir(dir); err != nil {
+		return err
+	}
+	defer func() { _ = os.Chdir(cwd) }()
+	fn()
+	return nil
+}
+
+// fixTargets returns the files any of the steps would look at, in order
+func fixTargets(files []string, steps []fixStep) []string {
+	seen := make(map[string]bool)
+	var targets []string
+	for _, step := range steps {
+		for _, file := range step.check.FilterFiles(files) {
+			if !seen[file] {
+				seen[file] = true
+				targets = append(targets, file)
+			}
+		}
+	}
+	return targets
+}
+
+// readFileContents returns the content of each readable file
+func readFileContents(repoRoot string, files []string) map[string][]byte {
+	contents := make(map[string][]byte, len(files))
+	for _, file := range files {
+		if content, err := os.ReadFile(repoFilePath(repoRoot, file)); err == nil { //nolint:gosec // path comes from the git file list
+			contents[file] = content
+		}
+	}
+	return contents
+}
+
+// repoFilePath resolves a file from the file list against the repository root
+func repoFilePath(repoRoot, file string) string {
+	if filepath.IsAbs(file) {
+		return file
+	}
+	return filepath.Join(repoRoot, file)
+}
+
+// writeFileDiff writes the unified diff that turns before into after, in the
+// form git diff prints it
+func writeFileDiff(w io.Writer, name string, before, after []byte) error {
+	if _, err := fmt.Fprintf(w, "diff --git a/%s b/%s\n", name, name); err != nil {
+		return err
+	}
+	return difflib.WriteUnifiedDiff(w, difflib.UnifiedDiff{
+		A:        diffLines(before),
+		B:        diffLines(after),
+		FromFile: "a/" + name,
+		ToFile:   "b/" + name,
+		Context:  3,
+	})
+}
+
+// diffLines splits content into lines that keep their newline. A last line
+// without one carries the marker patch tools expect after it, which also
+// keeps it from matching the same text with a newline.
+func diffLines(content []byte) []string {
+	lines := strings.SplitAfter(string(content), "\n")
+	if last := lines[len(lines)-1]; last == "" {
+		lines = lines[:len(lines)-1]
+	} else {
+		lines[len(lines)-1] = last + "\n" + noNewlineMarker
+	}
+	return lines
+}
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunFix_PatchOutputAppliesCleanly(t *testing.T) {
+	dir := setupFixRepo(t)
+	builder := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date"))
+	patch := filepath.Join(t.TempDir(), "fixes.patch")
+
+	var err error
+	out := captureCmdOutput(t, func() {
+		err = builder.runFix(context.Background(), FixConfig{PatchFile: patch})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, "Wrote fixes for 2 file(s)")
+	assert.Contains(t, out, "git apply "+patch)
+
+	// The working tree is untouched
+	assert.Empty(t, gitCmd(t, dir, "diff", "--name-only"))
+	content, err := os.ReadFile(filepath.Join(dir, "noeol.md")) //nolint:gosec // test path
+	require.NoError(t, err)
+	assert.Equal(t, "# Title", string(content))
+
+	// Both fixers' changes are in the one patch, and it reproduces the fixes
+	gitCmd(t, dir, "apply", "--check", patch)
+	gitCmd(t, dir, "apply", patch)
+
+	content, err = os.ReadFile(filepath.Join(dir, "trailing.txt")) //nolint:gosec // test path
+	require.NoError(t, err)
+	assert.Equal(t, "hello\nworld\n", string(content))
+
+	content, err = os.ReadFile(filepath.Join(dir, "noeol.md")) //nolint:gosec // test path
+	require.NoError(t, err)
+	assert.Equal(t, "# Title\n", string(content))
+}
+
+func TestRunFix_PatchOutputNeverWritesWorkingTree(t *testing.T) {
+	dir := setupFixRepo(t)
+	builder := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date"))
+	patch := filepath.Join(t.TempDir(), "fixes.patch")
+
+	old := time.Now().Add(-time.Hour).Truncate(time.Second)
+	for _, file := range []string{"trailing.txt", "noeol.md"} {
+		require.NoError(t, os.Chtimes(filepath.Join(dir, file), old, old))
+	}
+
+	var err error
+	captureCmdOutput(t, func() {
+		err = builder.runFix(context.Background(), FixConfig{AllFiles: true, PatchFile: patch})
+	})
+	require.NoError(t, err)
+	require.FileExists(t, patch)
+
+	for _, file := range []string{"trailing.txt", "noeol.md"} {
+		info, statErr := os.Stat(filepath.Join(dir, file))
+		require.NoError(t, statErr)
+		assert.True(t, info.ModTime().Equal(old), "%s was rewritten", file)
+	}
+}
+
+func TestRunFix_PatchOutputFumptInNestedModule(t *testing.T) {
+	if _, err := exec.LookPath("gofumpt"); err != nil {
+		t.Skip("gofumpt not installed")
+	}
+	dir := setupFixRepo(t)
+	t.Setenv("GO_PRE_COMMIT_ENABLE_FUMPT", "true")
+
+	module := filepath.Join(dir, "tools")
+	require.NoError(t, os.MkdirAll(filepath.Join(module, "pkg"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(module, "go.mod"), []byte("module example.com/tools\n\ngo 1.21\n"), 0o600))
+	messy := "package pkg\n\nfunc  F()  {\n}\n"
+	require.NoError(t, os.WriteFile(filepath.Join(module, "pkg", "messy.go"), []byte(messy), 0o600))
+	gitCmd(t, dir, "add", ".")
+
+	builder := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date"))
+	patch := filepath.Join(t.TempDir(), "fixes.patch")
+	var err error
+	captureCmdOutput(t, func() {
+		err = builder.runFix(context.Background(), FixConfig{Files: []string{"tools/pkg/messy.go"}, PatchFile: patch})
+	})
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(module, "pkg", "messy.go")) //nolint:gosec // test path
+	require.NoError(t, err)
+	assert.Equal(t, messy, string(content), "the working tree is untouched")
+
+	gitCmd(t, dir, "apply", patch)
+	content, err = os.ReadFile(filepath.Join(module, "pkg", "messy.go")) //nolint:gosec // test path
+	require.NoError(t, err)
+	assert.Equal(t, "package pkg\n\nfunc F() {\n}\n", string(content))
+}
+
+func TestRunFix_PatchOutputNothingToFix(t *testing.T) {
+	setupFixRepo(t)
+	builder := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date"))
+	patch := filepath.Join(t.TempDir(), "fixes.patch")
+
+	var err error
+	out := captureCmdOutput(t, func() {
+		err = builder.runFix(context.Background(), FixConfig{Files: []string{"clean.txt"}, PatchFile: patch})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, "No fixes needed")
+	assert.NoFileExists(t, patch)
+}
+
+func TestParseFixOutput(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{value: "", want: ""},
+		{value: "patch:fixes.patch", want: "fixes.patch"},
+		{value: "patch:/tmp/out/fixes.diff", want: "/tmp/out/fixes.diff"},
+		{value: "patch:", wantErr: true},
+		{value: "fixes.patch", wantErr: true},
+		{value: "diff:fixes.patch", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseFixOutput(tt.value)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidFixOutput)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWriteFileDiff_MissingFinalNewline(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writeFileDiff(&buf, "a.md", []byte("one\ntwo"), []byte("one\ntwo\n")))
+	assert.Equal(t, "diff --git a/a.md b/a.md\n--- a/a.md\n+++ b/a.md\n@@ -1,2 +1,2 @@\n one\n-two\n\\ No newline at end of file\n+two\n", buf.String())
+}
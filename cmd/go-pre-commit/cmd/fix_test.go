@@ -0,0 +1,213 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+// gitCmd runs a git command in dir and returns its trimmed output
+func gitCmd(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.CommandContext(context.Background(), "git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+	return strings.TrimSpace(string(out))
+}
+
+// setupFixRepo creates a git repository with fixable files, a minimal
+// configuration, and makes it the working directory
+func setupFixRepo(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	gitCmd(t, dir, "init", "-q")
+	gitCmd(t, dir, "config", "user.email", "test@example.com")
+	gitCmd(t, dir, "config", "user.name", "Test")
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".github"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".github", ".env.base"), []byte("ENABLE_GO_PRE_COMMIT=true\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "trailing.txt"), []byte("hello  \nworld\t\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "noeol.md"), []byte("# Title"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "clean.txt"), []byte("clean\n"), 0o600))
+	gitCmd(t, dir, "add", ".")
+
+	t.Chdir(dir)
+	t.Setenv("GO_PRE_COMMIT_TEST_CONFIG_DIR", dir)
+	t.Setenv("GO_PRE_COMMIT_ENABLE_FUMPT", "false")
+	return dir
+}
+
+func TestApplyFixes(t *testing.T) {
+	dir := setupFixRepo(t)
+
+	cfg := &config.Config{}
+	cfg.Checks.Whitespace = true
+	cfg.Checks.EOF = true
+	cfg.CheckTimeouts.Whitespace = 30
+	cfg.CheckTimeouts.EOF = 30
+	cfg.CheckBehaviors.WhitespaceAutoStage = true // ignored: fix decides staging
+
+	modified, failures, err := applyFixes(context.Background(), cfg, dir, []string{"trailing.txt", "noeol.md", "clean.txt"})
+	require.NoError(t, err)
+	assert.Empty(t, failures)
+	assert.Equal(t, []string{"noeol.md", "trailing.txt"}, modified)
+
+	content, err := os.ReadFile(filepath.Join(dir, "trailing.txt")) //nolint:gosec // test path
+	require.NoError(t, err)
+	assert.Equal(t, "hello\nworld\n", string(content))
+
+	content, err = os.ReadFile(filepath.Join(dir, "noeol.md")) //nolint:gosec // test path
+	require.NoError(t, err)
+	assert.Equal(t, "# Title\n", string(content))
+
+	// The fixes were not staged
+	assert.Contains(t, gitCmd(t, dir, "diff", "--name-only"), "trailing.txt")
+}
+
+func TestApplyFixes_DisabledFixersDoNotRun(t *testing.T) {
+	dir := setupFixRepo(t)
+
+	cfg := &config.Config{}
+	cfg.Checks.EOF = true
+	cfg.CheckTimeouts.EOF = 30
+
+	modified, failures, err := applyFixes(context.Background(), cfg, dir, []string{"trailing.txt", "noeol.md"})
+	require.NoError(t, err)
+	assert.Empty(t, failures)
+	assert.Equal(t, []string{"noeol.md"}, modified)
+}
+
+// errTestRewrote is the "fixed" sentinel returned by the fake fixers
+var errTestRewrote = errors.New("rewrote files")
+
+// rewriteCheck is a fake fixer that sets every file it is given to content
+type rewriteCheck struct {
+	name    string
+	dir     string
+	content string
+}
+
+func (c *rewriteCheck) Name() string                        { return c.name }
+func (c *rewriteCheck) Description() string                 { return c.name }
+func (c *rewriteCheck) Metadata() any                       { return nil }
+func (c *rewriteCheck) FilterFiles(files []string) []string { return files }
+func (c *rewriteCheck) Run(_ context.Context, files []string) error {
+	rewrote := false
+	for _, file := range files {
+		path := filepath.Join(c.dir, file)
+		current, err := os.ReadFile(path) //nolint:gosec // test path
+		if err != nil {
+			return err
+		}
+		if string(current) == c.content {
+			continue
+		}
+		if err = os.WriteFile(path, []byte(c.content), 0o600); err != nil {
+			return err
+		}
+		rewrote = true
+	}
+	if rewrote {
+		return errTestRewrote
+	}
+	return nil
+}
+
+func rewriteStep(dir, name, content string) fixStep {
+	return fixStep{
+		fixer: fixer{name: name, enabled: true, fixed: errTestRewrote},
+		check: &rewriteCheck{name: name, dir: dir, content: content},
+	}
+}
+
+func TestRunFixSteps_DetectsOscillation(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("original\n"), 0o600))
+
+	// The two fixers toggle main.go between their preferred forms forever
+	steps := []fixStep{
+		rewriteStep(dir, "tabs", "tabs\n"),
+		rewriteStep(dir, "spaces", "spaces\n"),
+	}
+	modified, failures, err := runFixSteps(context.Background(), dir, []string{"main.go"}, steps)
+	assert.Empty(t, failures)
+	assert.Equal(t, []string{"main.go"}, modified)
+	require.ErrorIs(t, err, ErrFixNotConverged)
+	assert.Contains(t, err.Error(), "main.go (rewritten by tabs, spaces)")
+}
+
+func TestRunFixSteps_AgreeingFixersConverge(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("original\n"), 0o600))
+
+	steps := []fixStep{
+		rewriteStep(dir, "first", "fixed\n"),
+		rewriteStep(dir, "second", "fixed\n"),
+	}
+	modified, failures, err := runFixSteps(context.Background(), dir, []string{"main.go"}, steps)
+	require.NoError(t, err)
+	assert.Empty(t, failures)
+	assert.Equal(t, []string{"main.go"}, modified)
+}
+
+func TestRunFix_ListsAndStagesModifiedFiles(t *testing.T) {
+	dir := setupFixRepo(t)
+	builder := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date"))
+
+	var err error
+	out := captureCmdOutput(t, func() {
+		err = builder.runFix(context.Background(), FixConfig{Stage: true})
+	})
+	require.NoError(t, err, "fix exits successfully even when it changed files")
+
+	assert.Contains(t, out, "Fixed 2 file(s)")
+	assert.Contains(t, out, "trailing.txt")
+	assert.Contains(t, out, "noeol.md")
+	assert.NotContains(t, out, "clean.txt")
+
+	// Everything fixed was re-staged
+	assert.Empty(t, gitCmd(t, dir, "diff", "--name-only"))
+}
+
+func TestRunFix_NothingToFix(t *testing.T) {
+	setupFixRepo(t)
+	builder := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date"))
+
+	var err error
+	out := captureCmdOutput(t, func() {
+		err = builder.runFix(context.Background(), FixConfig{Files: []string{"clean.txt"}})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, "No fixes needed")
+}
+
+func TestIsFixedResult(t *testing.T) {
+	assert.True(t, isFixedResult(prerrors.ErrWhitespaceIssues, prerrors.ErrWhitespaceIssues))
+	assert.True(t, isFixedResult(&prerrors.CheckError{Err: prerrors.ErrFmtIssues}, prerrors.ErrFmtIssues))
+
+	wrapped := errors.Join(prerrors.ErrWhitespaceIssues, os.ErrPermission)
+	assert.False(t, isFixedResult(wrapped, prerrors.ErrWhitespaceIssues), "wrapped errors carry real failures")
+	assert.False(t, isFixedResult(prerrors.ErrEOFIssues, prerrors.ErrWhitespaceIssues))
+}
+
+func TestFixCmd_Flags(t *testing.T) {
+	builder := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date"))
+	fixCmd := builder.BuildFixCmd()
+
+	assert.Equal(t, "fix [flags]", fixCmd.Use)
+	for _, name := range []string{"all-files", "files", "fix-output", "stage"} {
+		assert.NotNil(t, fixCmd.Flags().Lookup(name), "flag %s should exist", name)
+	}
+}
@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	"github.com/mrz1836/go-pre-commit/internal/git"
+	"github.com/mrz1836/go-pre-commit/internal/output"
+)
+
+// invokedByHook reports whether run was started by a commit hook: the
+// installed hook script sets git.HookMarkerEnv, and --pre-commit-compat
+// means the pre-commit framework's hook started it
+func invokedByHook(runConfig RunConfig) bool {
+	return runConfig.PreCommitCompat || os.Getenv(git.HookMarkerEnv) != ""
+}
+
+// displayManualRunNotice reminds a user who ran the checks by hand that the
+// results did not gate a commit. Hook runs, CI, and quiet output skip it.
+func displayManualRunNotice(formatter *output.Formatter, runConfig RunConfig, cfg *config.Config) {
+	if invokedByHook(runConfig) || runConfig.Quiet || cfg.Environment.IsCI {
+		return
+	}
+	formatter.Info("Checks were run manually; on commit the installed hook runs them again, unless skipped with git commit --no-verify")
+}
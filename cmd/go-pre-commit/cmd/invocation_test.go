@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	"github.com/mrz1836/go-pre-commit/internal/git"
+	"github.com/mrz1836/go-pre-commit/internal/output"
+)
+
+// manualRunNotice returns what displayManualRunNotice writes
+func manualRunNotice(runConfig RunConfig, cfg *config.Config) string {
+	var stdout, stderr bytes.Buffer
+	formatter := output.New(output.Options{ColorEnabled: false, Out: &stdout, Err: &stderr})
+	displayManualRunNotice(formatter, runConfig, cfg)
+	return stdout.String() + stderr.String()
+}
+
+func TestDisplayManualRunNotice_ManualRun(t *testing.T) {
+	t.Setenv(git.HookMarkerEnv, "")
+
+	assert.False(t, invokedByHook(RunConfig{}))
+	out := manualRunNotice(RunConfig{}, &config.Config{})
+	assert.Contains(t, out, "Checks were run manually")
+	assert.Contains(t, out, "--no-verify")
+}
+
+func TestDisplayManualRunNotice_HookRun(t *testing.T) {
+	t.Setenv(git.HookMarkerEnv, "1")
+
+	assert.True(t, invokedByHook(RunConfig{}))
+	assert.Empty(t, manualRunNotice(RunConfig{}, &config.Config{}), "the hook's runs are not reminded")
+}
+
+func TestDisplayManualRunNotice_Suppressed(t *testing.T) {
+	t.Setenv(git.HookMarkerEnv, "")
+
+	ci := &config.Config{}
+	ci.Environment.IsCI = true
+
+	assert.True(t, invokedByHook(RunConfig{PreCommitCompat: true}), "the pre-commit framework is a hook")
+	assert.Empty(t, manualRunNotice(RunConfig{PreCommitCompat: true}, &config.Config{}))
+	assert.Empty(t, manualRunNotice(RunConfig{Quiet: true}, &config.Config{}))
+	assert.Empty(t, manualRunNotice(RunConfig{}, ci))
+}
@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/mrz1836/go-pre-commit/internal/git"
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+)
+
+// lastRunFile is the file in the git directory that records the last failing
+// run for --only-failed
+const lastRunFile = "go-pre-commit-last.json"
+
+// ErrOnlyFailedConflict is returned when --only-failed is combined with
+// another way of selecting checks
+var ErrOnlyFailedConflict = errors.New("--only-failed cannot be combined with --only, --skip, --profile, or a check name")
+
+// Statuses of a check in the last-run record
+const (
+	lastRunPassed  = "passed"
+	lastRunFailed  = "failed"
+	lastRunSkipped = "skipped"
+	lastRunWarning = "warning"
+)
+
+// lastRun is the record --only-failed reads: each check that ran and how it
+// ended
+type lastRun struct {
+	Checks []lastRunCheck `json:"checks"`
+}
+
+// lastRunCheck is one check in the last-run record
+type lastRunCheck struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// newLastRun builds the last-run record from the run's results
+func newLastRun(results *runner.Results) lastRun {
+	last := lastRun{Checks: make([]lastRunCheck, 0, len(results.CheckResults))}
+	for _, result := range results.CheckResults {
+		status := lastRunFailed
+		switch {
+		case result.Skipped:
+			status = lastRunSkipped
+		case result.Success:
+			status = lastRunPassed
+		case result.WarnOnly:
+			status = lastRunWarning
+		}
+		last.Checks = append(last.Checks, lastRunCheck{Name: result.Name, Status: status})
+	}
+	return last
+}
+
+// lastRunPath returns the path of the last-run record. It lives in the git
+// directory so it is never committed and each worktree keeps its own.
+func lastRunPath(repoRoot string) (string, error) {
+	gitDir, err := git.NewRepository(repoRoot).GetGitDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(gitDir, lastRunFile), nil
+}
+
+// saveLastRun records results for a later --only-failed run. A run where no
+// check failed removes the record instead.
+func saveLastRun(repoRoot string, results *runner.Results) error {
+	path, err := lastRunPath(repoRoot)
+	if err != nil {
+		return err
+	}
+
+	if results.Failed == 0 {
+		if err = os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to clear the last run record: %w", err)
+		}
+		return nil
+	}
+
+	data, err := json.MarshalIndent(newLastRun(results), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode the last run record: %w", err)
+	}
+	if err = os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write the last run record: %w", err)
+	}
+	return nil
+}
+
+// loadFailedChecks returns the checks that failed in the recorded run, or nil
+// when no failing run is recorded
+func loadFailedChecks(repoRoot string) ([]string, error) {
+	path, err := lastRunPath(repoRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path) //nolint:gosec // path is inside the repository's git directory
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the last run record: %w", err)
+	}
+
+	var last lastRun
+	if err = json.Unmarshal(data, &last); err != nil {
+		return nil, fmt.Errorf("failed to parse the last run record %s: %w", path, err)
+	}
+
+	var failed []string
+	for _, check := range last.Checks {
+		if check.Status == lastRunFailed {
+			failed = append(failed, check.Name)
+		}
+	}
+	return failed, nil
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunChecksWithConfig_OnlyFailed(t *testing.T) {
+	dir := setupFixRepo(t)
+	record := filepath.Join(dir, ".git", lastRunFile)
+	builder := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date"))
+
+	// A failing run records which checks failed
+	err := builder.runChecksWithConfig(RunConfig{Files: []string{"noeol.md", "clean.txt"}, Parallel: 1}, nil, []string{"eof"})
+	require.Error(t, err)
+
+	data, err := os.ReadFile(record) //nolint:gosec // test path
+	require.NoError(t, err)
+	var last lastRun
+	require.NoError(t, json.Unmarshal(data, &last))
+	assert.Equal(t, []lastRunCheck{{Name: "eof", Status: lastRunFailed}}, last.Checks)
+	assert.NotContains(t, string(data), "remote", "repository metadata is not recorded")
+
+	failed, err := loadFailedChecks(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"eof"}, failed)
+
+	// --only-failed re-runs eof alone: whitespace would fix trailing.txt
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "noeol.md"), []byte("# Title"), 0o600))
+	err = builder.runChecksWithConfig(RunConfig{Files: []string{"noeol.md", "trailing.txt"}, Parallel: 1, OnlyFailed: true}, nil, nil)
+	require.Error(t, err)
+	content, err := os.ReadFile(filepath.Join(dir, "trailing.txt")) //nolint:gosec // test path
+	require.NoError(t, err)
+	assert.Equal(t, "hello  \nworld\t\n", string(content), "whitespace did not fail last time, so it must not run")
+	assert.FileExists(t, record)
+
+	// The fix made the next re-run clean, which clears the record
+	err = builder.runChecksWithConfig(RunConfig{Files: []string{"noeol.md", "trailing.txt"}, Parallel: 1, OnlyFailed: true}, nil, nil)
+	require.NoError(t, err)
+	assert.NoFileExists(t, record)
+
+	// Without a record there is nothing to re-run
+	err = builder.runChecksWithConfig(RunConfig{Files: []string{"trailing.txt"}, Parallel: 1, OnlyFailed: true}, nil, nil)
+	require.NoError(t, err)
+	content, err = os.ReadFile(filepath.Join(dir, "trailing.txt")) //nolint:gosec // test path
+	require.NoError(t, err)
+	assert.Equal(t, "hello  \nworld\t\n", string(content))
+}
+
+func TestRunChecksWithConfig_OnlyFailedConflicts(t *testing.T) {
+	builder := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date"))
+	for name, tc := range map[string]struct {
+		runConfig RunConfig
+		args      []string
+	}{
+		"check name": {RunConfig{OnlyFailed: true}, []string{"eof"}},
+		"only":       {RunConfig{OnlyFailed: true, OnlyChecks: []string{"eof"}}, nil},
+		"skip":       {RunConfig{OnlyFailed: true, SkipChecks: []string{"eof"}}, nil},
+		"profile":    {RunConfig{OnlyFailed: true, Profile: "fast"}, nil},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := builder.runChecksWithConfig(tc.runConfig, nil, tc.args)
+			require.ErrorIs(t, err, ErrOnlyFailedConflict)
+		})
+	}
+}
+
+func TestLoadFailedChecks_CorruptRecord(t *testing.T) {
+	dir := setupFixRepo(t)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".git", lastRunFile), []byte("{"), 0o600))
+
+	_, err := loadFailedChecks(dir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse the last run record")
+}
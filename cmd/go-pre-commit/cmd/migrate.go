@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	"github.com/mrz1836/go-pre-commit/internal/envfile"
+	"github.com/mrz1836/go-pre-commit/internal/git"
+)
+
+// defaultMigrateSource is the env file migrate-config reads by default
+const defaultMigrateSource = ".github/.env.shared"
+
+// ErrConfigFileExists is returned when migrate-config would overwrite a file
+var ErrConfigFileExists = errors.New("config file already exists (use --force to overwrite)")
+
+// MigrateConfig holds configuration for the migrate-config command
+type MigrateConfig struct {
+	From   string
+	Output string
+	Force  bool
+}
+
+// BuildMigrateConfigCmd creates the migrate-config command
+func (cb *CommandBuilder) BuildMigrateConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-config",
+		Short: "Convert env configuration to .go-pre-commit.yml",
+		Long: `Convert the GO_PRE_COMMIT_* variables of an env file to .go-pre-commit.yml.
+
+Check enable flags, check timeouts, the global timeout, and exclude patterns
+are carried over. Every other pre-commit variable is listed as a warning so it
+can be moved by hand. Relative paths are resolved from the repository root.`,
+		Example: `  # Convert .github/.env.shared to .go-pre-commit.yml
+  go-pre-commit migrate-config
+
+  # Convert a different env file, replacing an existing YAML file
+  go-pre-commit migrate-config --from .github/env/90-project.env --force`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			migrateConfig := MigrateConfig{}
+			var err error
+
+			if migrateConfig.From, err = cmd.Flags().GetString("from"); err != nil {
+				return err
+			}
+			if migrateConfig.Output, err = cmd.Flags().GetString("output"); err != nil {
+				return err
+			}
+			if migrateConfig.Force, err = cmd.Flags().GetBool("force"); err != nil {
+				return err
+			}
+
+			return cb.runMigrateConfig(migrateConfig)
+		},
+	}
+
+	cmd.Flags().String("from", defaultMigrateSource, "Env file to read")
+	cmd.Flags().StringP("output", "o", config.DefaultConfigFile, "YAML file to write")
+	cmd.Flags().Bool("force", false, "Overwrite the output file if it exists")
+
+	return cmd
+}
+
+func (cb *CommandBuilder) runMigrateConfig(migrateConfig MigrateConfig) error {
+	baseDir, err := git.FindRepositoryRoot()
+	if err != nil {
+		// Outside a repository the paths are taken as given
+		baseDir = ""
+	}
+	from := resolveFromDir(baseDir, migrateConfig.From)
+	output := resolveFromDir(baseDir, migrateConfig.Output)
+
+	if !migrateConfig.Force {
+		if _, statErr := os.Stat(output); statErr == nil {
+			printError("%s already exists", output)
+			return fmt.Errorf("%w: %s", ErrConfigFileExists, output)
+		}
+	}
+
+	vars, err := envfile.Read(from)
+	if err != nil {
+		printError("Failed to read %s: %v", from, err)
+		return err
+	}
+
+	migration := config.MigrateEnv(vars)
+	data, err := migration.YAML()
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(output, data, 0o644); err != nil { //nolint:gosec // config file is meant to be committed and readable
+		printError("Failed to write %s: %v", output, err)
+		return fmt.Errorf("failed to write %s: %w", output, err)
+	}
+
+	if cb.app.config.Verbose {
+		for _, key := range migration.Migrated {
+			printInfo("%s -> %s", key.Env, key.Path)
+		}
+	}
+	for _, key := range migration.Unmapped {
+		printWarning("Not migrated: %s (%s)", key.Env, key.Reason)
+	}
+	printSuccess("Migrated %d key(s) from %s to %s", len(migration.Migrated), from, output)
+
+	return nil
+}
+
+// resolveFromDir joins a relative path to dir, leaving absolute paths and an
+// empty dir alone
+func resolveFromDir(dir, path string) string {
+	if dir == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+const sampleSharedEnv = `# Shared configuration
+ENABLE_GO_PRE_COMMIT=true
+GO_PRE_COMMIT_ENABLE_LINT=false
+GO_PRE_COMMIT_LINT_TIMEOUT=300
+GO_PRE_COMMIT_EXCLUDE_PATTERNS="vendor/,testdata/"
+GO_PRE_COMMIT_LOG_LEVEL=debug
+`
+
+func TestMigrateConfigCmd_CommandStructure(t *testing.T) {
+	cmd := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date")).BuildMigrateConfigCmd()
+
+	assert.Equal(t, "migrate-config", cmd.Name())
+	assert.Equal(t, defaultMigrateSource, cmd.Flags().Lookup("from").DefValue)
+	assert.Equal(t, config.DefaultConfigFile, cmd.Flags().Lookup("output").DefValue)
+	assert.NotNil(t, cmd.Flags().Lookup("force"))
+}
+
+func TestRunMigrateConfig(t *testing.T) {
+	dir := setupFixRepo(t)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".github", ".env.shared"), []byte(sampleSharedEnv), 0o600))
+
+	builder := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date"))
+	migrateConfig := MigrateConfig{From: defaultMigrateSource, Output: config.DefaultConfigFile}
+	require.NoError(t, builder.runMigrateConfig(migrateConfig))
+
+	data, err := os.ReadFile(filepath.Join(dir, config.DefaultConfigFile)) //nolint:gosec // test path
+	require.NoError(t, err)
+
+	var fileConfig config.FileConfig
+	require.NoError(t, yaml.Unmarshal(data, &fileConfig))
+	require.NotNil(t, fileConfig.Enabled)
+	assert.True(t, *fileConfig.Enabled)
+	require.Contains(t, fileConfig.Checks.Builtin, "lint")
+	assert.False(t, *fileConfig.Checks.Builtin["lint"].Enabled)
+	assert.Equal(t, 300, *fileConfig.Checks.Builtin["lint"].Timeout)
+	assert.Equal(t, []string{"vendor/", "testdata/"}, fileConfig.ExcludePatterns)
+
+	// An existing file is only replaced with --force
+	err = builder.runMigrateConfig(migrateConfig)
+	require.ErrorIs(t, err, ErrConfigFileExists)
+
+	migrateConfig.Force = true
+	require.NoError(t, builder.runMigrateConfig(migrateConfig))
+}
+
+func TestRunMigrateConfig_MissingSource(t *testing.T) {
+	setupFixRepo(t)
+
+	builder := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date"))
+	err := builder.runMigrateConfig(MigrateConfig{From: "missing.env", Output: config.DefaultConfigFile})
+	require.Error(t, err)
+	assert.NoFileExists(t, config.DefaultConfigFile)
+}
+
+func TestResolveFromDir(t *testing.T) {
+	assert.Equal(t, filepath.Join("root", "a.yml"), resolveFromDir("root", "a.yml"))
+	assert.Equal(t, "a.yml", resolveFromDir("", "a.yml"))
+	assert.Equal(t, "/abs/a.yml", resolveFromDir("root", "/abs/a.yml"))
+}
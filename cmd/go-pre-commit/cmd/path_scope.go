@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+// ErrPathOutsideRepo is returned when a --path value is not inside the repository
+var ErrPathOutsideRepo = errors.New("path is outside the repository")
+
+// ErrExcludeDirRoot is returned when --exclude-dir names the repository root
+var ErrExcludeDirRoot = errors.New("cannot exclude the repository root")
+
+// normalizeScopePaths converts --path values into slash-separated paths
+// relative to the repository root. Relative values are resolved against the
+// working directory, as git pathspecs are, so "--path ." scopes a run to the
+// current directory.
+func normalizeScopePaths(paths []string, repoRoot, workDir string) ([]string, error) {
+	normalized := make([]string, 0, len(paths))
+	for _, path := range paths {
+		abs := path
+		if !filepath.IsAbs(abs) {
+			abs = filepath.Join(workDir, abs)
+		}
+		rel, err := filepath.Rel(repoRoot, filepath.Clean(abs))
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return nil, fmt.Errorf("%w: %s", ErrPathOutsideRepo, path)
+		}
+		normalized = append(normalized, filepath.ToSlash(rel))
+	}
+	return normalized, nil
+}
+
+// filterFilesByPaths keeps the repository-relative files that are under one of
+// the given repository-relative path prefixes. A prefix matches the file
+// itself or anything below it as a directory, never a sibling that merely
+// shares the leading characters.
+func filterFilesByPaths(files, paths []string) []string {
+	var filtered []string
+	for _, file := range files {
+		slashed := filepath.ToSlash(filepath.Clean(file))
+		for _, path := range paths {
+			if path == "." || slashed == path || strings.HasPrefix(slashed, path+"/") {
+				filtered = append(filtered, file)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// scopeFilesToPaths restricts files to the --path prefixes, resolved from the
+// current working directory. No paths leaves files unchanged.
+func scopeFilesToPaths(files, paths []string, repoRoot string) ([]string, error) {
+	if len(paths) == 0 {
+		return files, nil
+	}
+	normalized, err := resolveScopePaths(paths, repoRoot)
+	if err != nil {
+		return nil, err
+	}
+	return filterFilesByPaths(files, normalized), nil
+}
+
+// addExcludeDirs adds the --exclude-dir values, resolved from the current
+// working directory like --path, to the directories every check skips
+func addExcludeDirs(cfg *config.Config, dirs []string, repoRoot string) error {
+	if len(dirs) == 0 {
+		return nil
+	}
+	normalized, err := resolveScopePaths(dirs, repoRoot)
+	if err != nil {
+		return err
+	}
+	for i, dir := range normalized {
+		if dir == "." {
+			return fmt.Errorf("%w: %s", ErrExcludeDirRoot, dirs[i])
+		}
+	}
+	cfg.Git.ExcludeDirs = append(cfg.Git.ExcludeDirs, normalized...)
+	return nil
+}
+
+// resolveScopePaths normalizes paths against the current working directory
+// and repoRoot, see normalizeScopePaths
+func resolveScopePaths(paths []string, repoRoot string) ([]string, error) {
+	workDir, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get working directory: %w", err)
+	}
+	// Compare like with like when the temp or home directory is a symlink
+	if resolved, resolveErr := filepath.EvalSymlinks(workDir); resolveErr == nil {
+		workDir = resolved
+	}
+	if resolved, resolveErr := filepath.EvalSymlinks(repoRoot); resolveErr == nil {
+		repoRoot = resolved
+	}
+	return normalizeScopePaths(paths, repoRoot, workDir)
+}
@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+func TestNormalizeScopePaths(t *testing.T) {
+	repoRoot := filepath.Join(string(filepath.Separator), "repo")
+
+	tests := []struct {
+		name    string
+		paths   []string
+		workDir string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:    "relative to the repository root",
+			paths:   []string{"services/api", "libs/shared/"},
+			workDir: repoRoot,
+			want:    []string{"services/api", "libs/shared"},
+		},
+		{
+			name:    "relative to a subdirectory",
+			paths:   []string{"api", ".", "../libs"},
+			workDir: filepath.Join(repoRoot, "services"),
+			want:    []string{"services/api", "services", "libs"},
+		},
+		{
+			name:    "absolute path",
+			paths:   []string{filepath.Join(repoRoot, "services", "api")},
+			workDir: repoRoot,
+			want:    []string{"services/api"},
+		},
+		{
+			name:    "repository root",
+			paths:   []string{"./"},
+			workDir: repoRoot,
+			want:    []string{"."},
+		},
+		{
+			name:    "outside the repository",
+			paths:   []string{"../other"},
+			workDir: repoRoot,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeScopePaths(tt.paths, repoRoot, tt.workDir)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrPathOutsideRepo)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFilterFilesByPaths(t *testing.T) {
+	files := []string{
+		"go.mod",
+		"services/api/main.go",
+		"services/api/handlers/user.go",
+		"services/api-gateway/main.go",
+		"services/worker/main.go",
+		"libs/shared/util.go",
+		"docs/README.md",
+	}
+
+	tests := []struct {
+		name  string
+		paths []string
+		want  []string
+	}{
+		{
+			name:  "single directory excludes prefix siblings",
+			paths: []string{"services/api"},
+			want:  []string{"services/api/main.go", "services/api/handlers/user.go"},
+		},
+		{
+			name:  "multiple directories",
+			paths: []string{"services/worker", "libs/shared"},
+			want:  []string{"services/worker/main.go", "libs/shared/util.go"},
+		},
+		{
+			name:  "single file",
+			paths: []string{"go.mod"},
+			want:  []string{"go.mod"},
+		},
+		{
+			name:  "repository root keeps everything",
+			paths: []string{"."},
+			want:  files,
+		},
+		{
+			name:  "no matches",
+			paths: []string{"tools"},
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, filterFilesByPaths(files, tt.paths))
+		})
+	}
+}
+
+func TestScopeFilesToPaths(t *testing.T) {
+	repoRoot := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(repoRoot, "services", "api"), 0o750))
+	t.Chdir(filepath.Join(repoRoot, "services"))
+
+	files := []string{"services/api/main.go", "services/worker/main.go", "libs/util.go"}
+
+	scoped, err := scopeFilesToPaths(files, nil, repoRoot)
+	require.NoError(t, err)
+	assert.Equal(t, files, scoped, "no --path leaves the file set unchanged")
+
+	scoped, err = scopeFilesToPaths(files, []string{"api", "../libs"}, repoRoot)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"services/api/main.go", "libs/util.go"}, scoped)
+
+	_, err = scopeFilesToPaths(files, []string{"../../elsewhere"}, repoRoot)
+	require.ErrorIs(t, err, ErrPathOutsideRepo)
+}
+
+func TestAddExcludeDirs(t *testing.T) {
+	repoRoot := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(repoRoot, "services", "api"), 0o750))
+	t.Chdir(filepath.Join(repoRoot, "services"))
+
+	cfg := &config.Config{}
+	cfg.Git.ExcludeDirs = []string{"third_party"}
+
+	require.NoError(t, addExcludeDirs(cfg, nil, repoRoot))
+	assert.Equal(t, []string{"third_party"}, cfg.Git.ExcludeDirs)
+
+	require.NoError(t, addExcludeDirs(cfg, []string{"api", "../web/dist/"}, repoRoot))
+	assert.Equal(t, []string{"third_party", "services/api", "web/dist"}, cfg.Git.ExcludeDirs,
+		"--exclude-dir adds to the configured directories, resolved from the working directory")
+
+	require.ErrorIs(t, addExcludeDirs(cfg, []string{".."}, repoRoot), ErrExcludeDirRoot)
+	require.ErrorIs(t, addExcludeDirs(cfg, []string{"../../elsewhere"}, repoRoot), ErrPathOutsideRepo)
+}
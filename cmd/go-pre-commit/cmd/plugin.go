@@ -69,7 +69,8 @@ func (cb *CommandBuilder) buildPluginListCmd() *cobra.Command {
   # List plugins with verbose output
   go-pre-commit plugin list -v`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			verbose, _ := cmd.Flags().GetBool("verbose")
+			verbosity, _ := cmd.Flags().GetCount("verbose")
+			verbose := verbosity > 0
 
 			// Load configuration
 			cfg, err := config.Load()
@@ -112,7 +112,7 @@ func runPluginSubcmd(t *testing.T, name string, flags map[string]string, args ..
 	require.NotNil(t, sub)
 
 	if sub.Flags().Lookup("verbose") == nil {
-		sub.Flags().Bool("verbose", false, "")
+		sub.Flags().Count("verbose", "")
 	}
 	if sub.Flags().Lookup("force") == nil {
 		sub.Flags().Bool("force", false, "")
@@ -190,7 +190,7 @@ func TestPluginListCmd_RunE(t *testing.T) {
 		dir := setupPluginTestEnv(t)
 		createPluginManifestDir(t, dir, "alpha")
 
-		out, err := runPluginSubcmd(t, "list", map[string]string{"verbose": "true"})
+		out, err := runPluginSubcmd(t, "list", map[string]string{"verbose": "1"})
 		require.NoError(t, err)
 		assert.Contains(t, out, "Plugin: alpha")
 		assert.Contains(t, out, "Version: 1.2.3")
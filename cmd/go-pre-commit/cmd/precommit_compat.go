@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+)
+
+// ErrPreCommitCompatFormat is returned when --pre-commit-compat is combined
+// with a machine-readable output format
+var ErrPreCommitCompatFormat = errors.New("--pre-commit-compat cannot be combined with --format tap")
+
+// applyPreCommitCompat adapts a run to the pre-commit framework, which runs
+// the hook from the repository root with the staged file names as arguments
+// and shows its output verbatim on failure. The positional arguments become
+// the files to check (select checks with --only), no prompts are shown,
+// and only failures are reported.
+func applyPreCommitCompat(runConfig *RunConfig, args []string) error {
+	if runConfig.Format == outputFormatTAP {
+		return ErrPreCommitCompatFormat
+	}
+	runConfig.Files = append(runConfig.Files, args...)
+	runConfig.Quiet = true
+	runConfig.ShowProgress = false
+	runConfig.Interactive = false
+	return nil
+}
+
+// disableAutoStage keeps fixes in the working tree. The framework detects
+// modified files by diffing the working tree, so staging them would hide the
+// fixes from it and from the user.
+func disableAutoStage(cfg *config.Config) {
+	cfg.CheckBehaviors.FumptAutoStage = false
+	cfg.CheckBehaviors.WhitespaceAutoStage = false
+	cfg.CheckBehaviors.EOFAutoStage = false
+}
+
+// writeFixedFiles lists the files that checks fixed in place, one
+// "Fixing <file>" line each, as the pre-commit-hooks fixers do
+func writeFixedFiles(w io.Writer, results *runner.Results) error {
+	var fixed []string
+	for _, result := range results.CheckResults {
+		if result.Fixed {
+			fixed = append(fixed, result.Files...)
+		}
+	}
+	slices.Sort(fixed)
+	for _, file := range slices.Compact(fixed) {
+		if _, err := fmt.Fprintf(w, "Fixing %s\n", file); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// preCommitCompatError returns the error for a failed run in compat mode.
+// The framework treats any non-zero exit as a failure, so fixes and real
+// failures both exit 1 regardless of the configured exit codes.
+func preCommitCompatError(results *runner.Results) error {
+	return &ExitError{
+		Code: defaultExitCodeFailure,
+		Err:  fmt.Errorf("%w: %d", prerrors.ErrChecksFailed, results.BlockingFailures()),
+	}
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+)
+
+func TestApplyPreCommitCompat(t *testing.T) {
+	runConfig := RunConfig{ShowProgress: true, Interactive: true, Files: []string{"a.go"}}
+	require.NoError(t, applyPreCommitCompat(&runConfig, []string{"b.go", "docs/c.md"}))
+	assert.Equal(t, []string{"a.go", "b.go", "docs/c.md"}, runConfig.Files, "arguments are file names")
+	assert.True(t, runConfig.Quiet)
+	assert.False(t, runConfig.ShowProgress)
+	assert.False(t, runConfig.Interactive)
+
+	require.ErrorIs(t, applyPreCommitCompat(&RunConfig{Format: outputFormatTAP}, nil), ErrPreCommitCompatFormat)
+}
+
+func TestDisableAutoStage(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.FumptAutoStage = true
+	cfg.CheckBehaviors.WhitespaceAutoStage = true
+	cfg.CheckBehaviors.EOFAutoStage = true
+
+	disableAutoStage(cfg)
+	assert.False(t, cfg.CheckBehaviors.FumptAutoStage)
+	assert.False(t, cfg.CheckBehaviors.WhitespaceAutoStage)
+	assert.False(t, cfg.CheckBehaviors.EOFAutoStage)
+}
+
+func TestWriteFixedFiles(t *testing.T) {
+	results := &runner.Results{CheckResults: []runner.CheckResult{
+		{Name: "whitespace", Fixed: true, Files: []string{"b.go", "a.md"}},
+		{Name: "eof", Fixed: true, Files: []string{"b.go"}},
+		{Name: "lint", Files: []string{"c.go"}},
+	}}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeFixedFiles(&buf, results))
+	assert.Equal(t, "Fixing a.md\nFixing b.go\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, writeFixedFiles(&buf, &runner.Results{}))
+	assert.Empty(t, buf.String())
+}
+
+func TestPreCommitCompatError(t *testing.T) {
+	results := &runner.Results{Failed: 1, Fixed: 1}
+	err := preCommitCompatError(results)
+	require.ErrorIs(t, err, prerrors.ErrChecksFailed)
+	assert.Equal(t, 1, ExitCode(err), "fixes exit 1, not the fixed exit code")
+}
@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+)
+
+// Profile kind constants
+const (
+	profileCPU = "cpu"
+	profileMem = "mem"
+)
+
+// ErrUnknownPprofKind is returned when --pprof names an unsupported profile kind
+var ErrUnknownPprofKind = errors.New("unknown pprof kind")
+
+// isValidPprofKind reports whether kind is a supported --pprof value.
+// An empty value disables profiling.
+func isValidPprofKind(kind string) bool {
+	switch kind {
+	case "", profileCPU, profileMem:
+		return true
+	default:
+		return false
+	}
+}
+
+// defaultProfileOut returns the output file used when --pprof-out is not set
+func defaultProfileOut(kind string) string {
+	return fmt.Sprintf("go-pre-commit.%s.pprof", kind)
+}
+
+// startProfile begins collecting a pprof profile of the given kind and
+// returns a function that finishes it and writes the data to path.
+// CPU profiles stream samples for the whole run; heap profiles are written
+// once, when the returned function is called.
+func startProfile(kind, path string) (func() error, error) {
+	if kind != profileCPU && kind != profileMem {
+		return nil, fmt.Errorf("%w: %q (expected %s or %s)", ErrUnknownPprofKind, kind, profileCPU, profileMem)
+	}
+	if path == "" {
+		path = defaultProfileOut(kind)
+	}
+
+	f, err := os.Create(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create profile file: %w", err)
+	}
+
+	if kind == profileCPU {
+		if err = pprof.StartCPUProfile(f); err != nil {
+			_ = f.Close()
+			return nil, fmt.Errorf("failed to start CPU profile: %w", err)
+		}
+		return func() error {
+			pprof.StopCPUProfile()
+			return f.Close()
+		}, nil
+	}
+
+	return func() error {
+		// Collect garbage first so the profile reflects live allocations
+		runtime.GC()
+		if writeErr := pprof.WriteHeapProfile(f); writeErr != nil {
+			_ = f.Close()
+			return fmt.Errorf("failed to write heap profile: %w", writeErr)
+		}
+		return f.Close()
+	}, nil
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// requirePprofFile asserts that path holds a gzip-compressed pprof profile
+func requirePprofFile(t *testing.T, path string) {
+	t.Helper()
+
+	data, err := os.ReadFile(path) //nolint:gosec // test path
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(data, []byte{0x1f, 0x8b}), "profile must start with the gzip magic header")
+
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	raw, err := io.ReadAll(zr)
+	require.NoError(t, err)
+	assert.NotEmpty(t, raw, "profile must contain protobuf data")
+}
+
+func TestStartProfile(t *testing.T) {
+	for _, kind := range []string{profileCPU, profileMem} {
+		t.Run(kind, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), kind+".pprof")
+
+			stop, err := startProfile(kind, path)
+			require.NoError(t, err)
+
+			// Do a little work so the profile has something to record
+			var sink []byte
+			for i := 0; i < 1000; i++ {
+				sink = append(sink, bytes.Repeat([]byte{byte(i)}, 64)...)
+			}
+			assert.NotEmpty(t, sink)
+
+			require.NoError(t, stop())
+			requirePprofFile(t, path)
+		})
+	}
+}
+
+func TestStartProfile_DefaultOut(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	stop, err := startProfile(profileMem, "")
+	require.NoError(t, err)
+	require.NoError(t, stop())
+	requirePprofFile(t, defaultProfileOut(profileMem))
+}
+
+func TestStartProfile_Errors(t *testing.T) {
+	_, err := startProfile("block", filepath.Join(t.TempDir(), "out.pprof"))
+	require.ErrorIs(t, err, ErrUnknownPprofKind)
+
+	_, err = startProfile(profileCPU, filepath.Join(t.TempDir(), "missing", "out.pprof"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to create profile file")
+}
+
+func TestRunCmd_UnknownPprofKind(t *testing.T) {
+	app := NewCLIApp("test", "test-commit", "test-date")
+	builder := NewCommandBuilder(app)
+
+	err := builder.runChecksWithConfig(RunConfig{Pprof: "trace"}, nil, nil)
+	require.ErrorIs(t, err, ErrUnknownPprofKind)
+}
+
+func TestRunCmd_ProfileWrittenWhenRunFails(t *testing.T) {
+	// No configuration is available, so the run fails after profiling starts
+	dir := t.TempDir()
+	t.Chdir(dir)
+	out := filepath.Join(dir, "run.pprof")
+
+	app := NewCLIApp("test", "test-commit", "test-date")
+	builder := NewCommandBuilder(app)
+
+	err := builder.runChecksWithConfig(RunConfig{Pprof: profileCPU, PprofOut: out}, nil, nil)
+	require.Error(t, err)
+	requirePprofFile(t, out)
+}
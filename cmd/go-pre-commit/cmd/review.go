@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"context"
+	"os"
+
+	"github.com/mrz1836/go-pre-commit/internal/review"
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+)
+
+// postReview posts the run's diagnostics as inline comments on the pull
+// request named by the environment and returns how many were posted. It is
+// best effort: the caller reports the error but never lets it change the
+// run's outcome.
+func (cb *CommandBuilder) postReview(ctx context.Context, results *runner.Results) (int, error) {
+	target, err := review.TargetFromEnv(os.Getenv)
+	if err != nil {
+		return 0, err
+	}
+	return review.Post(ctx, nil, target, review.Diagnostics(results), cb.app.version)
+}
@@ -1,18 +1,71 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"strconv"
+	"syscall"
 	"time"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	"github.com/mrz1836/go-pre-commit/internal/output"
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
 	"github.com/mrz1836/go-pre-commit/internal/update"
 	"github.com/mrz1836/go-pre-commit/internal/version"
 )
 
+// ErrInterrupted is returned when the command was stopped by SIGINT or SIGTERM
+var ErrInterrupted = errors.New("interrupted")
+
+// ErrNoFilesToCheck is returned for a run with nothing to check when
+// GO_PRE_COMMIT_EXIT_CODE_NO_FILES asks for a distinct exit code
+var ErrNoFilesToCheck = errors.New("no files to check")
+
+// ErrInvalidVerbosity is returned for a --verbose value that is neither a
+// boolean nor a level
+var ErrInvalidVerbosity = errors.New("verbose must be true, false, or a level")
+
+// verbosityFlag is the --verbose level. Each -v adds one, as a count flag
+// does, while --verbose=true and --verbose=false keep working as they did
+// when the flag was a boolean.
+type verbosityFlag int
+
+func (v *verbosityFlag) String() string {
+	return strconv.Itoa(int(*v))
+}
+
+// Set adds one for a bare -v or true, clears the level for false, and takes
+// any other number as the level itself
+func (v *verbosityFlag) Set(value string) error {
+	switch value {
+	case "+1", "true":
+		*v++
+		return nil
+	case "false":
+		*v = 0
+		return nil
+	}
+	level, err := strconv.Atoi(value)
+	if err != nil || level < 0 {
+		return fmt.Errorf("%w: %q", ErrInvalidVerbosity, value)
+	}
+	*v = verbosityFlag(level)
+	return nil
+}
+
+// Type is "count" so the level is read back with GetCount
+func (v *verbosityFlag) Type() string {
+	return "count"
+}
+
 // CLIApp holds the application state and configuration
 type CLIApp struct {
 	version    string
@@ -20,13 +73,21 @@ type CLIApp struct {
 	buildDate  string
 	config     *AppConfig
 	updateChan <-chan *update.CheckResult
+
+	// finalResults holds the results of a run with --emit-final-json until
+	// WriteFinalSummary writes them
+	finalResults *runner.Results
 }
 
 // AppConfig holds global application configuration
 type AppConfig struct {
-	Verbose   bool
-	NoColor   bool
-	ColorMode string // "auto", "always", "never"
+	Verbose    bool
+	Verbosity  int // 1-3 for -v, -vv, -vvv; run output detail grows with each level
+	NoColor    bool
+	ColorMode  string // "auto", "always", "never"
+	OutputDest string // "stdout", "stderr", "file:<path>", "syslog"
+	RepoRoot   string // overrides git-based repository root detection
+	Env        string // environment whose config overlay is merged over the config file
 }
 
 // NewCLIApp creates a new CLI application instance
@@ -56,6 +117,16 @@ type CommandBuilder struct {
 	// installRelease performs the actual binary install (go install). It defaults
 	// to defaultGoInstall and is overridable in tests to avoid network/exec.
 	installRelease releaseInstaller
+
+	// downloadBinary installs a release binary over the running one for
+	// self-update. It defaults to defaultBinaryDownload and is overridable in
+	// tests to avoid network access and replacing real binaries.
+	downloadBinary binaryDownloader
+
+	// newWatcher creates the file watcher used by the watch command. It
+	// defaults to newFSNotifyWatcher and is overridable in tests to feed
+	// synthetic file events.
+	newWatcher watcherFactory
 }
 
 // NewCommandBuilder creates a new command builder
@@ -64,6 +135,8 @@ func NewCommandBuilder(app *CLIApp) *CommandBuilder {
 		app:            app,
 		fetchRelease:   version.GetLatestReleaseWithVersion,
 		installRelease: defaultGoInstall,
+		downloadBinary: defaultBinaryDownload,
+		newWatcher:     newFSNotifyWatcher,
 	}
 }
 
@@ -86,9 +159,23 @@ Key features:
 		SilenceErrors: true,
 		PersistentPreRun: func(cmd *cobra.Command, _ []string) {
 			// Get flags and set in app config
-			cb.app.config.Verbose, _ = cmd.Flags().GetBool("verbose")
+			verbosity, _ := cmd.Flags().GetCount("verbose")
+			cb.app.config.Verbosity = min(verbosity, int(output.VerbosityCommands))
+			cb.app.config.Verbose = verbosity > 0
 			cb.app.config.NoColor, _ = cmd.Flags().GetBool("no-color")
 			cb.app.config.ColorMode, _ = cmd.Flags().GetString("color")
+			cb.app.config.OutputDest, _ = cmd.Flags().GetString("output-dest")
+			cb.app.config.RepoRoot, _ = cmd.Flags().GetString("repo-root")
+			if cb.app.config.RepoRoot != "" {
+				// The environment carries the override to config discovery and
+				// every check; it is validated where the root is resolved
+				_ = os.Setenv(shared.RepoRootEnv, cb.app.config.RepoRoot)
+			}
+			cb.app.config.Env, _ = cmd.Flags().GetString("env")
+			if cb.app.config.Env != "" {
+				// Config loading reads the environment name from the environment
+				_ = os.Setenv(config.OverlayEnv, cb.app.config.Env)
+			}
 			cb.initConfig()
 		},
 	}
@@ -99,9 +186,14 @@ Key features:
 `)
 
 	// Add persistent flags
-	cmd.PersistentFlags().Bool("verbose", false, "Enable verbose output")
+	verbosity := verbosityFlag(0)
+	verboseFlag := cmd.PersistentFlags().VarPF(&verbosity, "verbose", "v", "Increase output detail: -v check summaries, -vv file lists, -vvv tool commands and output")
+	verboseFlag.NoOptDefVal = "+1"
 	cmd.PersistentFlags().Bool("no-color", false, "Disable colored output (same as --color=never)")
 	cmd.PersistentFlags().String("color", colorModeAuto, "Control color output: auto, always, never")
+	cmd.PersistentFlags().String("output-dest", output.SinkStdout, "Where to write output: stdout, stderr, file:<path>, syslog")
+	cmd.PersistentFlags().String("repo-root", "", "Use this directory as the repository root instead of asking git (same as GO_PRE_COMMIT_REPO_ROOT)")
+	cmd.PersistentFlags().String("env", "", "Merge the config overlay .go-pre-commit.<env>.yml over the config file (same as GO_PRE_COMMIT_ENV)")
 
 	// Add PersistentPostRunE to check for updates after command execution
 	// This runs after ALL subcommands complete, which is the desired behavior
@@ -144,12 +236,34 @@ func (cb *CommandBuilder) Execute() error {
 	// Add subcommands
 	rootCmd.AddCommand(cb.BuildInstallCmd())
 	rootCmd.AddCommand(cb.BuildRunCmd())
+	rootCmd.AddCommand(cb.BuildFixCmd())
 	rootCmd.AddCommand(cb.BuildUninstallCmd())
 	rootCmd.AddCommand(cb.BuildStatusCmd())
 	rootCmd.AddCommand(cb.BuildUpgradeCmd())
+	rootCmd.AddCommand(cb.BuildSelfUpdateCmd())
 	rootCmd.AddCommand(cb.BuildPluginCmd())
+	rootCmd.AddCommand(cb.BuildMigrateConfigCmd())
+	rootCmd.AddCommand(cb.BuildConfigCmd())
+	rootCmd.AddCommand(cb.BuildWatchCmd())
+	rootCmd.AddCommand(cb.BuildStatsCmd())
+
+	// Cancel the command context on Ctrl-C or SIGTERM so running checks abort
+	// and clean up instead of being killed mid-write
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	return interruptedError(ctx, rootCmd.ExecuteContext(ctx))
+}
 
-	return rootCmd.Execute()
+// interruptedError marks err as ErrInterrupted when ctx was canceled by a signal
+func interruptedError(ctx context.Context, err error) error {
+	if ctx.Err() == nil || errors.Is(err, ErrInterrupted) {
+		return err
+	}
+	if err == nil {
+		return ErrInterrupted
+	}
+	return fmt.Errorf("%w: %w", ErrInterrupted, err)
 }
 
 // Execute runs the default CLI application (legacy compatibility function)
@@ -2,14 +2,21 @@ package cmd
 
 import (
 	"bytes"
+	"context"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/fatih/color"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
 )
 
 func TestNewCLIApp(t *testing.T) {
@@ -86,8 +93,8 @@ func TestBuildRootCmdProperties(t *testing.T) {
 	// Test persistent flags
 	verboseFlag := cmd.PersistentFlags().Lookup("verbose")
 	require.NotNil(t, verboseFlag)
-	assert.Empty(t, verboseFlag.Shorthand) // No shorthand for verbose anymore
-	assert.Equal(t, "false", verboseFlag.DefValue)
+	assert.Equal(t, "v", verboseFlag.Shorthand)
+	assert.Equal(t, "0", verboseFlag.DefValue)
 
 	noColorFlag := cmd.PersistentFlags().Lookup("no-color")
 	require.NotNil(t, noColorFlag)
@@ -100,11 +107,30 @@ func TestBuildRootCmdProperties(t *testing.T) {
 	assert.Contains(t, colorFlag.Usage, "Control color output")
 }
 
+func TestVerbosityFlag_Set(t *testing.T) {
+	var level verbosityFlag
+	for _, value := range []string{"+1", "true", "+1"} {
+		require.NoError(t, level.Set(value))
+	}
+	assert.Equal(t, "3", level.String())
+
+	require.NoError(t, level.Set("false"))
+	assert.Equal(t, "0", level.String())
+
+	require.NoError(t, level.Set("2"))
+	assert.Equal(t, "2", level.String())
+
+	for _, value := range []string{"loud", "-1"} {
+		require.ErrorIs(t, level.Set(value), ErrInvalidVerbosity, value)
+	}
+}
+
 func TestBuildRootCmdPersistentPreRun(t *testing.T) {
 	tests := []struct {
 		name              string
 		args              []string
 		expectedVerbose   bool
+		expectedVerbosity int
 		expectedNoColor   bool
 		expectedColorMode string
 	}{
@@ -119,9 +145,44 @@ func TestBuildRootCmdPersistentPreRun(t *testing.T) {
 			name:              "verbose flag",
 			args:              []string{"--verbose"},
 			expectedVerbose:   true,
+			expectedVerbosity: 1,
 			expectedNoColor:   false,
 			expectedColorMode: colorModeAuto,
 		},
+		{
+			name:              "-vv flag",
+			args:              []string{"-vv"},
+			expectedVerbose:   true,
+			expectedVerbosity: 2,
+			expectedColorMode: colorModeAuto,
+		},
+		{
+			name:              "-vvvv is capped at -vvv",
+			args:              []string{"-vvvv"},
+			expectedVerbose:   true,
+			expectedVerbosity: 3,
+			expectedColorMode: colorModeAuto,
+		},
+		{
+			name:              "verbose=true counts as -v",
+			args:              []string{"--verbose=true"},
+			expectedVerbose:   true,
+			expectedVerbosity: 1,
+			expectedColorMode: colorModeAuto,
+		},
+		{
+			name:              "verbose=false turns verbose output off",
+			args:              []string{"-vv", "--verbose=false"},
+			expectedVerbose:   false,
+			expectedColorMode: colorModeAuto,
+		},
+		{
+			name:              "verbose level",
+			args:              []string{"--verbose=2"},
+			expectedVerbose:   true,
+			expectedVerbosity: 2,
+			expectedColorMode: colorModeAuto,
+		},
 		{
 			name:              "no-color flag",
 			args:              []string{"--no-color"},
@@ -133,6 +194,7 @@ func TestBuildRootCmdPersistentPreRun(t *testing.T) {
 			name:              "both flags",
 			args:              []string{"--verbose", "--no-color"},
 			expectedVerbose:   true,
+			expectedVerbosity: 1,
 			expectedNoColor:   true,
 			expectedColorMode: colorModeAuto,
 		},
@@ -186,6 +248,7 @@ func TestBuildRootCmdPersistentPreRun(t *testing.T) {
 
 			// Check that flags were properly set in app config
 			assert.Equal(t, tt.expectedVerbose, app.config.Verbose)
+			assert.Equal(t, tt.expectedVerbosity, app.config.Verbosity)
 			assert.Equal(t, tt.expectedNoColor, app.config.NoColor)
 			assert.Equal(t, tt.expectedColorMode, app.config.ColorMode)
 		})
@@ -708,6 +771,7 @@ func TestCommandBuilderIntegration(t *testing.T) {
 	// Add all subcommands (simulating Execute())
 	rootCmd.AddCommand(builder.BuildInstallCmd())
 	rootCmd.AddCommand(builder.BuildRunCmd())
+	rootCmd.AddCommand(builder.BuildFixCmd())
 	rootCmd.AddCommand(builder.BuildUninstallCmd())
 	rootCmd.AddCommand(builder.BuildStatusCmd())
 
@@ -718,7 +782,7 @@ func TestCommandBuilderIntegration(t *testing.T) {
 		cmdNames[cmd.Name()] = true
 	}
 
-	expectedCommands := []string{"install", "run", "uninstall", "status"}
+	expectedCommands := []string{"install", "run", "fix", "uninstall", "status"}
 	for _, expectedCmd := range expectedCommands {
 		assert.True(t, cmdNames[expectedCmd], "Command %s should be present", expectedCmd)
 	}
@@ -786,3 +850,60 @@ func TestEdgeCasesAndErrorScenarios(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+// errTestInterruptCause stands in for a command failure during an interrupt
+var errTestInterruptCause = errors.New("command failed")
+
+func TestInterruptedError(t *testing.T) {
+	active := context.Background()
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.NoError(t, interruptedError(active, nil))
+	require.ErrorIs(t, interruptedError(active, errTestInterruptCause), errTestInterruptCause)
+	assert.NotErrorIs(t, interruptedError(active, errTestInterruptCause), ErrInterrupted)
+
+	require.ErrorIs(t, interruptedError(canceled, nil), ErrInterrupted)
+
+	err := interruptedError(canceled, errTestInterruptCause)
+	require.ErrorIs(t, err, ErrInterrupted)
+	require.ErrorIs(t, err, errTestInterruptCause)
+
+	already := fmt.Errorf("%w: stopped", ErrInterrupted)
+	assert.Same(t, already, interruptedError(canceled, already))
+}
+
+func TestCommandContext(t *testing.T) {
+	assert.NotNil(t, commandContext(nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cmd := &cobra.Command{}
+	cmd.SetContext(ctx)
+	assert.Equal(t, ctx, commandContext(cmd))
+}
+
+func TestBuildRootCmdRepoRootFlag(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(shared.RepoRootEnv, "") // restored after the flag sets it
+
+	app := NewCLIApp("test", "test-commit", "test-date")
+	cmd := NewCommandBuilder(app).BuildRootCmd()
+	require.NoError(t, cmd.ParseFlags([]string{"--repo-root", dir}))
+	cmd.PersistentPreRun(cmd, []string{})
+
+	assert.Equal(t, dir, app.config.RepoRoot)
+	assert.Equal(t, dir, os.Getenv(shared.RepoRootEnv))
+}
+
+func TestBuildRootCmdEnvFlag(t *testing.T) {
+	t.Setenv(config.OverlayEnv, "") // restored after the flag sets it
+
+	app := NewCLIApp("test", "test-commit", "test-date")
+	cmd := NewCommandBuilder(app).BuildRootCmd()
+	require.NoError(t, cmd.ParseFlags([]string{"--env", "ci"}))
+	cmd.PersistentPreRun(cmd, []string{})
+
+	assert.Equal(t, "ci", app.config.Env)
+	assert.Equal(t, "ci", os.Getenv(config.OverlayEnv))
+}
@@ -2,33 +2,85 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"maps"
+	"os"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/mrz1836/go-pre-commit/internal/checks/gotools"
 	"github.com/mrz1836/go-pre-commit/internal/config"
-	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
 	"github.com/mrz1836/go-pre-commit/internal/git"
 	"github.com/mrz1836/go-pre-commit/internal/output"
 	"github.com/mrz1836/go-pre-commit/internal/runner"
+	"github.com/mrz1836/go-pre-commit/internal/webhook"
 )
 
+// ErrUnknownOutputFormat is returned when --format names an unsupported format
+var ErrUnknownOutputFormat = errors.New("unknown output format")
+
+// ErrInvalidSince is returned when --since is not a positive duration
+var ErrInvalidSince = errors.New("--since must be a positive duration")
+
+// ErrInvalidMaxFailures is returned when --max-failures is negative
+var ErrInvalidMaxFailures = errors.New("--max-failures must be 0 (unlimited) or greater")
+
+// ErrUnknownProfile is returned when --profile names a check profile that is not configured
+var ErrUnknownProfile = errors.New("unknown check profile")
+
+// ErrUnknownReportPolicy is returned when --report-on is not always or failure
+var ErrUnknownReportPolicy = errors.New("unknown report policy")
+
+// ErrConflictingScope is returned when the file scope flags contradict each other
+var ErrConflictingScope = errors.New("conflicting file scope flags")
+
 // RunConfig holds configuration for the run command
 type RunConfig struct {
 	AllFiles            bool
 	Files               []string
+	FilesFrom           string // --files-from: file listing paths to check, one per line ("-" for stdin)
 	SkipChecks          []string
 	OnlyChecks          []string
+	OnlyFailed          bool
 	Parallel            int
 	FailFast            bool
+	MaxFailures         int
 	ShowVersion         bool
 	GracefulDegradation bool
 	ShowProgress        bool
+	ProgressRequested   bool // --progress was passed explicitly
 	Quiet               bool
 	DebugTimeout        bool
+	Format              string
+	Interactive         bool
+	ForceAllChecks      bool
+	Offline             bool
+	SkipMissingTools    bool
+	Since               time.Duration
+	Pprof               string
+	PprofOut            string
+	WriteBaseline       bool
+	DumpFileList        bool
+	PlanJSON            bool
+	Paths               []string
+	ExcludeDirs         []string // --exclude-dir, added to GO_PRE_COMMIT_EXCLUDE_DIRS
+	WebhookURL          string
+	UploadSARIF         bool // upload the findings as SARIF to GitHub code scanning after the run
+	Bootstrap           bool
+	Profile             string
+	Scope               string // config.ScopeChanged or config.ScopeAll from --[no-]changed-only; empty uses GO_PRE_COMMIT_DEFAULT_SCOPE
+	ReportOn            string // config.ReportOnAlways or config.ReportOnFailure from --report-on; empty uses GO_PRE_COMMIT_REPORT_ON
+	ExplainFailures     bool   // print the commands that fix each failed check; defaults on when stdout is a terminal
+	PreCommitCompat     bool   // run as a pre-commit framework hook: file-name arguments, plain output, exit 1 on fixes
+	EmitFinalJSON       bool   // write a one-line JSON summary of the run to stderr after all other output
+	TopOffenders        int    // report the N files with the most diagnostics without fixing anything; 0 runs normally
 }
 
 // BuildRunCmd creates the run command
@@ -41,13 +93,59 @@ func (cb *CommandBuilder) BuildRunCmd() *cobra.Command {
 By default, runs all enabled checks on files staged for commit.
 You can specify individual checks to run, or provide specific files to check.
 
+Which files are checked is decided in this order:
+  1. --files, --all-files, or --since
+  2. --changed-only (staged files) or --no-changed-only (all files)
+  3. GO_PRE_COMMIT_DEFAULT_SCOPE (changed or all; default changed)
+
 Available checks:
-  eof          - Ensure files end with newline
-  fumpt        - Format code with gofumpt
-  gitleaks     - Scan for secrets and credentials in code
-  lint         - Run golangci-lint
-  mod-tidy     - Ensure go.mod and go.sum are tidy
-  whitespace   - Fix trailing whitespace`,
+  build-artifacts - Block staged coverage profiles, CPU profiles, and test binaries
+  build-constraints - Flag //go:build constraints no build can satisfy
+  build-tags    - Require //go:build alongside legacy // +build lines
+  commit-size   - Warn when a commit stages more files than allowed
+  context-background - Flag context.Background and context.TODO outside main and tests
+  context-first - Require context.Context to be the first parameter
+  data-format   - Enforce JSON and YAML indentation and, optionally, sorted keys
+  doc-comments  - Require doc comments on exported symbols
+  embedded-blobs - Flag large base64 string literals that belong in //go:embed files
+  empty-commit  - Fail when fixes leave nothing staged to commit
+  eof           - Ensure files end with newline
+  error-compare - Flag == comparisons against sentinel errors
+  error-var-naming - Require package-level error variables to be named ErrXxx or errXxx
+  error-wrap    - Require %w when fmt.Errorf formats an error
+  env-access    - Flag os.Getenv calls outside the config packages
+  file-header-order - Order license, build constraints, and package doc in Go file headers
+  file-permissions - Block world-writable files and disallowed file modes
+  filename-case - Block paths that differ only in case from another tracked path
+  forbidden-imports - Block imports of packages on the deny list
+  fumpt         - Format code with gofumpt
+  func-length   - Flag Go functions over the line or statement limit
+  gitleaks      - Scan for secrets and credentials in code
+  global-vars   - Flag mutable package-level variables
+  go-generate   - Verify go:generate output is up to date
+  go-directive  - Require go directives the installed Go supports
+  go-indent     - Flag Go files indented with spaces
+  go-version    - Require the same go directive in every go.mod
+  gosec         - Scan Go code for security problems with gosec
+  hadolint      - Lint Dockerfiles with hadolint
+  internal-import - Block imports of another module's internal packages
+  large-diffs   - Warn when one staged file changes more lines than allowed
+  lint          - Run golangci-lint
+  major-version-conflict - Block packages importing two major versions of one module
+  mod-pair      - Require go.mod and go.sum changes to be staged together
+  mod-tidy      - Ensure go.mod and go.sum are tidy
+  module-path   - Require lowercase module paths under the configured prefix
+  os-junk       - Block staged .DS_Store, Thumbs.db, and desktop.ini files
+  receiver-names - Require short, consistent method receiver names
+  repo-files    - Require LICENSE, README, and other files at the repository root
+  shellcheck    - Lint shell scripts with shellcheck
+  struct-tags   - Flag malformed struct tags
+  stub-funcs    - Flag exported Go functions that are still stubs
+  test-package  - Require _test.go files to be in <pkg> or <pkg>_test
+  test-only-deps - Flag direct requirements only imported by tests
+  test-presence - Flag changed Go packages without test files
+  toolchain     - Enforce the go.mod toolchain directive policy
+  whitespace    - Fix trailing whitespace`,
 		Example: `  # Run all checks on staged files
   go-pre-commit run
 
@@ -60,11 +158,58 @@ Available checks:
   # Run checks on specific files
   go-pre-commit run --files main.go,utils.go
 
+  # Run checks on the files listed in a file, or on stdin with -
+  go-pre-commit run --files-from changed.txt
+  git diff --name-only main... | go-pre-commit run --files-from -
+
+  # Run checks on tracked files modified in the last 24 hours
+  go-pre-commit run --since 24h
+
   # Skip specific checks
   go-pre-commit run --skip lint,fumpt
 
   # Run only specific checks
-  go-pre-commit run --only whitespace,eof`,
+  go-pre-commit run --only whitespace,eof
+
+  # Confirm each whitespace/EOF fix before it is applied
+  go-pre-commit run --interactive
+
+  # Emit results in TAP format
+  go-pre-commit run --format tap
+
+  # Write a CPU profile of the run for "go tool pprof"
+  go-pre-commit run --all-files --pprof cpu --pprof-out cpu.pprof
+
+  # Grandfather existing lint issues so only new ones fail
+  go-pre-commit run --write-baseline
+
+  # Show which files each check would receive, and why others are excluded
+  go-pre-commit run --all-files --dump-filelist
+
+  # Only consider files under one or more directories (monorepos)
+  go-pre-commit run --path services/api --path libs/shared
+
+  # Report results to a dashboard (best effort; never changes the outcome)
+  go-pre-commit run --webhook-url https://dashboard.example.com/hooks/pre-commit
+
+  # List the 10 files with the most findings, without fixing anything
+  go-pre-commit run --all-files --top-offenders 10
+
+  # Upload findings to GitHub code scanning from a workflow (best effort)
+  go-pre-commit run --all-files --upload-sarif
+
+  # Re-run only the checks that failed last time
+  go-pre-commit run --only-failed
+
+  # Adopting the hooks: fix and stage every file without blocking the commit
+  go-pre-commit run --all-files --bootstrap
+
+  # Run as a hook of the pre-commit framework (it passes the file names)
+  go-pre-commit run --pre-commit-compat --only whitespace,eof
+
+  # Run the quick checks on commit and everything before a push
+  go-pre-commit run --profile fast
+  go-pre-commit run --profile full`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Get flags and create config
 			config := RunConfig{}
@@ -80,6 +225,11 @@ Available checks:
 				return err
 			}
 
+			config.FilesFrom, err = cmd.Flags().GetString("files-from")
+			if err != nil {
+				return err
+			}
+
 			config.SkipChecks, err = cmd.Flags().GetStringSlice("skip")
 			if err != nil {
 				return err
@@ -90,6 +240,11 @@ Available checks:
 				return err
 			}
 
+			config.OnlyFailed, err = cmd.Flags().GetBool("only-failed")
+			if err != nil {
+				return err
+			}
+
 			config.Parallel, err = cmd.Flags().GetInt("parallel")
 			if err != nil {
 				return err
@@ -100,6 +255,11 @@ Available checks:
 				return err
 			}
 
+			config.MaxFailures, err = cmd.Flags().GetInt("max-failures")
+			if err != nil {
+				return err
+			}
+
 			config.ShowVersion, err = cmd.Flags().GetBool("show-checks")
 			if err != nil {
 				return err
@@ -114,6 +274,7 @@ Available checks:
 			if err != nil {
 				return err
 			}
+			config.ProgressRequested = cmd.Flags().Changed("progress") && config.ShowProgress
 
 			config.Quiet, err = cmd.Flags().GetBool("quiet")
 			if err != nil {
@@ -125,6 +286,121 @@ Available checks:
 				return err
 			}
 
+			config.Format, err = cmd.Flags().GetString("format")
+			if err != nil {
+				return err
+			}
+
+			config.Interactive, err = cmd.Flags().GetBool("interactive")
+			if err != nil {
+				return err
+			}
+
+			config.ForceAllChecks, err = cmd.Flags().GetBool("force-all-checks")
+			if err != nil {
+				return err
+			}
+
+			config.Offline, err = cmd.Flags().GetBool("offline")
+			if err != nil {
+				return err
+			}
+
+			config.SkipMissingTools, err = cmd.Flags().GetBool("skip-missing-tools")
+			if err != nil {
+				return err
+			}
+
+			config.Since, err = cmd.Flags().GetDuration("since")
+			if err != nil {
+				return err
+			}
+
+			config.Pprof, err = cmd.Flags().GetString("pprof")
+			if err != nil {
+				return err
+			}
+
+			config.PprofOut, err = cmd.Flags().GetString("pprof-out")
+			if err != nil {
+				return err
+			}
+
+			config.WriteBaseline, err = cmd.Flags().GetBool("write-baseline")
+			if err != nil {
+				return err
+			}
+
+			config.DumpFileList, err = cmd.Flags().GetBool("dump-filelist")
+			if err != nil {
+				return err
+			}
+
+			config.PlanJSON, err = cmd.Flags().GetBool("plan-json")
+			if err != nil {
+				return err
+			}
+
+			config.Paths, err = cmd.Flags().GetStringSlice("path")
+			if err != nil {
+				return err
+			}
+
+			config.ExcludeDirs, err = cmd.Flags().GetStringSlice("exclude-dir")
+			if err != nil {
+				return err
+			}
+
+			config.WebhookURL, err = cmd.Flags().GetString("webhook-url")
+			if err != nil {
+				return err
+			}
+
+			config.UploadSARIF, err = cmd.Flags().GetBool("upload-sarif")
+			if err != nil {
+				return err
+			}
+
+			config.Bootstrap, err = cmd.Flags().GetBool("bootstrap")
+			if err != nil {
+				return err
+			}
+
+			config.Profile, err = cmd.Flags().GetString("profile")
+			if err != nil {
+				return err
+			}
+
+			config.Scope, err = scopeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			config.ReportOn, err = cmd.Flags().GetString("report-on")
+			if err != nil {
+				return err
+			}
+
+			config.ExplainFailures, err = explainFailuresFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			config.PreCommitCompat, err = cmd.Flags().GetBool("pre-commit-compat")
+			if err != nil {
+				return err
+			}
+
+			config.EmitFinalJSON, err = cmd.Flags().GetBool("emit-final-json")
+			if err != nil {
+				return err
+			}
+
+			config.TopOffenders, err = cmd.Flags().GetInt("top-offenders")
+			if err != nil {
+				return err
+			}
+
 			return cb.runChecksWithConfig(config, cmd, args)
 		},
 	}
@@ -132,31 +408,133 @@ Available checks:
 	// Add flags
 	cmd.Flags().BoolP("all-files", "a", false, "Run on all files in the repository")
 	cmd.Flags().StringSliceP("files", "f", nil, "Specific files to check")
+	cmd.Flags().String("files-from", "", "Check the files listed in this file, one per line; - reads stdin (# comments and blank lines are ignored)")
 	cmd.Flags().StringSlice("skip", nil, "Skip specific checks")
 	cmd.Flags().StringSlice("only", nil, "Run only specific checks")
+	cmd.Flags().Bool("only-failed", false, "Run only the checks that failed in the last run")
 	cmd.Flags().IntP("parallel", "p", 0, "Number of parallel workers (0 = auto)")
 	cmd.Flags().Bool("fail-fast", false, "Stop on first check failure")
+	cmd.Flags().Int("max-failures", 0, "Skip the remaining checks once this many have failed (0 = unlimited)")
 	cmd.Flags().Bool("show-checks", false, "Show available checks and exit")
 	cmd.Flags().Bool("graceful", false, "Skip checks that can't run instead of failing")
 	cmd.Flags().Bool("progress", true, "Show progress indicators during execution")
 	cmd.Flags().BoolP("quiet", "q", false, "Suppress progress messages, show only errors and results")
 	cmd.Flags().Bool("debug-timeout", false, "Enable detailed timeout debugging information")
+	cmd.Flags().String("format", outputFormatText, "Output format for results (text, tap)")
+	cmd.Flags().Bool("interactive", false, "Ask before applying whitespace/EOF fixes (requires a terminal)")
+	cmd.Flags().Bool("force-all-checks", false, "Run Go checks even when no Go files or go.mod changed")
+	cmd.Flags().Bool("offline", false, "Skip checks that need the network, such as mod-tidy")
+	cmd.Flags().Bool("skip-missing-tools", false, "Skip checks whose tool (golangci-lint, gofumpt, ...) is not installed instead of failing")
+	cmd.Flags().Duration("since", 0, "Run on tracked files modified within this window (e.g. 24h), regardless of git state")
+	cmd.Flags().String("pprof", "", "Write a pprof profile of the run (cpu, mem)")
+	cmd.Flags().String("pprof-out", "", "File for --pprof data (default go-pre-commit.<kind>.pprof)")
+	cmd.Flags().Bool("write-baseline", false, "Record all current lint issues in "+gotools.LintBaselineFile+" (runs lint on all files)")
+	cmd.Flags().Bool("dump-filelist", false, "Print each check's input files, exclusions with reasons, and final file set, then exit")
+	cmd.Flags().Bool("plan-json", false, "Print the checks that would run, their file counts, needs, and estimated durations as JSON, then exit")
+	cmd.Flags().StringSlice("path", nil, "Only consider files under these paths (repeatable)")
+	cmd.Flags().StringSlice("exclude-dir", nil, "Leave files under this directory out of every check (repeatable)")
+	cmd.Flags().String("webhook-url", "", "POST the run results as JSON to this URL (best effort)")
+	cmd.Flags().Bool("upload-sarif", false, "Upload the findings as SARIF to GitHub code scanning using GITHUB_TOKEN (best effort)")
+	cmd.Flags().Bool("bootstrap", false, "Apply and stage every auto-fix, then succeed without running other checks")
+	cmd.Flags().String("profile", "", "Run the checks of a configured profile (e.g. fast, full) instead of the enabled ones")
+	cmd.Flags().Bool("changed-only", true, "Check only staged files (the default unless GO_PRE_COMMIT_DEFAULT_SCOPE=all)")
+	cmd.Flags().Bool("no-changed-only", false, "Check all files in the repository (same as --all-files)")
+	cmd.Flags().String("report-on", "", "When to show each check's result: always, or failure for only a summary on success (default GO_PRE_COMMIT_REPORT_ON)")
+	cmd.Flags().Bool("pre-commit-compat", false, "Run as a pre-commit framework hook: arguments are files, output is plain, and fixes exit 1")
+	cmd.Flags().Bool("explain-failures", false, "After a failing run, list the commands that fix each failed check (default on in a terminal)")
+	cmd.Flags().Bool("emit-final-json", false, "After all other output, write a one-line JSON summary of the run to stderr")
+	cmd.Flags().Int("top-offenders", 0, "Run without fixing anything and list the N files with the most diagnostics across all checks")
 
 	return cmd
 }
 
-func (cb *CommandBuilder) runChecksWithConfig(runConfig RunConfig, _ *cobra.Command, args []string) error {
+func (cb *CommandBuilder) runChecksWithConfig(runConfig RunConfig, cmd *cobra.Command, args []string) (err error) {
+	// Validate the output format before doing any work
+	if !isValidOutputFormat(runConfig.Format) {
+		return fmt.Errorf("%w: %q (expected %s or %s)", ErrUnknownOutputFormat, runConfig.Format, outputFormatText, outputFormatTAP)
+	}
+	if runConfig.PreCommitCompat {
+		if err = applyPreCommitCompat(&runConfig, args); err != nil {
+			return err
+		}
+		args = nil
+		// The framework shows the hook output verbatim; keep it free of ANSI codes
+		cb.app.config.NoColor = true
+	}
+	if runConfig.Since < 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidSince, runConfig.Since)
+	}
+	if runConfig.MaxFailures < 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidMaxFailures, runConfig.MaxFailures)
+	}
+	if runConfig.TopOffenders < 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidTopOffenders, runConfig.TopOffenders)
+	}
+	if runConfig.FilesFrom != "" {
+		listed, listErr := readFilesFrom(runConfig.FilesFrom, commandStdin(cmd))
+		if listErr != nil {
+			return listErr
+		}
+		runConfig.Files = append(runConfig.Files, listed...)
+	}
+	if runConfig.WebhookURL != "" {
+		if err = webhook.ValidateURL(runConfig.WebhookURL); err != nil {
+			return err
+		}
+	}
+	if runConfig.OnlyFailed && (len(args) > 0 || len(runConfig.OnlyChecks) > 0 || len(runConfig.SkipChecks) > 0 || runConfig.Profile != "") {
+		return ErrOnlyFailedConflict
+	}
+	runConfig.ReportOn = strings.ToLower(runConfig.ReportOn)
+	if runConfig.ReportOn != "" && runConfig.ReportOn != config.ReportOnAlways && runConfig.ReportOn != config.ReportOnFailure {
+		return fmt.Errorf("%w: %q (expected %s or %s)", ErrUnknownReportPolicy, runConfig.ReportOn, config.ReportOnAlways, config.ReportOnFailure)
+	}
+	if !isValidPprofKind(runConfig.Pprof) {
+		return fmt.Errorf("%w: %q (expected %s or %s)", ErrUnknownPprofKind, runConfig.Pprof, profileCPU, profileMem)
+	}
+	if runConfig.Pprof != "" {
+		stopProfile, profileErr := startProfile(runConfig.Pprof, runConfig.PprofOut)
+		if profileErr != nil {
+			return profileErr
+		}
+		defer func() {
+			if stopErr := stopProfile(); stopErr != nil && err == nil {
+				err = stopErr
+			}
+		}()
+	}
+	if runConfig.WriteBaseline {
+		// The baseline covers the whole repository, so regenerate it from a
+		// full lint run rather than the staged files
+		runConfig.AllFiles = true
+		runConfig.Files = nil
+		runConfig.FilesFrom = ""
+		runConfig.Since = 0
+		args = []string{"lint"}
+	}
+	if runConfig.Format == outputFormatTAP || runConfig.PlanJSON || runConfig.TopOffenders > 0 {
+		// Machine-readable output and the offenders report own stdout;
+		// suppress progress chatter
+		runConfig.Quiet = true
+		runConfig.ShowProgress = false
+	}
+
 	// Load configuration first
 	cfg, err := config.Load()
 	if err != nil {
 		// Use basic formatter for this error since config failed to load
 		formatter := output.NewDefault()
 		formatter.Error("Failed to load configuration: %v", err)
-		return fmt.Errorf("failed to load configuration: %w", err)
+		return setupError(nil, fmt.Errorf("failed to load configuration: %w", err))
 	}
 
 	// Create output formatter with config-based color settings
-	formatter := cb.newFormatter(cfg)
+	formatter, err := cb.newFormatter(cfg)
+	if err != nil {
+		output.NewDefault().Error("Invalid output destination: %v", err)
+		return setupError(cfg, err)
+	}
+	defer func() { _ = formatter.Close() }()
 
 	// Check if pre-commit system is enabled
 	if !cfg.Enabled {
@@ -164,11 +542,36 @@ func (cb *CommandBuilder) runChecksWithConfig(runConfig RunConfig, _ *cobra.Comm
 		return nil
 	}
 
+	if runConfig.ReportOn == "" {
+		runConfig.ReportOn = cfg.Reporting.ReportOn
+	}
+	if runConfig.PreCommitCompat {
+		disableAutoStage(cfg)
+	}
+	if runConfig.TopOffenders > 0 {
+		applyReportOnly(cfg)
+	}
+
+	if err = applyScope(&runConfig, cfg); err != nil {
+		formatter.Error("%v", err)
+		return setupError(cfg, err)
+	}
+
+	// Resolve the check profile before selecting files
+	var profileChecks []string
+	if runConfig.Profile != "" {
+		var ok bool
+		if profileChecks, ok = cfg.Profiles[runConfig.Profile]; !ok {
+			formatter.Error("Unknown check profile %q (available: %s)", runConfig.Profile, strings.Join(slices.Sorted(maps.Keys(cfg.Profiles)), ", "))
+			return setupError(cfg, fmt.Errorf("%w: %q", ErrUnknownProfile, runConfig.Profile))
+		}
+	}
+
 	// Get repository root
 	repoRoot, err := git.FindRepositoryRoot()
 	if err != nil {
 		formatter.Error("Failed to find git repository: %v", err)
-		return fmt.Errorf("failed to find git repository: %w", err)
+		return setupError(cfg, fmt.Errorf("failed to find git repository: %w", err))
 	}
 
 	// If show-checks flag is set, display available checks and exit
@@ -176,20 +579,92 @@ func (cb *CommandBuilder) runChecksWithConfig(runConfig RunConfig, _ *cobra.Comm
 		return showAvailableChecks(cfg, formatter)
 	}
 
+	// Narrow the run to the checks that failed last time
+	var failedChecks []string
+	if runConfig.OnlyFailed {
+		if failedChecks, err = loadFailedChecks(repoRoot); err != nil {
+			formatter.Error("%v", err)
+			return setupError(cfg, err)
+		}
+		if len(failedChecks) == 0 {
+			if runConfig.PlanJSON {
+				return writePlanJSON(os.Stdout, &runner.Plan{Checks: []runner.PlannedCheck{}})
+			}
+			if runConfig.Format == outputFormatTAP {
+				return (&runner.Results{}).WriteTAP(os.Stdout)
+			}
+			formatter.Info("No failed checks recorded from the last run")
+			return nil
+		}
+		if !runConfig.Quiet {
+			formatter.Info("Re-running checks that failed last time: %s", strings.Join(failedChecks, ", "))
+		}
+	}
+
 	// Determine which files to check
-	filesToCheck, err := selectFilesToCheck(runConfig, repoRoot, formatter)
+	filesToCheck, err := selectFilesToCheck(runConfig, cfg, repoRoot, formatter)
 	if err != nil {
-		return err
+		return setupError(cfg, err)
+	}
+	filesToCheck, err = scopeFilesToPaths(filesToCheck, runConfig.Paths, repoRoot)
+	if err != nil {
+		formatter.Error("Invalid --path: %v", err)
+		return setupError(cfg, err)
+	}
+	if err = addExcludeDirs(cfg, runConfig.ExcludeDirs, repoRoot); err != nil {
+		formatter.Error("Invalid --exclude-dir: %v", err)
+		return setupError(cfg, err)
 	}
 
 	if len(filesToCheck) == 0 {
+		if runConfig.PlanJSON {
+			return writePlanJSON(os.Stdout, &runner.Plan{Checks: []runner.PlannedCheck{}})
+		}
+		missed := noFilesRequiredMisses(cfg)
+		if runConfig.Format == outputFormatTAP {
+			if err = (&runner.Results{}).WriteTAP(os.Stdout); err != nil {
+				return err
+			}
+			return requiredChecksError(cfg, missed)
+		}
 		formatter.Info("No files to check")
-		return nil
+		if len(missed) > 0 {
+			displayRequiredMisses(formatter, missed)
+			return requiredChecksError(cfg, missed)
+		}
+		return noFilesError(cfg)
 	}
 
 	// Create runner and configure options
 	r := runner.New(cfg, repoRoot)
 	opts := buildRunnerOptions(runConfig, args, filesToCheck, formatter)
+	opts.ProfileChecks = profileChecks
+	opts.HeartbeatInterval = heartbeatInterval(cfg)
+	finishProgress := attachProgressBar(&opts, cfg, runConfig, formatter, output.IsOutputTTY())
+	if runConfig.OnlyFailed {
+		opts.OnlyChecks = failedChecks
+	}
+
+	if runConfig.PlanJSON {
+		plan, planErr := r.Plan(commandContext(cmd), opts)
+		if planErr != nil {
+			return fmt.Errorf("failed to plan checks: %w", planErr)
+		}
+		return writePlanJSON(os.Stdout, plan)
+	}
+
+	if runConfig.DumpFileList {
+		lists, listErr := r.FileLists(commandContext(cmd), opts)
+		if listErr != nil {
+			return fmt.Errorf("failed to list files: %w", listErr)
+		}
+		displayFileLists(formatter, lists)
+		return nil
+	}
+
+	if runConfig.Bootstrap {
+		return runBootstrap(commandContext(cmd), cfg, repoRoot, filesToCheck, formatter)
+	}
 
 	// Show initial information (unless in quiet mode)
 	if cb.app.config.Verbose && !runConfig.Quiet {
@@ -203,59 +678,286 @@ func (cb *CommandBuilder) runChecksWithConfig(runConfig RunConfig, _ *cobra.Comm
 	}
 
 	// Run checks
-	results, err := r.Run(context.Background(), opts)
+	results, err := r.Run(commandContext(cmd), opts)
+	finishProgress()
+	if errors.Is(err, runner.ErrRunCanceled) {
+		formatter.Warning("Interrupted; checks were stopped before completing")
+		return fmt.Errorf("%w: %w", ErrInterrupted, err)
+	}
 	if err != nil {
 		formatter.Error("Failed to run checks: %v", err)
-		return fmt.Errorf("failed to run checks: %w", err)
+		return setupError(cfg, fmt.Errorf("failed to run checks: %w", err))
+	}
+
+	// The offenders report replaces the results and never fails the run
+	if runConfig.TopOffenders > 0 {
+		displayTopOffenders(formatter, results, runConfig.TopOffenders)
+		return nil
+	}
+
+	// The summary line is written by the caller once everything else is out
+	if runConfig.EmitFinalJSON {
+		cb.app.finalResults = results
+	}
+
+	if saveErr := saveLastRun(repoRoot, results); saveErr != nil && runConfig.Format != outputFormatTAP {
+		formatter.Warning("Could not record the run for --only-failed: %v", saveErr)
+	}
+
+	if cb.app.config.Verbose && !runConfig.Quiet && results.UniqueFiles < results.InputFiles {
+		formatter.Info("De-duplicated %d input path(s) to %d file(s)", results.InputFiles, results.UniqueFiles)
+	}
+
+	if runConfig.WebhookURL != "" {
+		if hookErr := cb.sendWebhook(commandContext(cmd), runConfig.WebhookURL, repoRoot, results); hookErr != nil && runConfig.Format != outputFormatTAP {
+			formatter.Warning("Could not send results to webhook: %v", hookErr)
+		}
+	}
+
+	if runConfig.UploadSARIF {
+		if uploadErr := cb.uploadSARIF(commandContext(cmd), results); uploadErr != nil && runConfig.Format != outputFormatTAP {
+			formatter.Warning("Could not upload SARIF to code scanning: %v", uploadErr)
+		}
+	}
+
+	if summaryErr := appendStepSummary(results); summaryErr != nil && runConfig.Format != outputFormatTAP {
+		formatter.Warning("Could not write the GitHub step summary: %v", summaryErr)
+	}
+
+	if cfg.Reporting.GitHubReview {
+		posted, reviewErr := cb.postReview(commandContext(cmd), results)
+		switch {
+		case reviewErr != nil && runConfig.Format != outputFormatTAP:
+			formatter.Warning("Could not post review comments: %v", reviewErr)
+		case posted > 0 && cb.app.config.Verbose && !runConfig.Quiet:
+			formatter.Info("Posted %d review comment(s) to the pull request", posted)
+		}
 	}
 
 	// Display results
-	displayEnhancedResults(formatter, results, runConfig.Quiet, cb.app.config.Verbose)
+	if runConfig.Format == outputFormatTAP {
+		if err = results.WriteTAP(os.Stdout); err != nil {
+			return err
+		}
+		if results.BlockingFailures() > 0 {
+			return checksFailedError(cfg, results)
+		}
+		return requiredChecksError(cfg, results.RequiredMissed)
+	}
+	if runConfig.PreCommitCompat {
+		if err = writeFixedFiles(os.Stdout, results); err != nil {
+			return err
+		}
+	}
+	displayReport(formatter, results, runConfig.ReportOn, runConfig.Quiet)
+	if runConfig.ExplainFailures {
+		displayRemediation(formatter, results.CheckResults)
+	}
+	displayManualRunNotice(formatter, runConfig, cfg)
+
+	// Return error if any checks failed (unless they were gracefully skipped
+	// or continue on error)
+	if results.BlockingFailures() > 0 {
+		if runConfig.PreCommitCompat {
+			return preCommitCompatError(results)
+		}
+		return checksFailedError(cfg, results)
+	}
 
-	// Return error if any checks failed (unless they were gracefully skipped)
-	if results.Failed > 0 {
-		return fmt.Errorf("%w: %d", prerrors.ErrChecksFailed, results.Failed)
+	// CI that requires checks fails when one of them did not actually run
+	if len(results.RequiredMissed) > 0 {
+		displayRequiredMisses(formatter, results.RequiredMissed)
+		return requiredChecksError(cfg, results.RequiredMissed)
 	}
 
-	if results.Passed > 0 {
+	if runConfig.WriteBaseline && results.Passed > 0 {
+		formatter.Success("Recorded current lint issues in %s", gotools.LintBaselineFile)
+	}
+
+	if results.Passed > 0 && results.Failed == 0 {
 		formatter.Success("All checks passed! %s",
 			formatter.FormatExecutionStats(results.Passed, results.Failed, results.Skipped, results.TotalDuration, results.TotalFiles))
 	}
 
+	if cfg.UI.EmptyCommitNotice && results.NothingChecked() {
+		formatter.Info("No files to check")
+		return noFilesError(cfg)
+	}
+
 	return nil
 }
 
+// displayRequiredMisses explains which required checks did not run and why
+func displayRequiredMisses(formatter *output.Formatter, missed []runner.RequiredCheckMiss) {
+	for _, miss := range missed {
+		formatter.Error("Required check %s did not run: %s", miss.Name, miss.Reason)
+	}
+	formatter.Info("Enable the checks and make sure they have files and tools, or remove them from GO_PRE_COMMIT_REQUIRED_CHECKS")
+}
+
+// noFilesRequiredMisses returns every required check as missed by a run
+// that had no files to check
+func noFilesRequiredMisses(cfg *config.Config) []runner.RequiredCheckMiss {
+	missed := make([]runner.RequiredCheckMiss, 0, len(cfg.RequiredChecks))
+	for _, name := range cfg.RequiredChecks {
+		missed = append(missed, runner.RequiredCheckMiss{Name: name, Reason: "no files to check"})
+	}
+	return missed
+}
+
+// commandContext returns the context of cmd, which the root command cancels
+// on SIGINT or SIGTERM
+func commandContext(cmd *cobra.Command) context.Context {
+	if cmd == nil || cmd.Context() == nil {
+		return context.Background()
+	}
+	return cmd.Context()
+}
+
 // newFormatter builds an output formatter honoring the CLI color flags
-// (--no-color, --color) with a fallback to the configured color preference.
-func (cb *CommandBuilder) newFormatter(cfg *config.Config) *output.Formatter {
+// (--no-color, --color) with a fallback to the configured color preference,
+// the GO_PRE_COMMIT_OUTPUT_THEME colors, and the configured
+// GO_PRE_COMMIT_MAX_FILES_IN_SUMMARY file list limit. Output goes to the
+// destination selected with --output-dest; the caller must Close the
+// formatter.
+func (cb *CommandBuilder) newFormatter(cfg *config.Config) (*output.Formatter, error) {
+	formatter, err := output.OpenWithColorMode(cb.colorMode(cfg), cb.app.config.OutputDest)
+	if err != nil {
+		return nil, err
+	}
+	formatter.SetVerbosity(cb.verbosity())
+	if cfg != nil {
+		formatter.SetMaxFiles(cfg.UI.MaxFilesInSummary)
+		formatter.SetDiffLimits(cfg.UI.DiffContextLines, cfg.UI.DiffMaxLinesShown)
+		formatter.SetRedactSecrets(cfg.UI.RedactDiffSecrets)
+		if cfg.UI.RedactDiffSecrets {
+			loadGitleaksRules(formatter)
+		}
+		if theme, ok := output.LookupTheme(cfg.UI.Theme); ok {
+			formatter.SetTheme(theme)
+		}
+	}
+	return formatter, nil
+}
+
+// loadGitleaksRules adds the project's gitleaks rules, if it has any, to the
+// ones the formatter redacts diffs with
+func loadGitleaksRules(formatter *output.Formatter) {
+	repoRoot, err := git.FindRepositoryRoot()
+	if err != nil {
+		return
+	}
+	path := gotools.FindGitleaksConfig(repoRoot)
+	if path == "" {
+		return
+	}
+	if err := formatter.LoadGitleaksRules(path); err != nil {
+		formatter.Warning("Diffs are redacted with the default gitleaks rules only: %v", err)
+	}
+}
+
+// verbosity returns the output level selected with -v, -vv, or -vvv. A plain
+// Verbose setting counts as -v.
+func (cb *CommandBuilder) verbosity() output.Verbosity {
+	if cb.app.config.Verbosity == 0 && cb.app.config.Verbose {
+		return output.VerbosityChecks
+	}
+	return output.Verbosity(cb.app.config.Verbosity)
+}
+
+// colorMode resolves the effective color mode from the CLI flags and config
+func (cb *CommandBuilder) colorMode(cfg *config.Config) output.ColorMode {
 	if cb.app.config.NoColor {
 		// --no-color flag takes highest priority
-		return output.NewWithColorMode(output.ColorNever)
+		return output.ColorNever
 	}
 
 	// Use --color flag or auto-detect
 	switch cb.app.config.ColorMode {
 	case colorModeAlways:
-		return output.NewWithColorMode(output.ColorAlways)
+		return output.ColorAlways
 	case colorModeNever:
-		return output.NewWithColorMode(output.ColorNever)
+		return output.ColorNever
 	case colorModeAuto:
-		return output.NewWithColorMode(output.ColorAuto)
+		return output.ColorAuto
 	default:
 		// Default to auto mode with config override
 		if !cfg.UI.ColorOutput {
-			return output.NewWithColorMode(output.ColorNever)
+			return output.ColorNever
 		}
-		return output.NewWithColorMode(output.ColorAuto)
+		return output.ColorAuto
 	}
 }
 
+// isValidOutputFormat reports whether format is a supported --format value.
+// An empty value selects the default text output.
+func isValidOutputFormat(format string) bool {
+	switch format {
+	case "", outputFormatText, outputFormatTAP:
+		return true
+	default:
+		return false
+	}
+}
+
+// scopeFromFlags returns the file scope chosen with --changed-only or
+// --no-changed-only, or "" when neither flag was given
+func scopeFromFlags(cmd *cobra.Command) (string, error) {
+	flags := cmd.Flags()
+	scope := ""
+	if flags.Changed("changed-only") {
+		changedOnly, err := flags.GetBool("changed-only")
+		if err != nil {
+			return "", err
+		}
+		scope = config.ScopeAll
+		if changedOnly {
+			scope = config.ScopeChanged
+		}
+	}
+	if flags.Changed("no-changed-only") {
+		noChangedOnly, err := flags.GetBool("no-changed-only")
+		if err != nil {
+			return "", err
+		}
+		flagScope := config.ScopeChanged
+		if noChangedOnly {
+			flagScope = config.ScopeAll
+		}
+		if scope != "" && scope != flagScope {
+			return "", fmt.Errorf("%w: --changed-only and --no-changed-only", ErrConflictingScope)
+		}
+		scope = flagScope
+	}
+	return scope, nil
+}
+
+// applyScope turns the file scope into a file source. Explicit sources
+// (--files, --files-from, --all-files, --since) win; otherwise the scope flag, then
+// GO_PRE_COMMIT_DEFAULT_SCOPE, decides between staged files and all files.
+func applyScope(runConfig *RunConfig, cfg *config.Config) error {
+	if runConfig.Scope == config.ScopeChanged && runConfig.AllFiles && !runConfig.WriteBaseline {
+		return fmt.Errorf("%w: --changed-only and --all-files", ErrConflictingScope)
+	}
+	if runConfig.AllFiles || len(runConfig.Files) > 0 || runConfig.FilesFrom != "" || runConfig.Since > 0 {
+		return nil
+	}
+
+	scope := runConfig.Scope
+	if scope == "" {
+		scope = cfg.DefaultScope
+	}
+	runConfig.AllFiles = scope == config.ScopeAll
+	return nil
+}
+
 // selectFilesToCheck resolves the set of files to run checks against based on
-// the run configuration: explicit files, all repository files, or staged files.
-func selectFilesToCheck(runConfig RunConfig, repoRoot string, formatter *output.Formatter) ([]string, error) {
+// the run configuration: explicit files, all repository files, recently
+// modified files, or staged files.
+func selectFilesToCheck(runConfig RunConfig, cfg *config.Config, repoRoot string, formatter *output.Formatter) ([]string, error) {
 	switch {
-	case len(runConfig.Files) > 0:
-		// Specific files provided
+	case len(runConfig.Files) > 0 || runConfig.FilesFrom != "":
+		// Specific files provided; an empty --files-from list checks nothing
 		return runConfig.Files, nil
 	case runConfig.AllFiles:
 		// All files in repository
@@ -265,9 +967,17 @@ func selectFilesToCheck(runConfig RunConfig, repoRoot string, formatter *output.
 			return nil, fmt.Errorf("failed to get all files: %w", err)
 		}
 		return files, nil
+	case runConfig.Since > 0:
+		// Tracked files touched within the window, minus classifier exclusions
+		files, err := git.NewRepository(repoRoot).GetFilesModifiedSince(time.Now().Add(-runConfig.Since))
+		if err != nil {
+			formatter.Error("Failed to get recently modified files: %v", err)
+			return nil, fmt.Errorf("failed to get recently modified files: %w", err)
+		}
+		return git.NewFileClassifier(cfg).FilterExcluded(files), nil
 	default:
 		// Staged files (default)
-		files, err := git.NewRepository(repoRoot).GetStagedFiles()
+		files, err := git.NewRepositoryWithConfig(repoRoot, cfg).GetStagedFiles()
 		if err != nil {
 			formatter.Error("Failed to get staged files: %v", err)
 			return nil, fmt.Errorf("failed to get staged files: %w", err)
@@ -276,6 +986,57 @@ func selectFilesToCheck(runConfig RunConfig, repoRoot string, formatter *output.
 	}
 }
 
+// heartbeatInterval returns how often a slow check is reported as still
+// running: GO_PRE_COMMIT_HEARTBEAT_INTERVAL seconds when a person is watching
+// a terminal, and never in CI or when output is redirected
+func heartbeatInterval(cfg *config.Config) time.Duration {
+	if cfg.UI.HeartbeatInterval <= 0 || cfg.Environment.IsCI || !output.IsOutputTTY() {
+		return 0
+	}
+	return time.Duration(cfg.UI.HeartbeatInterval) * time.Second
+}
+
+// attachProgressBar replaces the line per running and passing check with a
+// progress bar of finished checks when GO_PRE_COMMIT_PROGRESS_BAR is set: a
+// bar redrawn in place on a terminal outside CI, or, when --progress was
+// passed explicitly, a line at every 10%. Failed, skipped, and warn-only
+// checks are still reported on their own lines. The returned function erases
+// the bar once the run is over.
+func attachProgressBar(opts *runner.Options, cfg *config.Config, runConfig RunConfig, formatter *output.Formatter, tty bool) (finish func()) {
+	live := tty && !cfg.Environment.IsCI
+	if !cfg.UI.ProgressBar || opts.ProgressCallback == nil || (!live && !runConfig.ProgressRequested) {
+		return func() {}
+	}
+
+	var bar *output.ProgressBar
+	opts.PlanCallback = func(checks, files int) {
+		bar = formatter.NewProgressBar(checks, files, live)
+	}
+
+	report := opts.ProgressCallback
+	opts.ProgressCallback = func(checkName, status string, duration time.Duration) {
+		if bar == nil {
+			report(checkName, status, duration)
+			return
+		}
+		switch status {
+		case "running", "heartbeat":
+			return
+		case "passed":
+		default:
+			bar.Clear()
+			report(checkName, status, duration)
+		}
+		bar.Advance(checkName)
+	}
+
+	return func() {
+		if bar != nil {
+			bar.Finish()
+		}
+	}
+}
+
 // buildRunnerOptions assembles runner options from the run configuration,
 // wiring up the progress callback and resolving which checks to run.
 func buildRunnerOptions(runConfig RunConfig, args, filesToCheck []string, formatter *output.Formatter) runner.Options {
@@ -283,27 +1044,50 @@ func buildRunnerOptions(runConfig RunConfig, args, filesToCheck []string, format
 		Files:               filesToCheck,
 		Parallel:            runConfig.Parallel,
 		FailFast:            runConfig.FailFast,
+		MaxFailures:         runConfig.MaxFailures,
 		GracefulDegradation: runConfig.GracefulDegradation,
 		DebugTimeout:        runConfig.DebugTimeout,
+		Interactive:         runConfig.Interactive,
+		DeclineFixes:        runConfig.TopOffenders > 0,
+		ForceAllChecks:      runConfig.ForceAllChecks,
+		Offline:             runConfig.Offline,
+		SkipMissingTools:    runConfig.SkipMissingTools,
+		WriteLintBaseline:   runConfig.WriteBaseline,
 	}
 
-	// Set up progress callback if progress is enabled and not in quiet mode
+	// Set up progress callback if progress is enabled and not in quiet mode.
+	// Reporting only on failure drops the lines for checks that pass.
 	if runConfig.ShowProgress && !runConfig.Quiet {
+		reportOnFailure := runConfig.ReportOn == config.ReportOnFailure
 		opts.ProgressCallback = func(checkName, status string, duration time.Duration) {
 			durationStr := formatter.Duration(duration)
 			switch status {
 			case "running":
-				formatter.Progress("Running %s check...", checkName)
+				if !reportOnFailure {
+					formatter.Progress("Running %s check...", checkName)
+				}
+			case "heartbeat":
+				if !reportOnFailure {
+					formatter.Progress("%s check still running (%s)", checkName, durationStr)
+				}
 			case "passed":
-				formatter.Success("%s check passed (%s)", checkName, durationStr)
+				if !reportOnFailure {
+					formatter.Success("%s check passed (%s)", checkName, durationStr)
+				}
 			case "failed":
 				formatter.Error("%s check failed (%s)", checkName, durationStr)
 			case "skipped":
 				formatter.Warning("%s check skipped (%s)", checkName, durationStr)
+			case "warning":
+				formatter.Warning("%s check failed, warn-only (%s)", checkName, durationStr)
 			}
 		}
 	}
 
+	opts.HookCallback = func(result runner.HookResult) {
+		displayHookResult(formatter, result, runConfig.Quiet)
+	}
+
 	// Handle check selection
 	switch {
 	case len(args) > 0:
@@ -320,6 +1104,23 @@ func buildRunnerOptions(runConfig RunConfig, args, filesToCheck []string, format
 	return opts
 }
 
+// displayHookResult reports a pre-run or post-run command. Output is shown
+// when the command fails or at -v; post-run failures are also listed with the
+// run's warnings, so only their output is printed here.
+func displayHookResult(formatter *output.Formatter, result runner.HookResult, quietMode bool) {
+	label := fmt.Sprintf("%s command %q", result.Phase, result.Command)
+	switch {
+	case result.Err == nil:
+		if quietMode || !formatter.Verbose(output.VerbosityChecks) {
+			return
+		}
+		formatter.Success("%s passed (%s)", label, formatter.Duration(result.Duration))
+	case result.Phase == runner.HookPhasePreRun:
+		formatter.Error("%s failed (%s)", label, formatter.Duration(result.Duration))
+	}
+	formatter.CheckOutput(label, result.Output)
+}
+
 // extractKeyErrorLines parses command output and extracts the most important error lines
 func extractKeyErrorLines(output string) []string {
 	var errorLines []string
@@ -413,11 +1214,52 @@ func showAvailableChecks(cfg *config.Config, formatter *output.Formatter) error
 		description string
 		enabled     bool
 	}{
+		{"build-artifacts", "Block staged coverage profiles, CPU profiles, and test binaries", cfg.Checks.BuildArtifacts},
+		{"build-constraints", "Flag //go:build constraints no build can satisfy", cfg.Checks.BuildConstraints},
+		{"build-tags", "Require //go:build alongside legacy // +build lines", cfg.Checks.BuildTags},
+		{"commit-size", "Warn when a commit stages more files than allowed", cfg.Checks.CommitSize},
+		{"context-background", "Flag context.Background and context.TODO outside main and tests", cfg.Checks.ContextBackground},
+		{"context-first", "Require context.Context to be the first parameter", cfg.Checks.ContextFirst},
+		{"data-format", "Enforce JSON and YAML indentation and, optionally, sorted keys", cfg.Checks.DataFormat},
+		{"doc-comments", "Require doc comments on exported symbols", cfg.Checks.DocComments},
+		{"embedded-blobs", "Flag large base64 string literals that belong in //go:embed files", cfg.Checks.EmbeddedBlobs},
+		{"empty-commit", "Fail when fixes leave nothing staged to commit", cfg.Checks.EmptyCommit},
 		{"eof", "Ensure files end with newline", cfg.Checks.EOF},
+		{"error-compare", "Flag == comparisons against sentinel errors", cfg.Checks.ErrorCompare},
+		{"error-var-naming", "Require package-level error variables to be named ErrXxx or errXxx", cfg.Checks.ErrorVarNaming},
+		{"error-wrap", "Require %w when fmt.Errorf formats an error", cfg.Checks.ErrorWrap},
+		{"env-access", "Flag os.Getenv calls outside the config packages", cfg.Checks.EnvAccess},
+		{"file-header-order", "Order license, build constraints, and package doc in Go file headers", cfg.Checks.FileHeaderOrder},
+		{"file-permissions", "Block world-writable files and disallowed file modes", cfg.Checks.FilePermissions},
+		{"filename-case", "Block paths that differ only in case from another tracked path", cfg.Checks.FilenameCase},
+		{"forbidden-imports", "Block imports of packages on the deny list", cfg.Checks.ForbiddenImports},
 		{"fumpt", "Format code with gofumpt", cfg.Checks.Fumpt},
+		{"func-length", "Flag Go functions over the line or statement limit", cfg.Checks.FuncLength},
 		{"gitleaks", "Scan for secrets and credentials in code", cfg.Checks.Gitleaks},
+		{"global-vars", "Flag mutable package-level variables", cfg.Checks.GlobalVars},
+		{"go-generate", "Verify go:generate output is up to date", cfg.Checks.GoGenerate},
+		{"go-directive", "Require go directives the installed Go supports", cfg.Checks.GoDirective},
+		{"go-indent", "Flag Go files indented with spaces", cfg.Checks.GoIndent},
+		{"go-version", "Require the same go directive in every go.mod", cfg.Checks.GoVersion},
+		{"gosec", "Scan Go code for security problems with gosec", cfg.Checks.Gosec},
+		{"hadolint", "Lint Dockerfiles with hadolint", cfg.Checks.Hadolint},
+		{"internal-import", "Block imports of another module's internal packages", cfg.Checks.InternalImport},
+		{"large-diffs", "Warn when one staged file changes more lines than allowed", cfg.Checks.LargeDiffs},
 		{"lint", "Run golangci-lint", cfg.Checks.Lint},
+		{"major-version-conflict", "Block packages importing two major versions of one module", cfg.Checks.MajorVersionConflict},
+		{"mod-pair", "Require go.mod and go.sum changes to be staged together", cfg.Checks.ModPair},
 		{"mod-tidy", "Ensure go.mod and go.sum are tidy", cfg.Checks.ModTidy},
+		{"module-path", "Require lowercase module paths under the configured prefix", cfg.Checks.ModulePath},
+		{"os-junk", "Block staged .DS_Store, Thumbs.db, and desktop.ini files", cfg.Checks.OSJunk},
+		{"receiver-names", "Require short, consistent method receiver names", cfg.Checks.ReceiverNames},
+		{"repo-files", "Require LICENSE, README, and other files at the repository root", cfg.Checks.RepoFiles},
+		{"shellcheck", "Lint shell scripts with shellcheck", cfg.Checks.Shellcheck},
+		{"struct-tags", "Flag malformed struct tags", cfg.Checks.StructTags},
+		{"stub-funcs", "Flag exported Go functions that are still stubs", cfg.Checks.StubFuncs},
+		{"test-package", "Require _test.go files to be in <pkg> or <pkg>_test", cfg.Checks.TestPackage},
+		{"test-only-deps", "Flag direct requirements only imported by tests", cfg.Checks.TestOnlyDeps},
+		{"test-presence", "Flag changed Go packages without test files", cfg.Checks.TestPresence},
+		{"toolchain", "Enforce the go.mod toolchain directive policy", cfg.Checks.Toolchain},
 		{"whitespace", "Fix trailing whitespace", cfg.Checks.Whitespace},
 	}
 
@@ -432,19 +1274,76 @@ func showAvailableChecks(cfg *config.Config, formatter *output.Formatter) error
 	return nil
 }
 
-func displayEnhancedResults(formatter *output.Formatter, results *runner.Results, quietMode, verboseMode bool) {
+// writePlanJSON writes the check plan to w as indented JSON
+func writePlanJSON(w io.Writer, plan *runner.Plan) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(plan)
+}
+
+// displayFileLists prints, for each check, the files it was given, those it
+// excludes with the reason, and the files it would check
+func displayFileLists(formatter *output.Formatter, lists []runner.CheckFileList) {
+	formatter.Header("Check File Lists")
+
+	for _, list := range lists {
+		formatter.Subheader(list.Name)
+		formatter.Detail("Input (%d):", len(list.Input))
+		for _, file := range list.Input {
+			formatter.Detail("  %s", file)
+		}
+		formatter.Detail("Excluded (%d):", len(list.Excluded))
+		for _, excluded := range list.Excluded {
+			if excluded.Detail != "" {
+				formatter.Detail("  %s (%s: %s)", excluded.File, excluded.Reason, excluded.Detail)
+				continue
+			}
+			formatter.Detail("  %s (%s)", excluded.File, excluded.Reason)
+		}
+		formatter.Detail("Checked (%d):", len(list.Files))
+		for _, file := range list.Files {
+			formatter.Detail("  %s", file)
+		}
+	}
+}
+
+// displayReport shows the run's results under the report policy. With
+// config.ReportOnFailure a run where nothing failed prints only its warnings
+// and summary; the caller prints the "All checks passed" line when any check
+// ran. Any failure shows full detail.
+func displayReport(formatter *output.Formatter, results *runner.Results, reportOn string, quietMode bool) {
+	if reportOn == config.ReportOnFailure && results.Failed == 0 && results.Warned == 0 {
+		for _, warning := range results.Warnings {
+			formatter.Warning("%s", warning)
+		}
+		if results.Passed == 0 {
+			displayResultSummary(formatter, results, quietMode)
+		}
+		return
+	}
+	displayEnhancedResults(formatter, results, quietMode)
+}
+
+func displayEnhancedResults(formatter *output.Formatter, results *runner.Results, quietMode bool) {
 	// In quiet mode, skip the header and only show failures
 	if !quietMode {
 		formatter.Header("Check Results")
 	}
 
+	for _, warning := range results.Warnings {
+		formatter.Warning("%s", warning)
+	}
+
 	// Display each check result, collecting failures for the error summary
 	var failedChecks []runner.CheckResult
 	for _, result := range results.CheckResults {
-		if !result.Success {
+		if !result.Success && !result.WarnOnly {
 			failedChecks = append(failedChecks, result)
 		}
-		displayCheckResult(formatter, result, quietMode, verboseMode)
+		displayCheckResult(formatter, result, quietMode)
+		if formatter.Verbose(output.VerbosityChecks) {
+			formatter.CheckOutput(result.Name, result.Log)
+		}
 	}
 
 	displayResultSummary(formatter, results, quietMode)
@@ -453,53 +1352,69 @@ func displayEnhancedResults(formatter *output.Formatter, results *runner.Results
 
 // displayCheckResult renders a single check result: success, graceful skip, or
 // failure (with key error lines and a remediation suggestion).
-func displayCheckResult(formatter *output.Formatter, result runner.CheckResult, quietMode, verboseMode bool) {
+func displayCheckResult(formatter *output.Formatter, result runner.CheckResult, quietMode bool) {
 	if result.Success {
 		if quietMode {
 			return
 		}
+		if result.Skipped {
+			formatter.Info("%s skipped - %s", result.Name, result.Error)
+			return
+		}
 		if result.CanSkip && result.Suggestion != "" {
 			// This was a gracefully skipped check
 			formatter.Warning("%s - %s", result.Name, result.Error)
 			formatter.SuggestAction(result.Suggestion)
 			return
 		}
+		if result.Cached {
+			formatter.Success("%s completed successfully (cached)", result.Name)
+			return
+		}
 		// Normal success - always show duration inline
 		formatter.Success("%s completed successfully (%s)", result.Name, formatter.Duration(result.Duration))
-		if verboseMode && len(result.Files) > 0 {
-			formatter.Detail("Files: %s", formatter.FormatFileList(result.Files, 3))
-		}
+		displayCheckDetails(formatter, result)
+		displayLineLimitSkips(formatter, result)
 		return
 	}
 
 	// Failed check - always show duration inline
-	formatter.Error("%s failed (%s)", result.Name, formatter.Duration(result.Duration))
-
-	if verboseMode && len(result.Files) > 0 {
-		formatter.Detail("Files: %s", formatter.FormatFileList(result.Files, 3))
+	switch {
+	case result.WarnOnly:
+		formatter.Warning("%s failed (%s, warn-only: not blocking)", result.Name, formatter.Duration(result.Duration))
+	case result.ContinueOnError:
+		formatter.Error("%s failed (%s, continue-on-error: not blocking)", result.Name, formatter.Duration(result.Duration))
+	default:
+		formatter.Error("%s failed (%s)", result.Name, formatter.Duration(result.Duration))
 	}
 
+	displayCheckDetails(formatter, result)
+	displayLineLimitSkips(formatter, result)
+
 	// Show error message
 	if result.Error != "" {
 		formatter.Detail("Error: %s", result.Error)
 	}
 
-	// Always show command output for failures to make errors visible,
-	// even without verbose mode.
-	if result.Output != "" {
+	// Always show command output for failures to make errors visible: the
+	// key error lines (or the check's diagnostics, grouped by file) normally,
+	// the full tool output at -vvv
+	switch {
+	case result.Output == "" && len(result.Diagnostics) == 0:
+	case formatter.Verbose(output.VerbosityCommands) && result.Output != "":
+		formatter.Subheader("Command Output")
+		formatter.CodeBlock(result.Output)
+	case len(result.Diagnostics) > 0:
+		if formatter.Diagnostics(result.Diagnostics, 10) {
+			formatter.Detail("  ... (run with -vvv for full output)")
+		}
+	default:
 		errorLines := extractKeyErrorLines(result.Output)
-		switch {
-		case len(errorLines) > 0:
-			for _, line := range errorLines {
-				formatter.Detail("  %s", line)
-			}
-			if !verboseMode && len(errorLines) >= 10 {
-				formatter.Detail("  ... (run with --verbose for full output)")
-			}
-		case verboseMode:
-			// Fall back to full output in verbose mode
-			formatter.Subheader("Command Output")
-			formatter.CodeBlock(result.Output)
+		for _, line := range errorLines {
+			formatter.Detail("  %s", line)
+		}
+		if len(errorLines) >= 10 {
+			formatter.Detail("  ... (run with -vvv for full output)")
 		}
 	}
 
@@ -509,10 +1424,35 @@ func displayCheckResult(formatter *output.Formatter, result runner.CheckResult,
 	}
 }
 
+// displayCheckDetails prints what each verbosity level adds to a check
+// result: the file count and inline opt-outs at -v, every file at -vv, and
+// the tool command lines at -vvv
+func displayCheckDetails(formatter *output.Formatter, result runner.CheckResult) {
+	if len(result.Files) > 0 {
+		formatter.DetailAt(output.VerbosityChecks, "Files: %d", len(result.Files))
+		formatter.FileListAt(output.VerbosityFiles, result.Files)
+	}
+	if len(result.InlineDisabled) > 0 {
+		formatter.DetailAt(output.VerbosityChecks, "Disabled inline: %s", formatter.FormatFileList(result.InlineDisabled, 3))
+	}
+	for _, command := range result.Commands {
+		formatter.DetailAt(output.VerbosityCommands, "$ %s", command)
+	}
+}
+
+// displayLineLimitSkips notes the files a check left out because they exceed
+// its GO_PRE_COMMIT_CHECK_MAX_LINES limit
+func displayLineLimitSkips(formatter *output.Formatter, result runner.CheckResult) {
+	if len(result.LineLimitSkipped) == 0 {
+		return
+	}
+	formatter.Detail("Skipped over line limit: %s", formatter.FormatFileList(result.LineLimitSkipped, 3))
+}
+
 // displayResultSummary prints the execution-statistics summary line, colored by
 // outcome. It is skipped in quiet mode when everything passed.
 func displayResultSummary(formatter *output.Formatter, results *runner.Results, quietMode bool) {
-	if quietMode && results.Failed == 0 {
+	if quietMode && results.Failed == 0 && results.Warned == 0 {
 		return
 	}
 
@@ -521,11 +1461,17 @@ func displayResultSummary(formatter *output.Formatter, results *runner.Results,
 	switch {
 	case results.Failed > 0:
 		formatter.Error(stats)
-	case results.Skipped > 0:
+	case results.Skipped > 0 || results.Warned > 0:
 		formatter.Warning(stats)
 	default:
 		formatter.Success(stats)
 	}
+	if results.Warned > 0 {
+		formatter.Warning("%d warn-only check(s) failed without blocking the commit", results.Warned)
+	}
+	if results.Continued > 0 {
+		formatter.Warning("%d continue-on-error check(s) failed without failing the run", results.Continued)
+	}
 }
 
 // displayErrorSummary prints a consolidated view of all failed checks with their
@@ -571,7 +1517,7 @@ func displayErrorSummary(formatter *output.Formatter, failedChecks []runner.Chec
 	}
 
 	// Overall guidance
-	formatter.Info("Run with --verbose flag to see full error details")
+	formatter.Info("Run with -vvv to see full error details")
 	formatter.Info("Fix the errors above and run the checks again")
 }
 
@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunChecksWithConfig_ReportsDuplicatePathsWhenVerbose(t *testing.T) {
+	setupFixRepo(t)
+	files := []string{"clean.txt", "./clean.txt", "clean.txt"}
+
+	app := NewCLIApp("test", "test-commit", "test-date")
+	app.config.Verbose = true
+	builder := NewCommandBuilder(app)
+
+	var err error
+	out := captureCmdOutput(t, func() {
+		err = builder.runChecksWithConfig(RunConfig{Files: files, Parallel: 1}, nil, []string{"eof"})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, "De-duplicated 3 input path(s) to 1 file(s)")
+
+	app.config.Verbose = false
+	out = captureCmdOutput(t, func() {
+		err = builder.runChecksWithConfig(RunConfig{Files: files, Parallel: 1}, nil, []string{"eof"})
+	})
+	require.NoError(t, err)
+	assert.NotContains(t, out, "De-duplicated")
+}
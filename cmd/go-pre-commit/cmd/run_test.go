@@ -1,7 +1,11 @@
 package cmd
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -10,8 +14,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
 	"github.com/mrz1836/go-pre-commit/internal/output"
 	"github.com/mrz1836/go-pre-commit/internal/runner"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
 )
 
 func TestRunCmd_CommandStructure(t *testing.T) {
@@ -27,7 +34,9 @@ func TestRunCmd_CommandStructure(t *testing.T) {
 	// Test that all expected flags exist
 	expectedFlags := []string{
 		"all-files", "files", "skip", "only", "parallel",
-		"fail-fast", "show-checks", "graceful", "progress", "quiet",
+		"fail-fast", "show-checks", "graceful", "progress", "quiet", "format",
+		"interactive", "force-all-checks", "since", "pprof", "pprof-out", "dump-filelist", "path", "webhook-url", "bootstrap", "profile",
+		"changed-only", "no-changed-only", "offline", "max-failures",
 	}
 
 	for _, flagName := range expectedFlags {
@@ -543,13 +552,18 @@ Done.`,
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			// Create output formatter
+			verbosity := output.VerbosityNormal
+			if tc.verboseMode {
+				verbosity = output.VerbosityChecks
+			}
 			formatter := output.New(output.Options{
 				ColorEnabled: false, // Disable colors for consistent testing
+				Verbosity:    verbosity,
 			})
 
 			// This should not panic and should complete successfully
 			require.NotPanics(t, func() {
-				displayEnhancedResults(formatter, tc.results, tc.quietMode, tc.verboseMode)
+				displayEnhancedResults(formatter, tc.results, tc.quietMode)
 			}, "displayEnhancedResults should not panic for case: %s", tc.description)
 
 			t.Logf("✓ %s: %s", tc.name, tc.description)
@@ -557,6 +571,186 @@ Done.`,
 	}
 }
 
+// TestDisplayEnhancedResults_GroupsCheckLogs tests that captured check output
+// is printed as one block under each check in verbose mode
+func TestDisplayEnhancedResults_GroupsCheckLogs(t *testing.T) {
+	results := &runner.Results{
+		CheckResults: []runner.CheckResult{
+			{Name: "alpha", Success: true, Log: "alpha: line 1\nalpha: line 2\n"},
+			{Name: "beta", Success: true, Log: "beta: line 1\n"},
+		},
+		Passed: 2,
+	}
+
+	var out bytes.Buffer
+	formatter := output.New(output.Options{ColorEnabled: false, Out: &out, Verbosity: output.VerbosityChecks})
+	displayEnhancedResults(formatter, results, false)
+	assert.Contains(t, out.String(), "  alpha output:\n    alpha: line 1\n    alpha: line 2\n")
+	assert.Contains(t, out.String(), "  beta output:\n    beta: line 1\n")
+
+	out.Reset()
+	formatter.SetVerbosity(output.VerbosityNormal)
+	displayEnhancedResults(formatter, results, false)
+	assert.NotContains(t, out.String(), "alpha: line 1", "check output is shown only in verbose mode")
+}
+
+// TestDisplayHookResult tests that hook output is shown at -v or on failure
+func TestDisplayHookResult(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	formatter := output.New(output.Options{ColorEnabled: false, Out: &stdout, Err: &stderr})
+
+	passed := runner.HookResult{Phase: runner.HookPhasePreRun, Command: "make warm", Output: "warmed"}
+	displayHookResult(formatter, passed, false)
+	assert.Empty(t, stdout.String(), "passing commands are quiet without -v")
+
+	formatter.SetVerbosity(output.VerbosityChecks)
+	displayHookResult(formatter, passed, false)
+	assert.Contains(t, stdout.String(), `pre-run command "make warm" passed`)
+	assert.Contains(t, stdout.String(), "    warmed\n")
+
+	stdout.Reset()
+	formatter.SetVerbosity(output.VerbosityNormal)
+	failed := runner.HookResult{Phase: runner.HookPhasePreRun, Command: "make warm", Output: "no rule", Err: errExitTest}
+	displayHookResult(formatter, failed, true)
+	assert.Contains(t, stderr.String(), `pre-run command "make warm" failed`)
+	assert.Contains(t, stdout.String(), "    no rule\n", "failure output is shown even in quiet mode")
+
+	stdout.Reset()
+	stderr.Reset()
+	failed.Phase = runner.HookPhasePostRun
+	displayHookResult(formatter, failed, false)
+	assert.Empty(t, stderr.String(), "post-run failures are listed with the run's warnings")
+	assert.Contains(t, stdout.String(), "    no rule\n")
+}
+
+// TestDisplayEnhancedResults_Warnings tests that run-level warnings are shown,
+// even in quiet mode
+func TestDisplayEnhancedResults_Warnings(t *testing.T) {
+	results := &runner.Results{
+		CheckResults: []runner.CheckResult{{Name: "fumpt", Success: true}},
+		Passed:       1,
+		Warnings:     []string{".golangci.yml enables gofumpt, which can conflict with the standalone fumpt check"},
+	}
+
+	for _, quiet := range []bool{false, true} {
+		var stderr bytes.Buffer
+		formatter := output.New(output.Options{ColorEnabled: false, Out: &bytes.Buffer{}, Err: &stderr})
+		displayEnhancedResults(formatter, results, quiet)
+		assert.Contains(t, stderr.String(), "⚠ .golangci.yml enables gofumpt", "quiet=%v", quiet)
+	}
+}
+
+// TestDisplayReport tests that reporting on failure keeps an all-pass run to
+// its summary but shows every check once something fails
+func TestDisplayReport(t *testing.T) {
+	passing := &runner.Results{
+		CheckResults: []runner.CheckResult{
+			{Name: "fumpt", Success: true, Duration: time.Second},
+			{Name: "lint", Success: true, Duration: time.Second},
+		},
+		Passed:   2,
+		Warnings: []string{"lint config is stale"},
+	}
+	mixed := &runner.Results{
+		CheckResults: []runner.CheckResult{
+			{Name: "fumpt", Success: true, Duration: time.Second},
+			{Name: "lint", Error: "2 issues found", Suggestion: "Fix the lint issues", Duration: time.Second},
+		},
+		Passed: 1,
+		Failed: 1,
+	}
+	skipped := &runner.Results{
+		CheckResults: []runner.CheckResult{{Name: "lint", Success: true, Skipped: true, Error: "no Go files"}},
+		Skipped:      1,
+	}
+
+	render := func(results *runner.Results, reportOn string) string {
+		var stdout, stderr bytes.Buffer
+		formatter := output.New(output.Options{ColorEnabled: false, Out: &stdout, Err: &stderr})
+		displayReport(formatter, results, reportOn, false)
+		return stdout.String() + stderr.String()
+	}
+
+	t.Run("all pass is quiet", func(t *testing.T) {
+		out := render(passing, config.ReportOnFailure)
+		assert.NotContains(t, out, "completed successfully")
+		assert.NotContains(t, out, "Check Results")
+		assert.Contains(t, out, "lint config is stale")
+	})
+
+	t.Run("mixed run is detailed", func(t *testing.T) {
+		out := render(mixed, config.ReportOnFailure)
+		assert.Contains(t, out, "Check Results")
+		assert.Contains(t, out, "fumpt completed successfully")
+		assert.Contains(t, out, "lint failed")
+		assert.Contains(t, out, "2 issues found")
+		assert.Contains(t, out, "Summary")
+	})
+
+	t.Run("all skipped still gets a summary", func(t *testing.T) {
+		out := render(skipped, config.ReportOnFailure)
+		assert.NotContains(t, out, "lint skipped")
+		assert.Contains(t, out, "Summary")
+	})
+
+	t.Run("always shows every check", func(t *testing.T) {
+		out := render(passing, config.ReportOnAlways)
+		assert.Contains(t, out, "fumpt completed successfully")
+		assert.Contains(t, out, "lint completed successfully")
+	})
+}
+
+func TestWritePlanJSON(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writePlanJSON(&buf, &runner.Plan{
+		TotalFiles:  3,
+		EstimatedMS: 2000,
+		Checks: []runner.PlannedCheck{
+			{Name: "lint", Runs: true, Files: 3, NeedsTools: true, Tools: []string{"lint"}, EstimatedMS: 2000, TimeoutMS: 60000},
+			{Name: "whitespace", SkipReason: "no matching files to check", TimeoutMS: 30000},
+		},
+	}))
+
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.InDelta(t, 3, decoded["total_files"], 0)
+	checks, ok := decoded["checks"].([]any)
+	require.True(t, ok)
+	require.Len(t, checks, 2)
+	lint, ok := checks[0].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, true, lint["runs"])
+	assert.Equal(t, []any{"lint"}, lint["tools"])
+	whitespace, ok := checks[1].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "no matching files to check", whitespace["skip_reason"])
+	assert.NotContains(t, whitespace, "tools")
+}
+
+func TestDisplayFileLists(t *testing.T) {
+	lists := []runner.CheckFileList{
+		{
+			Name:  "whitespace",
+			Input: []string{"main.go", "logo.png", "vendor/x.go"},
+			Excluded: []runner.ExcludedFile{
+				{File: "logo.png", Reason: "binary"},
+				{File: "vendor/x.go", Reason: "excluded-pattern", Detail: "matched exclude pattern vendor/"},
+			},
+			Files: []string{"main.go"},
+		},
+	}
+
+	var out bytes.Buffer
+	formatter := output.New(output.Options{ColorEnabled: false, Out: &out})
+	displayFileLists(formatter, lists)
+
+	text := out.String()
+	assert.Contains(t, text, "whitespace:\n")
+	assert.Contains(t, text, "  Input (3):\n    main.go\n    logo.png\n    vendor/x.go\n")
+	assert.Contains(t, text, "  Excluded (2):\n    logo.png (binary)\n    vendor/x.go (excluded-pattern: matched exclude pattern vendor/)\n")
+	assert.Contains(t, text, "  Checked (1):\n    main.go\n")
+}
+
 // TestExtractKeyErrorLines tests the error extraction functionality
 func TestExtractKeyErrorLines(t *testing.T) {
 	testCases := []struct {
@@ -1004,3 +1198,136 @@ func TestRunCmd_ColorModeConfiguration(t *testing.T) {
 		})
 	}
 }
+
+func TestRunCmd_UnknownFormat(t *testing.T) {
+	app := NewCLIApp("test", "test-commit", "test-date")
+	builder := NewCommandBuilder(app)
+
+	err := builder.runChecksWithConfig(RunConfig{Format: "xml"}, nil, nil)
+	require.Error(t, err)
+	require.ErrorIs(t, err, ErrUnknownOutputFormat)
+	assert.Contains(t, err.Error(), "xml")
+}
+
+func TestRunCmd_UnknownReportPolicy(t *testing.T) {
+	app := NewCLIApp("test", "test-commit", "test-date")
+	builder := NewCommandBuilder(app)
+
+	err := builder.runChecksWithConfig(RunConfig{Format: outputFormatText, ReportOn: "never"}, nil, nil)
+	require.ErrorIs(t, err, ErrUnknownReportPolicy)
+	assert.Contains(t, err.Error(), "never")
+}
+
+func TestRunCmd_NegativeSince(t *testing.T) {
+	app := NewCLIApp("test", "test-commit", "test-date")
+	builder := NewCommandBuilder(app)
+
+	err := builder.runChecksWithConfig(RunConfig{Since: -time.Hour}, nil, nil)
+	require.ErrorIs(t, err, ErrInvalidSince)
+}
+
+func TestRunCmd_NegativeMaxFailures(t *testing.T) {
+	app := NewCLIApp("test", "test-commit", "test-date")
+	builder := NewCommandBuilder(app)
+
+	err := builder.runChecksWithConfig(RunConfig{MaxFailures: -1}, nil, nil)
+	require.ErrorIs(t, err, ErrInvalidMaxFailures)
+}
+
+func TestRunCmd_SinceFlagParsing(t *testing.T) {
+	app := NewCLIApp("test", "test-commit", "test-date")
+	builder := NewCommandBuilder(app)
+	runCmd := builder.BuildRunCmd()
+
+	require.NoError(t, runCmd.ParseFlags([]string{"--since", "24h"}))
+	since, err := runCmd.Flags().GetDuration("since")
+	require.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, since)
+}
+
+func TestSelectFilesToCheck_Since(t *testing.T) {
+	dir := t.TempDir()
+	cmd := exec.CommandContext(context.Background(), "git", "init")
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	old := time.Now().Add(-48 * time.Hour)
+	for _, name := range []string{"fresh.go", "stale.go", "debug.log", "skip.gen"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("x\n"), 0o600))
+	}
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "stale.go"), old, old))
+
+	add := exec.CommandContext(context.Background(), "git", "add", "-f", ".")
+	add.Dir = dir
+	out, err = add.CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	cfg := &config.Config{}
+	cfg.Git.ExcludePatterns = []string{"*.gen"}
+
+	files, err := selectFilesToCheck(RunConfig{Since: 24 * time.Hour}, cfg, dir, output.NewDefault())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fresh.go"}, files, "stale files and classifier exclusions are dropped")
+}
+
+func TestRunChecksWithConfig_RepoRootOverride(t *testing.T) {
+	// The checkout has configuration but no .git, and the command runs elsewhere
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, ".github"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(root, ".github", ".env.base"), []byte("ENABLE_GO_PRE_COMMIT=true\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "clean.txt"), []byte("clean\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "noeol.txt"), []byte("no newline"), 0o600))
+	t.Chdir(t.TempDir())
+	t.Setenv("GO_PRE_COMMIT_ENABLE_FUMPT", "false")
+
+	builder := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date"))
+	run := func() error {
+		var err error
+		captureCmdOutput(t, func() {
+			err = builder.runChecksWithConfig(RunConfig{Files: []string{"clean.txt", "noeol.txt"}, Parallel: 1}, nil, []string{"eof"})
+		})
+		return err
+	}
+
+	t.Setenv(shared.RepoRootEnv, "")
+	require.Error(t, run(), "without the override there is no repository to find")
+
+	t.Setenv(shared.RepoRootEnv, root)
+	require.ErrorIs(t, run(), prerrors.ErrChecksFailed, "the first run fixes noeol.txt")
+	require.NoError(t, run())
+	content, err := os.ReadFile(filepath.Join(root, "noeol.txt")) //nolint:gosec // test path
+	require.NoError(t, err)
+	assert.Equal(t, "no newline\n", string(content))
+
+	t.Setenv(shared.RepoRootEnv, filepath.Join(root, "missing"))
+	require.Error(t, run())
+}
+
+func TestIsValidOutputFormat(t *testing.T) {
+	assert.True(t, isValidOutputFormat(""))
+	assert.True(t, isValidOutputFormat(outputFormatText))
+	assert.True(t, isValidOutputFormat(outputFormatTAP))
+	assert.False(t, isValidOutputFormat("junit"))
+}
+
+func TestNewFormatter_OutputDest(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.log")
+
+	app := NewCLIApp("test", "test-commit", "test-date")
+	app.config.OutputDest = "file:" + path
+	builder := NewCommandBuilder(app)
+
+	formatter, err := builder.newFormatter(&config.Config{})
+	require.NoError(t, err)
+	formatter.Success("all checks passed")
+	require.NoError(t, formatter.Close())
+
+	content, err := os.ReadFile(path) //nolint:gosec // test file in temp dir
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "all checks passed")
+
+	app.config.OutputDest = "nowhere"
+	_, err = builder.newFormatter(&config.Config{})
+	require.ErrorIs(t, err, output.ErrUnknownSink)
+}
@@ -0,0 +1,20 @@
+package cmd
+
+import (
+	"context"
+	"os"
+
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+	"github.com/mrz1836/go-pre-commit/internal/sarif"
+)
+
+// uploadSARIF uploads the run's findings as SARIF to GitHub code scanning for
+// the repository and ref named by the environment. It is best effort: the
+// caller reports the error but never lets it change the run's outcome.
+func (cb *CommandBuilder) uploadSARIF(ctx context.Context, results *runner.Results) error {
+	target, err := sarif.TargetFromEnv(os.Getenv)
+	if err != nil {
+		return err
+	}
+	return sarif.Upload(ctx, nil, target, sarif.Build(results, cb.app.version), cb.app.version)
+}
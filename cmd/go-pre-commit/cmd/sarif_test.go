@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setSARIFTarget points the code scanning upload at apiURL
+func setSARIFTarget(t *testing.T, apiURL string) {
+	t.Helper()
+	t.Setenv("GITHUB_API_URL", apiURL)
+	t.Setenv("GITHUB_TOKEN", "secret")
+	t.Setenv("GITHUB_REPOSITORY", "example/project")
+	t.Setenv("GITHUB_SHA", "abc123")
+	t.Setenv("GITHUB_REF", "refs/heads/main")
+}
+
+func TestRunChecksWithConfig_UploadsSARIF(t *testing.T) {
+	setupFixRepo(t)
+
+	paths := make(chan string, 1)
+	bodies := make(chan map[string]string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		if json.NewDecoder(r.Body).Decode(&body) == nil {
+			paths <- r.URL.Path
+			bodies <- body
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+	setSARIFTarget(t, server.URL)
+
+	builder := NewCommandBuilder(NewCLIApp("v9.9.9", "test-commit", "test-date"))
+	err := builder.runChecksWithConfig(RunConfig{Files: []string{"clean.txt"}, Parallel: 1, UploadSARIF: true}, nil, []string{"eof"})
+	require.NoError(t, err)
+
+	require.Len(t, bodies, 1)
+	assert.Equal(t, "/repos/example/project/code-scanning/sarifs", <-paths)
+	body := <-bodies
+	assert.Equal(t, "abc123", body["commit_sha"])
+	assert.Equal(t, "refs/heads/main", body["ref"])
+	assert.NotEmpty(t, body["sarif"])
+}
+
+func TestRunChecksWithConfig_SARIFUploadFailureDoesNotChangeOutcome(t *testing.T) {
+	setupFixRepo(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+	setSARIFTarget(t, server.URL)
+
+	builder := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date"))
+	err := builder.runChecksWithConfig(RunConfig{Files: []string{"clean.txt"}, Parallel: 1, UploadSARIF: true}, nil, []string{"eof"})
+	require.NoError(t, err, "a failed upload must not fail a passing run")
+
+	t.Setenv("GITHUB_TOKEN", "")
+	err = builder.runChecksWithConfig(RunConfig{Files: []string{"clean.txt"}, Parallel: 1, UploadSARIF: true}, nil, []string{"eof"})
+	require.NoError(t, err, "a missing token must not fail a passing run")
+
+	err = builder.runChecksWithConfig(RunConfig{Files: []string{"noeol.md"}, Parallel: 1, UploadSARIF: true}, nil, []string{"eof"})
+	require.Error(t, err, "a failing run still fails")
+}
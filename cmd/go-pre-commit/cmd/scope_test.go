@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+func TestScopeFromFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    string
+		wantErr bool
+	}{
+		{"no flags", nil, "", false},
+		{"changed-only", []string{"--changed-only"}, config.ScopeChanged, false},
+		{"changed-only=false", []string{"--changed-only=false"}, config.ScopeAll, false},
+		{"no-changed-only", []string{"--no-changed-only"}, config.ScopeAll, false},
+		{"both agreeing", []string{"--changed-only=false", "--no-changed-only"}, config.ScopeAll, false},
+		{"both conflicting", []string{"--changed-only", "--no-changed-only"}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date")).BuildRunCmd()
+			require.NoError(t, cmd.ParseFlags(tt.args))
+
+			scope, err := scopeFromFlags(cmd)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrConflictingScope)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, scope)
+		})
+	}
+}
+
+func TestApplyScope(t *testing.T) {
+	tests := []struct {
+		name         string
+		defaultScope string
+		runConfig    RunConfig
+		wantAllFiles bool
+	}{
+		{"default scope changed", config.ScopeChanged, RunConfig{}, false},
+		{"default scope all", config.ScopeAll, RunConfig{}, true},
+		{"unset default scope means changed", "", RunConfig{}, false},
+		{"flag overrides default all", config.ScopeAll, RunConfig{Scope: config.ScopeChanged}, false},
+		{"flag overrides default changed", config.ScopeChanged, RunConfig{Scope: config.ScopeAll}, true},
+		{"all-files wins over default", config.ScopeChanged, RunConfig{AllFiles: true}, true},
+		{"files win over scope all", config.ScopeAll, RunConfig{Scope: config.ScopeAll, Files: []string{"a.go"}}, false},
+		{"since wins over default all", config.ScopeAll, RunConfig{Since: time.Hour}, false},
+		{"write-baseline forces all files", config.ScopeChanged, RunConfig{Scope: config.ScopeChanged, AllFiles: true, WriteBaseline: true}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{DefaultScope: tt.defaultScope}
+			runConfig := tt.runConfig
+			require.NoError(t, applyScope(&runConfig, cfg))
+			assert.Equal(t, tt.wantAllFiles, runConfig.AllFiles)
+		})
+	}
+
+	t.Run("changed-only conflicts with all-files", func(t *testing.T) {
+		runConfig := RunConfig{Scope: config.ScopeChanged, AllFiles: true}
+		require.ErrorIs(t, applyScope(&runConfig, &config.Config{}), ErrConflictingScope)
+	})
+}
+
+func TestRunChecksWithConfig_DefaultScope(t *testing.T) {
+	dir := setupFixRepo(t)
+	gitCmd(t, dir, "commit", "-q", "-m", "initial")
+	// clean.txt stays committed and unstaged; trailing.txt is committed with
+	// trailing whitespace, so only an all-files run sees a failure
+	t.Setenv("GO_PRE_COMMIT_ENABLE_WHITESPACE", "true")
+	t.Setenv("GO_PRE_COMMIT_WHITESPACE_AUTO_STAGE", "false")
+	t.Setenv("GO_PRE_COMMIT_ENABLE_EOF", "false")
+	t.Setenv("GO_PRE_COMMIT_ENABLE_LINT", "false")
+	t.Setenv("GO_PRE_COMMIT_ENABLE_MOD_TIDY", "false")
+
+	run := func(scope string) error {
+		builder := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date"))
+		return builder.runChecksWithConfig(RunConfig{Parallel: 1, Scope: scope}, nil, nil)
+	}
+
+	t.Run("changed scope checks nothing when nothing is staged", func(t *testing.T) {
+		t.Setenv("GO_PRE_COMMIT_DEFAULT_SCOPE", config.ScopeChanged)
+		assert.NoError(t, run(""))
+	})
+
+	t.Run("all scope from config checks every file", func(t *testing.T) {
+		t.Setenv("GO_PRE_COMMIT_DEFAULT_SCOPE", config.ScopeAll)
+		require.Error(t, run(""))
+		gitCmd(t, dir, "checkout", "--", ".")
+	})
+
+	t.Run("changed-only flag overrides config", func(t *testing.T) {
+		t.Setenv("GO_PRE_COMMIT_DEFAULT_SCOPE", config.ScopeAll)
+		assert.NoError(t, run(config.ScopeChanged))
+	})
+
+	t.Run("no-changed-only flag overrides config", func(t *testing.T) {
+		t.Setenv("GO_PRE_COMMIT_DEFAULT_SCOPE", config.ScopeChanged)
+		require.Error(t, run(config.ScopeAll))
+		gitCmd(t, dir, "checkout", "--", ".")
+	})
+}
@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/go-pre-commit/internal/git"
+	"github.com/mrz1836/go-pre-commit/internal/update"
+	"github.com/mrz1836/go-pre-commit/internal/version"
+)
+
+// ErrSelfUpdateInHook is returned when self-update runs inside a git hook,
+// where replacing the binary would swap it out from under the commit
+var ErrSelfUpdateInHook = errors.New("self-update cannot run inside a git hook")
+
+// hookEnvVars are set while git runs a commit hook (GIT_INDEX_FILE), while
+// the installed hook script runs go-pre-commit, or while go-pre-commit runs
+// its own pre/post-run commands
+//
+//nolint:gochecknoglobals // Read-only list
+var hookEnvVars = []string{"GIT_INDEX_FILE", git.HookMarkerEnv, "GO_PRE_COMMIT_HOOK_PHASE"}
+
+// binaryDownloader installs the release binary for a version over target.
+// Tests inject a fake to avoid network access and replacing real binaries.
+type binaryDownloader func(ctx context.Context, releaseVersion, target string) error
+
+// defaultBinaryDownload is the production downloader: it fetches the GitHub
+// release archive for the current platform and verifies its checksum
+func defaultBinaryDownload(ctx context.Context, releaseVersion, target string) error {
+	return update.NewDownloader().Install(ctx, releaseVersion, target)
+}
+
+// SelfUpdateConfig holds configuration for the self-update command
+type SelfUpdateConfig struct {
+	Version string // release to install instead of the latest
+	Force   bool
+}
+
+// BuildSelfUpdateCmd creates the self-update command
+func (cb *CommandBuilder) BuildSelfUpdateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "self-update",
+		Short: "Replace this binary with a GitHub release binary",
+		Long: `Download a go-pre-commit release binary for this OS and architecture and
+replace the running binary with it.
+
+This command will:
+  - Resolve the latest release on GitHub, or the one given with --version
+  - Download the release archive and verify it against the release checksums
+  - Atomically replace the running binary
+
+Unlike 'upgrade', it does not need a Go toolchain. It refuses to run inside
+a git hook.`,
+		Example: `  # Update to the latest release
+  go-pre-commit self-update
+
+  # Install a specific release
+  go-pre-commit self-update --version v1.2.0
+
+  # Reinstall even if already on the latest release
+  go-pre-commit self-update --force`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			config := SelfUpdateConfig{}
+			var err error
+
+			config.Version, err = cmd.Flags().GetString("version")
+			if err != nil {
+				return err
+			}
+
+			config.Force, err = cmd.Flags().GetBool("force")
+			if err != nil {
+				return err
+			}
+
+			return cb.runSelfUpdate(commandContext(cmd), config)
+		},
+	}
+
+	cmd.Flags().String("version", "", "Release to install (e.g. v1.2.0) instead of the latest")
+	cmd.Flags().BoolP("force", "f", false, "Reinstall even if already on the requested version")
+
+	return cmd
+}
+
+func (cb *CommandBuilder) runSelfUpdate(ctx context.Context, config SelfUpdateConfig) error {
+	for _, name := range hookEnvVars {
+		if os.Getenv(name) != "" {
+			return fmt.Errorf("%w (%s is set)", ErrSelfUpdateInHook, name)
+		}
+	}
+
+	currentVersion := cb.app.version
+	isDev := currentVersion == versionDev || currentVersion == "" || isLikelyCommitHash(currentVersion)
+	if isDev && config.Version == "" && !config.Force {
+		printWarning("Current version appears to be a development build (%s)", currentVersion)
+		printInfo("Use --force or --version to replace it with a release")
+		return ErrDevVersionNoForce
+	}
+
+	targetVersion := strings.TrimPrefix(config.Version, "v")
+	if targetVersion == "" {
+		printInfo("Checking for updates...")
+		release, err := cb.fetchRelease("mrz1836", "go-pre-commit", currentVersion)
+		if err != nil {
+			return fmt.Errorf("failed to check for updates: %w", err)
+		}
+		targetVersion = strings.TrimPrefix(release.TagName, "v")
+	}
+
+	if !config.Force && version.CompareVersions(targetVersion, currentVersion) == 0 {
+		printSuccess("Already on version %s", formatVersion(targetVersion))
+		return nil
+	}
+	if !config.Force && config.Version == "" && !version.IsNewerVersion(currentVersion, targetVersion) {
+		printSuccess("You are already on the latest version (%s)", formatVersion(currentVersion))
+		return nil
+	}
+
+	target, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to locate the running binary: %w", err)
+	}
+	if resolved, resolveErr := filepath.EvalSymlinks(target); resolveErr == nil {
+		target = resolved
+	}
+
+	printInfo("Installing %s to %s...", formatVersion(targetVersion), target)
+	if err := cb.downloadBinary(ctx, targetVersion, target); err != nil {
+		return fmt.Errorf("failed to self-update: %w", err)
+	}
+
+	// Clear the update cache so the banner does not announce the version
+	// that was just installed
+	if err := update.ClearCache(); err != nil && cb.app.config.Verbose {
+		printWarning("Failed to clear update cache: %v", err)
+	}
+
+	printSuccess("Successfully updated to version %s", formatVersion(targetVersion))
+	return nil
+}
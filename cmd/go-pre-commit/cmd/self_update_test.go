@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordDownloads returns a binaryDownloader that records each requested
+// version and target without touching the network or the binary
+func recordDownloads(versions, targets *[]string) binaryDownloader {
+	return func(_ context.Context, releaseVersion, target string) error {
+		*versions = append(*versions, releaseVersion)
+		*targets = append(*targets, target)
+		return nil
+	}
+}
+
+// newSelfUpdateBuilder builds a CommandBuilder for self-update tests, with
+// hook variables cleared and HOME isolated so the update cache stays local
+func newSelfUpdateBuilder(t *testing.T, current string, fetch releaseFetcher, download binaryDownloader) *CommandBuilder {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	for _, name := range hookEnvVars {
+		t.Setenv(name, "")
+	}
+	builder := newTestBuilder(current, fetch, nil)
+	builder.downloadBinary = download
+	return builder
+}
+
+func TestBuildSelfUpdateCmd(t *testing.T) {
+	cmd := NewCommandBuilder(NewCLIApp("1.0.0", "abc123", "2024-01-01")).BuildSelfUpdateCmd()
+
+	assert.Equal(t, "self-update", cmd.Use)
+	require.NotNil(t, cmd.Flags().Lookup("version"))
+	require.NotNil(t, cmd.Flags().Lookup("force"))
+}
+
+func TestSelfUpdate(t *testing.T) {
+	executable, err := os.Executable()
+	require.NoError(t, err)
+	if resolved, resolveErr := filepath.EvalSymlinks(executable); resolveErr == nil {
+		executable = resolved
+	}
+
+	t.Run("installs the latest release over the running binary", func(t *testing.T) {
+		var versions, targets []string
+		builder := newSelfUpdateBuilder(t, "1.0.0", stubRelease("v1.2.0"), recordDownloads(&versions, &targets))
+
+		require.NoError(t, builder.runSelfUpdate(context.Background(), SelfUpdateConfig{}))
+		assert.Equal(t, []string{"1.2.0"}, versions)
+		assert.Equal(t, []string{executable}, targets)
+	})
+
+	t.Run("already on the latest release", func(t *testing.T) {
+		var versions, targets []string
+		builder := newSelfUpdateBuilder(t, "1.2.0", stubRelease("v1.2.0"), recordDownloads(&versions, &targets))
+
+		require.NoError(t, builder.runSelfUpdate(context.Background(), SelfUpdateConfig{}))
+		assert.Empty(t, versions)
+
+		require.NoError(t, builder.runSelfUpdate(context.Background(), SelfUpdateConfig{Force: true}))
+		assert.Equal(t, []string{"1.2.0"}, versions, "--force reinstalls")
+	})
+
+	t.Run("--version pins the release without asking GitHub", func(t *testing.T) {
+		var versions, targets []string
+		builder := newSelfUpdateBuilder(t, "1.2.0", errRelease(errTestFetch), recordDownloads(&versions, &targets))
+
+		require.NoError(t, builder.runSelfUpdate(context.Background(), SelfUpdateConfig{Version: "v1.1.0"}))
+		assert.Equal(t, []string{"1.1.0"}, versions, "pinning allows a downgrade")
+	})
+
+	t.Run("fetch errors are wrapped", func(t *testing.T) {
+		var versions, targets []string
+		builder := newSelfUpdateBuilder(t, "1.0.0", errRelease(errTestFetch), recordDownloads(&versions, &targets))
+
+		err := builder.runSelfUpdate(context.Background(), SelfUpdateConfig{})
+		require.ErrorIs(t, err, errTestFetch)
+		assert.Empty(t, versions)
+	})
+
+	t.Run("development builds need --force or --version", func(t *testing.T) {
+		var versions, targets []string
+		builder := newSelfUpdateBuilder(t, versionDev, stubRelease("v1.2.0"), recordDownloads(&versions, &targets))
+
+		err := builder.runSelfUpdate(context.Background(), SelfUpdateConfig{})
+		require.ErrorIs(t, err, ErrDevVersionNoForce)
+		assert.Empty(t, versions)
+	})
+
+	t.Run("refuses to run inside a git hook", func(t *testing.T) {
+		var versions, targets []string
+		builder := newSelfUpdateBuilder(t, "1.0.0", stubRelease("v1.2.0"), recordDownloads(&versions, &targets))
+		t.Setenv("GIT_INDEX_FILE", ".git/index")
+
+		err := builder.runSelfUpdate(context.Background(), SelfUpdateConfig{})
+		require.ErrorIs(t, err, ErrSelfUpdateInHook)
+		assert.Empty(t, versions)
+	})
+}
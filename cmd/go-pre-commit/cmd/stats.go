@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	"github.com/mrz1836/go-pre-commit/internal/git"
+	"github.com/mrz1836/go-pre-commit/internal/output"
+)
+
+// StatsConfig holds configuration for the stats command
+type StatsConfig struct {
+	AllFiles bool
+	Files    []string
+	JSON     bool
+}
+
+// fileStatsReport is the --json form of the stats command's counts
+type fileStatsReport struct {
+	Total     int            `json:"total"`
+	Text      int            `json:"text"`
+	Binary    int            `json:"binary"`
+	Go        int            `json:"go"`
+	Generated int            `json:"generated"`
+	Excluded  int            `json:"excluded"`
+	Languages map[string]int `json:"languages"`
+}
+
+// newFileStatsReport arranges GetFileStats counts for JSON, gathering the
+// "lang_" counts under languages
+func newFileStatsReport(stats map[string]int) fileStatsReport {
+	report := fileStatsReport{
+		Total:     stats["total"],
+		Text:      stats["text"],
+		Binary:    stats["binary"],
+		Go:        stats["go"],
+		Generated: stats["generated"],
+		Excluded:  stats["excluded"],
+		Languages: map[string]int{},
+	}
+	for key, count := range stats {
+		if language, ok := strings.CutPrefix(key, "lang_"); ok {
+			report.Languages[language] = count
+		}
+	}
+	return report
+}
+
+// BuildStatsCmd creates the stats command
+func (cb *CommandBuilder) BuildStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats [flags]",
+		Short: "Summarize the files checks would see",
+		Long: `Classify files the way checks do and print how many there are of each kind:
+the total, text and binary files, Go files, generated files, files excluded by
+the default or configured exclude patterns, and a count per language.
+
+Staged files are counted by default, or all files with --all-files. Text and
+binary counts leave out excluded files; files that no longer exist are not
+counted at all.`,
+		Example: `  # Summarize the staged files
+  go-pre-commit stats
+
+  # Summarize the whole repository as JSON
+  go-pre-commit stats --all-files --json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			statsConfig := StatsConfig{}
+			var err error
+
+			statsConfig.AllFiles, err = cmd.Flags().GetBool("all-files")
+			if err != nil {
+				return err
+			}
+
+			statsConfig.Files, err = cmd.Flags().GetStringSlice("files")
+			if err != nil {
+				return err
+			}
+
+			statsConfig.JSON, err = cmd.Flags().GetBool("json")
+			if err != nil {
+				return err
+			}
+
+			return cb.runStats(commandContext(cmd), cmd.OutOrStdout(), statsConfig)
+		},
+	}
+
+	cmd.Flags().BoolP("all-files", "a", false, "Summarize all files in the repository")
+	cmd.Flags().StringSliceP("files", "f", nil, "Specific files to summarize")
+	cmd.Flags().Bool("json", false, "Print the counts as JSON")
+
+	return cmd
+}
+
+func (cb *CommandBuilder) runStats(ctx context.Context, out io.Writer, statsConfig StatsConfig) error {
+	cfg, err := config.Load()
+	if err != nil {
+		output.NewDefault().Error("Failed to load configuration: %v", err)
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	formatter, err := cb.newFormatter(cfg)
+	if err != nil {
+		output.NewDefault().Error("Invalid output destination: %v", err)
+		return err
+	}
+	defer func() { _ = formatter.Close() }()
+
+	repoRoot, err := git.FindRepositoryRoot()
+	if err != nil {
+		formatter.Error("Failed to find git repository: %v", err)
+		return fmt.Errorf("failed to find git repository: %w", err)
+	}
+
+	files, err := selectFilesToCheck(RunConfig{AllFiles: statsConfig.AllFiles, Files: statsConfig.Files}, cfg, repoRoot, formatter)
+	if err != nil {
+		return err
+	}
+
+	stats, err := repoFileStats(ctx, cfg, repoRoot, files)
+	if err != nil {
+		formatter.Error("Failed to classify files: %v", err)
+		return fmt.Errorf("failed to classify files: %w", err)
+	}
+
+	if statsConfig.JSON {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(newFileStatsReport(stats))
+	}
+	_, err = io.WriteString(out, git.NewFileClassifier(cfg).FormatStats(stats))
+	return err
+}
+
+// repoFileStats classifies repository-relative files from repoRoot and
+// returns their GetFileStats counts
+func repoFileStats(ctx context.Context, cfg *config.Config, repoRoot string, files []string) (map[string]int, error) {
+	resolved := make([]string, len(files))
+	for i, file := range files {
+		resolved[i] = filepath.Join(repoRoot, file)
+	}
+	return git.NewFileClassifier(cfg).GetFileStats(ctx, resolved)
+}
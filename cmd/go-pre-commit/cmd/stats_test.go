@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	"github.com/mrz1836/go-pre-commit/internal/git"
+)
+
+// setupStatsRepo creates a repository holding Go, Markdown, generated,
+// binary, and excluded files, all of them staged
+func setupStatsRepo(t *testing.T) string {
+	t.Helper()
+	dir := setupFixRepo(t)
+
+	files := map[string][]byte{
+		"main.go":        []byte("package main\n"),
+		"gen.pb.go":      []byte("// Code generated by protoc-gen-go. DO NOT EDIT.\n\npackage main\n"),
+		"vendor/x/x.go":  []byte("package x\n"),
+		"logo.bin":       {0x00, 0x01, 0x02, 0xFF},
+		"debug.log":      []byte("log line\n"),
+		"docs/guide.md":  []byte("# Guide\n"),
+		"docs/notes.txt": []byte("notes\n"),
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+		require.NoError(t, os.WriteFile(path, content, 0o600))
+	}
+	gitCmd(t, dir, "add", "-f", ".")
+	return dir
+}
+
+// runStatsCmd runs the stats command with args and returns its output
+func runStatsCmd(t *testing.T, args ...string) string {
+	t.Helper()
+	cmd := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date")).BuildStatsCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs(args)
+	require.NoError(t, cmd.Execute())
+	return out.String()
+}
+
+func TestStatsCmdMatchesGetFileStats(t *testing.T) {
+	dir := setupStatsRepo(t)
+
+	cfg, err := config.Load()
+	require.NoError(t, err)
+	files, err := git.NewRepository(dir).GetAllFiles()
+	require.NoError(t, err)
+	expected, err := repoFileStats(context.Background(), cfg, dir, files)
+	require.NoError(t, err)
+	require.Positive(t, expected["generated"])
+	require.Positive(t, expected["binary"])
+	require.Positive(t, expected["excluded"])
+
+	var report fileStatsReport
+	require.NoError(t, json.Unmarshal([]byte(runStatsCmd(t, "--all-files", "--json")), &report))
+	assert.Equal(t, newFileStatsReport(expected), report)
+	assert.Equal(t, len(files), report.Total)
+	assert.Equal(t, expected["lang_go"], report.Languages["go"])
+	assert.Equal(t, expected["lang_markdown"], report.Languages["markdown"])
+
+	assert.Equal(t, git.NewFileClassifier(cfg).FormatStats(expected), runStatsCmd(t, "--all-files"))
+}
+
+func TestStatsCmdFileSelection(t *testing.T) {
+	dir := setupStatsRepo(t)
+
+	var report fileStatsReport
+	require.NoError(t, json.Unmarshal([]byte(runStatsCmd(t, "--json", "--files", "main.go,docs/guide.md,missing.go")), &report))
+	assert.Equal(t, 2, report.Total, "files that do not exist are not counted")
+	assert.Equal(t, 1, report.Go)
+	assert.Equal(t, map[string]int{"go": 1, "markdown": 1}, report.Languages)
+
+	gitCmd(t, dir, "commit", "-q", "-m", "initial")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "docs", "guide.md"), []byte("# Guide\n\nMore.\n"), 0o600))
+	gitCmd(t, dir, "add", "docs/guide.md")
+
+	var staged fileStatsReport
+	require.NoError(t, json.Unmarshal([]byte(runStatsCmd(t, "--json")), &staged))
+	assert.Equal(t, 1, staged.Total, "staged files by default")
+	assert.Equal(t, map[string]int{"markdown": 1}, staged.Languages)
+}
@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+)
+
+// envGitHubStepSummary names the file GitHub Actions renders as the job
+// summary; every step may append markdown to it
+const envGitHubStepSummary = "GITHUB_STEP_SUMMARY"
+
+// appendStepSummary appends the results as a markdown table to the file
+// $GITHUB_STEP_SUMMARY points at. Outside GitHub Actions, where the variable
+// is unset, it does nothing.
+func appendStepSummary(results *runner.Results) (err error) {
+	path := os.Getenv(envGitHubStepSummary)
+	if path == "" {
+		return nil
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600) //nolint:gosec // path set by the GitHub Actions runner
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, file.Close())
+	}()
+	return results.WriteMarkdownSummary(file)
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+)
+
+func TestAppendStepSummary(t *testing.T) {
+	results := &runner.Results{
+		CheckResults: []runner.CheckResult{{Name: "eof", Success: true}},
+		Passed:       1,
+	}
+
+	t.Run("outside GitHub Actions", func(t *testing.T) {
+		t.Setenv(envGitHubStepSummary, "")
+		require.NoError(t, appendStepSummary(results))
+	})
+
+	t.Run("unwritable file", func(t *testing.T) {
+		t.Setenv(envGitHubStepSummary, filepath.Join(t.TempDir(), "missing", "summary.md"))
+		require.Error(t, appendStepSummary(results))
+	})
+}
+
+func TestRunChecks_AppendsGitHubStepSummary(t *testing.T) {
+	setupFixRepo(t)
+
+	summaryPath := filepath.Join(t.TempDir(), "step_summary.md")
+	require.NoError(t, os.WriteFile(summaryPath, []byte("## Earlier step\n\n"), 0o600))
+	t.Setenv(envGitHubStepSummary, summaryPath)
+
+	builder := NewCommandBuilder(NewCLIApp("test", "test-commit", "2024-01-01"))
+	builder.app.config.OutputDest = "file:" + filepath.Join(t.TempDir(), "run.log")
+	err := builder.runChecksWithConfig(RunConfig{
+		Profile:  config.ProfileFast,
+		Files:    []string{"trailing.txt", "clean.txt"},
+		Parallel: 1,
+	}, nil, nil)
+	require.Error(t, err, "trailing.txt is fixed, which fails the run")
+
+	content, err := os.ReadFile(summaryPath) //nolint:gosec // test path
+	require.NoError(t, err)
+	summary := string(content)
+	assert.Contains(t, summary, "## Earlier step\n\n### go-pre-commit\n", "the summary is appended, not overwritten")
+	assert.Contains(t, summary, "| Check | Status | Duration | Files |")
+	assert.Contains(t, summary, "| whitespace | 🔧 fixed, re-stage |")
+	assert.Contains(t, summary, "| eof | ✅ passed |")
+	assert.Contains(t, summary, "`trailing.txt`, `clean.txt`")
+	assert.Contains(t, summary, "**1 passed, 1 failed, 0 skipped**")
+}
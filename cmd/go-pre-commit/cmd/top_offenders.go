@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	"github.com/mrz1836/go-pre-commit/internal/output"
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+)
+
+// ErrInvalidTopOffenders is returned when --top-offenders is negative
+var ErrInvalidTopOffenders = errors.New("--top-offenders must be 0 (off) or greater")
+
+// applyReportOnly configures the checks to report problems without fixing
+// them: no auto-fix or auto-stage, mod-tidy compares instead of rewriting
+// go.mod, and fumpt, which can only rewrite files, is turned off. Checks that
+// ask before fixing are declined through runner.Options.DeclineFixes.
+func applyReportOnly(cfg *config.Config) {
+	disableAutoStage(cfg)
+	cfg.CheckBehaviors.BuildTagsAutoFix = false
+	cfg.CheckBehaviors.OSJunkAutoFix = false
+	cfg.CheckBehaviors.FilePermissionsAutoFix = false
+	cfg.CheckBehaviors.ModTidyDiff = config.ModTidyDiffAlways
+	cfg.Checks.Fumpt = false
+}
+
+// runFindings returns the diagnostics every check of the run reported
+func runFindings(results *runner.Results) []output.Diagnostic {
+	var findings []output.Diagnostic
+	for _, result := range results.CheckResults {
+		findings = append(findings, result.Findings()...)
+	}
+	return findings
+}
+
+// displayTopOffenders prints the n files with the most diagnostics across
+// all checks, most first
+func displayTopOffenders(formatter *output.Formatter, results *runner.Results, n int) {
+	ranked := output.RankFilesByDiagnostics(runFindings(results), n)
+	if len(ranked) == 0 {
+		formatter.Success("No diagnostics reported")
+		return
+	}
+
+	formatter.Header("Top Offenders")
+	for i, file := range ranked {
+		formatter.Detail("%2d. %-5d %s", i+1, file.Count, file.File)
+	}
+}
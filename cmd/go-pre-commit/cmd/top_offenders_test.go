@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	"github.com/mrz1836/go-pre-commit/internal/output"
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+)
+
+// renderTopOffenders returns everything displayTopOffenders prints
+func renderTopOffenders(results *runner.Results, n int) string {
+	var stdout, stderr bytes.Buffer
+	formatter := output.New(output.Options{ColorEnabled: false, Out: &stdout, Err: &stderr})
+	displayTopOffenders(formatter, results, n)
+	return stdout.String() + stderr.String()
+}
+
+func TestDisplayTopOffenders(t *testing.T) {
+	results := &runner.Results{CheckResults: []runner.CheckResult{
+		{Name: "lint", Diagnostics: []output.Diagnostic{
+			{File: "internal/app/app.go", Line: 3, Severity: output.SeverityError, Message: "unused"},
+			{File: "internal/app/app.go", Line: 9, Severity: output.SeverityError, Message: "ineffectual assignment"},
+			{File: "main.go", Line: 4, Severity: output.SeverityError, Message: "shadowed"},
+		}},
+		{Name: "vet", Error: "internal/app/app.go:12: unreachable code\ncmd/tool.go:5: printf arguments"},
+		{Name: "global-vars", WarnOnly: true, Output: "cmd/tool.go:2: global variable cache is mutable\nmain.go:7: global variable debug is mutable"},
+		{Name: "doc-comments", WarnOnly: true, Output: "util.go:1: exported Run should have a comment"},
+		{Name: "eof", Success: true, Output: "zzz.go:1: ignored because the check passed"},
+	}}
+
+	out := renderTopOffenders(results, 3)
+	assert.Contains(t, out, "Top Offenders")
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.GreaterOrEqual(t, len(lines), 3)
+	ranking := lines[len(lines)-3:]
+	assert.Regexp(t, `^\s*1\.\s+3\s+internal/app/app\.go$`, ranking[0])
+	assert.Regexp(t, `^\s*2\.\s+2\s+cmd/tool\.go$`, ranking[1], "ties are ordered by file name")
+	assert.Regexp(t, `^\s*3\.\s+2\s+main\.go$`, ranking[2])
+	assert.NotContains(t, out, "util.go", "only the top N files are listed")
+	assert.NotContains(t, out, "zzz.go")
+
+	assert.Contains(t, renderTopOffenders(&runner.Results{CheckResults: []runner.CheckResult{{Name: "eof", Success: true}}}, 5), "No diagnostics reported")
+}
+
+func TestApplyReportOnly(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Checks.Fumpt = true
+	cfg.CheckBehaviors.WhitespaceAutoStage = true
+	cfg.CheckBehaviors.BuildTagsAutoFix = true
+	cfg.CheckBehaviors.OSJunkAutoFix = true
+	cfg.CheckBehaviors.FilePermissionsAutoFix = true
+	cfg.CheckBehaviors.ModTidyDiff = config.ModTidyDiffAuto
+
+	applyReportOnly(cfg)
+	assert.False(t, cfg.Checks.Fumpt, "fumpt can only rewrite files")
+	assert.False(t, cfg.CheckBehaviors.WhitespaceAutoStage)
+	assert.False(t, cfg.CheckBehaviors.BuildTagsAutoFix)
+	assert.False(t, cfg.CheckBehaviors.OSJunkAutoFix)
+	assert.False(t, cfg.CheckBehaviors.FilePermissionsAutoFix)
+	assert.Equal(t, config.ModTidyDiffAlways, cfg.CheckBehaviors.ModTidyDiff)
+}
+
+func TestRunCmd_NegativeTopOffenders(t *testing.T) {
+	builder := NewCommandBuilder(NewCLIApp("test", "test-commit", "test-date"))
+
+	err := builder.runChecksWithConfig(RunConfig{TopOffenders: -1}, nil, nil)
+	require.ErrorIs(t, err, ErrInvalidTopOffenders)
+}
+
+func TestBuildRunnerOptions_TopOffendersDeclinesFixes(t *testing.T) {
+	formatter := output.NewDefault()
+	assert.True(t, buildRunnerOptions(RunConfig{TopOffenders: 5}, nil, nil, formatter).DeclineFixes)
+	assert.False(t, buildRunnerOptions(RunConfig{}, nil, nil, formatter).DeclineFixes)
+}
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/output"
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+)
+
+// TestDisplayEnhancedResults_VerbosityLevels renders one run at each level
+// and checks that every level adds detail on top of the previous one
+func TestDisplayEnhancedResults_VerbosityLevels(t *testing.T) {
+	lintOutput := strings.Repeat("main.go:1:1: error: unused variable\n", 12) + "raw tool trailer"
+	results := &runner.Results{
+		CheckResults: []runner.CheckResult{
+			{
+				Name:           "whitespace",
+				Success:        true,
+				Duration:       10 * time.Millisecond,
+				Files:          []string{"main.go", "util.go"},
+				Log:            "whitespace: checked 2 files\n",
+				InlineDisabled: []string{"gen.txt"},
+			},
+			{
+				Name:     "lint",
+				Success:  false,
+				Error:    "lint failed",
+				Output:   lintOutput,
+				Duration: time.Second,
+				Files:    []string{"main.go"},
+				Commands: []string{"golangci-lint run ./..."},
+			},
+		},
+		Passed:     1,
+		Failed:     1,
+		TotalFiles: 2,
+	}
+
+	render := func(level output.Verbosity) string {
+		var out bytes.Buffer
+		formatter := output.New(output.Options{ColorEnabled: false, Out: &out, Err: &out, Verbosity: level})
+		displayEnhancedResults(formatter, results, false)
+		return out.String()
+	}
+
+	normal := render(output.VerbosityNormal)
+	checks := render(output.VerbosityChecks)
+	files := render(output.VerbosityFiles)
+	commands := render(output.VerbosityCommands)
+
+	t.Run("normal shows failures only", func(t *testing.T) {
+		assert.Contains(t, normal, "unused variable")
+		assert.Contains(t, normal, "run with -vvv for full output")
+		assert.NotContains(t, normal, "Files: 2")
+		assert.NotContains(t, normal, "whitespace: checked 2 files")
+		assert.NotContains(t, normal, "Disabled inline")
+	})
+
+	t.Run("-v adds check summaries", func(t *testing.T) {
+		assert.Contains(t, checks, "Files: 2")
+		assert.Contains(t, checks, "whitespace: checked 2 files")
+		assert.Contains(t, checks, "Disabled inline: gen.txt")
+		assert.NotContains(t, checks, "    util.go")
+	})
+
+	t.Run("-vv adds file lists", func(t *testing.T) {
+		assert.Contains(t, files, "    util.go")
+		assert.NotContains(t, files, "$ golangci-lint run ./...")
+		assert.NotContains(t, files, "raw tool trailer")
+	})
+
+	t.Run("-vvv adds commands and full output", func(t *testing.T) {
+		assert.Contains(t, commands, "$ golangci-lint run ./...")
+		assert.Contains(t, commands, "raw tool trailer")
+		assert.NotContains(t, commands, "run with -vvv for full output")
+	})
+
+	t.Run("each level prints more", func(t *testing.T) {
+		require.Less(t, len(normal), len(checks))
+		require.Less(t, len(checks), len(files))
+		require.Less(t, len(files), len(commands))
+	})
+}
+
+// TestDisplayEnhancedResults_MaxFilesInSummary checks that the file lists of
+// fixed and failed checks stop at the configured limit
+func TestDisplayEnhancedResults_MaxFilesInSummary(t *testing.T) {
+	results := &runner.Results{
+		CheckResults: []runner.CheckResult{
+			{
+				Name:     "fumpt",
+				Success:  false,
+				Fixed:    true,
+				Error:    "fumpt reformatted files",
+				Duration: 10 * time.Millisecond,
+				Files:    []string{"a.go", "b.go", "c.go"},
+			},
+			{
+				Name:     "lint",
+				Success:  false,
+				Error:    "lint failed",
+				Duration: time.Second,
+				Files:    []string{"d.go", "e.go", "f.go", "g.go", "h.go"},
+			},
+		},
+		Failed:     2,
+		Fixed:      1,
+		TotalFiles: 8,
+	}
+
+	var out bytes.Buffer
+	formatter := output.New(output.Options{ColorEnabled: false, Out: &out, Err: &out, Verbosity: output.VerbosityFiles, MaxFiles: 2})
+	displayEnhancedResults(formatter, results, false)
+	rendered := out.String()
+
+	assert.Contains(t, rendered, "    b.go\n    ... and 1 more\n")
+	assert.NotContains(t, rendered, "c.go")
+	assert.Contains(t, rendered, "Files: 5")
+	assert.Contains(t, rendered, "    e.go\n    ... and 3 more\n")
+	assert.NotContains(t, rendered, "f.go")
+}
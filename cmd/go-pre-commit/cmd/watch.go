@@ -0,0 +1,302 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	"github.com/mrz1836/go-pre-commit/internal/git"
+	"github.com/mrz1836/go-pre-commit/internal/output"
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+)
+
+// defaultWatchDebounce is how long watch waits after the last save before
+// checking, so an editor writing several files at once triggers one run
+const defaultWatchDebounce = 300 * time.Millisecond
+
+// ErrInvalidDebounce is returned when --debounce is not positive
+var ErrInvalidDebounce = errors.New("--debounce must be greater than 0")
+
+// WatchConfig holds configuration for the watch command
+type WatchConfig struct {
+	Profile  string
+	Debounce time.Duration
+}
+
+// fileWatcher is the part of fsnotify.Watcher the watch command uses, so
+// tests can drive synthetic events
+type fileWatcher interface {
+	Add(name string) error
+	Events() <-chan fsnotify.Event
+	Errors() <-chan error
+	Close() error
+}
+
+// watcherFactory creates the file watcher for the watch command
+type watcherFactory func() (fileWatcher, error)
+
+// fsnotifyWatcher adapts fsnotify.Watcher to fileWatcher
+type fsnotifyWatcher struct {
+	watcher *fsnotify.Watcher
+}
+
+// newFSNotifyWatcher creates a watcher backed by the operating system
+func newFSNotifyWatcher() (fileWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	return fsnotifyWatcher{watcher: watcher}, nil
+}
+
+// Add starts watching the named directory
+func (w fsnotifyWatcher) Add(name string) error { return w.watcher.Add(name) }
+
+// Events returns the file system event channel
+func (w fsnotifyWatcher) Events() <-chan fsnotify.Event { return w.watcher.Events }
+
+// Errors returns the watcher error channel
+func (w fsnotifyWatcher) Errors() <-chan error { return w.watcher.Errors }
+
+// Close stops watching and releases the watcher
+func (w fsnotifyWatcher) Close() error { return w.watcher.Close() }
+
+// BuildWatchCmd creates the watch command
+func (cb *CommandBuilder) BuildWatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch [flags]",
+		Short: "Re-run fast checks on files as they are saved",
+		Long: `Watch the working tree and re-run a check profile on files as they are saved.
+
+Saves are debounced, so a burst of writes triggers a single run over every
+file that changed. Excluded and generated files are ignored. Results are
+printed after each run; press Ctrl-C to stop.`,
+		Example: `  # Run the fast profile on every saved file
+  go-pre-commit watch
+
+  # Use another profile and wait longer for editors that save in bursts
+  go-pre-commit watch --profile full --debounce 1s`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			watchConfig := WatchConfig{}
+			var err error
+
+			watchConfig.Profile, err = cmd.Flags().GetString("profile")
+			if err != nil {
+				return err
+			}
+
+			watchConfig.Debounce, err = cmd.Flags().GetDuration("debounce")
+			if err != nil {
+				return err
+			}
+
+			return cb.runWatch(commandContext(cmd), watchConfig)
+		},
+	}
+
+	cmd.Flags().String("profile", config.ProfileFast, "Check profile to run on changed files")
+	cmd.Flags().Duration("debounce", defaultWatchDebounce, "Quiet period after the last save before checking")
+
+	return cmd
+}
+
+func (cb *CommandBuilder) runWatch(ctx context.Context, watchConfig WatchConfig) error {
+	if watchConfig.Debounce <= 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidDebounce, watchConfig.Debounce)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		formatter := output.NewDefault()
+		formatter.Error("Failed to load configuration: %v", err)
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	formatter, err := cb.newFormatter(cfg)
+	if err != nil {
+		output.NewDefault().Error("Invalid output destination: %v", err)
+		return err
+	}
+	defer func() { _ = formatter.Close() }()
+
+	if !cfg.Enabled {
+		formatter.Warning("Pre-commit system is disabled in configuration (ENABLE_GO_PRE_COMMIT=false)")
+		return nil
+	}
+
+	profileChecks, ok := cfg.Profiles[watchConfig.Profile]
+	if !ok {
+		formatter.Error("Unknown check profile %q (available: %s)", watchConfig.Profile, strings.Join(slices.Sorted(maps.Keys(cfg.Profiles)), ", "))
+		return fmt.Errorf("%w: %q", ErrUnknownProfile, watchConfig.Profile)
+	}
+
+	repoRoot, err := git.FindRepositoryRoot()
+	if err != nil {
+		formatter.Error("Failed to find git repository: %v", err)
+		return fmt.Errorf("failed to find git repository: %w", err)
+	}
+
+	// Fixes stay in the working tree: staging them on every save would add
+	// the user's unfinished edits to the next commit
+	checkCfg := *cfg
+	disableAutoStage(&checkCfg)
+
+	watcher, err := cb.newWatcher()
+	if err != nil {
+		formatter.Error("Failed to start file watcher: %v", err)
+		return fmt.Errorf("failed to start file watcher: %w", err)
+	}
+	defer func() { _ = watcher.Close() }()
+
+	session := &watchSession{
+		watcher:    watcher,
+		repoRoot:   repoRoot,
+		classifier: git.NewFileClassifier(cfg),
+		debounce:   watchConfig.Debounce,
+		formatter:  formatter,
+		check: func(ctx context.Context, files []string) {
+			results, runErr := runner.New(&checkCfg, repoRoot).Run(ctx, runner.Options{Files: files, ProfileChecks: profileChecks})
+			if runErr != nil {
+				if ctx.Err() == nil {
+					formatter.Error("Failed to run checks: %v", runErr)
+				}
+				return
+			}
+			displayEnhancedResults(formatter, results, false)
+		},
+	}
+	if err = session.watchTree(repoRoot); err != nil {
+		formatter.Error("Failed to watch %s: %v", repoRoot, err)
+		return err
+	}
+
+	formatter.Info("Watching for changes (profile %s: %s); press Ctrl-C to stop", watchConfig.Profile, strings.Join(profileChecks, ", "))
+	session.loop(ctx)
+	return nil
+}
+
+// watchSession turns file system events into debounced check runs
+type watchSession struct {
+	watcher    fileWatcher
+	repoRoot   string
+	classifier *git.FileClassifier
+	debounce   time.Duration
+	formatter  *output.Formatter
+
+	// check runs the profile on a batch of repository-relative files
+	check func(ctx context.Context, files []string)
+}
+
+// watchTree adds dir and every directory below it that is not excluded.
+// fsnotify does not watch recursively, so each directory is added on its own.
+func (s *watchSession) watchTree(dir string) error {
+	return filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() {
+			return nil
+		}
+		if path != s.repoRoot && s.ignoredDir(path) {
+			return filepath.SkipDir
+		}
+		return s.watcher.Add(path)
+	})
+}
+
+// ignoredDir reports whether the directory at path is excluded from checks
+func (s *watchSession) ignoredDir(path string) bool {
+	rel, err := filepath.Rel(s.repoRoot, path)
+	if err != nil {
+		return true
+	}
+	rel = filepath.ToSlash(rel)
+	return rel == ".git" || len(s.classifier.FilterExcluded([]string{rel + "/"})) == 0
+}
+
+// loop collects changed files until the debounce period passes without a
+// save, then checks them. It returns when ctx is canceled or the watcher
+// closes.
+func (s *watchSession) loop(ctx context.Context) {
+	pending := make(map[string]bool)
+	timer := time.NewTimer(s.debounce)
+	timer.Stop()
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-s.watcher.Events():
+			if !ok {
+				return
+			}
+			if s.handleEvent(event, pending) {
+				timer.Reset(s.debounce)
+			}
+		case err, ok := <-s.watcher.Errors():
+			if !ok {
+				return
+			}
+			s.formatter.Warning("File watcher error: %v", err)
+		case <-timer.C:
+			if len(pending) == 0 {
+				continue
+			}
+			files := make([]string, 0, len(pending))
+			for file := range pending {
+				files = append(files, file)
+			}
+			clear(pending)
+			slices.Sort(files)
+
+			s.formatter.Header(fmt.Sprintf("Checking %d changed file(s)", len(files)))
+			s.check(ctx, files)
+		}
+	}
+}
+
+// handleEvent records the file an event touched when it should be checked
+// and reports whether it did. New directories are watched as they appear.
+func (s *watchSession) handleEvent(event fsnotify.Event, pending map[string]bool) bool {
+	if !event.Has(fsnotify.Write) && !event.Has(fsnotify.Create) {
+		return false
+	}
+
+	info, err := os.Stat(event.Name)
+	if err != nil {
+		// Removed again before we looked, as editors do with swap files
+		return false
+	}
+	if info.IsDir() {
+		if event.Has(fsnotify.Create) && !s.ignoredDir(event.Name) {
+			if err = s.watchTree(event.Name); err != nil {
+				s.formatter.Warning("Failed to watch %s: %v", event.Name, err)
+			}
+		}
+		return false
+	}
+
+	rel, err := filepath.Rel(s.repoRoot, event.Name)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return false
+	}
+	rel = filepath.ToSlash(rel)
+	if len(s.classifier.FilterExcluded([]string{rel})) == 0 || s.classifier.IsGenerated(event.Name) {
+		return false
+	}
+
+	pending[rel] = true
+	return true
+}
@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	"github.com/mrz1836/go-pre-commit/internal/git"
+	"github.com/mrz1836/go-pre-commit/internal/output"
+)
+
+// fakeWatcher is a fileWatcher fed by the test instead of the file system
+type fakeWatcher struct {
+	mu     sync.Mutex
+	added  []string
+	events chan fsnotify.Event
+	errors chan error
+}
+
+func newFakeWatcher() *fakeWatcher {
+	return &fakeWatcher{
+		events: make(chan fsnotify.Event),
+		errors: make(chan error),
+	}
+}
+
+func (w *fakeWatcher) Add(name string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.added = append(w.added, name)
+	return nil
+}
+
+func (w *fakeWatcher) Events() <-chan fsnotify.Event { return w.events }
+
+func (w *fakeWatcher) Errors() <-chan error { return w.errors }
+
+func (w *fakeWatcher) Close() error { return nil }
+
+func (w *fakeWatcher) watched() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]string(nil), w.added...)
+}
+
+// newTestWatchSession returns a session over repoRoot that records each
+// batch it would check
+func newTestWatchSession(t *testing.T, repoRoot string, watcher fileWatcher) (*watchSession, chan []string) {
+	t.Helper()
+
+	cfg := &config.Config{}
+	cfg.Git.ExcludePatterns = []string{"vendor/", ".git/"}
+	batches := make(chan []string, 10)
+	return &watchSession{
+		watcher:    watcher,
+		repoRoot:   repoRoot,
+		classifier: git.NewFileClassifier(cfg),
+		debounce:   50 * time.Millisecond,
+		formatter:  output.New(output.Options{ColorEnabled: false}),
+		check: func(_ context.Context, files []string) {
+			batches <- files
+		},
+	}, batches
+}
+
+func TestWatchSession_DebouncesAndFilters(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "vendor", "lib"), 0o750))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "pkg"), 0o750))
+	for _, name := range []string{"a.go", "pkg/b.go", "vendor/lib/c.go", "api.pb.go"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("package x\n"), 0o600))
+	}
+
+	watcher := newFakeWatcher()
+	session, batches := newTestWatchSession(t, dir, watcher)
+	require.NoError(t, session.watchTree(dir))
+	assert.Equal(t, []string{dir, filepath.Join(dir, "pkg")}, watcher.watched(), "vendor is not watched")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		session.loop(ctx)
+		close(done)
+	}()
+
+	send := func(name string, op fsnotify.Op) {
+		watcher.events <- fsnotify.Event{Name: filepath.Join(dir, name), Op: op}
+	}
+	send("a.go", fsnotify.Write)
+	send("a.go", fsnotify.Write)
+	send("pkg/b.go", fsnotify.Create)
+	send("vendor/lib/c.go", fsnotify.Write)
+	send("api.pb.go", fsnotify.Write)
+	send("deleted.go", fsnotify.Write)
+	send("a.go", fsnotify.Chmod)
+
+	select {
+	case batch := <-batches:
+		assert.Equal(t, []string{"a.go", "pkg/b.go"}, batch)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "no check run after the debounce period")
+	}
+
+	// A later save starts a new batch
+	send("pkg/b.go", fsnotify.Write)
+	select {
+	case batch := <-batches:
+		assert.Equal(t, []string{"pkg/b.go"}, batch)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "no check run for the second save")
+	}
+
+	// A new directory is watched as soon as it appears
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "internal"), 0o750))
+	send("internal", fsnotify.Create)
+
+	cancel()
+	<-done
+	assert.Contains(t, watcher.watched(), filepath.Join(dir, "internal"))
+	assert.Empty(t, batches, "directories and filtered files never trigger a run")
+}
+
+func TestWatchSession_StopsWhenWatcherCloses(t *testing.T) {
+	watcher := newFakeWatcher()
+	session, _ := newTestWatchSession(t, t.TempDir(), watcher)
+
+	close(watcher.events)
+	session.loop(context.Background())
+}
+
+// runWatchOnSave runs the fast profile watch in dir until it has checked a
+// save of file, returning the watch output
+func runWatchOnSave(t *testing.T, dir, file string) string {
+	t.Helper()
+
+	app := NewCLIApp("test", "test-commit", "2024-01-01")
+	outPath := filepath.Join(t.TempDir(), "watch.log")
+	app.config.OutputDest = "file:" + outPath
+	builder := NewCommandBuilder(app)
+
+	watcher := newFakeWatcher()
+	builder.newWatcher = func() (fileWatcher, error) { return watcher, nil }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- builder.runWatch(ctx, WatchConfig{Profile: config.ProfileFast, Debounce: 20 * time.Millisecond})
+	}()
+
+	watcher.events <- fsnotify.Event{Name: filepath.Join(dir, file), Op: fsnotify.Write}
+	require.Eventually(t, func() bool {
+		content, err := os.ReadFile(outPath) //nolint:gosec // test path
+		return err == nil && strings.Contains(string(content), "Check Results")
+	}, 10*time.Second, 20*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-errCh)
+
+	content, err := os.ReadFile(outPath) //nolint:gosec // test path
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestRunWatch_RunsFastProfileOnSavedFiles(t *testing.T) {
+	content := runWatchOnSave(t, setupFixRepo(t), "trailing.txt")
+
+	assert.Contains(t, content, "Checking 1 changed file(s)")
+	assert.Contains(t, content, "whitespace failed", "trailing.txt has trailing whitespace")
+	assert.Contains(t, content, "eof completed successfully")
+	assert.Contains(t, content, "on 1 file(s)")
+	assert.NotContains(t, content, "fumpt", "only the fast profile runs")
+}
+
+func TestRunWatch_FixesAreNotStaged(t *testing.T) {
+	dir := setupFixRepo(t)
+	t.Setenv("GO_PRE_COMMIT_WHITESPACE_AUTO_STAGE", "true")
+
+	runWatchOnSave(t, dir, "trailing.txt")
+
+	content, err := os.ReadFile(filepath.Join(dir, "trailing.txt")) //nolint:gosec // test path
+	require.NoError(t, err)
+	assert.Equal(t, "hello\nworld\n", string(content), "the fix is applied in the working tree")
+	assert.Equal(t, "hello  \nworld", gitCmd(t, dir, "show", ":trailing.txt"), "the index keeps the staged content")
+	assert.Equal(t, "trailing.txt", gitCmd(t, dir, "diff", "--name-only"))
+}
+
+func TestRunWatch_Errors(t *testing.T) {
+	setupFixRepo(t)
+	builder := NewCommandBuilder(NewCLIApp("test", "test-commit", "2024-01-01"))
+	builder.newWatcher = func() (fileWatcher, error) { return newFakeWatcher(), nil }
+
+	err := builder.runWatch(context.Background(), WatchConfig{Profile: "nope", Debounce: time.Second})
+	require.ErrorIs(t, err, ErrUnknownProfile)
+
+	err = builder.runWatch(context.Background(), WatchConfig{Profile: config.ProfileFast})
+	require.ErrorIs(t, err, ErrInvalidDebounce)
+}
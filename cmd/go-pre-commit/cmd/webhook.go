@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"context"
+	"net/url"
+	"path/filepath"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/git"
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+	"github.com/mrz1836/go-pre-commit/internal/webhook"
+)
+
+// webhookMetadata describes the repository state for the webhook payload.
+// Metadata git cannot provide, such as the commit of a fresh repository, is
+// left empty rather than failing the delivery.
+func webhookMetadata(repoRoot string) webhook.Metadata {
+	repo := git.NewRepository(repoRoot)
+	meta := webhook.Metadata{Repository: filepath.Base(repoRoot)}
+	if remote, err := repo.GetRemoteURL("origin"); err == nil && remote != "" {
+		meta.Repository = stripRemoteCredentials(remote)
+	}
+	if branch, err := repo.GetCurrentBranch(); err == nil {
+		meta.Branch = branch
+	}
+	if commit, err := repo.GetHeadCommit(); err == nil {
+		meta.Commit = commit
+	}
+	return meta
+}
+
+// stripRemoteCredentials removes the user information from a remote URL,
+// where https remotes often embed an access token. Remotes that are not URLs,
+// such as scp-like git@host:owner/repo paths, are returned unchanged.
+func stripRemoteCredentials(remote string) string {
+	u, err := url.Parse(remote)
+	if err != nil || u.User == nil {
+		return remote
+	}
+	u.User = nil
+	return u.String()
+}
+
+// sendWebhook posts results to rawURL. Delivery is best effort: the caller
+// reports the error but never lets it change the run's outcome.
+func (cb *CommandBuilder) sendWebhook(ctx context.Context, rawURL, repoRoot string, results *runner.Results) error {
+	payload := webhook.NewRunResult(results, webhookMetadata(repoRoot), cb.app.version, time.Now())
+	return webhook.Post(ctx, nil, rawURL, payload)
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"os"
 	"os/exec"
@@ -285,3 +286,125 @@ func TestBinaryExitCodes(t *testing.T) {
 		})
 	}
 }
+
+// TestPreCommitCompatMode runs the binary the way the pre-commit framework
+// does: from the repository root, with the staged file names as arguments
+func TestPreCommitCompatMode(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	originalWD, err := os.Getwd()
+	require.NoError(t, err)
+
+	buildPath := "./cmd/go-pre-commit"
+	if strings.Contains(originalWD, "/cmd/go-pre-commit") {
+		buildPath = "."
+	}
+
+	ctx := context.Background()
+	testBinary := filepath.Join(t.TempDir(), "test-compat")
+	buildCmd := exec.CommandContext(ctx, "go", "build", "-o", testBinary, buildPath) //nolint:gosec // Safe: controlled test
+	buildOut, err := buildCmd.CombinedOutput()
+	require.NoError(t, err, string(buildOut))
+
+	repoDir := t.TempDir()
+	runGit := func(args ...string) string {
+		cmd := exec.CommandContext(ctx, "git", args...) //nolint:gosec // Safe: controlled test
+		cmd.Dir = repoDir
+		out, gitErr := cmd.CombinedOutput()
+		require.NoError(t, gitErr, string(out))
+		return string(out)
+	}
+	runGit("init", "-q")
+	runGit("config", "user.email", "test@example.com")
+	runGit("config", "user.name", "Test")
+
+	require.NoError(t, os.MkdirAll(filepath.Join(repoDir, ".github"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, ".github", ".env.base"), []byte("ENABLE_GO_PRE_COMMIT=true\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "notes.txt"), []byte("trailing   \n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "clean.txt"), []byte("clean\n"), 0o600))
+	runGit("add", "notes.txt", "clean.txt")
+
+	runHook := func() (string, int) {
+		cmd := exec.CommandContext(ctx, testBinary, "run", "--pre-commit-compat", "--only", "whitespace,eof", "notes.txt", "clean.txt") //nolint:gosec // Safe: our binary
+		cmd.Dir = repoDir
+		cmd.Env = append(os.Environ(), "GO_PRE_COMMIT_COLOR_OUTPUT=true", "FORCE_COLOR=1")
+		out, runErr := cmd.CombinedOutput()
+		var exitErr *exec.ExitError
+		if errors.As(runErr, &exitErr) {
+			return string(out), exitErr.ExitCode()
+		}
+		require.NoError(t, runErr, string(out))
+		return string(out), 0
+	}
+
+	output, exitCode := runHook()
+	assert.Equal(t, 1, exitCode, "a fix exits 1 so the framework fails the hook")
+	assert.Contains(t, output, "Fixing notes.txt\n")
+	assert.NotContains(t, output, "Fixing clean.txt")
+	assert.NotContains(t, output, "\x1b[", "output is plain")
+
+	// The fix is left unstaged for the framework to report
+	assert.Equal(t, "notes.txt\n", runGit("diff", "--name-only"))
+
+	runGit("add", "notes.txt")
+	output, exitCode = runHook()
+	assert.Equal(t, 0, exitCode, output)
+	assert.NotContains(t, output, "Fixing")
+}
+
+func TestEmitFinalJSON(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	originalWD, err := os.Getwd()
+	require.NoError(t, err)
+
+	buildPath := "./cmd/go-pre-commit"
+	if strings.Contains(originalWD, "/cmd/go-pre-commit") {
+		buildPath = "."
+	}
+
+	ctx := context.Background()
+	testBinary := filepath.Join(t.TempDir(), "test-final-json")
+	buildCmd := exec.CommandContext(ctx, "go", "build", "-o", testBinary, buildPath) //nolint:gosec // Safe: controlled test
+	buildOut, err := buildCmd.CombinedOutput()
+	require.NoError(t, err, string(buildOut))
+
+	repoDir := t.TempDir()
+	for _, args := range [][]string{{"init", "-q"}, {"config", "user.email", "test@example.com"}, {"config", "user.name", "Test"}} {
+		cmd := exec.CommandContext(ctx, "git", args...) //nolint:gosec // Safe: controlled test
+		cmd.Dir = repoDir
+		out, gitErr := cmd.CombinedOutput()
+		require.NoError(t, gitErr, string(out))
+	}
+	require.NoError(t, os.MkdirAll(filepath.Join(repoDir, ".github"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, ".github", ".env.base"), []byte("ENABLE_GO_PRE_COMMIT=true\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "notes.txt"), []byte("trailing   \n"), 0o600))
+
+	cmd := exec.CommandContext(ctx, testBinary, "run", "--emit-final-json", "--only", "whitespace,eof", "--files", "notes.txt") //nolint:gosec // Safe: our binary
+	cmd.Dir = repoDir
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	var exitErr *exec.ExitError
+	require.ErrorAs(t, cmd.Run(), &exitErr, "the whitespace fix fails the run")
+
+	lines := strings.Split(strings.TrimRight(stderr.String(), "\n"), "\n")
+	var summary struct {
+		Passed       int      `json:"passed"`
+		Failed       int      `json:"failed"`
+		Skipped      int      `json:"skipped"`
+		DurationMS   int64    `json:"durationMs"`
+		FailedChecks []string `json:"failedChecks"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(lines[len(lines)-1]), &summary), stderr.String())
+	assert.Equal(t, 1, summary.Passed, "eof passes")
+	assert.Equal(t, 1, summary.Failed)
+	assert.Equal(t, 0, summary.Skipped)
+	assert.GreaterOrEqual(t, summary.DurationMS, int64(0))
+	assert.Equal(t, []string{"whitespace"}, summary.FailedChecks)
+	assert.NotContains(t, stdout.String(), "failedChecks", "the summary goes to stderr only")
+}
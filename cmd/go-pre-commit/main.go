@@ -3,6 +3,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -11,6 +12,9 @@ import (
 	"github.com/mrz1836/go-pre-commit/internal/update"
 )
 
+// exitCodeInterrupted is the conventional exit code for a process stopped by SIGINT
+const exitCodeInterrupted = 130
+
 func main() {
 	os.Exit(run())
 }
@@ -38,9 +42,19 @@ func run() int {
 	builder := cmd.NewCommandBuilder(app)
 
 	// Execute the root command
-	if err := builder.Execute(); err != nil {
+	err := builder.Execute()
+	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		return 1
+	}
+
+	// A run with --emit-final-json ends stderr with its JSON summary
+	_ = app.WriteFinalSummary(os.Stderr)
+
+	if err != nil {
+		if errors.Is(err, cmd.ErrInterrupted) {
+			return exitCodeInterrupted
+		}
+		return cmd.ExitCode(err)
 	}
 	return 0
 }
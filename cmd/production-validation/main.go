@@ -4,9 +4,12 @@ package main
 import (
 	"encoding/json"
 	"flag"
+	"fmt"
 	"log"
 	"os"
 	"path/filepath"
+	"strconv"
+	"time"
 
 	"github.com/mrz1836/go-pre-commit/internal/validation"
 )
@@ -40,9 +43,20 @@ func mainWithDeps(deps dependencies) {
 		outputFormat = flag.String("format", "text", "Output format: text, json")
 		outputFile   = flag.String("output", "", "Output file (default: stdout)")
 		verbose      = flag.Bool("verbose", false, "Enable verbose output")
+		historyDir   = flag.String("history-dir", "", "Directory to keep timestamped JSON reports and latest.json for trend tracking")
+		reportTime   = flag.String("report-time", "", "Fixed report timestamp (RFC3339) for reproducible output (default: SOURCE_DATE_EPOCH, else now)")
+
+		singleWorkers    = flag.Int("scaling-single-workers", 0, "Workers for the baseline run of the parallel-scaling test (default: 1)")
+		parallelWorkers  = flag.Int("scaling-parallel-workers", 0, "Workers for the parallel run of the parallel-scaling test (default: 4)")
+		scalingTolerance = flag.Int("scaling-tolerance", 0, "Longest the parallel run may take, as a percentage of the baseline run (default: 150)")
 	)
 	flag.Parse()
 
+	generatedAt, err := resolveReportTime(*reportTime, os.Getenv(sourceDateEpochEnv))
+	if err != nil {
+		deps.logFatalf("Invalid report time: %v", err)
+	}
+
 	if *verbose {
 		log.Println("Starting GoFortress Pre-commit System production readiness validation...")
 	}
@@ -53,6 +67,12 @@ func mainWithDeps(deps dependencies) {
 		deps.logFatalf("Failed to create validator: %v", err)
 	}
 	defer validator.Cleanup()
+	validator.SetReportTime(generatedAt)
+	validator.SetParallelScaling(validation.ParallelScalingCriteria{
+		SingleWorkers:    *singleWorkers,
+		ParallelWorkers:  *parallelWorkers,
+		TolerancePercent: *scalingTolerance,
+	})
 
 	if *verbose {
 		log.Println("Running comprehensive validation tests...")
@@ -103,6 +123,18 @@ func mainWithDeps(deps dependencies) {
 		}
 	}
 
+	// Keep a JSON copy of the report for trend tracking
+	if *historyDir != "" {
+		historyFile, err := writeHistory(*historyDir, report)
+		if err != nil {
+			deps.logFatalf("Failed to write report history: %v", err)
+		}
+
+		if *verbose {
+			log.Printf("Report history written to: %s", historyFile)
+		}
+	}
+
 	// Exit with appropriate code
 	if !report.ProductionReady {
 		if *verbose {
@@ -115,3 +147,61 @@ func mainWithDeps(deps dependencies) {
 		log.Println("System is production ready!")
 	}
 }
+
+// sourceDateEpochEnv is the reproducible-builds variable holding the Unix
+// time build artifacts are stamped with
+const sourceDateEpochEnv = "SOURCE_DATE_EPOCH"
+
+// resolveReportTime returns the fixed time to stamp the report with: the
+// -report-time flag, else SOURCE_DATE_EPOCH, else the zero time for now
+func resolveReportTime(flagValue, sourceDateEpoch string) (time.Time, error) {
+	if flagValue != "" {
+		t, err := time.Parse(time.RFC3339, flagValue)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("-report-time must be RFC3339: %w", err)
+		}
+		return t, nil
+	}
+	if sourceDateEpoch != "" {
+		seconds, err := strconv.ParseInt(sourceDateEpoch, 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%s must be a Unix timestamp: %w", sourceDateEpochEnv, err)
+		}
+		return time.Unix(seconds, 0).UTC(), nil
+	}
+	return time.Time{}, nil
+}
+
+// historyLatestFile is the name of the copy of the most recent report in the history directory
+const historyLatestFile = "latest.json"
+
+// writeHistory stores the report as <dir>/<timestamp>-<score>.json and refreshes
+// <dir>/latest.json with the same content. It returns the timestamped file path.
+func writeHistory(dir string, report *validation.ProductionReadinessReport) (string, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return "", fmt.Errorf("failed to create history directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal report: %w", err)
+	}
+
+	generatedAt := report.GeneratedAt
+	if generatedAt.IsZero() {
+		generatedAt = time.Now()
+	}
+	name := fmt.Sprintf("%s-%d.json", generatedAt.UTC().Format("20060102T150405Z"), report.OverallScore)
+	historyFile := filepath.Join(dir, name)
+
+	if err = os.WriteFile(historyFile, data, 0o600); err != nil {
+		return "", fmt.Errorf("failed to write history file: %w", err)
+	}
+
+	// A copy rather than a symlink keeps the pointer usable on every platform
+	if err = os.WriteFile(filepath.Join(dir, historyLatestFile), data, 0o600); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", historyLatestFile, err)
+	}
+
+	return historyFile, nil
+}
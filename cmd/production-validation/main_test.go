@@ -13,6 +13,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -833,3 +834,157 @@ func TestMainWithDeps_WriteFileFailure(t *testing.T) {
 	assert.True(t, fatalCalled)
 	assert.Contains(t, fatalMessage, "Failed to write output file")
 }
+
+// TestMain_HistoryDir verifies a timestamped history file and latest.json are written
+func TestMain_HistoryDir(t *testing.T) {
+	historyDir := filepath.Join(t.TempDir(), "history", "nested")
+
+	oldArgs := os.Args
+	oldCommandLine := flag.CommandLine
+	oldStdout := os.Stdout
+	defer func() {
+		os.Args = oldArgs
+		flag.CommandLine = oldCommandLine
+		os.Stdout = oldStdout
+	}()
+
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	require.NoError(t, err)
+	defer func() { _ = devNull.Close() }()
+	os.Stdout = devNull
+
+	flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+	os.Args = []string{binaryName, "-history-dir", historyDir}
+
+	generatedAt := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	testDeps := getDependencies()
+	testDeps.newProductionReadinessValidator = func() (*validation.ProductionReadinessValidator, error) {
+		return &validation.ProductionReadinessValidator{}, nil
+	}
+	testDeps.generateReport = func(_ *validation.ProductionReadinessValidator) (*validation.ProductionReadinessReport, error) {
+		return &validation.ProductionReadinessReport{
+			GeneratedAt:     generatedAt,
+			OverallScore:    92,
+			ProductionReady: true,
+		}, nil
+	}
+
+	assert.Equal(t, 0, runMainWithExitCodeAndDeps(testDeps))
+
+	historyFile := filepath.Join(historyDir, "20260304T050607Z-92.json")
+	require.FileExists(t, historyFile)
+	require.FileExists(t, filepath.Join(historyDir, historyLatestFile))
+
+	historyContent, err := os.ReadFile(historyFile) // #nosec G304 - test file path is controlled
+	require.NoError(t, err)
+	latestContent, err := os.ReadFile(filepath.Join(historyDir, historyLatestFile)) // #nosec G304 - test file path is controlled
+	require.NoError(t, err)
+	assert.Equal(t, historyContent, latestContent)
+
+	var report validation.ProductionReadinessReport
+	require.NoError(t, json.Unmarshal(latestContent, &report))
+	assert.Equal(t, 92, report.OverallScore)
+
+	// A later report becomes the new latest while the old history file is kept
+	newer, err := writeHistory(historyDir, &validation.ProductionReadinessReport{
+		GeneratedAt:  generatedAt.Add(time.Hour),
+		OverallScore: 80,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(historyDir, "20260304T060607Z-80.json"), newer)
+	assert.FileExists(t, historyFile)
+
+	latestContent, err = os.ReadFile(filepath.Join(historyDir, historyLatestFile)) // #nosec G304 - test file path is controlled
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(latestContent, &report))
+	assert.Equal(t, 80, report.OverallScore)
+}
+
+func TestResolveReportTime(t *testing.T) {
+	tests := []struct {
+		name            string
+		flagValue       string
+		sourceDateEpoch string
+		want            time.Time
+		wantErr         string
+	}{
+		{name: "neither set"},
+		{name: "flag", flagValue: "2026-05-06T07:08:09+02:00", want: time.Date(2026, 5, 6, 5, 8, 9, 0, time.UTC)},
+		{name: "source date epoch", sourceDateEpoch: "1700000000", want: time.Unix(1700000000, 0).UTC()},
+		{name: "flag wins", flagValue: "2026-05-06T07:08:09Z", sourceDateEpoch: "1700000000", want: time.Date(2026, 5, 6, 7, 8, 9, 0, time.UTC)},
+		{name: "invalid flag", flagValue: "yesterday", wantErr: "-report-time must be RFC3339"},
+		{name: "invalid source date epoch", sourceDateEpoch: "2026-05-06", wantErr: "SOURCE_DATE_EPOCH must be a Unix timestamp"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveReportTime(tt.flagValue, tt.sourceDateEpoch)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "got %s, want %s", got, tt.want)
+		})
+	}
+}
+
+func TestMain_ReportTime(t *testing.T) {
+	oldArgs := os.Args
+	oldCommandLine := flag.CommandLine
+	defer func() {
+		os.Args = oldArgs
+		flag.CommandLine = oldCommandLine
+	}()
+
+	testDeps := getDependencies()
+	testDeps.newProductionReadinessValidator = func() (*validation.ProductionReadinessValidator, error) {
+		return &validation.ProductionReadinessValidator{}, nil
+	}
+	testDeps.generateReport = func(v *validation.ProductionReadinessValidator) (*validation.ProductionReadinessReport, error) {
+		return &validation.ProductionReadinessReport{
+			GeneratedAt:     v.ReportTime(),
+			OverallScore:    90,
+			ProductionReady: true,
+		}, nil
+	}
+
+	run := func(t *testing.T, args ...string) ([]byte, []string) {
+		t.Helper()
+		dir := t.TempDir()
+		outputPath := filepath.Join(dir, "report.json")
+		historyDir := filepath.Join(dir, "history")
+
+		flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+		os.Args = append([]string{binaryName, "-format", "json", "-output", outputPath, "-history-dir", historyDir}, args...)
+		require.Equal(t, 0, runMainWithExitCodeAndDeps(testDeps))
+
+		content, err := os.ReadFile(outputPath) // #nosec G304 - test file path is controlled
+		require.NoError(t, err)
+		entries, err := os.ReadDir(historyDir)
+		require.NoError(t, err)
+		names := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+		return content, names
+	}
+
+	t.Run("flag", func(t *testing.T) {
+		first, history := run(t, "-report-time", "2026-05-06T07:08:09Z")
+		second, _ := run(t, "-report-time", "2026-05-06T07:08:09Z")
+		assert.Equal(t, first, second, "byte-for-byte identical")
+		assert.Equal(t, []string{"20260506T070809Z-90.json", historyLatestFile}, history)
+
+		var report validation.ProductionReadinessReport
+		require.NoError(t, json.Unmarshal(first, &report))
+		assert.True(t, time.Date(2026, 5, 6, 7, 8, 9, 0, time.UTC).Equal(report.GeneratedAt))
+	})
+
+	t.Run("source date epoch", func(t *testing.T) {
+		t.Setenv("SOURCE_DATE_EPOCH", "1700000000")
+		content, history := run(t)
+		assert.Contains(t, string(content), `"generated_at": "2023-11-14T22:13:20Z"`)
+		assert.Equal(t, []string{"20231114T221320Z-90.json", historyLatestFile}, history)
+	})
+}
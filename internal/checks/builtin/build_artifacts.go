@@ -0,0 +1,122 @@
+package builtin
+
+import (
+	"context"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+// defaultBuildArtifactPatterns are the staged paths blocked when no patterns
+// are configured: coverage profiles, CPU and memory profiles, and compiled
+// test binaries
+var defaultBuildArtifactPatterns = []string{"coverage.out", "*.prof", "*.pprof", "*.test"}
+
+// BuildArtifactsCheck blocks staged files that are build or test output.
+// The file classifier already keeps these out of the other checks, but
+// nothing stops them from being committed.
+type BuildArtifactsCheck struct {
+	timeout  time.Duration
+	patterns []string
+}
+
+// NewBuildArtifactsCheck creates a new build artifact check
+func NewBuildArtifactsCheck() *BuildArtifactsCheck {
+	return NewBuildArtifactsCheckWithConfig(nil)
+}
+
+// NewBuildArtifactsCheckWithConfig creates a new build artifact check with configuration
+func NewBuildArtifactsCheckWithConfig(cfg *config.Config) *BuildArtifactsCheck {
+	check := &BuildArtifactsCheck{
+		timeout:  10 * time.Second,
+		patterns: defaultBuildArtifactPatterns,
+	}
+	if cfg != nil {
+		if cfg.CheckTimeouts.BuildArtifacts > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.BuildArtifacts) * time.Second
+		}
+		if cfg.CheckBehaviors.BuildArtifactPatterns != nil {
+			check.patterns = cfg.CheckBehaviors.BuildArtifactPatterns
+		}
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *BuildArtifactsCheck) Name() string {
+	return "build-artifacts"
+}
+
+// Description returns a brief description of the check
+func (c *BuildArtifactsCheck) Description() string {
+	return "Block staged coverage profiles, CPU profiles, and test binaries"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *BuildArtifactsCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "build-artifacts",
+		Description:       "Fail when staged files match GO_PRE_COMMIT_BUILD_ARTIFACT_PATTERNS",
+		FilePatterns:      c.patterns,
+		EstimatedDuration: 10 * time.Millisecond,
+		DefaultTimeout:    c.timeout,
+		Category:          "git",
+		RequiresFiles:     true,
+	}
+}
+
+// Run fails for every file it is given; FilterFiles has already narrowed
+// the staged files to artifacts
+func (c *BuildArtifactsCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return &prerrors.CheckError{
+		Err:        prerrors.ErrBuildArtifact,
+		Message:    "Build artifacts are staged for commit",
+		Suggestion: "Unstage them with 'git rm --cached <file>' and add them to .gitignore",
+		Output:     strings.Join(files, "\n"),
+		Files:      files,
+	}
+}
+
+// FilterFiles returns the files matching an artifact pattern
+func (c *BuildArtifactsCheck) FilterFiles(files []string) []string {
+	var artifacts []string
+	for _, file := range files {
+		if c.isArtifact(file) {
+			artifacts = append(artifacts, file)
+		}
+	}
+	return artifacts
+}
+
+// isArtifact reports whether file matches an artifact pattern
+func (c *BuildArtifactsCheck) isArtifact(file string) bool {
+	return matchesAnyPattern(file, c.patterns)
+}
+
+// matchesAnyPattern reports whether file matches one of patterns. Patterns
+// without a slash match the file name in any directory; the rest match the
+// whole slash-separated path.
+func matchesAnyPattern(file string, patterns []string) bool {
+	slashPath := filepath.ToSlash(filepath.Clean(file))
+	for _, pattern := range patterns {
+		name := slashPath
+		if !strings.Contains(pattern, "/") {
+			name = path.Base(slashPath)
+		}
+		if matched, err := path.Match(pattern, name); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
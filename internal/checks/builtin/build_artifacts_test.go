@@ -0,0 +1,87 @@
+package builtin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+func TestBuildArtifactsCheckMetadata(t *testing.T) {
+	check := NewBuildArtifactsCheck()
+
+	assert.Equal(t, "build-artifacts", check.Name())
+	assert.NotEmpty(t, check.Description())
+
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "build-artifacts", metadata.Name)
+	assert.Equal(t, 10*time.Second, metadata.DefaultTimeout)
+	assert.Equal(t, defaultBuildArtifactPatterns, metadata.FilePatterns)
+	assert.True(t, metadata.RequiresFiles)
+}
+
+func TestBuildArtifactsCheck_BlocksEachArtifact(t *testing.T) {
+	check := NewBuildArtifactsCheck()
+
+	for _, file := range []string{
+		"coverage.out",
+		"internal/runner/coverage.out",
+		"cpu.prof",
+		"profiles/mem.pprof",
+		"runner.test",
+		"cmd/go-pre-commit/cmd.test",
+	} {
+		t.Run(file, func(t *testing.T) {
+			files := check.FilterFiles([]string{"main.go", file, "README.md"})
+			require.Equal(t, []string{file}, files)
+
+			err := check.Run(context.Background(), files)
+			require.ErrorIs(t, err, prerrors.ErrBuildArtifact)
+
+			var checkErr *prerrors.CheckError
+			require.ErrorAs(t, err, &checkErr)
+			assert.Equal(t, []string{file}, checkErr.Files)
+			assert.Contains(t, checkErr.Output, file)
+			assert.Contains(t, checkErr.Suggestion, ".gitignore")
+			assert.False(t, checkErr.WarnOnly, "artifacts block the commit")
+		})
+	}
+}
+
+func TestBuildArtifactsCheck_CleanCommit(t *testing.T) {
+	check := NewBuildArtifactsCheck()
+
+	files := check.FilterFiles([]string{
+		"main.go",
+		"main_test.go",
+		"coverage.go",
+		"docs/profiling.md",
+		"testdata/golden.txt",
+		"coverage.out.md",
+	})
+	assert.Empty(t, files)
+	require.NoError(t, check.Run(context.Background(), files))
+}
+
+func TestNewBuildArtifactsCheckWithConfig(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.BuildArtifacts = 3
+	cfg.CheckBehaviors.BuildArtifactPatterns = []string{"*.out", "dist/*"}
+
+	check := NewBuildArtifactsCheckWithConfig(cfg)
+	assert.Equal(t, 3*time.Second, check.timeout)
+	assert.Equal(t,
+		[]string{"coverage.out", "build/c.out", "dist/app"},
+		check.FilterFiles([]string{"coverage.out", "build/c.out", "dist/app", "dist/sub/app", "cpu.prof"}),
+		"configured patterns replace the defaults; patterns with a slash match the whole path")
+
+	cfg.CheckBehaviors.BuildArtifactPatterns = []string{}
+	assert.Empty(t, NewBuildArtifactsCheckWithConfig(cfg).FilterFiles([]string{"coverage.out"}),
+		"an empty pattern list blocks nothing")
+}
@@ -0,0 +1,162 @@
+package builtin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/git"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// defaultMaxStagedFiles is the staged file limit when none is configured
+const defaultMaxStagedFiles = 50
+
+// CommitSizeCheck flags commits that stage more files than allowed, which
+// usually means unrelated changes were mixed into one commit. Files in an
+// exempt category, such as generated code, do not count toward the limit.
+// Findings are warn-only unless the severity is set to error.
+type CommitSizeCheck struct {
+	timeout    time.Duration
+	maxFiles   int
+	blocking   bool
+	exempt     []string
+	classifier *git.FileClassifier
+}
+
+// NewCommitSizeCheck creates a new commit size check
+func NewCommitSizeCheck() *CommitSizeCheck {
+	return NewCommitSizeCheckWithConfig(nil)
+}
+
+// NewCommitSizeCheckWithConfig creates a new commit size check with configuration
+func NewCommitSizeCheckWithConfig(cfg *config.Config) *CommitSizeCheck {
+	check := &CommitSizeCheck{
+		timeout:    10 * time.Second,
+		maxFiles:   defaultMaxStagedFiles,
+		exempt:     []string{config.CommitSizeExemptGenerated},
+		classifier: git.NewFileClassifier(cfg),
+	}
+	if cfg != nil {
+		if cfg.CheckTimeouts.CommitSize > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.CommitSize) * time.Second
+		}
+		if cfg.CheckBehaviors.MaxStagedFiles > 0 {
+			check.maxFiles = cfg.CheckBehaviors.MaxStagedFiles
+		}
+		check.blocking = cfg.CheckBehaviors.CommitSizeSeverity == config.LintSeverityError
+		if len(cfg.CheckBehaviors.CommitSizeExempt) > 0 {
+			check.exempt = cfg.CheckBehaviors.CommitSizeExempt
+		}
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *CommitSizeCheck) Name() string {
+	return "commit-size"
+}
+
+// Description returns a brief description of the check
+func (c *CommitSizeCheck) Description() string {
+	return "Warn when a commit stages more files than allowed"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *CommitSizeCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "commit-size",
+		Description:       "Warn when the number of staged files exceeds GO_PRE_COMMIT_MAX_STAGED_FILES",
+		FilePatterns:      []string{"*"},
+		EstimatedDuration: 100 * time.Millisecond,
+		Dependencies:      []string{"git"},
+		DefaultTimeout:    c.timeout,
+		Category:          "git",
+		RequiresFiles:     true,
+	}
+}
+
+// Run executes the commit size check
+func (c *CommitSizeCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	// The index, not the files handed to the check, is what the commit will
+	// hold; -z keeps unusual paths unquoted
+	cmd := exec.CommandContext(ctx, "git", "diff", "--cached", "--name-only", "-z")
+	shared.LogCommand(ctx, cmd)
+	out, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("failed to list staged files: %w", err)
+	}
+
+	var counted []string
+	exempt := 0
+	for _, entry := range bytes.Split(out, []byte{0}) {
+		file := strings.TrimSpace(string(entry))
+		if file == "" {
+			continue
+		}
+		if c.isExempt(file) {
+			exempt++
+			continue
+		}
+		counted = append(counted, file)
+	}
+
+	if len(counted) <= c.maxFiles {
+		return nil
+	}
+
+	message := fmt.Sprintf("%d staged files exceed the limit of %d", len(counted), c.maxFiles)
+	if exempt > 0 {
+		message += fmt.Sprintf(" (%d exempt file(s) not counted)", exempt)
+	}
+	return &prerrors.CheckError{
+		Err:     prerrors.ErrCommitTooLarge,
+		Message: message,
+		Suggestion: "Split the change into smaller commits, or raise GO_PRE_COMMIT_MAX_STAGED_FILES " +
+			"if it belongs together",
+		Command:  "git diff --cached --name-only",
+		Output:   fmt.Sprintf("%d staged file(s) counted, limit %d", len(counted), c.maxFiles),
+		Files:    counted,
+		WarnOnly: !c.blocking,
+	}
+}
+
+// isExempt reports whether file belongs to an exempt category
+func (c *CommitSizeCheck) isExempt(file string) bool {
+	for _, category := range c.exempt {
+		switch category {
+		case config.CommitSizeExemptGenerated:
+			if c.classifier.IsGenerated(file) {
+				return true
+			}
+		case config.CommitSizeExemptVendored:
+			if slices.Contains(strings.Split(path.Dir(file), "/"), "vendor") {
+				return true
+			}
+		case config.CommitSizeExemptTestdata:
+			if slices.Contains(strings.Split(path.Dir(file), "/"), "testdata") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// FilterFiles returns all files; the count comes from the index
+func (c *CommitSizeCheck) FilterFiles(files []string) []string {
+	return files
+}
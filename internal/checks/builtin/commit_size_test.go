@@ -0,0 +1,145 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+func TestCommitSizeCheckMetadata(t *testing.T) {
+	check := NewCommitSizeCheck()
+
+	assert.Equal(t, "commit-size", check.Name())
+	assert.NotEmpty(t, check.Description())
+
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "commit-size", metadata.Name)
+	assert.Equal(t, 10*time.Second, metadata.DefaultTimeout)
+	assert.Equal(t, defaultMaxStagedFiles, check.maxFiles)
+	assert.False(t, check.blocking)
+	assert.Equal(t, []string{config.CommitSizeExemptGenerated}, check.exempt)
+	assert.Equal(t, []string{"a", "b"}, check.FilterFiles([]string{"a", "b"}))
+}
+
+func TestNewCommitSizeCheckWithConfig(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.CommitSize = 3
+	cfg.CheckBehaviors.MaxStagedFiles = 5
+	cfg.CheckBehaviors.CommitSizeSeverity = config.LintSeverityError
+	cfg.CheckBehaviors.CommitSizeExempt = []string{config.CommitSizeExemptVendored}
+
+	check := NewCommitSizeCheckWithConfig(cfg)
+	assert.Equal(t, 3*time.Second, check.timeout)
+	assert.Equal(t, 5, check.maxFiles)
+	assert.True(t, check.blocking)
+	assert.Equal(t, []string{config.CommitSizeExemptVendored}, check.exempt)
+}
+
+func TestCommitSizeCheckIsExempt(t *testing.T) {
+	check := NewCommitSizeCheck()
+	check.exempt = []string{config.CommitSizeExemptGenerated, config.CommitSizeExemptVendored, config.CommitSizeExemptTestdata}
+
+	assert.True(t, check.isExempt("api/service.pb.go"))
+	assert.True(t, check.isExempt("vendor/github.com/pkg/errors/errors.go"))
+	assert.True(t, check.isExempt("parser/testdata/input.json"))
+	assert.False(t, check.isExempt("vendor.go"), "only a vendor directory counts")
+	assert.False(t, check.isExempt("parser/parser.go"))
+
+	check.exempt = []string{config.CommitSizeExemptNone}
+	assert.False(t, check.isExempt("api/service.pb.go"))
+}
+
+func TestCommitSizeCheckRun(t *testing.T) {
+	repoDir, _ := setupEmptyCommitRepo(t)
+	stage := func(names ...string) []string {
+		for _, name := range names {
+			full := filepath.Join(repoDir, name)
+			require.NoError(t, os.MkdirAll(filepath.Dir(full), 0o750))
+			require.NoError(t, os.WriteFile(full, []byte("package p\n"), 0o600))
+		}
+		cmd := exec.CommandContext(context.Background(), "git", append([]string{"add"}, names...)...) //nolint:gosec // test code with controlled input
+		cmd.Dir = repoDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return names
+	}
+	reset := func() {
+		cmd := exec.CommandContext(context.Background(), "git", "reset", "-q")
+		cmd.Dir = repoDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.MaxStagedFiles = 3
+
+	t.Run("below the limit passes", func(t *testing.T) {
+		defer reset()
+		files := stage("a.go", "b.go", "c.go")
+		assert.NoError(t, NewCommitSizeCheckWithConfig(cfg).Run(context.Background(), files))
+	})
+
+	t.Run("above the limit warns with the count and limit", func(t *testing.T) {
+		defer reset()
+		files := stage("a.go", "b.go", "c.go", "d.go")
+		err := NewCommitSizeCheckWithConfig(cfg).Run(context.Background(), files)
+		require.ErrorIs(t, err, prerrors.ErrCommitTooLarge)
+
+		var checkErr *prerrors.CheckError
+		require.ErrorAs(t, err, &checkErr)
+		assert.True(t, checkErr.WarnOnly)
+		assert.Equal(t, "4 staged files exceed the limit of 3", checkErr.Message)
+		assert.Equal(t, files, checkErr.Files)
+	})
+
+	t.Run("error severity blocks", func(t *testing.T) {
+		defer reset()
+		files := stage("a.go", "b.go", "c.go", "d.go")
+		blocking := *cfg
+		blocking.CheckBehaviors.CommitSizeSeverity = config.LintSeverityError
+
+		var checkErr *prerrors.CheckError
+		require.ErrorAs(t, NewCommitSizeCheckWithConfig(&blocking).Run(context.Background(), files), &checkErr)
+		assert.False(t, checkErr.WarnOnly)
+	})
+
+	t.Run("generated files do not count", func(t *testing.T) {
+		defer reset()
+		var files []string
+		for i := range 10 {
+			files = append(files, stage(fmt.Sprintf("api/v%d.pb.go", i))...)
+		}
+		files = append(files, stage("api/server.go")...)
+		assert.NoError(t, NewCommitSizeCheckWithConfig(cfg).Run(context.Background(), files))
+
+		counting := *cfg
+		counting.CheckBehaviors.CommitSizeExempt = []string{config.CommitSizeExemptNone}
+		var checkErr *prerrors.CheckError
+		require.ErrorAs(t, NewCommitSizeCheckWithConfig(&counting).Run(context.Background(), files), &checkErr)
+		assert.Equal(t, "11 staged files exceed the limit of 3", checkErr.Message)
+	})
+
+	t.Run("reports exempt files it did not count", func(t *testing.T) {
+		defer reset()
+		files := stage("a.go", "b.go", "c.go", "d.go", "mock_store.go")
+		var checkErr *prerrors.CheckError
+		require.ErrorAs(t, NewCommitSizeCheckWithConfig(cfg).Run(context.Background(), files), &checkErr)
+		assert.Equal(t, "4 staged files exceed the limit of 3 (1 exempt file(s) not counted)", checkErr.Message)
+		assert.NotContains(t, checkErr.Files, "mock_store.go")
+	})
+
+	t.Run("no files", func(t *testing.T) {
+		assert.NoError(t, NewCommitSizeCheckWithConfig(cfg).Run(context.Background(), nil))
+	})
+}
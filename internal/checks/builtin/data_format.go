@@ -0,0 +1,292 @@
+package builtin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+// yamlBlockScalar matches a line that opens a literal or folded block
+// scalar, such as "run: |" or "- >-"; the deeper lines after it are text
+var yamlBlockScalar = regexp.MustCompile(`(?:^|\s)[|>][0-9+-]*\s*(?:#.*)?$`)
+
+// DataFormatCheck enforces the formatting of JSON and YAML files. The style
+// decides how strict it is: none only requires the files to parse, indent
+// also requires the configured indentation while leaving key order alone,
+// and canonical also requires the keys of every object to be sorted.
+type DataFormatCheck struct {
+	timeout time.Duration
+	style   string
+	indent  int
+}
+
+// NewDataFormatCheck creates a new data format check
+func NewDataFormatCheck() *DataFormatCheck {
+	return NewDataFormatCheckWithConfig(nil)
+}
+
+// NewDataFormatCheckWithConfig creates a new data format check with configuration
+func NewDataFormatCheckWithConfig(cfg *config.Config) *DataFormatCheck {
+	check := &DataFormatCheck{
+		timeout: 30 * time.Second,
+		style:   config.DataFormatIndent,
+		indent:  2,
+	}
+	if cfg != nil {
+		if cfg.CheckTimeouts.DataFormat > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.DataFormat) * time.Second
+		}
+		if cfg.CheckBehaviors.DataFormatStyle != "" {
+			check.style = cfg.CheckBehaviors.DataFormatStyle
+		}
+		if cfg.CheckBehaviors.DataFormatIndent > 0 {
+			check.indent = cfg.CheckBehaviors.DataFormatIndent
+		}
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *DataFormatCheck) Name() string {
+	return "data-format"
+}
+
+// Description returns a brief description of the check
+func (c *DataFormatCheck) Description() string {
+	return "Enforce JSON and YAML indentation and, optionally, sorted keys"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *DataFormatCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "data-format",
+		Description:       "Fail when JSON or YAML files do not parse or do not match GO_PRE_COMMIT_DATA_FORMAT_STYLE",
+		FilePatterns:      []string{"*.json", "*.yml", "*.yaml"},
+		EstimatedDuration: 500 * time.Millisecond,
+		Dependencies:      []string{}, // No external dependencies
+		DefaultTimeout:    c.timeout,
+		Category:          "formatting",
+		RequiresFiles:     true,
+		Cacheable:         true,
+	}
+}
+
+// Run checks every file and reports the first problem found in each
+func (c *DataFormatCheck) Run(ctx context.Context, files []string) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var issues, issueFiles []string
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		data, err := os.ReadFile(file) //nolint:gosec // File from user input
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", file, err)
+		}
+
+		var issue string
+		if isJSONFile(file) {
+			issue = c.jsonIssue(data)
+		} else {
+			issue = c.yamlIssue(data)
+		}
+		if issue != "" {
+			issues = append(issues, fmt.Sprintf("%s: %s", file, issue))
+			issueFiles = append(issueFiles, file)
+		}
+	}
+
+	if len(issues) == 0 {
+		return nil
+	}
+
+	suggestion := fmt.Sprintf("Reindent with %d spaces, e.g. 'jq --indent %d . <file>' for JSON", c.indent, c.indent)
+	if c.style == config.DataFormatCanonical {
+		suggestion = fmt.Sprintf("Sort keys and reindent with %d spaces, e.g. 'jq -S --indent %d . <file>' for JSON", c.indent, c.indent)
+	}
+	if c.style == config.DataFormatNone {
+		suggestion = "Fix the syntax errors"
+	}
+	return &prerrors.CheckError{
+		Err:        prerrors.ErrDataFormat,
+		Message:    fmt.Sprintf("%d JSON or YAML file(s) are not formatted (style: %s)", len(issues), c.style),
+		Suggestion: suggestion,
+		Output:     strings.Join(issues, "\n"),
+		Files:      issueFiles,
+	}
+}
+
+// FilterFiles filters to JSON and YAML files
+func (c *DataFormatCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		switch strings.ToLower(filepath.Ext(file)) {
+		case ".json", ".yml", ".yaml":
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// isJSONFile reports whether file is JSON rather than YAML
+func isJSONFile(file string) bool {
+	return strings.EqualFold(filepath.Ext(file), ".json")
+}
+
+// jsonIssue describes why data does not match the style, or returns "".
+// Comparing against json.Indent keeps key order, so a wrongly ordered file
+// only fails the canonical style.
+func (c *DataFormatCheck) jsonIssue(data []byte) string {
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, data); err != nil {
+		return fmt.Sprintf("invalid JSON: %v", err)
+	}
+	if c.style == config.DataFormatNone {
+		return ""
+	}
+
+	prefix := strings.Repeat(" ", c.indent)
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, compact.Bytes(), "", prefix); err != nil {
+		return fmt.Sprintf("invalid JSON: %v", err)
+	}
+	indented.WriteByte('\n')
+	if !bytes.Equal(data, indented.Bytes()) {
+		return fmt.Sprintf("not indented with %d spaces", c.indent)
+	}
+	if c.style != config.DataFormatCanonical {
+		return ""
+	}
+
+	// Decoding into maps and encoding again sorts every object's keys;
+	// UseNumber keeps numbers as written
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return fmt.Sprintf("invalid JSON: %v", err)
+	}
+	var canonical bytes.Buffer
+	enc := json.NewEncoder(&canonical)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", prefix)
+	if err := enc.Encode(v); err != nil {
+		return fmt.Sprintf("invalid JSON: %v", err)
+	}
+	if !bytes.Equal(data, canonical.Bytes()) {
+		return "keys are not sorted"
+	}
+	return ""
+}
+
+// yamlIssue describes why data does not match the style, or returns "".
+// YAML is not re-encoded because that would drop blank lines and change
+// quoting; indentation is checked line by line and key order on the parsed
+// nodes instead.
+func (c *DataFormatCheck) yamlIssue(data []byte) string {
+	var docs []*yaml.Node
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var doc yaml.Node
+		if err := dec.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return fmt.Sprintf("invalid YAML: %v", err)
+		}
+		docs = append(docs, &doc)
+	}
+	if c.style == config.DataFormatNone {
+		return ""
+	}
+
+	if issue := yamlIndentIssue(data, c.indent); issue != "" {
+		return issue
+	}
+	if c.style != config.DataFormatCanonical {
+		return ""
+	}
+	for _, doc := range docs {
+		if issue := yamlKeyOrderIssue(doc); issue != "" {
+			return issue
+		}
+	}
+	return ""
+}
+
+// yamlIndentIssue returns the first line whose indentation is a tab or not a
+// multiple of indent. Lines may also line up with the content of a sequence
+// item ("- key: v" followed by "  other: v"), and block scalar text is skipped.
+func yamlIndentIssue(data []byte, indent int) string {
+	blockIndent := -1
+	itemColumns := map[int]bool{}
+	for i, line := range strings.Split(string(data), "\n") {
+		trimmed := strings.TrimLeft(line, " ")
+		if strings.TrimSpace(trimmed) == "" {
+			continue
+		}
+		width := len(line) - len(trimmed)
+		if blockIndent >= 0 {
+			if width > blockIndent {
+				continue
+			}
+			blockIndent = -1
+		}
+		if strings.HasPrefix(trimmed, "\t") {
+			return fmt.Sprintf("line %d: indented with a tab", i+1)
+		}
+		if strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		if width%indent != 0 && !itemColumns[width] {
+			return fmt.Sprintf("line %d: indented %d spaces, not a multiple of %d", i+1, width, indent)
+		}
+
+		// Record where the content of each (possibly nested) sequence item starts
+		column := width
+		for strings.HasPrefix(trimmed, "- ") {
+			rest := strings.TrimLeft(trimmed[1:], " ")
+			column += len(trimmed) - len(rest)
+			itemColumns[column] = true
+			trimmed = rest
+		}
+		if yamlBlockScalar.MatchString(trimmed) {
+			blockIndent = width
+		}
+	}
+	return ""
+}
+
+// yamlKeyOrderIssue returns the first mapping key that sorts before the key
+// above it, searching node and everything below it
+func yamlKeyOrderIssue(node *yaml.Node) string {
+	if node.Kind == yaml.MappingNode {
+		for i := 2; i < len(node.Content); i += 2 {
+			prev, key := node.Content[i-2], node.Content[i]
+			if key.Value < prev.Value {
+				return fmt.Sprintf("line %d: key %q is not sorted (it follows %q)", key.Line, key.Value, prev.Value)
+			}
+		}
+	}
+	for _, child := range node.Content {
+		if issue := yamlKeyOrderIssue(child); issue != "" {
+			return issue
+		}
+	}
+	return ""
+}
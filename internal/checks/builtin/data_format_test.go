@@ -0,0 +1,141 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+// writeDataFile writes content to name in a temporary directory
+func writeDataFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+// newDataFormatCheck creates a data format check with the given style
+func newDataFormatCheck(style string) *DataFormatCheck {
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.DataFormatStyle = style
+	return NewDataFormatCheckWithConfig(cfg)
+}
+
+func TestDataFormatCheckMetadata(t *testing.T) {
+	check := NewDataFormatCheck()
+
+	assert.Equal(t, "data-format", check.Name())
+	assert.NotEmpty(t, check.Description())
+
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "data-format", metadata.Name)
+	assert.Equal(t, 30*time.Second, metadata.DefaultTimeout)
+	assert.Equal(t, config.DataFormatIndent, check.style)
+	assert.Equal(t, 2, check.indent)
+	assert.Equal(t, []string{"a.json", "b.YML", "c.yaml"},
+		check.FilterFiles([]string{"a.json", "b.YML", "c.yaml", "main.go", "README.md"}))
+}
+
+func TestDataFormatCheckJSON(t *testing.T) {
+	sortedFourSpaces := "{\n    \"a\": 1,\n    \"b\": [\n        true\n    ]\n}\n"
+	unsortedTwoSpaces := "{\n  \"b\": 1,\n  \"a\": {\n    \"d\": \"<x>\",\n    \"c\": 1.50\n  }\n}\n"
+	sortedTwoSpaces := "{\n  \"a\": {\n    \"c\": 1.50,\n    \"d\": \"<x>\"\n  },\n  \"b\": []\n}\n"
+
+	tests := []struct {
+		name    string
+		style   string
+		content string
+		issue   string
+	}{
+		{"indent rejects correctly ordered but wrongly indented", config.DataFormatIndent, sortedFourSpaces, "not indented with 2 spaces"},
+		{"indent keeps key order", config.DataFormatIndent, unsortedTwoSpaces, ""},
+		{"indent requires a final newline", config.DataFormatIndent, "{}", "not indented with 2 spaces"},
+		{"canonical rejects unsorted keys", config.DataFormatCanonical, unsortedTwoSpaces, "keys are not sorted"},
+		{"canonical rejects wrong indentation first", config.DataFormatCanonical, sortedFourSpaces, "not indented with 2 spaces"},
+		{"canonical accepts sorted keys", config.DataFormatCanonical, sortedTwoSpaces, ""},
+		{"none ignores formatting", config.DataFormatNone, "{\"b\":1,\"a\":2}", ""},
+		{"none rejects invalid JSON", config.DataFormatNone, "{\"a\":}", "invalid JSON"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issue := newDataFormatCheck(tt.style).jsonIssue([]byte(tt.content))
+			if tt.issue == "" {
+				assert.Empty(t, issue)
+			} else {
+				assert.Contains(t, issue, tt.issue)
+			}
+		})
+	}
+}
+
+func TestDataFormatCheckYAML(t *testing.T) {
+	sequences := "steps:\n  - name: build\n    run: |\n        go build ./...\n         go vet ./...\n  - name: test\n"
+	unsorted := "zeta: 1\nalpha:\n  b: 2\n  a: 1\n"
+
+	tests := []struct {
+		name    string
+		style   string
+		content string
+		issue   string
+	}{
+		{"indent accepts sequences and block scalars", config.DataFormatIndent, sequences, ""},
+		{"indent rejects odd indentation", config.DataFormatIndent, "a:\n   b: 1\n", "line 2: indented 3 spaces"},
+		{"indent keeps key order", config.DataFormatIndent, unsorted, ""},
+		{"canonical rejects unsorted keys", config.DataFormatCanonical, unsorted, `line 2: key "alpha" is not sorted`},
+		{"canonical checks nested mappings", config.DataFormatCanonical, "alpha:\n  b: 2\n  a: 1\n", `line 3: key "a"`},
+		{"canonical accepts every document sorted", config.DataFormatCanonical, "a: 1\nb: 2\n---\nc: 3\n", ""},
+		{"none rejects invalid YAML", config.DataFormatNone, "a: [1\n", "invalid YAML"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issue := newDataFormatCheck(tt.style).yamlIssue([]byte(tt.content))
+			if tt.issue == "" {
+				assert.Empty(t, issue)
+			} else {
+				assert.Contains(t, issue, tt.issue)
+			}
+		})
+	}
+}
+
+func TestDataFormatCheckYAMLIndentWidth(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.DataFormatIndent = 4
+	check := NewDataFormatCheckWithConfig(cfg)
+
+	assert.Empty(t, check.yamlIssue([]byte("a:\n    b:\n        - c: 1\n          d: 2\n")))
+	assert.Contains(t, check.yamlIssue([]byte("a:\n  b: 1\n")), "not a multiple of 4")
+}
+
+func TestDataFormatCheckRun(t *testing.T) {
+	good := writeDataFile(t, "good.json", "{\n  \"name\": \"x\"\n}\n")
+	bad := writeDataFile(t, "bad.json", "{\n    \"name\": \"x\"\n}\n")
+
+	check := NewDataFormatCheck()
+	require.NoError(t, check.Run(context.Background(), []string{good}))
+
+	err := check.Run(context.Background(), []string{good, bad})
+	require.ErrorIs(t, err, prerrors.ErrDataFormat)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Equal(t, []string{bad}, checkErr.Files)
+	assert.Contains(t, checkErr.Output, bad+": not indented with 2 spaces")
+	assert.Contains(t, checkErr.Suggestion, "jq --indent 2")
+	assert.False(t, checkErr.Fixed)
+}
+
+func TestDataFormatCheckRunMissingFile(t *testing.T) {
+	err := NewDataFormatCheck().Run(context.Background(), []string{filepath.Join(t.TempDir(), "missing.json")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read")
+}
@@ -0,0 +1,214 @@
+package builtin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/git"
+	"github.com/mrz1836/go-pre-commit/internal/output"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// hadolintInstallSuggestion tells users how to install hadolint when it is missing
+const hadolintInstallSuggestion = "Install hadolint: brew install hadolint, " +
+	"or download a binary from https://github.com/hadolint/hadolint/releases"
+
+// hadolintLevelRank orders hadolint's levels so they can be compared with
+// the configured threshold
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var hadolintLevelRank = map[string]int{
+	config.ShellcheckSeverityStyle:   1,
+	config.ShellcheckSeverityInfo:    2,
+	config.ShellcheckSeverityWarning: 3,
+	config.ShellcheckSeverityError:   4,
+}
+
+// hadolintFinding is one finding in hadolint's JSON output (--format json)
+type hadolintFinding struct {
+	Code    string `json:"code"`
+	Column  int    `json:"column"`
+	File    string `json:"file"`
+	Level   string `json:"level"`
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+}
+
+// DockerfileCheck runs hadolint on staged Dockerfiles, the files the
+// classifier detects as docker. Findings at or above the configured level
+// fail the check; lower ones are reported as warnings.
+type DockerfileCheck struct {
+	timeout    time.Duration
+	threshold  string
+	classifier *git.FileClassifier
+}
+
+// NewDockerfileCheck creates a new Dockerfile check
+func NewDockerfileCheck() *DockerfileCheck {
+	return NewDockerfileCheckWithConfig(nil)
+}
+
+// NewDockerfileCheckWithConfig creates a new Dockerfile check with configuration
+func NewDockerfileCheckWithConfig(cfg *config.Config) *DockerfileCheck {
+	check := &DockerfileCheck{
+		timeout:    60 * time.Second,
+		threshold:  config.ShellcheckSeverityInfo,
+		classifier: git.NewFileClassifier(cfg),
+	}
+	if cfg != nil {
+		if cfg.CheckTimeouts.Hadolint > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.Hadolint) * time.Second
+		}
+		if cfg.CheckBehaviors.HadolintSeverity != "" {
+			check.threshold = cfg.CheckBehaviors.HadolintSeverity
+		}
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *DockerfileCheck) Name() string {
+	return "hadolint"
+}
+
+// Description returns a brief description of the check
+func (c *DockerfileCheck) Description() string {
+	return "Lint Dockerfiles with hadolint"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *DockerfileCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "hadolint",
+		Description:       "Run hadolint on staged Dockerfiles and fail on findings at or above GO_PRE_COMMIT_HADOLINT_SEVERITY",
+		FilePatterns:      []string{"Dockerfile", "Dockerfile.*", "*.dockerfile", "Containerfile"},
+		EstimatedDuration: 1 * time.Second,
+		Dependencies:      []string{"hadolint"},
+		DefaultTimeout:    c.timeout,
+		Category:          "linting",
+		RequiresFiles:     true,
+	}
+}
+
+// FilterFiles filters to Dockerfiles
+func (c *DockerfileCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if c.classifier.DetectLanguage(file) == git.LanguageDocker {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// Run executes hadolint on all files at once
+func (c *DockerfileCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	if _, err := exec.LookPath("hadolint"); err != nil {
+		return prerrors.NewToolNotFoundError("hadolint", hadolintInstallSuggestion)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	// --no-fail keeps the exit code for real failures rather than findings
+	args := append([]string{"--format", "json", "--no-fail", "--"}, files...)
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "hadolint", args...) //nolint:gosec // Files come from git
+	shared.LogCommand(ctx, cmd)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return prerrors.NewToolExecutionError(
+			"hadolint",
+			stderr.String(),
+			fmt.Sprintf("hadolint timed out after %v. Consider increasing GO_PRE_COMMIT_HADOLINT_TIMEOUT.", c.timeout),
+		)
+	}
+
+	var diagnostics []output.Diagnostic
+	if err == nil {
+		diagnostics, err = c.parseHadolintJSON(stdout.Bytes())
+	}
+	if err != nil {
+		return prerrors.NewToolExecutionError("hadolint --format json", strings.TrimSpace(stdout.String()+stderr.String()),
+			"Run 'hadolint' on the Dockerfiles to see the full error")
+	}
+	return c.hadolintError(diagnostics)
+}
+
+// parseHadolintJSON converts hadolint's JSON output into diagnostics.
+// Findings at or above the threshold are errors and the rest are warnings.
+func (c *DockerfileCheck) parseHadolintJSON(data []byte) ([]output.Diagnostic, error) {
+	var findings []hadolintFinding
+	if err := json.Unmarshal(data, &findings); err != nil {
+		return nil, fmt.Errorf("failed to parse hadolint output: %w", err)
+	}
+
+	diagnostics := make([]output.Diagnostic, 0, len(findings))
+	for _, finding := range findings {
+		severity := output.SeverityWarning
+		if hadolintLevelRank[finding.Level] >= hadolintLevelRank[c.threshold] {
+			severity = output.SeverityError
+		}
+		diagnostics = append(diagnostics, output.Diagnostic{
+			File:     filepath.ToSlash(finding.File),
+			Line:     finding.Line,
+			Col:      finding.Column,
+			Severity: severity,
+			Rule:     finding.Code,
+			Message:  fmt.Sprintf("%s (level: %s)", finding.Message, finding.Level),
+		})
+	}
+	return diagnostics, nil
+}
+
+// hadolintError reports diagnostics, failing only when one of them is an error
+func (c *DockerfileCheck) hadolintError(diagnostics []output.Diagnostic) error {
+	if len(diagnostics) == 0 {
+		return nil
+	}
+
+	blocking := 0
+	lines := make([]string, 0, len(diagnostics))
+	var files []string
+	seen := make(map[string]bool)
+	for _, d := range diagnostics {
+		line := fmt.Sprintf("%s:%d:%d: %s: %s", d.File, d.Line, d.Col, d.Rule, d.Message)
+		if d.Severity == output.SeverityError {
+			blocking++
+		} else {
+			line = "[warning] " + line
+		}
+		lines = append(lines, line)
+		if !seen[d.File] {
+			seen[d.File] = true
+			files = append(files, d.File)
+		}
+	}
+
+	return &prerrors.CheckError{
+		Err: prerrors.ErrHadolintIssues,
+		Message: fmt.Sprintf("hadolint found %d issue(s), %d at or above %s level",
+			len(diagnostics), blocking, c.threshold),
+		Suggestion:  "Fix the findings, or ignore a rule with '# hadolint ignore=DLxxxx' above the instruction",
+		Output:      strings.Join(lines, "\n"),
+		Files:       files,
+		WarnOnly:    blocking == 0,
+		Diagnostics: diagnostics,
+	}
+}
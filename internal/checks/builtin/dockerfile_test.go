@@ -0,0 +1,127 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/output"
+)
+
+const (
+	dockerfileUntagged = "testdata/hadolint/Dockerfile.untagged"
+	dockerfileClean    = "testdata/hadolint/Dockerfile.clean"
+)
+
+// newDockerfileCheck creates a Dockerfile check failing at the given level
+func newDockerfileCheck(threshold string) *DockerfileCheck {
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.HadolintSeverity = threshold
+	return NewDockerfileCheckWithConfig(cfg)
+}
+
+// fakeHadolint puts a hadolint on PATH that reports the recorded DL3006
+// finding for Dockerfile.untagged and nothing for any other file
+func fakeHadolint(t *testing.T) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script as the fake hadolint")
+	}
+
+	fixture, err := filepath.Abs("testdata/hadolint/dl3006.json")
+	require.NoError(t, err)
+	binDir := t.TempDir()
+	script := "#!/bin/sh\n" +
+		"for arg in \"$@\"; do\n" +
+		"  case \"$arg\" in\n" +
+		"    *Dockerfile.untagged) cat \"" + fixture + "\"; exit 0 ;;\n" +
+		"  esac\n" +
+		"done\n" +
+		"echo '[]'\n"
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "hadolint"), []byte(script), 0o755)) //nolint:gosec // executable test fixture
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+func TestDockerfileCheckMetadata(t *testing.T) {
+	check := NewDockerfileCheck()
+
+	assert.Equal(t, "hadolint", check.Name())
+	assert.NotEmpty(t, check.Description())
+	assert.Equal(t, config.ShellcheckSeverityInfo, check.threshold)
+
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, 60*time.Second, metadata.DefaultTimeout)
+	assert.Equal(t, []string{"hadolint"}, metadata.Dependencies)
+	assert.Equal(t, []string{"Dockerfile", "build/Dockerfile.prod", "api.dockerfile", "Containerfile"},
+		check.FilterFiles([]string{"Dockerfile", "build/Dockerfile.prod", "api.dockerfile", "Containerfile", "docker-compose.yml", "main.go"}))
+}
+
+func TestParseHadolintJSON(t *testing.T) {
+	data, err := os.ReadFile("testdata/hadolint/dl3006.json")
+	require.NoError(t, err)
+
+	diagnostics, err := newDockerfileCheck(config.ShellcheckSeverityInfo).parseHadolintJSON(data)
+	require.NoError(t, err)
+	assert.Equal(t, []output.Diagnostic{{
+		File:     dockerfileUntagged,
+		Line:     1,
+		Col:      1,
+		Severity: output.SeverityError,
+		Rule:     "DL3006",
+		Message:  "Always tag the version of an image explicitly (level: warning)",
+	}}, diagnostics)
+
+	diagnostics, err = newDockerfileCheck(config.ShellcheckSeverityError).parseHadolintJSON(data)
+	require.NoError(t, err)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, output.SeverityWarning, diagnostics[0].Severity, "a warning below the error threshold only warns")
+
+	_, err = newDockerfileCheck(config.ShellcheckSeverityInfo).parseHadolintJSON([]byte("hadolint: Dockerfile: openFile: does not exist"))
+	require.Error(t, err)
+}
+
+func TestDockerfileCheckRun(t *testing.T) {
+	if _, err := exec.LookPath("hadolint"); err != nil {
+		fakeHadolint(t)
+	}
+
+	require.NoError(t, NewDockerfileCheck().Run(context.Background(), []string{dockerfileClean}))
+
+	err := NewDockerfileCheck().Run(context.Background(), []string{dockerfileClean, dockerfileUntagged})
+	require.ErrorIs(t, err, prerrors.ErrHadolintIssues)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.False(t, checkErr.WarnOnly)
+	assert.Equal(t, []string{dockerfileUntagged}, checkErr.Files)
+	assert.Contains(t, checkErr.Output, dockerfileUntagged+":1:1: DL3006: Always tag the version")
+	require.NotEmpty(t, checkErr.Diagnostics)
+	assert.Equal(t, "DL3006", checkErr.Diagnostics[0].Rule)
+
+	err = newDockerfileCheck(config.ShellcheckSeverityError).Run(context.Background(), []string{dockerfileUntagged})
+	require.ErrorAs(t, err, &checkErr)
+	assert.True(t, checkErr.WarnOnly)
+	assert.Contains(t, checkErr.Output, "[warning] "+dockerfileUntagged)
+}
+
+func TestDockerfileCheckRunNoTool(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+
+	err := NewDockerfileCheck().Run(context.Background(), []string{dockerfileUntagged})
+	require.ErrorIs(t, err, prerrors.ErrToolNotFound)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.True(t, checkErr.CanSkip)
+	assert.Contains(t, checkErr.Suggestion, "Install hadolint")
+}
@@ -0,0 +1,92 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+// EmptyCommitCheck fails when no staged changes remain, which happens when
+// auto-fixes revert everything that was staged. The runner schedules it after
+// all other checks so it sees the index as the fixers left it.
+type EmptyCommitCheck struct {
+	timeout time.Duration
+}
+
+// NewEmptyCommitCheck creates a new empty commit check
+func NewEmptyCommitCheck() *EmptyCommitCheck {
+	return &EmptyCommitCheck{
+		timeout: 10 * time.Second, // Default 10 second timeout
+	}
+}
+
+// NewEmptyCommitCheckWithConfig creates a new empty commit check with configuration
+func NewEmptyCommitCheckWithConfig(cfg *config.Config) *EmptyCommitCheck {
+	timeout := 10 * time.Second
+	if cfg != nil && cfg.CheckTimeouts.EmptyCommit > 0 {
+		timeout = time.Duration(cfg.CheckTimeouts.EmptyCommit) * time.Second
+	}
+
+	return &EmptyCommitCheck{
+		timeout: timeout,
+	}
+}
+
+// Name returns the name of the check
+func (c *EmptyCommitCheck) Name() string {
+	return "empty-commit"
+}
+
+// Description returns a brief description of the check
+func (c *EmptyCommitCheck) Description() string {
+	return "Fail when fixes leave nothing staged to commit"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *EmptyCommitCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "empty-commit",
+		Description:       "Fail when no staged changes remain after auto-fixes",
+		FilePatterns:      []string{"*"},
+		EstimatedDuration: 100 * time.Millisecond,
+		Dependencies:      []string{"git"},
+		DefaultTimeout:    c.timeout,
+		Category:          "git",
+		RequiresFiles:     true,
+	}
+}
+
+// Run executes the empty commit check
+func (c *EmptyCommitCheck) Run(ctx context.Context, _ []string) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	// --quiet exits 1 when the index differs from HEAD and 0 when it does not
+	cmd := exec.CommandContext(ctx, "git", "diff", "--cached", "--quiet")
+	err := cmd.Run()
+
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+		return &prerrors.CheckError{
+			Err:        prerrors.ErrEmptyCommit,
+			Message:    "No staged changes remain after fixes; there is nothing to commit",
+			Suggestion: "Stage the changes you meant to commit, or use 'git commit --allow-empty' with GO_PRE_COMMIT_ENABLE_EMPTY_COMMIT=false",
+			Command:    "git diff --cached",
+		}
+	case errors.As(err, &exitErr) && exitErr.ExitCode() == 1:
+		return nil
+	default:
+		return fmt.Errorf("failed to inspect staged changes: %w", err)
+	}
+}
+
+// FilterFiles returns all files; the check inspects the whole index
+func (c *EmptyCommitCheck) FilterFiles(files []string) []string {
+	return files
+}
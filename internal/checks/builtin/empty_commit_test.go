@@ -0,0 +1,110 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+// setupEmptyCommitRepo creates a repository with one committed file and
+// switches the working directory into it
+func setupEmptyCommitRepo(t *testing.T) (string, string) {
+	t.Helper()
+
+	repoDir := t.TempDir()
+	runGit := func(args ...string) {
+		cmd := exec.CommandContext(context.Background(), "git", args...) //nolint:gosec // test code with controlled input
+		cmd.Dir = repoDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	runGit("init", "-q")
+	runGit("config", "user.email", "test@example.com")
+	runGit("config", "user.name", "Test")
+	runGit("config", "commit.gpgsign", "false")
+
+	file := filepath.Join(repoDir, "notes.txt")
+	require.NoError(t, os.WriteFile(file, []byte("hello\n"), 0o600))
+	runGit("add", "notes.txt")
+	runGit("commit", "-q", "-m", "initial")
+
+	t.Chdir(repoDir)
+	return repoDir, file
+}
+
+func TestEmptyCommitCheckMetadata(t *testing.T) {
+	check := NewEmptyCommitCheck()
+
+	assert.Equal(t, "empty-commit", check.Name())
+	assert.NotEmpty(t, check.Description())
+
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "empty-commit", metadata.Name)
+	assert.Equal(t, 10*time.Second, metadata.DefaultTimeout)
+	assert.Equal(t, []string{"a", "b"}, check.FilterFiles([]string{"a", "b"}))
+}
+
+func TestNewEmptyCommitCheckWithConfig(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.EmptyCommit = 3
+
+	assert.Equal(t, 3*time.Second, NewEmptyCommitCheckWithConfig(cfg).timeout)
+	assert.Equal(t, 10*time.Second, NewEmptyCommitCheckWithConfig(nil).timeout)
+}
+
+func TestEmptyCommitCheckPassesWithStagedChanges(t *testing.T) {
+	repoDir, file := setupEmptyCommitRepo(t)
+
+	require.NoError(t, os.WriteFile(file, []byte("hello\nworld\n"), 0o600))
+	cmd := exec.CommandContext(context.Background(), "git", "add", "notes.txt")
+	cmd.Dir = repoDir
+	require.NoError(t, cmd.Run())
+
+	require.NoError(t, NewEmptyCommitCheck().Run(context.Background(), []string{file}))
+}
+
+func TestEmptyCommitCheckFiresWhenFixerRevertsOnlyChange(t *testing.T) {
+	repoDir, file := setupEmptyCommitRepo(t)
+
+	// The only staged change is trailing whitespace
+	require.NoError(t, os.WriteFile(file, []byte("hello   \n"), 0o600))
+	cmd := exec.CommandContext(context.Background(), "git", "add", "notes.txt")
+	cmd.Dir = repoDir
+	require.NoError(t, cmd.Run())
+
+	empty := NewEmptyCommitCheck()
+	require.NoError(t, empty.Run(context.Background(), []string{file}))
+
+	// The whitespace fixer removes it and re-stages the file
+	cfg := &config.Config{Directory: filepath.Join(repoDir, ".github", "pre-commit")}
+	cfg.CheckTimeouts.Whitespace = 30
+	cfg.CheckBehaviors.WhitespaceAutoStage = true
+	err := NewWhitespaceCheckWithConfig(cfg).Run(context.Background(), []string{file})
+	require.ErrorIs(t, err, prerrors.ErrWhitespaceIssues)
+
+	err = empty.Run(context.Background(), []string{file})
+	require.ErrorIs(t, err, prerrors.ErrEmptyCommit)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.NotEmpty(t, checkErr.Suggestion)
+}
+
+func TestEmptyCommitCheckOutsideRepository(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	err := NewEmptyCommitCheck().Run(context.Background(), nil)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, prerrors.ErrEmptyCommit)
+}
@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"syscall"
 	"time"
 
+	"github.com/mrz1836/go-pre-commit/internal/config"
 	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
 )
 
 const (
@@ -16,7 +19,14 @@ const (
 
 // EOFCheck ensures files end with a newline
 type EOFCheck struct {
-	timeout time.Duration
+	timeout       time.Duration
+	exempt        []string // glob patterns for files that may lack a final newline
+	chmodWritable bool     // make read-only files writable for the fix
+	warnOnly      bool     // report fixed files as a warning instead of failing
+
+	// whitespace is set when the whitespace fix adds final newlines itself;
+	// files it rewrites are left to it
+	whitespace *WhitespaceCheck
 }
 
 // NewEOFCheck creates a new EOF check
@@ -33,6 +43,23 @@ func NewEOFCheckWithTimeout(timeout time.Duration) *EOFCheck {
 	}
 }
 
+// NewEOFCheckWithConfig creates a new EOF check with full configuration
+func NewEOFCheckWithConfig(cfg *config.Config) *EOFCheck {
+	check := NewEOFCheck()
+	if cfg != nil {
+		if cfg.CheckTimeouts.EOF > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.EOF) * time.Second
+		}
+		check.exempt = cfg.CheckBehaviors.EOFExempt
+		check.chmodWritable = cfg.CheckBehaviors.FixChmodWritable
+		check.warnOnly = cfg.CheckBehaviors.EOFSeverity == config.LintSeverityWarning
+		if cfg.Checks.Whitespace && cfg.CheckBehaviors.WhitespaceAddFinalNewline {
+			check.whitespace = NewWhitespaceCheckWithConfig(cfg)
+		}
+	}
+	return check
+}
+
 // Name returns the name of the check
 func (c *EOFCheck) Name() string {
 	return "eof"
@@ -54,6 +81,7 @@ func (c *EOFCheck) Metadata() any {
 		DefaultTimeout:    c.timeout,
 		Category:          "formatting",
 		RequiresFiles:     true,
+		Cacheable:         true,
 	}
 }
 
@@ -65,17 +93,19 @@ func (c *EOFCheck) Run(ctx context.Context, files []string) error {
 
 	var errors []string
 	var foundIssues bool
+	var modifiedFiles []string
 
 	for _, file := range files {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
 		default:
-			modified, err := c.processFile(file)
+			modified, err := c.processFile(ctx, file)
 			if err != nil {
 				errors = append(errors, fmt.Sprintf("%s: %v", file, err))
 			} else if modified {
 				foundIssues = true
+				modifiedFiles = append(modifiedFiles, file)
 			}
 		}
 	}
@@ -84,47 +114,94 @@ func (c *EOFCheck) Run(ctx context.Context, files []string) error {
 		return fmt.Errorf("%w:\n%s", prerrors.ErrEOFIssues, strings.Join(errors, "\n"))
 	}
 
+	if foundIssues && c.warnOnly {
+		return prerrors.NewWarnOnlyFixedError(prerrors.ErrEOFIssues,
+			fmt.Sprintf("added a final newline to %d file(s): %s", len(modifiedFiles), strings.Join(modifiedFiles, ", ")),
+			modifiedFiles)
+	}
+
 	if foundIssues {
-		return prerrors.ErrEOFIssues
+		return prerrors.NewFixedError(prerrors.ErrEOFIssues, modifiedFiles)
 	}
 
 	return nil
 }
 
-// FilterFiles filters to only text files
+// FilterFiles filters to text files that are not exempt from the newline rule
 func (c *EOFCheck) FilterFiles(files []string) []string {
 	var filtered []string
 	for _, file := range files {
-		if isTextFile(file) {
+		if isTextFile(file) && !matchesAnyPattern(file, c.exempt) {
 			filtered = append(filtered, file)
 		}
 	}
 	return filtered
 }
 
-// processFile ensures a file ends with a newline
-func (c *EOFCheck) processFile(filename string) (bool, error) {
-	// Read file
-	content, err := os.ReadFile(filename) //nolint:gosec // File from user input
+// processFile ensures a file ends with a newline, asking for confirmation
+// first when an interactive confirmer is present in ctx
+func (c *EOFCheck) processFile(ctx context.Context, filename string) (bool, error) {
+	// Only the last byte matters, so read just that instead of the whole file
+	f, err := os.Open(filename) //nolint:gosec // File from user input
 	if err != nil {
 		return false, fmt.Errorf("failed to read file: %w", err)
 	}
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil {
+		return false, fmt.Errorf("failed to read file: %w", err)
+	}
+	if info.IsDir() {
+		return false, fmt.Errorf("failed to read file: %w", syscall.EISDIR)
+	}
 
 	// Skip empty files
-	if len(content) == 0 {
+	if info.Size() == 0 {
 		return false, nil
 	}
 
+	last := make([]byte, 1)
+	if _, err = f.ReadAt(last, info.Size()-1); err != nil {
+		return false, fmt.Errorf("failed to read file: %w", err)
+	}
+
 	// Check if file ends with newline
-	if content[len(content)-1] != '\n' {
-		// Add newline
-		content = append(content, '\n')
+	if last[0] == '\n' {
+		return false, nil
+	}
 
-		if err := os.WriteFile(filename, content, 0o600); err != nil { //nolint:gosec // G703: filename comes from user-provided file path, same as ReadFile above
-			return false, fmt.Errorf("failed to write file: %w", err)
-		}
-		return true, nil
+	// The whitespace fix adds the newline when it rewrites the file
+	if c.whitespace != nil && c.whitespace.addsFinalNewline(filename) {
+		return false, nil
+	}
+
+	// In interactive mode the user may decline the fix, leaving the issue in place
+	if !shared.ConfirmFix(ctx, c.Name(), filename) {
+		return false, prerrors.ErrFixDeclined
+	}
+
+	if err = withWritableFile(filename, c.chmodWritable, func() error {
+		return appendNewline(filename)
+	}); err != nil {
+		return false, err
 	}
+	return true, nil
+}
 
-	return false, nil
+// appendNewline appends '\n' to filename; appending keeps the fix O(1)
+// regardless of file size
+func appendNewline(filename string) error {
+	out, err := os.OpenFile(filename, os.O_WRONLY|os.O_APPEND, 0) //nolint:gosec // G703: same path as the read above
+	if err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if _, err = out.Write([]byte{'\n'}); err != nil {
+		_ = out.Close()
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err = out.Close(); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	return nil
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/mrz1836/go-pre-commit/internal/config"
 	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
 )
 
@@ -525,7 +526,7 @@ func TestEOFCheckProcessFileDirect(t *testing.T) {
 			check := NewEOFCheck()
 
 			// Call processFile directly
-			modified, err := check.processFile(testFile)
+			modified, err := check.processFile(context.Background(), testFile)
 
 			if tt.expectedError {
 				require.Error(t, err)
@@ -761,3 +762,98 @@ func TestEOFCheckMixedErrorsAndFixes(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "needs fixing\n", string(content))
 }
+
+func TestEOFCheckExemptFiles(t *testing.T) {
+	t.Chdir(t.TempDir())
+	require.NoError(t, os.MkdirAll("testdata", 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join("testdata", "render.golden.json"), []byte("exact output"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join("testdata", "input.txt"), []byte("needs a newline"), 0o600))
+
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.EOF = 5
+	cfg.CheckBehaviors.EOFExempt = []string{"*.golden.json"}
+	check := NewEOFCheckWithConfig(cfg)
+	assert.Equal(t, 5*time.Second, check.timeout)
+
+	files := check.FilterFiles([]string{"testdata/render.golden.json", "testdata/input.txt"})
+	require.Equal(t, []string{"testdata/input.txt"}, files, "exempt files are never checked")
+
+	err := check.Run(context.Background(), files)
+	require.ErrorIs(t, err, prerrors.ErrEOFIssues)
+
+	golden, err := os.ReadFile(filepath.Join("testdata", "render.golden.json"))
+	require.NoError(t, err)
+	assert.Equal(t, "exact output", string(golden), "the exempt file is left alone")
+	input, err := os.ReadFile(filepath.Join("testdata", "input.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "needs a newline\n", string(input))
+
+	t.Run("without exemptions", func(t *testing.T) {
+		assert.Len(t, NewEOFCheckWithConfig(nil).FilterFiles([]string{"testdata/render.golden.json", "testdata/input.txt"}), 2)
+	})
+}
+
+func TestEOFCheckWarnOnlySeverity(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.EOFSeverity = config.LintSeverityWarning
+
+	path := filepath.Join(t.TempDir(), "notes.txt")
+	require.NoError(t, os.WriteFile(path, []byte("no newline"), 0o600))
+
+	err := NewEOFCheckWithConfig(cfg).Run(context.Background(), []string{path})
+	require.ErrorIs(t, err, prerrors.ErrEOFIssues)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.True(t, checkErr.WarnOnly, "the fix is reported without blocking")
+	assert.True(t, checkErr.Fixed)
+	assert.Equal(t, "added a final newline to 1 file(s): "+path, checkErr.Message)
+
+	content, err := os.ReadFile(path) //nolint:gosec // test file path is controlled
+	require.NoError(t, err)
+	assert.Equal(t, "no newline\n", string(content), "files are still fixed")
+
+	cfg.CheckBehaviors.EOFSeverity = config.LintSeverityError
+	require.NoError(t, os.WriteFile(path, []byte("no newline"), 0o600))
+	err = NewEOFCheckWithConfig(cfg).Run(context.Background(), []string{path})
+	require.ErrorAs(t, err, &checkErr)
+	assert.False(t, checkErr.WarnOnly)
+}
+
+func TestEOFCheckLeavesFinalNewlineToWhitespace(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Checks.Whitespace = true
+	cfg.CheckTimeouts.Whitespace = 30
+	cfg.CheckBehaviors.WhitespaceAddFinalNewline = true
+
+	dir := t.TempDir()
+	trailing := filepath.Join(dir, "trailing.txt")
+	clean := filepath.Join(dir, "clean.txt")
+	require.NoError(t, os.WriteFile(trailing, []byte("text  "), 0o600))
+	require.NoError(t, os.WriteFile(clean, []byte("text"), 0o600))
+
+	// Run both the way the runner does, at the same time
+	var eofErr, whitespaceErr error
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		eofErr = NewEOFCheckWithConfig(cfg).Run(context.Background(), []string{trailing, clean})
+	}()
+	whitespaceErr = NewWhitespaceCheckWithConfig(cfg).Run(context.Background(), []string{trailing, clean})
+	<-done
+
+	require.ErrorIs(t, whitespaceErr, prerrors.ErrWhitespaceIssues)
+	require.ErrorIs(t, eofErr, prerrors.ErrEOFIssues)
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, eofErr, &checkErr)
+	assert.Equal(t, []string{clean}, checkErr.Files, "files the whitespace fix rewrites are left to it")
+
+	for _, path := range []string{trailing, clean} {
+		content, err := os.ReadFile(path) //nolint:gosec // test file path is controlled
+		require.NoError(t, err)
+		assert.Equal(t, "text\n", string(content), "exactly one newline is added")
+	}
+
+	cfg.Checks.Whitespace = false
+	assert.Nil(t, NewEOFCheckWithConfig(cfg).whitespace, "nothing to defer to when whitespace is disabled")
+}
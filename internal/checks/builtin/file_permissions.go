@@ -0,0 +1,273 @@
+package builtin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// Git records only whether a regular file is executable
+const (
+	gitModeRegular    = "100644"
+	gitModeExecutable = "100755"
+)
+
+// defaultFilePermissionModes are the git modes allowed when none are configured
+var defaultFilePermissionModes = []string{gitModeRegular, gitModeExecutable}
+
+// FilePermissionCheck fails when a staged regular file has a git mode outside
+// the allowlist, or is world-writable in the working tree. Git tracks only
+// 100644 and 100755, so the allowlist decides whether executables may be
+// committed; symlinks and submodules are left alone. With auto-fix enabled it
+// flips the executable bit with `git update-index --chmod` and in the working
+// tree, and removes world write permission.
+type FilePermissionCheck struct {
+	timeout time.Duration
+	allowed []string
+	autoFix bool
+}
+
+// permissionIssue is one staged file with a disallowed mode
+type permissionIssue struct {
+	file          string
+	gitMode       string      // the mode in the index, set when it is not allowed
+	worldWritable fs.FileMode // the working tree permissions, set when world-writable
+}
+
+// NewFilePermissionCheck creates a new file permission check
+func NewFilePermissionCheck() *FilePermissionCheck {
+	return NewFilePermissionCheckWithConfig(nil)
+}
+
+// NewFilePermissionCheckWithConfig creates a new file permission check with configuration
+func NewFilePermissionCheckWithConfig(cfg *config.Config) *FilePermissionCheck {
+	check := &FilePermissionCheck{
+		timeout: 10 * time.Second,
+		allowed: defaultFilePermissionModes,
+	}
+	if cfg != nil {
+		if cfg.CheckTimeouts.FilePermissions > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.FilePermissions) * time.Second
+		}
+		if len(cfg.CheckBehaviors.FilePermissionModes) > 0 {
+			check.allowed = cfg.CheckBehaviors.FilePermissionModes
+		}
+		check.autoFix = cfg.CheckBehaviors.FilePermissionsAutoFix
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *FilePermissionCheck) Name() string {
+	return "file-permissions"
+}
+
+// Description returns a brief description of the check
+func (c *FilePermissionCheck) Description() string {
+	return "Block world-writable files and file modes outside the allowlist"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *FilePermissionCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "file-permissions",
+		Description:       "Fail when staged files are world-writable or have a git mode outside GO_PRE_COMMIT_FILE_PERMISSION_MODES",
+		FilePatterns:      []string{"*"},
+		EstimatedDuration: 50 * time.Millisecond,
+		Dependencies:      []string{"git"},
+		DefaultTimeout:    c.timeout,
+		Category:          "security",
+		RequiresFiles:     true,
+	}
+}
+
+// Run checks the index mode and working tree permissions of each file,
+// fixing them first when auto-fix is enabled
+func (c *FilePermissionCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	modes, err := stagedModes(ctx, files)
+	if err != nil {
+		return err
+	}
+
+	issues := c.findIssues(files, modes)
+	if len(issues) == 0 {
+		return nil
+	}
+
+	lines := make([]string, 0, len(issues))
+	issueFiles := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		lines = append(lines, issue.String())
+		issueFiles = append(issueFiles, issue.file)
+	}
+
+	if c.autoFix {
+		if err = c.fix(ctx, issues); err != nil {
+			return err
+		}
+		return &prerrors.CheckError{
+			Err:        prerrors.ErrFilePermissions,
+			Message:    fmt.Sprintf("Fixed the permissions of %d file(s)", len(issues)),
+			Suggestion: "Review the mode changes and commit again",
+			Output:     strings.Join(lines, "\n"),
+			Files:      issueFiles,
+			Fixed:      true,
+		}
+	}
+
+	return &prerrors.CheckError{
+		Err:     prerrors.ErrFilePermissions,
+		Message: fmt.Sprintf("%d staged file(s) have disallowed permissions", len(issues)),
+		Suggestion: "Run 'git update-index --chmod=-x <file>' (or +x) and 'chmod o-w <file>', " +
+			"or set GO_PRE_COMMIT_FILE_PERMISSIONS_AUTO_FIX=true",
+		Output: strings.Join(lines, "\n"),
+		Files:  issueFiles,
+	}
+}
+
+// FilterFiles returns every file; modes matter whatever the content
+func (c *FilePermissionCheck) FilterFiles(files []string) []string {
+	return files
+}
+
+// String formats the issue for the check output
+func (i permissionIssue) String() string {
+	var problems []string
+	if i.gitMode != "" {
+		problems = append(problems, fmt.Sprintf("mode %s is not allowed", i.gitMode))
+	}
+	if i.worldWritable != 0 {
+		problems = append(problems, fmt.Sprintf("world-writable (%04o)", i.worldWritable))
+	}
+	return fmt.Sprintf("%s: %s", i.file, strings.Join(problems, ", "))
+}
+
+// findIssues returns the regular files whose index mode is not allowed or
+// that are world-writable. Files missing from the index or the working tree
+// are skipped.
+func (c *FilePermissionCheck) findIssues(files []string, modes map[string]string) []permissionIssue {
+	var issues []permissionIssue
+	for _, file := range files {
+		mode, staged := modes[absPath(file)]
+		if !staged || (mode != gitModeRegular && mode != gitModeExecutable) {
+			continue
+		}
+
+		issue := permissionIssue{file: file}
+		if !slices.Contains(c.allowed, mode) {
+			issue.gitMode = mode
+		}
+		if info, err := os.Lstat(file); err == nil && info.Mode().IsRegular() && info.Mode().Perm()&0o002 != 0 {
+			issue.worldWritable = info.Mode().Perm()
+		}
+		if issue.gitMode != "" || issue.worldWritable != 0 {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// fix removes world write permission and flips the executable bit of files
+// with a disallowed mode, in both the index and the working tree
+func (c *FilePermissionCheck) fix(ctx context.Context, issues []permissionIssue) error {
+	var addExec, removeExec []string
+	for _, issue := range issues {
+		if issue.worldWritable != 0 {
+			if err := os.Chmod(issue.file, issue.worldWritable&^0o002); err != nil {
+				return fmt.Errorf("failed to remove world write permission from %s: %w", issue.file, err)
+			}
+		}
+		switch {
+		case issue.gitMode == gitModeExecutable && slices.Contains(c.allowed, gitModeRegular):
+			removeExec = append(removeExec, issue.file)
+		case issue.gitMode == gitModeRegular && slices.Contains(c.allowed, gitModeExecutable):
+			addExec = append(addExec, issue.file)
+		}
+	}
+
+	if err := chmodStaged(ctx, "-x", removeExec); err != nil {
+		return err
+	}
+	return chmodStaged(ctx, "+x", addExec)
+}
+
+// chmodStaged sets or clears the executable bit of files in the index and in
+// the working tree, so staging them again keeps the new mode
+func chmodStaged(ctx context.Context, flag string, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	args := append([]string{"update-index", "--chmod=" + flag, "--"}, files...)
+	cmd := exec.CommandContext(ctx, "git", args...) //nolint:gosec // git update-index with controlled file list
+	shared.LogCommand(ctx, cmd)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to update file modes: %w (output: %s)", err, strings.TrimSpace(string(output)))
+	}
+
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			continue // the index is what gets committed
+		}
+		perm := info.Mode().Perm() &^ 0o111
+		if flag == "+x" {
+			perm |= (perm & 0o444) >> 2 // execute wherever read is allowed
+		}
+		if err = os.Chmod(file, perm); err != nil {
+			return fmt.Errorf("failed to chmod %s: %w", file, err)
+		}
+	}
+	return nil
+}
+
+// stagedModes returns the index mode of each of files, keyed by absolute
+// path so absolute and relative inputs find their entry alike
+func stagedModes(ctx context.Context, files []string) (map[string]string, error) {
+	args := append([]string{"ls-files", "--stage", "-z", "--"}, files...)
+	cmd := exec.CommandContext(ctx, "git", args...) //nolint:gosec // git ls-files with controlled file list
+	shared.LogCommand(ctx, cmd)
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read staged file modes: %w", err)
+	}
+
+	// Each entry is "<mode> <object> <stage>\t<path>"
+	modes := make(map[string]string, len(files))
+	for _, entry := range bytes.Split(output, []byte{0}) {
+		meta, path, ok := bytes.Cut(entry, []byte{'\t'})
+		if !ok {
+			continue
+		}
+		mode, _, _ := bytes.Cut(meta, []byte{' '})
+		modes[absPath(string(path))] = string(mode)
+	}
+	return modes, nil
+}
+
+// absPath returns file as an absolute path; git prints paths relative to the
+// working directory
+func absPath(file string) string {
+	if abs, err := filepath.Abs(file); err == nil {
+		return abs
+	}
+	return file
+}
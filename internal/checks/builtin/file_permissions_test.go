@@ -0,0 +1,157 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+// stageWithMode writes and stages a file in repoDir with the given working
+// tree permissions
+func stageWithMode(t *testing.T, repoDir, name string, perm os.FileMode) {
+	t.Helper()
+	path := filepath.Join(repoDir, name)
+	require.NoError(t, os.WriteFile(path, []byte("content\n"), 0o600))
+	require.NoError(t, os.Chmod(path, perm)) //nolint:gosec // test sets deliberately loose permissions
+	cmd := exec.CommandContext(context.Background(), "git", "add", "--", name)
+	cmd.Dir = repoDir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+}
+
+// indexMode returns the staged git mode of file
+func indexMode(t *testing.T, file string) string {
+	t.Helper()
+	out, err := exec.CommandContext(context.Background(), "git", "ls-files", "--stage", "--", file).Output() //nolint:gosec // test code with controlled input
+	require.NoError(t, err)
+	return strings.Fields(string(out))[0]
+}
+
+func TestFilePermissionCheckMetadata(t *testing.T) {
+	check := NewFilePermissionCheck()
+
+	assert.Equal(t, "file-permissions", check.Name())
+	assert.NotEmpty(t, check.Description())
+
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "file-permissions", metadata.Name)
+	assert.Equal(t, 10*time.Second, metadata.DefaultTimeout)
+	assert.Equal(t, "security", metadata.Category)
+	assert.Equal(t, []string{"a.go", "run.sh"}, check.FilterFiles([]string{"a.go", "run.sh"}))
+}
+
+func TestFilePermissionCheckCorrectFile(t *testing.T) {
+	repoDir, _ := setupEmptyCommitRepo(t)
+	stageWithMode(t, repoDir, "main.go", 0o644)
+	stageWithMode(t, repoDir, "build.sh", 0o755)
+
+	check := NewFilePermissionCheck()
+	require.NoError(t, check.Run(context.Background(), nil))
+	require.NoError(t, check.Run(context.Background(), []string{"main.go", "build.sh", "missing.txt"}))
+}
+
+func TestFilePermissionCheckWorldWritable(t *testing.T) {
+	repoDir, _ := setupEmptyCommitRepo(t)
+	stageWithMode(t, repoDir, "main.go", 0o644)
+	stageWithMode(t, repoDir, "shared.txt", 0o666)
+
+	err := NewFilePermissionCheck().Run(context.Background(), []string{"main.go", "shared.txt"})
+	require.ErrorIs(t, err, prerrors.ErrFilePermissions)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.False(t, checkErr.Fixed)
+	assert.Equal(t, []string{"shared.txt"}, checkErr.Files)
+	assert.Equal(t, "shared.txt: world-writable (0666)", checkErr.Output)
+
+	absolute := filepath.Join(repoDir, "shared.txt")
+	err = NewFilePermissionCheck().Run(context.Background(), []string{filepath.Join(repoDir, "main.go"), absolute})
+	require.ErrorAs(t, err, &checkErr, "absolute paths find their index entries too")
+	assert.Equal(t, []string{absolute}, checkErr.Files)
+
+	info, statErr := os.Stat("shared.txt")
+	require.NoError(t, statErr)
+	assert.Equal(t, os.FileMode(0o666), info.Mode().Perm(), "nothing changes without auto-fix")
+}
+
+func TestFilePermissionCheckDisallowedMode(t *testing.T) {
+	repoDir, _ := setupEmptyCommitRepo(t)
+	stageWithMode(t, repoDir, "main.go", 0o755)
+
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.FilePermissionModes = []string{"100644"}
+
+	err := NewFilePermissionCheckWithConfig(cfg).Run(context.Background(), []string{"main.go"})
+	require.ErrorIs(t, err, prerrors.ErrFilePermissions)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Equal(t, "main.go: mode 100755 is not allowed", checkErr.Output)
+	assert.Equal(t, "100755", indexMode(t, "main.go"))
+}
+
+func TestFilePermissionCheckAutoFix(t *testing.T) {
+	repoDir, _ := setupEmptyCommitRepo(t)
+	stageWithMode(t, repoDir, "main.go", 0o777)
+	stageWithMode(t, repoDir, "ok.go", 0o644)
+
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.FilePermissions = 5
+	cfg.CheckBehaviors.FilePermissionModes = []string{"100644"}
+	cfg.CheckBehaviors.FilePermissionsAutoFix = true
+	check := NewFilePermissionCheckWithConfig(cfg)
+	assert.Equal(t, 5*time.Second, check.timeout)
+
+	err := check.Run(context.Background(), []string{"main.go", "ok.go"})
+	require.ErrorIs(t, err, prerrors.ErrFilePermissions)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.True(t, checkErr.Fixed)
+	assert.Equal(t, []string{"main.go"}, checkErr.Files)
+	assert.Equal(t, "main.go: mode 100755 is not allowed, world-writable (0777)", checkErr.Output)
+
+	assert.Equal(t, "100644", indexMode(t, "main.go"))
+	info, statErr := os.Stat("main.go")
+	require.NoError(t, statErr)
+	assert.Equal(t, os.FileMode(0o664), info.Mode().Perm())
+
+	require.NoError(t, check.Run(context.Background(), []string{"main.go", "ok.go"}), "fixed files pass")
+}
+
+func TestFilePermissionCheckAutoFixAddsExecutable(t *testing.T) {
+	repoDir, _ := setupEmptyCommitRepo(t)
+	stageWithMode(t, repoDir, "run.sh", 0o644)
+
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.FilePermissionModes = []string{"100755"}
+	cfg.CheckBehaviors.FilePermissionsAutoFix = true
+
+	err := NewFilePermissionCheckWithConfig(cfg).Run(context.Background(), []string{"run.sh"})
+	require.ErrorIs(t, err, prerrors.ErrFilePermissions)
+	assert.Equal(t, "100755", indexMode(t, "run.sh"))
+
+	info, statErr := os.Stat("run.sh")
+	require.NoError(t, statErr)
+	assert.Equal(t, os.FileMode(0o755), info.Mode().Perm())
+}
+
+func TestFilePermissionCheckOutsideRepo(t *testing.T) {
+	t.Chdir(t.TempDir())
+	t.Setenv("GIT_CEILING_DIRECTORIES", filepath.Dir(t.TempDir()))
+
+	err := NewFilePermissionCheck().Run(context.Background(), []string{"main.go"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read staged file modes")
+}
@@ -0,0 +1,231 @@
+package builtin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// FilenameCaseCheck fails when a staged path differs only in case from
+// another path in the index, whether another staged file or one already
+// tracked. macOS and Windows check out such paths as one file, so the second
+// silently overwrites the first. Directories are compared too: a staged
+// Docs/a.md collides with a tracked docs/b.md. A file renamed with
+// `git mv foo.go Foo.go` leaves only the new path in the index and passes.
+type FilenameCaseCheck struct {
+	timeout time.Duration
+}
+
+// caseCollision is a staged path whose name, or the name of one of its
+// directories, matches another path in the index but for case
+type caseCollision struct {
+	file  string // the staged file as given
+	dir   string // the staged file's colliding directory, set when the name itself does not collide
+	other string // the path in the index it collides with
+}
+
+// NewFilenameCaseCheck creates a new filename case check
+func NewFilenameCaseCheck() *FilenameCaseCheck {
+	return NewFilenameCaseCheckWithConfig(nil)
+}
+
+// NewFilenameCaseCheckWithConfig creates a new filename case check with configuration
+func NewFilenameCaseCheckWithConfig(cfg *config.Config) *FilenameCaseCheck {
+	check := &FilenameCaseCheck{
+		timeout: 10 * time.Second,
+	}
+	if cfg != nil && cfg.CheckTimeouts.FilenameCase > 0 {
+		check.timeout = time.Duration(cfg.CheckTimeouts.FilenameCase) * time.Second
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *FilenameCaseCheck) Name() string {
+	return "filename-case"
+}
+
+// Description returns a brief description of the check
+func (c *FilenameCaseCheck) Description() string {
+	return "Block paths that differ only in case from another tracked path"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *FilenameCaseCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "filename-case",
+		Description:       "Fail when a staged path collides on case-insensitive filesystems with a staged or tracked path",
+		FilePatterns:      []string{"*"},
+		EstimatedDuration: 50 * time.Millisecond,
+		Dependencies:      []string{"git"},
+		DefaultTimeout:    c.timeout,
+		Category:          "correctness",
+		RequiresFiles:     true,
+	}
+}
+
+// Run compares each staged path with every path in the index, ignoring case
+func (c *FilenameCaseCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	repoRoot, err := gitRepoRoot(ctx)
+	if err != nil {
+		return err
+	}
+	indexed, err := indexedPaths(ctx, repoRoot)
+	if err != nil {
+		return err
+	}
+
+	collisions := findCaseCollisions(repoRoot, files, indexed)
+	if len(collisions) == 0 {
+		return nil
+	}
+
+	lines := make([]string, 0, len(collisions))
+	issueFiles := make([]string, 0, len(collisions))
+	for _, collision := range collisions {
+		lines = append(lines, collision.String())
+		issueFiles = append(issueFiles, collision.file)
+	}
+
+	return &prerrors.CheckError{
+		Err:        prerrors.ErrFilenameCase,
+		Message:    fmt.Sprintf("%d path(s) collide with another path on case-insensitive filesystems", len(collisions)),
+		Suggestion: "Rename to match the existing path's case, or rename the existing path with 'git mv'",
+		Output:     strings.Join(lines, "\n"),
+		Files:      issueFiles,
+	}
+}
+
+// FilterFiles returns all files; any path can collide
+func (c *FilenameCaseCheck) FilterFiles(files []string) []string {
+	return files
+}
+
+// String formats the collision, naming the directory when that is the part
+// that collides
+func (c caseCollision) String() string {
+	if c.dir != "" {
+		return fmt.Sprintf("%s: directory %s collides with %s on case-insensitive filesystems", c.file, c.dir, c.other)
+	}
+	return fmt.Sprintf("%s: collides with %s on case-insensitive filesystems", c.file, c.other)
+}
+
+// findCaseCollisions returns, for each staged file, the first of its path
+// prefixes, shortest first, that another indexed path spells differently
+// but for case. Files outside repoRoot are ignored.
+func findCaseCollisions(repoRoot string, files, indexed []string) []caseCollision {
+	spellings := make(map[string][]string)
+	for _, indexedPath := range indexed {
+		for _, prefix := range pathPrefixes(indexedPath) {
+			key := strings.ToLower(prefix)
+			if !slices.Contains(spellings[key], prefix) {
+				spellings[key] = append(spellings[key], prefix)
+			}
+		}
+	}
+
+	var collisions []caseCollision
+	for _, file := range files {
+		rel, ok := repoRelative(repoRoot, file)
+		if !ok {
+			continue
+		}
+		prefixes := pathPrefixes(rel)
+		for i, prefix := range prefixes {
+			others := spellings[strings.ToLower(prefix)]
+			index := slices.IndexFunc(others, func(spelling string) bool { return spelling != prefix })
+			if index < 0 {
+				continue
+			}
+			collision := caseCollision{file: file, other: others[index]}
+			if i < len(prefixes)-1 {
+				collision.dir = prefix
+			}
+			collisions = append(collisions, collision)
+			break
+		}
+	}
+	return collisions
+}
+
+// pathPrefixes returns each directory of a slash-separated path followed by
+// the path itself, such as "a", "a/b", and "a/b/c.go"
+func pathPrefixes(p string) []string {
+	var prefixes []string
+	for i := range len(p) {
+		if p[i] == '/' {
+			prefixes = append(prefixes, p[:i])
+		}
+	}
+	return append(prefixes, path.Clean(p))
+}
+
+// repoRelative returns file as a slash-separated path relative to repoRoot,
+// resolving symlinks in its directory when the plain path lies outside (git
+// prints the root with symlinks resolved)
+func repoRelative(repoRoot, file string) (string, bool) {
+	inside := func(abs string) (string, bool) {
+		rel, err := filepath.Rel(repoRoot, abs)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return "", false
+		}
+		return filepath.ToSlash(rel), true
+	}
+
+	abs := absPath(file)
+	if rel, ok := inside(abs); ok {
+		return rel, true
+	}
+	dir, err := filepath.EvalSymlinks(filepath.Dir(abs))
+	if err != nil {
+		return "", false
+	}
+	return inside(filepath.Join(dir, filepath.Base(abs)))
+}
+
+// gitRepoRoot returns the absolute path of the working tree's top directory
+func gitRepoRoot(ctx context.Context) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "--show-toplevel")
+	shared.LogCommand(ctx, cmd)
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to find the repository root: %w", err)
+	}
+	return absPath(strings.TrimSpace(string(output))), nil
+}
+
+// indexedPaths returns every path in the index, staged or already tracked,
+// relative to repoRoot
+func indexedPaths(ctx context.Context, repoRoot string) ([]string, error) {
+	cmd := exec.CommandContext(ctx, "git", "-C", repoRoot, "ls-files", "-z") //nolint:gosec // git ls-files in the repository root
+	shared.LogCommand(ctx, cmd)
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tracked files: %w", err)
+	}
+
+	var paths []string
+	for _, entry := range bytes.Split(output, []byte{0}) {
+		if len(entry) > 0 {
+			paths = append(paths, string(entry))
+		}
+	}
+	return paths, nil
+}
@@ -0,0 +1,117 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+// gitIn runs git in repoDir and fails the test on error
+func gitIn(t *testing.T, repoDir string, args ...string) {
+	t.Helper()
+	cmd := exec.CommandContext(context.Background(), "git", args...) //nolint:gosec // test code with controlled input
+	cmd.Dir = repoDir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+}
+
+// stageFiles writes and stages files in repoDir. git add records each path
+// as given even on case-insensitive filesystems.
+func stageFiles(t *testing.T, repoDir string, names ...string) {
+	t.Helper()
+	for _, name := range names {
+		path := filepath.Join(repoDir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+		require.NoError(t, os.WriteFile(path, []byte("package x\n"), 0o600))
+		gitIn(t, repoDir, "add", "--", name)
+	}
+}
+
+func TestFilenameCaseCheckMetadata(t *testing.T) {
+	check := NewFilenameCaseCheck()
+
+	assert.Equal(t, "filename-case", check.Name())
+	assert.NotEmpty(t, check.Description())
+
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "filename-case", metadata.Name)
+	assert.Equal(t, 10*time.Second, metadata.DefaultTimeout)
+	assert.Equal(t, []string{"a.go", "B.md"}, check.FilterFiles([]string{"a.go", "B.md"}))
+
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.FilenameCase = 3
+	assert.Equal(t, 3*time.Second, NewFilenameCaseCheckWithConfig(cfg).timeout)
+}
+
+func TestFilenameCaseCheckTrackedCollision(t *testing.T) {
+	repoDir, _ := setupEmptyCommitRepo(t)
+	stageFiles(t, repoDir, "foo.go")
+	gitIn(t, repoDir, "commit", "-q", "-m", "add foo.go")
+
+	stageFiles(t, repoDir, "Foo.go")
+
+	err := NewFilenameCaseCheck().Run(context.Background(), []string{"Foo.go"})
+	require.ErrorIs(t, err, prerrors.ErrFilenameCase)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Equal(t, []string{"Foo.go"}, checkErr.Files)
+	assert.Equal(t, "Foo.go: collides with foo.go on case-insensitive filesystems", checkErr.Output)
+}
+
+func TestFilenameCaseCheckStagedCollision(t *testing.T) {
+	repoDir, _ := setupEmptyCommitRepo(t)
+	stageFiles(t, repoDir, "README.md", "readme.md")
+
+	err := NewFilenameCaseCheck().Run(context.Background(), []string{"README.md", "readme.md"})
+	require.ErrorIs(t, err, prerrors.ErrFilenameCase)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Equal(t, []string{"README.md", "readme.md"}, checkErr.Files)
+}
+
+func TestFilenameCaseCheckDirectoryCollision(t *testing.T) {
+	repoDir, _ := setupEmptyCommitRepo(t)
+	stageFiles(t, repoDir, "docs/guide.md")
+	gitIn(t, repoDir, "commit", "-q", "-m", "add docs")
+
+	stageFiles(t, repoDir, "Docs/api/index.md")
+
+	err := NewFilenameCaseCheck().Run(context.Background(), []string{"Docs/api/index.md"})
+	require.ErrorIs(t, err, prerrors.ErrFilenameCase)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Equal(t, "Docs/api/index.md: directory Docs collides with docs on case-insensitive filesystems", checkErr.Output)
+}
+
+func TestFilenameCaseCheckNoCollision(t *testing.T) {
+	repoDir, _ := setupEmptyCommitRepo(t)
+	stageFiles(t, repoDir, "foo.go", "docs/guide.md")
+	gitIn(t, repoDir, "commit", "-q", "-m", "add files")
+
+	stageFiles(t, repoDir, "foo_test.go", "docs/api.md")
+
+	check := NewFilenameCaseCheck()
+	require.NoError(t, check.Run(context.Background(), nil))
+	require.NoError(t, check.Run(context.Background(), []string{"foo_test.go", "docs/api.md", "foo.go"}))
+
+	gitIn(t, repoDir, "mv", "foo.go", "Foo.go")
+	require.NoError(t, check.Run(context.Background(), []string{"Foo.go"}), "a case-only rename leaves one path")
+}
+
+func TestFilenameCaseCheckOutsideRepo(t *testing.T) {
+	t.Chdir(t.TempDir())
+	require.Error(t, NewFilenameCaseCheck().Run(context.Background(), []string{"a.go"}))
+}
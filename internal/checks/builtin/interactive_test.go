@@ -0,0 +1,59 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+func TestWhitespaceCheck_InteractiveConfirmation(t *testing.T) {
+	dir := t.TempDir()
+	accepted := filepath.Join(dir, "accepted.txt")
+	declined := filepath.Join(dir, "declined.txt")
+	require.NoError(t, os.WriteFile(accepted, []byte("a  \n"), 0o600))
+	require.NoError(t, os.WriteFile(declined, []byte("b  \n"), 0o600))
+
+	ctx := shared.WithFixConfirm(context.Background(), func(checkName, file string) bool {
+		assert.Equal(t, "whitespace", checkName)
+		return file == accepted
+	})
+
+	err := NewWhitespaceCheck().Run(ctx, []string{accepted, declined})
+	require.Error(t, err)
+	require.ErrorIs(t, err, prerrors.ErrWhitespaceIssues)
+	assert.Contains(t, err.Error(), "declined.txt: auto-fix declined")
+
+	content, readErr := os.ReadFile(accepted) //nolint:gosec // test file
+	require.NoError(t, readErr)
+	assert.Equal(t, "a\n", string(content))
+
+	content, readErr = os.ReadFile(declined) //nolint:gosec // test file
+	require.NoError(t, readErr)
+	assert.Equal(t, "b  \n", string(content), "declined file must be left untouched")
+}
+
+func TestEOFCheck_InteractiveConfirmation(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "main.go")
+	require.NoError(t, os.WriteFile(file, []byte("package main"), 0o600))
+
+	ctx := shared.WithFixConfirm(context.Background(), func(string, string) bool { return false })
+	err := NewEOFCheck().Run(ctx, []string{file})
+	require.ErrorIs(t, err, prerrors.ErrEOFIssues)
+
+	content, readErr := os.ReadFile(file) //nolint:gosec // test file
+	require.NoError(t, readErr)
+	assert.Equal(t, "package main", string(content))
+
+	// Without a confirmer the fix is applied as before
+	require.ErrorIs(t, NewEOFCheck().Run(context.Background(), []string{file}), prerrors.ErrEOFIssues)
+	content, readErr = os.ReadFile(file) //nolint:gosec // test file
+	require.NoError(t, readErr)
+	assert.Equal(t, "package main\n", string(content))
+}
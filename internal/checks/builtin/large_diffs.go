@@ -0,0 +1,174 @@
+package builtin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/git"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// defaultMaxDiffLines is the per-file change limit when none is configured
+const defaultMaxDiffLines = 1000
+
+// LargeDiffsCheck flags staged files whose added plus removed line count is
+// over a limit, which usually means a generated or vendored file was
+// committed by accident. Files classified as generated are exempt. Findings
+// are warn-only unless the severity is set to error.
+type LargeDiffsCheck struct {
+	timeout    time.Duration
+	maxLines   int
+	blocking   bool
+	classifier *git.FileClassifier
+}
+
+// diffStat is one line of `git diff --numstat` output
+type diffStat struct {
+	Path    string
+	Added   int
+	Removed int
+}
+
+// Lines returns the total number of changed lines
+func (d diffStat) Lines() int {
+	return d.Added + d.Removed
+}
+
+// NewLargeDiffsCheck creates a new large diff check
+func NewLargeDiffsCheck() *LargeDiffsCheck {
+	return NewLargeDiffsCheckWithConfig(nil)
+}
+
+// NewLargeDiffsCheckWithConfig creates a new large diff check with configuration
+func NewLargeDiffsCheckWithConfig(cfg *config.Config) *LargeDiffsCheck {
+	check := &LargeDiffsCheck{
+		timeout:    10 * time.Second,
+		maxLines:   defaultMaxDiffLines,
+		classifier: git.NewFileClassifier(cfg),
+	}
+	if cfg != nil {
+		if cfg.CheckTimeouts.LargeDiffs > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.LargeDiffs) * time.Second
+		}
+		if cfg.CheckBehaviors.MaxDiffLines > 0 {
+			check.maxLines = cfg.CheckBehaviors.MaxDiffLines
+		}
+		check.blocking = cfg.CheckBehaviors.LargeDiffsSeverity == config.LintSeverityError
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *LargeDiffsCheck) Name() string {
+	return "large-diffs"
+}
+
+// Description returns a brief description of the check
+func (c *LargeDiffsCheck) Description() string {
+	return "Warn when one staged file changes more lines than allowed"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *LargeDiffsCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "large-diffs",
+		Description:       "Warn when a staged file's added plus removed lines exceed GO_PRE_COMMIT_MAX_DIFF_LINES",
+		FilePatterns:      []string{"*"},
+		EstimatedDuration: 100 * time.Millisecond,
+		Dependencies:      []string{"git"},
+		DefaultTimeout:    c.timeout,
+		Category:          "git",
+		RequiresFiles:     true,
+	}
+}
+
+// Run executes the large diff check
+func (c *LargeDiffsCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	// -z keeps unusual paths unquoted; --no-renames reports a rename as the
+	// lines it added and removed rather than as "old => new"
+	cmd := exec.CommandContext(ctx, "git", "diff", "--cached", "--numstat", "--no-renames", "-z")
+	shared.LogCommand(ctx, cmd)
+	out, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("failed to read staged diff stats: %w", err)
+	}
+
+	checked := make(map[string]bool, len(files))
+	for _, file := range files {
+		checked[filepath.ToSlash(filepath.Clean(file))] = true
+	}
+
+	var issues []string
+	var issueFiles []string
+	for _, stat := range oversizedDiffs(parseNumstat(out), c.maxLines) {
+		if !checked[stat.Path] || c.classifier.IsGenerated(stat.Path) {
+			continue
+		}
+		issues = append(issues, fmt.Sprintf("%s: %d lines changed (+%d -%d)", stat.Path, stat.Lines(), stat.Added, stat.Removed))
+		issueFiles = append(issueFiles, stat.Path)
+	}
+
+	if len(issues) == 0 {
+		return nil
+	}
+
+	return &prerrors.CheckError{
+		Err:        prerrors.ErrLargeDiff,
+		Message:    fmt.Sprintf("%d staged file(s) change more than %d lines", len(issues), c.maxLines),
+		Suggestion: "Unstage files committed by accident, or raise GO_PRE_COMMIT_MAX_DIFF_LINES if the change is intended",
+		Command:    "git diff --cached --numstat",
+		Output:     strings.Join(issues, "\n"),
+		Files:      issueFiles,
+		WarnOnly:   !c.blocking,
+	}
+}
+
+// FilterFiles returns all files; the line counts come from the index
+func (c *LargeDiffsCheck) FilterFiles(files []string) []string {
+	return files
+}
+
+// parseNumstat parses `git diff --numstat -z` output. Binary files, which
+// numstat reports as "-" counts, are left out since they have no lines.
+func parseNumstat(out []byte) []diffStat {
+	var stats []diffStat
+	for _, record := range bytes.Split(out, []byte{0}) {
+		fields := strings.SplitN(strings.TrimPrefix(string(record), "\n"), "\t", 3)
+		if len(fields) != 3 || fields[2] == "" {
+			continue
+		}
+		added, addErr := strconv.Atoi(fields[0])
+		removed, removeErr := strconv.Atoi(fields[1])
+		if addErr != nil || removeErr != nil {
+			continue
+		}
+		stats = append(stats, diffStat{Path: fields[2], Added: added, Removed: removed})
+	}
+	return stats
+}
+
+// oversizedDiffs returns the stats whose changed line count exceeds limit
+func oversizedDiffs(stats []diffStat, limit int) []diffStat {
+	var over []diffStat
+	for _, stat := range stats {
+		if stat.Lines() > limit {
+			over = append(over, stat)
+		}
+	}
+	return over
+}
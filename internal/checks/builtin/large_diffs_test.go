@@ -0,0 +1,125 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+func TestLargeDiffsCheckMetadata(t *testing.T) {
+	check := NewLargeDiffsCheck()
+
+	assert.Equal(t, "large-diffs", check.Name())
+	assert.NotEmpty(t, check.Description())
+
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "large-diffs", metadata.Name)
+	assert.Equal(t, 10*time.Second, metadata.DefaultTimeout)
+	assert.Equal(t, defaultMaxDiffLines, check.maxLines)
+	assert.False(t, check.blocking)
+	assert.Equal(t, []string{"a", "b"}, check.FilterFiles([]string{"a", "b"}))
+}
+
+func TestNewLargeDiffsCheckWithConfig(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.LargeDiffs = 3
+	cfg.CheckBehaviors.MaxDiffLines = 250
+	cfg.CheckBehaviors.LargeDiffsSeverity = config.LintSeverityError
+
+	check := NewLargeDiffsCheckWithConfig(cfg)
+	assert.Equal(t, 3*time.Second, check.timeout)
+	assert.Equal(t, 250, check.maxLines)
+	assert.True(t, check.blocking)
+}
+
+func TestParseNumstat(t *testing.T) {
+	out := []byte("1200\t30\tgen/big.txt\x00" +
+		"10\t5\tsmall.go\x00" +
+		"-\t-\tlogo.png\x00" +
+		"0\t1001\tdocs/old notes.md\x00")
+
+	stats := parseNumstat(out)
+	assert.Equal(t, []diffStat{
+		{Path: "gen/big.txt", Added: 1200, Removed: 30},
+		{Path: "small.go", Added: 10, Removed: 5},
+		{Path: "docs/old notes.md", Added: 0, Removed: 1001},
+	}, stats, "binary files have no line counts and are left out")
+
+	t.Run("threshold", func(t *testing.T) {
+		over := oversizedDiffs(stats, 1000)
+		require.Len(t, over, 2)
+		assert.Equal(t, "gen/big.txt", over[0].Path)
+		assert.Equal(t, 1230, over[0].Lines())
+		assert.Equal(t, "docs/old notes.md", over[1].Path)
+	})
+
+	t.Run("limit is inclusive", func(t *testing.T) {
+		assert.Empty(t, oversizedDiffs([]diffStat{{Path: "a", Added: 600, Removed: 400}}, 1000))
+	})
+
+	t.Run("empty output", func(t *testing.T) {
+		assert.Empty(t, parseNumstat(nil))
+	})
+}
+
+func TestLargeDiffsCheckRun(t *testing.T) {
+	repoDir, _ := setupEmptyCommitRepo(t)
+	stage := func(name string, lines int) {
+		require.NoError(t, os.WriteFile(filepath.Join(repoDir, name), []byte(strings.Repeat("line\n", lines)), 0o600))
+		cmd := exec.CommandContext(context.Background(), "git", "add", name) //nolint:gosec // test code with controlled input
+		cmd.Dir = repoDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	stage("big.txt", 50)
+	stage("small.txt", 5)
+	stage("mock_store.go", 80) // generated by name
+
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.MaxDiffLines = 20
+	files := []string{"big.txt", "small.txt", "mock_store.go"}
+
+	t.Run("warns about files above the limit", func(t *testing.T) {
+		err := NewLargeDiffsCheckWithConfig(cfg).Run(context.Background(), files)
+		require.Error(t, err)
+
+		var checkErr *prerrors.CheckError
+		require.ErrorAs(t, err, &checkErr)
+		require.ErrorIs(t, err, prerrors.ErrLargeDiff)
+		assert.True(t, checkErr.WarnOnly)
+		assert.Equal(t, []string{"big.txt"}, checkErr.Files)
+		assert.Contains(t, checkErr.Output, "big.txt: 50 lines changed (+50 -0)")
+		assert.NotContains(t, checkErr.Output, "mock_store.go")
+	})
+
+	t.Run("only checks the files it is given", func(t *testing.T) {
+		err := NewLargeDiffsCheckWithConfig(cfg).Run(context.Background(), []string{"small.txt"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("error severity blocks", func(t *testing.T) {
+		blocking := *cfg
+		blocking.CheckBehaviors.LargeDiffsSeverity = config.LintSeverityError
+		var checkErr *prerrors.CheckError
+		require.True(t, errors.As(NewLargeDiffsCheckWithConfig(&blocking).Run(context.Background(), files), &checkErr))
+		assert.False(t, checkErr.WarnOnly)
+	})
+
+	t.Run("under the limit passes", func(t *testing.T) {
+		relaxed := *cfg
+		relaxed.CheckBehaviors.MaxDiffLines = 100
+		assert.NoError(t, NewLargeDiffsCheckWithConfig(&relaxed).Run(context.Background(), files))
+	})
+}
@@ -12,4 +12,9 @@ type CheckMetadata struct {
 	DefaultTimeout    time.Duration
 	Category          string
 	RequiresFiles     bool
+	NeedsNetwork      bool
+	Scope             string // "files" (default), "module", or "repo"
+	Cacheable         bool
+	CacheScope        string   // "files" (default), "package", or "module"
+	ConfigFiles       []string // tool config files whose content keys cached passes
 }
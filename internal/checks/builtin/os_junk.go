@@ -0,0 +1,127 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// defaultOSJunkPatterns are the staged files blocked when no patterns are
+// configured: the folder metadata macOS Finder and Windows Explorer write
+var defaultOSJunkPatterns = []string{".DS_Store", "Thumbs.db", "desktop.ini"}
+
+// OSJunkCheck blocks staged operating system metadata files. With auto-fix
+// enabled it unstages them with `git rm --cached`, leaving the files on disk.
+type OSJunkCheck struct {
+	timeout  time.Duration
+	patterns []string
+	autoFix  bool
+}
+
+// NewOSJunkCheck creates a new OS junk check
+func NewOSJunkCheck() *OSJunkCheck {
+	return NewOSJunkCheckWithConfig(nil)
+}
+
+// NewOSJunkCheckWithConfig creates a new OS junk check with configuration
+func NewOSJunkCheckWithConfig(cfg *config.Config) *OSJunkCheck {
+	check := &OSJunkCheck{
+		timeout:  10 * time.Second,
+		patterns: defaultOSJunkPatterns,
+	}
+	if cfg != nil {
+		if cfg.CheckTimeouts.OSJunk > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.OSJunk) * time.Second
+		}
+		if cfg.CheckBehaviors.OSJunkPatterns != nil {
+			check.patterns = cfg.CheckBehaviors.OSJunkPatterns
+		}
+		check.autoFix = cfg.CheckBehaviors.OSJunkAutoFix
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *OSJunkCheck) Name() string {
+	return "os-junk"
+}
+
+// Description returns a brief description of the check
+func (c *OSJunkCheck) Description() string {
+	return "Block staged .DS_Store, Thumbs.db, and desktop.ini files"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *OSJunkCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "os-junk",
+		Description:       "Fail when staged files match GO_PRE_COMMIT_OS_JUNK_PATTERNS",
+		FilePatterns:      c.patterns,
+		EstimatedDuration: 10 * time.Millisecond,
+		Dependencies:      []string{"git"},
+		DefaultTimeout:    c.timeout,
+		Category:          "git",
+		RequiresFiles:     true,
+	}
+}
+
+// Run fails for every file it is given, unstaging them first when auto-fix
+// is enabled; FilterFiles has already narrowed the staged files to junk
+func (c *OSJunkCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if c.autoFix {
+		if err := c.unstage(ctx, files); err != nil {
+			return err
+		}
+		return &prerrors.CheckError{
+			Err:        prerrors.ErrOSJunk,
+			Message:    "Unstaged operating system metadata files",
+			Suggestion: "Add them to .gitignore so they are not staged again",
+			Output:     strings.Join(files, "\n"),
+			Files:      files,
+			Fixed:      true,
+		}
+	}
+
+	return &prerrors.CheckError{
+		Err:        prerrors.ErrOSJunk,
+		Message:    "Operating system metadata files are staged for commit",
+		Suggestion: "Unstage them with 'git rm --cached <file>' and add them to .gitignore, or set GO_PRE_COMMIT_OS_JUNK_AUTO_FIX=true",
+		Output:     strings.Join(files, "\n"),
+		Files:      files,
+	}
+}
+
+// FilterFiles returns the files matching a junk pattern
+func (c *OSJunkCheck) FilterFiles(files []string) []string {
+	var junk []string
+	for _, file := range files {
+		if matchesAnyPattern(file, c.patterns) {
+			junk = append(junk, file)
+		}
+	}
+	return junk
+}
+
+// unstage removes files from the index and keeps them in the working tree
+func (c *OSJunkCheck) unstage(ctx context.Context, files []string) error {
+	args := append([]string{"rm", "--cached", "--quiet", "--"}, files...)
+	cmd := exec.CommandContext(ctx, "git", args...) //nolint:gosec // git rm with controlled file list
+	shared.LogCommand(ctx, cmd)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to unstage files: %w (output: %s)", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+// stagedFiles lists the files staged in the current repository
+func stagedFiles(t *testing.T) []string {
+	t.Helper()
+	out, err := exec.CommandContext(context.Background(), "git", "diff", "--cached", "--name-only").Output()
+	require.NoError(t, err)
+	return strings.Fields(string(out))
+}
+
+// stageJunk writes and stages files in repoDir
+func stageJunk(t *testing.T, repoDir string, names ...string) {
+	t.Helper()
+	for _, name := range names {
+		path := filepath.Join(repoDir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+		require.NoError(t, os.WriteFile(path, []byte("junk\n"), 0o600))
+	}
+	cmd := exec.CommandContext(context.Background(), "git", append([]string{"add", "--"}, names...)...) //nolint:gosec // test code with controlled input
+	cmd.Dir = repoDir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+}
+
+func TestOSJunkCheckMetadata(t *testing.T) {
+	check := NewOSJunkCheck()
+
+	assert.Equal(t, "os-junk", check.Name())
+	assert.NotEmpty(t, check.Description())
+
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "os-junk", metadata.Name)
+	assert.Equal(t, 10*time.Second, metadata.DefaultTimeout)
+	assert.Equal(t, defaultOSJunkPatterns, metadata.FilePatterns)
+	assert.False(t, check.autoFix)
+}
+
+func TestOSJunkCheck_DSStoreStaged(t *testing.T) {
+	repoDir, _ := setupEmptyCommitRepo(t)
+	stageJunk(t, repoDir, ".DS_Store", "assets/Thumbs.db", "docs/desktop.ini", "main.txt")
+
+	check := NewOSJunkCheck()
+	files := check.FilterFiles([]string{".DS_Store", "assets/Thumbs.db", "docs/desktop.ini", "main.txt"})
+	require.Equal(t, []string{".DS_Store", "assets/Thumbs.db", "docs/desktop.ini"}, files)
+
+	err := check.Run(context.Background(), files)
+	require.ErrorIs(t, err, prerrors.ErrOSJunk)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Equal(t, files, checkErr.Files)
+	assert.False(t, checkErr.Fixed)
+	assert.Contains(t, checkErr.Suggestion, ".gitignore")
+	assert.Contains(t, stagedFiles(t), ".DS_Store", "without auto-fix the index is left alone")
+}
+
+func TestOSJunkCheck_AutoFixUnstages(t *testing.T) {
+	repoDir, _ := setupEmptyCommitRepo(t)
+	stageJunk(t, repoDir, ".DS_Store", "main.txt")
+
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.OSJunkAutoFix = true
+	check := NewOSJunkCheckWithConfig(cfg)
+
+	err := check.Run(context.Background(), check.FilterFiles([]string{".DS_Store", "main.txt"}))
+	require.ErrorIs(t, err, prerrors.ErrOSJunk)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.True(t, checkErr.Fixed, "unstaging fixes the commit")
+	assert.Equal(t, []string{"main.txt"}, stagedFiles(t))
+	assert.FileExists(t, filepath.Join(repoDir, ".DS_Store"), "the file stays on disk")
+}
+
+func TestOSJunkCheck_CleanCommit(t *testing.T) {
+	repoDir, _ := setupEmptyCommitRepo(t)
+	stageJunk(t, repoDir, "main.txt", "docs/DS_Store.md")
+
+	check := NewOSJunkCheck()
+	files := check.FilterFiles([]string{"main.txt", "docs/DS_Store.md"})
+	assert.Empty(t, files)
+	require.NoError(t, check.Run(context.Background(), files))
+}
+
+func TestNewOSJunkCheckWithConfig(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.OSJunk = 3
+	cfg.CheckBehaviors.OSJunkPatterns = []string{"._*"}
+
+	check := NewOSJunkCheckWithConfig(cfg)
+	assert.Equal(t, 3*time.Second, check.timeout)
+	assert.Equal(t, []string{"docs/._notes.md"}, check.FilterFiles([]string{".DS_Store", "docs/._notes.md"}),
+		"configured patterns replace the defaults")
+}
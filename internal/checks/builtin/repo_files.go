@@ -0,0 +1,136 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+// defaultRequiredRepoFiles are the files repo-files requires when none are
+// configured
+var defaultRequiredRepoFiles = []string{"LICENSE*", "README*"}
+
+// RepoFilesCheck fails when the repository root lacks a file every project
+// should have, such as a LICENSE or README. Each required entry is a glob
+// matched, ignoring case, against the files tracked or staged at the root, so
+// "LICENSE*" accepts LICENSE, LICENSE.md, and license.txt. It runs once per
+// invocation whichever files are staged.
+type RepoFilesCheck struct {
+	timeout  time.Duration
+	required []string
+}
+
+// NewRepoFilesCheck creates a new repo files check
+func NewRepoFilesCheck() *RepoFilesCheck {
+	return NewRepoFilesCheckWithConfig(nil)
+}
+
+// NewRepoFilesCheckWithConfig creates a new repo files check with configuration
+func NewRepoFilesCheckWithConfig(cfg *config.Config) *RepoFilesCheck {
+	check := &RepoFilesCheck{
+		timeout:  10 * time.Second,
+		required: defaultRequiredRepoFiles,
+	}
+	if cfg != nil {
+		if cfg.CheckTimeouts.RepoFiles > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.RepoFiles) * time.Second
+		}
+		if cfg.CheckBehaviors.RepoFilesRequired != nil {
+			check.required = cfg.CheckBehaviors.RepoFilesRequired
+		}
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *RepoFilesCheck) Name() string {
+	return "repo-files"
+}
+
+// Description returns a brief description of the check
+func (c *RepoFilesCheck) Description() string {
+	return "Require LICENSE, README, and other files at the repository root"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *RepoFilesCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "repo-files",
+		Description:       "Fail when no file at the repository root matches one of GO_PRE_COMMIT_REPO_FILES_REQUIRED",
+		FilePatterns:      []string{"*"},
+		EstimatedDuration: 20 * time.Millisecond,
+		Dependencies:      []string{"git"},
+		DefaultTimeout:    c.timeout,
+		Category:          "documentation",
+		RequiresFiles:     false, // Runs whichever files are staged
+		Scope:             "repo",
+	}
+}
+
+// Run lists the required entries no file at the repository root matches;
+// the files it is given only trigger the run
+func (c *RepoFilesCheck) Run(ctx context.Context, _ []string) error {
+	if len(c.required) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	repoRoot, err := gitRepoRoot(ctx)
+	if err != nil {
+		return err
+	}
+	indexed, err := indexedPaths(ctx, repoRoot)
+	if err != nil {
+		return err
+	}
+
+	missing := missingRepoFiles(c.required, indexed)
+	if len(missing) == 0 {
+		return nil
+	}
+
+	return &prerrors.CheckError{
+		Err:        prerrors.ErrMissingRepoFiles,
+		Message:    fmt.Sprintf("%d required file(s) missing from the repository root", len(missing)),
+		Suggestion: "Add and stage the missing files, or change GO_PRE_COMMIT_REPO_FILES_REQUIRED",
+		Output:     strings.Join(missing, "\n"),
+	}
+}
+
+// FilterFiles returns all files; any staged file triggers the run
+func (c *RepoFilesCheck) FilterFiles(files []string) []string {
+	return files
+}
+
+// missingRepoFiles returns the required patterns, in order, that match no
+// root-level path in indexed, ignoring case
+func missingRepoFiles(required, indexed []string) []string {
+	var rootFiles []string
+	for _, indexedPath := range indexed {
+		if !strings.Contains(indexedPath, "/") {
+			rootFiles = append(rootFiles, strings.ToLower(indexedPath))
+		}
+	}
+
+	var missing []string
+	for _, pattern := range required {
+		found := false
+		for _, name := range rootFiles {
+			if matched, _ := path.Match(strings.ToLower(pattern), name); matched {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, pattern)
+		}
+	}
+	return missing
+}
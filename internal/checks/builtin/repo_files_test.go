@@ -0,0 +1,81 @@
+package builtin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+// newRequiredFilesCheck returns a repo files check requiring LICENSE,
+// README.md, and SECURITY.md
+func newRequiredFilesCheck() *RepoFilesCheck {
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.RepoFilesRequired = []string{"LICENSE*", "README.md", "SECURITY.md"}
+	return NewRepoFilesCheckWithConfig(cfg)
+}
+
+func TestRepoFilesCheckMetadata(t *testing.T) {
+	check := NewRepoFilesCheck()
+
+	assert.Equal(t, "repo-files", check.Name())
+	assert.NotEmpty(t, check.Description())
+
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "repo-files", metadata.Name)
+	assert.Equal(t, "repo", metadata.Scope)
+	assert.False(t, metadata.RequiresFiles, "runs whichever files are staged")
+	assert.Equal(t, 10*time.Second, metadata.DefaultTimeout)
+	assert.Equal(t, []string{"LICENSE*", "README*"}, check.required)
+	assert.Equal(t, []string{"a.go", "docs/b.md"}, check.FilterFiles([]string{"a.go", "docs/b.md"}))
+
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.RepoFiles = 3
+	assert.Equal(t, 3*time.Second, NewRepoFilesCheckWithConfig(cfg).timeout)
+}
+
+func TestRepoFilesCheckMissingFile(t *testing.T) {
+	repoDir, _ := setupEmptyCommitRepo(t)
+	stageFiles(t, repoDir, "license.txt", "README.md", "docs/SECURITY.md")
+
+	err := newRequiredFilesCheck().Run(context.Background(), []string{"."})
+	require.ErrorIs(t, err, prerrors.ErrMissingRepoFiles)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Equal(t, "SECURITY.md", checkErr.Output, "only the repository root counts")
+	assert.Contains(t, checkErr.Message, "1 required file(s)")
+}
+
+func TestRepoFilesCheckCompleteRepo(t *testing.T) {
+	repoDir, _ := setupEmptyCommitRepo(t)
+	stageFiles(t, repoDir, "LICENSE", "README.md")
+	gitIn(t, repoDir, "commit", "-q", "-m", "add license and readme")
+	stageFiles(t, repoDir, "SECURITY.md")
+
+	assert.NoError(t, newRequiredFilesCheck().Run(context.Background(), nil), "tracked and staged files both count")
+}
+
+func TestRepoFilesCheckUntrackedFile(t *testing.T) {
+	repoDir, _ := setupEmptyCommitRepo(t)
+	stageFiles(t, repoDir, "LICENSE", "README.md")
+	gitIn(t, repoDir, "rm", "-q", "--cached", "README.md")
+
+	err := NewRepoFilesCheck().Run(context.Background(), []string{"."})
+	require.ErrorIs(t, err, prerrors.ErrMissingRepoFiles, "a file only on disk is not committed")
+}
+
+func TestMissingRepoFiles(t *testing.T) {
+	indexed := []string{"License.md", "README.md", "docs/CONTRIBUTING.md"}
+
+	assert.Empty(t, missingRepoFiles([]string{"LICENSE*", "readme.md"}, indexed))
+	assert.Equal(t, []string{"CONTRIBUTING.md", "SECURITY*"},
+		missingRepoFiles([]string{"CONTRIBUTING.md", "LICENSE*", "SECURITY*"}, indexed))
+	assert.Empty(t, missingRepoFiles(nil, indexed))
+}
@@ -0,0 +1,247 @@
+package builtin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/git"
+	"github.com/mrz1836/go-pre-commit/internal/output"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// shellcheckInstallSuggestion tells users how to install shellcheck when it is missing
+const shellcheckInstallSuggestion = "Install shellcheck: brew install shellcheck, apt-get install shellcheck, " +
+	"or see https://github.com/koalaman/shellcheck#installing"
+
+// shellcheckShells are the interpreters shellcheck understands; scripts for
+// other shells, such as zsh and fish, are left alone
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var shellcheckShells = map[string]bool{"sh": true, "bash": true, "dash": true, "ksh": true}
+
+// shellcheckComment is one finding in shellcheck's JSON output (--format=json)
+type shellcheckComment struct {
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Level   string `json:"level"`
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// ShellcheckCheck runs shellcheck on staged shell scripts: files the
+// classifier detects as shell by extension, and extensionless files whose
+// shebang names a shell shellcheck supports. Every reported finding fails
+// the check; the severity setting decides which findings are reported.
+type ShellcheckCheck struct {
+	timeout    time.Duration
+	severity   string
+	classifier *git.FileClassifier
+}
+
+// NewShellcheckCheck creates a new shellcheck check
+func NewShellcheckCheck() *ShellcheckCheck {
+	return NewShellcheckCheckWithConfig(nil)
+}
+
+// NewShellcheckCheckWithConfig creates a new shellcheck check with configuration
+func NewShellcheckCheckWithConfig(cfg *config.Config) *ShellcheckCheck {
+	check := &ShellcheckCheck{
+		timeout:    60 * time.Second,
+		severity:   config.ShellcheckSeverityStyle,
+		classifier: git.NewFileClassifier(cfg),
+	}
+	if cfg != nil {
+		if cfg.CheckTimeouts.Shellcheck > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.Shellcheck) * time.Second
+		}
+		if cfg.CheckBehaviors.ShellcheckSeverity != "" {
+			check.severity = cfg.CheckBehaviors.ShellcheckSeverity
+		}
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *ShellcheckCheck) Name() string {
+	return "shellcheck"
+}
+
+// Description returns a brief description of the check
+func (c *ShellcheckCheck) Description() string {
+	return "Lint shell scripts with shellcheck"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *ShellcheckCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "shellcheck",
+		Description:       "Run shellcheck on staged sh, bash, dash, and ksh scripts",
+		FilePatterns:      []string{"*.sh", "*.bash", "*.ksh"},
+		EstimatedDuration: 2 * time.Second,
+		Dependencies:      []string{"shellcheck"},
+		DefaultTimeout:    c.timeout,
+		Category:          "linting",
+		RequiresFiles:     true,
+	}
+}
+
+// FilterFiles filters to shell scripts shellcheck supports
+func (c *ShellcheckCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if c.isShellScript(file) {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// isShellScript reports whether file is a script shellcheck can lint
+func (c *ShellcheckCheck) isShellScript(file string) bool {
+	if c.classifier.DetectLanguage(file) == git.LanguageShell {
+		ext := strings.ToLower(filepath.Ext(file))
+		return ext != ".zsh" && ext != ".fish"
+	}
+	if filepath.Ext(file) != "" {
+		return false
+	}
+	head, err := c.classifier.ReadFileHead(file, 128)
+	if err != nil {
+		return false
+	}
+	return shellcheckShells[shebangShell(head)]
+}
+
+// shebangShell returns the interpreter named by a "#!" first line, looking
+// past env and its flags, or "" when there is no shebang
+func shebangShell(head []byte) string {
+	if !bytes.HasPrefix(head, []byte("#!")) {
+		return ""
+	}
+	line, _, _ := strings.Cut(string(head[2:]), "\n")
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return ""
+	}
+	interpreter := filepath.Base(fields[0])
+	if interpreter == "env" {
+		interpreter = ""
+		for _, field := range fields[1:] {
+			if !strings.HasPrefix(field, "-") {
+				interpreter = filepath.Base(field)
+				break
+			}
+		}
+	}
+	return interpreter
+}
+
+// Run executes shellcheck on all files at once
+func (c *ShellcheckCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	if _, err := exec.LookPath("shellcheck"); err != nil {
+		return prerrors.NewToolNotFoundError("shellcheck", shellcheckInstallSuggestion)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	args := append([]string{"--format=json", "--severity=" + c.severity, "--"}, files...)
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "shellcheck", args...) //nolint:gosec // Files come from git
+	shared.LogCommand(ctx, cmd)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	// shellcheck exits 1 when it reports findings and higher for real errors
+	err := cmd.Run()
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return prerrors.NewToolExecutionError(
+			"shellcheck",
+			stderr.String(),
+			fmt.Sprintf("shellcheck timed out after %v. Consider increasing GO_PRE_COMMIT_SHELLCHECK_TIMEOUT.", c.timeout),
+		)
+	}
+	var exitErr *exec.ExitError
+	if err != nil && (!errors.As(err, &exitErr) || exitErr.ExitCode() != 1) {
+		return prerrors.NewToolExecutionError("shellcheck --format=json", strings.TrimSpace(stdout.String()+stderr.String()),
+			"Run 'shellcheck' on the scripts to see the full error")
+	}
+
+	diagnostics, parseErr := parseShellcheckJSON(stdout.Bytes())
+	if parseErr != nil {
+		return prerrors.NewToolExecutionError("shellcheck --format=json", strings.TrimSpace(stdout.String()+stderr.String()),
+			"Run 'shellcheck' on the scripts to see the full error")
+	}
+	return shellcheckError(diagnostics)
+}
+
+// parseShellcheckJSON converts shellcheck's JSON output into diagnostics.
+// Its error and warning levels keep their meaning, and info and style
+// findings become info diagnostics.
+func parseShellcheckJSON(data []byte) ([]output.Diagnostic, error) {
+	var comments []shellcheckComment
+	if err := json.Unmarshal(data, &comments); err != nil {
+		return nil, fmt.Errorf("failed to parse shellcheck output: %w", err)
+	}
+
+	diagnostics := make([]output.Diagnostic, 0, len(comments))
+	for _, comment := range comments {
+		severity := output.SeverityInfo
+		switch comment.Level {
+		case config.ShellcheckSeverityError:
+			severity = output.SeverityError
+		case config.ShellcheckSeverityWarning:
+			severity = output.SeverityWarning
+		}
+		diagnostics = append(diagnostics, output.Diagnostic{
+			File:     filepath.ToSlash(comment.File),
+			Line:     comment.Line,
+			Col:      comment.Column,
+			Severity: severity,
+			Rule:     fmt.Sprintf("SC%d", comment.Code),
+			Message:  comment.Message,
+		})
+	}
+	return diagnostics, nil
+}
+
+// shellcheckError reports diagnostics, or returns nil when there are none
+func shellcheckError(diagnostics []output.Diagnostic) error {
+	if len(diagnostics) == 0 {
+		return nil
+	}
+
+	lines := make([]string, 0, len(diagnostics))
+	var files []string
+	seen := make(map[string]bool)
+	for _, d := range diagnostics {
+		lines = append(lines, fmt.Sprintf("%s:%d:%d: %s (%s): %s", d.File, d.Line, d.Col, d.Rule, d.Severity, d.Message))
+		if !seen[d.File] {
+			seen[d.File] = true
+			files = append(files, d.File)
+		}
+	}
+
+	return &prerrors.CheckError{
+		Err:         prerrors.ErrShellcheckIssues,
+		Message:     fmt.Sprintf("shellcheck found %d issue(s) in %d script(s)", len(diagnostics), len(files)),
+		Suggestion:  "Fix the findings, or disable one with '# shellcheck disable=SCxxxx' above the line",
+		Output:      strings.Join(lines, "\n"),
+		Files:       files,
+		Diagnostics: diagnostics,
+	}
+}
@@ -0,0 +1,153 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/output"
+)
+
+const (
+	shellcheckDirty = "testdata/shellcheck/sc2086.sh"
+	shellcheckClean = "testdata/shellcheck/clean.sh"
+)
+
+// fakeShellcheck puts a shellcheck on PATH that reports the recorded SC2086
+// finding for sc2086.sh and nothing for any other script
+func fakeShellcheck(t *testing.T) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script as the fake shellcheck")
+	}
+
+	fixture, err := filepath.Abs("testdata/shellcheck/sc2086.json")
+	require.NoError(t, err)
+	binDir := t.TempDir()
+	script := "#!/bin/sh\n" +
+		"for arg in \"$@\"; do\n" +
+		"  case \"$arg\" in\n" +
+		"    *sc2086.sh) cat \"" + fixture + "\"; exit 1 ;;\n" +
+		"  esac\n" +
+		"done\n" +
+		"echo '[]'\n"
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "shellcheck"), []byte(script), 0o755)) //nolint:gosec // executable test fixture
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+func TestShellcheckCheckMetadata(t *testing.T) {
+	check := NewShellcheckCheck()
+
+	assert.Equal(t, "shellcheck", check.Name())
+	assert.NotEmpty(t, check.Description())
+	assert.Equal(t, config.ShellcheckSeverityStyle, check.severity)
+
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, 60*time.Second, metadata.DefaultTimeout)
+	assert.Equal(t, []string{"shellcheck"}, metadata.Dependencies)
+}
+
+func TestShellcheckCheckFilterFiles(t *testing.T) {
+	dir := t.TempDir()
+	scripts := map[string]string{
+		"deploy":   "#!/usr/bin/env -S bash -e\necho deploy\n",
+		"release":  "#!/bin/sh\necho release\n",
+		"setup":    "#!/usr/bin/env python3\nprint('setup')\n",
+		"notes":    "plain text\n",
+		"prompt.z": "#!/bin/zsh\n",
+	}
+	for name, content := range scripts {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+
+	files := []string{
+		"build.sh", "lib.BASH", "rc.zsh", "config.fish", "main.go",
+		filepath.Join(dir, "deploy"), filepath.Join(dir, "release"), filepath.Join(dir, "setup"),
+		filepath.Join(dir, "notes"), filepath.Join(dir, "prompt.z"), filepath.Join(dir, "missing"),
+	}
+	assert.Equal(t, []string{"build.sh", "lib.BASH", filepath.Join(dir, "deploy"), filepath.Join(dir, "release")},
+		NewShellcheckCheck().FilterFiles(files))
+}
+
+func TestShebangShell(t *testing.T) {
+	tests := map[string]string{
+		"#!/bin/bash\n":               "bash",
+		"#! /bin/sh -e\n":             "sh",
+		"#!/usr/bin/env dash\n":       "dash",
+		"#!/usr/bin/env -S ksh -x\n":  "ksh",
+		"#!/usr/bin/env\n":            "",
+		"echo '#!/bin/sh'\n":          "",
+		"#!\n":                        "",
+		"#!/usr/local/bin/fish -l\nx": "fish",
+	}
+	for head, want := range tests {
+		assert.Equal(t, want, shebangShell([]byte(head)), head)
+	}
+}
+
+func TestParseShellcheckJSON(t *testing.T) {
+	data, err := os.ReadFile("testdata/shellcheck/sc2086.json")
+	require.NoError(t, err)
+
+	diagnostics, err := parseShellcheckJSON(data)
+	require.NoError(t, err)
+	assert.Equal(t, []output.Diagnostic{{
+		File:     shellcheckDirty,
+		Line:     4,
+		Col:      12,
+		Severity: output.SeverityInfo,
+		Rule:     "SC2086",
+		Message:  "Double quote to prevent globbing and word splitting.",
+	}}, diagnostics)
+
+	diagnostics, err = parseShellcheckJSON([]byte(`[{"file":"a.sh","line":1,"column":1,"level":"error","code":1073,"message":"x"},` +
+		`{"file":"a.sh","line":2,"column":3,"level":"warning","code":2034,"message":"y"}]`))
+	require.NoError(t, err)
+	require.Len(t, diagnostics, 2)
+	assert.Equal(t, output.SeverityError, diagnostics[0].Severity)
+	assert.Equal(t, output.SeverityWarning, diagnostics[1].Severity)
+
+	_, err = parseShellcheckJSON([]byte("shellcheck: commitBuffer: invalid argument"))
+	require.Error(t, err)
+}
+
+func TestShellcheckCheckRun(t *testing.T) {
+	if _, err := exec.LookPath("shellcheck"); err != nil {
+		fakeShellcheck(t)
+	}
+	check := NewShellcheckCheck()
+
+	require.NoError(t, check.Run(context.Background(), []string{shellcheckClean}))
+
+	err := check.Run(context.Background(), []string{shellcheckClean, shellcheckDirty})
+	require.ErrorIs(t, err, prerrors.ErrShellcheckIssues)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Equal(t, []string{shellcheckDirty}, checkErr.Files)
+	assert.Contains(t, checkErr.Output, shellcheckDirty+":4:12: SC2086 (info): Double quote")
+	require.NotEmpty(t, checkErr.Diagnostics)
+	assert.Equal(t, "SC2086", checkErr.Diagnostics[0].Rule)
+}
+
+func TestShellcheckCheckRunNoTool(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+
+	err := NewShellcheckCheck().Run(context.Background(), []string{shellcheckDirty})
+	require.ErrorIs(t, err, prerrors.ErrToolNotFound)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.True(t, checkErr.CanSkip)
+	assert.Contains(t, checkErr.Suggestion, "Install shellcheck")
+}
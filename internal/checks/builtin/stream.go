@@ -0,0 +1,141 @@
+package builtin
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+const (
+	// defaultMaxLineSize bounds the per-line buffer of the streaming text checks
+	defaultMaxLineSize = 10 * 1024 * 1024
+
+	// initialLineBufferSize is the starting scanner buffer; it grows up to the max line size
+	initialLineBufferSize = 64 * 1024
+)
+
+// maxLineSizeFromConfig returns the configured max line size in bytes,
+// falling back to the default when unset
+func maxLineSizeFromConfig(cfg *config.Config) int {
+	if cfg != nil && cfg.Performance.MaxLineSizeKB > 0 {
+		return cfg.Performance.MaxLineSizeKB * 1024
+	}
+	return defaultMaxLineSize
+}
+
+// newLineScanner returns a scanner yielding each line with its trailing '\n'
+// (if any), buffering at most maxLineSize bytes so memory stays bounded
+// regardless of file size
+func newLineScanner(r io.Reader, maxLineSize int) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, min(initialLineBufferSize, maxLineSize)), maxLineSize)
+	scanner.Split(scanLinesKeepEOL)
+	return scanner
+}
+
+// scanLinesKeepEOL is a bufio.SplitFunc like bufio.ScanLines that keeps the
+// line terminator so callers can tell whether the final line was terminated
+func scanLinesKeepEOL(data []byte, atEOF bool) (int, []byte, error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		return i + 1, data[:i+1], nil
+	}
+	if atEOF {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
+// scanError wraps a scanner failure, pointing at the line size setting when a
+// line does not fit in the buffer
+func scanError(err error, maxLineSize int) error {
+	if errors.Is(err, bufio.ErrTooLong) {
+		return fmt.Errorf("failed to read file: line longer than %d bytes (raise GO_PRE_COMMIT_MAX_LINE_SIZE_KB): %w", maxLineSize, err)
+	}
+	return fmt.Errorf("failed to read file: %w", err)
+}
+
+// withWritableFile runs fix on filename. With chmodWritable set and the file
+// lacking owner write permission, the permission is added for the fix and the
+// original mode restored afterwards, whether or not the fix succeeded.
+func withWritableFile(filename string, chmodWritable bool, fix func() error) (err error) {
+	if !chmodWritable {
+		return fix()
+	}
+	info, err := os.Stat(filename)
+	if err != nil || info.Mode().Perm()&0o200 != 0 {
+		return fix()
+	}
+
+	perm := info.Mode().Perm()
+	if err = os.Chmod(filename, perm|0o200); err != nil {
+		return fmt.Errorf("failed to make file writable: %w", err)
+	}
+	defer func() {
+		if restoreErr := os.Chmod(filename, perm); restoreErr != nil && err == nil {
+			err = fmt.Errorf("failed to restore file mode: %w", restoreErr)
+		}
+	}()
+	return fix()
+}
+
+// replaceFile streams new content for filename into a temporary file in the
+// same directory and renames it over the original, preserving its mode.
+// Symlinks are resolved so the link target is updated, not replaced.
+func replaceFile(filename string, write func(w *bufio.Writer) error) error {
+	target, err := filepath.EvalSymlinks(filename)
+	if err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	info, err := os.Stat(target)
+	if err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	// Renaming only needs directory write access; keep honoring read-only files
+	probe, err := os.OpenFile(target, os.O_WRONLY, 0) //nolint:gosec // G304: target resolved from the checked file path
+	if err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	_ = probe.Close()
+
+	tmp, err := os.CreateTemp(filepath.Dir(target), "."+filepath.Base(target)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	tmpName := tmp.Name()
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tmp.Close()
+			_ = os.Remove(tmpName)
+		}
+	}()
+
+	w := bufio.NewWriter(tmp)
+	if err = write(w); err != nil {
+		return err
+	}
+	if err = w.Flush(); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err = tmp.Chmod(info.Mode().Perm()); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err = os.Rename(tmpName, target); err != nil { //nolint:gosec // G703: target resolved from the checked file path
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	committed = true
+	return nil
+}
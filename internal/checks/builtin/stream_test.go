@@ -0,0 +1,244 @@
+package builtin
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+// writeLargeFile writes lines copies of line to a new file and returns its path
+func writeLargeFile(t *testing.T, name, line string, lines int, trailer string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	f, err := os.Create(path) //nolint:gosec // test path
+	require.NoError(t, err)
+	w := bufio.NewWriter(f)
+	for i := 0; i < lines; i++ {
+		_, err = w.WriteString(line)
+		require.NoError(t, err)
+	}
+	_, err = w.WriteString(trailer)
+	require.NoError(t, err)
+	require.NoError(t, w.Flush())
+	require.NoError(t, f.Close())
+	return path
+}
+
+// allocatedDuring reports the bytes allocated on the heap while fn runs
+func allocatedDuring(fn func()) uint64 {
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	fn()
+	runtime.ReadMemStats(&after)
+	return after.TotalAlloc - before.TotalAlloc
+}
+
+func TestWhitespaceCheck_LargeFileBoundedMemory(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping large file test in short mode")
+	}
+
+	const lines = 400_000 // ~32MB
+	line := strings.Repeat("x", 76) + "  \t\n"
+	path := writeLargeFile(t, "large.txt", line, lines, "tail   ")
+
+	check := NewWhitespaceCheck()
+	var err error
+	allocated := allocatedDuring(func() {
+		err = check.Run(context.Background(), []string{path})
+	})
+	require.ErrorIs(t, err, prerrors.ErrWhitespaceIssues)
+	assert.Less(t, allocated, uint64(4*1024*1024), "memory must not scale with file size")
+
+	// Verify the content without loading the whole file
+	f, err := os.Open(path) //nolint:gosec // test path
+	require.NoError(t, err)
+	defer func() { _ = f.Close() }()
+
+	want := strings.Repeat("x", 76)
+	scanner := bufio.NewScanner(f)
+	var count int
+	for scanner.Scan() {
+		count++
+		if count <= lines {
+			require.Equal(t, want, scanner.Text(), "line %d", count)
+		} else {
+			assert.Equal(t, "tail", scanner.Text())
+		}
+	}
+	require.NoError(t, scanner.Err())
+	assert.Equal(t, lines+1, count)
+}
+
+func TestEOFCheck_LargeFileBoundedMemory(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping large file test in short mode")
+	}
+
+	path := writeLargeFile(t, "large.txt", strings.Repeat("y", 79)+"\n", 400_000, "no newline")
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+
+	check := NewEOFCheck()
+	allocated := allocatedDuring(func() {
+		err = check.Run(context.Background(), []string{path})
+	})
+	require.ErrorIs(t, err, prerrors.ErrEOFIssues)
+	assert.Less(t, allocated, uint64(1024*1024))
+
+	fixed, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, info.Size()+1, fixed.Size())
+}
+
+func TestWhitespaceCheck_LineLongerThanLimit(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "minified.txt")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Repeat("a", 4096)+" \n"), 0o600))
+
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.Whitespace = 30
+	cfg.Performance.MaxLineSizeKB = 1
+	err := NewWhitespaceCheckWithConfig(cfg).Run(context.Background(), []string{path})
+	require.ErrorIs(t, err, prerrors.ErrWhitespaceIssues)
+	assert.Contains(t, err.Error(), "GO_PRE_COMMIT_MAX_LINE_SIZE_KB")
+
+	// The default limit handles the same line
+	cfg.Performance.MaxLineSizeKB = 0
+	err = NewWhitespaceCheckWithConfig(cfg).Run(context.Background(), []string{path})
+	require.ErrorIs(t, err, prerrors.ErrWhitespaceIssues)
+	content, readErr := os.ReadFile(path) //nolint:gosec // test path
+	require.NoError(t, readErr)
+	assert.Equal(t, strings.Repeat("a", 4096)+"\n", string(content))
+}
+
+func TestWhitespaceCheck_PreservesFileMode(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "script.sh")
+	require.NoError(t, os.WriteFile(path, []byte("echo hi  \n"), 0o600))
+	require.NoError(t, os.Chmod(path, 0o755)) //nolint:gosec // executable script fixture
+
+	require.ErrorIs(t, NewWhitespaceCheck().Run(context.Background(), []string{path}), prerrors.ErrWhitespaceIssues)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o755), info.Mode().Perm())
+
+	// No temp files are left behind
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+// readOnlyFixer is a fixing check with content it needs to fix and the
+// content after fixing
+type readOnlyFixer struct {
+	check interface {
+		Run(context.Context, []string) error
+	}
+	err     error
+	content string
+	fixed   string
+}
+
+// readOnlyFixers returns the whitespace and eof checks configured with
+// GO_PRE_COMMIT_FIX_CHMOD_WRITABLE
+func readOnlyFixers(chmodWritable bool) []readOnlyFixer {
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.Whitespace, cfg.CheckTimeouts.EOF = 30, 30
+	cfg.CheckBehaviors.FixChmodWritable = chmodWritable
+
+	return []readOnlyFixer{
+		{check: NewWhitespaceCheckWithConfig(cfg), err: prerrors.ErrWhitespaceIssues, content: "hello  \n", fixed: "hello\n"},
+		{check: NewEOFCheckWithConfig(cfg), err: prerrors.ErrEOFIssues, content: "hello", fixed: "hello\n"},
+	}
+}
+
+func TestFixers_ReadOnlyFileChmodWritable(t *testing.T) {
+	for _, tt := range readOnlyFixers(true) {
+		path := filepath.Join(t.TempDir(), "readonly.txt")
+		require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+		require.NoError(t, os.Chmod(path, 0o444)) //nolint:gosec // read-only fixture
+		t.Cleanup(func() { _ = os.Chmod(path, 0o600) })
+
+		require.ErrorIs(t, tt.check.Run(context.Background(), []string{path}), tt.err)
+
+		content, err := os.ReadFile(path) //nolint:gosec // test path
+		require.NoError(t, err)
+		assert.Equal(t, tt.fixed, string(content))
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o444), info.Mode().Perm(), "the original mode is restored")
+	}
+}
+
+func TestFixers_ReadOnlyFileWithoutChmod(t *testing.T) {
+	if runtime.GOOS == "windows" || os.Geteuid() == 0 {
+		t.Skip("read-only permissions are not enforced for this user")
+	}
+
+	for _, tt := range readOnlyFixers(false) {
+		path := filepath.Join(t.TempDir(), "readonly.txt")
+		require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+		require.NoError(t, os.Chmod(path, 0o444)) //nolint:gosec // read-only fixture
+		t.Cleanup(func() { _ = os.Chmod(path, 0o600) })
+
+		err := tt.check.Run(context.Background(), []string{path})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to write file")
+
+		content, readErr := os.ReadFile(path) //nolint:gosec // test path
+		require.NoError(t, readErr)
+		assert.Equal(t, tt.content, string(content), "the file is left untouched")
+	}
+}
+
+func TestWithWritableFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "readonly.txt")
+	require.NoError(t, os.WriteFile(path, []byte("x"), 0o600))
+	require.NoError(t, os.Chmod(path, 0o400))
+
+	var modeDuringFix os.FileMode
+	err := withWritableFile(path, true, func() error {
+		info, statErr := os.Stat(path)
+		require.NoError(t, statErr)
+		modeDuringFix = info.Mode().Perm()
+		return assert.AnError
+	})
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Equal(t, os.FileMode(0o600), modeDuringFix, "owner write is added for the fix")
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o400), info.Mode().Perm(), "restored even when the fix fails")
+
+	// Off, the mode is never touched
+	require.NoError(t, withWritableFile(path, false, func() error {
+		info, err = os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o400), info.Mode().Perm())
+		return nil
+	}))
+}
+
+func TestScanLinesKeepEOL(t *testing.T) {
+	scanner := bufio.NewScanner(bytes.NewReader([]byte("a\r\nb\n\nc")))
+	scanner.Split(scanLinesKeepEOL)
+
+	var tokens []string
+	for scanner.Scan() {
+		tokens = append(tokens, scanner.Text())
+	}
+	require.NoError(t, scanner.Err())
+	assert.Equal(t, []string{"a\r\n", "b\n", "\n", "c"}, tokens)
+}
@@ -0,0 +1,6 @@
+#!/usr/bin/env bash
+# Greets the name given as the first argument
+set -euo pipefail
+
+name="${1:-world}"
+echo "Hello ${name}"
@@ -0,0 +1,4 @@
+#!/bin/sh
+# Greets the name given as the first argument
+name=$1
+echo Hello $name
@@ -2,41 +2,61 @@
 package builtin
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/mrz1836/go-pre-commit/internal/config"
 	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/git"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
 )
 
+// markdownHardBreak is the trailing whitespace that ends a Markdown line with
+// a hard line break
+const markdownHardBreak = "  "
+
 // WhitespaceCheck removes trailing whitespace from files
 type WhitespaceCheck struct {
-	timeout   time.Duration
-	config    *config.Config
-	autoStage bool
+	timeout        time.Duration
+	config         *config.Config
+	autoStage      bool
+	chmodWritable  bool // make read-only files writable for the fix
+	preserveMtime  bool // restore the modification time of fixed files
+	markdownBreaks bool // keep two-space hard line breaks in Markdown files
+	warnOnly       bool // report fixed files as a warning instead of failing
+	emptyToNewline bool // leave a single newline of whitespace-only files instead of nothing
+	finalNewline   bool // end rewritten files with a newline when they lack one
+	eofExempt      []string
+	classifier     *git.FileClassifier
 }
 
 // NewWhitespaceCheck creates a new whitespace check
 func NewWhitespaceCheck() *WhitespaceCheck {
 	return &WhitespaceCheck{
-		timeout:   30 * time.Second, // Default 30 second timeout
-		config:    nil,
-		autoStage: false,
+		timeout:        30 * time.Second, // Default 30 second timeout
+		config:         nil,
+		autoStage:      false,
+		markdownBreaks: true,
+		emptyToNewline: true,
+		classifier:     git.NewFileClassifier(nil),
 	}
 }
 
 // NewWhitespaceCheckWithTimeout creates a new whitespace check with custom timeout
 func NewWhitespaceCheckWithTimeout(timeout time.Duration) *WhitespaceCheck {
 	return &WhitespaceCheck{
-		timeout:   timeout,
-		config:    nil,
-		autoStage: false,
+		timeout:        timeout,
+		config:         nil,
+		autoStage:      false,
+		markdownBreaks: true,
+		emptyToNewline: true,
+		classifier:     git.NewFileClassifier(nil),
 	}
 }
 
@@ -44,16 +64,38 @@ func NewWhitespaceCheckWithTimeout(timeout time.Duration) *WhitespaceCheck {
 func NewWhitespaceCheckWithConfig(cfg *config.Config) *WhitespaceCheck {
 	timeout := 30 * time.Second
 	autoStage := false
+	chmodWritable := false
+	preserveMtime := false
+	markdownBreaks := true
+	warnOnly := false
+	emptyToNewline := true
+	finalNewline := false
+	var eofExempt []string
 
 	if cfg != nil {
 		timeout = time.Duration(cfg.CheckTimeouts.Whitespace) * time.Second
 		autoStage = cfg.CheckBehaviors.WhitespaceAutoStage
+		chmodWritable = cfg.CheckBehaviors.FixChmodWritable
+		preserveMtime = cfg.CheckBehaviors.WhitespacePreserveMtime
+		markdownBreaks = cfg.CheckBehaviors.WhitespaceMarkdownBreaks
+		warnOnly = cfg.CheckBehaviors.WhitespaceSeverity == config.LintSeverityWarning
+		emptyToNewline = cfg.CheckBehaviors.WhitespaceEmptyFilePolicy != config.WhitespaceEmptyFileEmpty
+		finalNewline = cfg.CheckBehaviors.WhitespaceAddFinalNewline
+		eofExempt = cfg.CheckBehaviors.EOFExempt
 	}
 
 	return &WhitespaceCheck{
-		timeout:   timeout,
-		config:    cfg,
-		autoStage: autoStage,
+		timeout:        timeout,
+		config:         cfg,
+		autoStage:      autoStage,
+		chmodWritable:  chmodWritable,
+		preserveMtime:  preserveMtime,
+		markdownBreaks: markdownBreaks,
+		warnOnly:       warnOnly,
+		emptyToNewline: emptyToNewline,
+		finalNewline:   finalNewline,
+		eofExempt:      eofExempt,
+		classifier:     git.NewFileClassifier(cfg),
 	}
 }
 
@@ -78,6 +120,7 @@ func (c *WhitespaceCheck) Metadata() any {
 		DefaultTimeout:    c.timeout,
 		Category:          "formatting",
 		RequiresFiles:     true,
+		Cacheable:         true,
 	}
 }
 
@@ -96,7 +139,7 @@ func (c *WhitespaceCheck) Run(ctx context.Context, files []string) error {
 		case <-ctx.Done():
 			return ctx.Err()
 		default:
-			modified, err := c.processFile(file)
+			modified, err := c.processFile(ctx, file)
 			if err != nil {
 				errors = append(errors, fmt.Sprintf("%s: %v", file, err))
 			} else if modified {
@@ -118,8 +161,14 @@ func (c *WhitespaceCheck) Run(ctx context.Context, files []string) error {
 		return fmt.Errorf("%w:\n%s", prerrors.ErrWhitespaceIssues, strings.Join(errors, "\n"))
 	}
 
+	if foundIssues && c.warnOnly {
+		return prerrors.NewWarnOnlyFixedError(prerrors.ErrWhitespaceIssues,
+			fmt.Sprintf("removed trailing whitespace from %d file(s): %s", len(modifiedFiles), strings.Join(modifiedFiles, ", ")),
+			modifiedFiles)
+	}
+
 	if foundIssues {
-		return prerrors.ErrWhitespaceIssues
+		return prerrors.NewFixedError(prerrors.ErrWhitespaceIssues, modifiedFiles)
 	}
 
 	return nil
@@ -136,83 +185,170 @@ func (c *WhitespaceCheck) FilterFiles(files []string) []string {
 	return filtered
 }
 
-// processFile removes trailing whitespace from a single file
-func (c *WhitespaceCheck) processFile(filename string) (bool, error) {
-	// Read file
-	content, err := os.ReadFile(filename) //nolint:gosec // File from user input
-	if err != nil {
-		return false, fmt.Errorf("failed to read file: %w", err)
+// processFile removes trailing whitespace from a single file, asking for
+// confirmation first when an interactive confirmer is present in ctx.
+// Files are streamed line by line, so memory is bounded by the longest line
+// rather than the file size; clean files are only read once and never
+// rewritten, so their modification times are untouched. With preserveMtime
+// set, fixed files keep their original modification time too. Markdown hard
+// line breaks are kept when markdownBreaks is set. A file of nothing but
+// whitespace becomes a single newline, or empty when emptyToNewline is off.
+// With finalNewline set, a fixed file that lacks a final newline gets one,
+// unless it is exempt from the eof check.
+func (c *WhitespaceCheck) processFile(ctx context.Context, filename string) (bool, error) {
+	maxLineSize := maxLineSizeFromConfig(c.config)
+	keepHardBreaks := c.keepHardBreaks(filename)
+
+	stats, err := scanWhitespace(filename, maxLineSize, keepHardBreaks)
+	if err != nil || !stats.trailing {
+		return false, err
 	}
+	finalNewline := c.finalNewline && !matchesAnyPattern(filename, c.eofExempt)
 
-	// Process lines manually without bufio.Scanner to handle files with very long lines
-	// Scanner has a default 64KB token limit which causes "token too long" errors on minified files
-	var modified bool
-	var output bytes.Buffer
-	var hasNonEmptyLines bool
+	// In interactive mode the user may decline the fix, leaving the issue in place
+	if !shared.ConfirmFix(ctx, c.Name(), filename) {
+		return false, prerrors.ErrFixDeclined
+	}
 
-	// Split content on newlines and process each line
-	lines := bytes.Split(content, []byte{'\n'})
+	var modTime time.Time
+	if c.preserveMtime {
+		info, statErr := os.Stat(filename)
+		if statErr != nil {
+			return false, fmt.Errorf("failed to read file: %w", statErr)
+		}
+		modTime = info.ModTime()
+	}
 
-	for i, lineBytes := range lines {
-		// Remove trailing \r if present (handles CRLF line endings)
-		lineBytes = bytes.TrimSuffix(lineBytes, []byte{'\r'})
-		line := string(lineBytes)
-		trimmed := strings.TrimRight(line, " \t")
+	if err = withWritableFile(filename, c.chmodWritable, func() error {
+		return replaceFile(filename, func(w *bufio.Writer) error {
+			if !stats.hasNonEmptyLines {
+				// File contained only whitespace; the policy decides what is left
+				if c.emptyToNewline {
+					return w.WriteByte('\n')
+				}
+				return nil
+			}
+			return trimTrailingWhitespace(filename, w, maxLineSize, keepHardBreaks, finalNewline)
+		})
+	}); err != nil {
+		return false, err
+	}
 
-		if line != trimmed {
-			modified = true
+	if c.preserveMtime {
+		// The zero access time leaves it as the rewrite set it
+		if err = os.Chtimes(filename, time.Time{}, modTime); err != nil {
+			return false, fmt.Errorf("failed to restore modification time: %w", err)
 		}
+	}
+	return true, nil
+}
 
-		if trimmed != "" {
-			hasNonEmptyLines = true
-		}
+// addsFinalNewline reports whether the whitespace fix will rewrite filename
+// and end it with a newline, so the eof check can leave the file alone rather
+// than race the rewrite and add a second one
+func (c *WhitespaceCheck) addsFinalNewline(filename string) bool {
+	if !c.finalNewline || matchesAnyPattern(filename, c.eofExempt) {
+		return false
+	}
+	stats, err := scanWhitespace(filename, maxLineSizeFromConfig(c.config), c.keepHardBreaks(filename))
+	return err == nil && stats.trailing
+}
+
+// keepHardBreaks reports whether Markdown hard line breaks in filename are kept
+func (c *WhitespaceCheck) keepHardBreaks(filename string) bool {
+	return c.markdownBreaks && c.classifier.DetectLanguage(filename) == git.LanguageMarkdown
+}
 
-		output.WriteString(trimmed)
-		// Add newline for all lines except the last (Split includes empty element after final \n)
-		if i < len(lines)-1 {
-			output.WriteByte('\n')
+// whitespaceStats summarizes a file for the whitespace check
+type whitespaceStats struct {
+	trailing         bool // some line ends in spaces or tabs
+	hasNonEmptyLines bool // some line has content besides whitespace
+}
+
+// scanWhitespace reads filename line by line and reports whether any line
+// ends in spaces or tabs (ignoring a CRLF carriage return). With
+// keepHardBreaks, a Markdown hard line break does not count.
+func scanWhitespace(filename string, maxLineSize int, keepHardBreaks bool) (whitespaceStats, error) {
+	var stats whitespaceStats
+
+	in, err := os.Open(filename) //nolint:gosec // File from user input
+	if err != nil {
+		return stats, fmt.Errorf("failed to read file: %w", err)
+	}
+	defer func() { _ = in.Close() }()
+
+	scanner := newLineScanner(in, maxLineSize)
+	for scanner.Scan() {
+		raw := scanner.Bytes()
+		line := bytes.TrimSuffix(bytes.TrimSuffix(raw, []byte{'\n'}), []byte{'\r'})
+		trimmed := trimLine(line, keepHardBreaks)
+		if len(trimmed) != len(line) {
+			stats.trailing = true
 		}
+		if len(trimmed) > 0 {
+			stats.hasNonEmptyLines = true
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return stats, scanError(err, maxLineSize)
 	}
+	return stats, nil
+}
 
-	// Only write if modified
-	if modified {
-		result := output.Bytes()
-
-		// If we have no non-empty lines, we need to handle this carefully
-		if !hasNonEmptyLines && len(content) > 0 {
-			// Special case: if original was just a single newline, keep it as is
-			if len(content) == 1 && content[0] == '\n' {
-				result = []byte{'\n'}
-			} else {
-				// File contained only whitespace that was trimmed away
-				// For substantial content (>5 chars), preserve a newline to avoid complete data loss
-				// This helps satisfy fuzz test expectations about not completely losing substantial content
-				if len(content) > 5 {
-					result = []byte{'\n'}
-				} else if content[len(content)-1] == '\n' {
-					result = []byte{'\n'}
-				} else {
-					result = []byte{}
-				}
-			}
-		} else {
-			// Normal case: remove the extra newline we added in the loop
-			if len(result) > 0 && result[len(result)-1] == '\n' {
-				result = result[:len(result)-1]
-			}
+// trimTrailingWhitespace streams filename to w with trailing spaces and tabs
+// removed from every line. Line endings are normalized to LF, the original
+// final newline is preserved, and a trailing unterminated whitespace-only line
+// is dropped together with the newline before it. With keepHardBreaks,
+// Markdown hard line breaks are kept. With finalNewline, the output always
+// ends with a single newline.
+func trimTrailingWhitespace(filename string, w *bufio.Writer, maxLineSize int, keepHardBreaks, finalNewline bool) error {
+	in, err := os.Open(filename) //nolint:gosec // File from user input
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+	defer func() { _ = in.Close() }()
 
-			// Preserve original file ending
-			if len(content) > 0 && content[len(content)-1] == '\n' {
-				result = append(result, '\n')
-			}
+	var pendingNewline bool
+	scanner := newLineScanner(in, maxLineSize)
+	for scanner.Scan() {
+		raw := scanner.Bytes()
+		endsWithNewline := raw[len(raw)-1] == '\n'
+
+		line := bytes.TrimSuffix(bytes.TrimSuffix(raw, []byte{'\n'}), []byte{'\r'})
+		trimmed := trimLine(line, keepHardBreaks)
+
+		// An unterminated final line that trims to nothing is dropped entirely
+		if !endsWithNewline && len(trimmed) == 0 {
+			pendingNewline = false
+			continue
 		}
 
-		if err := os.WriteFile(filename, result, 0o600); err != nil {
-			return false, fmt.Errorf("failed to write file: %w", err)
+		if pendingNewline {
+			_ = w.WriteByte('\n')
 		}
+		_, _ = w.Write(trimmed)
+		pendingNewline = endsWithNewline
 	}
+	if err = scanner.Err(); err != nil {
+		return scanError(err, maxLineSize)
+	}
+
+	if pendingNewline || finalNewline {
+		_ = w.WriteByte('\n')
+	}
+	return nil
+}
 
-	return modified, nil
+// trimLine removes trailing spaces and tabs from line. With keepHardBreaks,
+// exactly two trailing spaces after text are kept: in Markdown they end the
+// line with a hard break. Any other run of trailing whitespace is removed.
+func trimLine(line []byte, keepHardBreaks bool) []byte {
+	trimmed := bytes.TrimRight(line, " \t")
+	if keepHardBreaks && len(trimmed) > 0 && len(line)-len(trimmed) == len(markdownHardBreak) &&
+		bytes.HasSuffix(line, []byte(markdownHardBreak)) {
+		return line
+	}
+	return trimmed
 }
 
 // stageFiles adds modified files to git staging area
@@ -221,23 +357,14 @@ func (c *WhitespaceCheck) stageFiles(ctx context.Context, files []string) error
 		return nil
 	}
 
-	// Build git add command with all modified files
-	args := append([]string{"add"}, files...)
-	cmd := exec.CommandContext(ctx, "git", args...) //nolint:gosec // git add with controlled file list
-
-	// Set working directory to repository root if possible
+	// Stage from the repository root if possible, else the current directory
+	repoRoot := ""
 	if c.config != nil && c.config.Directory != "" {
 		// Go up from pre-commit directory to repository root
-		repoRoot := filepath.Dir(filepath.Dir(c.config.Directory))
-		cmd.Dir = repoRoot
+		repoRoot = filepath.Dir(filepath.Dir(c.config.Directory))
 	}
 
-	// Run git add command
-	if output, err := cmd.CombinedOutput(); err != nil {
-		return fmt.Errorf("failed to stage files: %w (output: %s)", err, string(output))
-	}
-
-	return nil
+	return git.NewRepositoryWithConfig(repoRoot, c.config).StageFiles(ctx, files)
 }
 
 // isTextFile checks if a file is likely a text file based on extension
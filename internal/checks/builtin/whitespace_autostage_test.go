@@ -33,19 +33,121 @@ func TestNewWhitespaceCheckWithConfig(t *testing.T) {
 			name: "config with auto-stage disabled",
 			config: &config.Config{
 				CheckTimeouts: struct {
-					Fumpt      int
-					Lint       int
-					ModTidy    int
-					Whitespace int
-					EOF        int
-					Gitleaks   int
+					Fumpt                int
+					Lint                 int
+					ModTidy              int
+					Whitespace           int
+					EOF                  int
+					Gitleaks             int
+					BuildTags            int
+					GoIndent             int
+					EmptyCommit          int
+					GoVersion            int
+					ErrorCompare         int
+					TestPresence         int
+					GoGenerate           int
+					LargeDiffs           int
+					Toolchain            int
+					ModulePath           int
+					BuildArtifacts       int
+					OSJunk               int
+					ContextFirst         int
+					ForbiddenImports     int
+					StructTags           int
+					DocComments          int
+					ReceiverNames        int
+					FilePermissions      int
+					EmbeddedBlobs        int
+					DataFormat           int
+					Gosec                int
+					Shellcheck           int
+					Hadolint             int
+					GoDirective          int
+					StubFuncs            int
+					CommitSize           int
+					TestPackage          int
+					ModPair              int
+					ErrorWrap            int
+					InternalImport       int
+					FuncLength           int
+					EnvAccess            int
+					TestOnlyDeps         int
+					GlobalVars           int
+					FileHeaderOrder      int
+					ContextBackground    int
+					MajorVersionConflict int
+					FilenameCase         int
+					BuildConstraints     int
+					RepoFiles            int
+					ErrorVarNaming       int
 				}{
 					Whitespace: 60,
 				},
 				CheckBehaviors: struct {
-					FumptAutoStage      bool
-					WhitespaceAutoStage bool
-					EOFAutoStage        bool
+					FumptAutoStage            bool
+					WhitespaceAutoStage       bool
+					EOFAutoStage              bool
+					BuildTagsAutoFix          bool
+					WarnOnly                  []string
+					MaxLines                  map[string]int
+					WorkDir                   map[string]string
+					GoVersionAllow            []string
+					ErrorCompareSkipTests     bool
+					LintPackageThreshold      int
+					LintSeverity              map[string]string
+					TestPresenceSeverity      string
+					MaxDiffLines              int
+					LargeDiffsSeverity        string
+					ToolchainPolicy           string
+					ToolchainVersion          string
+					ModulePathPrefix          string
+					ResolveFormatterConflicts bool
+					ReRunValidatorsAfterFix   bool
+					GoWorkspace               bool
+					BuildArtifactPatterns     []string
+					OSJunkPatterns            []string
+					OSJunkAutoFix             bool
+					ModTidyDiff               string
+					EOFExempt                 []string
+					ForbiddenImports          []string
+					ForbiddenImportsAllow     []string
+					StructTagKeys             []string
+					DocCommentsSkipMain       bool
+					FixChmodWritable          bool
+					ReceiverNameMaxLength     int
+					ContinueOnError           []string
+					FilePermissionModes       []string
+					FilePermissionsAutoFix    bool
+					EmbeddedBlobMinLength     int
+					WhitespacePreserveMtime   bool
+					DataFormatStyle           string
+					DataFormatIndent          int
+					GosecSeverity             string
+					ShellcheckSeverity        string
+					HadolintSeverity          string
+					StubFuncsSeverity         string
+					MaxStagedFiles            int
+					CommitSizeSeverity        string
+					CommitSizeExempt          []string
+					WhitespaceMarkdownBreaks  bool
+					WhitespaceSeverity        string
+					WhitespaceEmptyFilePolicy string
+					WhitespaceAddFinalNewline bool
+					EOFSeverity               string
+					InternalImportAllow       []string
+					FuncLengthMaxLines        int
+					FuncLengthMaxStatements   int
+					FuncLengthSeverity        string
+					EnvAccessAllow            []string
+					TestOnlyDepsAllow         []string
+					GlobalVarsAllow           []string
+					GlobalVarsSeverity        string
+					FileHeaderLicenseMarkers  []string
+					ContextBackgroundAllow    []string
+					ContextBackgroundSeverity string
+					MajorVersionConflictAllow []string
+					RepoFilesRequired         []string
+					ErrorVarNamingSeverity    string
 				}{
 					WhitespaceAutoStage: false,
 				},
@@ -57,19 +159,121 @@ func TestNewWhitespaceCheckWithConfig(t *testing.T) {
 			name: "config with auto-stage enabled",
 			config: &config.Config{
 				CheckTimeouts: struct {
-					Fumpt      int
-					Lint       int
-					ModTidy    int
-					Whitespace int
-					EOF        int
-					Gitleaks   int
+					Fumpt                int
+					Lint                 int
+					ModTidy              int
+					Whitespace           int
+					EOF                  int
+					Gitleaks             int
+					BuildTags            int
+					GoIndent             int
+					EmptyCommit          int
+					GoVersion            int
+					ErrorCompare         int
+					TestPresence         int
+					GoGenerate           int
+					LargeDiffs           int
+					Toolchain            int
+					ModulePath           int
+					BuildArtifacts       int
+					OSJunk               int
+					ContextFirst         int
+					ForbiddenImports     int
+					StructTags           int
+					DocComments          int
+					ReceiverNames        int
+					FilePermissions      int
+					EmbeddedBlobs        int
+					DataFormat           int
+					Gosec                int
+					Shellcheck           int
+					Hadolint             int
+					GoDirective          int
+					StubFuncs            int
+					CommitSize           int
+					TestPackage          int
+					ModPair              int
+					ErrorWrap            int
+					InternalImport       int
+					FuncLength           int
+					EnvAccess            int
+					TestOnlyDeps         int
+					GlobalVars           int
+					FileHeaderOrder      int
+					ContextBackground    int
+					MajorVersionConflict int
+					FilenameCase         int
+					BuildConstraints     int
+					RepoFiles            int
+					ErrorVarNaming       int
 				}{
 					Whitespace: 90,
 				},
 				CheckBehaviors: struct {
-					FumptAutoStage      bool
-					WhitespaceAutoStage bool
-					EOFAutoStage        bool
+					FumptAutoStage            bool
+					WhitespaceAutoStage       bool
+					EOFAutoStage              bool
+					BuildTagsAutoFix          bool
+					WarnOnly                  []string
+					MaxLines                  map[string]int
+					WorkDir                   map[string]string
+					GoVersionAllow            []string
+					ErrorCompareSkipTests     bool
+					LintPackageThreshold      int
+					LintSeverity              map[string]string
+					TestPresenceSeverity      string
+					MaxDiffLines              int
+					LargeDiffsSeverity        string
+					ToolchainPolicy           string
+					ToolchainVersion          string
+					ModulePathPrefix          string
+					ResolveFormatterConflicts bool
+					ReRunValidatorsAfterFix   bool
+					GoWorkspace               bool
+					BuildArtifactPatterns     []string
+					OSJunkPatterns            []string
+					OSJunkAutoFix             bool
+					ModTidyDiff               string
+					EOFExempt                 []string
+					ForbiddenImports          []string
+					ForbiddenImportsAllow     []string
+					StructTagKeys             []string
+					DocCommentsSkipMain       bool
+					FixChmodWritable          bool
+					ReceiverNameMaxLength     int
+					ContinueOnError           []string
+					FilePermissionModes       []string
+					FilePermissionsAutoFix    bool
+					EmbeddedBlobMinLength     int
+					WhitespacePreserveMtime   bool
+					DataFormatStyle           string
+					DataFormatIndent          int
+					GosecSeverity             string
+					ShellcheckSeverity        string
+					HadolintSeverity          string
+					StubFuncsSeverity         string
+					MaxStagedFiles            int
+					CommitSizeSeverity        string
+					CommitSizeExempt          []string
+					WhitespaceMarkdownBreaks  bool
+					WhitespaceSeverity        string
+					WhitespaceEmptyFilePolicy string
+					WhitespaceAddFinalNewline bool
+					EOFSeverity               string
+					InternalImportAllow       []string
+					FuncLengthMaxLines        int
+					FuncLengthMaxStatements   int
+					FuncLengthSeverity        string
+					EnvAccessAllow            []string
+					TestOnlyDepsAllow         []string
+					GlobalVarsAllow           []string
+					GlobalVarsSeverity        string
+					FileHeaderLicenseMarkers  []string
+					ContextBackgroundAllow    []string
+					ContextBackgroundSeverity string
+					MajorVersionConflictAllow []string
+					RepoFilesRequired         []string
+					ErrorVarNamingSeverity    string
 				}{
 					WhitespaceAutoStage: true,
 				},
@@ -119,19 +323,121 @@ func TestWhitespaceCheck_Run_WithAutoStage(t *testing.T) {
 	cfg := &config.Config{
 		Directory: filepath.Join(".", "pre-commit"), // Current directory structure
 		CheckTimeouts: struct {
-			Fumpt      int
-			Lint       int
-			ModTidy    int
-			Whitespace int
-			EOF        int
-			Gitleaks   int
+			Fumpt                int
+			Lint                 int
+			ModTidy              int
+			Whitespace           int
+			EOF                  int
+			Gitleaks             int
+			BuildTags            int
+			GoIndent             int
+			EmptyCommit          int
+			GoVersion            int
+			ErrorCompare         int
+			TestPresence         int
+			GoGenerate           int
+			LargeDiffs           int
+			Toolchain            int
+			ModulePath           int
+			BuildArtifacts       int
+			OSJunk               int
+			ContextFirst         int
+			ForbiddenImports     int
+			StructTags           int
+			DocComments          int
+			ReceiverNames        int
+			FilePermissions      int
+			EmbeddedBlobs        int
+			DataFormat           int
+			Gosec                int
+			Shellcheck           int
+			Hadolint             int
+			GoDirective          int
+			StubFuncs            int
+			CommitSize           int
+			TestPackage          int
+			ModPair              int
+			ErrorWrap            int
+			InternalImport       int
+			FuncLength           int
+			EnvAccess            int
+			TestOnlyDeps         int
+			GlobalVars           int
+			FileHeaderOrder      int
+			ContextBackground    int
+			MajorVersionConflict int
+			FilenameCase         int
+			BuildConstraints     int
+			RepoFiles            int
+			ErrorVarNaming       int
 		}{
 			Whitespace: 30,
 		},
 		CheckBehaviors: struct {
-			FumptAutoStage      bool
-			WhitespaceAutoStage bool
-			EOFAutoStage        bool
+			FumptAutoStage            bool
+			WhitespaceAutoStage       bool
+			EOFAutoStage              bool
+			BuildTagsAutoFix          bool
+			WarnOnly                  []string
+			MaxLines                  map[string]int
+			WorkDir                   map[string]string
+			GoVersionAllow            []string
+			ErrorCompareSkipTests     bool
+			LintPackageThreshold      int
+			LintSeverity              map[string]string
+			TestPresenceSeverity      string
+			MaxDiffLines              int
+			LargeDiffsSeverity        string
+			ToolchainPolicy           string
+			ToolchainVersion          string
+			ModulePathPrefix          string
+			ResolveFormatterConflicts bool
+			ReRunValidatorsAfterFix   bool
+			GoWorkspace               bool
+			BuildArtifactPatterns     []string
+			OSJunkPatterns            []string
+			OSJunkAutoFix             bool
+			ModTidyDiff               string
+			EOFExempt                 []string
+			ForbiddenImports          []string
+			ForbiddenImportsAllow     []string
+			StructTagKeys             []string
+			DocCommentsSkipMain       bool
+			FixChmodWritable          bool
+			ReceiverNameMaxLength     int
+			ContinueOnError           []string
+			FilePermissionModes       []string
+			FilePermissionsAutoFix    bool
+			EmbeddedBlobMinLength     int
+			WhitespacePreserveMtime   bool
+			DataFormatStyle           string
+			DataFormatIndent          int
+			GosecSeverity             string
+			ShellcheckSeverity        string
+			HadolintSeverity          string
+			StubFuncsSeverity         string
+			MaxStagedFiles            int
+			CommitSizeSeverity        string
+			CommitSizeExempt          []string
+			WhitespaceMarkdownBreaks  bool
+			WhitespaceSeverity        string
+			WhitespaceEmptyFilePolicy string
+			WhitespaceAddFinalNewline bool
+			EOFSeverity               string
+			InternalImportAllow       []string
+			FuncLengthMaxLines        int
+			FuncLengthMaxStatements   int
+			FuncLengthSeverity        string
+			EnvAccessAllow            []string
+			TestOnlyDepsAllow         []string
+			GlobalVarsAllow           []string
+			GlobalVarsSeverity        string
+			FileHeaderLicenseMarkers  []string
+			ContextBackgroundAllow    []string
+			ContextBackgroundSeverity string
+			MajorVersionConflictAllow []string
+			RepoFilesRequired         []string
+			ErrorVarNamingSeverity    string
 		}{
 			WhitespaceAutoStage: true,
 		},
@@ -184,19 +490,121 @@ func TestWhitespaceCheck_Run_AutoStageError(t *testing.T) {
 	cfg := &config.Config{
 		Directory: "/invalid/directory/pre-commit",
 		CheckTimeouts: struct {
-			Fumpt      int
-			Lint       int
-			ModTidy    int
-			Whitespace int
-			EOF        int
-			Gitleaks   int
+			Fumpt                int
+			Lint                 int
+			ModTidy              int
+			Whitespace           int
+			EOF                  int
+			Gitleaks             int
+			BuildTags            int
+			GoIndent             int
+			EmptyCommit          int
+			GoVersion            int
+			ErrorCompare         int
+			TestPresence         int
+			GoGenerate           int
+			LargeDiffs           int
+			Toolchain            int
+			ModulePath           int
+			BuildArtifacts       int
+			OSJunk               int
+			ContextFirst         int
+			ForbiddenImports     int
+			StructTags           int
+			DocComments          int
+			ReceiverNames        int
+			FilePermissions      int
+			EmbeddedBlobs        int
+			DataFormat           int
+			Gosec                int
+			Shellcheck           int
+			Hadolint             int
+			GoDirective          int
+			StubFuncs            int
+			CommitSize           int
+			TestPackage          int
+			ModPair              int
+			ErrorWrap            int
+			InternalImport       int
+			FuncLength           int
+			EnvAccess            int
+			TestOnlyDeps         int
+			GlobalVars           int
+			FileHeaderOrder      int
+			ContextBackground    int
+			MajorVersionConflict int
+			FilenameCase         int
+			BuildConstraints     int
+			RepoFiles            int
+			ErrorVarNaming       int
 		}{
 			Whitespace: 30,
 		},
 		CheckBehaviors: struct {
-			FumptAutoStage      bool
-			WhitespaceAutoStage bool
-			EOFAutoStage        bool
+			FumptAutoStage            bool
+			WhitespaceAutoStage       bool
+			EOFAutoStage              bool
+			BuildTagsAutoFix          bool
+			WarnOnly                  []string
+			MaxLines                  map[string]int
+			WorkDir                   map[string]string
+			GoVersionAllow            []string
+			ErrorCompareSkipTests     bool
+			LintPackageThreshold      int
+			LintSeverity              map[string]string
+			TestPresenceSeverity      string
+			MaxDiffLines              int
+			LargeDiffsSeverity        string
+			ToolchainPolicy           string
+			ToolchainVersion          string
+			ModulePathPrefix          string
+			ResolveFormatterConflicts bool
+			ReRunValidatorsAfterFix   bool
+			GoWorkspace               bool
+			BuildArtifactPatterns     []string
+			OSJunkPatterns            []string
+			OSJunkAutoFix             bool
+			ModTidyDiff               string
+			EOFExempt                 []string
+			ForbiddenImports          []string
+			ForbiddenImportsAllow     []string
+			StructTagKeys             []string
+			DocCommentsSkipMain       bool
+			FixChmodWritable          bool
+			ReceiverNameMaxLength     int
+			ContinueOnError           []string
+			FilePermissionModes       []string
+			FilePermissionsAutoFix    bool
+			EmbeddedBlobMinLength     int
+			WhitespacePreserveMtime   bool
+			DataFormatStyle           string
+			DataFormatIndent          int
+			GosecSeverity             string
+			ShellcheckSeverity        string
+			HadolintSeverity          string
+			StubFuncsSeverity         string
+			MaxStagedFiles            int
+			CommitSizeSeverity        string
+			CommitSizeExempt          []string
+			WhitespaceMarkdownBreaks  bool
+			WhitespaceSeverity        string
+			WhitespaceEmptyFilePolicy string
+			WhitespaceAddFinalNewline bool
+			EOFSeverity               string
+			InternalImportAllow       []string
+			FuncLengthMaxLines        int
+			FuncLengthMaxStatements   int
+			FuncLengthSeverity        string
+			EnvAccessAllow            []string
+			TestOnlyDepsAllow         []string
+			GlobalVarsAllow           []string
+			GlobalVarsSeverity        string
+			FileHeaderLicenseMarkers  []string
+			ContextBackgroundAllow    []string
+			ContextBackgroundSeverity string
+			MajorVersionConflictAllow []string
+			RepoFilesRequired         []string
+			ErrorVarNamingSeverity    string
 		}{
 			WhitespaceAutoStage: true,
 		},
@@ -259,6 +667,26 @@ func TestWhitespaceCheck_StageFiles(t *testing.T) {
 	assert.Contains(t, staged, filepath.Base(testFile))
 }
 
+func TestWhitespaceCheck_StageFilesLockedIndex(t *testing.T) {
+	if !isGitAvailable() {
+		t.Skip("Skipping git staging tests: git not available")
+	}
+
+	repoRoot := t.TempDir()
+	require.NoError(t, exec.CommandContext(context.Background(), "git", "init", "-q", repoRoot).Run()) //nolint:gosec // test code with controlled input
+	testFile := filepath.Join(repoRoot, "test.txt")
+	require.NoError(t, os.WriteFile(testFile, []byte("test content"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(repoRoot, ".git", "index.lock"), nil, 0o600))
+
+	cfg := &config.Config{Directory: filepath.Join(repoRoot, ".github", "pre-commit")}
+	cfg.Git.LockAttempts = 1
+
+	// Staging goes through the repository's index lock retry
+	err := NewWhitespaceCheckWithConfig(cfg).stageFiles(context.Background(), []string{testFile})
+	require.ErrorIs(t, err, prerrors.ErrGitIndexLocked)
+	assert.Contains(t, err.Error(), "after 1 attempt(s)")
+}
+
 func TestWhitespaceCheck_AutoStageFields(t *testing.T) {
 	// Test that existing constructors have autoStage set to false
 	check1 := NewWhitespaceCheck()
@@ -272,9 +700,70 @@ func TestWhitespaceCheck_AutoStageFields(t *testing.T) {
 	// Test that new constructor can enable auto-staging
 	cfg := &config.Config{
 		CheckBehaviors: struct {
-			FumptAutoStage      bool
-			WhitespaceAutoStage bool
-			EOFAutoStage        bool
+			FumptAutoStage            bool
+			WhitespaceAutoStage       bool
+			EOFAutoStage              bool
+			BuildTagsAutoFix          bool
+			WarnOnly                  []string
+			MaxLines                  map[string]int
+			WorkDir                   map[string]string
+			GoVersionAllow            []string
+			ErrorCompareSkipTests     bool
+			LintPackageThreshold      int
+			LintSeverity              map[string]string
+			TestPresenceSeverity      string
+			MaxDiffLines              int
+			LargeDiffsSeverity        string
+			ToolchainPolicy           string
+			ToolchainVersion          string
+			ModulePathPrefix          string
+			ResolveFormatterConflicts bool
+			ReRunValidatorsAfterFix   bool
+			GoWorkspace               bool
+			BuildArtifactPatterns     []string
+			OSJunkPatterns            []string
+			OSJunkAutoFix             bool
+			ModTidyDiff               string
+			EOFExempt                 []string
+			ForbiddenImports          []string
+			ForbiddenImportsAllow     []string
+			StructTagKeys             []string
+			DocCommentsSkipMain       bool
+			FixChmodWritable          bool
+			ReceiverNameMaxLength     int
+			ContinueOnError           []string
+			FilePermissionModes       []string
+			FilePermissionsAutoFix    bool
+			EmbeddedBlobMinLength     int
+			WhitespacePreserveMtime   bool
+			DataFormatStyle           string
+			DataFormatIndent          int
+			GosecSeverity             string
+			ShellcheckSeverity        string
+			HadolintSeverity          string
+			StubFuncsSeverity         string
+			MaxStagedFiles            int
+			CommitSizeSeverity        string
+			CommitSizeExempt          []string
+			WhitespaceMarkdownBreaks  bool
+			WhitespaceSeverity        string
+			WhitespaceEmptyFilePolicy string
+			WhitespaceAddFinalNewline bool
+			EOFSeverity               string
+			InternalImportAllow       []string
+			FuncLengthMaxLines        int
+			FuncLengthMaxStatements   int
+			FuncLengthSeverity        string
+			EnvAccessAllow            []string
+			TestOnlyDepsAllow         []string
+			GlobalVarsAllow           []string
+			GlobalVarsSeverity        string
+			FileHeaderLicenseMarkers  []string
+			ContextBackgroundAllow    []string
+			ContextBackgroundSeverity string
+			MajorVersionConflictAllow []string
+			RepoFilesRequired         []string
+			ErrorVarNamingSeverity    string
 		}{
 			WhitespaceAutoStage: true,
 		},
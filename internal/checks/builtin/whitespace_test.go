@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/mrz1836/go-pre-commit/internal/config"
 	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
 )
 
@@ -271,7 +272,8 @@ func TestWhitespaceCheckRunEmptyFiles(t *testing.T) {
 		name          string
 		fileContent   string
 		expectedFixed bool
-		expectedFinal string
+		expectedFinal string // with the default single-newline policy
+		expectedEmpty string // with the empty policy
 		description   string
 	}{
 		{
@@ -279,6 +281,7 @@ func TestWhitespaceCheckRunEmptyFiles(t *testing.T) {
 			fileContent:   "",
 			expectedFixed: false,
 			expectedFinal: "",
+			expectedEmpty: "",
 			description:   "Empty files should remain empty",
 		},
 		{
@@ -286,86 +289,112 @@ func TestWhitespaceCheckRunEmptyFiles(t *testing.T) {
 			fileContent:   "\n",
 			expectedFixed: false,
 			expectedFinal: "\n",
-			description:   "Single newline should be preserved",
+			expectedEmpty: "\n",
+			description:   "Files without trailing whitespace are never rewritten",
 		},
 		{
 			name:          "file with only spaces",
 			fileContent:   "   ",
 			expectedFixed: true,
-			expectedFinal: "",
-			description:   "File with only spaces should become empty",
+			expectedFinal: "\n",
+			expectedEmpty: "",
+			description:   "File with only spaces follows the policy",
 		},
 		{
 			name:          "file with only tabs",
 			fileContent:   "\t\t\t",
 			expectedFixed: true,
-			expectedFinal: "",
-			description:   "File with only tabs should become empty",
+			expectedFinal: "\n",
+			expectedEmpty: "",
+			description:   "File with only tabs follows the policy",
 		},
 		{
 			name:          "file with only mixed whitespace",
 			fileContent:   " \t  \t ",
 			expectedFixed: true,
 			expectedFinal: "\n",
-			description:   "File with only mixed whitespace should preserve newline",
+			expectedEmpty: "",
+			description:   "File with only mixed whitespace follows the policy",
 		},
 		{
 			name:          "file with spaces and newline",
 			fileContent:   "   \n",
 			expectedFixed: true,
 			expectedFinal: "\n",
-			description:   "File with trailing spaces before newline should preserve newline",
+			expectedEmpty: "",
+			description:   "A final newline does not change the outcome",
 		},
 		{
 			name:          "file with tabs and newline",
 			fileContent:   "\t\t\n",
 			expectedFixed: true,
 			expectedFinal: "\n",
-			description:   "File with trailing tabs before newline should preserve newline",
+			expectedEmpty: "",
+			description:   "A final newline does not change the outcome",
 		},
 		{
 			name:          "file with substantial whitespace content",
-			fileContent:   "      \t\t   \t  ", // More than 5 chars
+			fileContent:   "      \t\t   \t  ",
 			expectedFixed: true,
 			expectedFinal: "\n",
-			description:   "Substantial whitespace content should preserve a newline",
+			expectedEmpty: "",
+			description:   "The length of the whitespace does not change the outcome",
 		},
 		{
 			name:          "file with substantial whitespace and final newline",
-			fileContent:   "      \t\t   \t  \n", // More than 5 chars plus newline
+			fileContent:   "      \t\t   \t  \n",
 			expectedFixed: true,
 			expectedFinal: "\n",
-			description:   "Substantial whitespace with final newline should preserve single newline",
+			expectedEmpty: "",
+			description:   "The length of the whitespace does not change the outcome",
+		},
+		{
+			name:          "file with several whitespace-only lines",
+			fileContent:   "   \n\t\t\n \t \n",
+			expectedFixed: true,
+			expectedFinal: "\n",
+			expectedEmpty: "",
+			description:   "Several blank lines collapse like one",
 		},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			tmpDir := t.TempDir()
-			testFile := filepath.Join(tmpDir, "test.txt")
+	for _, policy := range []string{config.WhitespaceEmptyFileNewline, config.WhitespaceEmptyFileEmpty} {
+		for _, tt := range tests {
+			t.Run(policy+"/"+tt.name, func(t *testing.T) {
+				tmpDir := t.TempDir()
+				testFile := filepath.Join(tmpDir, "test.txt")
 
-			// Create test file
-			err := os.WriteFile(testFile, []byte(tt.fileContent), 0o600)
-			require.NoError(t, err)
+				// Create test file
+				err := os.WriteFile(testFile, []byte(tt.fileContent), 0o600)
+				require.NoError(t, err)
 
-			check := NewWhitespaceCheck()
-			ctx := context.Background()
+				cfg := &config.Config{}
+				cfg.CheckTimeouts.Whitespace = 30
+				cfg.CheckBehaviors.WhitespaceEmptyFilePolicy = policy
+				check := NewWhitespaceCheckWithConfig(cfg)
+				ctx := context.Background()
 
-			// Run the check
-			err = check.Run(ctx, []string{testFile})
+				// Run the check
+				err = check.Run(ctx, []string{testFile})
 
-			if tt.expectedFixed {
-				require.Error(t, err)
-				require.ErrorIs(t, err, prerrors.ErrWhitespaceIssues)
-			} else {
-				require.NoError(t, err)
-			}
+				if tt.expectedFixed {
+					require.Error(t, err)
+					require.ErrorIs(t, err, prerrors.ErrWhitespaceIssues)
+				} else {
+					require.NoError(t, err)
+				}
 
-			// Verify file content after processing
-			content, err := os.ReadFile(testFile) //nolint:gosec // test file path is controlled
-			require.NoError(t, err)
-			assert.Equal(t, tt.expectedFinal, string(content), tt.description)
-		})
+				expected := tt.expectedFinal
+				if policy == config.WhitespaceEmptyFileEmpty {
+					expected = tt.expectedEmpty
+				}
+
+				// Verify file content after processing
+				content, err := os.ReadFile(testFile) //nolint:gosec // test file path is controlled
+				require.NoError(t, err)
+				assert.Equal(t, expected, string(content), tt.description)
+			})
+		}
 	}
 }
 
@@ -760,7 +789,7 @@ func TestWhitespaceCheckProcessFileDirect(t *testing.T) {
 			check := NewWhitespaceCheck()
 
 			// Call processFile directly
-			modified, err := check.processFile(testFile)
+			modified, err := check.processFile(context.Background(), testFile)
 
 			if tt.expectedError {
 				require.Error(t, err)
@@ -1270,3 +1299,213 @@ func TestWhitespaceCheckEdgeCaseFileEndings(t *testing.T) {
 		})
 	}
 }
+
+// writeAged writes content to a file in a temp directory and backdates its
+// modification time, returning the path and its file info
+func writeAged(t *testing.T, content string) (string, os.FileInfo) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "file.txt")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	aged := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	require.NoError(t, os.Chtimes(path, aged, aged))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	return path, info
+}
+
+func TestWhitespaceCheckCleanFileNotRewritten(t *testing.T) {
+	for _, preserveMtime := range []bool{false, true} {
+		cfg := &config.Config{}
+		cfg.CheckTimeouts.Whitespace = 30
+		cfg.CheckBehaviors.WhitespacePreserveMtime = preserveMtime
+
+		path, before := writeAged(t, "clean\r\nlines\n")
+		require.NoError(t, NewWhitespaceCheckWithConfig(cfg).Run(context.Background(), []string{path}))
+
+		after, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.True(t, os.SameFile(before, after), "a fix would replace the file")
+		assert.Equal(t, before.ModTime(), after.ModTime())
+	}
+}
+
+func TestWhitespaceCheckPreserveMtime(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.Whitespace = 30
+	cfg.CheckBehaviors.WhitespacePreserveMtime = true
+	check := NewWhitespaceCheckWithConfig(cfg)
+	assert.True(t, check.preserveMtime)
+
+	path, before := writeAged(t, "hello  \nworld\t\n")
+	require.ErrorIs(t, check.Run(context.Background(), []string{path}), prerrors.ErrWhitespaceIssues)
+
+	content, err := os.ReadFile(path) //nolint:gosec // test file path is controlled
+	require.NoError(t, err)
+	assert.Equal(t, "hello\nworld\n", string(content))
+	after, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, before.ModTime(), after.ModTime(), "the fixed file keeps its modification time")
+
+	// Without the option the rewrite stamps the current time
+	path, before = writeAged(t, "hello  \n")
+	require.ErrorIs(t, NewWhitespaceCheck().Run(context.Background(), []string{path}), prerrors.ErrWhitespaceIssues)
+	after, err = os.Stat(path)
+	require.NoError(t, err)
+	assert.True(t, after.ModTime().After(before.ModTime()))
+}
+
+func TestWhitespaceCheckMarkdownHardBreaks(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		breaks   bool
+		content  string
+		expected string
+		fixed    bool
+	}{
+		{
+			name:     "two-space line break is preserved",
+			file:     "README.md",
+			breaks:   true,
+			content:  "first line  \nsecond line\n",
+			expected: "first line  \nsecond line\n",
+		},
+		{
+			name:     "three spaces are trimmed to none",
+			file:     "README.md",
+			breaks:   true,
+			content:  "first line   \nsecond line\n",
+			expected: "first line\nsecond line\n",
+			fixed:    true,
+		},
+		{
+			name:     "tabs and whitespace-only lines are trimmed",
+			file:     "README.md",
+			breaks:   true,
+			content:  "tab\t\n  \nmixed \t \nbreak  \r\n",
+			expected: "tab\n\nmixed\nbreak  \n",
+			fixed:    true,
+		},
+		{
+			name:     "other languages trim two spaces",
+			file:     "notes.txt",
+			breaks:   true,
+			content:  "first line  \n",
+			expected: "first line\n",
+			fixed:    true,
+		},
+		{
+			name:     "disabled trims Markdown breaks",
+			file:     "README.md",
+			content:  "first line  \n",
+			expected: "first line\n",
+			fixed:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{}
+			cfg.CheckTimeouts.Whitespace = 30
+			cfg.CheckBehaviors.WhitespaceMarkdownBreaks = tt.breaks
+
+			path := filepath.Join(t.TempDir(), tt.file)
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+
+			err := NewWhitespaceCheckWithConfig(cfg).Run(context.Background(), []string{path})
+			if tt.fixed {
+				require.ErrorIs(t, err, prerrors.ErrWhitespaceIssues)
+			} else {
+				require.NoError(t, err)
+			}
+
+			content, err := os.ReadFile(path) //nolint:gosec // test file path is controlled
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, string(content))
+		})
+	}
+
+	assert.True(t, NewWhitespaceCheck().markdownBreaks, "kept by default")
+}
+
+func TestWhitespaceCheckWarnOnlySeverity(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.Whitespace = 30
+	cfg.CheckBehaviors.WhitespaceSeverity = config.LintSeverityWarning
+
+	dir := t.TempDir()
+	dirty := filepath.Join(dir, "dirty.txt")
+	clean := filepath.Join(dir, "clean.txt")
+	require.NoError(t, os.WriteFile(dirty, []byte("trailing \t\n"), 0o600))
+	require.NoError(t, os.WriteFile(clean, []byte("clean\n"), 0o600))
+
+	err := NewWhitespaceCheckWithConfig(cfg).Run(context.Background(), []string{dirty, clean})
+	require.ErrorIs(t, err, prerrors.ErrWhitespaceIssues)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.True(t, checkErr.WarnOnly, "the fix is reported without blocking")
+	assert.True(t, checkErr.Fixed)
+	assert.Equal(t, []string{dirty}, checkErr.Files)
+	assert.Equal(t, "removed trailing whitespace from 1 file(s): "+dirty, checkErr.Message)
+
+	content, err := os.ReadFile(dirty) //nolint:gosec // test file path is controlled
+	require.NoError(t, err)
+	assert.Equal(t, "trailing\n", string(content), "files are still fixed")
+
+	require.NoError(t, NewWhitespaceCheckWithConfig(cfg).Run(context.Background(), []string{clean}),
+		"clean files pass without a warning")
+}
+
+func TestWhitespaceCheckAddFinalNewline(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		add      bool
+		expected string
+	}{
+		{name: "off keeps no newline", file: "a.txt", content: "text  ", expected: "text"},
+		{name: "off drops whitespace-only last line", file: "a.txt", content: "text\n  ", expected: "text"},
+		{name: "on adds newline", file: "a.txt", content: "text  ", add: true, expected: "text\n"},
+		{name: "on adds newline after dropped last line", file: "a.txt", content: "text\n  ", add: true, expected: "text\n"},
+		{name: "on keeps existing newline", file: "a.txt", content: "text  \n", add: true, expected: "text\n"},
+		{name: "on normalizes CRLF without a second newline", file: "a.txt", content: "text \r\nmore\t", add: true, expected: "text\nmore\n"},
+		{name: "on skips files exempt from eof", file: "render.golden.json", content: "{}  ", add: true, expected: "{}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{}
+			cfg.CheckTimeouts.Whitespace = 30
+			cfg.CheckBehaviors.WhitespaceAddFinalNewline = tt.add
+			cfg.CheckBehaviors.EOFExempt = []string{"*.golden.json"}
+
+			path := filepath.Join(t.TempDir(), tt.file)
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+
+			err := NewWhitespaceCheckWithConfig(cfg).Run(context.Background(), []string{path})
+			require.ErrorIs(t, err, prerrors.ErrWhitespaceIssues)
+
+			content, err := os.ReadFile(path) //nolint:gosec // test file path is controlled
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, string(content))
+		})
+	}
+
+	t.Run("clean file without newline is left to eof", func(t *testing.T) {
+		cfg := &config.Config{}
+		cfg.CheckTimeouts.Whitespace = 30
+		cfg.CheckBehaviors.WhitespaceAddFinalNewline = true
+
+		path := filepath.Join(t.TempDir(), "clean.txt")
+		require.NoError(t, os.WriteFile(path, []byte("clean"), 0o600))
+		require.NoError(t, NewWhitespaceCheckWithConfig(cfg).Run(context.Background(), []string{path}))
+
+		content, err := os.ReadFile(path) //nolint:gosec // test file path is controlled
+		require.NoError(t, err)
+		assert.Equal(t, "clean", string(content))
+	})
+
+	assert.False(t, NewWhitespaceCheck().finalNewline, "off by default")
+}
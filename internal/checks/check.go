@@ -31,8 +31,46 @@ type CheckMetadata struct {
 
 	// RequiresFiles indicates if the check needs at least one file to run
 	RequiresFiles bool
+
+	// NeedsNetwork indicates the check may reach the network, such as the
+	// module proxy, and is skipped in offline mode
+	NeedsNetwork bool
+
+	// Scope is the input the check runs on: ScopeFiles (the default when
+	// empty) for the matching files, ScopeModule for the root of each Go
+	// module holding one, or ScopeRepo for a single run on the repository
+	Scope string
+
+	// Cacheable indicates a pass may be served from the result cache while
+	// the check's inputs are unchanged. Checks that read the index, the
+	// commit, or anything beyond their inputs leave it off.
+	Cacheable bool
+
+	// CacheScope is what a cached pass covers: CacheScopeFiles (the default
+	// when empty) for each checked file, CacheScopePackage for the Go files of
+	// its package and the module's go.mod, or CacheScopeModule for every Go
+	// file of its module with go.mod and go.sum
+	CacheScope string
+
+	// ConfigFiles names tool configuration files, looked up in the module
+	// and repository roots, whose content also keys cached passes
+	ConfigFiles []string
 }
 
+// Check scopes for CheckMetadata.Scope
+const (
+	ScopeFiles  = "files"
+	ScopeModule = "module"
+	ScopeRepo   = "repo"
+)
+
+// Cache scopes for CheckMetadata.CacheScope
+const (
+	CacheScopeFiles   = "files"
+	CacheScopePackage = "package"
+	CacheScopeModule  = "module"
+)
+
 // Check is the interface that all pre-commit checks must implement
 type Check interface {
 	// Name returns the name of the check
@@ -0,0 +1,129 @@
+package gotools
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// findings collects a check's issues, one line each, and the files they
+// were found in, both in the order they were found
+type findings struct {
+	issues []string
+	files  []string
+}
+
+// add records issues found in file
+func (f *findings) add(file string, issues ...string) {
+	if len(issues) == 0 {
+		return
+	}
+	f.issues = append(f.issues, issues...)
+	if !slices.Contains(f.files, file) {
+		f.files = append(f.files, file)
+	}
+}
+
+// checkReport describes the CheckError a check returns for its findings
+type checkReport struct {
+	err        error  // sentinel the CheckError wraps
+	message    string // format of the message, given the number of issues
+	suggestion string
+	warnOnly   bool
+}
+
+// result returns nil when nothing was found, and otherwise a CheckError
+// listing the issues one per line against the files they were found in
+func (r checkReport) result(found *findings) error {
+	if len(found.issues) == 0 {
+		return nil
+	}
+	return &prerrors.CheckError{
+		Err:        r.err,
+		Message:    fmt.Sprintf(r.message, len(found.issues)),
+		Suggestion: r.suggestion,
+		Output:     strings.Join(found.issues, "\n"),
+		Files:      found.files,
+		WarnOnly:   r.warnOnly,
+	}
+}
+
+// astCheck is a check that inspects Go files one at a time: find parses a
+// file's content and returns what it flags. Checks provide the finder and
+// how findings are reported; reading, skipping, and reporting are shared.
+type astCheck[T fmt.Stringer] struct {
+	checkReport
+
+	sharedCtx *shared.Context
+	timeout   time.Duration
+	skip      func(repoRoot, file string) bool // leaves a file out before it is read; optional
+	find      func(file string, content []byte) ([]T, error)
+}
+
+// run checks files within the check's timeout, resolving them against the
+// repository root
+func (a astCheck[T]) run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	return a.scan(ctx, repoRootOrEmpty(ctx, a.sharedCtx), files)
+}
+
+// scan passes each file under repoRoot to the finder and reports every
+// finding. Files the finder cannot parse are skipped: they are the
+// compiler's and formatter's concern.
+func (a astCheck[T]) scan(ctx context.Context, repoRoot string, files []string) error {
+	var skip func(string) bool
+	if a.skip != nil {
+		skip = func(file string) bool { return a.skip(repoRoot, file) }
+	}
+
+	found := &findings{}
+	err := readGoSources(ctx, repoRoot, files, skip, found, func(file string, content []byte) {
+		flagged, err := a.find(file, content)
+		if err != nil {
+			return
+		}
+		for _, finding := range flagged {
+			found.add(file, finding.String())
+		}
+	})
+	if err != nil {
+		return err
+	}
+	return a.result(found)
+}
+
+// readGoSources calls visit with the content of each file under repoRoot
+// that skip, when set, does not leave out. Files that cannot be read are
+// added to found. It stops with ctx's error once ctx is done.
+func readGoSources(ctx context.Context, repoRoot string, files []string, skip func(file string) bool,
+	found *findings, visit func(file string, content []byte),
+) error {
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if skip != nil && skip(file) {
+			continue
+		}
+
+		content, err := os.ReadFile(resolveRepoPath(repoRoot, file)) //nolint:gosec // File from staged file list
+		if err != nil {
+			found.add(file, fmt.Sprintf("%s: failed to read file: %v", file, err))
+			continue
+		}
+		visit(file, content)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package gotools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+// finderCase is one row of a finder's table test: the findings want lists,
+// as strings, are expected in content checked as file "a.go"
+type finderCase struct {
+	name    string
+	content string
+	want    []string
+}
+
+// runFinderCases runs find over each case as a subtest
+func runFinderCases[T fmt.Stringer](t *testing.T, cases []finderCase, find func(file string, content []byte) ([]T, error)) {
+	t.Helper()
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			found, err := find("a.go", []byte(tc.content))
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, findingStrings(found))
+		})
+	}
+}
+
+// findingStrings returns the String of each finding, or nil for none
+func findingStrings[T fmt.Stringer](found []T) []string {
+	var got []string
+	for _, finding := range found {
+		got = append(got, finding.String())
+	}
+	return got
+}
+
+// testFinding is a finding of the test finder, one per "flag" line
+type testFinding struct {
+	file string
+	line int
+}
+
+func (f testFinding) String() string {
+	return fmt.Sprintf("%s:%d: flagged", f.file, f.line)
+}
+
+// errTestParse is what the test finder returns for content starting "bad"
+var errTestParse = errors.New("cannot parse")
+
+// findTestFindings flags each line of content that reads "flag"
+func findTestFindings(file string, content []byte) ([]testFinding, error) {
+	if strings.HasPrefix(string(content), "bad") {
+		return nil, errTestParse
+	}
+	var found []testFinding
+	for i, line := range strings.Split(string(content), "\n") {
+		if line == "flag" {
+			found = append(found, testFinding{file: file, line: i + 1})
+		}
+	}
+	return found, nil
+}
+
+func testASTCheck() astCheck[testFinding] {
+	return astCheck[testFinding]{
+		checkReport: checkReport{
+			err:        prerrors.ErrErrorComparison,
+			message:    "%d line(s) flagged",
+			suggestion: "Unflag them",
+		},
+		timeout: time.Minute,
+		find:    findTestFindings,
+	}
+}
+
+func TestRunFinderCases(t *testing.T) {
+	runFinderCases(t, []finderCase{
+		{name: "nothing flagged", content: "ok\nok"},
+		{name: "flagged lines", content: "ok\nflag\nflag", want: []string{"a.go:2: flagged", "a.go:3: flagged"}},
+	}, findTestFindings)
+}
+
+func TestASTCheck_Run(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+	flagged := write("flagged.go", "flag\nok\nflag")
+	clean := write("clean.go", "ok")
+	unparsable := write("bad.go", "bad\nflag")
+	missing := filepath.Join(dir, "missing.go")
+
+	t.Run("no files", func(t *testing.T) {
+		require.NoError(t, testASTCheck().run(context.Background(), nil))
+	})
+
+	t.Run("clean and unparsable files pass", func(t *testing.T) {
+		require.NoError(t, testASTCheck().run(context.Background(), []string{clean, unparsable}))
+	})
+
+	t.Run("findings and read failures are reported in order", func(t *testing.T) {
+		check := testASTCheck()
+		check.warnOnly = true
+		err := check.run(context.Background(), []string{missing, flagged, clean})
+		require.Error(t, err)
+		require.ErrorIs(t, err, prerrors.ErrErrorComparison)
+
+		var checkErr *prerrors.CheckError
+		require.ErrorAs(t, err, &checkErr)
+		assert.Equal(t, "3 line(s) flagged", checkErr.Message)
+		assert.Equal(t, "Unflag them", checkErr.Suggestion)
+		assert.True(t, checkErr.WarnOnly)
+		assert.Equal(t, []string{missing, flagged}, checkErr.Files)
+		lines := strings.Split(checkErr.Output, "\n")
+		require.Len(t, lines, 3)
+		assert.Contains(t, lines[0], missing+": failed to read file:")
+		assert.Equal(t, flagged+":1: flagged", lines[1])
+		assert.Equal(t, flagged+":3: flagged", lines[2])
+	})
+
+	t.Run("skipped files are not read", func(t *testing.T) {
+		check := testASTCheck()
+		check.skip = func(_, file string) bool { return file == flagged || file == missing }
+		require.NoError(t, check.run(context.Background(), []string{missing, flagged, clean}))
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := testASTCheck().run(ctx, []string{flagged})
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
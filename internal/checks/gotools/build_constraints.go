@@ -0,0 +1,342 @@
+package gotools
+
+import (
+	"context"
+	"fmt"
+	"go/build/constraint"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// maxFreeConstraintTags bounds the tags tried in every combination; a
+// constraint naming more custom tags is assumed satisfiable
+const maxFreeConstraintTags = 12
+
+// Stand-ins for a GOOS a constraint does not name, which may or may not
+// satisfy the "unix" tag
+const (
+	otherOS     = ""
+	otherUnixOS = "<other unix>"
+)
+
+// knownOS holds the GOOS values the go command recognizes, in tags and in
+// file name suffixes
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var knownOS = map[string]bool{
+	"aix": true, "android": true, "darwin": true, "dragonfly": true, "freebsd": true,
+	"hurd": true, "illumos": true, "ios": true, "js": true, "linux": true, "nacl": true,
+	"netbsd": true, "openbsd": true, "plan9": true, "solaris": true, "wasip1": true,
+	"windows": true, "zos": true,
+}
+
+// unixOS holds the GOOS values that satisfy the "unix" tag
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var unixOS = map[string]bool{
+	"aix": true, "android": true, "darwin": true, "dragonfly": true, "freebsd": true,
+	"hurd": true, "illumos": true, "ios": true, "linux": true, "netbsd": true,
+	"openbsd": true, "solaris": true,
+}
+
+// impliedOS maps a GOOS to the other GOOS tag it also satisfies
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var impliedOS = map[string]string{"android": "linux", "ios": "darwin", "illumos": "solaris"}
+
+// knownArch holds the GOARCH values the go command recognizes
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var knownArch = map[string]bool{
+	"386": true, "amd64": true, "amd64p32": true, "arm": true, "armbe": true, "arm64": true,
+	"arm64be": true, "loong64": true, "mips": true, "mipsle": true, "mips64": true,
+	"mips64le": true, "mips64p32": true, "mips64p32le": true, "ppc": true, "ppc64": true,
+	"ppc64le": true, "riscv": true, "riscv64": true, "s390": true, "s390x": true,
+	"sparc": true, "sparc64": true, "wasm": true,
+}
+
+// BuildConstraintCheck flags Go files whose //go:build constraint no build can
+// satisfy, such as "linux && !linux" or "linux && windows", which makes the
+// file dead code. A GOOS or GOARCH file name suffix counts as part of the
+// constraint, so a foo_windows.go file constrained to linux is flagged too.
+// Each build has exactly one GOOS and GOARCH, android, ios, and illumos also
+// satisfy linux, darwin, and solaris, and a go1.N tag implies every earlier
+// release; other tags may be set in any combination.
+type BuildConstraintCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+}
+
+// NewBuildConstraintCheck creates a new build constraint check
+func NewBuildConstraintCheck() *BuildConstraintCheck {
+	return &BuildConstraintCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewBuildConstraintCheckWithSharedContext creates a new build constraint check with shared context
+func NewBuildConstraintCheckWithSharedContext(sharedCtx *shared.Context) *BuildConstraintCheck {
+	return &BuildConstraintCheck{
+		sharedCtx: sharedCtx,
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewBuildConstraintCheckWithFullConfig creates a new build constraint check with full configuration
+func NewBuildConstraintCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *BuildConstraintCheck {
+	check := NewBuildConstraintCheckWithSharedContext(sharedCtx)
+	if cfg != nil && cfg.CheckTimeouts.BuildConstraints > 0 {
+		check.timeout = time.Duration(cfg.CheckTimeouts.BuildConstraints) * time.Second
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *BuildConstraintCheck) Name() string {
+	return "build-constraints"
+}
+
+// Description returns a brief description of the check
+func (c *BuildConstraintCheck) Description() string {
+	return "Flag //go:build constraints no build can satisfy"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *BuildConstraintCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "build-constraints",
+		Description:       "Flag Go files whose //go:build constraint, with any GOOS or GOARCH file name suffix, is always false",
+		FilePatterns:      []string{"*.go"},
+		EstimatedDuration: 1 * time.Second,
+		Dependencies:      []string{}, // Pure Go, no external tools
+		DefaultTimeout:    c.timeout,
+		Category:          "correctness",
+		RequiresFiles:     true,
+	}
+}
+
+// Run executes the build constraint check
+func (c *BuildConstraintCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	repoRoot := repoRootOrEmpty(ctx, c.sharedCtx)
+
+	var issues []string
+	var issueFiles []string
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		content, err := os.ReadFile(resolveRepoPath(repoRoot, file)) //nolint:gosec // File from staged file list
+		if err != nil {
+			issues = append(issues, fmt.Sprintf("%s: failed to read file: %v", file, err))
+			issueFiles = append(issueFiles, file)
+			continue
+		}
+
+		if issue := unsatisfiableConstraint(file, string(content)); issue != "" {
+			issues = append(issues, issue)
+			issueFiles = append(issueFiles, file)
+		}
+	}
+
+	if len(issues) == 0 {
+		return nil
+	}
+
+	return &prerrors.CheckError{
+		Err:        prerrors.ErrUnsatisfiableConstraint,
+		Message:    fmt.Sprintf("%d file(s) have a build constraint no build satisfies", len(issueFiles)),
+		Suggestion: "Fix the //go:build expression or the file name suffix, or delete the file if it is no longer built",
+		Output:     strings.Join(issues, "\n"),
+		Files:      issueFiles,
+	}
+}
+
+// FilterFiles filters to only Go files
+func (c *BuildConstraintCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if strings.HasSuffix(file, ".go") {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// unsatisfiableConstraint returns an issue line when the //go:build line of
+// content, combined with the file name suffix of file, is always false. Files
+// without a //go:build line, and unparsable ones, are the concern of the
+// build-tags check and the compiler.
+func unsatisfiableConstraint(file, content string) string {
+	lines := strings.Split(content, "\n")
+	header := parseBuildHeader(lines)
+	if header.goBuild < 0 {
+		return ""
+	}
+
+	text := strings.TrimSpace(lines[header.goBuild])
+	expr, err := constraint.Parse(text)
+	if err != nil {
+		return ""
+	}
+
+	suffix := fileNameConstraint(filepath.Base(file))
+	full := expr
+	if suffix != nil {
+		full = &constraint.AndExpr{X: expr, Y: suffix}
+	}
+	if constraintSatisfiable(full) {
+		return ""
+	}
+
+	if suffix != nil && constraintSatisfiable(expr) {
+		return fmt.Sprintf("%s:%d: %q can never be satisfied with the file name suffix (%s)",
+			file, header.goBuild+1, strings.TrimPrefix(text, "//go:build "), suffix)
+	}
+	return fmt.Sprintf("%s:%d: %q can never be satisfied", file, header.goBuild+1, strings.TrimPrefix(text, "//go:build "))
+}
+
+// fileNameConstraint returns the constraint the go command derives from a
+// _GOOS, _GOARCH, or _GOOS_GOARCH file name suffix, or nil when the name has
+// none
+func fileNameConstraint(name string) constraint.Expr {
+	name = strings.TrimSuffix(name, ".go")
+	i := strings.Index(name, "_")
+	if i < 0 {
+		return nil
+	}
+	parts := strings.Split(name[i:], "_")
+	if n := len(parts); n > 0 && parts[n-1] == "test" {
+		parts = parts[:n-1]
+	}
+
+	n := len(parts)
+	switch {
+	case n >= 2 && knownOS[parts[n-2]] && knownArch[parts[n-1]]:
+		return &constraint.AndExpr{X: &constraint.TagExpr{Tag: parts[n-2]}, Y: &constraint.TagExpr{Tag: parts[n-1]}}
+	case n >= 1 && knownOS[parts[n-1]]:
+		return &constraint.TagExpr{Tag: parts[n-1]}
+	case n >= 1 && knownArch[parts[n-1]]:
+		return &constraint.TagExpr{Tag: parts[n-1]}
+	}
+	return nil
+}
+
+// constraintSatisfiable reports whether some build satisfies expr: one GOOS
+// and one GOARCH, of those it names or any other, a Go release, and any
+// combination of its other tags. Constraints naming more than
+// maxFreeConstraintTags other tags are assumed satisfiable.
+func constraintSatisfiable(expr constraint.Expr) bool {
+	var goos, goarch, free []string
+	var releases []int
+	collectConstraintTags(expr, func(tag string) {
+		switch {
+		case knownOS[tag]:
+			goos = appendUnique(goos, tag)
+		case knownArch[tag]:
+			goarch = appendUnique(goarch, tag)
+		case tag == "unix":
+			// Decided by the GOOS
+		case releaseMinor(tag) >= 0:
+			if minor := releaseMinor(tag); !slices.Contains(releases, minor) {
+				releases = append(releases, minor)
+			}
+		default:
+			free = appendUnique(free, tag)
+		}
+	})
+	if len(free) > maxFreeConstraintTags {
+		return true
+	}
+
+	// The empty GOARCH is one the constraint does not name; a release of -1
+	// predates every release it names
+	goos = append(goos, otherOS, otherUnixOS)
+	goarch = append(goarch, "")
+	releases = append(releases, -1)
+
+	for _, buildOS := range goos {
+		for _, buildArch := range goarch {
+			for _, release := range releases {
+				for set := range 1 << len(free) {
+					if expr.Eval(func(tag string) bool {
+						return buildHasTag(tag, buildOS, buildArch, release, free, set)
+					}) {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+// buildHasTag reports whether a build for goos and goarch with Go release
+// 1.release, and the free tags whose bits are in set, satisfies tag
+func buildHasTag(tag, goos, goarch string, release int, free []string, set int) bool {
+	switch {
+	case knownOS[tag]:
+		return tag == goos || tag == impliedOS[goos]
+	case knownArch[tag]:
+		return tag == goarch
+	case tag == "unix":
+		return goos == otherUnixOS || unixOS[goos]
+	case releaseMinor(tag) >= 0:
+		return releaseMinor(tag) <= release
+	}
+	i := slices.Index(free, tag)
+	return i >= 0 && set&(1<<i) != 0
+}
+
+// releaseMinor returns N for a go1.N release tag, or -1 for other tags
+func releaseMinor(tag string) int {
+	rest, ok := strings.CutPrefix(tag, "go1.")
+	if !ok {
+		return -1
+	}
+	minor, err := strconv.Atoi(rest)
+	if err != nil || minor < 0 {
+		return -1
+	}
+	return minor
+}
+
+// collectConstraintTags calls fn for each tag expr names
+func collectConstraintTags(expr constraint.Expr, fn func(string)) {
+	switch e := expr.(type) {
+	case *constraint.TagExpr:
+		fn(e.Tag)
+	case *constraint.NotExpr:
+		collectConstraintTags(e.X, fn)
+	case *constraint.AndExpr:
+		collectConstraintTags(e.X, fn)
+		collectConstraintTags(e.Y, fn)
+	case *constraint.OrExpr:
+		collectConstraintTags(e.X, fn)
+		collectConstraintTags(e.Y, fn)
+	}
+}
+
+// appendUnique appends s to list unless it is already there
+func appendUnique(list []string, s string) []string {
+	if slices.Contains(list, s) {
+		return list
+	}
+	return append(list, s)
+}
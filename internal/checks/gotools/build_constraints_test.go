@@ -0,0 +1,117 @@
+package gotools
+
+import (
+	"context"
+	"go/build/constraint"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+func TestConstraintSatisfiable(t *testing.T) {
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{expr: "linux", want: true},
+		{expr: "linux && !linux", want: false},
+		{expr: "linux && windows", want: false},
+		{expr: "linux || windows", want: true},
+		{expr: "amd64 && arm64", want: false},
+		{expr: "linux && amd64", want: true},
+		{expr: "android && linux", want: true},
+		{expr: "android && !linux", want: false},
+		{expr: "unix && windows", want: false},
+		{expr: "unix && !linux && !darwin", want: true},
+		{expr: "!unix && !windows", want: true},
+		{expr: "go1.21 && !go1.20", want: false},
+		{expr: "go1.20 && !go1.21", want: true},
+		{expr: "integration && !integration", want: false},
+		{expr: "integration && !e2e", want: true},
+		{expr: "ignore", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			expr, err := constraint.Parse("//go:build " + tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, constraintSatisfiable(expr))
+		})
+	}
+}
+
+func TestFileNameConstraint(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "main.go"},
+		{name: "linux.go"},
+		{name: "file_linux.go", want: "linux"},
+		{name: "file_linux_test.go", want: "linux"},
+		{name: "file_arm64.go", want: "arm64"},
+		{name: "file_windows_amd64.go", want: "windows && amd64"},
+		{name: "file_other.go"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr := fileNameConstraint(tt.name)
+			if tt.want == "" {
+				assert.Nil(t, expr)
+				return
+			}
+			require.NotNil(t, expr)
+			assert.Equal(t, tt.want, expr.String())
+		})
+	}
+}
+
+func TestBuildConstraintCheck_Run(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+	valid := write("valid.go", "//go:build linux && amd64\n\npackage p\n")
+	contradictory := write("dead.go", "// Copyright 2024\n\n//go:build linux && !linux\n\npackage p\n")
+	suffix := write("net_windows.go", "//go:build linux\n\npackage p\n")
+	plain := write("plain.go", "package p\n")
+
+	check := NewBuildConstraintCheck()
+	require.NoError(t, check.Run(context.Background(), nil))
+	require.NoError(t, check.Run(context.Background(), []string{valid, plain}))
+
+	err := check.Run(context.Background(), []string{valid, contradictory, suffix, plain})
+	require.ErrorIs(t, err, prerrors.ErrUnsatisfiableConstraint)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Equal(t, []string{contradictory, suffix}, checkErr.Files)
+	assert.Equal(t, contradictory+`:3: "linux && !linux" can never be satisfied`+"\n"+
+		suffix+`:1: "linux" can never be satisfied with the file name suffix (windows)`, checkErr.Output)
+}
+
+func TestBuildConstraintCheck_FilterFilesAndMetadata(t *testing.T) {
+	check := NewBuildConstraintCheck()
+	assert.Equal(t, []string{"a.go"}, check.FilterFiles([]string{"a.go", "README.md"}))
+
+	assert.Equal(t, "build-constraints", check.Name())
+	assert.NotEmpty(t, check.Description())
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "build-constraints", metadata.Name)
+	assert.Equal(t, 30*time.Second, metadata.DefaultTimeout)
+
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.BuildConstraints = 5
+	assert.Equal(t, 5*time.Second, NewBuildConstraintCheckWithFullConfig(nil, cfg).timeout)
+}
@@ -0,0 +1,238 @@
+package gotools
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go/build/constraint"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// BuildTagCheck flags Go files that still use only the legacy "// +build"
+// constraint syntax without the matching "//go:build" line (Go 1.17+)
+type BuildTagCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+	autoFix   bool
+}
+
+// NewBuildTagCheck creates a new build tag check
+func NewBuildTagCheck() *BuildTagCheck {
+	return &BuildTagCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewBuildTagCheckWithSharedContext creates a new build tag check with shared context
+func NewBuildTagCheckWithSharedContext(sharedCtx *shared.Context) *BuildTagCheck {
+	return &BuildTagCheck{
+		sharedCtx: sharedCtx,
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewBuildTagCheckWithFullConfig creates a new build tag check with full configuration including auto-fix
+func NewBuildTagCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *BuildTagCheck {
+	check := NewBuildTagCheckWithSharedContext(sharedCtx)
+	if cfg != nil {
+		if cfg.CheckTimeouts.BuildTags > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.BuildTags) * time.Second
+		}
+		check.autoFix = cfg.CheckBehaviors.BuildTagsAutoFix
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *BuildTagCheck) Name() string {
+	return "build-tags"
+}
+
+// Description returns a brief description of the check
+func (c *BuildTagCheck) Description() string {
+	return "Require //go:build alongside legacy // +build lines"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *BuildTagCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "build-tags",
+		Description:       "Flag Go files using only the deprecated // +build constraint syntax",
+		FilePatterns:      []string{"*.go"},
+		EstimatedDuration: 1 * time.Second,
+		Dependencies:      []string{}, // Pure Go, no external tools
+		DefaultTimeout:    c.timeout,
+		Category:          "correctness",
+		RequiresFiles:     true,
+	}
+}
+
+// Run executes the build tag check
+func (c *BuildTagCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	repoRoot := repoRootOrEmpty(ctx, c.sharedCtx)
+
+	var issues []string
+	var issueFiles []string
+	fixedAll := true
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		path := resolveRepoPath(repoRoot, file)
+		content, err := os.ReadFile(path) //nolint:gosec // File from staged file list
+		if err != nil {
+			issues = append(issues, fmt.Sprintf("%s: failed to read file: %v", file, err))
+			issueFiles = append(issueFiles, file)
+			fixedAll = false
+			continue
+		}
+
+		fixed, legacy, err := fixLegacyBuildTags(content)
+		if err != nil {
+			issues = append(issues, fmt.Sprintf("%s: %v", file, err))
+			issueFiles = append(issueFiles, file)
+			fixedAll = false
+			continue
+		}
+		if !legacy {
+			continue
+		}
+
+		if c.autoFix && shared.ConfirmFix(ctx, c.Name(), file) {
+			if err = os.WriteFile(path, fixed, 0o600); err != nil { //nolint:gosec // G703: same path as ReadFile above
+				issues = append(issues, fmt.Sprintf("%s: failed to write file: %v", file, err))
+				fixedAll = false
+			} else {
+				issues = append(issues, fmt.Sprintf("%s: added //go:build line (review and stage the change)", file))
+			}
+		} else {
+			issues = append(issues, fmt.Sprintf("%s: uses // +build without a matching //go:build line", file))
+			fixedAll = false
+		}
+		issueFiles = append(issueFiles, file)
+	}
+
+	if len(issues) == 0 {
+		return nil
+	}
+
+	return &prerrors.CheckError{
+		Err:        prerrors.ErrBuildTagIssues,
+		Message:    fmt.Sprintf("%d file(s) use legacy build constraints", len(issueFiles)),
+		Suggestion: "Add a //go:build line above the // +build lines (gofmt or 'go fix' can generate it), or set GO_PRE_COMMIT_BUILD_TAGS_AUTO_FIX=true",
+		Output:     strings.Join(issues, "\n"),
+		Files:      issueFiles,
+		Fixed:      fixedAll,
+	}
+}
+
+// FilterFiles filters to only Go files
+func (c *BuildTagCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if strings.HasSuffix(file, ".go") {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// buildHeader describes the build constraint lines in a Go file header
+type buildHeader struct {
+	goBuild   int   // line index of the //go:build line, or -1
+	plusBuild []int // line indexes of effective // +build lines
+}
+
+// parseBuildHeader scans the lines preceding the package clause. Constraint
+// lines only take effect when they are followed by a blank line before the
+// package clause, so comments attached to the package doc are ignored.
+func parseBuildHeader(lines []string) buildHeader {
+	header := buildHeader{goBuild: -1}
+
+	// Find the header end and the last blank line inside it
+	lastBlank := -1
+	inBlock := false
+	for i, raw := range lines {
+		line := strings.TrimSpace(raw)
+		switch {
+		case inBlock:
+			if strings.Contains(line, "*/") {
+				inBlock = false
+			}
+			continue
+		case line == "":
+			lastBlank = i
+			continue
+		case strings.HasPrefix(line, "//"):
+			continue
+		case strings.HasPrefix(line, "/*"):
+			inBlock = !strings.Contains(line[2:], "*/")
+			continue
+		}
+		break // first non-comment line (package clause)
+	}
+
+	for i := 0; i < lastBlank; i++ {
+		line := strings.TrimSpace(lines[i])
+		switch {
+		case constraint.IsGoBuild(line):
+			if header.goBuild < 0 {
+				header.goBuild = i
+			}
+		case constraint.IsPlusBuild(line):
+			header.plusBuild = append(header.plusBuild, i)
+		}
+	}
+	return header
+}
+
+// fixLegacyBuildTags reports whether content uses only legacy // +build lines
+// and returns the content with an equivalent //go:build line inserted above them
+func fixLegacyBuildTags(content []byte) ([]byte, bool, error) {
+	lines := strings.Split(string(content), "\n")
+	header := parseBuildHeader(lines)
+	if len(header.plusBuild) == 0 || header.goBuild >= 0 {
+		return content, false, nil
+	}
+
+	var expr constraint.Expr
+	for _, idx := range header.plusBuild {
+		parsed, err := constraint.Parse(strings.TrimSpace(lines[idx]))
+		if err != nil {
+			return content, false, fmt.Errorf("invalid build constraint on line %d: %w", idx+1, err)
+		}
+		if expr == nil {
+			expr = parsed
+		} else {
+			expr = &constraint.AndExpr{X: expr, Y: parsed}
+		}
+	}
+
+	first := header.plusBuild[0]
+	var out bytes.Buffer
+	for i, line := range lines {
+		if i == first {
+			out.WriteString("//go:build " + expr.String() + "\n")
+		}
+		out.WriteString(line)
+		if i < len(lines)-1 {
+			out.WriteByte('\n')
+		}
+	}
+	return out.Bytes(), true, nil
+}
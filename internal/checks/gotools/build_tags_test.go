@@ -0,0 +1,128 @@
+package gotools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+func TestFixLegacyBuildTags(t *testing.T) {
+	tests := []struct {
+		name       string
+		content    string
+		wantLegacy bool
+		want       string
+	}{
+		{
+			name:       "legacy only",
+			content:    "// +build linux darwin\n// +build amd64\n\npackage main\n",
+			wantLegacy: true,
+			want:       "//go:build (linux || darwin) && amd64\n// +build linux darwin\n// +build amd64\n\npackage main\n",
+		},
+		{
+			name:    "modern only",
+			content: "//go:build linux\n\npackage main\n",
+		},
+		{
+			name:    "both present",
+			content: "//go:build linux\n// +build linux\n\npackage main\n",
+		},
+		{
+			name:       "legacy after license header",
+			content:    "// Copyright 2024\n\n// +build integration\n\npackage main\n",
+			wantLegacy: true,
+			want:       "// Copyright 2024\n\n//go:build integration\n// +build integration\n\npackage main\n",
+		},
+		{
+			name:    "legacy without blank line is a doc comment, not a constraint",
+			content: "// +build linux\npackage main\n",
+		},
+		{
+			name:    "legacy text after package clause is ignored",
+			content: "package main\n\n// +build linux\n\nfunc main() {}\n",
+		},
+		{
+			name:    "no constraints",
+			content: "package main\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fixed, legacy, err := fixLegacyBuildTags([]byte(tt.content))
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantLegacy, legacy)
+			if tt.wantLegacy {
+				assert.Equal(t, tt.want, string(fixed))
+			} else {
+				assert.Equal(t, tt.content, string(fixed))
+			}
+		})
+	}
+}
+
+func TestBuildTagCheck_Run(t *testing.T) {
+	dir := t.TempDir()
+	legacy := filepath.Join(dir, "legacy.go")
+	modern := filepath.Join(dir, "modern.go")
+	both := filepath.Join(dir, "both.go")
+	require.NoError(t, os.WriteFile(legacy, []byte("// +build tools\n\npackage tools\n"), 0o600))
+	require.NoError(t, os.WriteFile(modern, []byte("//go:build tools\n\npackage tools\n"), 0o600))
+	require.NoError(t, os.WriteFile(both, []byte("//go:build tools\n// +build tools\n\npackage tools\n"), 0o600))
+
+	check := NewBuildTagCheck()
+	require.NoError(t, check.Run(context.Background(), []string{modern, both}))
+
+	err := check.Run(context.Background(), []string{legacy, modern, both})
+	require.Error(t, err)
+	require.ErrorIs(t, err, prerrors.ErrBuildTagIssues)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Equal(t, []string{legacy}, checkErr.Files)
+	assert.Contains(t, checkErr.Output, "legacy.go: uses // +build without a matching //go:build line")
+
+	// Without auto-fix the file is left untouched
+	content, readErr := os.ReadFile(legacy) //nolint:gosec // test file
+	require.NoError(t, readErr)
+	assert.Equal(t, "// +build tools\n\npackage tools\n", string(content))
+}
+
+func TestBuildTagCheck_AutoFix(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "legacy.go")
+	require.NoError(t, os.WriteFile(file, []byte("// +build !windows\n\npackage tools\n"), 0o600))
+
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.BuildTagsAutoFix = true
+	check := NewBuildTagCheckWithFullConfig(nil, cfg)
+
+	err := check.Run(context.Background(), []string{file})
+	require.ErrorIs(t, err, prerrors.ErrBuildTagIssues, "fixed files still fail so the change gets reviewed")
+
+	content, readErr := os.ReadFile(file) //nolint:gosec // test file
+	require.NoError(t, readErr)
+	assert.True(t, strings.HasPrefix(string(content), "//go:build !windows\n// +build !windows\n"))
+
+	// Second run passes
+	require.NoError(t, check.Run(context.Background(), []string{file}))
+}
+
+func TestBuildTagCheck_FilterFilesAndMetadata(t *testing.T) {
+	check := NewBuildTagCheck()
+	assert.Equal(t, []string{"a.go"}, check.FilterFiles([]string{"a.go", "b.md"}))
+	assert.Equal(t, "build-tags", check.Name())
+	assert.NotEmpty(t, check.Description())
+
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "build-tags", metadata.Name)
+	assert.Empty(t, metadata.Dependencies)
+}
@@ -0,0 +1,192 @@
+package gotools
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// contextBackgroundDirective on the line of a call keeps it
+const contextBackgroundDirective = "go-pre-commit:allow-context"
+
+// ContextBackgroundCheck flags context.Background and context.TODO calls in
+// library code, where a fresh context drops the caller's cancellation and
+// deadline. Package main, test files, and generated files are skipped, as
+// are package directories on the allowlist, matched exactly, as a glob, or
+// with a trailing "/..." for a whole tree. A call can be kept with
+// //go-pre-commit:allow-context on its line. Findings are warn-only unless
+// the severity is set to error.
+type ContextBackgroundCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+	allow     []string
+	blocking  bool
+}
+
+// contextBackgroundCall is one flagged call
+type contextBackgroundCall struct {
+	file     string
+	line     int
+	function string // "context.Background" or "context.TODO"
+}
+
+// NewContextBackgroundCheck creates a new context background check
+func NewContextBackgroundCheck() *ContextBackgroundCheck {
+	return &ContextBackgroundCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewContextBackgroundCheckWithSharedContext creates a new context background check with shared context
+func NewContextBackgroundCheckWithSharedContext(sharedCtx *shared.Context) *ContextBackgroundCheck {
+	return &ContextBackgroundCheck{
+		sharedCtx: sharedCtx,
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewContextBackgroundCheckWithFullConfig creates a new context background check with full configuration
+func NewContextBackgroundCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *ContextBackgroundCheck {
+	check := NewContextBackgroundCheckWithSharedContext(sharedCtx)
+	if cfg != nil {
+		if cfg.CheckTimeouts.ContextBackground > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.ContextBackground) * time.Second
+		}
+		check.allow = cfg.CheckBehaviors.ContextBackgroundAllow
+		check.blocking = cfg.CheckBehaviors.ContextBackgroundSeverity == config.LintSeverityError
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *ContextBackgroundCheck) Name() string {
+	return "context-background"
+}
+
+// Description returns a brief description of the check
+func (c *ContextBackgroundCheck) Description() string {
+	return "Flag context.Background and context.TODO outside main and tests"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *ContextBackgroundCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "context-background",
+		Description:       "Warn when library code starts a new context with context.Background or context.TODO instead of propagating the caller's",
+		FilePatterns:      []string{"*.go"},
+		EstimatedDuration: 1 * time.Second,
+		Dependencies:      []string{}, // Pure Go, no external tools
+		DefaultTimeout:    c.timeout,
+		Category:          "style",
+		RequiresFiles:     true,
+		Cacheable:         true,
+	}
+}
+
+// Run executes the context background check
+func (c *ContextBackgroundCheck) Run(ctx context.Context, files []string) error {
+	return astCheck[contextBackgroundCall]{
+		checkReport: checkReport{
+			err:     prerrors.ErrContextBackground,
+			message: "%d new context(s) started outside main and tests",
+			suggestion: "Accept a context.Context parameter and pass it along, add the package to " +
+				"GO_PRE_COMMIT_CONTEXT_BACKGROUND_ALLOW, or add //" + contextBackgroundDirective + " to the line",
+			warnOnly: !c.blocking,
+		},
+		sharedCtx: c.sharedCtx,
+		timeout:   c.timeout,
+		skip:      c.allowed,
+		find:      findContextBackground,
+	}.run(ctx, files)
+}
+
+// allowed reports whether file is in a package GO_PRE_COMMIT_CONTEXT_BACKGROUND_ALLOW exempts
+func (c *ContextBackgroundCheck) allowed(repoRoot, file string) bool {
+	_, allowed := matchImportRule(packageDir(repoRoot, file), c.allow)
+	return allowed
+}
+
+// FilterFiles filters to Go files, leaving out tests
+func (c *ContextBackgroundCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if strings.HasSuffix(file, ".go") && !strings.HasSuffix(file, "_test.go") {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// String formats the call with its location
+func (c contextBackgroundCall) String() string {
+	return fmt.Sprintf("%s:%d: %s() drops the caller's cancellation; pass a context in", c.file, c.line, c.function)
+}
+
+// findContextBackground parses a Go file and returns its context.Background
+// and context.TODO calls, through any name the context package is imported
+// under. Package main, generated files, and calls with
+// contextBackgroundDirective on their line are skipped.
+func findContextBackground(filename string, content []byte) ([]contextBackgroundCall, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, content, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	if file.Name.Name == "main" || ast.IsGenerated(file) {
+		return nil, nil
+	}
+
+	contextName := ""
+	for _, spec := range file.Imports {
+		if importPath, unquoteErr := strconv.Unquote(spec.Path.Value); unquoteErr != nil || importPath != "context" {
+			continue
+		}
+		contextName = "context"
+		if spec.Name != nil {
+			contextName = spec.Name.Name
+		}
+	}
+	if contextName == "" || contextName == "_" || contextName == "." {
+		return nil, nil
+	}
+
+	suppressed := make(map[int]bool)
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			if strings.Contains(comment.Text, contextBackgroundDirective) {
+				suppressed[fset.Position(comment.Slash).Line] = true
+			}
+		}
+	}
+
+	var found []contextBackgroundCall
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || len(call.Args) > 0 {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || (sel.Sel.Name != "Background" && sel.Sel.Name != "TODO") {
+			return true
+		}
+		if pkg, isIdent := sel.X.(*ast.Ident); !isIdent || pkg.Name != contextName {
+			return true
+		}
+		line := fset.Position(call.Pos()).Line
+		if !suppressed[line] {
+			found = append(found, contextBackgroundCall{file: filename, line: line, function: "context." + sel.Sel.Name})
+		}
+		return true
+	})
+	return found, nil
+}
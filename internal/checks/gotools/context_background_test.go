@@ -0,0 +1,99 @@
+package gotools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+func TestFindContextBackground(t *testing.T) {
+	runFinderCases(t, []finderCase{
+		{
+			name:    "background and todo in library code",
+			content: "package p\n\nimport \"context\"\n\nfunc f() {\n\tg(context.Background())\n\tg(context.TODO())\n}\n\nfunc g(context.Context) {}\n",
+			want: []string{
+				"a.go:6: context.Background() drops the caller's cancellation; pass a context in",
+				"a.go:7: context.TODO() drops the caller's cancellation; pass a context in",
+			},
+		},
+		{
+			name:    "package-level initializer and renamed import",
+			content: "package p\n\nimport stdctx \"context\"\n\nvar root = stdctx.Background()\n",
+			want:    []string{"a.go:5: context.Background() drops the caller's cancellation; pass a context in"},
+		},
+		{
+			name:    "package main",
+			content: "package main\n\nimport \"context\"\n\nfunc main() { run(context.Background()) }\n\nfunc run(context.Context) {}\n",
+		},
+		{
+			name:    "suppressed on the line",
+			content: "package p\n\nimport \"context\"\n\nvar root = context.Background() //go-pre-commit:allow-context\n",
+		},
+		{
+			name:    "derived contexts",
+			content: "package p\n\nimport \"context\"\n\nfunc f(ctx context.Context) {\n\tctx, cancel := context.WithCancel(ctx)\n\tdefer cancel()\n\t_ = ctx\n}\n",
+		},
+		{
+			name:    "other package with the same function name",
+			content: "package p\n\nimport \"image/color\"\n\ntype ui struct{}\n\nfunc (ui) Background() color.Color { return nil }\n\nvar c = ui{}.Background()\n",
+		},
+		{
+			name:    "generated file",
+			content: "// Code generated by mockgen. DO NOT EDIT.\n\npackage p\n\nimport \"context\"\n\nvar root = context.Background()\n",
+		},
+	}, findContextBackground)
+}
+
+func TestContextBackgroundCheck_Run(t *testing.T) {
+	setupMultiModuleRepo(t, map[string]string{".": "module example.com/repo\n\ngo 1.22\n"})
+	library := "package server\n\nimport \"context\"\n\nfunc Start() { serve(context.Background()) }\n\nfunc serve(context.Context) {}\n"
+	writeModuleFiles(t, map[string]string{
+		"cmd/app/main.go":           "package main\n\nimport \"context\"\n\nfunc main() { _ = context.Background() }\n",
+		"internal/server/server.go": library,
+		"internal/worker/worker.go": library,
+	})
+
+	check := NewContextBackgroundCheckWithSharedContext(shared.NewContext())
+	require.NoError(t, check.Run(context.Background(), []string{"cmd/app/main.go"}), "package main may start contexts")
+
+	err := check.Run(context.Background(), []string{"cmd/app/main.go", "internal/server/server.go"})
+	require.ErrorIs(t, err, prerrors.ErrContextBackground)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.True(t, checkErr.WarnOnly)
+	assert.Equal(t, []string{"internal/server/server.go"}, checkErr.Files)
+	assert.Equal(t, "internal/server/server.go:5: context.Background() drops the caller's cancellation; pass a context in", checkErr.Output)
+	assert.Contains(t, checkErr.Suggestion, "//go-pre-commit:allow-context")
+
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.ContextBackgroundAllow = []string{"internal/worker/..."}
+	cfg.CheckBehaviors.ContextBackgroundSeverity = config.LintSeverityError
+	configured := NewContextBackgroundCheckWithFullConfig(shared.NewContext(), cfg)
+	require.NoError(t, configured.Run(context.Background(), []string{"internal/worker/worker.go"}), "allowed by prefix")
+
+	err = configured.Run(context.Background(), []string{"internal/server/server.go"})
+	require.ErrorAs(t, err, &checkErr)
+	assert.False(t, checkErr.WarnOnly, "error severity blocks the commit")
+}
+
+func TestContextBackgroundCheck_FilterFilesAndMetadata(t *testing.T) {
+	check := NewContextBackgroundCheck()
+	assert.Equal(t, []string{"a.go"}, check.FilterFiles([]string{"a.go", "a_test.go", "go.mod"}))
+
+	assert.Equal(t, "context-background", check.Name())
+	assert.NotEmpty(t, check.Description())
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "context-background", metadata.Name)
+
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.ContextBackground = 5
+	assert.Equal(t, 5, int(NewContextBackgroundCheckWithFullConfig(nil, cfg).timeout.Seconds()))
+}
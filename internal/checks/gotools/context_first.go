@@ -0,0 +1,222 @@
+package gotools
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// contextFirstDirective suppresses a finding when it appears in the function's
+// doc comment or on the line of its signature. It matches golangci-lint's
+// revive, whose context-as-argument rule reports the same problem.
+const contextFirstDirective = "nolint:revive"
+
+// ContextFirstCheck flags exported functions and methods that take a
+// context.Context anywhere but as their first parameter. Test and generated
+// files are skipped.
+type ContextFirstCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+}
+
+// contextMisplacement is one flagged function
+type contextMisplacement struct {
+	file     string
+	line     int
+	function string // the function name, qualified by its receiver for methods
+	position int    // 1-based position of the context.Context parameter
+}
+
+// NewContextFirstCheck creates a new context-first parameter check
+func NewContextFirstCheck() *ContextFirstCheck {
+	return &ContextFirstCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewContextFirstCheckWithSharedContext creates a new context-first parameter check with shared context
+func NewContextFirstCheckWithSharedContext(sharedCtx *shared.Context) *ContextFirstCheck {
+	return &ContextFirstCheck{
+		sharedCtx: sharedCtx,
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewContextFirstCheckWithFullConfig creates a new context-first parameter check with full configuration
+func NewContextFirstCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *ContextFirstCheck {
+	check := NewContextFirstCheckWithSharedContext(sharedCtx)
+	if cfg != nil && cfg.CheckTimeouts.ContextFirst > 0 {
+		check.timeout = time.Duration(cfg.CheckTimeouts.ContextFirst) * time.Second
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *ContextFirstCheck) Name() string {
+	return "context-first"
+}
+
+// Description returns a brief description of the check
+func (c *ContextFirstCheck) Description() string {
+	return "Require context.Context to be the first parameter"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *ContextFirstCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "context-first",
+		Description:       "Require exported functions to take context.Context as their first parameter",
+		FilePatterns:      []string{"*.go"},
+		EstimatedDuration: 1 * time.Second,
+		Dependencies:      []string{}, // Pure Go, no external tools
+		DefaultTimeout:    c.timeout,
+		Category:          "style",
+		RequiresFiles:     true,
+		Cacheable:         true,
+	}
+}
+
+// Run executes the context-first parameter check
+func (c *ContextFirstCheck) Run(ctx context.Context, files []string) error {
+	return astCheck[contextMisplacement]{
+		checkReport: checkReport{
+			err:        prerrors.ErrContextNotFirst,
+			message:    "%d exported function(s) take context.Context after another parameter",
+			suggestion: "Move the context.Context parameter to the front, or add //" + contextFirstDirective + " to the function's doc comment to keep the signature",
+		},
+		sharedCtx: c.sharedCtx,
+		timeout:   c.timeout,
+		find:      findMisplacedContexts,
+	}.run(ctx, files)
+}
+
+// FilterFiles filters to Go files, leaving out tests
+func (c *ContextFirstCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if strings.HasSuffix(file, ".go") && !strings.HasSuffix(file, "_test.go") {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// String formats the finding with the parameter's position
+func (m contextMisplacement) String() string {
+	return fmt.Sprintf("%s:%d: %s takes context.Context as parameter %d; make it the first parameter", m.file, m.line, m.function, m.position)
+}
+
+// findMisplacedContexts parses a Go file and returns its exported functions
+// and methods whose context.Context parameter is not first. Generated files
+// and suppressed functions are skipped.
+func findMisplacedContexts(filename string, content []byte) ([]contextMisplacement, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, content, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	if ast.IsGenerated(file) {
+		return nil, nil
+	}
+
+	suppressed := make(map[int]bool)
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			if strings.Contains(comment.Text, contextFirstDirective) {
+				suppressed[fset.Position(comment.Slash).Line] = true
+			}
+		}
+	}
+
+	var misplaced []contextMisplacement
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || !fn.Name.IsExported() {
+			continue
+		}
+
+		position := contextParamPosition(fn.Type.Params)
+		if position <= 1 {
+			continue
+		}
+
+		line := fset.Position(fn.Name.Pos()).Line
+		if suppressed[line] || docSuppressed(fn.Doc) {
+			continue
+		}
+		misplaced = append(misplaced, contextMisplacement{
+			file:     filename,
+			line:     line,
+			function: funcDisplayName(fn),
+			position: position,
+		})
+	}
+	return misplaced, nil
+}
+
+// contextParamPosition returns the 1-based position of the first
+// context.Context parameter, or 0 when there is none
+func contextParamPosition(params *ast.FieldList) int {
+	if params == nil {
+		return 0
+	}
+
+	position := 0
+	for _, field := range params.List {
+		names := len(field.Names)
+		if names == 0 {
+			names = 1 // an unnamed parameter
+		}
+		if isContextType(field.Type) {
+			return position + 1
+		}
+		position += names
+	}
+	return 0
+}
+
+// isContextType reports whether expr is context.Context
+func isContextType(expr ast.Expr) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	return ok && pkg.Name == "context" && sel.Sel.Name == "Context"
+}
+
+// docSuppressed reports whether a doc comment carries the suppression directive
+func docSuppressed(doc *ast.CommentGroup) bool {
+	if doc == nil {
+		return false
+	}
+	for _, comment := range doc.List {
+		if strings.Contains(comment.Text, contextFirstDirective) {
+			return true
+		}
+	}
+	return false
+}
+
+// funcDisplayName returns the function's name, with the receiver type for
+// methods, such as "(*Server).Serve"
+func funcDisplayName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return fn.Name.Name
+	}
+	recv := types.ExprString(fn.Recv.List[0].Type)
+	if strings.HasPrefix(recv, "*") {
+		recv = "(" + recv + ")"
+	}
+	return recv + "." + fn.Name.Name
+}
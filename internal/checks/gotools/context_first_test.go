@@ -0,0 +1,130 @@
+package gotools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+func TestFindMisplacedContexts(t *testing.T) {
+	runFinderCases(t, []finderCase{
+		{
+			name: "context first",
+			content: `package p
+func Fetch(ctx context.Context, id string) error { return nil }
+func (s *Store) Load(ctx context.Context, ids ...string) error { return nil }
+`,
+		},
+		{
+			name: "no context",
+			content: `package p
+func Sum(a, b int) int { return a + b }
+`,
+		},
+		{
+			name: "context second",
+			content: `package p
+func Fetch(id string, ctx context.Context) error { return nil }
+`,
+			want: []string{"a.go:2: Fetch takes context.Context as parameter 2; make it the first parameter"},
+		},
+		{
+			name: "grouped names count individually",
+			content: `package p
+
+func (s Store) Save(a, b int, _ context.Context) {}
+
+func (s *Store) Put(string, context.Context) {}
+`,
+			want: []string{
+				"a.go:3: Store.Save takes context.Context as parameter 3; make it the first parameter",
+				"a.go:5: (*Store).Put takes context.Context as parameter 2; make it the first parameter",
+			},
+		},
+		{
+			name: "unexported functions are ignored",
+			content: `package p
+func fetch(id string, ctx context.Context) error { return nil }
+func (s *Store) load(id string, ctx context.Context) error { return nil }
+`,
+		},
+		{
+			name: "other Context types are ignored",
+			content: `package p
+func Render(w io.Writer, ctx template.Context) error { return nil }
+`,
+		},
+		{
+			name: "suppressed in the doc comment",
+			content: `package p
+
+// Fetch keeps its signature for compatibility
+//
+//nolint:revive // public API
+func Fetch(id string, ctx context.Context) error { return nil }
+`,
+		},
+		{
+			name: "suppressed on the signature line",
+			content: `package p
+func Fetch(id string, ctx context.Context) error { return nil } //nolint:revive // public API
+`,
+		},
+		{
+			name: "generated file",
+			content: `// Code generated by protoc-gen-go. DO NOT EDIT.
+
+package p
+func Fetch(id string, ctx context.Context) error { return nil }
+`,
+		},
+	}, findMisplacedContexts)
+}
+
+func TestFindMisplacedContexts_ParseError(t *testing.T) {
+	_, err := findMisplacedContexts("a.go", []byte("package p\nfunc {"))
+	require.Error(t, err)
+}
+
+func TestContextFirstCheck_Run(t *testing.T) {
+	dir := t.TempDir()
+	bad := filepath.Join(dir, "bad.go")
+	good := filepath.Join(dir, "good.go")
+	require.NoError(t, os.WriteFile(bad, []byte("package p\n\nimport \"context\"\n\nfunc Get(key string, ctx context.Context) error {\n\treturn ctx.Err()\n}\n"), 0o600))
+	require.NoError(t, os.WriteFile(good, []byte("package p\n\nimport \"context\"\n\nfunc Put(ctx context.Context, key string) error {\n\treturn ctx.Err()\n}\n"), 0o600))
+
+	check := NewContextFirstCheck()
+	require.NoError(t, check.Run(context.Background(), nil))
+	require.NoError(t, check.Run(context.Background(), []string{good}))
+
+	err := check.Run(context.Background(), []string{bad, good})
+	require.ErrorIs(t, err, prerrors.ErrContextNotFirst)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Equal(t, []string{bad}, checkErr.Files)
+	assert.Contains(t, checkErr.Output, "bad.go:5: Get takes context.Context as parameter 2")
+	assert.Contains(t, checkErr.Suggestion, "//nolint:revive")
+}
+
+func TestContextFirstCheck_FilterFilesAndMetadata(t *testing.T) {
+	check := NewContextFirstCheck()
+	assert.Equal(t, []string{"a.go"}, check.FilterFiles([]string{"a.go", "a_test.go", "b.py"}))
+
+	assert.Equal(t, "context-first", check.Name())
+	assert.NotEmpty(t, check.Description())
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "context-first", metadata.Name)
+
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.ContextFirst = 5
+	assert.Equal(t, 5, int(NewContextFirstCheckWithFullConfig(nil, cfg).timeout.Seconds()))
+}
@@ -0,0 +1,247 @@
+package gotools
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// DocCommentCheck flags exported functions, methods, types, variables, and
+// constants without a doc comment that starts with the symbol's name, the
+// convention go doc and revive's exported rule expect. Test and generated
+// files are skipped, and main packages too unless configured otherwise.
+type DocCommentCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+	skipMain  bool
+}
+
+// docCommentIssue is one exported symbol with a missing or misnamed doc comment
+type docCommentIssue struct {
+	file    string
+	line    int
+	kind    string // function, method, type, var, or const
+	symbol  string // display name, such as "(*Store).Put"
+	name    string // the name the comment should start with
+	missing bool   // true when there is no doc comment at all
+}
+
+// NewDocCommentCheck creates a new doc comment check
+func NewDocCommentCheck() *DocCommentCheck {
+	return &DocCommentCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   30 * time.Second,
+		skipMain:  true,
+	}
+}
+
+// NewDocCommentCheckWithSharedContext creates a new doc comment check with shared context
+func NewDocCommentCheckWithSharedContext(sharedCtx *shared.Context) *DocCommentCheck {
+	return &DocCommentCheck{
+		sharedCtx: sharedCtx,
+		timeout:   30 * time.Second,
+		skipMain:  true,
+	}
+}
+
+// NewDocCommentCheckWithFullConfig creates a new doc comment check with full configuration
+func NewDocCommentCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *DocCommentCheck {
+	check := NewDocCommentCheckWithSharedContext(sharedCtx)
+	if cfg != nil {
+		if cfg.CheckTimeouts.DocComments > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.DocComments) * time.Second
+		}
+		check.skipMain = cfg.CheckBehaviors.DocCommentsSkipMain
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *DocCommentCheck) Name() string {
+	return "doc-comments"
+}
+
+// Description returns a brief description of the check
+func (c *DocCommentCheck) Description() string {
+	return "Require doc comments on exported symbols"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *DocCommentCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "doc-comments",
+		Description:       "Require every exported symbol to have a doc comment starting with its name",
+		FilePatterns:      []string{"*.go"},
+		EstimatedDuration: 1 * time.Second,
+		Dependencies:      []string{}, // Pure Go, no external tools
+		DefaultTimeout:    c.timeout,
+		Category:          "style",
+		RequiresFiles:     true,
+		Cacheable:         true,
+	}
+}
+
+// Run executes the doc comment check
+func (c *DocCommentCheck) Run(ctx context.Context, files []string) error {
+	return astCheck[docCommentIssue]{
+		checkReport: checkReport{
+			err:        prerrors.ErrMissingDocComment,
+			message:    "%d exported symbol(s) lack a doc comment starting with their name",
+			suggestion: "Add a comment directly above each symbol that starts with its name, such as \"// Store persists records\"",
+		},
+		sharedCtx: c.sharedCtx,
+		timeout:   c.timeout,
+		find: func(file string, content []byte) ([]docCommentIssue, error) {
+			return findDocCommentIssues(file, content, c.skipMain)
+		},
+	}.run(ctx, files)
+}
+
+// FilterFiles filters to Go files, leaving out tests
+func (c *DocCommentCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if strings.HasSuffix(file, ".go") && !strings.HasSuffix(file, "_test.go") {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// String formats the issue as a missing or misnamed comment
+func (i docCommentIssue) String() string {
+	if i.missing {
+		return fmt.Sprintf("%s:%d: exported %s %s has no doc comment", i.file, i.line, i.kind, i.symbol)
+	}
+	return fmt.Sprintf("%s:%d: doc comment for exported %s %s should start with %q", i.file, i.line, i.kind, i.symbol, i.name)
+}
+
+// findDocCommentIssues parses a Go file and returns its exported symbols with
+// a missing or misnamed doc comment. Generated files, and main packages when
+// skipMain is set, have none.
+func findDocCommentIssues(filename string, content []byte, skipMain bool) ([]docCommentIssue, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, content, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	if ast.IsGenerated(file) || (skipMain && file.Name.Name == "main") {
+		return nil, nil
+	}
+
+	var issues []docCommentIssue
+	report := func(pos token.Pos, kind, symbol, name string, doc *ast.CommentGroup, articles bool) {
+		missing := doc == nil || strings.TrimSpace(doc.Text()) == ""
+		if !missing && docStartsWith(doc.Text(), name, articles) {
+			return
+		}
+		issues = append(issues, docCommentIssue{
+			file:    filename,
+			line:    fset.Position(pos).Line,
+			kind:    kind,
+			symbol:  symbol,
+			name:    name,
+			missing: missing,
+		})
+	}
+
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if !decl.Name.IsExported() || !exportedReceiver(decl) {
+				continue
+			}
+			kind := "function"
+			if decl.Recv != nil {
+				kind = "method"
+			}
+			report(decl.Name.Pos(), kind, funcDisplayName(decl), decl.Name.Name, decl.Doc, false)
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					if !spec.Name.IsExported() {
+						continue
+					}
+					doc := spec.Doc
+					if doc == nil && !decl.Lparen.IsValid() {
+						doc = decl.Doc
+					}
+					report(spec.Name.Pos(), "type", spec.Name.Name, spec.Name.Name, doc, true)
+				case *ast.ValueSpec:
+					name := firstExportedName(spec.Names)
+					if name == nil {
+						continue
+					}
+					if decl.Lparen.IsValid() && decl.Doc != nil && spec.Doc == nil {
+						// A comment on the group documents its members
+						continue
+					}
+					doc := spec.Doc
+					if doc == nil {
+						doc = decl.Doc
+					}
+					report(name.Pos(), decl.Tok.String(), name.Name, name.Name, doc, false)
+				}
+			}
+		}
+	}
+	return issues, nil
+}
+
+// exportedReceiver reports whether fn is a function, or a method on an
+// exported type; methods of unexported types are not part of the API
+func exportedReceiver(fn *ast.FuncDecl) bool {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return true
+	}
+	recv := types.ExprString(fn.Recv.List[0].Type)
+	recv = strings.TrimPrefix(recv, "*")
+	if i := strings.IndexByte(recv, '['); i >= 0 {
+		recv = recv[:i] // generic receiver, such as List[T]
+	}
+	return ast.IsExported(recv)
+}
+
+// firstExportedName returns the first exported identifier in names
+func firstExportedName(names []*ast.Ident) *ast.Ident {
+	for _, name := range names {
+		if name.IsExported() {
+			return name
+		}
+	}
+	return nil
+}
+
+// docStartsWith reports whether a doc comment's text starts with name as a
+// whole word. Type comments may lead with an article, as in "A Store ...".
+func docStartsWith(text, name string, articles bool) bool {
+	text = strings.TrimSpace(text)
+	if articles {
+		for _, article := range []string{"A ", "An ", "The "} {
+			if rest, ok := strings.CutPrefix(text, article); ok && strings.HasPrefix(rest, name) {
+				text = rest
+				break
+			}
+		}
+	}
+	rest, ok := strings.CutPrefix(text, name)
+	if !ok {
+		return false
+	}
+	return rest == "" || !isIdentChar(rest[0])
+}
+
+// isIdentChar reports whether b can continue a Go identifier
+func isIdentChar(b byte) bool {
+	return b == '_' || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (b >= '0' && b <= '9')
+}
@@ -0,0 +1,225 @@
+package gotools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+func TestFindDocCommentIssues(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want []string
+	}{
+		{
+			name: "documented",
+			src: `package store
+
+// Store persists records
+type Store struct{}
+
+// A Record is one stored row
+type Record struct{}
+
+// Put saves a record
+func (s *Store) Put(r Record) {}
+
+// New returns an empty store
+func New() *Store { return nil }
+
+// MaxSize caps the store
+const MaxSize = 10
+
+// Store errors
+var (
+	ErrFull   = error(nil)
+	ErrClosed = error(nil)
+)
+
+// Default is the shared store
+//
+//nolint:gochecknoglobals // shared instance
+var Default = New()
+`,
+		},
+		{
+			name: "undocumented",
+			src: `package store
+
+type Store struct{}
+
+func (s *Store) Put() {}
+
+func New() *Store { return nil }
+
+var Default = New()
+
+const (
+	MaxSize = 10
+)
+`,
+			want: []string{
+				"store.go:3: exported type Store has no doc comment",
+				"store.go:5: exported method (*Store).Put has no doc comment",
+				"store.go:7: exported function New has no doc comment",
+				"store.go:9: exported var Default has no doc comment",
+				"store.go:12: exported const MaxSize has no doc comment",
+			},
+		},
+		{
+			name: "wrongly prefixed",
+			src: `package store
+
+// This type persists records
+type Store struct{}
+
+// Puts saves a record
+func (s *Store) Put() {}
+
+// new returns an empty store
+func New() *Store { return nil }
+
+// A Limit caps the store
+const Limit = 10
+`,
+			want: []string{
+				`store.go:4: doc comment for exported type Store should start with "Store"`,
+				`store.go:7: doc comment for exported method (*Store).Put should start with "Put"`,
+				`store.go:10: doc comment for exported function New should start with "New"`,
+				`store.go:13: doc comment for exported const Limit should start with "Limit"`,
+			},
+		},
+		{
+			name: "unexported and unexported receivers are skipped",
+			src: `package store
+
+type store struct{}
+
+func (s store) Put() {}
+
+func helper() {}
+
+var _, count = 1, 2
+`,
+		},
+		{
+			name: "directives alone are not documentation",
+			src: `package store
+
+//go:noinline
+func New() {}
+`,
+			want: []string{"store.go:4: exported function New has no doc comment"},
+		},
+		{
+			name: "generic receiver",
+			src: `package store
+
+type list[T any] []T
+
+func (l list[T]) Len() int { return len(l) }
+
+// List is a typed slice
+type List[T any] []T
+
+func (l List[T]) Len() int { return len(l) }
+`,
+			want: []string{"store.go:10: exported method List[T].Len has no doc comment"},
+		},
+		{
+			name: "generated files are skipped",
+			src:  "// Code generated by mockgen. DO NOT EDIT.\n\npackage store\n\nfunc New() {}\n",
+		},
+		{
+			name: "main packages are skipped",
+			src:  "package main\n\nfunc Run() {}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues, err := findDocCommentIssues("store.go", []byte(tt.src), true)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, findingStrings(issues))
+		})
+	}
+}
+
+func TestFindDocCommentIssues_MainPackage(t *testing.T) {
+	issues, err := findDocCommentIssues("main.go", []byte("package main\n\nfunc Run() {}\n"), false)
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "main.go:3: exported function Run has no doc comment", issues[0].String())
+}
+
+func TestFindDocCommentIssues_ParseError(t *testing.T) {
+	_, err := findDocCommentIssues("bad.go", []byte("package p\nfunc {"), true)
+	require.Error(t, err)
+}
+
+func TestDocStartsWith(t *testing.T) {
+	assert.True(t, docStartsWith("Store persists records", "Store", false))
+	assert.True(t, docStartsWith("Store", "Store", false))
+	assert.True(t, docStartsWith("Store's records", "Store", false))
+	assert.False(t, docStartsWith("Stores persist records", "Store", false))
+	assert.False(t, docStartsWith("A Store persists records", "Store", false))
+	assert.True(t, docStartsWith("A Store persists records", "Store", true))
+	assert.True(t, docStartsWith("An Index speeds up reads", "Index", true))
+	assert.True(t, docStartsWith("The Store persists records", "Store", true))
+	assert.False(t, docStartsWith("A store persists records", "Store", true))
+}
+
+func TestDocCommentCheck_Run(t *testing.T) {
+	dir := t.TempDir()
+	bad := filepath.Join(dir, "bad.go")
+	good := filepath.Join(dir, "good.go")
+	require.NoError(t, os.WriteFile(bad, []byte("package p\n\nfunc New() {}\n"), 0o600))
+	require.NoError(t, os.WriteFile(good, []byte("package p\n\n// New does nothing\nfunc New() {}\n"), 0o600))
+
+	check := NewDocCommentCheck()
+	require.NoError(t, check.Run(context.Background(), nil))
+	require.NoError(t, check.Run(context.Background(), []string{good}))
+
+	err := check.Run(context.Background(), []string{bad, good})
+	require.ErrorIs(t, err, prerrors.ErrMissingDocComment)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Equal(t, []string{bad}, checkErr.Files)
+	assert.Contains(t, checkErr.Output, "bad.go:3: exported function New has no doc comment")
+}
+
+func TestDocCommentCheck_FullConfig(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.DocComments = 5
+
+	check := NewDocCommentCheckWithFullConfig(nil, cfg)
+	assert.False(t, check.skipMain)
+	assert.Equal(t, 5, int(check.timeout.Seconds()))
+
+	file := filepath.Join(t.TempDir(), "main.go")
+	require.NoError(t, os.WriteFile(file, []byte("package main\n\nfunc Run() {}\n"), 0o600))
+	require.ErrorIs(t, check.Run(context.Background(), []string{file}), prerrors.ErrMissingDocComment)
+	require.NoError(t, NewDocCommentCheck().Run(context.Background(), []string{file}))
+
+	assert.Equal(t, []string{"a.go"}, check.FilterFiles([]string{"a.go", "a_test.go", "README.md"}))
+}
+
+func TestDocCommentCheck_Metadata(t *testing.T) {
+	check := NewDocCommentCheck()
+	assert.Equal(t, "doc-comments", check.Name())
+	assert.NotEmpty(t, check.Description())
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "doc-comments", metadata.Name)
+	assert.True(t, check.skipMain)
+}
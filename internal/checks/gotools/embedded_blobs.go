@@ -0,0 +1,234 @@
+package gotools
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// defaultEmbeddedBlobMinLength is the shortest literal the check flags when
+// no length is configured
+const defaultEmbeddedBlobMinLength = 1024
+
+// EmbeddedBlobCheck flags string literals that hold a long base64 payload,
+// such as an image or certificate pasted into source. Such blobs bloat diffs
+// and belong in a file loaded with //go:embed. Literals concatenated with +
+// count as one. Generated files are skipped.
+type EmbeddedBlobCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+	minLength int
+}
+
+// embeddedBlob is one flagged string literal
+type embeddedBlob struct {
+	file   string
+	line   int
+	length int
+}
+
+// NewEmbeddedBlobCheck creates a new embedded blob check
+func NewEmbeddedBlobCheck() *EmbeddedBlobCheck {
+	return NewEmbeddedBlobCheckWithSharedContext(shared.NewContext())
+}
+
+// NewEmbeddedBlobCheckWithSharedContext creates a new embedded blob check with shared context
+func NewEmbeddedBlobCheckWithSharedContext(sharedCtx *shared.Context) *EmbeddedBlobCheck {
+	return &EmbeddedBlobCheck{
+		sharedCtx: sharedCtx,
+		timeout:   30 * time.Second,
+		minLength: defaultEmbeddedBlobMinLength,
+	}
+}
+
+// NewEmbeddedBlobCheckWithFullConfig creates a new embedded blob check with full configuration
+func NewEmbeddedBlobCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *EmbeddedBlobCheck {
+	check := NewEmbeddedBlobCheckWithSharedContext(sharedCtx)
+	if cfg != nil {
+		if cfg.CheckTimeouts.EmbeddedBlobs > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.EmbeddedBlobs) * time.Second
+		}
+		if cfg.CheckBehaviors.EmbeddedBlobMinLength > 0 {
+			check.minLength = cfg.CheckBehaviors.EmbeddedBlobMinLength
+		}
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *EmbeddedBlobCheck) Name() string {
+	return "embedded-blobs"
+}
+
+// Description returns a brief description of the check
+func (c *EmbeddedBlobCheck) Description() string {
+	return "Flag large base64 string literals that belong in //go:embed files"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *EmbeddedBlobCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "embedded-blobs",
+		Description:       "Flag base64 string literals longer than GO_PRE_COMMIT_EMBEDDED_BLOB_MIN_LENGTH and suggest //go:embed",
+		FilePatterns:      []string{"*.go"},
+		EstimatedDuration: 1 * time.Second,
+		Dependencies:      []string{}, // Pure Go, no external tools
+		DefaultTimeout:    c.timeout,
+		Category:          "quality",
+		RequiresFiles:     true,
+		Cacheable:         true,
+	}
+}
+
+// Run executes the embedded blob check
+func (c *EmbeddedBlobCheck) Run(ctx context.Context, files []string) error {
+	return astCheck[embeddedBlob]{
+		checkReport: checkReport{
+			err:     prerrors.ErrEmbeddedBlob,
+			message: "%d large base64 string literal(s) embedded in source",
+			suggestion: "Save the decoded data as a file and load it with //go:embed, " +
+				"or raise GO_PRE_COMMIT_EMBEDDED_BLOB_MIN_LENGTH",
+		},
+		sharedCtx: c.sharedCtx,
+		timeout:   c.timeout,
+		find: func(file string, content []byte) ([]embeddedBlob, error) {
+			return findEmbeddedBlobs(file, content, c.minLength)
+		},
+	}.run(ctx, files)
+}
+
+// FilterFiles filters to Go files
+func (c *EmbeddedBlobCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if strings.HasSuffix(file, ".go") {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// String formats the blob for the check output
+func (b embeddedBlob) String() string {
+	return fmt.Sprintf("%s:%d: %d-character base64 string literal; load it with //go:embed instead", b.file, b.line, b.length)
+}
+
+// findEmbeddedBlobs parses a Go file and returns its string literals, or
+// chains of literals joined with +, that are at least minLength characters
+// of base64. Generated files are skipped.
+func findEmbeddedBlobs(filename string, content []byte, minLength int) ([]embeddedBlob, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, content, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	if ast.IsGenerated(file) {
+		return nil, nil
+	}
+
+	var blobs []embeddedBlob
+	ast.Inspect(file, func(n ast.Node) bool {
+		expr, ok := n.(ast.Expr)
+		if !ok {
+			return true
+		}
+		value, isString := stringConstant(expr)
+		if !isString {
+			return true
+		}
+		if payload := stripBase64Whitespace(value); len(payload) >= minLength && looksLikeBase64(payload) {
+			blobs = append(blobs, embeddedBlob{
+				file:   filename,
+				line:   fset.Position(expr.Pos()).Line,
+				length: len(payload),
+			})
+		}
+		// The parts of a concatenation are not checked again on their own
+		return false
+	})
+	return blobs, nil
+}
+
+// stringConstant returns the value of a string literal or a chain of string
+// literals joined with +, and false for any other expression
+func stringConstant(expr ast.Expr) (string, bool) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.STRING {
+			return "", false
+		}
+		value, err := strconv.Unquote(e.Value)
+		return value, err == nil
+	case *ast.ParenExpr:
+		return stringConstant(e.X)
+	case *ast.BinaryExpr:
+		if e.Op != token.ADD {
+			return "", false
+		}
+		left, ok := stringConstant(e.X)
+		if !ok {
+			return "", false
+		}
+		right, ok := stringConstant(e.Y)
+		if !ok {
+			return "", false
+		}
+		return left + right, true
+	default:
+		return "", false
+	}
+}
+
+// stripBase64Whitespace removes the line breaks and spaces that wrapped
+// base64, such as PEM bodies in raw strings, may contain
+func stripBase64Whitespace(value string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '\n', '\r', '\t', ' ':
+			return -1
+		default:
+			return r
+		}
+	}, value)
+}
+
+// looksLikeBase64 reports whether value decodes as standard or URL-safe
+// base64, padded or not, and mixes upper case, lower case, and digits as
+// encoded binary data does. The mix rules out long hex strings and runs of a
+// single character.
+func looksLikeBase64(value string) bool {
+	var upper, lower, digit bool
+	for _, r := range value {
+		switch {
+		case r >= 'A' && r <= 'Z':
+			upper = true
+		case r >= 'a' && r <= 'z':
+			lower = true
+		case r >= '0' && r <= '9':
+			digit = true
+		case r == '+' || r == '/' || r == '-' || r == '_' || r == '=':
+		default:
+			return false
+		}
+	}
+	if !upper || !lower || !digit {
+		return false
+	}
+
+	for _, encoding := range []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding, base64.RawURLEncoding} {
+		if _, err := encoding.DecodeString(value); err == nil {
+			return true
+		}
+	}
+	return false
+}
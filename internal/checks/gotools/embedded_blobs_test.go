@@ -0,0 +1,134 @@
+package gotools
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+// testBlob returns base64 of n pseudo-random bytes, as an encoded image or
+// certificate would look
+func testBlob(n int) string {
+	data := make([]byte, 0, n+sha256.Size)
+	for seed := 0; len(data) < n; seed++ {
+		sum := sha256.Sum256([]byte{byte(seed), byte(seed >> 8)})
+		data = append(data, sum[:]...)
+	}
+	return base64.StdEncoding.EncodeToString(data[:n])
+}
+
+func TestFindEmbeddedBlobs(t *testing.T) {
+	blob := testBlob(900)
+	prose := strings.Repeat("The quick brown fox jumps over the lazy dog 42 times. ", 40)
+
+	runFinderCases(t, []finderCase{
+		{
+			name:    "long base64 literal",
+			content: fmt.Sprintf("package p\n\nvar logo = %q\n", blob),
+			want:    []string{"a.go:3: 1200-character base64 string literal; load it with //go:embed instead"},
+		},
+		{
+			name:    "normal long string",
+			content: fmt.Sprintf("package p\n\nconst usage = %q\n", prose),
+		},
+		{
+			name:    "short base64 literal",
+			content: fmt.Sprintf("package p\n\nconst token = %q\n", testBlob(48)),
+		},
+		{
+			name:    "long hex string",
+			content: fmt.Sprintf("package p\n\nconst digest = %q\n", strings.Repeat("0123456789abcdef", 80)),
+		},
+		{
+			name:    "concatenated literal counts as one",
+			content: fmt.Sprintf("package p\n\nvar cert = %q +\n\t%q\n", blob[:600], blob[600:]),
+			want:    []string{"a.go:3: 1200-character base64 string literal; load it with //go:embed instead"},
+		},
+		{
+			name:    "wrapped raw string",
+			content: "package p\n\nconst pem = `\n" + blob[:600] + "\n" + blob[600:] + "\n`\n",
+			want:    []string{"a.go:3: 1200-character base64 string literal; load it with //go:embed instead"},
+		},
+		{
+			name:    "literal passed to a call",
+			content: fmt.Sprintf("package p\n\nimport \"fmt\"\n\nfunc f() {\n\tfmt.Println(%q)\n}\n", blob),
+			want:    []string{"a.go:6: 1200-character base64 string literal; load it with //go:embed instead"},
+		},
+		{
+			name:    "generated file",
+			content: fmt.Sprintf("// Code generated by go-bindata. DO NOT EDIT.\n\npackage p\n\nvar logo = %q\n", blob),
+		},
+	}, func(file string, content []byte) ([]embeddedBlob, error) {
+		return findEmbeddedBlobs(file, content, defaultEmbeddedBlobMinLength)
+	})
+
+	_, err := findEmbeddedBlobs("bad.go", []byte("package p\nvar {"), defaultEmbeddedBlobMinLength)
+	require.Error(t, err)
+}
+
+func TestLooksLikeBase64(t *testing.T) {
+	assert.True(t, looksLikeBase64(testBlob(60)))
+	assert.True(t, looksLikeBase64(base64.RawURLEncoding.EncodeToString([]byte(testBlob(60)))))
+	assert.False(t, looksLikeBase64(strings.Repeat("A", 80)), "a single character")
+	assert.False(t, looksLikeBase64("not base64 at all"))
+	assert.False(t, looksLikeBase64(testBlob(60)[3:]), "a length no encoding produces")
+}
+
+func TestEmbeddedBlobCheck_Run(t *testing.T) {
+	dir := t.TempDir()
+	assets := filepath.Join(dir, "assets.go")
+	clean := filepath.Join(dir, "clean.go")
+	require.NoError(t, os.WriteFile(assets, fmt.Appendf(nil, "package p\n\nvar icon = %q\n", testBlob(1024)), 0o600))
+	require.NoError(t, os.WriteFile(clean, []byte("package p\n\nconst name = \"gopher\"\n"), 0o600))
+
+	check := NewEmbeddedBlobCheck()
+	require.NoError(t, check.Run(context.Background(), nil))
+	require.NoError(t, check.Run(context.Background(), []string{clean}))
+
+	err := check.Run(context.Background(), []string{assets, clean})
+	require.ErrorIs(t, err, prerrors.ErrEmbeddedBlob)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Equal(t, []string{assets}, checkErr.Files)
+	assert.Equal(t, assets+":3: 1368-character base64 string literal; load it with //go:embed instead", checkErr.Output)
+	assert.Contains(t, checkErr.Suggestion, "//go:embed")
+}
+
+func TestEmbeddedBlobCheck_FullConfig(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.EmbeddedBlobs = 5
+	cfg.CheckBehaviors.EmbeddedBlobMinLength = 64
+
+	check := NewEmbeddedBlobCheckWithFullConfig(nil, cfg)
+	assert.Equal(t, 64, check.minLength)
+	assert.Equal(t, 5, int(check.timeout.Seconds()))
+
+	file := filepath.Join(t.TempDir(), "keys.go")
+	require.NoError(t, os.WriteFile(file, fmt.Appendf(nil, "package p\n\nconst key = %q\n", testBlob(96)), 0o600))
+	require.ErrorIs(t, check.Run(context.Background(), []string{file}), prerrors.ErrEmbeddedBlob)
+	require.NoError(t, NewEmbeddedBlobCheck().Run(context.Background(), []string{file}))
+
+	assert.Equal(t, []string{"a.go", "a_test.go"}, check.FilterFiles([]string{"a.go", "a_test.go", "logo.png"}))
+}
+
+func TestEmbeddedBlobCheck_Metadata(t *testing.T) {
+	check := NewEmbeddedBlobCheck()
+	assert.Equal(t, "embedded-blobs", check.Name())
+	assert.NotEmpty(t, check.Description())
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "embedded-blobs", metadata.Name)
+	assert.Equal(t, defaultEmbeddedBlobMinLength, check.minLength)
+}
@@ -0,0 +1,208 @@
+package gotools
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// envAccessDirective on the line of a call keeps it
+const envAccessDirective = "go-pre-commit:allow-env"
+
+// defaultEnvAccessAllow is the package allowlist used when none is configured
+var defaultEnvAccessAllow = []string{"internal/config"}
+
+// EnvAccessCheck flags os.Getenv and os.LookupEnv calls in Go packages
+// outside an allowlist, so environment access stays in the packages meant
+// to hold it. Allowlist entries are repository-relative package directories
+// matched exactly, as a glob, or with a trailing "/..." for a whole tree.
+// Test and generated files are skipped, and a call can be kept with
+// //go-pre-commit:allow-env on its line. Findings are warn-only.
+type EnvAccessCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+	allow     []string
+}
+
+// envAccess is one flagged call
+type envAccess struct {
+	file     string
+	line     int
+	function string // "os.Getenv" or "os.LookupEnv"
+}
+
+// NewEnvAccessCheck creates a new environment access check
+func NewEnvAccessCheck() *EnvAccessCheck {
+	return &EnvAccessCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   30 * time.Second,
+		allow:     defaultEnvAccessAllow,
+	}
+}
+
+// NewEnvAccessCheckWithSharedContext creates a new environment access check with shared context
+func NewEnvAccessCheckWithSharedContext(sharedCtx *shared.Context) *EnvAccessCheck {
+	return &EnvAccessCheck{
+		sharedCtx: sharedCtx,
+		timeout:   30 * time.Second,
+		allow:     defaultEnvAccessAllow,
+	}
+}
+
+// NewEnvAccessCheckWithFullConfig creates a new environment access check with full configuration
+func NewEnvAccessCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *EnvAccessCheck {
+	check := NewEnvAccessCheckWithSharedContext(sharedCtx)
+	if cfg != nil {
+		if cfg.CheckTimeouts.EnvAccess > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.EnvAccess) * time.Second
+		}
+		if len(cfg.CheckBehaviors.EnvAccessAllow) > 0 {
+			check.allow = cfg.CheckBehaviors.EnvAccessAllow
+		}
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *EnvAccessCheck) Name() string {
+	return "env-access"
+}
+
+// Description returns a brief description of the check
+func (c *EnvAccessCheck) Description() string {
+	return "Flag os.Getenv calls outside the config packages"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *EnvAccessCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "env-access",
+		Description:       "Warn when os.Getenv or os.LookupEnv is called outside the allowlisted packages",
+		FilePatterns:      []string{"*.go"},
+		EstimatedDuration: 1 * time.Second,
+		Dependencies:      []string{}, // Pure Go, no external tools
+		DefaultTimeout:    c.timeout,
+		Category:          "style",
+		RequiresFiles:     true,
+		Cacheable:         true,
+	}
+}
+
+// Run executes the environment access check
+func (c *EnvAccessCheck) Run(ctx context.Context, files []string) error {
+	return astCheck[envAccess]{
+		checkReport: checkReport{
+			err:     prerrors.ErrEnvAccess,
+			message: "%d environment variable read(s) outside the config packages",
+			suggestion: "Read the variable in a config package and pass the value in, add the package to " +
+				"GO_PRE_COMMIT_ENV_ACCESS_ALLOW, or add //" + envAccessDirective + " to the line",
+			warnOnly: true,
+		},
+		sharedCtx: c.sharedCtx,
+		timeout:   c.timeout,
+		skip:      c.allowed,
+		find:      findEnvAccess,
+	}.run(ctx, files)
+}
+
+// allowed reports whether file is in a package GO_PRE_COMMIT_ENV_ACCESS_ALLOW exempts
+func (c *EnvAccessCheck) allowed(repoRoot, file string) bool {
+	_, allowed := matchImportRule(packageDir(repoRoot, file), c.allow)
+	return allowed
+}
+
+// FilterFiles filters to Go files, leaving out tests
+func (c *EnvAccessCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if strings.HasSuffix(file, ".go") && !strings.HasSuffix(file, "_test.go") {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// String formats the call with its location
+func (e envAccess) String() string {
+	return fmt.Sprintf("%s:%d: %s outside the config packages", e.file, e.line, e.function)
+}
+
+// packageDir returns the slash-separated directory of file relative to the
+// repository root, which is what allowlist entries match
+func packageDir(repoRoot, file string) string {
+	if filepath.IsAbs(file) && repoRoot != "" {
+		if rel, err := filepath.Rel(repoRoot, file); err == nil {
+			file = rel
+		}
+	}
+	return path.Dir(filepath.ToSlash(filepath.Clean(file)))
+}
+
+// findEnvAccess parses a Go file and returns its os.Getenv and os.LookupEnv
+// calls, through any name the os package is imported under. Generated files
+// and calls with envAccessDirective on their line are skipped.
+func findEnvAccess(filename string, content []byte) ([]envAccess, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, content, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	if ast.IsGenerated(file) {
+		return nil, nil
+	}
+
+	osName := ""
+	for _, spec := range file.Imports {
+		if importPath, unquoteErr := strconv.Unquote(spec.Path.Value); unquoteErr != nil || importPath != "os" {
+			continue
+		}
+		osName = "os"
+		if spec.Name != nil {
+			osName = spec.Name.Name
+		}
+	}
+	if osName == "" || osName == "_" || osName == "." {
+		return nil, nil
+	}
+
+	suppressed := make(map[int]bool)
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			if strings.Contains(comment.Text, envAccessDirective) {
+				suppressed[fset.Position(comment.Slash).Line] = true
+			}
+		}
+	}
+
+	var found []envAccess
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || (sel.Sel.Name != "Getenv" && sel.Sel.Name != "LookupEnv") {
+			return true
+		}
+		if pkg, isIdent := sel.X.(*ast.Ident); !isIdent || pkg.Name != osName {
+			return true
+		}
+		line := fset.Position(call.Pos()).Line
+		if !suppressed[line] {
+			found = append(found, envAccess{file: filename, line: line, function: "os." + sel.Sel.Name})
+		}
+		return true
+	})
+	return found, nil
+}
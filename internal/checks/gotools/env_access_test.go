@@ -0,0 +1,89 @@
+package gotools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+func TestFindEnvAccess(t *testing.T) {
+	runFinderCases(t, []finderCase{
+		{
+			name:    "getenv and lookupenv",
+			content: "package p\n\nimport \"os\"\n\nfunc f() {\n\t_ = os.Getenv(\"HOME\")\n\t_, _ = os.LookupEnv(\"PATH\")\n}\n",
+			want:    []string{"a.go:6: os.Getenv outside the config packages", "a.go:7: os.LookupEnv outside the config packages"},
+		},
+		{
+			name:    "renamed import",
+			content: "package p\n\nimport stdos \"os\"\n\nvar home = stdos.Getenv(\"HOME\")\n",
+			want:    []string{"a.go:5: os.Getenv outside the config packages"},
+		},
+		{
+			name:    "suppressed on the line",
+			content: "package p\n\nimport \"os\"\n\nvar home = os.Getenv(\"HOME\") //go-pre-commit:allow-env\n",
+		},
+		{
+			name:    "other package with the same function name",
+			content: "package p\n\nimport \"syscall\"\n\nvar home, _ = syscall.Getenv(\"HOME\")\n",
+		},
+		{
+			name:    "os imported but only setenv used",
+			content: "package p\n\nimport \"os\"\n\nfunc f() { _ = os.Setenv(\"A\", \"b\") }\n",
+		},
+		{
+			name:    "generated file",
+			content: "// Code generated by mockgen. DO NOT EDIT.\n\npackage p\n\nimport \"os\"\n\nvar home = os.Getenv(\"HOME\")\n",
+		},
+	}, findEnvAccess)
+}
+
+func TestEnvAccessCheck_Run(t *testing.T) {
+	setupMultiModuleRepo(t, map[string]string{".": "module example.com/repo\n\ngo 1.22\n"})
+	content := []byte("package p\n\nimport \"os\"\n\nvar home = os.Getenv(\"HOME\")\n")
+	for _, file := range []string{"internal/config/env.go", "internal/server/server.go", "cmd/app/main.go"} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(file), 0o750))
+		require.NoError(t, os.WriteFile(file, content, 0o600))
+	}
+
+	check := NewEnvAccessCheckWithSharedContext(shared.NewContext())
+	require.NoError(t, check.Run(context.Background(), []string{"internal/config/env.go"}), "allowed package")
+
+	err := check.Run(context.Background(), []string{"internal/config/env.go", "internal/server/server.go"})
+	require.ErrorIs(t, err, prerrors.ErrEnvAccess)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.True(t, checkErr.WarnOnly)
+	assert.Equal(t, []string{"internal/server/server.go"}, checkErr.Files)
+	assert.Equal(t, "internal/server/server.go:5: os.Getenv outside the config packages", checkErr.Output)
+	assert.Contains(t, checkErr.Suggestion, "//go-pre-commit:allow-env")
+
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.EnvAccessAllow = []string{"internal/config", "cmd/..."}
+	configured := NewEnvAccessCheckWithFullConfig(shared.NewContext(), cfg)
+	require.NoError(t, configured.Run(context.Background(), []string{"cmd/app/main.go"}), "allowed by prefix")
+	require.Error(t, configured.Run(context.Background(), []string{"internal/server/server.go"}))
+}
+
+func TestEnvAccessCheck_FilterFilesAndMetadata(t *testing.T) {
+	check := NewEnvAccessCheck()
+	assert.Equal(t, []string{"a.go"}, check.FilterFiles([]string{"a.go", "a_test.go", "go.mod"}))
+
+	assert.Equal(t, "env-access", check.Name())
+	assert.NotEmpty(t, check.Description())
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "env-access", metadata.Name)
+
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.EnvAccess = 5
+	assert.Equal(t, 5, int(NewEnvAccessCheckWithFullConfig(nil, cfg).timeout.Seconds()))
+}
@@ -0,0 +1,219 @@
+package gotools
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// errorCompareDirective suppresses a finding when it appears in a comment on
+// the same line. It matches golangci-lint's errorlint, so one directive
+// silences both tools.
+const errorCompareDirective = "nolint:errorlint"
+
+// ErrorCompareCheck flags == and != comparisons against sentinel errors, which
+// miss wrapped errors; errors.Is unwraps them. The check parses files without
+// type information, so sentinels are recognized by the Go naming convention:
+// exported ErrXxx identifiers and io.EOF.
+type ErrorCompareCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+	skipTests bool
+}
+
+// errorComparison is one flagged comparison
+type errorComparison struct {
+	file     string
+	line     int
+	expr     string // the comparison as written
+	sentinel string
+	operand  string // the other side of the comparison
+	negated  bool
+}
+
+// NewErrorCompareCheck creates a new sentinel error comparison check
+func NewErrorCompareCheck() *ErrorCompareCheck {
+	return &ErrorCompareCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewErrorCompareCheckWithSharedContext creates a new sentinel error comparison check with shared context
+func NewErrorCompareCheckWithSharedContext(sharedCtx *shared.Context) *ErrorCompareCheck {
+	return &ErrorCompareCheck{
+		sharedCtx: sharedCtx,
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewErrorCompareCheckWithFullConfig creates a new sentinel error comparison check with full configuration
+func NewErrorCompareCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *ErrorCompareCheck {
+	check := NewErrorCompareCheckWithSharedContext(sharedCtx)
+	if cfg != nil {
+		if cfg.CheckTimeouts.ErrorCompare > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.ErrorCompare) * time.Second
+		}
+		check.skipTests = cfg.CheckBehaviors.ErrorCompareSkipTests
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *ErrorCompareCheck) Name() string {
+	return "error-compare"
+}
+
+// Description returns a brief description of the check
+func (c *ErrorCompareCheck) Description() string {
+	return "Flag == comparisons against sentinel errors"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *ErrorCompareCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "error-compare",
+		Description:       "Require errors.Is instead of == or != against sentinel errors",
+		FilePatterns:      []string{"*.go"},
+		EstimatedDuration: 1 * time.Second,
+		Dependencies:      []string{}, // Pure Go, no external tools
+		DefaultTimeout:    c.timeout,
+		Category:          "correctness",
+		RequiresFiles:     true,
+		Cacheable:         true,
+	}
+}
+
+// Run executes the sentinel error comparison check
+func (c *ErrorCompareCheck) Run(ctx context.Context, files []string) error {
+	return astCheck[errorComparison]{
+		checkReport: checkReport{
+			err:        prerrors.ErrErrorComparison,
+			message:    "%d comparison(s) against sentinel errors use == or !=",
+			suggestion: "Use errors.Is, which also matches wrapped errors, or add //" + errorCompareDirective + " to keep an intentional comparison",
+		},
+		sharedCtx: c.sharedCtx,
+		timeout:   c.timeout,
+		find:      findErrorComparisons,
+	}.run(ctx, files)
+}
+
+// FilterFiles filters to Go files, leaving out tests when configured to
+func (c *ErrorCompareCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if !strings.HasSuffix(file, ".go") {
+			continue
+		}
+		if c.skipTests && strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		filtered = append(filtered, file)
+	}
+	return filtered
+}
+
+// String formats the comparison with the errors.Is replacement
+func (e errorComparison) String() string {
+	replacement := fmt.Sprintf("errors.Is(%s, %s)", e.operand, e.sentinel)
+	if e.negated {
+		replacement = "!" + replacement
+	}
+	return fmt.Sprintf("%s:%d: %s; use %s", e.file, e.line, e.expr, replacement)
+}
+
+// findErrorComparisons parses a Go file and returns its == and != comparisons
+// against sentinel errors. Generated files and suppressed lines are skipped.
+func findErrorComparisons(filename string, content []byte) ([]errorComparison, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, content, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	if ast.IsGenerated(file) {
+		return nil, nil
+	}
+
+	suppressed := make(map[int]bool)
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			if strings.Contains(comment.Text, errorCompareDirective) {
+				suppressed[fset.Position(comment.Slash).Line] = true
+			}
+		}
+	}
+
+	var comparisons []errorComparison
+	ast.Inspect(file, func(n ast.Node) bool {
+		bin, ok := n.(*ast.BinaryExpr)
+		if !ok || (bin.Op != token.EQL && bin.Op != token.NEQ) {
+			return true
+		}
+
+		sentinel, operand := bin.Y, bin.X
+		if !isSentinelError(sentinel) {
+			sentinel, operand = bin.X, bin.Y
+		}
+		if !isSentinelError(sentinel) || isNil(operand) {
+			return true
+		}
+
+		line := fset.Position(bin.OpPos).Line
+		if suppressed[line] {
+			return true
+		}
+		comparisons = append(comparisons, errorComparison{
+			file:     filename,
+			line:     line,
+			expr:     types.ExprString(bin),
+			sentinel: types.ExprString(sentinel),
+			operand:  types.ExprString(operand),
+			negated:  bin.Op == token.NEQ,
+		})
+		return true
+	})
+	return comparisons, nil
+}
+
+// isSentinelError reports whether expr names a sentinel error by convention:
+// an exported ErrXxx identifier, optionally package-qualified, or io.EOF
+func isSentinelError(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return isSentinelName(e.Name)
+	case *ast.SelectorExpr:
+		if pkg, ok := e.X.(*ast.Ident); ok && pkg.Name == "io" && e.Sel.Name == "EOF" {
+			return true
+		}
+		return isSentinelName(e.Sel.Name)
+	default:
+		return false
+	}
+}
+
+// isSentinelName reports whether name follows the ErrXxx convention
+func isSentinelName(name string) bool {
+	rest, ok := strings.CutPrefix(name, "Err")
+	if !ok || rest == "" {
+		return false
+	}
+	r, _ := utf8.DecodeRuneInString(rest)
+	return unicode.IsUpper(r) || unicode.IsDigit(r)
+}
+
+// isNil reports whether expr is the nil identifier
+func isNil(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == "nil"
+}
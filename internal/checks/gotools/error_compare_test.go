@@ -0,0 +1,124 @@
+package gotools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+func TestFindErrorComparisons(t *testing.T) {
+	runFinderCases(t, []finderCase{
+		{
+			name: "nil comparisons are allowed",
+			content: `package p
+func f(err error) bool { return err == nil || nil != err }
+`,
+		},
+		{
+			name: "local sentinel",
+			content: `package p
+func f(err error) bool {
+	return err == ErrNotFound
+}
+`,
+			want: []string{"a.go:3: err == ErrNotFound; use errors.Is(err, ErrNotFound)"},
+		},
+		{
+			name: "qualified sentinel on the left with !=",
+			content: `package p
+func f(err error) bool {
+	return os.ErrNotExist != err
+}
+`,
+			want: []string{"a.go:3: os.ErrNotExist != err; use !errors.Is(err, os.ErrNotExist)"},
+		},
+		{
+			name: "io.EOF",
+			content: `package p
+func f(err error) bool {
+	if err == io.EOF {
+		return true
+	}
+	return false
+}
+`,
+			want: []string{"a.go:3: err == io.EOF; use errors.Is(err, io.EOF)"},
+		},
+		{
+			name: "non-sentinel names are ignored",
+			content: `package p
+func f(a, b error, Errand string) bool {
+	return a == b || Errand == "x" || a == errLocal || a == Err
+}
+`,
+		},
+		{
+			name: "errors.Is is allowed",
+			content: `package p
+func f(err error) bool { return errors.Is(err, ErrNotFound) }
+`,
+		},
+		{
+			name: "suppressed on the same line",
+			content: `package p
+func f(err error) bool {
+	return err == ErrNotFound //nolint:errorlint // identity is intended
+}
+`,
+		},
+		{
+			name: "generated file",
+			content: `// Code generated by mockgen. DO NOT EDIT.
+
+package p
+func f(err error) bool { return err == ErrNotFound }
+`,
+		},
+	}, findErrorComparisons)
+}
+
+func TestFindErrorComparisons_ParseError(t *testing.T) {
+	_, err := findErrorComparisons("a.go", []byte("package p\nfunc {"))
+	require.Error(t, err)
+}
+
+func TestErrorCompareCheck_Run(t *testing.T) {
+	dir := t.TempDir()
+	bad := filepath.Join(dir, "bad.go")
+	good := filepath.Join(dir, "good.go")
+	require.NoError(t, os.WriteFile(bad, []byte("package p\n\nfunc f(err error) bool {\n\treturn err == ErrClosed\n}\n"), 0o600))
+	require.NoError(t, os.WriteFile(good, []byte("package p\n\nfunc g(err error) bool {\n\treturn err != nil\n}\n"), 0o600))
+
+	check := NewErrorCompareCheck()
+	require.NoError(t, check.Run(context.Background(), []string{good}))
+
+	err := check.Run(context.Background(), []string{bad, good})
+	require.ErrorIs(t, err, prerrors.ErrErrorComparison)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Equal(t, []string{bad}, checkErr.Files)
+	assert.Contains(t, checkErr.Output, "bad.go:4: err == ErrClosed; use errors.Is(err, ErrClosed)")
+	assert.Contains(t, checkErr.Suggestion, "errors.Is")
+}
+
+func TestErrorCompareCheck_FilterFilesAndMetadata(t *testing.T) {
+	check := NewErrorCompareCheck()
+	files := []string{"a.go", "a_test.go", "b.py"}
+	assert.Equal(t, []string{"a.go", "a_test.go"}, check.FilterFiles(files))
+
+	check.skipTests = true
+	assert.Equal(t, []string{"a.go"}, check.FilterFiles(files))
+
+	assert.Equal(t, "error-compare", check.Name())
+	assert.NotEmpty(t, check.Description())
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "error-compare", metadata.Name)
+}
@@ -0,0 +1,176 @@
+package gotools
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// ErrorVarNamingCheck flags package-level variables initialized with
+// errors.New or fmt.Errorf whose names do not start with Err, or err when
+// unexported, so sentinel errors are easy to find and to tell from other
+// values. Blank variables and generated files are skipped. Findings are
+// warn-only unless the severity is set to error.
+type ErrorVarNamingCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+	blocking  bool
+}
+
+// misnamedErrorVar is one flagged error variable
+type misnamedErrorVar struct {
+	file string
+	line int
+	name string
+}
+
+// NewErrorVarNamingCheck creates a new error variable naming check
+func NewErrorVarNamingCheck() *ErrorVarNamingCheck {
+	return &ErrorVarNamingCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewErrorVarNamingCheckWithSharedContext creates a new error variable naming check with shared context
+func NewErrorVarNamingCheckWithSharedContext(sharedCtx *shared.Context) *ErrorVarNamingCheck {
+	return &ErrorVarNamingCheck{
+		sharedCtx: sharedCtx,
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewErrorVarNamingCheckWithFullConfig creates a new error variable naming check with full configuration
+func NewErrorVarNamingCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *ErrorVarNamingCheck {
+	check := NewErrorVarNamingCheckWithSharedContext(sharedCtx)
+	if cfg != nil {
+		if cfg.CheckTimeouts.ErrorVarNaming > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.ErrorVarNaming) * time.Second
+		}
+		check.blocking = cfg.CheckBehaviors.ErrorVarNamingSeverity == config.LintSeverityError
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *ErrorVarNamingCheck) Name() string {
+	return "error-var-naming"
+}
+
+// Description returns a brief description of the check
+func (c *ErrorVarNamingCheck) Description() string {
+	return "Require package-level error variables to be named ErrXxx or errXxx"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *ErrorVarNamingCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "error-var-naming",
+		Description:       "Warn when a package-level errors.New or fmt.Errorf variable is not named with an Err or err prefix",
+		FilePatterns:      []string{"*.go"},
+		EstimatedDuration: 1 * time.Second,
+		Dependencies:      []string{}, // Pure Go, no external tools
+		DefaultTimeout:    c.timeout,
+		Category:          "style",
+		RequiresFiles:     true,
+		Cacheable:         true,
+	}
+}
+
+// Run executes the error variable naming check
+func (c *ErrorVarNamingCheck) Run(ctx context.Context, files []string) error {
+	return astCheck[misnamedErrorVar]{
+		checkReport: checkReport{
+			err:        prerrors.ErrErrorVarNaming,
+			message:    "%d error variable(s) not named ErrXxx or errXxx",
+			suggestion: "Rename each variable as suggested and update its uses",
+			warnOnly:   !c.blocking,
+		},
+		sharedCtx: c.sharedCtx,
+		timeout:   c.timeout,
+		find:      findMisnamedErrorVars,
+	}.run(ctx, files)
+}
+
+// FilterFiles filters to only Go files
+func (c *ErrorVarNamingCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if strings.HasSuffix(file, ".go") {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// String formats the finding with its location and the suggested name
+func (v misnamedErrorVar) String() string {
+	return fmt.Sprintf("%s:%d: error variable %s should be named %s", v.file, v.line, v.name, errorVarName(v.name))
+}
+
+// findMisnamedErrorVars parses a Go file and returns its package-level
+// variables set to errors.New or fmt.Errorf without an Err or err prefix,
+// leaving out generated files
+func findMisnamedErrorVars(filename string, content []byte) ([]misnamedErrorVar, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, content, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	if ast.IsGenerated(file) {
+		return nil, nil
+	}
+
+	var found []misnamedErrorVar
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			valueSpec, isValue := spec.(*ast.ValueSpec)
+			if !isValue || len(valueSpec.Values) != len(valueSpec.Names) {
+				continue
+			}
+			for i, name := range valueSpec.Names {
+				if name.Name == "_" || hasErrorVarPrefix(name.Name) || !isErrorConstructor(valueSpec.Values[i]) {
+					continue
+				}
+				found = append(found, misnamedErrorVar{file: filename, line: fset.Position(name.Pos()).Line, name: name.Name})
+			}
+		}
+	}
+	return found, nil
+}
+
+// hasErrorVarPrefix reports whether name starts with Err or err
+func hasErrorVarPrefix(name string) bool {
+	return strings.HasPrefix(name, "Err") || strings.HasPrefix(name, "err")
+}
+
+// errorVarName returns name with the prefix its visibility calls for, and
+// without an Err or Error suffix: notFound becomes errNotFound and
+// NotFoundError becomes ErrNotFound
+func errorVarName(name string) string {
+	for _, suffix := range []string{"Error", "Err"} {
+		if trimmed := strings.TrimSuffix(name, suffix); trimmed != name && trimmed != "" {
+			name = trimmed
+			break
+		}
+	}
+	first, size := utf8.DecodeRuneInString(name)
+	if unicode.IsUpper(first) {
+		return "Err" + name
+	}
+	return "err" + string(unicode.ToUpper(first)) + name[size:]
+}
@@ -0,0 +1,94 @@
+package gotools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+func TestFindMisnamedErrorVars(t *testing.T) {
+	runFinderCases(t, []finderCase{
+		{
+			name:    "well named",
+			content: "package p\n\nimport (\n\t\"errors\"\n\t\"fmt\"\n)\n\nvar (\n\tErrNotFound = errors.New(\"not found\")\n\terrClosed = fmt.Errorf(\"closed: %w\", ErrNotFound)\n)\n",
+		},
+		{
+			name:    "misnamed",
+			content: "package p\n\nimport (\n\t\"errors\"\n\t\"fmt\"\n)\n\nvar (\n\tNotFound = errors.New(\"not found\")\n\tclosedErr = fmt.Errorf(\"closed\")\n\tTimeoutError = errors.New(\"timeout\")\n)\n",
+			want: []string{
+				"a.go:9: error variable NotFound should be named ErrNotFound",
+				"a.go:10: error variable closedErr should be named errClosed",
+				"a.go:11: error variable TimeoutError should be named ErrTimeout",
+			},
+		},
+		{
+			name:    "several names in one spec",
+			content: "package p\n\nimport \"errors\"\n\nvar ErrA, b = errors.New(\"a\"), errors.New(\"b\")\n",
+			want:    []string{"a.go:5: error variable b should be named errB"},
+		},
+		{
+			name:    "other initializers",
+			content: "package p\n\nimport \"errors\"\n\nvar (\n\tcache = map[string]error{}\n\tlast error\n\twrapped = errors.Join(nil)\n)\n",
+		},
+		{
+			name:    "blank variable",
+			content: "package p\n\nimport \"errors\"\n\nvar _ = errors.New(\"unused\")\n",
+		},
+		{
+			name:    "local variables",
+			content: "package p\n\nimport \"errors\"\n\nfunc f() error {\n\tnotFound := errors.New(\"x\")\n\treturn notFound\n}\n",
+		},
+		{
+			name:    "generated file",
+			content: "// Code generated by protoc-gen-go. DO NOT EDIT.\n\npackage p\n\nimport \"errors\"\n\nvar NotFound = errors.New(\"not found\")\n",
+		},
+	}, findMisnamedErrorVars)
+}
+
+func TestErrorVarNamingCheck_Run(t *testing.T) {
+	setupMultiModuleRepo(t, map[string]string{".": "module example.com/repo\n\ngo 1.22\n"})
+	writeModuleFiles(t, map[string]string{
+		"errors.go":         "package repo\n\nimport \"errors\"\n\nvar ErrBad = errors.New(\"bad\")\n",
+		"internal/s/s.go":   "package s\n\nimport \"errors\"\n\nvar invalidInput = errors.New(\"invalid input\")\n",
+		"internal/s/bad.go": "package s\n\nfunc {\n",
+	})
+
+	check := NewErrorVarNamingCheckWithSharedContext(shared.NewContext())
+	require.NoError(t, check.Run(context.Background(), []string{"errors.go", "internal/s/bad.go"}), "well named and unparsable")
+
+	err := check.Run(context.Background(), []string{"errors.go", "internal/s/s.go"})
+	require.ErrorIs(t, err, prerrors.ErrErrorVarNaming)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.True(t, checkErr.WarnOnly, "warn-only by default")
+	assert.Equal(t, []string{"internal/s/s.go"}, checkErr.Files)
+	assert.Equal(t, "internal/s/s.go:5: error variable invalidInput should be named errInvalidInput", checkErr.Output)
+
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.ErrorVarNamingSeverity = config.LintSeverityError
+	err = NewErrorVarNamingCheckWithFullConfig(shared.NewContext(), cfg).Run(context.Background(), []string{"internal/s/s.go"})
+	require.ErrorAs(t, err, &checkErr)
+	assert.False(t, checkErr.WarnOnly, "error severity blocks the commit")
+}
+
+func TestErrorVarNamingCheck_FilterFilesAndMetadata(t *testing.T) {
+	check := NewErrorVarNamingCheck()
+	assert.Equal(t, []string{"a.go", "a_test.go"}, check.FilterFiles([]string{"a.go", "a_test.go", "go.mod"}))
+
+	assert.Equal(t, "error-var-naming", check.Name())
+	assert.NotEmpty(t, check.Description())
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "error-var-naming", metadata.Name)
+
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.ErrorVarNaming = 5
+	assert.Equal(t, 5, int(NewErrorVarNamingCheckWithFullConfig(nil, cfg).timeout.Seconds()))
+}
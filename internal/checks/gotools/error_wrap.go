@@ -0,0 +1,260 @@
+package gotools
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// errorWrapDirective suppresses a finding when it appears in a comment on the
+// same line. Like error-compare it matches golangci-lint's errorlint, which
+// reports the same problem.
+const errorWrapDirective = "nolint:errorlint"
+
+// ErrorWrapCheck flags fmt.Errorf calls that format an error with %v or %s,
+// which flattens it to text so errors.Is and errors.As no longer see it; %w
+// keeps it in the chain. The check parses files without type information, so
+// errors are recognized by name: err, or any name ending in Err.
+type ErrorWrapCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+}
+
+// unwrappedError is one error argument formatted without %w
+type unwrappedError struct {
+	file string
+	line int
+	arg  string // the error argument as written
+	verb rune
+}
+
+// NewErrorWrapCheck creates a new error wrapping check
+func NewErrorWrapCheck() *ErrorWrapCheck {
+	return &ErrorWrapCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewErrorWrapCheckWithSharedContext creates a new error wrapping check with shared context
+func NewErrorWrapCheckWithSharedContext(sharedCtx *shared.Context) *ErrorWrapCheck {
+	return &ErrorWrapCheck{
+		sharedCtx: sharedCtx,
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewErrorWrapCheckWithFullConfig creates a new error wrapping check with full configuration
+func NewErrorWrapCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *ErrorWrapCheck {
+	check := NewErrorWrapCheckWithSharedContext(sharedCtx)
+	if cfg != nil && cfg.CheckTimeouts.ErrorWrap > 0 {
+		check.timeout = time.Duration(cfg.CheckTimeouts.ErrorWrap) * time.Second
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *ErrorWrapCheck) Name() string {
+	return "error-wrap"
+}
+
+// Description returns a brief description of the check
+func (c *ErrorWrapCheck) Description() string {
+	return "Require %w when fmt.Errorf formats an error"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *ErrorWrapCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "error-wrap",
+		Description:       "Flag fmt.Errorf calls that format an error with %v or %s instead of wrapping it with %w",
+		FilePatterns:      []string{"*.go"},
+		EstimatedDuration: 1 * time.Second,
+		Dependencies:      []string{}, // Pure Go, no external tools
+		DefaultTimeout:    c.timeout,
+		Category:          "correctness",
+		RequiresFiles:     true,
+		Cacheable:         true,
+	}
+}
+
+// Run executes the error wrapping check
+func (c *ErrorWrapCheck) Run(ctx context.Context, files []string) error {
+	return astCheck[unwrappedError]{
+		checkReport: checkReport{
+			err:        prerrors.ErrErrorNotWrapped,
+			message:    "%d fmt.Errorf argument(s) format an error without %%w",
+			suggestion: "Use %w so callers can match the error with errors.Is and errors.As, or add //" + errorWrapDirective + " to keep the text only",
+		},
+		sharedCtx: c.sharedCtx,
+		timeout:   c.timeout,
+		find:      findUnwrappedErrors,
+	}.run(ctx, files)
+}
+
+// FilterFiles filters to Go files
+func (c *ErrorWrapCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if strings.HasSuffix(file, ".go") {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// String formats the finding with the verb to use instead
+func (u unwrappedError) String() string {
+	return fmt.Sprintf("%s:%d: %s formatted with %%%c; use %%w to wrap it", u.file, u.line, u.arg, u.verb)
+}
+
+// findUnwrappedErrors parses a Go file and returns the error arguments of its
+// fmt.Errorf calls that are formatted with %v or %s. Generated files,
+// suppressed lines, and calls whose format is not a string literal are
+// skipped.
+func findUnwrappedErrors(filename string, content []byte) ([]unwrappedError, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, content, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	if ast.IsGenerated(file) {
+		return nil, nil
+	}
+
+	fmtName := importName(file, "fmt")
+	if fmtName == "" {
+		return nil, nil
+	}
+
+	suppressed := make(map[int]bool)
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			if strings.Contains(comment.Text, errorWrapDirective) {
+				suppressed[fset.Position(comment.Slash).Line] = true
+			}
+		}
+	}
+
+	var unwrapped []unwrappedError
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || !isPackageCall(call, fmtName, "Errorf") || len(call.Args) < 2 {
+			return true
+		}
+		lit, ok := call.Args[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return true
+		}
+		format, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return true
+		}
+
+		args := call.Args[1:]
+		for i, verb := range formatVerbs(format) {
+			if i >= len(args) || (verb != 'v' && verb != 's') {
+				continue
+			}
+			name, isErr := errorArgName(args[i])
+			if !isErr {
+				continue
+			}
+			line := fset.Position(args[i].Pos()).Line
+			if suppressed[line] || suppressed[fset.Position(call.Pos()).Line] {
+				continue
+			}
+			unwrapped = append(unwrapped, unwrappedError{file: filename, line: line, arg: name, verb: verb})
+		}
+		return true
+	})
+	return unwrapped, nil
+}
+
+// formatVerbs returns the verb each argument of a printf format is formatted
+// with, in argument order; a * width or precision takes an argument of its
+// own, recorded as '*'. Formats with explicit argument indexes return nil, as
+// the argument order can no longer be followed without evaluating them.
+func formatVerbs(format string) []rune {
+	var verbs []rune
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+		for i++; i < len(format); i++ {
+			ch := format[i]
+			if ch == '[' {
+				return nil
+			}
+			if ch == '*' {
+				verbs = append(verbs, '*')
+				continue
+			}
+			if strings.IndexByte("+-# 0123456789.", ch) >= 0 {
+				continue
+			}
+			// A literal percent sign takes no argument
+			if ch != '%' {
+				verbs = append(verbs, rune(ch))
+			}
+			break
+		}
+	}
+	return verbs
+}
+
+// errorArgName returns the argument as written when it names an error by
+// convention: err, or an identifier or field ending in Err
+func errorArgName(arg ast.Expr) (string, bool) {
+	var name string
+	switch e := arg.(type) {
+	case *ast.Ident:
+		name = e.Name
+	case *ast.SelectorExpr:
+		name = e.Sel.Name
+	default:
+		return "", false
+	}
+	if name != "err" && !strings.HasSuffix(name, "Err") {
+		return "", false
+	}
+	return types.ExprString(arg), true
+}
+
+// importName returns the name a file refers to the imported package path by,
+// or "" when the file does not import it (or imports it blank or dotted)
+func importName(file *ast.File, path string) string {
+	for _, spec := range file.Imports {
+		if importPath, err := strconv.Unquote(spec.Path.Value); err != nil || importPath != path {
+			continue
+		}
+		if spec.Name == nil {
+			return path[strings.LastIndexByte(path, '/')+1:]
+		}
+		if spec.Name.Name == "_" || spec.Name.Name == "." {
+			return ""
+		}
+		return spec.Name.Name
+	}
+	return ""
+}
+
+// isPackageCall reports whether call is pkg.fn(...)
+func isPackageCall(call *ast.CallExpr, pkg, fn string) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != fn {
+		return false
+	}
+	ident, ok := sel.X.(*ast.Ident)
+	return ok && ident.Name == pkg
+}
@@ -0,0 +1,146 @@
+package gotools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+func TestFindUnwrappedErrors(t *testing.T) {
+	runFinderCases(t, []finderCase{
+		{
+			name: "wrapped with %w",
+			content: `package p
+import "fmt"
+func f(err error) error { return fmt.Errorf("load config: %w", err) }
+`,
+		},
+		{
+			name: "unwrapped with %v",
+			content: `package p
+import "fmt"
+func f(err error) error {
+	return fmt.Errorf("load config: %v", err)
+}
+`,
+			want: []string{"a.go:4: err formatted with %v; use %w to wrap it"},
+		},
+		{
+			name: "names ending in Err and fields count as errors",
+			content: `package p
+import "fmt"
+func f(name string, readErr error, r result) error {
+	return fmt.Errorf("read %s: %s (%+v)", name, readErr, r.closeErr)
+}
+`,
+			want: []string{
+				"a.go:4: readErr formatted with %s; use %w to wrap it",
+				"a.go:4: r.closeErr formatted with %v; use %w to wrap it",
+			},
+		},
+		{
+			name: "star width and literal percent keep arguments aligned",
+			content: `package p
+import "fmt"
+func f(width int, err error) error {
+	return fmt.Errorf("100%% done, %*d: %v", width, 3, err)
+}
+`,
+			want: []string{"a.go:4: err formatted with %v; use %w to wrap it"},
+		},
+		{
+			name: "non-error arguments are ignored",
+			content: `package p
+import "fmt"
+func f(name string, errCount int, code int) error {
+	return fmt.Errorf("%s failed %v times: %d", name, errCount, code)
+}
+`,
+		},
+		{
+			name: "error text on purpose is ignored",
+			content: `package p
+import "fmt"
+func f(err error) error { return fmt.Errorf("status %d: %v", 500, err.Error()) }
+`,
+		},
+		{
+			name: "renamed fmt import",
+			content: `package p
+import format "fmt"
+func f(err error) error { return format.Errorf("%v", err) }
+`,
+			want: []string{"a.go:3: err formatted with %v; use %w to wrap it"},
+		},
+		{
+			name: "other packages and explicit indexes are ignored",
+			content: `package p
+import "fmt"
+func f(err error) error {
+	_ = errors.Errorf("%v", err)
+	return fmt.Errorf("%[1]v", err)
+}
+`,
+		},
+		{
+			name: "suppressed on the same line",
+			content: `package p
+import "fmt"
+func f(err error) error {
+	return fmt.Errorf("hide the cause: %v", err) //nolint:errorlint // not part of the API
+}
+`,
+		},
+		{
+			name: "generated file",
+			content: `// Code generated by mockgen. DO NOT EDIT.
+
+package p
+import "fmt"
+func f(err error) error { return fmt.Errorf("%v", err) }
+`,
+		},
+	}, findUnwrappedErrors)
+}
+
+func TestFindUnwrappedErrors_ParseError(t *testing.T) {
+	_, err := findUnwrappedErrors("a.go", []byte("package p\nfunc {"))
+	require.Error(t, err)
+}
+
+func TestErrorWrapCheck_Run(t *testing.T) {
+	dir := t.TempDir()
+	bad := filepath.Join(dir, "bad.go")
+	good := filepath.Join(dir, "good.go")
+	require.NoError(t, os.WriteFile(bad, []byte("package p\n\nimport \"fmt\"\n\nfunc f(err error) error {\n\treturn fmt.Errorf(\"open: %v\", err)\n}\n"), 0o600))
+	require.NoError(t, os.WriteFile(good, []byte("package p\n\nimport \"fmt\"\n\nfunc g(err error) error {\n\treturn fmt.Errorf(\"open: %w\", err)\n}\n"), 0o600))
+
+	check := NewErrorWrapCheck()
+	require.NoError(t, check.Run(context.Background(), []string{good}))
+
+	err := check.Run(context.Background(), []string{bad, good})
+	require.ErrorIs(t, err, prerrors.ErrErrorNotWrapped)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Equal(t, []string{bad}, checkErr.Files)
+	assert.Contains(t, checkErr.Output, "bad.go:6: err formatted with %v; use %w to wrap it")
+	assert.Contains(t, checkErr.Suggestion, "nolint:errorlint")
+}
+
+func TestErrorWrapCheck_FilterFilesAndMetadata(t *testing.T) {
+	check := NewErrorWrapCheck()
+	assert.Equal(t, []string{"a.go", "a_test.go"}, check.FilterFiles([]string{"a.go", "a_test.go", "b.py"}))
+
+	assert.Equal(t, "error-wrap", check.Name())
+	assert.NotEmpty(t, check.Description())
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "error-wrap", metadata.Name)
+}
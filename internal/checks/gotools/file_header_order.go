@@ -0,0 +1,222 @@
+package gotools
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/build/constraint"
+	"go/parser"
+	"go/token"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// defaultFileHeaderLicenseMarkers identify license comments when none are configured
+var defaultFileHeaderLicenseMarkers = []string{"Copyright", "SPDX-License-Identifier"}
+
+// FileHeaderOrderCheck verifies the comments above the package clause come
+// in the order license, build constraints, package doc, each separated by a
+// blank line except the package doc, which must sit directly on the package
+// clause. A build constraint after the package clause or attached to it is
+// ignored by the go command, a license attached to it becomes the package
+// doc, and a "Package x" comment set apart from it is not one. License
+// comments are those containing a configured marker, matched regardless of
+// case. Generated files are skipped.
+type FileHeaderOrderCheck struct {
+	sharedCtx      *shared.Context
+	timeout        time.Duration
+	licenseMarkers []string
+}
+
+// headerOrderIssue is one ordering violation in a file header
+type headerOrderIssue struct {
+	file    string
+	line    int
+	message string
+}
+
+// NewFileHeaderOrderCheck creates a new file header order check
+func NewFileHeaderOrderCheck() *FileHeaderOrderCheck {
+	return &FileHeaderOrderCheck{
+		sharedCtx:      shared.NewContext(),
+		timeout:        30 * time.Second,
+		licenseMarkers: defaultFileHeaderLicenseMarkers,
+	}
+}
+
+// NewFileHeaderOrderCheckWithSharedContext creates a new file header order check with shared context
+func NewFileHeaderOrderCheckWithSharedContext(sharedCtx *shared.Context) *FileHeaderOrderCheck {
+	return &FileHeaderOrderCheck{
+		sharedCtx:      sharedCtx,
+		timeout:        30 * time.Second,
+		licenseMarkers: defaultFileHeaderLicenseMarkers,
+	}
+}
+
+// NewFileHeaderOrderCheckWithFullConfig creates a new file header order check with full configuration
+func NewFileHeaderOrderCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *FileHeaderOrderCheck {
+	check := NewFileHeaderOrderCheckWithSharedContext(sharedCtx)
+	if cfg != nil {
+		if cfg.CheckTimeouts.FileHeaderOrder > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.FileHeaderOrder) * time.Second
+		}
+		if len(cfg.CheckBehaviors.FileHeaderLicenseMarkers) > 0 {
+			check.licenseMarkers = cfg.CheckBehaviors.FileHeaderLicenseMarkers
+		}
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *FileHeaderOrderCheck) Name() string {
+	return "file-header-order"
+}
+
+// Description returns a brief description of the check
+func (c *FileHeaderOrderCheck) Description() string {
+	return "Order license, build constraints, and package doc in Go file headers"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *FileHeaderOrderCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "file-header-order",
+		Description:       "Flag Go files whose license, build constraint, and package doc comments are out of order or misplaced",
+		FilePatterns:      []string{"*.go"},
+		EstimatedDuration: 1 * time.Second,
+		Dependencies:      []string{}, // Pure Go, no external tools
+		DefaultTimeout:    c.timeout,
+		Category:          "style",
+		RequiresFiles:     true,
+		Cacheable:         true,
+	}
+}
+
+// Run executes the file header order check
+func (c *FileHeaderOrderCheck) Run(ctx context.Context, files []string) error {
+	return astCheck[headerOrderIssue]{
+		checkReport: checkReport{
+			err:     prerrors.ErrFileHeaderOrder,
+			message: "%d file header ordering problem(s)",
+			suggestion: "Start Go files with the license comment, then the build constraints, each followed by a blank line, " +
+				"then the package doc comment directly above the package clause",
+		},
+		sharedCtx: c.sharedCtx,
+		timeout:   c.timeout,
+		find: func(file string, content []byte) ([]headerOrderIssue, error) {
+			return findHeaderOrderIssues(file, content, c.licenseMarkers)
+		},
+	}.run(ctx, files)
+}
+
+// FilterFiles filters to only Go files
+func (c *FileHeaderOrderCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if strings.HasSuffix(file, ".go") {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// String formats the issue with its location
+func (i headerOrderIssue) String() string {
+	return fmt.Sprintf("%s:%d: %s", i.file, i.line, i.message)
+}
+
+// findHeaderOrderIssues parses a Go file and returns the ordering problems
+// of its header comments, in file order. Generated files have none.
+func findHeaderOrderIssues(filename string, content []byte, licenseMarkers []string) ([]headerOrderIssue, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, content, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	if ast.IsGenerated(file) {
+		return nil, nil
+	}
+
+	var issues []headerOrderIssue
+	add := func(pos token.Pos, message string) {
+		issues = append(issues, headerOrderIssue{file: filename, line: fset.Position(pos).Line, message: message})
+	}
+
+	// The first effective build constraint, which a license must precede
+	firstConstraint := token.NoPos
+	for _, group := range file.Comments {
+		if group.End() >= file.Package || group == file.Doc {
+			break
+		}
+		for _, comment := range group.List {
+			if isBuildConstraint(comment.Text) {
+				firstConstraint = comment.Pos()
+				break
+			}
+		}
+		if firstConstraint.IsValid() {
+			break
+		}
+	}
+
+	for _, group := range file.Comments {
+		header := group.End() < file.Package
+		isDoc := group == file.Doc
+
+		if license := licenseComment(group, licenseMarkers); header && license != nil {
+			switch {
+			case isDoc:
+				add(license.Pos(), "license comment is attached to the package clause and becomes the package doc; separate them with a blank line")
+			case firstConstraint.IsValid() && license.Pos() > firstConstraint:
+				add(license.Pos(), "license comment follows a build constraint; move it to the top of the file")
+			}
+		}
+
+		for _, comment := range group.List {
+			if !isBuildConstraint(comment.Text) {
+				continue
+			}
+			switch {
+			case !header:
+				add(comment.Pos(), "build constraint after the package clause is ignored; move it above the package clause")
+			case isDoc:
+				add(comment.Pos(), "build constraint is not followed by a blank line, so it is part of the package doc and ignored")
+			}
+		}
+
+		if header && !isDoc && isPackageDocText(group.Text(), file.Name.Name) {
+			add(group.Pos(), "package doc comment is separated from the package clause; remove the blank line or build constraint in between")
+		}
+	}
+	return issues, nil
+}
+
+// isBuildConstraint reports whether a comment is a //go:build or // +build line
+func isBuildConstraint(text string) bool {
+	return constraint.IsGoBuild(text) || constraint.IsPlusBuild(text)
+}
+
+// licenseComment returns the first comment of group that contains one of
+// the markers, regardless of case, or nil
+func licenseComment(group *ast.CommentGroup, markers []string) *ast.Comment {
+	for _, comment := range group.List {
+		text := strings.ToLower(comment.Text)
+		for _, marker := range markers {
+			if strings.Contains(text, strings.ToLower(marker)) {
+				return comment
+			}
+		}
+	}
+	return nil
+}
+
+// isPackageDocText reports whether comment text starts "Package <name>", the
+// way a package doc comment does
+func isPackageDocText(text, name string) bool {
+	fields := strings.Fields(text)
+	return len(fields) >= 2 && fields[0] == "Package" && strings.TrimRight(fields[1], ".,:;") == name
+}
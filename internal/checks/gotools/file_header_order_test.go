@@ -0,0 +1,122 @@
+package gotools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+func TestFindHeaderOrderIssues(t *testing.T) {
+	runFinderCases(t, []finderCase{
+		{
+			name:    "license, build constraint, and package doc in order",
+			content: "// Copyright 2025 Example Authors\n\n//go:build linux\n\n// Package p does things.\npackage p\n",
+		},
+		{
+			name:    "block license comment and legacy constraint",
+			content: "/*\nSPDX-License-Identifier: MIT\n*/\n\n//go:build linux\n// +build linux\n\npackage p\n",
+		},
+		{
+			name:    "no header at all",
+			content: "package p\n\nfunc f() {}\n",
+		},
+		{
+			name:    "build constraint after the package clause",
+			content: "package p\n\n//go:build linux\n\nfunc f() {}\n",
+			want:    []string{"a.go:3: build constraint after the package clause is ignored; move it above the package clause"},
+		},
+		{
+			name:    "build constraint without a blank line",
+			content: "//go:build linux\n// Package p does things.\npackage p\n",
+			want:    []string{"a.go:1: build constraint is not followed by a blank line, so it is part of the package doc and ignored"},
+		},
+		{
+			name:    "license after the build constraint",
+			content: "//go:build linux\n\n// Copyright 2025 Example Authors\n\npackage p\n",
+			want:    []string{"a.go:3: license comment follows a build constraint; move it to the top of the file"},
+		},
+		{
+			name:    "license after the build constraint in one group",
+			content: "//go:build linux\n// copyright 2025 Example Authors\n\npackage p\n",
+			want:    []string{"a.go:2: license comment follows a build constraint; move it to the top of the file"},
+		},
+		{
+			name:    "license attached to the package clause",
+			content: "// Copyright 2025 Example Authors\npackage p\n",
+			want:    []string{"a.go:1: license comment is attached to the package clause and becomes the package doc; separate them with a blank line"},
+		},
+		{
+			name:    "package doc before the build constraint",
+			content: "// Package p does things.\n\n//go:build linux\n\npackage p\n",
+			want:    []string{"a.go:1: package doc comment is separated from the package clause; remove the blank line or build constraint in between"},
+		},
+		{
+			name:    "comment after the package clause naming the package",
+			content: "package p\n\n// Package p is documented elsewhere.\nfunc f() {}\n",
+		},
+		{
+			name:    "generated file",
+			content: "// Code generated by stringer. DO NOT EDIT.\n\npackage p\n\n//go:build linux\n",
+		},
+	}, func(file string, content []byte) ([]headerOrderIssue, error) {
+		return findHeaderOrderIssues(file, content, defaultFileHeaderLicenseMarkers)
+	})
+}
+
+func TestFindHeaderOrderIssues_CustomMarkers(t *testing.T) {
+	content := []byte("//go:build linux\n\n// Licensed under the Apache License.\n\npackage p\n")
+
+	issues, err := findHeaderOrderIssues("a.go", content, defaultFileHeaderLicenseMarkers)
+	require.NoError(t, err)
+	assert.Empty(t, issues, "not a license comment by default")
+
+	issues, err = findHeaderOrderIssues("a.go", content, []string{"licensed under"})
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, 3, issues[0].line)
+}
+
+func TestFileHeaderOrderCheck_Run(t *testing.T) {
+	setupMultiModuleRepo(t, map[string]string{".": "module example.com/repo\n\ngo 1.22\n"})
+	writeModuleFiles(t, map[string]string{
+		"good.go":  "// Copyright 2025 Example Authors\n\n//go:build linux\n\npackage repo\n",
+		"bad.go":   "package repo\n\n//go:build linux\n",
+		"parse.go": "package repo\n\nfunc {\n",
+	})
+
+	check := NewFileHeaderOrderCheckWithSharedContext(shared.NewContext())
+	require.NoError(t, check.Run(context.Background(), []string{"good.go", "parse.go"}))
+
+	err := check.Run(context.Background(), []string{"good.go", "bad.go"})
+	require.ErrorIs(t, err, prerrors.ErrFileHeaderOrder)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.False(t, checkErr.WarnOnly)
+	assert.Equal(t, []string{"bad.go"}, checkErr.Files)
+	assert.Equal(t, "bad.go:3: build constraint after the package clause is ignored; move it above the package clause", checkErr.Output)
+}
+
+func TestFileHeaderOrderCheck_FilterFilesAndMetadata(t *testing.T) {
+	check := NewFileHeaderOrderCheck()
+	assert.Equal(t, []string{"a.go", "a_test.go"}, check.FilterFiles([]string{"a.go", "a_test.go", "go.mod"}))
+
+	assert.Equal(t, "file-header-order", check.Name())
+	assert.NotEmpty(t, check.Description())
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "file-header-order", metadata.Name)
+
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.FileHeaderOrder = 5
+	cfg.CheckBehaviors.FileHeaderLicenseMarkers = []string{"Licensed under"}
+	configured := NewFileHeaderOrderCheckWithFullConfig(nil, cfg)
+	assert.Equal(t, 5, int(configured.timeout.Seconds()))
+	assert.Equal(t, []string{"Licensed under"}, configured.licenseMarkers)
+}
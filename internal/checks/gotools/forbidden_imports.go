@@ -0,0 +1,203 @@
+package gotools
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// defaultForbiddenImports is the deny list used when none is configured
+var defaultForbiddenImports = []string{"io/ioutil"}
+
+// ForbiddenImportsCheck fails when a Go file imports a package on the deny
+// list. Entries match an import path exactly, as a glob (path.Match, where *
+// stops at a slash), or with a trailing "/..." as the path and everything
+// below it. Generated files and allowlisted files are skipped.
+type ForbiddenImportsCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+	deny      []string
+	allow     []string // file patterns; without a slash they match file names
+}
+
+// forbiddenImport is one flagged import
+type forbiddenImport struct {
+	file       string
+	line       int
+	importPath string
+	rule       string // the deny list entry it matched
+}
+
+// NewForbiddenImportsCheck creates a new forbidden import check
+func NewForbiddenImportsCheck() *ForbiddenImportsCheck {
+	return &ForbiddenImportsCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   30 * time.Second,
+		deny:      defaultForbiddenImports,
+	}
+}
+
+// NewForbiddenImportsCheckWithSharedContext creates a new forbidden import check with shared context
+func NewForbiddenImportsCheckWithSharedContext(sharedCtx *shared.Context) *ForbiddenImportsCheck {
+	return &ForbiddenImportsCheck{
+		sharedCtx: sharedCtx,
+		timeout:   30 * time.Second,
+		deny:      defaultForbiddenImports,
+	}
+}
+
+// NewForbiddenImportsCheckWithFullConfig creates a new forbidden import check with full configuration
+func NewForbiddenImportsCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *ForbiddenImportsCheck {
+	check := NewForbiddenImportsCheckWithSharedContext(sharedCtx)
+	if cfg != nil {
+		if cfg.CheckTimeouts.ForbiddenImports > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.ForbiddenImports) * time.Second
+		}
+		if len(cfg.CheckBehaviors.ForbiddenImports) > 0 {
+			check.deny = cfg.CheckBehaviors.ForbiddenImports
+		}
+		check.allow = cfg.CheckBehaviors.ForbiddenImportsAllow
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *ForbiddenImportsCheck) Name() string {
+	return "forbidden-imports"
+}
+
+// Description returns a brief description of the check
+func (c *ForbiddenImportsCheck) Description() string {
+	return "Block imports of packages on the deny list"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *ForbiddenImportsCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "forbidden-imports",
+		Description:       "Fail when Go files import packages on the configured deny list",
+		FilePatterns:      []string{"*.go"},
+		EstimatedDuration: 1 * time.Second,
+		Dependencies:      []string{}, // Pure Go, no external tools
+		DefaultTimeout:    c.timeout,
+		Category:          "dependencies",
+		RequiresFiles:     true,
+		Cacheable:         true,
+	}
+}
+
+// Run executes the forbidden import check
+func (c *ForbiddenImportsCheck) Run(ctx context.Context, files []string) error {
+	if len(c.deny) == 0 {
+		return nil
+	}
+
+	return astCheck[forbiddenImport]{
+		checkReport: checkReport{
+			err:        prerrors.ErrForbiddenImport,
+			message:    "%d forbidden import(s) found",
+			suggestion: "Replace the import, or list the file in GO_PRE_COMMIT_FORBIDDEN_IMPORTS_ALLOW if it must keep it",
+		},
+		sharedCtx: c.sharedCtx,
+		timeout:   c.timeout,
+		find: func(file string, content []byte) ([]forbiddenImport, error) {
+			return findForbiddenImports(file, content, c.deny)
+		},
+	}.run(ctx, files)
+}
+
+// FilterFiles filters to Go files that are not allowlisted
+func (c *ForbiddenImportsCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if strings.HasSuffix(file, ".go") && !matchesFilePattern(file, c.allow) {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// String formats the import with the deny list entry it matched
+func (f forbiddenImport) String() string {
+	if f.rule == f.importPath {
+		return fmt.Sprintf("%s:%d: import %q is forbidden", f.file, f.line, f.importPath)
+	}
+	return fmt.Sprintf("%s:%d: import %q is forbidden by %q", f.file, f.line, f.importPath, f.rule)
+}
+
+// findForbiddenImports parses the imports of a Go file and returns those that
+// match an entry of deny. Generated files are skipped.
+func findForbiddenImports(filename string, content []byte, deny []string) ([]forbiddenImport, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, content, parser.ImportsOnly|parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	if ast.IsGenerated(file) {
+		return nil, nil
+	}
+
+	var found []forbiddenImport
+	for _, spec := range file.Imports {
+		importPath, unquoteErr := strconv.Unquote(spec.Path.Value)
+		if unquoteErr != nil {
+			continue
+		}
+		if rule, ok := matchImportRule(importPath, deny); ok {
+			found = append(found, forbiddenImport{
+				file:       filename,
+				line:       fset.Position(spec.Pos()).Line,
+				importPath: importPath,
+				rule:       rule,
+			})
+		}
+	}
+	return found, nil
+}
+
+// matchImportRule returns the first deny list entry importPath matches
+func matchImportRule(importPath string, deny []string) (string, bool) {
+	for _, rule := range deny {
+		if prefix, ok := strings.CutSuffix(rule, "/..."); ok {
+			if importPath == prefix || strings.HasPrefix(importPath, prefix+"/") {
+				return rule, true
+			}
+			continue
+		}
+		if importPath == rule {
+			return rule, true
+		}
+		if matched, err := path.Match(rule, importPath); err == nil && matched {
+			return rule, true
+		}
+	}
+	return "", false
+}
+
+// matchesFilePattern reports whether file matches one of patterns. Patterns
+// without a slash match the file name in any directory; the rest match the
+// whole slash-separated path.
+func matchesFilePattern(file string, patterns []string) bool {
+	slashPath := filepath.ToSlash(filepath.Clean(file))
+	for _, pattern := range patterns {
+		name := slashPath
+		if !strings.Contains(pattern, "/") {
+			name = path.Base(slashPath)
+		}
+		if matched, err := path.Match(pattern, name); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,120 @@
+package gotools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+func TestFindForbiddenImports(t *testing.T) {
+	deny := []string{"io/ioutil", "github.com/example/legacy/...", "github.com/*/internal/*"}
+
+	runFinderCases(t, []finderCase{
+		{
+			name: "permitted imports",
+			content: `package p
+
+import (
+	"io"
+	"os"
+
+	"github.com/example/legacyfork"
+	"github.com/example/internal/a/b"
+)
+`,
+		},
+		{
+			name: "exact match",
+			content: `package p
+
+import "io/ioutil"
+`,
+			want: []string{`a.go:3: import "io/ioutil" is forbidden`},
+		},
+		{
+			name: "prefix and glob matches",
+			content: `package p
+
+import (
+	"fmt"
+	old "github.com/example/legacy"
+	_ "github.com/example/legacy/store/sql"
+	"github.com/acme/internal/auth"
+)
+`,
+			want: []string{
+				`a.go:5: import "github.com/example/legacy" is forbidden by "github.com/example/legacy/..."`,
+				`a.go:6: import "github.com/example/legacy/store/sql" is forbidden by "github.com/example/legacy/..."`,
+				`a.go:7: import "github.com/acme/internal/auth" is forbidden by "github.com/*/internal/*"`,
+			},
+		},
+		{
+			name: "generated file",
+			content: `// Code generated by go-bindata. DO NOT EDIT.
+
+package p
+
+import "io/ioutil"
+`,
+		},
+	}, func(file string, content []byte) ([]forbiddenImport, error) {
+		return findForbiddenImports(file, content, deny)
+	})
+}
+
+func TestFindForbiddenImports_ParseError(t *testing.T) {
+	_, err := findForbiddenImports("a.go", []byte("package p\nimport {"), []string{"io/ioutil"})
+	require.Error(t, err)
+}
+
+func TestForbiddenImportsCheck_Run(t *testing.T) {
+	dir := t.TempDir()
+	bad := filepath.Join(dir, "bad.go")
+	good := filepath.Join(dir, "good.go")
+	require.NoError(t, os.WriteFile(bad, []byte("package p\n\nimport \"io/ioutil\"\n\nvar _ = ioutil.Discard\n"), 0o600))
+	require.NoError(t, os.WriteFile(good, []byte("package p\n\nimport \"io\"\n\nvar _ = io.Discard\n"), 0o600))
+
+	check := NewForbiddenImportsCheck()
+	require.NoError(t, check.Run(context.Background(), nil))
+	require.NoError(t, check.Run(context.Background(), []string{good}))
+
+	err := check.Run(context.Background(), []string{bad, good})
+	require.ErrorIs(t, err, prerrors.ErrForbiddenImport)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Equal(t, []string{bad}, checkErr.Files)
+	assert.Contains(t, checkErr.Output, `bad.go:3: import "io/ioutil" is forbidden`)
+	assert.Contains(t, checkErr.Suggestion, "GO_PRE_COMMIT_FORBIDDEN_IMPORTS_ALLOW")
+}
+
+func TestForbiddenImportsCheck_Allowlist(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.ForbiddenImports = 5
+	cfg.CheckBehaviors.ForbiddenImports = []string{"unsafe"}
+	cfg.CheckBehaviors.ForbiddenImportsAllow = []string{"internal/compat/*.go", "zz_legacy.go"}
+
+	check := NewForbiddenImportsCheckWithFullConfig(nil, cfg)
+	assert.Equal(t, []string{"unsafe"}, check.deny)
+	assert.Equal(t, 5, int(check.timeout.Seconds()))
+
+	files := []string{"main.go", "internal/compat/shim.go", "pkg/zz_legacy.go", "internal/compat/sub/x.go", "README.md"}
+	assert.Equal(t, []string{"main.go", "internal/compat/sub/x.go"}, check.FilterFiles(files))
+}
+
+func TestForbiddenImportsCheck_Metadata(t *testing.T) {
+	check := NewForbiddenImportsCheck()
+	assert.Equal(t, "forbidden-imports", check.Name())
+	assert.NotEmpty(t, check.Description())
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "forbidden-imports", metadata.Name)
+	assert.Equal(t, defaultForbiddenImports, check.deny)
+}
@@ -9,14 +9,15 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/mrz1836/go-pre-commit/internal/config"
 	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/git"
 	"github.com/mrz1836/go-pre-commit/internal/golangci"
 	"github.com/mrz1836/go-pre-commit/internal/shared"
-	"github.com/mrz1836/go-pre-commit/internal/tools"
 )
 
 // FumptCheck runs gofumpt directly or via build tools
@@ -96,6 +97,8 @@ func (c *FumptCheck) Metadata() any {
 		DefaultTimeout:    c.timeout,
 		Category:          "formatting",
 		RequiresFiles:     true,
+		Cacheable:         true,
+		CacheScope:        "package",
 	}
 }
 
@@ -106,14 +109,8 @@ func (c *FumptCheck) Run(ctx context.Context, files []string) error {
 		return nil
 	}
 
-	// Get list of files before formatting for auto-stage detection
-	var modifiedFiles []string
-	if c.autoStage {
-		modifiedFiles = files // Track the files we're formatting
-	}
-
 	// Ensure gofumpt is installed
-	if err := tools.EnsureInstalled(ctx, "gofumpt"); err != nil {
+	if err := ensureTool(ctx, c.sharedCtx, "gofumpt"); err != nil {
 		return prerrors.NewToolExecutionError(
 			"gofumpt",
 			err.Error(),
@@ -122,10 +119,17 @@ func (c *FumptCheck) Run(ctx context.Context, files []string) error {
 	}
 
 	// Run gofumpt directly
-	err := c.runDirectFumpt(ctx, files)
+	reformatted, declined, err := c.runDirectFumpt(ctx, files)
+	if err != nil {
+		return err
+	}
 
-	// If formatting succeeded and auto-stage is enabled, stage the modified files
-	if err == nil && c.autoStage && len(modifiedFiles) > 0 {
+	// If formatting succeeded and auto-stage is enabled, stage the files
+	// the user did not decline to format
+	if c.autoStage {
+		modifiedFiles := slices.DeleteFunc(slices.Clone(files), func(file string) bool {
+			return slices.Contains(declined, file)
+		})
 		if stageErr := c.stageFiles(ctx, modifiedFiles); stageErr != nil {
 			// Log warning but don't fail the check
 			// The formatting was successful, staging is a convenience feature
@@ -133,7 +137,40 @@ func (c *FumptCheck) Run(ctx context.Context, files []string) error {
 		}
 	}
 
-	return err
+	// In interactive mode the user may decline a fix, leaving the file unformatted
+	if len(declined) > 0 {
+		issues := make([]string, len(declined))
+		for i, file := range declined {
+			issues[i] = fmt.Sprintf("%s: %v", file, prerrors.ErrFixDeclined)
+		}
+		return &prerrors.CheckError{
+			Err:        prerrors.ErrFmtIssues,
+			Message:    fmt.Sprintf("%d file(s) need formatting with gofumpt", len(declined)),
+			Suggestion: "Accept the fix or run 'gofumpt -w' on the files",
+			Command:    "gofumpt -l",
+			Output:     strings.Join(issues, "\n"),
+			Files:      declined,
+		}
+	}
+	if c.autoStage {
+		return nil
+	}
+
+	// Without auto-stage the fixes only exist in the working tree, so report
+	// exactly which files were rewritten and need to be staged
+	if len(reformatted) > 0 {
+		return &prerrors.CheckError{
+			Err:        prerrors.ErrFmtIssues,
+			Message:    fmt.Sprintf("%d file(s) needed formatting and were rewritten by gofumpt", len(reformatted)),
+			Suggestion: "Review and stage the formatted files, or set GO_PRE_COMMIT_FUMPT_AUTO_STAGE=true",
+			Command:    "gofumpt -l -w",
+			Output:     strings.Join(reformatted, "\n"),
+			Files:      reformatted,
+			Fixed:      true,
+		}
+	}
+
+	return nil
 }
 
 // FilterFiles filters to only Go files
@@ -147,25 +184,55 @@ func (c *FumptCheck) FilterFiles(files []string) []string {
 	return filtered
 }
 
-// runDirectFumpt runs gofumpt directly on files
-func (c *FumptCheck) runDirectFumpt(ctx context.Context, files []string) error {
+// runDirectFumpt runs gofumpt directly on files and returns the repository
+// relative paths of the files it rewrote (as listed by -l). When the user
+// confirms fixes, the files needing formatting are listed first and only
+// those confirmed are rewritten; the rest are returned as declined.
+func (c *FumptCheck) runDirectFumpt(ctx context.Context, files []string) (reformatted, declined []string, err error) {
 	// Tool installation is already handled in Run(), so we can proceed directly
 
 	repoRoot, err := c.sharedCtx.GetRepoRoot(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to find repository root: %w", err)
+		return nil, nil, fmt.Errorf("failed to find repository root: %w", err)
+	}
+
+	// Add timeout for gofumpt command
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if !shared.ConfirmsFixes(ctx) {
+		reformatted, err = c.gofumpt(ctx, repoRoot, files, true)
+		return reformatted, nil, err
 	}
 
+	listed, err := c.gofumpt(ctx, repoRoot, files, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, file := range listed {
+		if shared.ConfirmFix(ctx, c.Name(), file) {
+			reformatted = append(reformatted, file)
+		} else {
+			declined = append(declined, file)
+		}
+	}
+	if len(reformatted) > 0 {
+		if _, err = c.gofumpt(ctx, repoRoot, reformatted, true); err != nil {
+			return nil, nil, err
+		}
+	}
+	return reformatted, declined, nil
+}
+
+// gofumpt runs gofumpt -l on the repository relative files, rewriting them
+// when write is set, and returns the files it listed as needing formatting
+func (c *FumptCheck) gofumpt(ctx context.Context, repoRoot string, files []string, write bool) ([]string, error) {
 	// Build absolute paths
 	absFiles := make([]string, len(files))
 	for i, file := range files {
 		absFiles[i] = filepath.Join(repoRoot, file)
 	}
 
-	// Add timeout for gofumpt command
-	ctx, cancel := context.WithTimeout(ctx, c.timeout)
-	defer cancel()
-
 	// Build arguments with module path if available
 	args := []string{}
 
@@ -178,22 +245,36 @@ func (c *FumptCheck) runDirectFumpt(ctx context.Context, files []string) error {
 	}
 	// If no module path found, gofumpt will auto-detect from go.mod in the current directory
 
-	args = append(args, "-w")
+	args = append(args, "-l")
+	if write {
+		args = append(args, "-w")
+	}
 	args = append(args, absFiles...)
 
-	cmd := exec.CommandContext(ctx, "gofumpt", args...) //nolint:gosec // Command arguments are validated
-	cmd.Dir = repoRoot
-
 	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
+	err := runAfterInstall(ctx, c.sharedCtx, "gofumpt", func() error {
+		stdout.Reset()
+		stderr.Reset()
 
-	if err := cmd.Run(); err != nil {
+		cmd := exec.CommandContext(ctx, "gofumpt", args...) //nolint:gosec // Command arguments are validated
+		cmd.Dir = repoRoot
+		shared.LogCommand(ctx, cmd)
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		return cmd.Run()
+	})
+	if errors.Is(err, exec.ErrNotFound) {
+		return nil, prerrors.NewToolNotFoundError(
+			"gofumpt",
+			"Install gofumpt manually: go install mvdan.cc/gofumpt@latest",
+		)
+	}
+	if err != nil {
 		output := stdout.String() + stderr.String()
 
 		// Check if it's a context timeout
 		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
-			return prerrors.NewToolExecutionError(
+			return nil, prerrors.NewToolExecutionError(
 				"gofumpt",
 				output,
 				fmt.Sprintf("Fumpt timed out after %v. Consider running on fewer files or increasing GO_PRE_COMMIT_FUMPT_TIMEOUT.", c.timeout),
@@ -201,7 +282,7 @@ func (c *FumptCheck) runDirectFumpt(ctx context.Context, files []string) error {
 		}
 
 		if strings.Contains(output, "permission denied") {
-			return prerrors.NewToolExecutionError(
+			return nil, prerrors.NewToolExecutionError(
 				"gofumpt",
 				output,
 				"Permission denied. Check file permissions and ensure you have write access to all Go files.",
@@ -209,7 +290,7 @@ func (c *FumptCheck) runDirectFumpt(ctx context.Context, files []string) error {
 		}
 
 		if strings.Contains(output, "syntax error") || strings.Contains(output, "invalid Go syntax") {
-			return prerrors.NewToolExecutionError(
+			return nil, prerrors.NewToolExecutionError(
 				"gofumpt",
 				output,
 				"Go syntax errors prevent formatting. Fix syntax errors in your Go files before running fumpt.",
@@ -217,14 +298,31 @@ func (c *FumptCheck) runDirectFumpt(ctx context.Context, files []string) error {
 		}
 
 		// Generic failure
-		return prerrors.NewToolExecutionError(
+		return nil, prerrors.NewToolExecutionError(
 			"gofumpt",
 			output,
 			"Run 'gofumpt -w <files>' manually to see detailed error output.",
 		)
 	}
 
-	return nil
+	return parseFumptList(stdout.String(), repoRoot), nil
+}
+
+// parseFumptList converts the file list printed by "gofumpt -l" into paths
+// relative to the repository root
+func parseFumptList(output, repoRoot string) []string {
+	var listed []string
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if rel, err := filepath.Rel(repoRoot, line); err == nil && !strings.HasPrefix(rel, "..") {
+			line = rel
+		}
+		listed = append(listed, line)
+	}
+	return listed
 }
 
 // stageFiles adds modified files to git staging area
@@ -237,21 +335,11 @@ func (c *FumptCheck) stageFiles(ctx context.Context, files []string) error {
 		return nil
 	}
 
-	// Build git add command with all modified files
-	args := append([]string{"add"}, files...)
-	cmd := exec.CommandContext(ctx, "git", args...) //nolint:gosec // Command arguments are controlled
-
-	// Get repository root to run git command from correct location
-	if repoRoot, err := c.sharedCtx.GetRepoRoot(ctx); err == nil {
-		cmd.Dir = repoRoot
-	}
-
-	var stderr bytes.Buffer
-	cmd.Stderr = &stderr
-
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("git add failed: %w (stderr: %s)", err, stderr.String())
+	// Stage from the repository root if possible, else the current directory
+	repoRoot, err := c.sharedCtx.GetRepoRoot(ctx)
+	if err != nil {
+		repoRoot = ""
 	}
 
-	return nil
+	return git.NewRepositoryWithConfig(repoRoot, c.config).StageFiles(ctx, files)
 }
@@ -0,0 +1,91 @@
+package gotools
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+func TestParseFumptList(t *testing.T) {
+	root := filepath.Join(string(filepath.Separator), "repo")
+	output := filepath.Join(root, "a.go") + "\n\n" + filepath.Join(root, "pkg", "b.go") + "\n/elsewhere/c.go\n"
+
+	assert.Equal(t, []string{"a.go", filepath.Join("pkg", "b.go"), "/elsewhere/c.go"}, parseFumptList(output, root))
+	assert.Empty(t, parseFumptList("", root))
+}
+
+func TestFumptRun_ReportsFilesNeedingFormatting(t *testing.T) {
+	if _, err := exec.LookPath("gofumpt"); err != nil {
+		t.Skip("gofumpt not installed")
+	}
+
+	dir := t.TempDir()
+	initGitRepoAt(t, dir)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte(testGoModContent), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "pkg"), 0o750))
+
+	messy := filepath.Join("pkg", "messy.go")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, messy), []byte("package pkg\n\nfunc  F()  {\n}\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "clean.go"), []byte("package main\n\nfunc main() {}\n"), 0o600))
+	t.Chdir(dir)
+
+	check := NewFumptCheckWithSharedContext(shared.NewContext())
+	err := check.Run(context.Background(), []string{"clean.go", messy})
+	require.ErrorIs(t, err, prerrors.ErrFmtIssues)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Equal(t, []string{messy}, checkErr.Files, "only the file gofumpt rewrote is reported")
+	assert.Equal(t, messy, checkErr.Output)
+	assert.Contains(t, checkErr.Message, "1 file(s)")
+}
+
+func TestFumptRun_InteractiveConfirmation(t *testing.T) {
+	if _, err := exec.LookPath("gofumpt"); err != nil {
+		t.Skip("gofumpt not installed")
+	}
+
+	dir := t.TempDir()
+	initGitRepoAt(t, dir)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte(testGoModContent), 0o600))
+	messy := "package main\n\nimport (\n\t\"fmt\"\n\n\t\"os\"\n)\n\nfunc  F()  { fmt.Println(os.Args) }\n"
+	for _, name := range []string{"accepted.go", "declined.go"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(messy), 0o600))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "clean.go"), []byte("package main\n\nfunc main() {}\n"), 0o600))
+	t.Chdir(dir)
+
+	var asked []string
+	ctx := shared.WithFixConfirm(context.Background(), func(checkName, file string) bool {
+		assert.Equal(t, "fumpt", checkName)
+		asked = append(asked, file)
+		return file == "accepted.go"
+	})
+
+	check := NewFumptCheckWithSharedContext(shared.NewContext())
+	err := check.Run(ctx, []string{"accepted.go", "clean.go", "declined.go"})
+	require.ErrorIs(t, err, prerrors.ErrFmtIssues)
+	assert.Equal(t, []string{"accepted.go", "declined.go"}, asked, "only files needing formatting are offered")
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Equal(t, []string{"declined.go"}, checkErr.Files)
+	assert.Contains(t, checkErr.Output, "declined.go: auto-fix declined")
+	assert.False(t, checkErr.Fixed)
+
+	content, err := os.ReadFile(filepath.Join(dir, "accepted.go")) //nolint:gosec // test file
+	require.NoError(t, err)
+	assert.NotEqual(t, messy, string(content), "the accepted file is formatted")
+
+	content, err = os.ReadFile(filepath.Join(dir, "declined.go")) //nolint:gosec // test file
+	require.NoError(t, err)
+	assert.Equal(t, messy, string(content), "the declined file, imports included, is left untouched")
+}
@@ -169,7 +169,7 @@ func (s *FumptStageTestSuite) TestFumptCheck_StageFiles_NonexistentFile() {
 
 	err := check.stageFiles(context.Background(), []string{nonexistentFile})
 	s.Require().Error(err, "stageFiles should error when trying to stage nonexistent file")
-	s.Contains(err.Error(), "git add failed", "Error should mention git add failure")
+	s.Contains(err.Error(), "failed to stage files", "Error should mention git add failure")
 }
 
 // TestFumptCheck_StageFiles_ContextCancellation tests stageFiles with context cancellation
@@ -395,7 +395,7 @@ func (s *FumptStageTestSuite) TestFumptCheck_StageFiles_ErrorHandling() {
 			setupFunc: func() ([]string, context.Context) {
 				return []string{""}, context.Background()
 			},
-			expectedError: "git add failed",
+			expectedError: "failed to stage files",
 			description:   "Should handle empty file paths",
 		},
 	}
@@ -0,0 +1,226 @@
+package gotools
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// funcLengthDirective anywhere in a file exempts its functions from the limits
+const funcLengthDirective = "go-pre-commit:allow-long-funcs"
+
+// Default function length limits; 0 turns a limit off
+const (
+	defaultFuncMaxLines      = 80
+	defaultFuncMaxStatements = 50
+)
+
+// FunctionLengthCheck flags functions and methods longer than a line limit,
+// counted from the func keyword to the closing brace, or holding more
+// statements than a statement limit, counted through nested blocks. Test
+// and generated files are skipped, and a file can opt out with
+// //go-pre-commit:allow-long-funcs. Findings are warn-only unless the
+// severity is set to error.
+type FunctionLengthCheck struct {
+	sharedCtx     *shared.Context
+	timeout       time.Duration
+	maxLines      int
+	maxStatements int
+	blocking      bool
+}
+
+// longFunc is one function over a limit
+type longFunc struct {
+	file     string
+	line     int
+	function string // the function name, qualified by its receiver for methods
+	count    int
+	limit    int
+	unit     string // "lines" or "statements"
+}
+
+// NewFunctionLengthCheck creates a new function length check
+func NewFunctionLengthCheck() *FunctionLengthCheck {
+	return &FunctionLengthCheck{
+		sharedCtx:     shared.NewContext(),
+		timeout:       30 * time.Second,
+		maxLines:      defaultFuncMaxLines,
+		maxStatements: defaultFuncMaxStatements,
+	}
+}
+
+// NewFunctionLengthCheckWithSharedContext creates a new function length check with shared context
+func NewFunctionLengthCheckWithSharedContext(sharedCtx *shared.Context) *FunctionLengthCheck {
+	return &FunctionLengthCheck{
+		sharedCtx:     sharedCtx,
+		timeout:       30 * time.Second,
+		maxLines:      defaultFuncMaxLines,
+		maxStatements: defaultFuncMaxStatements,
+	}
+}
+
+// NewFunctionLengthCheckWithFullConfig creates a new function length check with full configuration
+func NewFunctionLengthCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *FunctionLengthCheck {
+	check := NewFunctionLengthCheckWithSharedContext(sharedCtx)
+	if cfg != nil {
+		if cfg.CheckTimeouts.FuncLength > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.FuncLength) * time.Second
+		}
+		check.maxLines = cfg.CheckBehaviors.FuncLengthMaxLines
+		check.maxStatements = cfg.CheckBehaviors.FuncLengthMaxStatements
+		check.blocking = cfg.CheckBehaviors.FuncLengthSeverity == config.LintSeverityError
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *FunctionLengthCheck) Name() string {
+	return "func-length"
+}
+
+// Description returns a brief description of the check
+func (c *FunctionLengthCheck) Description() string {
+	return "Flag Go functions over the line or statement limit"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *FunctionLengthCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "func-length",
+		Description:       "Warn when a function has more lines or statements than the configured limits",
+		FilePatterns:      []string{"*.go"},
+		EstimatedDuration: 1 * time.Second,
+		Dependencies:      []string{}, // Pure Go, no external tools
+		DefaultTimeout:    c.timeout,
+		Category:          "style",
+		RequiresFiles:     true,
+		Cacheable:         true,
+	}
+}
+
+// Run executes the function length check
+func (c *FunctionLengthCheck) Run(ctx context.Context, files []string) error {
+	if c.maxLines <= 0 && c.maxStatements <= 0 {
+		return nil
+	}
+
+	return astCheck[longFunc]{
+		checkReport: checkReport{
+			err:     prerrors.ErrLongFunctions,
+			message: "%d function(s) over the length limit",
+			suggestion: "Split the function into smaller ones, or add //" + funcLengthDirective +
+				" to the file to exempt it",
+			warnOnly: !c.blocking,
+		},
+		sharedCtx: c.sharedCtx,
+		timeout:   c.timeout,
+		find: func(file string, content []byte) ([]longFunc, error) {
+			return findLongFuncs(file, content, c.maxLines, c.maxStatements)
+		},
+	}.run(ctx, files)
+}
+
+// FilterFiles filters to Go files, leaving out tests
+func (c *FunctionLengthCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if strings.HasSuffix(file, ".go") && !strings.HasSuffix(file, "_test.go") {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// String formats the finding with the length and the limit it exceeds
+func (f longFunc) String() string {
+	if f.unit == "lines" {
+		return fmt.Sprintf("%s:%d: func %s is %d lines (limit %d)", f.file, f.line, f.function, f.count, f.limit)
+	}
+	return fmt.Sprintf("%s:%d: func %s has %d statements (limit %d)", f.file, f.line, f.function, f.count, f.limit)
+}
+
+// findLongFuncs parses a Go file and returns its functions over maxLines
+// lines or maxStatements statements; a limit of 0 is off. Generated files,
+// files carrying funcLengthDirective, and bodyless declarations are skipped.
+// A function over both limits is reported for its lines only.
+func findLongFuncs(filename string, content []byte, maxLines, maxStatements int) ([]longFunc, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, content, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	if ast.IsGenerated(file) || fileHasDirective(file, funcLengthDirective) {
+		return nil, nil
+	}
+
+	var found []longFunc
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+
+		start := fset.Position(fn.Pos()).Line
+		finding := longFunc{file: filename, line: start, function: funcDisplayName(fn)}
+		lines := fset.Position(fn.Body.Rbrace).Line - start + 1
+		switch statements := countStatements(fn.Body); {
+		case maxLines > 0 && lines > maxLines:
+			finding.count, finding.limit, finding.unit = lines, maxLines, "lines"
+		case maxStatements > 0 && statements > maxStatements:
+			finding.count, finding.limit, finding.unit = statements, maxStatements, "statements"
+		default:
+			continue
+		}
+		found = append(found, finding)
+	}
+	return found, nil
+}
+
+// countStatements counts the statements listed in body and in every block
+// and case nested in it, including function literals. Blocks and case
+// clauses themselves, and the init and post statements of a for or if, are
+// not counted.
+func countStatements(body *ast.BlockStmt) int {
+	count := 0
+	add := func(list []ast.Stmt) {
+		for _, stmt := range list {
+			switch stmt.(type) {
+			case *ast.BlockStmt, *ast.CaseClause, *ast.CommClause:
+			default:
+				count++
+			}
+		}
+	}
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.BlockStmt:
+			add(n.List)
+		case *ast.CaseClause:
+			add(n.Body)
+		case *ast.CommClause:
+			add(n.Body)
+		}
+		return true
+	})
+	return count
+}
+
+// fileHasDirective reports whether any comment in the file carries directive
+func fileHasDirective(file *ast.File, directive string) bool {
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			if strings.Contains(comment.Text, directive) {
+				return true
+			}
+		}
+	}
+	return false
+}
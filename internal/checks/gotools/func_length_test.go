@@ -0,0 +1,124 @@
+package gotools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+// funcWithStatements returns a Go function named name whose body holds n
+// assignment statements, one per line
+func funcWithStatements(name string, n int) string {
+	var b strings.Builder
+	b.WriteString("func " + name + "() {\n\tx := 0\n")
+	for i := 1; i < n; i++ {
+		b.WriteString("\tx++\n")
+	}
+	b.WriteString("\t_ = x\n}\n")
+	return b.String()
+}
+
+func TestFindLongFuncs(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		maxLines      int
+		maxStatements int
+		want          []string
+	}{
+		{
+			name:     "short function",
+			content:  "package p\n" + funcWithStatements("Short", 3),
+			maxLines: 10,
+		},
+		{
+			name:     "long function",
+			content:  "package p\n" + funcWithStatements("Short", 3) + funcWithStatements("Long", 12),
+			maxLines: 10,
+			want:     []string{"a.go:8: func Long is 15 lines (limit 10)"},
+		},
+		{
+			name:          "too many statements in nested blocks",
+			content:       "package p\ntype S struct{}\nfunc (s *S) Loop() {\n\tfor i := 0; i < 3; i++ { if i > 1 { println(i); println(i) } }\n\tswitch { case true: println(1) }\n}\n",
+			maxStatements: 4,
+			want:          []string{"a.go:3: func (*S).Loop has 6 statements (limit 4)"},
+		},
+		{
+			name:     "limits of 0 are off",
+			content:  "package p\n" + funcWithStatements("Long", 100),
+			maxLines: 0,
+		},
+		{
+			name:     "suppressed for the file",
+			content:  "// Table of constants, kept in one function on purpose\n//go-pre-commit:allow-long-funcs\npackage p\n" + funcWithStatements("Long", 12),
+			maxLines: 10,
+		},
+		{
+			name:     "generated file",
+			content:  "// Code generated by stringer. DO NOT EDIT.\n\npackage p\n" + funcWithStatements("Long", 12),
+			maxLines: 10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			funcs, err := findLongFuncs("a.go", []byte(tt.content), tt.maxLines, tt.maxStatements)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, findingStrings(funcs))
+		})
+	}
+}
+
+func TestFunctionLengthCheck_Run(t *testing.T) {
+	dir := t.TempDir()
+	long := filepath.Join(dir, "long.go")
+	short := filepath.Join(dir, "short.go")
+	require.NoError(t, os.WriteFile(long, []byte("package p\n\n"+funcWithStatements("Build", 90)), 0o600))
+	require.NoError(t, os.WriteFile(short, []byte("package p\n\n"+funcWithStatements("Small", 5)), 0o600))
+
+	check := NewFunctionLengthCheck()
+	require.NoError(t, check.Run(context.Background(), nil))
+	require.NoError(t, check.Run(context.Background(), []string{short}))
+
+	err := check.Run(context.Background(), []string{long, short})
+	require.ErrorIs(t, err, prerrors.ErrLongFunctions)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.True(t, checkErr.WarnOnly, "warn-only by default")
+	assert.Equal(t, []string{long}, checkErr.Files)
+	assert.Contains(t, checkErr.Output, "long.go:3: func Build is 93 lines (limit 80)")
+	assert.Contains(t, checkErr.Suggestion, "//go-pre-commit:allow-long-funcs")
+
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.FuncLengthMaxStatements = 4
+	cfg.CheckBehaviors.FuncLengthSeverity = config.LintSeverityError
+	err = NewFunctionLengthCheckWithFullConfig(nil, cfg).Run(context.Background(), []string{short})
+	require.ErrorAs(t, err, &checkErr)
+	assert.False(t, checkErr.WarnOnly, "severity=error blocks")
+	assert.Contains(t, checkErr.Output, "func Small has 6 statements (limit 4)")
+}
+
+func TestFunctionLengthCheck_FilterFilesAndMetadata(t *testing.T) {
+	check := NewFunctionLengthCheck()
+	assert.Equal(t, []string{"a.go"}, check.FilterFiles([]string{"a.go", "a_test.go", "b.py"}))
+
+	assert.Equal(t, "func-length", check.Name())
+	assert.NotEmpty(t, check.Description())
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "func-length", metadata.Name)
+
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.FuncLength = 5
+	assert.Equal(t, 5, int(NewFunctionLengthCheckWithFullConfig(nil, cfg).timeout.Seconds()))
+}
@@ -14,7 +14,6 @@ import (
 	"github.com/mrz1836/go-pre-commit/internal/config"
 	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
 	"github.com/mrz1836/go-pre-commit/internal/shared"
-	"github.com/mrz1836/go-pre-commit/internal/tools"
 )
 
 // GitleaksCheck runs gitleaks to scan for secrets and credentials
@@ -98,7 +97,7 @@ func (c *GitleaksCheck) Run(ctx context.Context, files []string) error {
 	}
 
 	// Ensure gitleaks is installed (auto-install if needed)
-	if err := tools.EnsureInstalled(ctx, "gitleaks"); err != nil {
+	if err := ensureTool(ctx, c.sharedCtx, "gitleaks"); err != nil {
 		return prerrors.NewToolExecutionError(
 			"gitleaks",
 			err.Error(),
@@ -163,14 +162,25 @@ func (c *GitleaksCheck) runGitleaks(ctx context.Context, files []string) error {
 		args = append(args, "--config", configPath)
 	}
 
-	cmd := exec.CommandContext(ctx, "gitleaks", args...) //nolint:gosec // Command arguments are validated
-	cmd.Dir = repoRoot
-
 	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
+	err = runAfterInstall(ctx, c.sharedCtx, "gitleaks", func() error {
+		stdout.Reset()
+		stderr.Reset()
 
-	if err := cmd.Run(); err != nil {
+		cmd := exec.CommandContext(ctx, "gitleaks", args...) //nolint:gosec // Command arguments are validated
+		cmd.Dir = repoRoot
+		shared.LogCommand(ctx, cmd)
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		return cmd.Run()
+	})
+	if errors.Is(err, exec.ErrNotFound) {
+		return prerrors.NewToolNotFoundError(
+			"gitleaks",
+			"Install gitleaks manually from: https://github.com/gitleaks/gitleaks#installation",
+		)
+	}
+	if err != nil {
 		output := stdout.String() + stderr.String()
 
 		// Check if it's a context timeout
@@ -218,8 +228,21 @@ func (c *GitleaksCheck) runGitleaks(ctx context.Context, files []string) error {
 // findGitleaksConfig searches for .gitleaks.toml in standard locations
 // Priority: 1) root directory, 2) .github directory
 func (c *GitleaksCheck) findGitleaksConfig(repoRoot string) string {
+	return findGitleaksConfig(repoRoot, c.config != nil)
+}
+
+// FindGitleaksConfig returns the gitleaks configuration the gitleaks check
+// scans repoRoot with: GO_PRE_COMMIT_GITLEAKS_CONFIG, then .gitleaks.toml in
+// the root or .github directory. It returns "" when gitleaks uses its defaults.
+func FindGitleaksConfig(repoRoot string) string {
+	return findGitleaksConfig(repoRoot, true)
+}
+
+// findGitleaksConfig searches for a gitleaks configuration, consulting
+// GO_PRE_COMMIT_GITLEAKS_CONFIG first when useEnv is set
+func findGitleaksConfig(repoRoot string, useEnv bool) string {
 	// Check if user specified custom config path via environment
-	if c.config != nil {
+	if useEnv {
 		if customPath := os.Getenv("GO_PRE_COMMIT_GITLEAKS_CONFIG"); customPath != "" {
 			absPath := customPath
 			if !filepath.IsAbs(customPath) {
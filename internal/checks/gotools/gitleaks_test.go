@@ -581,3 +581,18 @@ func TestGitleaksVariousFileTypes(t *testing.T) {
 	// Should succeed with clean files (no secrets)
 	assert.NoError(t, err)
 }
+
+func TestFindGitleaksConfigExported(t *testing.T) {
+	tmpDir := t.TempDir()
+	assert.Empty(t, FindGitleaksConfig(tmpDir), "gitleaks defaults")
+
+	githubConfig := filepath.Join(tmpDir, ".github", ".gitleaks.toml")
+	require.NoError(t, os.MkdirAll(filepath.Dir(githubConfig), 0o750))
+	require.NoError(t, os.WriteFile(githubConfig, []byte("title = \"github\""), 0o600))
+	assert.Equal(t, githubConfig, FindGitleaksConfig(tmpDir))
+
+	customConfig := filepath.Join(tmpDir, "custom.toml")
+	require.NoError(t, os.WriteFile(customConfig, []byte("title = \"custom\""), 0o600))
+	t.Setenv("GO_PRE_COMMIT_GITLEAKS_CONFIG", "custom.toml")
+	assert.Equal(t, customConfig, FindGitleaksConfig(tmpDir), "relative to the repository root")
+}
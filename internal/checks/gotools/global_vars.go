@@ -0,0 +1,247 @@
+package gotools
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// globalVarsDirective suppresses a finding when it appears in the
+// declaration's doc comment or on its line. It matches golangci-lint's
+// gochecknoglobals, which reports the same problem; a bare //nolint works too.
+const globalVarsDirective = "nolint:gochecknoglobals"
+
+// defaultGlobalVarsAllow lists variable names the linker commonly sets at build time
+var defaultGlobalVarsAllow = []string{"version", "commit"}
+
+// GlobalVarCheck flags package-level var declarations, which any goroutine
+// can change, suggesting they be made local or const. Error sentinels built
+// with errors.New or fmt.Errorf, blank and //go:embed variables, and names on
+// the allowlist (exact or glob) are left alone. Test and generated files are
+// skipped. Findings are warn-only unless the severity is set to error.
+type GlobalVarCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+	allow     []string
+	blocking  bool
+}
+
+// globalVar is one flagged package-level variable
+type globalVar struct {
+	file string
+	line int
+	name string
+}
+
+// NewGlobalVarCheck creates a new global variable check
+func NewGlobalVarCheck() *GlobalVarCheck {
+	return &GlobalVarCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   30 * time.Second,
+		allow:     defaultGlobalVarsAllow,
+	}
+}
+
+// NewGlobalVarCheckWithSharedContext creates a new global variable check with shared context
+func NewGlobalVarCheckWithSharedContext(sharedCtx *shared.Context) *GlobalVarCheck {
+	return &GlobalVarCheck{
+		sharedCtx: sharedCtx,
+		timeout:   30 * time.Second,
+		allow:     defaultGlobalVarsAllow,
+	}
+}
+
+// NewGlobalVarCheckWithFullConfig creates a new global variable check with full configuration
+func NewGlobalVarCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *GlobalVarCheck {
+	check := NewGlobalVarCheckWithSharedContext(sharedCtx)
+	if cfg != nil {
+		if cfg.CheckTimeouts.GlobalVars > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.GlobalVars) * time.Second
+		}
+		if len(cfg.CheckBehaviors.GlobalVarsAllow) > 0 {
+			check.allow = cfg.CheckBehaviors.GlobalVarsAllow
+		}
+		check.blocking = cfg.CheckBehaviors.GlobalVarsSeverity == config.LintSeverityError
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *GlobalVarCheck) Name() string {
+	return "global-vars"
+}
+
+// Description returns a brief description of the check
+func (c *GlobalVarCheck) Description() string {
+	return "Flag mutable package-level variables"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *GlobalVarCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "global-vars",
+		Description:       "Warn when a package declares a mutable global variable that is not an error sentinel or allowlisted",
+		FilePatterns:      []string{"*.go"},
+		EstimatedDuration: 1 * time.Second,
+		Dependencies:      []string{}, // Pure Go, no external tools
+		DefaultTimeout:    c.timeout,
+		Category:          "style",
+		RequiresFiles:     true,
+		Cacheable:         true,
+	}
+}
+
+// Run executes the global variable check
+func (c *GlobalVarCheck) Run(ctx context.Context, files []string) error {
+	return astCheck[globalVar]{
+		checkReport: checkReport{
+			err:     prerrors.ErrGlobalVars,
+			message: "%d mutable global variable(s)",
+			suggestion: "Make the variable local to the function or type that uses it, or a const; add its name to " +
+				"GO_PRE_COMMIT_GLOBAL_VARS_ALLOW or //" + globalVarsDirective + " to its declaration to keep it",
+			warnOnly: !c.blocking,
+		},
+		sharedCtx: c.sharedCtx,
+		timeout:   c.timeout,
+		find: func(file string, content []byte) ([]globalVar, error) {
+			return findGlobalVars(file, content, c.allow)
+		},
+	}.run(ctx, files)
+}
+
+// FilterFiles filters to Go files, leaving out tests
+func (c *GlobalVarCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if strings.HasSuffix(file, ".go") && !strings.HasSuffix(file, "_test.go") {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// String formats the finding with its location
+func (v globalVar) String() string {
+	return fmt.Sprintf("%s:%d: global variable %s is mutable", v.file, v.line, v.name)
+}
+
+// findGlobalVars parses a Go file and returns its package-level variables,
+// leaving out generated files, suppressed declarations, error sentinels,
+// blank and //go:embed variables, and names matching an allow pattern
+func findGlobalVars(filename string, content []byte, allow []string) ([]globalVar, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, content, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	if ast.IsGenerated(file) {
+		return nil, nil
+	}
+
+	suppressed := make(map[int]bool)
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			if suppressesGlobalVar(comment.Text) {
+				suppressed[fset.Position(comment.Slash).Line] = true
+			}
+		}
+	}
+
+	var found []globalVar
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR || docSuppressesGlobalVar(gen.Doc) || suppressed[fset.Position(gen.Pos()).Line] {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			valueSpec, isValue := spec.(*ast.ValueSpec)
+			if !isValue || docSuppressesGlobalVar(valueSpec.Doc) || isErrorSentinel(valueSpec) {
+				continue
+			}
+			for _, name := range valueSpec.Names {
+				line := fset.Position(name.Pos()).Line
+				if name.Name == "_" || suppressed[line] || globalVarAllowed(name.Name, allow) {
+					continue
+				}
+				found = append(found, globalVar{file: filename, line: line, name: name.Name})
+			}
+		}
+	}
+	return found, nil
+}
+
+// suppressesGlobalVar reports whether a comment is globalVarsDirective, a
+// bare //nolint, or a //go:embed directive, whose variable cannot be a const
+func suppressesGlobalVar(text string) bool {
+	text = strings.TrimPrefix(text, "//")
+	if strings.HasPrefix(text, "go:embed ") || strings.Contains(text, globalVarsDirective) {
+		return true
+	}
+	return text == "nolint" || strings.HasPrefix(text, "nolint ")
+}
+
+// docSuppressesGlobalVar reports whether a doc comment holds a comment
+// suppressesGlobalVar accepts
+func docSuppressesGlobalVar(doc *ast.CommentGroup) bool {
+	if doc == nil {
+		return false
+	}
+	for _, comment := range doc.List {
+		if suppressesGlobalVar(comment.Text) {
+			return true
+		}
+	}
+	return false
+}
+
+// isErrorSentinel reports whether spec declares error values: typed error,
+// or every value built with errors.New or fmt.Errorf
+func isErrorSentinel(spec *ast.ValueSpec) bool {
+	if ident, ok := spec.Type.(*ast.Ident); ok && ident.Name == "error" {
+		return true
+	}
+	if len(spec.Values) == 0 {
+		return false
+	}
+	for _, value := range spec.Values {
+		if !isErrorConstructor(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// isErrorConstructor reports whether expr is a call to errors.New or
+// fmt.Errorf
+func isErrorConstructor(expr ast.Expr) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	return ok && (pkg.Name+"."+sel.Sel.Name == "errors.New" || pkg.Name+"."+sel.Sel.Name == "fmt.Errorf")
+}
+
+// globalVarAllowed reports whether name matches one of the allow patterns,
+// exactly or as a glob
+func globalVarAllowed(name string, allow []string) bool {
+	for _, pattern := range allow {
+		if matched, err := path.Match(pattern, name); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+package gotools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+func TestFindGlobalVars(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		allow   []string
+		want    []string
+	}{
+		{
+			name:    "mutable variable",
+			content: "package p\n\nvar cache = map[string]int{}\n",
+			want:    []string{"a.go:3: global variable cache is mutable"},
+		},
+		{
+			name:    "grouped variables",
+			content: "package p\n\nvar (\n\tcount int\n\tname, path string\n)\n",
+			want: []string{
+				"a.go:4: global variable count is mutable",
+				"a.go:5: global variable name is mutable",
+				"a.go:5: global variable path is mutable",
+			},
+		},
+		{
+			name:    "error sentinels",
+			content: "package p\n\nimport (\n\t\"errors\"\n\t\"fmt\"\n)\n\nvar (\n\tErrA = errors.New(\"a\")\n\tErrB = fmt.Errorf(\"b: %w\", ErrA)\n\tErrC error\n)\n",
+		},
+		{
+			name:    "blank variable",
+			content: "package p\n\nvar _ = 1\n",
+		},
+		{
+			name:    "suppressed by doc comment",
+			content: "package p\n\n//nolint:gochecknoglobals // set once at startup\nvar cache = map[string]int{}\n",
+		},
+		{
+			name:    "suppressed on the line",
+			content: "package p\n\nvar (\n\tcache = map[string]int{} //nolint\n\tcount int\n)\n",
+			want:    []string{"a.go:5: global variable count is mutable"},
+		},
+		{
+			name:    "embedded file",
+			content: "package p\n\nimport _ \"embed\"\n\n//go:embed schema.json\nvar schema []byte\n",
+		},
+		{
+			name:    "generated file",
+			content: "// Code generated by stringer. DO NOT EDIT.\n\npackage p\n\nvar cache = map[string]int{}\n",
+		},
+		{
+			name:    "default allowlist",
+			content: "package p\n\nvar (\n\tversion = \"dev\"\n\tcommit  string\n)\n",
+			allow:   defaultGlobalVarsAllow,
+		},
+		{
+			name:    "glob allowlist",
+			content: "package p\n\nvar (\n\tdefaultTimeout = 5\n\tretries = 3\n)\n",
+			allow:   []string{"default*"},
+			want:    []string{"a.go:5: global variable retries is mutable"},
+		},
+		{
+			name:    "constants and functions",
+			content: "package p\n\nconst limit = 5\n\nfunc f() { var local int; _ = local }\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vars, err := findGlobalVars("a.go", []byte(tt.content), tt.allow)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, findingStrings(vars))
+		})
+	}
+}
+
+func TestGlobalVarCheck_Run(t *testing.T) {
+	setupMultiModuleRepo(t, map[string]string{".": "module example.com/repo\n\ngo 1.22\n"})
+	writeModuleFiles(t, map[string]string{
+		"main.go":           "package main\n\nvar version = \"dev\"\n\nfunc main() {}\n",
+		"internal/s/s.go":   "package s\n\nvar registry = map[string]int{}\n",
+		"internal/s/bad.go": "package s\n\nfunc {\n",
+	})
+
+	check := NewGlobalVarCheckWithSharedContext(shared.NewContext())
+	require.NoError(t, check.Run(context.Background(), []string{"main.go", "internal/s/bad.go"}), "allowlisted and unparsable")
+
+	err := check.Run(context.Background(), []string{"main.go", "internal/s/s.go"})
+	require.ErrorIs(t, err, prerrors.ErrGlobalVars)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.True(t, checkErr.WarnOnly)
+	assert.Equal(t, []string{"internal/s/s.go"}, checkErr.Files)
+	assert.Equal(t, "internal/s/s.go:3: global variable registry is mutable", checkErr.Output)
+	assert.Contains(t, checkErr.Suggestion, "//nolint:gochecknoglobals")
+
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.GlobalVarsSeverity = config.LintSeverityError
+	err = NewGlobalVarCheckWithFullConfig(shared.NewContext(), cfg).Run(context.Background(), []string{"internal/s/s.go"})
+	require.ErrorAs(t, err, &checkErr)
+	assert.False(t, checkErr.WarnOnly, "error severity blocks the commit")
+
+	cfg.CheckBehaviors.GlobalVarsAllow = []string{"registry"}
+	require.Error(t, NewGlobalVarCheckWithFullConfig(shared.NewContext(), cfg).Run(context.Background(), []string{"main.go"}),
+		"a custom allowlist replaces the default")
+}
+
+func TestGlobalVarCheck_FilterFilesAndMetadata(t *testing.T) {
+	check := NewGlobalVarCheck()
+	assert.Equal(t, []string{"a.go"}, check.FilterFiles([]string{"a.go", "a_test.go", "go.mod"}))
+
+	assert.Equal(t, "global-vars", check.Name())
+	assert.NotEmpty(t, check.Description())
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "global-vars", metadata.Name)
+
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.GlobalVars = 5
+	assert.Equal(t, 5, int(NewGlobalVarCheckWithFullConfig(nil, cfg).timeout.Seconds()))
+}
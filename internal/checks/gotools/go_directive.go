@@ -0,0 +1,139 @@
+package gotools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+	"github.com/mrz1836/go-pre-commit/internal/tools"
+	"github.com/mrz1836/go-pre-commit/internal/version"
+)
+
+// GoDirectiveToolchainCheck fails when a changed go.mod declares a go
+// directive newer than the installed Go toolchain, which otherwise surfaces
+// as a confusing build error on the developer's machine
+type GoDirectiveToolchainCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+
+	// installedVersion returns a tool's installed version; tests replace it
+	// to simulate go version output
+	installedVersion func(ctx context.Context, toolName string) (string, error)
+}
+
+// NewGoDirectiveToolchainCheck creates a new go directive toolchain check
+func NewGoDirectiveToolchainCheck() *GoDirectiveToolchainCheck {
+	return NewGoDirectiveToolchainCheckWithSharedContext(shared.NewContext())
+}
+
+// NewGoDirectiveToolchainCheckWithSharedContext creates a new go directive toolchain check with shared context
+func NewGoDirectiveToolchainCheckWithSharedContext(sharedCtx *shared.Context) *GoDirectiveToolchainCheck {
+	return &GoDirectiveToolchainCheck{
+		sharedCtx:        sharedCtx,
+		timeout:          30 * time.Second,
+		installedVersion: tools.InstalledVersion,
+	}
+}
+
+// NewGoDirectiveToolchainCheckWithFullConfig creates a new go directive toolchain check with full configuration
+func NewGoDirectiveToolchainCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *GoDirectiveToolchainCheck {
+	check := NewGoDirectiveToolchainCheckWithSharedContext(sharedCtx)
+	if cfg != nil && cfg.CheckTimeouts.GoDirective > 0 {
+		check.timeout = time.Duration(cfg.CheckTimeouts.GoDirective) * time.Second
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *GoDirectiveToolchainCheck) Name() string {
+	return "go-directive"
+}
+
+// Description returns a brief description of the check
+func (c *GoDirectiveToolchainCheck) Description() string {
+	return "Require go.mod go directives the installed Go toolchain supports"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *GoDirectiveToolchainCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "go-directive",
+		Description:       "Fail when a go.mod go directive is newer than the installed go version",
+		FilePatterns:      []string{fileGoMod},
+		EstimatedDuration: 200 * time.Millisecond,
+		Dependencies:      []string{"go"},
+		DefaultTimeout:    c.timeout,
+		Category:          "dependencies",
+		RequiresFiles:     true,
+		NeedsNetwork:      true, // with GOTOOLCHAIN=auto, go version downloads the toolchain go.mod asks for
+	}
+}
+
+// FilterFiles filters to go.mod files outside vendor and testdata directories
+func (c *GoDirectiveToolchainCheck) FilterFiles(files []string) []string {
+	return filterGoModFiles(files)
+}
+
+// Run compares the go directive of each go.mod in files with the installed
+// Go version, reporting every module that requires a newer one
+func (c *GoDirectiveToolchainCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	installed, err := c.installedVersion(ctx, "go")
+	if errors.Is(err, tools.ErrToolNotInstalled) {
+		return prerrors.NewToolNotFoundError("go", "Install Go from https://go.dev/dl/")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read the installed Go version: %w", err)
+	}
+	installed = strings.TrimPrefix(installed, "v")
+
+	repoRoot := repoRootOrEmpty(ctx, c.sharedCtx)
+
+	var issues []string
+	var issueFiles []string
+	newest := ""
+	for _, file := range files {
+		directive, readErr := readGoDirective(resolveRepoPath(repoRoot, file))
+		if errors.Is(readErr, fs.ErrNotExist) {
+			// A go.mod removed by the change has no directive left to check
+			continue
+		}
+		if readErr != nil {
+			return readErr
+		}
+
+		if directive == "" || version.CompareVersions(directive, installed) <= 0 {
+			continue
+		}
+		issues = append(issues, fmt.Sprintf("%s: go %s, installed go%s", file, directive, installed))
+		issueFiles = append(issueFiles, file)
+		if newest == "" || version.CompareVersions(directive, newest) > 0 {
+			newest = directive
+		}
+	}
+
+	if len(issues) == 0 {
+		return nil
+	}
+
+	return &prerrors.CheckError{
+		Err:     prerrors.ErrGoDirectiveTooNew,
+		Message: fmt.Sprintf("%d module(s) require a newer Go than the installed go%s", len(issues), installed),
+		Suggestion: fmt.Sprintf("Install go%s or newer from https://go.dev/dl/, or set GOTOOLCHAIN=auto so go downloads it",
+			newest),
+		Output: strings.Join(issues, "\n"),
+		Files:  issueFiles,
+	}
+}
@@ -0,0 +1,109 @@
+package gotools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+	"github.com/mrz1836/go-pre-commit/internal/tools"
+)
+
+// errGoVersionFailed simulates go version exiting with an error
+var errGoVersionFailed = errors.New("go version failed")
+
+// newGoDirectiveCheck creates a go directive check in a repository holding
+// the given go.mod files, reporting installed as the Go version
+func newGoDirectiveCheck(t *testing.T, installed string, goMods map[string]string) *GoDirectiveToolchainCheck {
+	t.Helper()
+	repoRoot := t.TempDir()
+	for file, content := range goMods {
+		path := filepath.Join(repoRoot, file)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+	t.Setenv(shared.RepoRootEnv, repoRoot)
+
+	check := NewGoDirectiveToolchainCheck()
+	check.installedVersion = func(_ context.Context, toolName string) (string, error) {
+		assert.Equal(t, "go", toolName)
+		return installed, nil
+	}
+	return check
+}
+
+func TestGoDirectiveToolchainCheckMetadata(t *testing.T) {
+	check := NewGoDirectiveToolchainCheck()
+
+	assert.Equal(t, "go-directive", check.Name())
+	assert.NotEmpty(t, check.Description())
+
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, 30*time.Second, metadata.DefaultTimeout)
+	assert.True(t, metadata.NeedsNetwork, "skipped by --offline")
+	assert.Equal(t, []string{fileGoMod, "api/go.mod"},
+		check.FilterFiles([]string{fileGoMod, "api/go.mod", "main.go", "vendor/x/go.mod", "pkg/testdata/m/go.mod"}))
+}
+
+func TestGoDirectiveToolchainCheckRun(t *testing.T) {
+	goMods := map[string]string{
+		fileGoMod:         "module example.com/app\n\ngo 1.21\n",
+		"api/go.mod":      "module example.com/app/api\n\ngo 1.23.2 // needs iterators\n",
+		"tools/go.mod":    "module example.com/app/tools\n\ngo 1.22\n",
+		"legacy/go.mod":   "module example.com/app/legacy\n",
+		"patch/go.mod":    "module example.com/app/patch\n\ngo 1.21.5\n",
+		"exact/go.mod":    "module example.com/app/exact\n\ngo 1.21.6\n",
+		"unstable/go.mod": "module example.com/app/unstable\n\ngo 1.21rc2\n",
+	}
+
+	t.Run("compatible directives pass", func(t *testing.T) {
+		check := newGoDirectiveCheck(t, "v1.21.6", goMods)
+		files := []string{fileGoMod, "legacy/go.mod", "patch/go.mod", "exact/go.mod", "unstable/go.mod", "removed/go.mod"}
+		require.NoError(t, check.Run(context.Background(), files))
+	})
+
+	t.Run("newer directives fail", func(t *testing.T) {
+		check := newGoDirectiveCheck(t, "v1.21.6", goMods)
+		err := check.Run(context.Background(), []string{fileGoMod, "api/go.mod", "tools/go.mod"})
+		require.ErrorIs(t, err, prerrors.ErrGoDirectiveTooNew)
+
+		var checkErr *prerrors.CheckError
+		require.ErrorAs(t, err, &checkErr)
+		assert.Equal(t, []string{"api/go.mod", "tools/go.mod"}, checkErr.Files)
+		assert.Equal(t, "api/go.mod: go 1.23.2, installed go1.21.6\ntools/go.mod: go 1.22, installed go1.21.6", checkErr.Output)
+		assert.Contains(t, checkErr.Message, "2 module(s) require a newer Go than the installed go1.21.6")
+		assert.Contains(t, checkErr.Suggestion, "Install go1.23.2 or newer")
+	})
+
+	t.Run("a newer toolchain passes", func(t *testing.T) {
+		check := newGoDirectiveCheck(t, "v1.23.2", goMods)
+		require.NoError(t, check.Run(context.Background(), []string{"api/go.mod", "tools/go.mod"}))
+	})
+}
+
+func TestGoDirectiveToolchainCheckRunVersionErrors(t *testing.T) {
+	check := newGoDirectiveCheck(t, "", map[string]string{fileGoMod: "module m\n\ngo 1.21\n"})
+
+	check.installedVersion = func(context.Context, string) (string, error) {
+		return "", fmt.Errorf("%w: go", tools.ErrToolNotInstalled)
+	}
+	err := check.Run(context.Background(), []string{fileGoMod})
+	require.ErrorIs(t, err, prerrors.ErrToolNotFound)
+
+	check.installedVersion = func(context.Context, string) (string, error) {
+		return "", errGoVersionFailed
+	}
+	err = check.Run(context.Background(), []string{fileGoMod})
+	require.ErrorIs(t, err, errGoVersionFailed)
+
+	require.NoError(t, check.Run(context.Background(), nil), "no go.mod changes means no go version call")
+}
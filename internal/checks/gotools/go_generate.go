@@ -0,0 +1,314 @@
+package gotools
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// goGenerateDirective starts a line that go generate runs
+const goGenerateDirective = "//go:generate "
+
+// GoGenerateCheck verifies that go:generate output matches the committed
+// files. It runs go generate in a scratch copy of each affected module, so the
+// working tree is never touched, and fails when generation changes anything.
+type GoGenerateCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+}
+
+// NewGoGenerateCheck creates a new go generate check
+func NewGoGenerateCheck() *GoGenerateCheck {
+	return &GoGenerateCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   120 * time.Second,
+	}
+}
+
+// NewGoGenerateCheckWithSharedContext creates a new go generate check with shared context
+func NewGoGenerateCheckWithSharedContext(sharedCtx *shared.Context) *GoGenerateCheck {
+	return &GoGenerateCheck{
+		sharedCtx: sharedCtx,
+		timeout:   120 * time.Second,
+	}
+}
+
+// NewGoGenerateCheckWithFullConfig creates a new go generate check with full configuration
+func NewGoGenerateCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *GoGenerateCheck {
+	check := NewGoGenerateCheckWithSharedContext(sharedCtx)
+	if cfg != nil && cfg.CheckTimeouts.GoGenerate > 0 {
+		check.timeout = time.Duration(cfg.CheckTimeouts.GoGenerate) * time.Second
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *GoGenerateCheck) Name() string {
+	return "go-generate"
+}
+
+// Description returns a brief description of the check
+func (c *GoGenerateCheck) Description() string {
+	return "Verify go:generate output is up to date"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *GoGenerateCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "go-generate",
+		Description:       "Run go generate in a scratch copy and fail if the committed output is stale",
+		FilePatterns:      []string{"*"},
+		EstimatedDuration: 10 * time.Second,
+		Dependencies:      []string{"go"},
+		DefaultTimeout:    c.timeout,
+		Category:          "generation",
+		RequiresFiles:     true,
+	}
+}
+
+// Run executes the go generate check. Only packages with a changed file and a
+// go:generate directive are regenerated, since a change to a generator's
+// inputs usually sits next to the directive that consumes them.
+func (c *GoGenerateCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	repoRoot := repoRootOrEmpty(ctx, c.sharedCtx)
+
+	// Go packages come from the mapping shared with the other Go checks;
+	// generator inputs of other types name their directories directly
+	dirs := make(map[string]bool)
+	for _, pkg := range c.sharedCtx.ChangedPackages(repoRoot, files) {
+		dirs[resolveRepoPath(repoRoot, pkg.Dir)] = true
+	}
+	for _, file := range files {
+		if !strings.HasSuffix(file, ".go") {
+			dirs[filepath.Dir(resolveRepoPath(repoRoot, file))] = true
+		}
+	}
+
+	packagesByModule := make(map[string][]string)
+	for _, dir := range slices.Sorted(maps.Keys(dirs)) {
+		if !hasGenerateDirective(dir) {
+			continue
+		}
+		moduleRoot := findGoModuleRoot(dir, repoRoot)
+		if moduleRoot == "" {
+			continue
+		}
+		rel, err := filepath.Rel(moduleRoot, dir)
+		if err != nil {
+			continue
+		}
+		packagesByModule[moduleRoot] = append(packagesByModule[moduleRoot], "./"+filepath.ToSlash(rel))
+	}
+
+	var stale []string
+	var commands []string
+	for _, moduleRoot := range slices.Sorted(maps.Keys(packagesByModule)) {
+		packages := packagesByModule[moduleRoot]
+		changed, err := c.generateInScratch(ctx, moduleRoot, packages)
+		if err != nil {
+			return err
+		}
+		if len(changed) == 0 {
+			continue
+		}
+		for _, rel := range changed {
+			stale = append(stale, displayPath(repoRoot, filepath.Join(moduleRoot, rel)))
+		}
+		commands = append(commands, fmt.Sprintf("cd %s && go generate %s", displayPath(repoRoot, moduleRoot), strings.Join(packages, " ")))
+	}
+
+	if len(stale) == 0 {
+		return nil
+	}
+
+	return &prerrors.CheckError{
+		Err:        prerrors.ErrGeneratedCodeStale,
+		Message:    fmt.Sprintf("%d generated file(s) are out of date", len(stale)),
+		Suggestion: "Run go generate and commit the regenerated files",
+		Command:    strings.Join(commands, "\n"),
+		Output:     strings.Join(stale, "\n"),
+		Files:      stale,
+	}
+}
+
+// FilterFiles keeps every file: generator inputs can be of any type, and Run
+// narrows them to directories with go:generate directives
+func (c *GoGenerateCheck) FilterFiles(files []string) []string {
+	return files
+}
+
+// generateInScratch copies moduleRoot to a temporary directory, runs go
+// generate there for packages, and returns the module-relative paths of files
+// the generation created or changed
+func (c *GoGenerateCheck) generateInScratch(ctx context.Context, moduleRoot string, packages []string) ([]string, error) {
+	scratch, err := c.sharedCtx.TempWorkspace("generate")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create scratch directory: %w", err)
+	}
+	defer func() { _ = c.sharedCtx.RemoveTempWorkspace(scratch) }()
+
+	if err = copyModuleTree(moduleRoot, scratch); err != nil {
+		return nil, fmt.Errorf("failed to copy module %s: %w", moduleRoot, err)
+	}
+
+	cmd := exec.CommandContext(ctx, "go", append([]string{"generate"}, packages...)...) //nolint:gosec // package paths come from the repository
+	cmd.Dir = scratch
+	shared.LogCommand(ctx, cmd)
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	if err = cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, prerrors.NewToolExecutionError(
+				"go generate "+strings.Join(packages, " "),
+				output.String(),
+				fmt.Sprintf("go generate timed out after %v. Consider increasing GO_PRE_COMMIT_GO_GENERATE_TIMEOUT.", c.timeout),
+			)
+		}
+		return nil, prerrors.NewToolExecutionError(
+			"go generate "+strings.Join(packages, " "),
+			output.String(),
+			fmt.Sprintf("Run 'cd %s && go generate %s' to see the failure.", moduleRoot, strings.Join(packages, " ")),
+		)
+	}
+
+	return changedTreeFiles(moduleRoot, scratch)
+}
+
+// hasGenerateDirective reports whether a Go file in dir has a go:generate line
+func hasGenerateDirective(dir string) bool {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") {
+			continue
+		}
+		if fileHasGenerateDirective(filepath.Join(dir, entry.Name())) {
+			return true
+		}
+	}
+	return false
+}
+
+// fileHasGenerateDirective reports whether path has a go:generate line
+func fileHasGenerateDirective(path string) bool {
+	f, err := os.Open(path) //nolint:gosec // path comes from a directory listing
+	if err != nil {
+		return false
+	}
+	defer func() { _ = f.Close() }()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if strings.HasPrefix(scanner.Text(), goGenerateDirective) {
+			return true
+		}
+	}
+	return false
+}
+
+// copyModuleTree copies the files of the module at src into dst, preserving
+// permissions and symlinks. Version control metadata and nested modules are
+// left out: go generate for this module cannot reach them.
+func copyModuleTree(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		switch {
+		case entry.IsDir():
+			if entry.Name() == ".git" || (rel != "." && isGoModule(path)) {
+				return filepath.SkipDir
+			}
+			return os.MkdirAll(target, 0o750)
+		case entry.Type()&fs.ModeSymlink != 0:
+			link, linkErr := os.Readlink(path)
+			if linkErr != nil {
+				return linkErr
+			}
+			return os.Symlink(link, target)
+		case entry.Type().IsRegular():
+			return copyRegularFile(path, target)
+		default:
+			return nil
+		}
+	})
+}
+
+// copyRegularFile copies src to dst with the same permissions
+func copyRegularFile(src, dst string) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(src) //nolint:gosec // path comes from walking the module
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(dst, data, info.Mode().Perm())
+}
+
+// changedTreeFiles returns the paths, relative to scratch, of regular files in
+// scratch that are missing from original or differ from it
+func changedTreeFiles(original, scratch string) ([]string, error) {
+	var changed []string
+	err := filepath.WalkDir(scratch, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || !entry.Type().IsRegular() {
+			return err
+		}
+		rel, err := filepath.Rel(scratch, path)
+		if err != nil {
+			return err
+		}
+		generated, err := os.ReadFile(path) //nolint:gosec // path comes from walking the scratch copy
+		if err != nil {
+			return err
+		}
+		committed, readErr := os.ReadFile(filepath.Join(original, rel)) //nolint:gosec // same relative path in the module
+		if readErr != nil || !bytes.Equal(generated, committed) {
+			changed = append(changed, rel)
+		}
+		return nil
+	})
+	return changed, err
+}
+
+// displayPath returns path relative to repoRoot when possible
+func displayPath(repoRoot, path string) string {
+	if repoRoot == "" {
+		return path
+	}
+	if rel, err := filepath.Rel(repoRoot, path); err == nil && !strings.HasPrefix(rel, "..") {
+		return rel
+	}
+	return path
+}
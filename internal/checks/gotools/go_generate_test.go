@@ -0,0 +1,136 @@
+package gotools
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+// writeGenerateModule creates a repository holding a module whose gen package
+// copies input.txt to output.txt with go generate, and makes it the working
+// directory
+func writeGenerateModule(t *testing.T, input, output string) string {
+	t.Helper()
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go not available")
+	}
+	if _, err := exec.LookPath("cp"); err != nil {
+		t.Skip("cp not available")
+	}
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":         "module example.com/gen\n\ngo 1.21\n",
+		"gen/gen.go":     "package gen\n\n//go:generate cp input.txt output.txt\n",
+		"gen/input.txt":  input,
+		"gen/output.txt": output,
+		"other/other.go": "package other\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+	require.NoError(t, exec.CommandContext(context.Background(), "git", "init", "-q", dir).Run())
+	t.Chdir(dir)
+	return dir
+}
+
+func TestGoGenerateCheck_CurrentOutputPasses(t *testing.T) {
+	writeGenerateModule(t, "v1\n", "v1\n")
+
+	check := NewGoGenerateCheck()
+	require.NoError(t, check.Run(context.Background(), []string{"gen/input.txt"}))
+}
+
+func TestGoGenerateCheck_StaleOutputFails(t *testing.T) {
+	dir := writeGenerateModule(t, "v2\n", "v1\n")
+
+	check := NewGoGenerateCheck()
+	err := check.Run(context.Background(), []string{"gen/input.txt"})
+	require.ErrorIs(t, err, prerrors.ErrGeneratedCodeStale)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Equal(t, []string{"gen/output.txt"}, checkErr.Files)
+	assert.Contains(t, checkErr.Command, "go generate ./gen")
+
+	// The working tree is left alone
+	content, readErr := os.ReadFile(filepath.Join(dir, "gen", "output.txt")) //nolint:gosec // test path
+	require.NoError(t, readErr)
+	assert.Equal(t, "v1\n", string(content))
+}
+
+func TestGoGenerateCheck_UncommittedOutputFails(t *testing.T) {
+	dir := writeGenerateModule(t, "v1\n", "v1\n")
+	require.NoError(t, os.Remove(filepath.Join(dir, "gen", "output.txt")))
+
+	check := NewGoGenerateCheck()
+	err := check.Run(context.Background(), []string{"gen/gen.go"})
+	require.ErrorIs(t, err, prerrors.ErrGeneratedCodeStale)
+}
+
+func TestGoGenerateCheck_UnrelatedChangesAreSkipped(t *testing.T) {
+	writeGenerateModule(t, "v2\n", "v1\n")
+
+	check := NewGoGenerateCheck()
+	require.NoError(t, check.Run(context.Background(), []string{"other/other.go"}),
+		"packages without go:generate directives are not regenerated")
+	require.NoError(t, check.Run(context.Background(), nil))
+}
+
+func TestGoGenerateCheck_GeneratorFailure(t *testing.T) {
+	dir := writeGenerateModule(t, "v1\n", "v1\n")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "gen", "gen.go"),
+		[]byte("package gen\n\n//go:generate go-pre-commit-missing-generator\n"), 0o600))
+
+	check := NewGoGenerateCheck()
+	err := check.Run(context.Background(), []string{"gen/gen.go"})
+	require.ErrorIs(t, err, prerrors.ErrToolExecutionFailed)
+}
+
+func TestCopyModuleTree_SkipsNestedModulesAndGit(t *testing.T) {
+	src := t.TempDir()
+	for _, name := range []string{"go.mod", "pkg/a.go", ".git/HEAD", "nested/go.mod", "nested/b.go"} {
+		path := filepath.Join(src, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+		require.NoError(t, os.WriteFile(path, []byte(name), 0o600))
+	}
+
+	dst := t.TempDir()
+	require.NoError(t, copyModuleTree(src, dst))
+	assert.FileExists(t, filepath.Join(dst, "pkg", "a.go"))
+	assert.NoDirExists(t, filepath.Join(dst, ".git"))
+	assert.NoDirExists(t, filepath.Join(dst, "nested"))
+
+	changed, err := changedTreeFiles(src, dst)
+	require.NoError(t, err)
+	assert.Empty(t, changed)
+}
+
+func TestGoGenerateCheck_ConfigAndMetadata(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.GoGenerate = 5
+	check := NewGoGenerateCheckWithFullConfig(nil, cfg)
+	assert.Equal(t, "go-generate", check.Name())
+	assert.NotEmpty(t, check.Description())
+
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "go-generate", metadata.Name)
+	assert.Equal(t, int64(5), int64(metadata.DefaultTimeout.Seconds()))
+
+	files := []string{"a.go", "schema.proto"}
+	assert.Equal(t, files, check.FilterFiles(files))
+}
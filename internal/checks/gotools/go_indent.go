@@ -0,0 +1,178 @@
+package gotools
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go/scanner"
+	"go/token"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// GoIndentCheck flags Go files indented with spaces. gofmt always indents
+// with tabs, so leading spaces mean the file was never formatted; detecting
+// that in-process is much cheaper than spawning gofumpt.
+type GoIndentCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+}
+
+// NewGoIndentCheck creates a new Go indentation check
+func NewGoIndentCheck() *GoIndentCheck {
+	return &GoIndentCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewGoIndentCheckWithSharedContext creates a new Go indentation check with shared context
+func NewGoIndentCheckWithSharedContext(sharedCtx *shared.Context) *GoIndentCheck {
+	return &GoIndentCheck{
+		sharedCtx: sharedCtx,
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewGoIndentCheckWithFullConfig creates a new Go indentation check with full configuration
+func NewGoIndentCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *GoIndentCheck {
+	check := NewGoIndentCheckWithSharedContext(sharedCtx)
+	if cfg != nil && cfg.CheckTimeouts.GoIndent > 0 {
+		check.timeout = time.Duration(cfg.CheckTimeouts.GoIndent) * time.Second
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *GoIndentCheck) Name() string {
+	return "go-indent"
+}
+
+// Description returns a brief description of the check
+func (c *GoIndentCheck) Description() string {
+	return "Flag Go files indented with spaces instead of tabs"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *GoIndentCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "go-indent",
+		Description:       "Fast pre-check for unformatted Go files (space indentation)",
+		FilePatterns:      []string{"*.go"},
+		EstimatedDuration: 1 * time.Second,
+		Dependencies:      []string{}, // Pure Go, no external tools
+		DefaultTimeout:    c.timeout,
+		Category:          "formatting",
+		RequiresFiles:     true,
+		Cacheable:         true,
+	}
+}
+
+// Run executes the Go indentation check
+func (c *GoIndentCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	repoRoot := repoRootOrEmpty(ctx, c.sharedCtx)
+
+	var issues []string
+	var issueFiles []string
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		content, err := os.ReadFile(resolveRepoPath(repoRoot, file)) //nolint:gosec // File from staged file list
+		if err != nil {
+			issues = append(issues, fmt.Sprintf("%s: failed to read file: %v", file, err))
+			issueFiles = append(issueFiles, file)
+			continue
+		}
+
+		lines := spaceIndentedLines(content)
+		if len(lines) == 0 {
+			continue
+		}
+		issues = append(issues, fmt.Sprintf("%s:%d: indented with spaces (%d line(s))", file, lines[0], len(lines)))
+		issueFiles = append(issueFiles, file)
+	}
+
+	if len(issues) == 0 {
+		return nil
+	}
+
+	return &prerrors.CheckError{
+		Err:        prerrors.ErrIndentationIssues,
+		Message:    fmt.Sprintf("%d Go file(s) are indented with spaces", len(issueFiles)),
+		Suggestion: "Run the formatter (gofumpt -w or gofmt -w) on the listed files",
+		Output:     strings.Join(issues, "\n"),
+		Files:      issueFiles,
+	}
+}
+
+// FilterFiles filters to only Go files
+func (c *GoIndentCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if strings.HasSuffix(file, ".go") {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// spaceIndentedLines returns the 1-based numbers of lines that start with a
+// space and contain code. Lines that continue a raw string literal or a block
+// comment are ignored since their leading whitespace is content, not indentation.
+func spaceIndentedLines(content []byte) []int {
+	exempt := multiLineTokenBodies(content)
+
+	var lines []int
+	for i, line := range bytes.Split(content, []byte("\n")) {
+		lineNum := i + 1
+		if len(line) == 0 || line[0] != ' ' || exempt[lineNum] {
+			continue
+		}
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue // whitespace-only lines are the whitespace check's concern
+		}
+		lines = append(lines, lineNum)
+	}
+	return lines
+}
+
+// multiLineTokenBodies returns the lines that start inside a raw string
+// literal or block comment spanning several lines
+func multiLineTokenBodies(content []byte) map[int]bool {
+	exempt := make(map[int]bool)
+
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(content))
+	var s scanner.Scanner
+	s.Init(file, content, nil, scanner.ScanComments)
+
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		if tok != token.STRING && tok != token.COMMENT {
+			continue
+		}
+		start := fset.Position(pos).Line
+		end := start + strings.Count(lit, "\n")
+		for line := start + 1; line <= end; line++ {
+			exempt[line] = true
+		}
+	}
+	return exempt
+}
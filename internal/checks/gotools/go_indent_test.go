@@ -0,0 +1,86 @@
+package gotools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+func TestSpaceIndentedLines(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []int
+	}{
+		{
+			name:    "tab indented",
+			content: "package main\n\nfunc main() {\n\tprintln(\"hi\")\n}\n",
+		},
+		{
+			name:    "space indented",
+			content: "package main\n\nfunc main() {\n    println(\"hi\")\n    println(\"bye\")\n}\n",
+			want:    []int{4, 5},
+		},
+		{
+			name:    "raw string body keeps its spaces",
+			content: "package main\n\nvar s = `\n    indented text\n  more`\n",
+		},
+		{
+			name:    "block comment body keeps its spaces",
+			content: "package main\n\n/*\n   Example:\n     foo()\n*/\nfunc main() {}\n",
+		},
+		{
+			name:    "whitespace-only line is ignored",
+			content: "package main\n    \nfunc main() {}\n",
+		},
+		{
+			name:    "space line after raw string ends is flagged",
+			content: "package main\n\nvar s = `a\nb`\n\nfunc f() {\n  return\n}\n",
+			want:    []int{7},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, spaceIndentedLines([]byte(tt.content)))
+		})
+	}
+}
+
+func TestGoIndentCheck_Run(t *testing.T) {
+	dir := t.TempDir()
+	spaces := filepath.Join(dir, "spaces.go")
+	tabs := filepath.Join(dir, "tabs.go")
+	require.NoError(t, os.WriteFile(spaces, []byte("package p\n\nfunc f() {\n    return\n}\n"), 0o600))
+	require.NoError(t, os.WriteFile(tabs, []byte("package p\n\nfunc g() {\n\treturn\n}\n"), 0o600))
+
+	check := NewGoIndentCheck()
+	require.NoError(t, check.Run(context.Background(), []string{tabs}))
+
+	err := check.Run(context.Background(), []string{spaces, tabs})
+	require.ErrorIs(t, err, prerrors.ErrIndentationIssues)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Equal(t, []string{spaces}, checkErr.Files)
+	assert.Contains(t, checkErr.Output, "spaces.go:4: indented with spaces (1 line(s))")
+	assert.Contains(t, checkErr.Suggestion, "gofumpt")
+}
+
+func TestGoIndentCheck_FilterFilesAndMetadata(t *testing.T) {
+	check := NewGoIndentCheck()
+	assert.Equal(t, []string{"a.go"}, check.FilterFiles([]string{"a.go", "b.py"}))
+	assert.Equal(t, "go-indent", check.Name())
+	assert.NotEmpty(t, check.Description())
+
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "go-indent", metadata.Name)
+	assert.Empty(t, metadata.Dependencies)
+}
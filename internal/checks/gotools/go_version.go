@@ -0,0 +1,232 @@
+package gotools
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// GoVersionCheck requires every Go module in the repository to declare the
+// same go directive version, so multi-module repositories do not drift apart
+type GoVersionCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+	allow     []string // module directories exempt from the comparison
+}
+
+// moduleGoVersion is the go directive declared by one module
+type moduleGoVersion struct {
+	dir     string // module directory relative to the repository root
+	version string // empty when go.mod has no go directive
+	allowed bool
+}
+
+// NewGoVersionCheck creates a new go directive consistency check
+func NewGoVersionCheck() *GoVersionCheck {
+	return &GoVersionCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewGoVersionCheckWithSharedContext creates a new go directive consistency check with shared context
+func NewGoVersionCheckWithSharedContext(sharedCtx *shared.Context) *GoVersionCheck {
+	return &GoVersionCheck{
+		sharedCtx: sharedCtx,
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewGoVersionCheckWithFullConfig creates a new go directive consistency check with full configuration
+func NewGoVersionCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *GoVersionCheck {
+	check := NewGoVersionCheckWithSharedContext(sharedCtx)
+	if cfg != nil {
+		if cfg.CheckTimeouts.GoVersion > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.GoVersion) * time.Second
+		}
+		check.allow = cfg.CheckBehaviors.GoVersionAllow
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *GoVersionCheck) Name() string {
+	return "go-version"
+}
+
+// Description returns a brief description of the check
+func (c *GoVersionCheck) Description() string {
+	return "Require the same go directive in every go.mod"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *GoVersionCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "go-version",
+		Description:       "Ensure all modules in the repository declare the same go version",
+		FilePatterns:      []string{fileGoMod},
+		EstimatedDuration: 500 * time.Millisecond,
+		Dependencies:      []string{"git"},
+		DefaultTimeout:    c.timeout,
+		Category:          "dependencies",
+		RequiresFiles:     true,
+	}
+}
+
+// Run executes the go directive consistency check. Any changed go.mod
+// triggers a comparison across every module in the repository.
+func (c *GoVersionCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	repoRoot, err := c.sharedCtx.GetRepoRoot(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to find repository root: %w", err)
+	}
+
+	moduleDirs, err := discoverGoModules(ctx, repoRoot)
+	if err != nil {
+		return err
+	}
+
+	modules := make([]moduleGoVersion, 0, len(moduleDirs))
+	for _, dir := range moduleDirs {
+		version, readErr := readGoDirective(filepath.Join(repoRoot, dir, fileGoMod))
+		if readErr != nil {
+			return readErr
+		}
+		modules = append(modules, moduleGoVersion{dir: dir, version: version, allowed: slices.Contains(c.allow, dir)})
+	}
+
+	versions := distinctGoVersions(modules)
+	if len(versions) <= 1 {
+		return nil
+	}
+
+	var moduleFiles []string
+	for _, m := range modules {
+		if !m.allowed {
+			moduleFiles = append(moduleFiles, filepath.Join(m.dir, fileGoMod))
+		}
+	}
+
+	return &prerrors.CheckError{
+		Err:        prerrors.ErrGoVersionMismatch,
+		Message:    fmt.Sprintf("Modules declare %d different go versions: %s", len(versions), strings.Join(versions, ", ")),
+		Suggestion: "Align the go directive in each go.mod (go mod edit -go=<version>), or list intentional exceptions in GO_PRE_COMMIT_GO_VERSION_ALLOW",
+		Output:     formatModuleVersions(modules),
+		Files:      moduleFiles,
+	}
+}
+
+// FilterFiles filters to go.mod files
+func (c *GoVersionCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if filepath.Base(file) == fileGoMod {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// discoverGoModules lists the directories, relative to repoRoot, of every
+// tracked or untracked-but-not-ignored go.mod. Vendored and testdata modules
+// are fixtures rather than part of the build and are left out.
+func discoverGoModules(ctx context.Context, repoRoot string) ([]string, error) {
+	cmd := exec.CommandContext(ctx, "git", "ls-files", "--cached", "--others", "--exclude-standard", "--", fileGoMod, "**/"+fileGoMod)
+	cmd.Dir = repoRoot
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list go.mod files: %w", err)
+	}
+
+	var dirs []string
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		if line == "" || filepath.Base(line) != fileGoMod {
+			continue
+		}
+		dir := filepath.ToSlash(filepath.Dir(line))
+		if slices.ContainsFunc(strings.Split(dir, "/"), func(part string) bool {
+			return part == "vendor" || part == "testdata"
+		}) {
+			continue
+		}
+		if !slices.Contains(dirs, dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+	slices.Sort(dirs)
+	return dirs, nil
+}
+
+// readGoDirective returns the version from the go directive of a go.mod file,
+// or an empty string when the file has none
+func readGoDirective(path string) (string, error) {
+	content, err := os.ReadFile(path) //nolint:gosec // path comes from git ls-files
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "//")
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == "go" {
+			return fields[1], nil
+		}
+	}
+	return "", scanner.Err()
+}
+
+// distinctGoVersions returns the sorted set of versions declared by modules
+// outside the allowlist
+func distinctGoVersions(modules []moduleGoVersion) []string {
+	var versions []string
+	for _, m := range modules {
+		if m.allowed {
+			continue
+		}
+		version := m.version
+		if version == "" {
+			version = "(none)"
+		}
+		if !slices.Contains(versions, version) {
+			versions = append(versions, version)
+		}
+	}
+	slices.Sort(versions)
+	return versions
+}
+
+// formatModuleVersions lists each module with the version it declares
+func formatModuleVersions(modules []moduleGoVersion) string {
+	lines := make([]string, 0, len(modules))
+	for _, m := range modules {
+		declared := "no go directive"
+		if m.version != "" {
+			declared = "go " + m.version
+		}
+		line := fmt.Sprintf("%s: %s", filepath.Join(m.dir, fileGoMod), declared)
+		if m.allowed {
+			line += " (allowed)"
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
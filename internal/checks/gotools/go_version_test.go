@@ -0,0 +1,140 @@
+package gotools
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// setupMultiModuleRepo creates a git repository with one go.mod per entry in
+// modules (directory -> go.mod content) and switches into it
+func setupMultiModuleRepo(t *testing.T, modules map[string]string) {
+	t.Helper()
+
+	repoDir := t.TempDir()
+	cmd := exec.CommandContext(context.Background(), "git", "init", "-q")
+	cmd.Dir = repoDir
+	require.NoError(t, cmd.Run())
+
+	for dir, content := range modules {
+		moduleDir := filepath.Join(repoDir, dir)
+		require.NoError(t, os.MkdirAll(moduleDir, 0o750))
+		require.NoError(t, os.WriteFile(filepath.Join(moduleDir, fileGoMod), []byte(content), 0o600))
+	}
+
+	t.Chdir(repoDir)
+}
+
+func TestReadGoDirective(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{name: "simple", content: "module example.com/a\n\ngo 1.22\n", want: "1.22"},
+		{name: "patch version with comment", content: "module example.com/a\n\ngo 1.22.3 // pinned\n\ntoolchain go1.23.0\n", want: "1.22.3"},
+		{name: "no directive", content: "module example.com/a\n"},
+		{name: "commented out", content: "module example.com/a\n// go 1.20\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), fileGoMod)
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+
+			got, err := readGoDirective(path)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGoVersionCheck_ConsistentModules(t *testing.T) {
+	setupMultiModuleRepo(t, map[string]string{
+		".":              "module example.com/root\n\ngo 1.22\n",
+		"tools":          "module example.com/root/tools\n\ngo 1.22\n",
+		"services/api":   "module example.com/root/services/api\n\ngo 1.22\n",
+		"vendor/x":       "module example.com/x\n\ngo 1.16\n",
+		"pkg/testdata/m": "module example.com/fixture\n\ngo 1.18\n",
+	})
+
+	check := NewGoVersionCheckWithSharedContext(shared.NewContext())
+	require.NoError(t, check.Run(context.Background(), []string{fileGoMod}))
+}
+
+func TestGoVersionCheck_DivergentModules(t *testing.T) {
+	setupMultiModuleRepo(t, map[string]string{
+		".":     "module example.com/root\n\ngo 1.22\n",
+		"tools": "module example.com/root/tools\n\ngo 1.21\n",
+		"api":   "module example.com/root/api\n\ngo 1.22\n",
+	})
+
+	check := NewGoVersionCheckWithSharedContext(shared.NewContext())
+	err := check.Run(context.Background(), []string{"tools/go.mod"})
+	require.ErrorIs(t, err, prerrors.ErrGoVersionMismatch)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Contains(t, checkErr.Message, "1.21, 1.22")
+	assert.Equal(t, "go.mod: go 1.22\napi/go.mod: go 1.22\ntools/go.mod: go 1.21", checkErr.Output)
+	assert.ElementsMatch(t, []string{"go.mod", "api/go.mod", "tools/go.mod"}, checkErr.Files)
+}
+
+func TestGoVersionCheck_AllowlistedModule(t *testing.T) {
+	setupMultiModuleRepo(t, map[string]string{
+		".":     "module example.com/root\n\ngo 1.22\n",
+		"tools": "module example.com/root/tools\n\ngo 1.21\n",
+	})
+
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.GoVersionAllow = []string{"tools"}
+	check := NewGoVersionCheckWithFullConfig(shared.NewContext(), cfg)
+
+	require.NoError(t, check.Run(context.Background(), []string{fileGoMod}))
+}
+
+func TestGoVersionCheck_MissingDirectiveDiffers(t *testing.T) {
+	setupMultiModuleRepo(t, map[string]string{
+		".":   "module example.com/root\n\ngo 1.22\n",
+		"old": "module example.com/root/old\n",
+	})
+
+	err := NewGoVersionCheck().Run(context.Background(), []string{fileGoMod})
+	require.ErrorIs(t, err, prerrors.ErrGoVersionMismatch)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Contains(t, checkErr.Output, "old/go.mod: no go directive")
+}
+
+func TestGoVersionCheck_NoFiles(t *testing.T) {
+	require.NoError(t, NewGoVersionCheck().Run(context.Background(), nil))
+}
+
+func TestGoVersionCheck_FilterFiles(t *testing.T) {
+	check := NewGoVersionCheck()
+	files := []string{"go.mod", "go.sum", "main.go", "tools/go.mod", "notgo.mod"}
+	assert.Equal(t, []string{"go.mod", "tools/go.mod"}, check.FilterFiles(files))
+}
+
+func TestGoVersionCheck_Metadata(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.GoVersion = 12
+	check := NewGoVersionCheckWithFullConfig(shared.NewContext(), cfg)
+
+	assert.Equal(t, "go-version", check.Name())
+	assert.NotEmpty(t, check.Description())
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, 12*time.Second, metadata.DefaultTimeout)
+}
@@ -0,0 +1,285 @@
+package gotools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/output"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// gosecInstallSuggestion tells users how to install gosec when it is missing
+const gosecInstallSuggestion = "Install gosec: go install github.com/securego/gosec/v2/cmd/gosec@latest"
+
+// gosecSeverityRank orders gosec's severities so they can be compared with
+// the configured threshold
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var gosecSeverityRank = map[string]int{
+	config.GosecSeverityLow:    1,
+	config.GosecSeverityMedium: 2,
+	config.GosecSeverityHigh:   3,
+}
+
+// gosecReport is the subset of gosec's JSON report (-fmt=json) parsed into
+// diagnostics. Lines and columns are strings, and a line may be a range
+// such as "12-14".
+type gosecReport struct {
+	Issues []gosecIssue `json:"Issues"`
+}
+
+// gosecIssue is one finding in a gosec report
+type gosecIssue struct {
+	Severity   string `json:"severity"`
+	Confidence string `json:"confidence"`
+	RuleID     string `json:"rule_id"`
+	Details    string `json:"details"`
+	File       string `json:"file"`
+	Line       string `json:"line"`
+	Column     string `json:"column"`
+}
+
+// GosecCheck runs gosec at the root of every Go module with changed files and
+// reports the findings in those files. Findings at or above the configured
+// severity fail the check; lower ones are reported as warnings.
+type GosecCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+	threshold string
+}
+
+// NewGosecCheck creates a new gosec check
+func NewGosecCheck() *GosecCheck {
+	return NewGosecCheckWithSharedContext(shared.NewContext())
+}
+
+// NewGosecCheckWithSharedContext creates a new gosec check with shared context
+func NewGosecCheckWithSharedContext(sharedCtx *shared.Context) *GosecCheck {
+	return NewGosecCheckWithFullConfig(sharedCtx, nil)
+}
+
+// NewGosecCheckWithFullConfig creates a new gosec check with full configuration
+func NewGosecCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *GosecCheck {
+	check := &GosecCheck{
+		sharedCtx: sharedCtx,
+		timeout:   120 * time.Second,
+		threshold: config.GosecSeverityMedium,
+	}
+	if cfg != nil {
+		if cfg.CheckTimeouts.Gosec > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.Gosec) * time.Second
+		}
+		if cfg.CheckBehaviors.GosecSeverity != "" {
+			check.threshold = cfg.CheckBehaviors.GosecSeverity
+		}
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *GosecCheck) Name() string {
+	return "gosec"
+}
+
+// Description returns a brief description of the check
+func (c *GosecCheck) Description() string {
+	return "Scan Go code for security problems with gosec"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *GosecCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "gosec",
+		Description:       "Run gosec in each changed Go module and fail on findings at or above GO_PRE_COMMIT_GOSEC_SEVERITY",
+		FilePatterns:      []string{"*.go"},
+		EstimatedDuration: 10 * time.Second,
+		Dependencies:      []string{"gosec"},
+		DefaultTimeout:    c.timeout,
+		Category:          "security",
+		RequiresFiles:     true,
+	}
+}
+
+// FilterFiles filters to Go files
+func (c *GosecCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if strings.HasSuffix(file, ".go") {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// Run executes gosec once per module and reports the findings in files
+func (c *GosecCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	if _, err := exec.LookPath("gosec"); err != nil {
+		return prerrors.NewToolNotFoundError("gosec", gosecInstallSuggestion)
+	}
+
+	repoRoot, err := c.sharedCtx.GetRepoRoot(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to find repository root: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	// gosec scans whole modules; only findings in the changed files are reported
+	changed := make(map[string]bool, len(files))
+	modules := make(map[string]bool)
+	for _, file := range files {
+		path := resolveRepoPath(repoRoot, file)
+		changed[repoRelativeFile(repoRoot, repoRoot, path)] = true
+		if moduleRoot := findGoModuleRoot(filepath.Dir(path), repoRoot); moduleRoot != "" {
+			modules[moduleRoot] = true
+		}
+	}
+	moduleRoots := make([]string, 0, len(modules))
+	for moduleRoot := range modules {
+		moduleRoots = append(moduleRoots, moduleRoot)
+	}
+	sort.Strings(moduleRoots)
+
+	var diagnostics []output.Diagnostic
+	for _, moduleRoot := range moduleRoots {
+		report, err := c.runGosec(ctx, moduleRoot)
+		if err != nil {
+			return err
+		}
+		for _, diagnostic := range c.gosecDiagnostics(repoRoot, moduleRoot, report) {
+			if changed[diagnostic.File] {
+				diagnostics = append(diagnostics, diagnostic)
+			}
+		}
+	}
+
+	return c.gosecError(diagnostics)
+}
+
+// runGosec runs gosec over every package in moduleRoot and parses its report.
+// -no-fail keeps the exit code for real failures rather than findings.
+func (c *GosecCheck) runGosec(ctx context.Context, moduleRoot string) (*gosecReport, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "gosec", "-fmt=json", "-no-fail", "./...")
+	cmd.Dir = moduleRoot
+	shared.LogCommand(ctx, cmd)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	runErr := cmd.Run()
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil, prerrors.NewToolExecutionError(
+			"gosec",
+			stderr.String(),
+			fmt.Sprintf("gosec timed out after %v. Consider increasing GO_PRE_COMMIT_GOSEC_TIMEOUT.", c.timeout),
+		)
+	}
+
+	report, parseErr := parseGosecJSON(stdout.Bytes())
+	if runErr != nil || parseErr != nil {
+		return nil, prerrors.NewToolExecutionError(
+			fmt.Sprintf("gosec -fmt=json ./... (in %s)", moduleRoot),
+			strings.TrimSpace(stdout.String()+stderr.String()),
+			"Run 'gosec ./...' in the module to see the full error",
+		)
+	}
+	return report, nil
+}
+
+// parseGosecJSON parses a gosec JSON report, skipping anything printed
+// before it
+func parseGosecJSON(data []byte) (*gosecReport, error) {
+	if start := bytes.IndexByte(data, '{'); start > 0 {
+		data = data[start:]
+	}
+	var report gosecReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("failed to parse gosec report: %w", err)
+	}
+	return &report, nil
+}
+
+// gosecDiagnostics converts the findings gosec reported while running in
+// moduleRoot into diagnostics with repository-relative paths. Findings at or
+// above the threshold are errors and the rest are warnings.
+func (c *GosecCheck) gosecDiagnostics(repoRoot, moduleRoot string, report *gosecReport) []output.Diagnostic {
+	diagnostics := make([]output.Diagnostic, 0, len(report.Issues))
+	for _, issue := range report.Issues {
+		severity := output.SeverityWarning
+		if gosecSeverityRank[strings.ToLower(issue.Severity)] >= gosecSeverityRank[c.threshold] {
+			severity = output.SeverityError
+		}
+		diagnostics = append(diagnostics, output.Diagnostic{
+			File:     repoRelativeFile(repoRoot, moduleRoot, issue.File),
+			Line:     gosecPosition(issue.Line),
+			Col:      gosecPosition(issue.Column),
+			Severity: severity,
+			Rule:     issue.RuleID,
+			Message:  fmt.Sprintf("%s (severity: %s, confidence: %s)", issue.Details, issue.Severity, issue.Confidence),
+		})
+	}
+	return diagnostics
+}
+
+// gosecPosition returns the first number of a gosec line or column, which
+// may be a range such as "12-14", or 0 when there is none
+func gosecPosition(value string) int {
+	first, _, _ := strings.Cut(value, "-")
+	n, err := strconv.Atoi(strings.TrimSpace(first))
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// gosecError reports diagnostics, failing only when one of them is an error
+func (c *GosecCheck) gosecError(diagnostics []output.Diagnostic) error {
+	if len(diagnostics) == 0 {
+		return nil
+	}
+
+	blocking := 0
+	lines := make([]string, 0, len(diagnostics))
+	files := make([]string, 0, len(diagnostics))
+	seen := make(map[string]bool, len(diagnostics))
+	for _, d := range diagnostics {
+		line := fmt.Sprintf("%s:%d:%d: %s: %s", d.File, d.Line, d.Col, d.Rule, d.Message)
+		if d.Severity == output.SeverityError {
+			blocking++
+		} else {
+			line = "[warning] " + line
+		}
+		lines = append(lines, line)
+		if !seen[d.File] {
+			seen[d.File] = true
+			files = append(files, d.File)
+		}
+	}
+
+	return &prerrors.CheckError{
+		Err: prerrors.ErrGosecIssues,
+		Message: fmt.Sprintf("gosec found %d issue(s), %d at or above %s severity",
+			len(diagnostics), blocking, c.threshold),
+		Suggestion:  "Fix the findings, or mark a reviewed line with '// #nosec G<rule> -- reason'",
+		Output:      strings.Join(lines, "\n"),
+		Files:       files,
+		WarnOnly:    blocking == 0,
+		Diagnostics: diagnostics,
+	}
+}
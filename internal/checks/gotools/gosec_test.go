@@ -0,0 +1,161 @@
+package gotools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/output"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// newGosecCheck creates a gosec check failing at the given severity
+func newGosecCheck(threshold string) *GosecCheck {
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.GosecSeverity = threshold
+	return NewGosecCheckWithFullConfig(shared.NewContext(), cfg)
+}
+
+// fakeGosec puts a gosec on PATH that prints the named fixture, with the
+// recorded /src/app paths moved to the directory it runs in, and exits with code
+func fakeGosec(t *testing.T, fixture string, code int) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script as the fake gosec")
+	}
+
+	binDir := t.TempDir()
+	report := filepath.Join(binDir, "report.json")
+	require.NoError(t, os.WriteFile(report, []byte(readFixture(t, fixture)), 0o600))
+	script := "#!/bin/sh\n" +
+		"sed \"s#/src/app#$PWD#g\" \"" + report + "\"\n" +
+		"exit " + strconv.Itoa(code) + "\n"
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "gosec"), []byte(script), 0o755)) //nolint:gosec // executable test fixture
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+// gosecRepo creates a single-module repository and makes it the repository root
+func gosecRepo(t *testing.T) string {
+	t.Helper()
+	repoRoot := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(repoRoot, "go.mod"), []byte("module example.com/app\n\ngo 1.24\n"), 0o600))
+	for _, dir := range []string{"cmd", "internal/store"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(repoRoot, dir), 0o750))
+	}
+	t.Setenv(shared.RepoRootEnv, repoRoot)
+	return repoRoot
+}
+
+func TestGosecCheckMetadata(t *testing.T) {
+	check := NewGosecCheck()
+
+	assert.Equal(t, "gosec", check.Name())
+	assert.NotEmpty(t, check.Description())
+	assert.Equal(t, config.GosecSeverityMedium, check.threshold)
+
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, 120*time.Second, metadata.DefaultTimeout)
+	assert.Equal(t, []string{"gosec"}, metadata.Dependencies)
+	assert.Equal(t, []string{"main.go", "pkg/a_test.go"},
+		check.FilterFiles([]string{"main.go", "go.mod", "pkg/a_test.go", "README.md"}))
+}
+
+func TestParseGosecJSON(t *testing.T) {
+	report, err := parseGosecJSON([]byte(readFixture(t, "gosec_clean.json")))
+	require.NoError(t, err)
+	assert.Empty(t, report.Issues)
+
+	report, err = parseGosecJSON([]byte("[gosec] 2025/01/02 Including rules: default\n" + readFixture(t, "gosec_issues.json")))
+	require.NoError(t, err)
+	assert.Len(t, report.Issues, 3)
+
+	_, err = parseGosecJSON([]byte("panic: no packages"))
+	require.Error(t, err)
+}
+
+func TestGosecDiagnostics(t *testing.T) {
+	report, err := parseGosecJSON([]byte(readFixture(t, "gosec_issues.json")))
+	require.NoError(t, err)
+
+	diagnostics := newGosecCheck(config.GosecSeverityMedium).gosecDiagnostics("/src", "/src/app", report)
+	assert.Equal(t, []output.Diagnostic{
+		{
+			File: "app/cmd/run.go", Line: 21, Col: 9, Severity: output.SeverityError, Rule: "G204",
+			Message: "Subprocess launched with a potential tainted input or cmd arguments (severity: HIGH, confidence: HIGH)",
+		},
+		{
+			File: "app/internal/store/load.go", Line: 40, Col: 15, Severity: output.SeverityError, Rule: "G304",
+			Message: "Potential file inclusion via variable (severity: MEDIUM, confidence: HIGH)",
+		},
+		{
+			File: "app/cmd/run.go", Line: 30, Col: 2, Severity: output.SeverityWarning, Rule: "G104",
+			Message: "Errors unhandled. (severity: LOW, confidence: HIGH)",
+		},
+	}, diagnostics)
+
+	var severities []string
+	for _, d := range newGosecCheck(config.GosecSeverityHigh).gosecDiagnostics("/src", "/src/app", report) {
+		severities = append(severities, d.Severity)
+	}
+	assert.Equal(t, []string{output.SeverityError, output.SeverityWarning, output.SeverityWarning}, severities)
+}
+
+func TestGosecCheckRun(t *testing.T) {
+	fakeGosec(t, "gosec_issues.json", 0)
+	gosecRepo(t)
+
+	err := newGosecCheck(config.GosecSeverityMedium).Run(context.Background(), []string{"cmd/run.go"})
+	require.ErrorIs(t, err, prerrors.ErrGosecIssues)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.False(t, checkErr.WarnOnly)
+	assert.Equal(t, []string{"cmd/run.go"}, checkErr.Files, "findings outside the changed files are dropped")
+	assert.Len(t, checkErr.Diagnostics, 2)
+	assert.Contains(t, checkErr.Output, "cmd/run.go:21:9: G204: Subprocess launched")
+	assert.Contains(t, checkErr.Output, "[warning] cmd/run.go:30:2: G104: Errors unhandled.")
+	assert.Contains(t, checkErr.Message, "1 at or above medium severity")
+
+	// A MEDIUM finding is only a warning when the threshold is high
+	err = newGosecCheck(config.GosecSeverityHigh).Run(context.Background(), []string{"internal/store/load.go"})
+	require.ErrorAs(t, err, &checkErr)
+	assert.True(t, checkErr.WarnOnly)
+	assert.Equal(t, []string{"internal/store/load.go"}, checkErr.Files)
+}
+
+func TestGosecCheckRunClean(t *testing.T) {
+	fakeGosec(t, "gosec_clean.json", 0)
+	gosecRepo(t)
+
+	require.NoError(t, NewGosecCheck().Run(context.Background(), []string{"cmd/run.go"}))
+}
+
+func TestGosecCheckRunToolFailure(t *testing.T) {
+	fakeGosec(t, "gosec_clean.json", 2)
+	gosecRepo(t)
+
+	err := NewGosecCheck().Run(context.Background(), []string{"cmd/run.go"})
+	require.ErrorIs(t, err, prerrors.ErrToolExecutionFailed)
+}
+
+func TestGosecCheckRunNoTool(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+
+	err := NewGosecCheck().Run(context.Background(), []string{"main.go"})
+	require.ErrorIs(t, err, prerrors.ErrToolNotFound)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.True(t, checkErr.CanSkip)
+	assert.Contains(t, checkErr.Suggestion, "go install github.com/securego/gosec/v2/cmd/gosec@latest")
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
 	"github.com/mrz1836/go-pre-commit/internal/shared"
 )
 
@@ -285,8 +286,14 @@ fmt.Println("hello")
 	check := NewFumptCheck()
 	err = check.Run(context.Background(), []string{testFileMainGo})
 
-	// Should succeed when gofumpt is available
-	s.NoError(err)
+	// Without auto-stage the rewritten file is reported so it can be staged
+	s.Require().ErrorIs(err, prerrors.ErrFmtIssues)
+	var checkErr *prerrors.CheckError
+	s.Require().ErrorAs(err, &checkErr)
+	s.Equal([]string{testFileMainGo}, checkErr.Files)
+
+	// The file is now formatted, so a second run passes
+	s.NoError(check.Run(context.Background(), []string{testFileMainGo}))
 }
 
 func (s *FumptCheckTestSuite) TestRunWithTimeout() {
@@ -0,0 +1,41 @@
+package gotools
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+	"github.com/mrz1836/go-pre-commit/internal/tools"
+)
+
+// ensureInstalled installs a tool when it is missing. It is a package
+// variable so tests can substitute an installer and simulate concurrent installs.
+//
+//nolint:gochecknoglobals // Injectable seam for testing install races
+var ensureInstalled = tools.EnsureInstalled
+
+// ensureTool installs tool while flagging the install on the shared context,
+// so other checks that find the binary missing know to wait for it
+func ensureTool(ctx context.Context, sharedCtx *shared.Context, tool string) error {
+	done := sharedCtx.BeginInstall(tool)
+	defer done()
+
+	return ensureInstalled(ctx, tool)
+}
+
+// runAfterInstall runs fn, which executes tool. If the binary is not found
+// while another check is still installing it, it waits for that install to
+// finish and retries once; other failures are returned unchanged.
+func runAfterInstall(ctx context.Context, sharedCtx *shared.Context, tool string, fn func() error) error {
+	err := fn()
+	if err == nil || !errors.Is(err, exec.ErrNotFound) {
+		return err
+	}
+
+	waited, waitErr := sharedCtx.WaitForInstall(ctx, tool)
+	if !waited || waitErr != nil {
+		return err
+	}
+	return fn()
+}
@@ -0,0 +1,140 @@
+package gotools
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// withInstaller swaps the tool installer for the duration of the test
+func withInstaller(t *testing.T, fn func(ctx context.Context, tool string) error) {
+	t.Helper()
+	original := ensureInstalled
+	ensureInstalled = fn
+	t.Cleanup(func() { ensureInstalled = original })
+}
+
+func TestRunAfterInstall_WaitsForConcurrentInstall(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script as the fake tool")
+	}
+
+	const tool = "fake-race-tool"
+	binDir := t.TempDir()
+	t.Setenv("PATH", binDir)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	withInstaller(t, func(_ context.Context, name string) error {
+		assert.Equal(t, tool, name)
+		close(started)
+		<-release
+		return os.WriteFile(filepath.Join(binDir, tool), []byte("#!/bin/sh\nexit 0\n"), 0o755) //nolint:gosec // executable test fixture
+	})
+
+	sharedCtx := shared.NewContext()
+	ctx := context.Background()
+
+	// Check A installs the tool
+	var wg sync.WaitGroup
+	var installErr error
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		installErr = ensureTool(ctx, sharedCtx, tool)
+	}()
+	<-started
+
+	// Check B runs the tool before the install has finished
+	attempts := 0
+	firstFailure := make(chan struct{})
+	var runErr error
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		runErr = runAfterInstall(ctx, sharedCtx, tool, func() error {
+			attempts++
+			err := exec.CommandContext(ctx, tool).Run()
+			if attempts == 1 {
+				close(firstFailure)
+			}
+			return err
+		})
+	}()
+
+	<-firstFailure
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.NoError(t, installErr)
+	require.NoError(t, runErr)
+	assert.Equal(t, 2, attempts, "tool should be retried once after the install completes")
+}
+
+func TestRunAfterInstall_NoRetryWithoutInstall(t *testing.T) {
+	sharedCtx := shared.NewContext()
+	attempts := 0
+
+	err := runAfterInstall(context.Background(), sharedCtx, "missing-tool", func() error {
+		attempts++
+		return &exec.Error{Name: "missing-tool", Err: exec.ErrNotFound}
+	})
+	require.ErrorIs(t, err, exec.ErrNotFound)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRunAfterInstall_OtherErrorsNotRetried(t *testing.T) {
+	sharedCtx := shared.NewContext()
+	done := sharedCtx.BeginInstall("tool")
+	defer done()
+
+	errBoom := errors.New("boom")
+	attempts := 0
+	err := runAfterInstall(context.Background(), sharedCtx, "tool", func() error {
+		attempts++
+		return errBoom
+	})
+	require.ErrorIs(t, err, errBoom)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRunAfterInstall_ContextCanceledWhileWaiting(t *testing.T) {
+	sharedCtx := shared.NewContext()
+	done := sharedCtx.BeginInstall("tool")
+	defer done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	attempts := 0
+	err := runAfterInstall(ctx, sharedCtx, "tool", func() error {
+		attempts++
+		return &exec.Error{Name: "tool", Err: exec.ErrNotFound}
+	})
+	require.ErrorIs(t, err, exec.ErrNotFound)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestEnsureTool_ReleasesInstallFlagOnError(t *testing.T) {
+	errInstall := errors.New("install failed")
+	withInstaller(t, func(context.Context, string) error { return errInstall })
+
+	sharedCtx := shared.NewContext()
+	require.ErrorIs(t, ensureTool(context.Background(), sharedCtx, "tool"), errInstall)
+
+	waited, err := sharedCtx.WaitForInstall(context.Background(), "tool")
+	require.NoError(t, err)
+	assert.False(t, waited)
+}
@@ -0,0 +1,237 @@
+package gotools
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// InternalImportCheck flags Go files that import an internal package of
+// another module in the same repository. The compiler only checks internal
+// packages by import path, so a nested module may still import its parent
+// module's internal packages; that ties the modules' releases together. Module
+// boundaries come from the repository's go.mod files, and import paths on the
+// allow list are exempt.
+type InternalImportCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+	allow     []string // import paths: exact, globs, or "path/..." prefixes
+}
+
+// repoModule is a module of the repository
+type repoModule struct {
+	dir  string // relative to the repository root, slash-separated
+	path string // module path from its go.mod
+}
+
+// internalImport is one import of another module's internal package
+type internalImport struct {
+	file       string
+	line       int
+	importPath string
+	owner      string // module path of the module the package belongs to
+}
+
+// NewInternalImportCheck creates a new internal import check
+func NewInternalImportCheck() *InternalImportCheck {
+	return &InternalImportCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewInternalImportCheckWithSharedContext creates a new internal import check with shared context
+func NewInternalImportCheckWithSharedContext(sharedCtx *shared.Context) *InternalImportCheck {
+	return &InternalImportCheck{
+		sharedCtx: sharedCtx,
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewInternalImportCheckWithFullConfig creates a new internal import check with full configuration
+func NewInternalImportCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *InternalImportCheck {
+	check := NewInternalImportCheckWithSharedContext(sharedCtx)
+	if cfg != nil {
+		if cfg.CheckTimeouts.InternalImport > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.InternalImport) * time.Second
+		}
+		check.allow = cfg.CheckBehaviors.InternalImportAllow
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *InternalImportCheck) Name() string {
+	return "internal-import"
+}
+
+// Description returns a brief description of the check
+func (c *InternalImportCheck) Description() string {
+	return "Block imports of another module's internal packages"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *InternalImportCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "internal-import",
+		Description:       "Fail when a Go file imports an internal package of another module in the repository",
+		FilePatterns:      []string{"*.go"},
+		EstimatedDuration: 1 * time.Second,
+		Dependencies:      []string{"git"},
+		DefaultTimeout:    c.timeout,
+		Category:          "dependencies",
+		RequiresFiles:     true,
+	}
+}
+
+// Run executes the internal import check
+func (c *InternalImportCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	repoRoot, err := c.sharedCtx.GetRepoRoot(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to find repository root: %w", err)
+	}
+
+	modules, err := readRepoModules(ctx, repoRoot)
+	if err != nil {
+		return err
+	}
+	// A single module cannot import another module's packages
+	if len(modules) < 2 {
+		return nil
+	}
+
+	return astCheck[internalImport]{
+		checkReport: checkReport{
+			err:        prerrors.ErrInternalImport,
+			message:    "%d import(s) of another module's internal package found",
+			suggestion: "Move the package out of internal/ or into the importing module, or list it in GO_PRE_COMMIT_INTERNAL_IMPORT_ALLOW",
+		},
+		skip: func(_, file string) bool {
+			_, ok := moduleOfFile(modules, file)
+			return !ok
+		},
+		find: func(file string, content []byte) ([]internalImport, error) {
+			module, _ := moduleOfFile(modules, file)
+			return findInternalImports(file, content, module, modules, c.allow)
+		},
+	}.scan(ctx, repoRoot, files)
+}
+
+// FilterFiles filters to Go files
+func (c *InternalImportCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if strings.HasSuffix(file, ".go") {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// String formats the import with the module that owns the package
+func (i internalImport) String() string {
+	return fmt.Sprintf("%s:%d: import %q is internal to module %s", i.file, i.line, i.importPath, i.owner)
+}
+
+// readRepoModules returns the modules of the repository with their module
+// paths; go.mod files without a module directive are left out
+func readRepoModules(ctx context.Context, repoRoot string) ([]repoModule, error) {
+	dirs, err := discoverGoModules(ctx, repoRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	modules := make([]repoModule, 0, len(dirs))
+	for _, dir := range dirs {
+		modulePath, readErr := shared.ReadModulePath(filepath.Join(repoRoot, dir, fileGoMod))
+		if readErr != nil || modulePath == "" {
+			continue
+		}
+		modules = append(modules, repoModule{dir: dir, path: modulePath})
+	}
+	return modules, nil
+}
+
+// moduleOfFile returns the innermost module whose directory holds file
+func moduleOfFile(modules []repoModule, file string) (repoModule, bool) {
+	dir := path.Dir(filepath.ToSlash(filepath.Clean(file)))
+
+	var owner repoModule
+	found := false
+	for _, module := range modules {
+		inModule := module.dir == "." || dir == module.dir || strings.HasPrefix(dir, module.dir+"/")
+		if inModule && (!found || len(module.dir) > len(owner.dir)) {
+			owner, found = module, true
+		}
+	}
+	return owner, found
+}
+
+// moduleOfImport returns the module whose path is the longest prefix of
+// importPath, or false for packages outside the repository
+func moduleOfImport(modules []repoModule, importPath string) (repoModule, bool) {
+	var owner repoModule
+	found := false
+	for _, module := range modules {
+		inModule := importPath == module.path || strings.HasPrefix(importPath, module.path+"/")
+		if inModule && (!found || len(module.path) > len(owner.path)) {
+			owner, found = module, true
+		}
+	}
+	return owner, found
+}
+
+// findInternalImports parses the imports of a Go file in module and returns
+// those of internal packages that belong to one of the other modules, unless
+// the import path is on the allow list. Generated files are skipped.
+func findInternalImports(filename string, content []byte, module repoModule, modules []repoModule, allow []string) ([]internalImport, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, content, parser.ImportsOnly|parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	if ast.IsGenerated(file) {
+		return nil, nil
+	}
+
+	var found []internalImport
+	for _, spec := range file.Imports {
+		importPath, unquoteErr := strconv.Unquote(spec.Path.Value)
+		if unquoteErr != nil || !slices.Contains(strings.Split(importPath, "/"), "internal") {
+			continue
+		}
+		owner, inRepo := moduleOfImport(modules, importPath)
+		if !inRepo || owner.path == module.path {
+			continue
+		}
+		if _, allowed := matchImportRule(importPath, allow); allowed {
+			continue
+		}
+		found = append(found, internalImport{
+			file:       filename,
+			line:       fset.Position(spec.Pos()).Line,
+			importPath: importPath,
+			owner:      owner.path,
+		})
+	}
+	return found, nil
+}
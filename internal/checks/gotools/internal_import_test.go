@@ -0,0 +1,169 @@
+package gotools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// testRepoModules is a repository with a root module and two nested modules
+//
+//nolint:gochecknoglobals // Test fixture
+var testRepoModules = []repoModule{
+	{dir: ".", path: "example.com/repo"},
+	{dir: "lib", path: "example.com/repo/lib"},
+	{dir: "tools", path: "example.com/repo/tools"},
+}
+
+func TestFindInternalImports(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		imports string
+		allow   []string
+		want    []string
+	}{
+		{
+			name:    "same module internal import",
+			file:    "cmd/app/main.go",
+			imports: `"example.com/repo/internal/config"`,
+		},
+		{
+			name:    "nested module importing the root module's internal package",
+			file:    "tools/gen/main.go",
+			imports: `"fmt"; "example.com/repo/internal/config"`,
+			want:    []string{`tools/gen/main.go:3: import "example.com/repo/internal/config" is internal to module example.com/repo`},
+		},
+		{
+			name:    "root module importing a nested module's internal package",
+			file:    "main.go",
+			imports: `"example.com/repo/lib/internal/cache"`,
+			want:    []string{`main.go:3: import "example.com/repo/lib/internal/cache" is internal to module example.com/repo/lib`},
+		},
+		{
+			name:    "nested module internal import from inside it",
+			file:    "lib/api.go",
+			imports: `"example.com/repo/lib/internal/cache"`,
+		},
+		{
+			name:    "non-internal package of another module",
+			file:    "tools/gen/main.go",
+			imports: `"example.com/repo/pkg/config"`,
+		},
+		{
+			name:    "internal package outside the repository",
+			file:    "tools/gen/main.go",
+			imports: `"golang.org/x/tools/internal/event"`,
+		},
+		{
+			name:    "allowed by exact path",
+			file:    "tools/gen/main.go",
+			imports: `"example.com/repo/internal/version"`,
+			allow:   []string{"example.com/repo/internal/version"},
+		},
+		{
+			name:    "allowed by prefix",
+			file:    "tools/gen/main.go",
+			imports: `"example.com/repo/internal/testutil/fake"`,
+			allow:   []string{"example.com/repo/internal/testutil/..."},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			module, ok := moduleOfFile(testRepoModules, tt.file)
+			require.True(t, ok)
+
+			content := "package p\n\nimport (" + tt.imports + ")\n"
+			imports, err := findInternalImports(tt.file, []byte(content), module, testRepoModules, tt.allow)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, findingStrings(imports))
+		})
+	}
+}
+
+func TestFindInternalImports_Generated(t *testing.T) {
+	content := "// Code generated by protoc-gen-go. DO NOT EDIT.\n\npackage p\n\nimport _ \"example.com/repo/internal/config\"\n"
+	imports, err := findInternalImports("tools/pb.go", []byte(content), testRepoModules[2], testRepoModules, nil)
+	require.NoError(t, err)
+	assert.Empty(t, imports)
+}
+
+func TestModuleOfFile(t *testing.T) {
+	for file, want := range map[string]string{
+		"main.go":            ".",
+		"internal/config.go": ".",
+		"lib/api.go":         "lib",
+		"lib/internal/c.go":  "lib",
+		"library/x.go":       ".",
+		"tools/gen/main.go":  "tools",
+	} {
+		module, ok := moduleOfFile(testRepoModules, file)
+		require.True(t, ok, file)
+		assert.Equal(t, want, module.dir, file)
+	}
+
+	_, ok := moduleOfFile(testRepoModules[1:], "main.go")
+	assert.False(t, ok, "files outside every module are skipped")
+}
+
+func TestInternalImportCheck_Run(t *testing.T) {
+	setupMultiModuleRepo(t, map[string]string{
+		".":     "module example.com/repo\n\ngo 1.22\n",
+		"tools": "module example.com/repo/tools\n\ngo 1.22\n",
+	})
+	for file, content := range map[string]string{
+		"cmd/app/main.go":   "package main\n\nimport _ \"example.com/repo/internal/config\"\n",
+		"tools/gen/main.go": "package main\n\nimport _ \"example.com/repo/internal/config\"\n",
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(file), 0o750))
+		require.NoError(t, os.WriteFile(file, []byte(content), 0o600))
+	}
+
+	check := NewInternalImportCheckWithSharedContext(shared.NewContext())
+	require.NoError(t, check.Run(context.Background(), []string{"cmd/app/main.go"}), "same-module import")
+
+	err := check.Run(context.Background(), []string{"cmd/app/main.go", "tools/gen/main.go"})
+	require.ErrorIs(t, err, prerrors.ErrInternalImport)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Equal(t, []string{"tools/gen/main.go"}, checkErr.Files)
+	assert.Equal(t, `tools/gen/main.go:3: import "example.com/repo/internal/config" is internal to module example.com/repo`, checkErr.Output)
+
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.InternalImportAllow = []string{"example.com/repo/internal/..."}
+	allowed := NewInternalImportCheckWithFullConfig(shared.NewContext(), cfg)
+	require.NoError(t, allowed.Run(context.Background(), []string{"tools/gen/main.go"}))
+}
+
+func TestInternalImportCheck_SingleModule(t *testing.T) {
+	setupMultiModuleRepo(t, map[string]string{".": "module example.com/repo\n\ngo 1.22\n"})
+
+	check := NewInternalImportCheckWithSharedContext(shared.NewContext())
+	require.NoError(t, check.Run(context.Background(), []string{"missing.go"}), "one module has nothing to cross")
+}
+
+func TestInternalImportCheck_FilterFilesAndMetadata(t *testing.T) {
+	check := NewInternalImportCheck()
+	assert.Equal(t, []string{"a.go", "a_test.go"}, check.FilterFiles([]string{"a.go", "a_test.go", "go.mod"}))
+
+	assert.Equal(t, "internal-import", check.Name())
+	assert.NotEmpty(t, check.Description())
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "internal-import", metadata.Name)
+
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.InternalImport = 5
+	assert.Equal(t, 5, int(NewInternalImportCheckWithFullConfig(nil, cfg).timeout.Seconds()))
+}
@@ -9,21 +9,35 @@ import (
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/mrz1836/go-pre-commit/internal/config"
 	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/output"
 	"github.com/mrz1836/go-pre-commit/internal/shared"
-	"github.com/mrz1836/go-pre-commit/internal/tools"
 )
 
+// defaultLintPackageThreshold is the package linting threshold used when no
+// configuration is available (GO_PRE_COMMIT_LINT_PACKAGE_THRESHOLD)
+const defaultLintPackageThreshold = 100
+
+// errLintBuildConstraints reports that a package-mode run hit a package whose
+// build constraints exclude every file, so it must be retried per directory
+var errLintBuildConstraints = errors.New("build constraints exclude all Go files")
+
 // LintCheck runs golangci-lint directly or via build tools
 type LintCheck struct {
 	sharedCtx *shared.Context
 	config    *config.Config
 	timeout   time.Duration
 	buildTags []string
+
+	// outputFormat is the lintOutputFormat that golangci-lint last accepted;
+	// it falls back from JSON to text when the installed version rejects the flags
+	outputFormat atomic.Int32
 }
 
 // NewLintCheck creates a new lint check
@@ -74,6 +88,9 @@ func (c *LintCheck) Metadata() any {
 		DefaultTimeout:    c.timeout,
 		Category:          "linting",
 		RequiresFiles:     true,
+		Cacheable:         true,
+		CacheScope:        "module",
+		ConfigFiles:       []string{".golangci.yml", ".golangci.yaml", ".golangci.toml", ".golangci.json", LintBaselineFile},
 	}
 }
 
@@ -108,7 +125,8 @@ func (c *LintCheck) FilterFiles(files []string) []string {
 	return filtered
 }
 
-// runDirectLint runs golangci-lint directly on files
+// runDirectLint runs golangci-lint directly on files, suppressing issues
+// recorded in the lint baseline or regenerating it when requested
 func (c *LintCheck) runDirectLint(ctx context.Context, files []string) error {
 	// Tool installation is already handled in Run(), so we can proceed directly
 
@@ -117,64 +135,165 @@ func (c *LintCheck) runDirectLint(ctx context.Context, files []string) error {
 		return fmt.Errorf("failed to find repository root: %w", err)
 	}
 
-	// Group files by directory
-	filesByDir := make(map[string][]string)
-	for _, file := range files {
-		dir := filepath.Dir(file)
-		filesByDir[dir] = append(filesByDir[dir], file)
+	baseline, err := loadLintBaseline(repoRoot, shared.LintBaselineWriteRequested(ctx))
+	if err != nil {
+		return &prerrors.CheckError{
+			Err:        err,
+			Message:    err.Error(),
+			Suggestion: fmt.Sprintf("Fix or delete %s, or regenerate it with 'go-pre-commit run --write-baseline'", LintBaselineFile),
+		}
 	}
 
+	err = c.lintFiles(withLintBaseline(ctx, baseline), repoRoot, files)
+	if baseline.write {
+		if err != nil {
+			return err
+		}
+		return baseline.save()
+	}
+
+	var checkErr *prerrors.CheckError
+	if n := baseline.suppressedCount(); n > 0 && errors.As(err, &checkErr) && errors.Is(checkErr.Err, prerrors.ErrLintingIssues) {
+		checkErr.Message += suppressedNote(n)
+		checkErr.Output += suppressedNote(n)
+	}
+	return err
+}
+
+// lintFiles runs golangci-lint on the directories containing files. Above
+// the package threshold, a module's affected packages are linted in one run,
+// which is much faster than one run per directory for large changes.
+func (c *LintCheck) lintFiles(ctx context.Context, repoRoot string, files []string) error {
+	// Group files by package, reusing the mapping other Go checks computed
+	packages := c.sharedCtx.ChangedPackages(repoRoot, files)
+
 	// If all files are in the same directory, use the optimized single-directory path
-	if len(filesByDir) == 1 {
+	if len(packages) == 1 {
 		return c.runLintOnFiles(ctx, repoRoot, files)
 	}
 
+	dirs := make([]string, 0, len(packages))
+	for _, pkg := range packages {
+		dirs = append(dirs, pkg.Dir)
+	}
+
+	if threshold := c.packageThreshold(); threshold > 0 && len(files) > threshold {
+		return c.runLintOnPackages(ctx, repoRoot, files, dirs)
+	}
+
 	// For multiple directories, run golangci-lint on each directory
 	// This avoids the "named files must all be in one directory" error
-	var allErrors []string
-	var hasLintingIssues bool
-	var hasToolFailure bool
-
-	for dir := range filesByDir {
+	var failures lintFailures
+	for _, dir := range dirs {
 		// Run golangci-lint on the directory containing the files
-		err = c.runLintOnDirectory(ctx, repoRoot, dir)
-		if err != nil {
-			// Check if it's actual linting issues vs tool failure
-			var checkErr *prerrors.CheckError
-			if errors.As(err, &checkErr) && errors.Is(checkErr.Err, prerrors.ErrLintingIssues) {
-				hasLintingIssues = true
-				allErrors = append(allErrors, fmt.Sprintf("Directory %s:\n%s", dir, checkErr.Message))
-			} else {
-				hasToolFailure = true
-				allErrors = append(allErrors, fmt.Sprintf("Directory %s: %v", dir, err))
-			}
-		}
+		failures.add(dir, c.runLintOnDirectory(ctx, repoRoot, dir))
 	}
+	return failures.err()
+}
 
-	// If there were any errors, aggregate and return them
-	if len(allErrors) > 0 {
-		combinedErrors := strings.Join(allErrors, "\n\n")
+// packageThreshold returns the changed Go file count above which affected
+// packages are linted together, or 0 when package linting is disabled
+func (c *LintCheck) packageThreshold() int {
+	if c.config == nil {
+		return defaultLintPackageThreshold
+	}
+	return c.config.CheckBehaviors.LintPackageThreshold
+}
 
-		if hasLintingIssues && !hasToolFailure {
-			// All errors are linting issues
-			return &prerrors.CheckError{
-				Err:        prerrors.ErrLintingIssues,
-				Message:    combinedErrors,
-				Suggestion: "Fix the linting issues shown above. Run 'golangci-lint run' on each directory to see full details.",
-				Command:    "golangci-lint run",
-				Output:     combinedErrors,
+// runLintOnPackages lints the packages in dirs with one golangci-lint run per
+// module. Whole packages report issues in files outside the change, so only
+// issues in files are kept.
+func (c *LintCheck) runLintOnPackages(ctx context.Context, repoRoot string, files, dirs []string) error {
+	scope := make(map[string]bool, len(files))
+	for _, file := range files {
+		scope[repoRelativeFile(repoRoot, repoRoot, file)] = true
+	}
+
+	var runs []*lintRun
+	byModule := make(map[string]*lintRun)
+	for _, dir := range dirs {
+		workingDir, lintTarget, ok := c.resolveLintTarget(repoRoot, dir)
+		if !ok {
+			continue
+		}
+		run := byModule[workingDir]
+		if run == nil {
+			run = &lintRun{dir: repoRelativeFile(repoRoot, repoRoot, workingDir), workingDir: workingDir, scope: scope}
+			byModule[workingDir] = run
+			runs = append(runs, run)
+		}
+		run.targets = append(run.targets, lintTarget)
+		run.packageDirs = append(run.packageDirs, dir)
+	}
+
+	var failures lintFailures
+	for _, run := range runs {
+		err := c.execLint(ctx, repoRoot, *run)
+		if errors.Is(err, errLintBuildConstraints) {
+			// Build tags are detected per directory, so retry the module that way
+			for _, dir := range run.packageDirs {
+				failures.add(dir, c.runLintOnDirectory(ctx, repoRoot, dir))
 			}
+			continue
 		}
+		failures.add(run.dir, err)
+	}
+	return failures.err()
+}
 
-		// There were tool failures
-		return prerrors.NewToolExecutionError(
-			"golangci-lint run",
-			combinedErrors,
-			"Run 'golangci-lint run' manually on each directory to see detailed error output.",
-		)
+// lintFailures aggregates the errors of several golangci-lint runs
+type lintFailures struct {
+	messages    []string
+	diagnostics []output.Diagnostic
+	lintIssues  bool
+	blocking    bool // some lint issues are errors rather than advisory
+	toolFailure bool
+}
+
+// add records the result of linting dir
+func (f *lintFailures) add(dir string, err error) {
+	if err == nil {
+		return
 	}
+	// Check if it's actual linting issues vs tool failure
+	var checkErr *prerrors.CheckError
+	if errors.As(err, &checkErr) && errors.Is(checkErr.Err, prerrors.ErrLintingIssues) {
+		f.lintIssues = true
+		f.blocking = f.blocking || !checkErr.WarnOnly
+		f.diagnostics = append(f.diagnostics, checkErr.Diagnostics...)
+		f.messages = append(f.messages, fmt.Sprintf("Directory %s:\n%s", dir, checkErr.Message))
+		return
+	}
+	f.toolFailure = true
+	f.messages = append(f.messages, fmt.Sprintf("Directory %s: %v", dir, err))
+}
 
-	return nil
+// err returns the combined error, or nil when every run passed
+func (f *lintFailures) err() error {
+	if len(f.messages) == 0 {
+		return nil
+	}
+	combinedErrors := strings.Join(f.messages, "\n\n")
+
+	if f.lintIssues && !f.toolFailure {
+		// All errors are linting issues
+		return &prerrors.CheckError{
+			Err:         prerrors.ErrLintingIssues,
+			Message:     combinedErrors,
+			Suggestion:  "Fix the linting issues shown above. Run 'golangci-lint run' on each directory to see full details.",
+			Command:     "golangci-lint run",
+			Output:      combinedErrors,
+			WarnOnly:    !f.blocking,
+			Diagnostics: f.diagnostics,
+		}
+	}
+
+	// There were tool failures
+	return prerrors.NewToolExecutionError(
+		"golangci-lint run",
+		combinedErrors,
+		"Run 'golangci-lint run' manually on each directory to see detailed error output.",
+	)
 }
 
 // runLintOnFiles runs golangci-lint on the directory containing the files (all in the same directory)
@@ -188,18 +307,21 @@ func (c *LintCheck) runLintOnFiles(ctx context.Context, repoRoot string, files [
 
 // runLintOnDirectory runs golangci-lint on a specific directory
 func (c *LintCheck) runLintOnDirectory(ctx context.Context, repoRoot, dir string) error {
-	// Build golangci-lint command arguments
-	args := []string{"run", "--new-from-rev=HEAD~1"}
-
-	// Add build tags if configured
-	if len(c.buildTags) > 0 {
-		args = append(args, "--build-tags", strings.Join(c.buildTags, ","))
+	workingDir, lintTarget, ok := c.resolveLintTarget(repoRoot, dir)
+	if !ok {
+		return nil
 	}
+	return c.execLint(ctx, repoRoot, lintRun{dir: dir, workingDir: workingDir, targets: []string{lintTarget}})
+}
 
+// resolveLintTarget returns the directory to run golangci-lint from and the
+// package pattern for dir. It reports false when dir is outside any Go module,
+// since such files cannot be linted.
+func (c *LintCheck) resolveLintTarget(repoRoot, dir string) (workingDir, lintTarget string, ok bool) {
 	// Determine the target directory and working directory
 	targetDir := filepath.Join(repoRoot, dir)
-	workingDir := repoRoot
-	lintTarget := targetDir
+	workingDir = repoRoot
+	lintTarget = targetDir
 
 	// Check if the target directory contains a Go module
 	if isGoModule(targetDir) {
@@ -237,23 +359,52 @@ func (c *LintCheck) runLintOnDirectory(ctx context.Context, repoRoot, dir string
 						lintTarget = "./" + relPath
 					} else {
 						// Target is outside the configured module - skip linting
-						return nil
+						return "", "", false
 					}
 				} else {
 					// No valid Go module found - skip linting
-					return nil
+					return "", "", false
 				}
 			} else {
 				// No config and no module found - skip linting
 				// Go files outside of modules can't be properly linted by golangci-lint
-				return nil
+				return "", "", false
 			}
 		}
 	}
 
+	return workingDir, lintTarget, true
+}
+
+// lintRun describes one golangci-lint invocation
+type lintRun struct {
+	dir         string // repository-relative directory named in messages
+	workingDir  string
+	targets     []string
+	packageDirs []string        // directories linted together in package mode
+	scope       map[string]bool // repository-relative files to report issues for; nil reports all
+}
+
+// execLint runs golangci-lint for run and converts its result into a check error
+func (c *LintCheck) execLint(ctx context.Context, repoRoot string, run lintRun) error {
+	dir, workingDir := run.dir, run.workingDir
+
+	// Build golangci-lint command arguments. Regenerating the baseline needs
+	// every existing issue, not just those introduced by the last commit.
+	baseline := lintBaselineFrom(ctx)
+	args := []string{"run"}
+	if baseline == nil || !baseline.write {
+		args = append(args, "--new-from-rev=HEAD~1")
+	}
+
+	// Add build tags if configured
+	if len(c.buildTags) > 0 {
+		args = append(args, "--build-tags", strings.Join(c.buildTags, ","))
+	}
+
 	// Ensure golangci-lint is installed only after confirming we need to run it.
 	// This keeps orphaned-file skips (above) free of any install attempt.
-	if err := tools.EnsureInstalled(ctx, "golangci-lint"); err != nil {
+	if err := ensureTool(ctx, c.sharedCtx, "golangci-lint"); err != nil {
 		return prerrors.NewToolNotFoundError(
 			"golangci-lint",
 			"Install golangci-lint manually: go install github.com/golangci/golangci-lint/cmd/golangci-lint@latest",
@@ -264,16 +415,48 @@ func (c *LintCheck) runLintOnDirectory(ctx context.Context, repoRoot, dir string
 	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
-	args = append(args, lintTarget)
-
-	cmd := exec.CommandContext(ctx, "golangci-lint", args...)
-	cmd.Dir = workingDir
+	// In workspace mode, type-check modules the workspace uses against it and
+	// the rest on their own
+	var env []string
+	if workspace := configuredWorkspace(c.config, repoRoot); workspace != nil {
+		env = append(os.Environ(), workspace.env(workingDir))
+	}
 
+	// Ask for the structured JSON report, falling back to older flags and
+	// finally to text output when the installed golangci-lint rejects them
 	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-
-	if err := cmd.Run(); err != nil {
+	var err error
+	for {
+		format := lintOutputFormat(c.outputFormat.Load())
+		runArgs := slices.Concat(args, format.args(), run.targets)
+		err = runAfterInstall(ctx, c.sharedCtx, "golangci-lint", func() error {
+			stdout.Reset()
+			stderr.Reset()
+
+			cmd := exec.CommandContext(ctx, "golangci-lint", runArgs...) //nolint:gosec // Command arguments are validated
+			cmd.Dir = workingDir
+			cmd.Env = env
+			shared.LogCommand(ctx, cmd)
+			cmd.Stdout = &stdout
+			cmd.Stderr = &stderr
+			return cmd.Run()
+		})
+		if err == nil || !isUnknownFlagOutput(stdout.String()+stderr.String()) {
+			break
+		}
+		next, ok := format.fallback()
+		if !ok {
+			break
+		}
+		c.outputFormat.Store(int32(next))
+	}
+	if errors.Is(err, exec.ErrNotFound) {
+		return prerrors.NewToolNotFoundError(
+			"golangci-lint",
+			"Install golangci-lint manually: go install github.com/golangci/golangci-lint/cmd/golangci-lint@latest",
+		)
+	}
+	if err != nil {
 		output := stdout.String() + stderr.String()
 
 		// Check if it's a context timeout
@@ -287,9 +470,32 @@ func (c *LintCheck) runLintOnDirectory(ctx context.Context, repoRoot, dir string
 
 		// Check if it's build constraints issue
 		if strings.Contains(output, "build constraints exclude all Go files") {
+			if run.packageDirs != nil {
+				return errLintBuildConstraints
+			}
 			return c.handleBuildConstraintsError(ctx, repoRoot, dir, output)
 		}
 
+		// A JSON report with issues is unambiguous, so check it before
+		// matching keywords in the raw output
+		if issues, ok := parseLintJSON(stdout.String()); ok && len(issues) > 0 {
+			if run.scope != nil {
+				if issues = scopeLintIssues(issues, repoRoot, workingDir, run.scope); len(issues) == 0 {
+					return nil
+				}
+			}
+			if baseline != nil {
+				if issues = baseline.filter(workingDir, issues); len(issues) == 0 {
+					return nil
+				}
+			}
+			issuesErr := lintIssuesError(dir, formatLintIssues(c.labelLintSeverity(issues)))
+			issuesErr.Diagnostics = c.lintDiagnostics(repoRoot, workingDir, issues)
+			// Findings mapped to warning or info are reported without blocking
+			issuesErr.WarnOnly = !c.hasBlockingIssue(issues)
+			return issuesErr
+		}
+
 		// Check if it's configuration issues
 		if strings.Contains(output, "config") && (strings.Contains(output, "error") || strings.Contains(output, "failed")) {
 			return prerrors.NewToolExecutionError(
@@ -300,18 +506,8 @@ func (c *LintCheck) runLintOnDirectory(ctx context.Context, repoRoot, dir string
 		}
 
 		// Check if it's actual linting issues vs tool failure
-		if strings.Contains(output, ".go:") && strings.Contains(output, ":") {
-			// This looks like linting issues, not a tool failure
-			// Extract and format specific lint errors for better visibility
-			formattedOutput := FormatLintErrors(output)
-			// For lint errors, return the formatted output as the error message
-			return &prerrors.CheckError{
-				Err:        prerrors.ErrLintingIssues,
-				Message:    formattedOutput,
-				Suggestion: fmt.Sprintf("Fix the linting issues shown above. Run 'golangci-lint run %s' to see full details.", dir),
-				Command:    fmt.Sprintf("golangci-lint run %s", dir),
-				Output:     formattedOutput,
-			}
+		if formattedOutput, ok := lintIssuesFromOutput(output); ok {
+			return lintIssuesError(dir, formattedOutput)
 		}
 
 		// Generic failure
@@ -325,6 +521,41 @@ func (c *LintCheck) runLintOnDirectory(ctx context.Context, repoRoot, dir string
 	return nil
 }
 
+// scopeLintIssues keeps the issues reported in files within scope
+func scopeLintIssues(issues []lintIssue, repoRoot, workingDir string, scope map[string]bool) []lintIssue {
+	var scoped []lintIssue
+	for _, issue := range issues {
+		if scope[repoRelativeFile(repoRoot, workingDir, issue.Pos.Filename)] {
+			scoped = append(scoped, issue)
+		}
+	}
+	return scoped
+}
+
+// repoRelativeFile converts a file name reported relative to workingDir into
+// a slash-separated path relative to the repository root
+func repoRelativeFile(repoRoot, workingDir, file string) string {
+	if !filepath.IsAbs(file) {
+		file = filepath.Join(workingDir, file)
+	}
+	if rel, err := filepath.Rel(repoRoot, file); err == nil {
+		file = rel
+	}
+	return filepath.ToSlash(file)
+}
+
+// lintIssuesError reports formatted lint issues found in dir
+func lintIssuesError(dir, formattedOutput string) *prerrors.CheckError {
+	// For lint errors, return the formatted output as the error message
+	return &prerrors.CheckError{
+		Err:        prerrors.ErrLintingIssues,
+		Message:    formattedOutput,
+		Suggestion: fmt.Sprintf("Fix the linting issues shown above. Run 'golangci-lint run %s' to see full details.", dir),
+		Command:    fmt.Sprintf("golangci-lint run %s", dir),
+		Output:     formattedOutput,
+	}
+}
+
 // runGolangciLintRetry runs golangci-lint for the build-constraints retry path
 // and returns the combined stdout+stderr together with the run error. It is a
 // package-level variable so tests can exercise the retry success / linting-issues
@@ -334,6 +565,7 @@ func (c *LintCheck) runLintOnDirectory(ctx context.Context, repoRoot, dir string
 var runGolangciLintRetry = func(ctx context.Context, dir string, args ...string) (string, error) {
 	cmd := exec.CommandContext(ctx, "golangci-lint", args...) //nolint:gosec // Command arguments are validated
 	cmd.Dir = dir
+	shared.LogCommand(ctx, cmd)
 
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
@@ -367,8 +599,9 @@ func (c *LintCheck) handleBuildConstraintsError(ctx context.Context, repoRoot, d
 	}
 
 	// Retry with detected build tags
-	retryArgs := make([]string, 0, 5) //nolint:mnd // Fixed capacity for known args
+	retryArgs := make([]string, 0, 6) //nolint:mnd // Fixed capacity for known args
 	retryArgs = append(retryArgs, "run", "--new-from-rev=HEAD~1", "--build-tags", strings.Join(buildTags, ","))
+	retryArgs = append(retryArgs, lintOutputFormat(c.outputFormat.Load()).args()...)
 	retryArgs = append(retryArgs, filepath.Join(repoRoot, dir))
 
 	retryOutput, retryErr := runGolangciLintRetry(ctx, repoRoot, retryArgs...)
@@ -378,14 +611,15 @@ func (c *LintCheck) handleBuildConstraintsError(ctx context.Context, repoRoot, d
 	}
 
 	// Check if the retry attempt shows linting issues (success case)
-	if strings.Contains(retryOutput, ".go:") && strings.Contains(retryOutput, ":") {
-		formattedOutput := FormatLintErrors(retryOutput)
+	if formattedOutput, ok := lintIssuesFromOutput(retryOutput); ok {
+		diagnostics, _ := output.ParseGolangciLintJSON(retryOutput)
 		return &prerrors.CheckError{
-			Err:        prerrors.ErrLintingIssues,
-			Message:    formattedOutput,
-			Suggestion: fmt.Sprintf("Fix the linting issues shown above. Run 'golangci-lint run --build-tags %s %s' to see full details.", strings.Join(buildTags, ","), dir),
-			Command:    fmt.Sprintf("golangci-lint run --build-tags %s %s", strings.Join(buildTags, ","), dir),
-			Output:     formattedOutput,
+			Err:         prerrors.ErrLintingIssues,
+			Message:     formattedOutput,
+			Suggestion:  fmt.Sprintf("Fix the linting issues shown above. Run 'golangci-lint run --build-tags %s %s' to see full details.", strings.Join(buildTags, ","), dir),
+			Command:     fmt.Sprintf("golangci-lint run --build-tags %s %s", strings.Join(buildTags, ","), dir),
+			Output:      formattedOutput,
+			Diagnostics: diagnostics,
 		}
 	}
 
@@ -0,0 +1,160 @@
+package gotools
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+)
+
+// LintBaselineFile is the repository-relative file recording lint issues
+// that existed before lint was adopted. Issues listed there are suppressed.
+const LintBaselineFile = ".go-pre-commit-baseline.json"
+
+// lintBaselineEntry identifies one grandfathered issue by rule, file, and line.
+// Text is kept for readers of the file and is not used for matching.
+type lintBaselineEntry struct {
+	Rule string `json:"rule"`
+	File string `json:"file"` // slash-separated, relative to the repository root
+	Line int    `json:"line"`
+	Text string `json:"text,omitempty"`
+}
+
+// key returns the fields that identify the entry
+func (e lintBaselineEntry) key() lintBaselineEntry {
+	return lintBaselineEntry{Rule: e.Rule, File: e.File, Line: e.Line}
+}
+
+// lintBaselineDocument is the on-disk format of LintBaselineFile
+type lintBaselineDocument struct {
+	Issues []lintBaselineEntry `json:"issues"`
+}
+
+// lintBaseline holds the baseline for a single lint run. In write mode every
+// issue is recorded rather than reported.
+type lintBaseline struct {
+	repoRoot string
+	known    map[lintBaselineEntry]bool
+	write    bool
+
+	mu         sync.Mutex
+	recorded   []lintBaselineEntry
+	suppressed int
+}
+
+// lintBaselineKey is the context key for the active lintBaseline
+type lintBaselineKey struct{}
+
+// withLintBaseline returns a context carrying baseline for runLintOnDirectory
+func withLintBaseline(ctx context.Context, baseline *lintBaseline) context.Context {
+	return context.WithValue(ctx, lintBaselineKey{}, baseline)
+}
+
+// lintBaselineFrom returns the baseline carried by ctx, or nil
+func lintBaselineFrom(ctx context.Context) *lintBaseline {
+	baseline, _ := ctx.Value(lintBaselineKey{}).(*lintBaseline)
+	return baseline
+}
+
+// loadLintBaseline reads LintBaselineFile from repoRoot. A missing file
+// yields an empty baseline that suppresses nothing.
+func loadLintBaseline(repoRoot string, write bool) (*lintBaseline, error) {
+	baseline := &lintBaseline{repoRoot: repoRoot, known: make(map[lintBaselineEntry]bool), write: write}
+	if write {
+		return baseline, nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(repoRoot, LintBaselineFile)) //nolint:gosec // fixed file name in the repository root
+	if errors.Is(err, os.ErrNotExist) {
+		return baseline, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", LintBaselineFile, err)
+	}
+
+	var doc lintBaselineDocument
+	if err = json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", LintBaselineFile, err)
+	}
+	for _, entry := range doc.Issues {
+		baseline.known[entry.key()] = true
+	}
+	return baseline, nil
+}
+
+// filter returns the issues not covered by the baseline. workingDir is the
+// directory golangci-lint ran in, which its file names are relative to. In
+// write mode every issue is recorded and none are returned.
+func (b *lintBaseline) filter(workingDir string, issues []lintIssue) []lintIssue {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var remaining []lintIssue
+	for _, issue := range issues {
+		entry := lintBaselineEntry{
+			Rule: issue.FromLinter,
+			File: repoRelativeFile(b.repoRoot, workingDir, issue.Pos.Filename),
+			Line: issue.Pos.Line,
+			Text: issue.Text,
+		}
+
+		switch {
+		case b.write:
+			b.recorded = append(b.recorded, entry)
+		case b.known[entry.key()]:
+			b.suppressed++
+		default:
+			remaining = append(remaining, issue)
+		}
+	}
+	return remaining
+}
+
+// suppressedCount returns how many issues the baseline has hidden so far
+func (b *lintBaseline) suppressedCount() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.suppressed
+}
+
+// save writes the recorded issues to LintBaselineFile, sorted and without
+// duplicates so regenerating an unchanged baseline produces no diff
+func (b *lintBaseline) save() error {
+	b.mu.Lock()
+	entries := slices.Clone(b.recorded)
+	b.mu.Unlock()
+
+	slices.SortFunc(entries, func(x, y lintBaselineEntry) int {
+		return cmp.Or(cmp.Compare(x.File, y.File), cmp.Compare(x.Line, y.Line), cmp.Compare(x.Rule, y.Rule), cmp.Compare(x.Text, y.Text))
+	})
+	entries = slices.CompactFunc(entries, func(x, y lintBaselineEntry) bool {
+		return x.key() == y.key()
+	})
+	if entries == nil {
+		entries = []lintBaselineEntry{}
+	}
+
+	data, err := json.MarshalIndent(lintBaselineDocument{Issues: entries}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode lint baseline: %w", err)
+	}
+	data = append(data, '\n')
+
+	if err = os.WriteFile(filepath.Join(b.repoRoot, LintBaselineFile), data, 0o644); err != nil { //nolint:gosec // the baseline is committed alongside the code
+		return fmt.Errorf("failed to write %s: %w", LintBaselineFile, err)
+	}
+	return nil
+}
+
+// suppressedNote tells the user how many known issues were hidden
+func suppressedNote(count int) string {
+	if count == 0 {
+		return ""
+	}
+	return fmt.Sprintf("\n\n%d known issue(s) suppressed by %s", count, LintBaselineFile)
+}
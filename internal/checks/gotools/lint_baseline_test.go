@@ -0,0 +1,184 @@
+package gotools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// newLintIssue builds a lintIssue for baseline tests
+func newLintIssue(rule, file string, line int) lintIssue {
+	issue := lintIssue{FromLinter: rule, Text: rule + " issue"}
+	issue.Pos.Filename = file
+	issue.Pos.Line = line
+	return issue
+}
+
+// writeBaseline stores entries as the repository's lint baseline
+func writeBaseline(t *testing.T, repoRoot string, entries ...lintBaselineEntry) {
+	t.Helper()
+	data, err := json.Marshal(lintBaselineDocument{Issues: entries})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(repoRoot, LintBaselineFile), data, 0o600))
+}
+
+func TestLoadLintBaseline_Missing(t *testing.T) {
+	baseline, err := loadLintBaseline(t.TempDir(), false)
+	require.NoError(t, err)
+
+	issues := []lintIssue{newLintIssue("errcheck", "main.go", 3)}
+	assert.Equal(t, issues, baseline.filter(baseline.repoRoot, issues))
+	assert.Zero(t, baseline.suppressedCount())
+}
+
+func TestLoadLintBaseline_Invalid(t *testing.T) {
+	repoRoot := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(repoRoot, LintBaselineFile), []byte("{not json"), 0o600))
+
+	_, err := loadLintBaseline(repoRoot, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), LintBaselineFile)
+}
+
+func TestLintBaseline_FilterSuppressesKnownIssues(t *testing.T) {
+	repoRoot := t.TempDir()
+	writeBaseline(t, repoRoot,
+		lintBaselineEntry{Rule: "errcheck", File: "pkg/a.go", Line: 10, Text: "old wording"},
+		lintBaselineEntry{Rule: "unused", File: "pkg/b.go", Line: 4},
+	)
+
+	baseline, err := loadLintBaseline(repoRoot, false)
+	require.NoError(t, err)
+
+	// golangci-lint ran inside pkg/, so its file names are relative to it
+	known := newLintIssue("errcheck", "a.go", 10)
+	movedLine := newLintIssue("errcheck", "a.go", 11)
+	otherRule := newLintIssue("govet", "a.go", 10)
+
+	remaining := baseline.filter(filepath.Join(repoRoot, "pkg"), []lintIssue{known, movedLine, otherRule})
+	assert.Equal(t, []lintIssue{movedLine, otherRule}, remaining)
+	assert.Equal(t, 1, baseline.suppressedCount())
+}
+
+func TestLintBaseline_WriteRecordsSortedUniqueIssues(t *testing.T) {
+	repoRoot := t.TempDir()
+	baseline, err := loadLintBaseline(repoRoot, true)
+	require.NoError(t, err)
+
+	remaining := baseline.filter(repoRoot, []lintIssue{
+		newLintIssue("unused", "z.go", 1),
+		newLintIssue("errcheck", "a.go", 9),
+		newLintIssue("errcheck", "a.go", 9),
+	})
+	assert.Empty(t, remaining)
+	require.NoError(t, baseline.save())
+
+	data, err := os.ReadFile(filepath.Join(repoRoot, LintBaselineFile)) //nolint:gosec // test file in temp dir
+	require.NoError(t, err)
+
+	var doc lintBaselineDocument
+	require.NoError(t, json.Unmarshal(data, &doc))
+	assert.Equal(t, []lintBaselineEntry{
+		{Rule: "errcheck", File: "a.go", Line: 9, Text: "errcheck issue"},
+		{Rule: "unused", File: "z.go", Line: 1, Text: "unused issue"},
+	}, doc.Issues)
+}
+
+func TestLintBaseline_WriteEmpty(t *testing.T) {
+	repoRoot := t.TempDir()
+	baseline, err := loadLintBaseline(repoRoot, true)
+	require.NoError(t, err)
+	require.NoError(t, baseline.save())
+
+	data, err := os.ReadFile(filepath.Join(repoRoot, LintBaselineFile)) //nolint:gosec // test file in temp dir
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"issues": []}`, string(data))
+}
+
+// setupFakeLintRepo creates a module in a git repository, switches into
+// it, and installs a fake golangci-lint that prints the v2 fixture report and
+// appends the arguments of each invocation as a line of the returned file
+func setupFakeLintRepo(t *testing.T) (string, string) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script as the fake golangci-lint")
+	}
+
+	binDir := t.TempDir()
+	argsFile := filepath.Join(binDir, "args")
+	script := "#!/bin/sh\n" +
+		"echo \"$@\" >> \"" + argsFile + "\"\n" +
+		"cat \"" + filepath.Join(binDir, "report.json") + "\"\n" +
+		"exit 1\n"
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "golangci-lint"), []byte(script), 0o755)) //nolint:gosec // executable test fixture
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "report.json"), []byte(readFixture(t, "golangci_v2.json")), 0o600))
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	withInstaller(t, func(context.Context, string) error { return nil })
+
+	repoRoot := t.TempDir()
+	cmd := exec.CommandContext(context.Background(), "git", "init", "-q")
+	cmd.Dir = repoRoot
+	require.NoError(t, cmd.Run())
+	require.NoError(t, os.WriteFile(filepath.Join(repoRoot, "go.mod"), []byte("module example.com/m\n\ngo 1.24\n"), 0o600))
+	t.Chdir(repoRoot)
+
+	return repoRoot, argsFile
+}
+
+func TestLintCheck_BaselineSuppressesAllKnownIssues(t *testing.T) {
+	repoRoot, _ := setupFakeLintRepo(t)
+	writeBaseline(t, repoRoot,
+		lintBaselineEntry{Rule: "errcheck", File: "internal/git/files.go", Line: 89},
+		lintBaselineEntry{Rule: "ineffassign", File: "internal/runner/runner.go", Line: 142},
+	)
+
+	check := NewLintCheckWithSharedContext(shared.NewContext())
+	require.NoError(t, check.runDirectLint(context.Background(), []string{"main.go"}))
+}
+
+func TestLintCheck_BaselineFailsOnNewIssue(t *testing.T) {
+	repoRoot, _ := setupFakeLintRepo(t)
+	writeBaseline(t, repoRoot,
+		lintBaselineEntry{Rule: "errcheck", File: "internal/git/files.go", Line: 89},
+	)
+
+	check := NewLintCheckWithSharedContext(shared.NewContext())
+	err := check.runDirectLint(context.Background(), []string{"main.go"})
+	require.ErrorIs(t, err, prerrors.ErrLintingIssues)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Contains(t, checkErr.Output, "Found 1 linting issue(s)")
+	assert.Contains(t, checkErr.Output, "internal/runner/runner.go:142:2: ineffectual assignment to err (ineffassign)")
+	assert.NotContains(t, checkErr.Output, "internal/git/files.go")
+	assert.Contains(t, checkErr.Message, "2 known issue(s) suppressed")
+}
+
+func TestLintCheck_WriteBaseline(t *testing.T) {
+	repoRoot, argsFile := setupFakeLintRepo(t)
+
+	check := NewLintCheckWithSharedContext(shared.NewContext())
+	ctx := shared.WithLintBaselineWrite(context.Background())
+	require.NoError(t, check.runDirectLint(ctx, []string{"main.go"}))
+
+	args, err := os.ReadFile(argsFile) //nolint:gosec // test file in temp dir
+	require.NoError(t, err)
+	assert.NotContains(t, string(args), "--new-from-rev", "the baseline records every existing issue")
+
+	baseline, err := loadLintBaseline(repoRoot, false)
+	require.NoError(t, err)
+	assert.Len(t, baseline.known, 2)
+
+	// The regenerated baseline now suppresses everything
+	require.NoError(t, check.runDirectLint(context.Background(), []string{"main.go"}))
+}
@@ -0,0 +1,143 @@
+package gotools
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/mrz1836/go-pre-commit/internal/output"
+)
+
+// lintOutputFormat selects how golangci-lint is asked to report issues
+type lintOutputFormat int32
+
+const (
+	lintFormatJSONv2 lintOutputFormat = iota // --output.json.path=stdout (golangci-lint v2)
+	lintFormatJSONv1                         // --out-format=json (golangci-lint v1)
+	lintFormatText                           // default text output, parsed by FormatLintErrors
+)
+
+// args returns the golangci-lint flags that select the format
+func (f lintOutputFormat) args() []string {
+	switch f {
+	case lintFormatJSONv2:
+		return []string{"--output.json.path=stdout"}
+	case lintFormatJSONv1:
+		return []string{"--out-format=json"}
+	default:
+		return nil
+	}
+}
+
+// fallback returns the next format to try when golangci-lint rejects the
+// flags of f, and false once plain text is reached
+func (f lintOutputFormat) fallback() (lintOutputFormat, bool) {
+	if f >= lintFormatText {
+		return lintFormatText, false
+	}
+	return f + 1, true
+}
+
+// isUnknownFlagOutput reports whether golangci-lint rejected a command line flag
+func isUnknownFlagOutput(output string) bool {
+	return strings.Contains(output, "unknown flag")
+}
+
+// lintIssue is a single issue from golangci-lint's JSON report
+type lintIssue struct {
+	FromLinter string `json:"FromLinter"`
+	Text       string `json:"Text"`
+	Pos        struct {
+		Filename string `json:"Filename"`
+		Line     int    `json:"Line"`
+		Column   int    `json:"Column"`
+	} `json:"Pos"`
+}
+
+// lintReport is the subset of golangci-lint's JSON output used here
+type lintReport struct {
+	Issues []lintIssue `json:"Issues"`
+}
+
+// parseLintJSON extracts issues from golangci-lint JSON output. Other output
+// (for example a text printer enabled in the project config) may surround the
+// JSON object. It returns false when no JSON report is found.
+func parseLintJSON(output string) ([]lintIssue, bool) {
+	for start := strings.Index(output, `{"Issues"`); start >= 0; {
+		var report lintReport
+		if err := json.NewDecoder(strings.NewReader(output[start:])).Decode(&report); err == nil {
+			return report.Issues, true
+		}
+
+		next := strings.Index(output[start+1:], `{"Issues"`)
+		if next < 0 {
+			break
+		}
+		start += next + 1
+	}
+	return nil, false
+}
+
+// formatLintIssues renders issues as "file:line:col: message (linter)" lines
+// under the same header FormatLintErrors uses for text output
+func formatLintIssues(issues []lintIssue) string {
+	var result strings.Builder
+	seen := make(map[string]bool, len(issues))
+	count := 0
+
+	for _, issue := range issues {
+		line := fmt.Sprintf("%s:%d:%d: %s (%s)", issue.Pos.Filename, issue.Pos.Line, issue.Pos.Column, issue.Text, issue.FromLinter)
+		if seen[line] {
+			continue
+		}
+		seen[line] = true
+
+		if count > 0 {
+			result.WriteString("\n")
+		}
+		result.WriteString(line)
+		count++
+	}
+
+	return fmt.Sprintf("Found %d linting issue(s):\n", count) + result.String()
+}
+
+// lintDiagnostics converts issues reported relative to workingDir into
+// diagnostics with repository-relative paths and each linter's configured
+// severity, dropping duplicates
+func (c *LintCheck) lintDiagnostics(repoRoot, workingDir string, issues []lintIssue) []output.Diagnostic {
+	diagnostics := make([]output.Diagnostic, 0, len(issues))
+	seen := make(map[output.Diagnostic]bool, len(issues))
+	for _, issue := range issues {
+		diagnostic := output.Diagnostic{
+			File:     repoRelativeFile(repoRoot, workingDir, issue.Pos.Filename),
+			Line:     issue.Pos.Line,
+			Col:      issue.Pos.Column,
+			Severity: c.lintSeverity(issue.FromLinter),
+			Rule:     issue.FromLinter,
+			Message:  issue.Text,
+		}
+		if !seen[diagnostic] {
+			seen[diagnostic] = true
+			diagnostics = append(diagnostics, diagnostic)
+		}
+	}
+	return diagnostics
+}
+
+// lintIssuesFromOutput returns formatted lint issues from golangci-lint
+// output, preferring the structured JSON report and falling back to text
+// parsing. It returns false when the output does not describe lint issues.
+func lintIssuesFromOutput(output string) (string, bool) {
+	if issues, ok := parseLintJSON(output); ok {
+		if len(issues) == 0 {
+			return "", false
+		}
+		return formatLintIssues(issues), true
+	}
+
+	if strings.Contains(output, ".go:") && strings.Contains(output, ":") {
+		return FormatLintErrors(output), true
+	}
+	return "", false
+}
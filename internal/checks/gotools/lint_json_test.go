@@ -0,0 +1,138 @@
+package gotools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+// readFixture returns a recorded golangci-lint output from testdata
+func readFixture(t *testing.T, name string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name)) //nolint:gosec // test fixture
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestParseLintJSON_Fixtures(t *testing.T) {
+	tests := []struct {
+		fixture string
+		want    string
+	}{
+		{
+			fixture: "golangci_v2.json",
+			want: "Found 2 linting issue(s):\n" +
+				"internal/git/files.go:89:11: Error return value of `os.Remove` is not checked (errcheck)\n" +
+				"internal/runner/runner.go:142:2: ineffectual assignment to err (ineffassign)",
+		},
+		{
+			fixture: "golangci_v1.json",
+			want: "Found 1 linting issue(s):\n" +
+				"cmd/main.go:21:5: S1002: should omit comparison to bool constant, can be simplified to `ok` (gosimple)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			issues, ok := parseLintJSON(readFixture(t, tt.fixture))
+			require.True(t, ok)
+			assert.Equal(t, tt.want, formatLintIssues(issues))
+		})
+	}
+}
+
+func TestParseLintJSON_CleanAndMissing(t *testing.T) {
+	issues, ok := parseLintJSON(readFixture(t, "golangci_clean.json"))
+	assert.True(t, ok)
+	assert.Empty(t, issues)
+
+	_, ok = parseLintJSON("internal/git/files.go:89:11: ineffectual assignment to err (ineffassign)")
+	assert.False(t, ok)
+
+	_, ok = parseLintJSON(`{"Issues": [`)
+	assert.False(t, ok, "truncated JSON is not a report")
+}
+
+func TestParseLintJSON_SurroundedByTextOutput(t *testing.T) {
+	// A project config can keep the text printer enabled alongside JSON
+	output := "level=warning msg=\"[config_reader] deprecated option\"\n" +
+		"cmd/main.go:21:5: S1002: should omit comparison (gosimple)\n" +
+		readFixture(t, "golangci_v1.json") +
+		"1 issues:\n* gosimple: 1\n"
+
+	issues, ok := parseLintJSON(output)
+	require.True(t, ok)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "cmd/main.go", issues[0].Pos.Filename)
+}
+
+func TestLintIssuesFromOutput(t *testing.T) {
+	formatted, ok := lintIssuesFromOutput(readFixture(t, "golangci_v2.json"))
+	require.True(t, ok)
+	assert.Contains(t, formatted, "Found 2 linting issue(s)")
+
+	_, ok = lintIssuesFromOutput(readFixture(t, "golangci_clean.json"))
+	assert.False(t, ok, "a clean report is not a lint failure")
+
+	// Text output is still understood
+	formatted, ok = lintIssuesFromOutput("main.go:10:2: ineffectual assignment to err (ineffassign)\n")
+	require.True(t, ok)
+	assert.Equal(t, "Found 1 linting issue(s):\nmain.go:10:2: ineffectual assignment to err (ineffassign)", formatted)
+
+	_, ok = lintIssuesFromOutput("Error: context loading failed")
+	assert.False(t, ok)
+}
+
+func TestLintOutputFormat_Fallback(t *testing.T) {
+	next, ok := lintFormatJSONv2.fallback()
+	assert.True(t, ok)
+	assert.Equal(t, lintFormatJSONv1, next)
+
+	next, ok = next.fallback()
+	assert.True(t, ok)
+	assert.Equal(t, lintFormatText, next)
+
+	_, ok = next.fallback()
+	assert.False(t, ok)
+	assert.Empty(t, lintFormatText.args())
+}
+
+func TestRunLintOnDirectory_FallsBackToV1JSON(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script as the fake golangci-lint")
+	}
+
+	// A fake golangci-lint v1: rejects the v2 flag and prints a recorded report
+	binDir := t.TempDir()
+	script := "#!/bin/sh\n" +
+		"for arg in \"$@\"; do\n" +
+		"  case \"$arg\" in\n" +
+		"    --output.json.path=*) echo 'Error: unknown flag: --output.json.path' >&2; exit 3 ;;\n" +
+		"    --out-format=json) cat \"" + filepath.Join(binDir, "report.json") + "\"; exit 1 ;;\n" +
+		"  esac\n" +
+		"done\n" +
+		"exit 2\n"
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "golangci-lint"), []byte(script), 0o755)) //nolint:gosec // executable test fixture
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "report.json"), []byte(readFixture(t, "golangci_v1.json")), 0o600))
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	withInstaller(t, func(context.Context, string) error { return nil })
+
+	repoRoot := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(repoRoot, "go.mod"), []byte("module example.com/m\n\ngo 1.24\n"), 0o600))
+
+	check := NewLintCheck()
+	err := check.runLintOnDirectory(context.Background(), repoRoot, ".")
+	require.ErrorIs(t, err, prerrors.ErrLintingIssues)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Contains(t, checkErr.Output, "cmd/main.go:21:5: S1002")
+	assert.Equal(t, lintFormatJSONv1, lintOutputFormat(check.outputFormat.Load()), "the accepted format is remembered")
+}
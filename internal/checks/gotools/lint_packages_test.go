@@ -0,0 +1,92 @@
+package gotools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// packageLintFiles are changed files in three packages. The v2 fixture
+// reports issues in internal/git/files.go and internal/runner/runner.go, so
+// only the first is part of the change.
+func packageLintFiles(t *testing.T, repoRoot string) []string {
+	t.Helper()
+	files := []string{"internal/git/files.go", "internal/runner/other.go", "cmd/main.go"}
+	for _, file := range files {
+		require.NoError(t, os.MkdirAll(filepath.Join(repoRoot, filepath.Dir(file)), 0o750))
+	}
+	return files
+}
+
+// newPackageLintCheck returns a lint check with the given package threshold
+func newPackageLintCheck(threshold int) *LintCheck {
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.LintPackageThreshold = threshold
+	return NewLintCheckWithConfig(shared.NewContext(), cfg, time.Minute)
+}
+
+// lintInvocations returns the arguments of each recorded golangci-lint run
+func lintInvocations(t *testing.T, argsFile string) []string {
+	t.Helper()
+	data, err := os.ReadFile(argsFile) //nolint:gosec // test file in temp dir
+	require.NoError(t, err)
+	return strings.Split(strings.TrimSpace(string(data)), "\n")
+}
+
+func TestLintFiles_AtThresholdLintsEachDirectory(t *testing.T) {
+	repoRoot, argsFile := setupFakeLintRepo(t)
+	files := packageLintFiles(t, repoRoot)
+
+	err := newPackageLintCheck(len(files)).lintFiles(context.Background(), repoRoot, files)
+	require.ErrorIs(t, err, prerrors.ErrLintingIssues)
+
+	assert.Len(t, lintInvocations(t, argsFile), 3, "one run per directory at the threshold")
+}
+
+func TestLintFiles_AboveThresholdLintsPackagesTogether(t *testing.T) {
+	repoRoot, argsFile := setupFakeLintRepo(t)
+	files := packageLintFiles(t, repoRoot)
+
+	err := newPackageLintCheck(len(files)-1).lintFiles(context.Background(), repoRoot, files)
+	require.ErrorIs(t, err, prerrors.ErrLintingIssues)
+
+	runs := lintInvocations(t, argsFile)
+	require.Len(t, runs, 1, "one run for the whole module above the threshold")
+	for _, dir := range []string{"cmd", "internal/git", "internal/runner"} {
+		assert.Contains(t, runs[0], filepath.Join(repoRoot, dir))
+	}
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Contains(t, checkErr.Output, "internal/git/files.go:89:11: Error return value of `os.Remove` is not checked (errcheck)")
+	assert.NotContains(t, checkErr.Output, "runner.go", "issues outside the changed files are not reported")
+}
+
+func TestLintFiles_AboveThresholdPassesWhenIssuesAreOutOfScope(t *testing.T) {
+	repoRoot, _ := setupFakeLintRepo(t)
+	files := []string{"internal/git/other.go", "internal/runner/other.go", "cmd/main.go"}
+	for _, file := range files {
+		require.NoError(t, os.MkdirAll(filepath.Join(repoRoot, filepath.Dir(file)), 0o750))
+	}
+
+	require.NoError(t, newPackageLintCheck(1).lintFiles(context.Background(), repoRoot, files))
+}
+
+func TestLintFiles_ZeroThresholdDisablesPackageLinting(t *testing.T) {
+	repoRoot, argsFile := setupFakeLintRepo(t)
+	files := packageLintFiles(t, repoRoot)
+
+	err := newPackageLintCheck(0).lintFiles(context.Background(), repoRoot, files)
+	require.ErrorIs(t, err, prerrors.ErrLintingIssues)
+	assert.Len(t, lintInvocations(t, argsFile), 3)
+}
@@ -0,0 +1,39 @@
+package gotools
+
+import (
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+// lintSeverity returns the configured severity for a linter's findings.
+// Linters without a mapping are errors, as they were before severities.
+func (c *LintCheck) lintSeverity(linter string) string {
+	if c.config != nil {
+		if severity, ok := c.config.CheckBehaviors.LintSeverity[linter]; ok {
+			return severity
+		}
+	}
+	return config.LintSeverityError
+}
+
+// hasBlockingIssue reports whether any issue is mapped to error severity
+func (c *LintCheck) hasBlockingIssue(issues []lintIssue) bool {
+	for _, issue := range issues {
+		if c.lintSeverity(issue.FromLinter) == config.LintSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// labelLintSeverity marks the text of non-error issues with their severity
+// so advisory findings stand out from the ones blocking the commit
+func (c *LintCheck) labelLintSeverity(issues []lintIssue) []lintIssue {
+	labeled := make([]lintIssue, len(issues))
+	for i, issue := range issues {
+		if severity := c.lintSeverity(issue.FromLinter); severity != config.LintSeverityError {
+			issue.Text = "[" + severity + "] " + issue.Text
+		}
+		labeled[i] = issue
+	}
+	return labeled
+}
@@ -0,0 +1,113 @@
+package gotools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/output"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// newSeverityLintCheck returns a lint check with the given severity mapping
+func newSeverityLintCheck(severity map[string]string) *LintCheck {
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.LintSeverity = severity
+	return NewLintCheckWithConfig(shared.NewContext(), cfg, time.Minute)
+}
+
+func TestLintSeverity(t *testing.T) {
+	check := newSeverityLintCheck(map[string]string{"gosec": "error", "revive": "warning"})
+	assert.Equal(t, config.LintSeverityError, check.lintSeverity("gosec"))
+	assert.Equal(t, config.LintSeverityWarning, check.lintSeverity("revive"))
+	assert.Equal(t, config.LintSeverityError, check.lintSeverity("errcheck"), "unmapped linters are errors")
+	assert.Equal(t, config.LintSeverityError, NewLintCheck().lintSeverity("revive"), "no config means errors")
+}
+
+func TestLintFiles_SeverityDecidesBlocking(t *testing.T) {
+	// The v2 fixture reports one errcheck and one ineffassign issue
+	tests := []struct {
+		name     string
+		severity map[string]string
+		warnOnly bool
+		labels   []string
+	}{
+		{
+			name:     "unmapped linters block",
+			warnOnly: false,
+		},
+		{
+			name:     "all findings mapped to warning or info",
+			severity: map[string]string{"errcheck": "warning", "ineffassign": "info"},
+			warnOnly: true,
+			labels:   []string{"[warning] Error return value", "[info] ineffectual assignment"},
+		},
+		{
+			name:     "one finding still mapped to error",
+			severity: map[string]string{"errcheck": "error", "ineffassign": "warning"},
+			warnOnly: false,
+			labels:   []string{"[warning] ineffectual assignment"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoRoot, _ := setupFakeLintRepo(t)
+			require.NoError(t, os.MkdirAll(filepath.Join(repoRoot, "internal", "git"), 0o750))
+
+			err := newSeverityLintCheck(tt.severity).lintFiles(context.Background(), repoRoot, []string{"internal/git/files.go"})
+			require.ErrorIs(t, err, prerrors.ErrLintingIssues)
+
+			var checkErr *prerrors.CheckError
+			require.ErrorAs(t, err, &checkErr)
+			assert.Equal(t, tt.warnOnly, checkErr.WarnOnly)
+			for _, label := range tt.labels {
+				assert.Contains(t, checkErr.Output, label)
+			}
+
+			require.Len(t, checkErr.Diagnostics, 2, "duplicate issues are reported once")
+			assert.Equal(t, output.Diagnostic{
+				File:     "internal/git/files.go",
+				Line:     89,
+				Col:      11,
+				Severity: newSeverityLintCheck(tt.severity).lintSeverity("errcheck"),
+				Rule:     "errcheck",
+				Message:  "Error return value of `os.Remove` is not checked",
+			}, checkErr.Diagnostics[0])
+			assert.Equal(t, "ineffassign", checkErr.Diagnostics[1].Rule)
+		})
+	}
+}
+
+func TestLintFailures_WarnOnlyAcrossDirectories(t *testing.T) {
+	advisory := &prerrors.CheckError{Err: prerrors.ErrLintingIssues, Message: "style", WarnOnly: true}
+	blocking := &prerrors.CheckError{Err: prerrors.ErrLintingIssues, Message: "security"}
+
+	var onlyAdvisory lintFailures
+	onlyAdvisory.add("a", advisory)
+	onlyAdvisory.add("b", advisory)
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, onlyAdvisory.err(), &checkErr)
+	assert.True(t, checkErr.WarnOnly)
+
+	var mixed lintFailures
+	mixed.add("a", advisory)
+	mixed.add("b", blocking)
+	require.ErrorAs(t, mixed.err(), &checkErr)
+	assert.False(t, checkErr.WarnOnly)
+
+	advisory.Diagnostics = []output.Diagnostic{{File: "a/a.go", Line: 1, Rule: "revive"}}
+	blocking.Diagnostics = []output.Diagnostic{{File: "b/b.go", Line: 2, Rule: "gosec"}}
+	var combined lintFailures
+	combined.add("a", advisory)
+	combined.add("b", blocking)
+	require.ErrorAs(t, combined.err(), &checkErr)
+	assert.Equal(t, []output.Diagnostic{advisory.Diagnostics[0], blocking.Diagnostics[0]}, checkErr.Diagnostics)
+}
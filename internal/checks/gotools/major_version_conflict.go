@@ -0,0 +1,301 @@
+package gotools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// MajorVersionConflictCheck flags packages that import two major versions of
+// the same module, such as github.com/x/foo and github.com/x/foo/v2, which
+// builds both into the binary and usually means a migration was left half
+// done. Every package in a directory holding a staged Go file is checked.
+// Imports are matched to the modules the nearest go.mod requires, or the
+// module itself, so packages that merely end in a version element (such as
+// k8s.io/api/apps/v1) are not mistaken for major versions. Modules on the
+// allowlist, named without their major suffix, may be imported at several
+// major versions.
+type MajorVersionConflictCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+	allow     []string
+}
+
+// packageImport is one import of a package's file
+type packageImport struct {
+	file string
+	line int
+	path string
+}
+
+// majorVersionConflict is an import of a module whose other major version
+// the package already imports
+type majorVersionConflict struct {
+	packageImport
+	other packageImport // the first import of the other major version
+}
+
+// NewMajorVersionConflictCheck creates a new major version conflict check
+func NewMajorVersionConflictCheck() *MajorVersionConflictCheck {
+	return &MajorVersionConflictCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewMajorVersionConflictCheckWithSharedContext creates a new major version conflict check with shared context
+func NewMajorVersionConflictCheckWithSharedContext(sharedCtx *shared.Context) *MajorVersionConflictCheck {
+	return &MajorVersionConflictCheck{
+		sharedCtx: sharedCtx,
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewMajorVersionConflictCheckWithFullConfig creates a new major version conflict check with full configuration
+func NewMajorVersionConflictCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *MajorVersionConflictCheck {
+	check := NewMajorVersionConflictCheckWithSharedContext(sharedCtx)
+	if cfg != nil {
+		if cfg.CheckTimeouts.MajorVersionConflict > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.MajorVersionConflict) * time.Second
+		}
+		check.allow = cfg.CheckBehaviors.MajorVersionConflictAllow
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *MajorVersionConflictCheck) Name() string {
+	return "major-version-conflict"
+}
+
+// Description returns a brief description of the check
+func (c *MajorVersionConflictCheck) Description() string {
+	return "Flag packages importing two major versions of one module"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *MajorVersionConflictCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "major-version-conflict",
+		Description:       "Block packages that import both a module and another major version of it, such as foo and foo/v2",
+		FilePatterns:      []string{"*.go"},
+		EstimatedDuration: 1 * time.Second,
+		Dependencies:      []string{}, // Pure Go, no external tools
+		DefaultTimeout:    c.timeout,
+		Category:          "dependencies",
+		RequiresFiles:     true,
+		Cacheable:         true,
+		CacheScope:        "package",
+	}
+}
+
+// Run executes the major version conflict check
+func (c *MajorVersionConflictCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	repoRoot := repoRootOrEmpty(ctx, c.sharedCtx)
+
+	var dirs []string
+	for _, file := range files {
+		if dir := packageDir(repoRoot, file); !slices.Contains(dirs, dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+
+	modules := make(map[string][]goModRequire)
+	found := &findings{}
+	for _, dir := range dirs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		absDir := resolveRepoPath(repoRoot, filepath.FromSlash(dir))
+		moduleDir := findGoModuleRoot(absDir, repoRoot)
+		if moduleDir == "" {
+			continue
+		}
+		if _, loaded := modules[moduleDir]; !loaded {
+			modules[moduleDir] = readModuleAndRequires(moduleDir)
+		}
+
+		packages, err := readPackageImports(absDir, dir)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue // the staged files deleted the package
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read package %s: %w", dir, err)
+		}
+		for _, name := range slices.Sorted(maps.Keys(packages)) {
+			for _, conflict := range findMajorVersionConflicts(packages[name], modules[moduleDir], c.allow) {
+				found.add(conflict.file, conflict.String())
+			}
+		}
+	}
+
+	return checkReport{
+		err:     prerrors.ErrMajorVersionConflict,
+		message: "%d import(s) of a second major version of a module",
+		suggestion: "Move the package to one major version, or add the module (without its major suffix) to " +
+			"GO_PRE_COMMIT_MAJOR_VERSION_CONFLICT_ALLOW while a migration is in progress",
+	}.result(found)
+}
+
+// FilterFiles filters to Go files outside vendor and testdata directories
+func (c *MajorVersionConflictCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if !strings.HasSuffix(file, ".go") {
+			continue
+		}
+		if slices.ContainsFunc(strings.Split(filepath.ToSlash(filepath.Dir(file)), "/"), func(part string) bool {
+			return part == "vendor" || part == "testdata"
+		}) {
+			continue
+		}
+		filtered = append(filtered, file)
+	}
+	return filtered
+}
+
+// String formats the conflict with the import it conflicts with
+func (m majorVersionConflict) String() string {
+	return fmt.Sprintf("%s:%d: %s is another major version of %s, imported at %s:%d; use one major version",
+		m.file, m.line, m.path, m.other.path, m.other.file, m.other.line)
+}
+
+// readModuleAndRequires returns the module path of the go.mod in moduleDir
+// followed by its requirements. An unreadable go.mod gives no modules.
+func readModuleAndRequires(moduleDir string) []goModRequire {
+	goMod := filepath.Join(moduleDir, fileGoMod)
+	content, err := os.ReadFile(goMod) //nolint:gosec // go.mod of a module in the repository
+	if err != nil {
+		return nil
+	}
+	var modules []goModRequire
+	if modulePath, pathErr := shared.ReadModulePath(goMod); pathErr == nil && modulePath != "" {
+		modules = append(modules, goModRequire{path: modulePath})
+	}
+	return append(modules, readRequires(content)...)
+}
+
+// readPackageImports parses the Go files in absDir, which is dir relative to
+// the repository, and returns their imports grouped by package name, in file
+// name order. Unparsable files are left out.
+func readPackageImports(absDir, dir string) (map[string][]packageImport, error) {
+	entries, err := os.ReadDir(absDir)
+	if err != nil {
+		return nil, err
+	}
+
+	packages := make(map[string][]packageImport)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") {
+			continue
+		}
+		content, readErr := os.ReadFile(filepath.Join(absDir, entry.Name())) //nolint:gosec // file in a package directory of the repository
+		if readErr != nil {
+			return nil, readErr
+		}
+		fset := token.NewFileSet()
+		file, parseErr := parser.ParseFile(fset, entry.Name(), content, parser.ImportsOnly)
+		if parseErr != nil {
+			// Unparsable files are the compiler's and formatter's concern
+			continue
+		}
+		name := path.Join(dir, entry.Name())
+		for _, spec := range file.Imports {
+			importPath, unquoteErr := strconv.Unquote(spec.Path.Value)
+			if unquoteErr != nil {
+				continue
+			}
+			packages[file.Name.Name] = append(packages[file.Name.Name], packageImport{
+				file: name,
+				line: fset.Position(spec.Pos()).Line,
+				path: importPath,
+			})
+		}
+	}
+	return packages, nil
+}
+
+// findMajorVersionConflicts returns the imports of a package that come from
+// a different major version of a module than an earlier import. Imports are
+// matched to the longest module path of modules providing them; imports no
+// module provides, such as the standard library, are ignored, as are
+// modules whose path without the major suffix matches an allowlist entry.
+func findMajorVersionConflicts(imports []packageImport, modules []goModRequire, allow []string) []majorVersionConflict {
+	firstByModule := make(map[string]packageImport)
+	var order []string
+	for _, imp := range imports {
+		module := requiredModuleOf(imp.path, modules)
+		if module == "" {
+			continue
+		}
+		if _, seen := firstByModule[module]; !seen {
+			firstByModule[module] = imp
+			order = append(order, module)
+		}
+	}
+
+	firstByBase := make(map[string]string)
+	var conflicts []majorVersionConflict
+	for _, module := range order {
+		base, _ := splitMajorVersion(module)
+		if _, allowed := matchImportRule(base, allow); allowed {
+			continue
+		}
+		first, seen := firstByBase[base]
+		if !seen {
+			firstByBase[base] = module
+			continue
+		}
+		conflicts = append(conflicts, majorVersionConflict{packageImport: firstByModule[module], other: firstByModule[first]})
+	}
+	return conflicts
+}
+
+// splitMajorVersion splits a module path into the path shared by all its
+// major versions and its major suffix: "/v2" and up, or ".v1" and up for
+// gopkg.in. Paths without a suffix are v0 or v1 and return "".
+func splitMajorVersion(modulePath string) (string, string) {
+	if strings.HasPrefix(modulePath, "gopkg.in/") {
+		if i := strings.LastIndex(modulePath, ".v"); i > 0 && isMajorNumber(modulePath[i+2:], 0) {
+			return modulePath[:i], modulePath[i+1:]
+		}
+		return modulePath, ""
+	}
+	if i := strings.LastIndex(modulePath, "/v"); i > 0 && isMajorNumber(modulePath[i+2:], 2) {
+		return modulePath[:i], modulePath[i+1:]
+	}
+	return modulePath, ""
+}
+
+// isMajorNumber reports whether s is a decimal number of at least minimum
+// without leading zeros
+func isMajorNumber(s string, minimum int) bool {
+	if s == "" || (len(s) > 1 && s[0] == '0') || strings.Trim(s, "0123456789") != "" {
+		return false
+	}
+	n, err := strconv.Atoi(s)
+	return err == nil && n >= minimum
+}
@@ -0,0 +1,159 @@
+package gotools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+func TestSplitMajorVersion(t *testing.T) {
+	tests := []struct {
+		path      string
+		wantBase  string
+		wantMajor string
+	}{
+		{path: "github.com/x/foo", wantBase: "github.com/x/foo"},
+		{path: "github.com/x/foo/v2", wantBase: "github.com/x/foo", wantMajor: "v2"},
+		{path: "github.com/x/foo/v12", wantBase: "github.com/x/foo", wantMajor: "v12"},
+		{path: "github.com/x/foo/v1", wantBase: "github.com/x/foo/v1"},
+		{path: "github.com/x/foo/v02", wantBase: "github.com/x/foo/v02"},
+		{path: "github.com/x/foo/vector", wantBase: "github.com/x/foo/vector"},
+		{path: "gopkg.in/yaml.v3", wantBase: "gopkg.in/yaml", wantMajor: "v3"},
+		{path: "gopkg.in/check.v1", wantBase: "gopkg.in/check", wantMajor: "v1"},
+		{path: "gopkg.in/yaml", wantBase: "gopkg.in/yaml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			base, major := splitMajorVersion(tt.path)
+			assert.Equal(t, tt.wantBase, base)
+			assert.Equal(t, tt.wantMajor, major)
+		})
+	}
+}
+
+func TestFindMajorVersionConflicts(t *testing.T) {
+	modules := []goModRequire{
+		{path: "example.com/repo"},
+		{path: "github.com/x/foo"},
+		{path: "github.com/x/foo/v2"},
+		{path: "github.com/x/foo/v3"},
+		{path: "gopkg.in/yaml.v2"},
+		{path: "gopkg.in/yaml.v3"},
+		{path: "k8s.io/api"},
+	}
+	imports := func(paths ...string) []packageImport {
+		var list []packageImport
+		for i, importPath := range paths {
+			list = append(list, packageImport{file: "a.go", line: i + 3, path: importPath})
+		}
+		return list
+	}
+
+	tests := []struct {
+		name    string
+		imports []packageImport
+		allow   []string
+		want    []string
+	}{
+		{
+			name:    "v1 and v2 of one module",
+			imports: imports("github.com/x/foo", "fmt", "github.com/x/foo/v2/client"),
+			want:    []string{"a.go:5: github.com/x/foo/v2/client is another major version of github.com/x/foo, imported at a.go:3; use one major version"},
+		},
+		{
+			name:    "differing major suffixes",
+			imports: imports("github.com/x/foo/v2", "github.com/x/foo/v3", "github.com/x/foo/v2/client"),
+			want:    []string{"a.go:4: github.com/x/foo/v3 is another major version of github.com/x/foo/v2, imported at a.go:3; use one major version"},
+		},
+		{
+			name:    "gopkg.in versions",
+			imports: imports("gopkg.in/yaml.v2", "gopkg.in/yaml.v3"),
+			want:    []string{"a.go:4: gopkg.in/yaml.v3 is another major version of gopkg.in/yaml.v2, imported at a.go:3; use one major version"},
+		},
+		{
+			name:    "one major version of each module",
+			imports: imports("github.com/x/foo/v2", "github.com/x/foo/v2/client", "gopkg.in/yaml.v3", "example.com/repo/internal/v2"),
+		},
+		{
+			name:    "package paths ending in a version within one module",
+			imports: imports("k8s.io/api/apps/v1", "k8s.io/api/apps/v2", "k8s.io/api/core/v1"),
+		},
+		{
+			name:    "modules the go.mod does not require",
+			imports: imports("github.com/y/bar", "github.com/y/bar/v2"),
+		},
+		{
+			name:    "allowed module",
+			imports: imports("github.com/x/foo", "github.com/x/foo/v2"),
+			allow:   []string{"github.com/x/..."},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, findingStrings(findMajorVersionConflicts(tt.imports, modules, tt.allow)))
+		})
+	}
+}
+
+func TestMajorVersionConflictCheck_Run(t *testing.T) {
+	setupMultiModuleRepo(t, map[string]string{".": `module example.com/repo
+
+go 1.22
+
+require (
+	github.com/x/foo v1.4.0
+	github.com/x/foo/v2 v2.1.0
+)
+`})
+	writeModuleFiles(t, map[string]string{
+		"store/store.go":        "package store\n\nimport \"github.com/x/foo\"\n\nvar _ = foo.New\n",
+		"store/migrate.go":      "package store\n\nimport foo2 \"github.com/x/foo/v2\"\n\nvar _ = foo2.New\n",
+		"store/store_test.go":   "package store_test\n\nimport \"github.com/x/foo/v2\"\n\nvar _ = foo.New\n",
+		"client/client.go":      "package client\n\nimport \"github.com/x/foo/v2\"\n\nvar _ = foo.New\n",
+		"client/client_test.go": "package client\n\nimport \"github.com/x/foo/v2\"\n\nvar _ = foo.New\n",
+	})
+
+	check := NewMajorVersionConflictCheckWithSharedContext(shared.NewContext())
+	require.NoError(t, check.Run(context.Background(), []string{"client/client.go"}), "one major version")
+
+	err := check.Run(context.Background(), []string{"store/store.go", "client/client.go"})
+	require.ErrorIs(t, err, prerrors.ErrMajorVersionConflict)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.False(t, checkErr.WarnOnly)
+	assert.Equal(t, []string{"store/store.go"}, checkErr.Files)
+	assert.Equal(t, "store/store.go:3: github.com/x/foo is another major version of github.com/x/foo/v2, imported at store/migrate.go:3; use one major version", checkErr.Output)
+	assert.Contains(t, checkErr.Suggestion, "GO_PRE_COMMIT_MAJOR_VERSION_CONFLICT_ALLOW")
+
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.MajorVersionConflictAllow = []string{"github.com/x/foo"}
+	configured := NewMajorVersionConflictCheckWithFullConfig(shared.NewContext(), cfg)
+	require.NoError(t, configured.Run(context.Background(), []string{"store/store.go"}), "allowed module")
+
+	require.NoError(t, check.Run(context.Background(), []string{"gone/gone.go"}), "deleted package")
+}
+
+func TestMajorVersionConflictCheck_FilterFilesAndMetadata(t *testing.T) {
+	check := NewMajorVersionConflictCheck()
+	assert.Equal(t, []string{"a.go", "a_test.go"},
+		check.FilterFiles([]string{"a.go", "a_test.go", "go.mod", "vendor/x/x.go", "p/testdata/t.go"}))
+
+	assert.Equal(t, "major-version-conflict", check.Name())
+	assert.NotEmpty(t, check.Description())
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "major-version-conflict", metadata.Name)
+
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.MajorVersionConflict = 5
+	assert.Equal(t, 5, int(NewMajorVersionConflictCheckWithFullConfig(nil, cfg).timeout.Seconds()))
+}
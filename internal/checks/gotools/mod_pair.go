@@ -0,0 +1,215 @@
+package gotools
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// fileGoSum is the checksum file kept next to go.mod
+const fileGoSum = "go.sum"
+
+// ModPairCheck flags modules whose go.mod is staged without go.sum, or go.sum
+// without go.mod, when the file left out belongs in the commit: it has
+// unstaged changes, or go mod tidy would change it. Committing only one half
+// of a dependency change breaks the build for everyone who checks it out.
+type ModPairCheck struct {
+	sharedCtx  *shared.Context
+	timeout    time.Duration
+	runCommand commandRunner // nil runs commands directly; tests fake git and go
+}
+
+// modPairIssue is one module committed with half of its module files
+type modPairIssue struct {
+	module  string // module directory relative to the repository root
+	staged  string
+	missing string
+	reason  string
+}
+
+// NewModPairCheck creates a new module file pair check
+func NewModPairCheck() *ModPairCheck {
+	return &ModPairCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   60 * time.Second,
+	}
+}
+
+// NewModPairCheckWithSharedContext creates a new module file pair check with shared context
+func NewModPairCheckWithSharedContext(sharedCtx *shared.Context) *ModPairCheck {
+	return &ModPairCheck{
+		sharedCtx: sharedCtx,
+		timeout:   60 * time.Second,
+	}
+}
+
+// NewModPairCheckWithFullConfig creates a new module file pair check with full configuration
+func NewModPairCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *ModPairCheck {
+	check := NewModPairCheckWithSharedContext(sharedCtx)
+	if cfg != nil && cfg.CheckTimeouts.ModPair > 0 {
+		check.timeout = time.Duration(cfg.CheckTimeouts.ModPair) * time.Second
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *ModPairCheck) Name() string {
+	return "mod-pair"
+}
+
+// Description returns a brief description of the check
+func (c *ModPairCheck) Description() string {
+	return "Require go.mod and go.sum changes to be staged together"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *ModPairCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "mod-pair",
+		Description:       "Fail when go.mod is staged without go.sum (or the reverse) and the other file needs to change too",
+		FilePatterns:      []string{fileGoMod, fileGoSum},
+		EstimatedDuration: 2 * time.Second,
+		Dependencies:      []string{"git", "go"},
+		DefaultTimeout:    c.timeout,
+		Category:          "dependencies",
+		RequiresFiles:     true,
+		NeedsNetwork:      true, // go mod tidy -diff may download missing modules
+	}
+}
+
+// Run executes the module file pair check
+func (c *ModPairCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	repoRoot := repoRootOrEmpty(ctx, c.sharedCtx)
+
+	// Record which of the two files each module stages
+	staged := make(map[string][]string)
+	for _, file := range c.FilterFiles(files) {
+		dir := filepath.ToSlash(filepath.Dir(file))
+		staged[dir] = append(staged[dir], filepath.Base(file))
+	}
+
+	var issues []modPairIssue
+	for _, dir := range slices.Sorted(maps.Keys(staged)) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		names := staged[dir]
+		if slices.Contains(names, fileGoMod) && slices.Contains(names, fileGoSum) {
+			continue
+		}
+		stagedFile, missing := fileGoMod, fileGoSum
+		if slices.Contains(names, fileGoSum) {
+			stagedFile, missing = fileGoSum, fileGoMod
+		}
+
+		if reason := c.missingReason(ctx, repoRoot, dir, missing); reason != "" {
+			issues = append(issues, modPairIssue{module: dir, staged: stagedFile, missing: missing, reason: reason})
+		}
+	}
+
+	if len(issues) == 0 {
+		return nil
+	}
+
+	lines := make([]string, len(issues))
+	issueFiles := make([]string, len(issues))
+	for i, issue := range issues {
+		lines[i] = issue.String()
+		issueFiles[i] = filepath.Join(issue.module, issue.missing)
+	}
+	return &prerrors.CheckError{
+		Err:        prerrors.ErrModPairMismatch,
+		Message:    fmt.Sprintf("%d module(s) stage go.mod and go.sum separately", len(issues)),
+		Suggestion: "Stage the missing file as well, running 'go mod tidy' in the module first if it is out of date",
+		Output:     strings.Join(lines, "\n"),
+		Files:      issueFiles,
+	}
+}
+
+// FilterFiles filters to go.mod and go.sum files
+func (c *ModPairCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if base := filepath.Base(file); base == fileGoMod || base == fileGoSum {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// String formats the issue with the module and the file left out
+func (i modPairIssue) String() string {
+	return fmt.Sprintf("module %s: %s staged without %s (%s)", i.module, i.staged, i.missing, i.reason)
+}
+
+// missingReason explains why the module file left out of the commit belongs
+// in it, or returns "" when it does not: changes to it in the working tree
+// are not staged, or go mod tidy would change it. Errors from tidy are left
+// to the mod-tidy check.
+func (c *ModPairCheck) missingReason(ctx context.Context, repoRoot, dir, missing string) string {
+	path := filepath.Join(dir, missing)
+
+	var stdout bytes.Buffer
+	cmd := exec.CommandContext(ctx, "git", "diff", "--name-only", "--", path)
+	cmd.Dir = repoRoot
+	cmd.Stdout = &stdout
+	shared.LogCommand(ctx, cmd)
+	if err := c.run(cmd); err == nil && strings.TrimSpace(stdout.String()) != "" {
+		return missing + " has unstaged changes"
+	}
+
+	moduleDir := resolveRepoPath(repoRoot, dir)
+	if _, err := os.Stat(filepath.Join(moduleDir, fileGoMod)); err != nil {
+		return ""
+	}
+
+	stdout.Reset()
+	cmd = exec.CommandContext(ctx, "go", "mod", "tidy", "-diff")
+	cmd.Dir = moduleDir
+	cmd.Stdout = &stdout
+	shared.LogCommand(ctx, cmd)
+	// go mod tidy -diff exits 1 when it prints a diff
+	_ = c.run(cmd)
+	if tidyChanges(stdout.String(), missing) {
+		return "go mod tidy would change " + missing
+	}
+	return ""
+}
+
+// run runs cmd with the check's command runner
+func (c *ModPairCheck) run(cmd *exec.Cmd) error {
+	if c.runCommand == nil {
+		return runCommand(cmd)
+	}
+	return c.runCommand(cmd)
+}
+
+// tidyChanges reports whether go mod tidy -diff output changes the named
+// module file, whose diff is headed "+++ tidy/<name>"
+func tidyChanges(diff, name string) bool {
+	for _, line := range strings.Split(diff, "\n") {
+		if strings.HasPrefix(line, "+++ ") && strings.HasSuffix(strings.TrimSpace(line), "/"+name) {
+			return true
+		}
+	}
+	return false
+}
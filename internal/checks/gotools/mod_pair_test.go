@@ -0,0 +1,160 @@
+package gotools
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+// fakeModPair simulates git and go for the mod-pair check: unstaged lists the
+// files with unstaged changes, and tidy maps module directories to the diff
+// go mod tidy -diff prints for them
+type fakeModPair struct {
+	unstaged []string
+	tidy     map[string]string
+	commands []string
+}
+
+func (f *fakeModPair) run(cmd *exec.Cmd) error {
+	line := strings.Join(cmd.Args, " ")
+	f.commands = append(f.commands, line)
+
+	switch {
+	case strings.HasPrefix(line, "git diff --name-only -- "):
+		path := strings.TrimPrefix(line, "git diff --name-only -- ")
+		if slices.Contains(f.unstaged, path) {
+			_, _ = fmt.Fprintln(cmd.Stdout, path)
+		}
+	case line == "go mod tidy -diff":
+		if diff := f.tidy[cmd.Dir]; diff != "" {
+			_, _ = fmt.Fprint(cmd.Stdout, diff)
+			return errFakeExit
+		}
+	}
+	return nil
+}
+
+// tidyDiff returns go mod tidy -diff output that changes the named file
+func tidyDiff(name string) string {
+	return fmt.Sprintf("diff current/%[1]s tidy/%[1]s\n--- current/%[1]s\n+++ tidy/%[1]s\n@@ -1 +1,2 @@\n+example.com/dep v1.0.0 h1:abc=\n", name)
+}
+
+// newFakeModPairCheck returns a mod-pair check running in a temporary
+// repository holding the modules app and lib
+func newFakeModPairCheck(t *testing.T, fake *fakeModPair) *ModPairCheck {
+	t.Helper()
+	root := t.TempDir()
+	for _, module := range []string{"app", "lib"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(root, module), 0o750))
+		require.NoError(t, os.WriteFile(filepath.Join(root, module, fileGoMod), []byte("module example.com/"+module+"\n"), 0o600))
+	}
+	t.Chdir(root)
+
+	check := NewModPairCheckWithSharedContext(nil)
+	check.runCommand = fake.run
+	return check
+}
+
+func TestModPairCheck_Run(t *testing.T) {
+	t.Run("staged both", func(t *testing.T) {
+		fake := &fakeModPair{unstaged: []string{"app/go.sum"}, tidy: map[string]string{"app": tidyDiff(fileGoSum)}}
+		check := newFakeModPairCheck(t, fake)
+
+		require.NoError(t, check.Run(context.Background(), []string{"app/go.mod", "app/go.sum"}))
+		assert.Empty(t, fake.commands, "a complete pair needs no lookups")
+	})
+
+	t.Run("staged go.mod only with unstaged go.sum changes", func(t *testing.T) {
+		fake := &fakeModPair{unstaged: []string{"app/go.sum"}}
+		check := newFakeModPairCheck(t, fake)
+
+		err := check.Run(context.Background(), []string{"app/go.mod"})
+		require.ErrorIs(t, err, prerrors.ErrModPairMismatch)
+
+		var checkErr *prerrors.CheckError
+		require.ErrorAs(t, err, &checkErr)
+		assert.Equal(t, "module app: go.mod staged without go.sum (go.sum has unstaged changes)", checkErr.Output)
+		assert.Equal(t, []string{"app/go.sum"}, checkErr.Files)
+	})
+
+	t.Run("staged go.mod only and tidy would change go.sum", func(t *testing.T) {
+		fake := &fakeModPair{tidy: map[string]string{"app": tidyDiff(fileGoSum)}}
+		check := newFakeModPairCheck(t, fake)
+
+		err := check.Run(context.Background(), []string{"app/go.mod"})
+		var checkErr *prerrors.CheckError
+		require.ErrorAs(t, err, &checkErr)
+		assert.Equal(t, "module app: go.mod staged without go.sum (go mod tidy would change go.sum)", checkErr.Output)
+	})
+
+	t.Run("staged go.mod only and go.sum is current", func(t *testing.T) {
+		// Tidy changing go.mod itself is the mod-tidy check's concern
+		fake := &fakeModPair{tidy: map[string]string{"app": tidyDiff(fileGoMod)}}
+		check := newFakeModPairCheck(t, fake)
+
+		require.NoError(t, check.Run(context.Background(), []string{"app/go.mod"}))
+	})
+
+	t.Run("staged go.sum only", func(t *testing.T) {
+		fake := &fakeModPair{unstaged: []string{"lib/go.mod"}}
+		check := newFakeModPairCheck(t, fake)
+
+		err := check.Run(context.Background(), []string{"lib/go.sum"})
+		var checkErr *prerrors.CheckError
+		require.ErrorAs(t, err, &checkErr)
+		assert.Equal(t, "module lib: go.sum staged without go.mod (go.mod has unstaged changes)", checkErr.Output)
+	})
+
+	t.Run("unrelated modules", func(t *testing.T) {
+		// app's go.mod and lib's go.sum do not make a pair; only lib's
+		// go.mod needs to change, and only app and lib are looked at
+		fake := &fakeModPair{tidy: map[string]string{"lib": tidyDiff(fileGoMod)}}
+		check := newFakeModPairCheck(t, fake)
+
+		err := check.Run(context.Background(), []string{"app/go.mod", "lib/go.sum", "README.md"})
+		var checkErr *prerrors.CheckError
+		require.ErrorAs(t, err, &checkErr)
+		assert.Equal(t, "module lib: go.sum staged without go.mod (go mod tidy would change go.mod)", checkErr.Output)
+		assert.Equal(t, []string{"lib/go.mod"}, checkErr.Files)
+		assert.Equal(t, []string{
+			"git diff --name-only -- app/go.sum",
+			"go mod tidy -diff",
+			"git diff --name-only -- lib/go.mod",
+			"go mod tidy -diff",
+		}, fake.commands)
+	})
+}
+
+func TestTidyChanges(t *testing.T) {
+	assert.True(t, tidyChanges(tidyDiff(fileGoSum), fileGoSum))
+	assert.False(t, tidyChanges(tidyDiff(fileGoSum), fileGoMod))
+	assert.False(t, tidyChanges("", fileGoSum))
+}
+
+func TestModPairCheck_FilterFilesAndMetadata(t *testing.T) {
+	check := NewModPairCheck()
+	assert.Equal(t, []string{"go.mod", "tools/go.sum"},
+		check.FilterFiles([]string{"go.mod", "main.go", "tools/go.sum", "go.work"}))
+
+	assert.Equal(t, "mod-pair", check.Name())
+	assert.NotEmpty(t, check.Description())
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "mod-pair", metadata.Name)
+	assert.True(t, metadata.NeedsNetwork)
+
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.ModPair = 5
+	assert.Equal(t, 5, int(NewModPairCheckWithFullConfig(nil, cfg).timeout.Seconds()))
+}
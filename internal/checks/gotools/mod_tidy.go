@@ -8,6 +8,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/mrz1836/go-pre-commit/internal/config"
@@ -22,13 +23,20 @@ var (
 
 	// ErrModTidyDiffFailed is returned when go mod tidy -diff command fails
 	ErrModTidyDiffFailed = errors.New("go mod tidy -diff failed")
+
+	// ErrGoVersionUnknown is returned when go env GOVERSION prints nothing
+	ErrGoVersionUnknown = errors.New("go env GOVERSION returned no version")
 )
 
 // ModTidyCheck ensures go.mod and go.sum are tidy
 type ModTidyCheck struct {
-	sharedCtx *shared.Context
-	config    *config.Config
-	timeout   time.Duration
+	sharedCtx  *shared.Context
+	config     *config.Config
+	timeout    time.Duration
+	runCommand commandRunner // nil runs commands directly
+
+	diffOnce      sync.Once
+	diffSupported bool // go mod tidy -diff is used, decided once per check
 }
 
 // NewModTidyCheck creates a new mod tidy check
@@ -79,6 +87,10 @@ func (c *ModTidyCheck) Metadata() any {
 		DefaultTimeout:    c.timeout,
 		Category:          "dependencies",
 		RequiresFiles:     false, // Can run even with no staged files
+		NeedsNetwork:      true,  // go mod tidy downloads missing modules
+		Scope:             "module",
+		Cacheable:         true,
+		CacheScope:        "module",
 	}
 }
 
@@ -93,12 +105,18 @@ func (c *ModTidyCheck) Run(ctx context.Context, files []string) error {
 	return c.runDirectModTidy(ctx, files)
 }
 
-// FilterFiles filters to only go.mod and go.sum files or when .go files change
+// FilterFiles filters to only go.mod and go.sum files (and go.work files in
+// workspace mode) or when .go files change
 func (c *ModTidyCheck) FilterFiles(files []string) []string {
 	var hasGoMod, hasGoFiles bool
 	var filtered []string
 
 	for _, file := range files {
+		// A workspace change can affect every module it uses
+		if c.config != nil && c.config.CheckBehaviors.GoWorkspace && isGoWorkFile(file) {
+			hasGoMod = true
+			filtered = append(filtered, file)
+		}
 		// Check for go.mod/go.sum changes
 		if file == fileGoMod || file == "go.sum" || strings.HasSuffix(file, "/"+fileGoMod) || strings.HasSuffix(file, "/go.sum") {
 			hasGoMod = true
@@ -139,9 +157,26 @@ func (c *ModTidyCheck) runDirectModTidy(ctx context.Context, files []string) err
 		return fmt.Errorf("failed to find repository root: %w", err)
 	}
 
-	// Group files by their module directory
+	// Group files by their module directory; a go.work change counts for
+	// every module the workspace uses
+	workspace := configuredWorkspace(c.config, repoRoot)
 	modulesByDir := make(map[string][]string)
 	for _, file := range files {
+		// The runner passes module roots rather than files
+		if dir := resolveRepoPath(repoRoot, file); isGoModule(dir) {
+			modulesByDir[dir] = append(modulesByDir[dir], file)
+			continue
+		}
+
+		if workspace != nil && isGoWorkFile(file) {
+			for _, module := range workspace.modules {
+				if isGoModule(module) {
+					modulesByDir[module] = append(modulesByDir[module], file)
+				}
+			}
+			continue
+		}
+
 		dir := filepath.Dir(file)
 		// Find the Go module root for this file
 		moduleRoot := findGoModuleRoot(filepath.Join(repoRoot, dir), repoRoot)
@@ -225,18 +260,21 @@ func (c *ModTidyCheck) runModTidyOnModule(ctx context.Context, moduleDir, repoRo
 		relPath = "."
 	}
 
-	// Try to use go mod tidy -diff first (Go 1.21+)
-	diffErr := c.checkModTidyDiff(ctx, moduleDir, repoRoot)
-	if diffErr != nil {
-		// Check if it's because -diff is not supported
-		if !strings.Contains(diffErr.Error(), "not supported") {
-			// -diff is supported but found issues, return the error
+	// Use go mod tidy -diff when the installed Go supports it (Go 1.23+)
+	if c.useModTidyDiff(ctx) {
+		diffErr := c.checkModTidyDiff(ctx, moduleDir, repoRoot)
+		if !errors.Is(diffErr, ErrModTidyDiffNotSupported) {
 			return diffErr
 		}
-		// -diff not supported, fall back to old method
-	} else {
-		// -diff succeeded, no changes needed
-		return nil
+		if c.diffMode() == config.ModTidyDiffAlways {
+			return prerrors.NewToolExecutionError(
+				fmt.Sprintf("go mod tidy -diff (in %s)", relPath),
+				diffErr.Error(),
+				"The installed Go does not support 'go mod tidy -diff' (Go 1.23+). Upgrade Go or set GO_PRE_COMMIT_MOD_TIDY_DIFF=auto.",
+			)
+		}
+		// The version looked new enough but the flag was rejected
+		_, _ = fmt.Fprintf(shared.CheckOutput(ctx), "mod-tidy: -diff rejected in %s, falling back to go mod tidy\n", relPath)
 	}
 
 	// Fall back to running go mod tidy and checking for changes
@@ -246,12 +284,16 @@ func (c *ModTidyCheck) runModTidyOnModule(ctx context.Context, moduleDir, repoRo
 
 	cmd := exec.CommandContext(ctx, "go", "mod", "tidy")
 	cmd.Dir = moduleDir
+	shared.LogCommand(ctx, cmd)
 
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 
-	if err := cmd.Run(); err != nil {
+	// go mod tidy rewrites go.mod and go.sum in place; a failed or canceled
+	// run puts them back instead of leaving them half updated
+	moduleFiles := []string{filepath.Join(moduleDir, fileGoMod), filepath.Join(moduleDir, "go.sum")}
+	if err := c.sharedCtx.Transact(ctx, moduleFiles, func() error { return c.run(cmd) }); err != nil {
 		output := stdout.String() + stderr.String()
 
 		// Check if it's a context timeout
@@ -307,7 +349,7 @@ func (c *ModTidyCheck) runModTidyOnModule(ctx context.Context, moduleDir, repoRo
 	return c.checkUncommittedChanges(ctx, moduleDir, repoRoot)
 }
 
-// checkModTidyDiff uses go mod tidy -diff to check if changes would be made (Go 1.21+)
+// checkModTidyDiff uses go mod tidy -diff to check if changes would be made (Go 1.23+)
 func (c *ModTidyCheck) checkModTidyDiff(ctx context.Context, moduleDir, repoRoot string) error {
 	// Calculate relative path for display
 	relPath, _ := filepath.Rel(repoRoot, moduleDir)
@@ -321,12 +363,13 @@ func (c *ModTidyCheck) checkModTidyDiff(ctx context.Context, moduleDir, repoRoot
 
 	cmd := exec.CommandContext(ctx, "go", "mod", "tidy", "-diff")
 	cmd.Dir = moduleDir
+	shared.LogCommand(ctx, cmd)
 
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 
-	if err := cmd.Run(); err != nil {
+	if err := c.run(cmd); err != nil {
 		output := stderr.String()
 
 		// Check if -diff flag is not supported (older Go versions)
@@ -429,7 +472,7 @@ func (c *ModTidyCheck) checkUncommittedChanges(ctx context.Context, moduleDir, r
 	statusCmd.Stdout = &statusOutput
 	statusCmd.Stderr = &statusOutput
 
-	if err := statusCmd.Run(); err != nil {
+	if err := c.run(statusCmd); err != nil {
 		return fmt.Errorf("failed to check git status in module '%s': %w", relPath, err)
 	}
 
@@ -0,0 +1,102 @@
+package gotools
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+	"github.com/mrz1836/go-pre-commit/internal/version"
+)
+
+// modTidyDiffMinGoVersion is the first Go release whose go mod tidy accepts -diff
+const modTidyDiffMinGoVersion = "1.23"
+
+// commandRunner runs a prepared command; tests replace it to simulate tool output
+type commandRunner func(cmd *exec.Cmd) error
+
+// runCommand runs cmd directly
+func runCommand(cmd *exec.Cmd) error {
+	return cmd.Run()
+}
+
+// run runs cmd with the check's command runner
+func (c *ModTidyCheck) run(cmd *exec.Cmd) error {
+	if c.runCommand == nil {
+		return runCommand(cmd)
+	}
+	return c.runCommand(cmd)
+}
+
+// diffMode returns the configured GO_PRE_COMMIT_MOD_TIDY_DIFF mode
+func (c *ModTidyCheck) diffMode() string {
+	if c.config == nil || c.config.CheckBehaviors.ModTidyDiff == "" {
+		return config.ModTidyDiffAuto
+	}
+	return c.config.CheckBehaviors.ModTidyDiff
+}
+
+// useModTidyDiff reports whether modules are checked with go mod tidy -diff.
+// The Go version is looked up once per check, and the choice is written to
+// the check output so verbose runs show which path was taken.
+func (c *ModTidyCheck) useModTidyDiff(ctx context.Context) bool {
+	c.diffOnce.Do(func() {
+		var reason string
+		c.diffSupported, reason = c.detectModTidyDiff(ctx)
+		method := "go mod tidy, then compare go.mod and go.sum"
+		if c.diffSupported {
+			method = "go mod tidy -diff"
+		}
+		_, _ = fmt.Fprintf(shared.CheckOutput(ctx), "mod-tidy: using %s (%s)\n", method, reason)
+	})
+	return c.diffSupported
+}
+
+// detectModTidyDiff decides between go mod tidy -diff and the fallback from
+// the configured mode and, in auto mode, the installed Go version. A version
+// that cannot be read tries -diff and falls back if the flag is rejected.
+func (c *ModTidyCheck) detectModTidyDiff(ctx context.Context) (bool, string) {
+	switch c.diffMode() {
+	case config.ModTidyDiffAlways:
+		return true, "GO_PRE_COMMIT_MOD_TIDY_DIFF=always"
+	case config.ModTidyDiffNever:
+		return false, "GO_PRE_COMMIT_MOD_TIDY_DIFF=never"
+	}
+
+	goVersion, err := c.goVersion(ctx)
+	if err != nil {
+		return true, fmt.Sprintf("Go version unknown: %v", err)
+	}
+	release := strings.TrimPrefix(goVersion, "go")
+	if strings.HasPrefix(release, "devel") {
+		return true, goVersion + " is a development build"
+	}
+	if version.CompareVersions(release, modTidyDiffMinGoVersion) < 0 {
+		return false, fmt.Sprintf("%s predates -diff, added in go%s", goVersion, modTidyDiffMinGoVersion)
+	}
+	return true, goVersion + " supports -diff"
+}
+
+// goVersion returns the installed Go version as reported by go env GOVERSION
+// (e.g. go1.22.5)
+func (c *ModTidyCheck) goVersion(ctx context.Context) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "go", "env", "GOVERSION")
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := c.run(cmd); err != nil {
+		return "", fmt.Errorf("go env GOVERSION: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	goVersion := strings.TrimSpace(stdout.String())
+	if goVersion == "" {
+		return "", ErrGoVersionUnknown
+	}
+	return goVersion, nil
+}
@@ -0,0 +1,219 @@
+package gotools
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+var errFakeExit = errors.New("exit status 2")
+
+// fakeGo simulates the go and git commands mod-tidy runs for an installed Go
+// of goVersion, recording each command line. An empty goVersion makes
+// go env fail; goVersion below go1.23 rejects -diff like older releases do.
+type fakeGo struct {
+	goVersion string
+	commands  []string
+	tidy      func(dir string) error // runs for go mod tidy when set
+}
+
+func (f *fakeGo) run(cmd *exec.Cmd) error {
+	line := strings.Join(cmd.Args, " ")
+	f.commands = append(f.commands, line)
+
+	switch line {
+	case "go env GOVERSION":
+		if f.goVersion == "" {
+			return errFakeExit
+		}
+		_, _ = fmt.Fprintln(cmd.Stdout, f.goVersion)
+	case "go mod tidy -diff":
+		if f.goVersion != "" && f.goVersion < "go1.23" {
+			_, _ = fmt.Fprintln(cmd.Stderr, "flag provided but not defined: -diff")
+			return errFakeExit
+		}
+	case "go mod tidy":
+		if f.tidy != nil {
+			return f.tidy(cmd.Dir)
+		}
+	}
+	return nil
+}
+
+// countCommands returns how many recorded commands start with prefix
+func (f *fakeGo) countCommands(prefix string) int {
+	var n int
+	for _, command := range f.commands {
+		if strings.HasPrefix(command, prefix) {
+			n++
+		}
+	}
+	return n
+}
+
+// newFakeModTidyCheck returns a mod-tidy check running commands through fake
+func newFakeModTidyCheck(fake *fakeGo, mode string) *ModTidyCheck {
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.ModTidyDiff = mode
+	check := NewModTidyCheckWithConfig(shared.NewContext(), cfg, 30*time.Second)
+	check.runCommand = fake.run
+	return check
+}
+
+// runModules runs the check's per-module step for each module and returns
+// the check output
+func runModules(t *testing.T, check *ModTidyCheck, modules ...string) (string, error) {
+	t.Helper()
+	var out bytes.Buffer
+	ctx := shared.WithCheckOutput(context.Background(), &out)
+	var err error
+	for _, module := range modules {
+		if err = check.runModTidyOnModule(ctx, module, "/repo"); err != nil {
+			break
+		}
+	}
+	return out.String(), err
+}
+
+func TestModTidyCheck_ModernGoUsesDiff(t *testing.T) {
+	fake := &fakeGo{goVersion: "go1.24.1"}
+	check := newFakeModTidyCheck(fake, config.ModTidyDiffAuto)
+
+	out, err := runModules(t, check, "/repo", "/repo/tools")
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, fake.countCommands("go env GOVERSION"), "the version is detected once")
+	assert.Equal(t, 2, fake.countCommands("go mod tidy -diff"))
+	assert.Equal(t, 0, fake.countCommands("git status"), "no fallback")
+	assert.Equal(t, "mod-tidy: using go mod tidy -diff (go1.24.1 supports -diff)\n", out)
+}
+
+func TestModTidyCheck_OldGoFallsBack(t *testing.T) {
+	fake := &fakeGo{goVersion: "go1.22.5"}
+	check := newFakeModTidyCheck(fake, config.ModTidyDiffAuto)
+
+	out, err := runModules(t, check, "/repo", "/repo/tools")
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, fake.countCommands("go env GOVERSION"))
+	assert.Equal(t, 0, fake.countCommands("go mod tidy -diff"), "-diff is never tried")
+	assert.Equal(t, 2, fake.countCommands("go mod tidy"))
+	assert.Equal(t, 2, fake.countCommands("git status"))
+	assert.Contains(t, out, "using go mod tidy, then compare go.mod and go.sum (go1.22.5 predates -diff, added in go1.23)")
+}
+
+func TestModTidyCheck_DiffModes(t *testing.T) {
+	t.Run("never", func(t *testing.T) {
+		fake := &fakeGo{goVersion: "go1.24.1"}
+		out, err := runModules(t, newFakeModTidyCheck(fake, config.ModTidyDiffNever), "/repo")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"go mod tidy", "git status --porcelain /repo/go.mod /repo/go.sum"}, fake.commands)
+		assert.Contains(t, out, "GO_PRE_COMMIT_MOD_TIDY_DIFF=never")
+	})
+
+	t.Run("always on an old Go", func(t *testing.T) {
+		fake := &fakeGo{goVersion: "go1.22.5"}
+		_, err := runModules(t, newFakeModTidyCheck(fake, config.ModTidyDiffAlways), "/repo")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "go mod tidy -diff")
+		assert.Equal(t, []string{"go mod tidy -diff"}, fake.commands, "no version lookup and no fallback")
+	})
+
+	t.Run("unknown version tries -diff first", func(t *testing.T) {
+		fake := &fakeGo{}
+		check := newFakeModTidyCheck(fake, "")
+		out, err := runModules(t, check, "/repo")
+		require.NoError(t, err)
+		assert.Equal(t, 1, fake.countCommands("go mod tidy -diff"))
+		assert.Contains(t, out, "Go version unknown")
+	})
+
+	t.Run("rejected -diff falls back", func(t *testing.T) {
+		fake := &fakeGo{goVersion: "go1.22.5"}
+		check := newFakeModTidyCheck(fake, config.ModTidyDiffAuto)
+		check.diffOnce.Do(func() { check.diffSupported = true })
+
+		out, err := runModules(t, check, "/repo")
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"go mod tidy -diff",
+			"go mod tidy",
+			"git status --porcelain /repo/go.mod /repo/go.sum",
+		}, fake.commands)
+		assert.Contains(t, out, "-diff rejected in ., falling back")
+	})
+}
+
+func TestModTidyCheck_DetectModTidyDiff(t *testing.T) {
+	tests := []struct {
+		goVersion string
+		expected  bool
+	}{
+		{"go1.21.13", false},
+		{"go1.22.5", false},
+		{"go1.23.0", true},
+		{"go1.23rc1", true},
+		{"go1.25.1", true},
+		{"devel go1.26-abcdef", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.goVersion, func(t *testing.T) {
+			check := newFakeModTidyCheck(&fakeGo{goVersion: tt.goVersion}, config.ModTidyDiffAuto)
+			supported, reason := check.detectModTidyDiff(context.Background())
+			assert.Equal(t, tt.expected, supported)
+			assert.NotEmpty(t, reason)
+		})
+	}
+}
+
+func TestModTidyCheck_RunOnModuleRoots(t *testing.T) {
+	setupMultiModuleRepo(t, map[string]string{
+		".":     "module example.com/repo\n\ngo 1.22\n",
+		"tools": "module example.com/repo/tools\n\ngo 1.22\n",
+	})
+	fake := &fakeGo{goVersion: "go1.24.1"}
+	check := newFakeModTidyCheck(fake, config.ModTidyDiffAuto)
+
+	require.NoError(t, check.Run(context.Background(), []string{".", "tools"}))
+	assert.Equal(t, 2, fake.countCommands("go mod tidy -diff"), "each module root is tidied once")
+
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "module", metadata.Scope)
+}
+
+func TestModTidyCheck_FailedTidyRestoresModuleFiles(t *testing.T) {
+	moduleDir := t.TempDir()
+	goMod := filepath.Join(moduleDir, "go.mod")
+	require.NoError(t, os.WriteFile(goMod, []byte("module example.com/repo\n\ngo 1.22\n"), 0o600))
+
+	fake := &fakeGo{goVersion: "go1.22.5", tidy: func(dir string) error {
+		// Stops after rewriting go.mod and creating go.sum
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/repo\n\ngo 1.22\n\nrequire ("), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "go.sum"), []byte("example.com/dep v1.0.0 h1:\n"), 0o600))
+		return errFakeExit
+	}}
+	check := newFakeModTidyCheck(fake, config.ModTidyDiffAuto)
+
+	_, err := runModules(t, check, moduleDir)
+	require.Error(t, err)
+
+	content, readErr := os.ReadFile(goMod) //nolint:gosec // test file
+	require.NoError(t, readErr)
+	assert.Equal(t, "module example.com/repo\n\ngo 1.22\n", string(content), "go.mod is restored")
+	assert.NoFileExists(t, filepath.Join(moduleDir, "go.sum"), "the new go.sum is removed")
+	assert.Equal(t, 0, fake.countCommands("git status"))
+}
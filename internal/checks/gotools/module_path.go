@@ -0,0 +1,155 @@
+package gotools
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// ModulePathCheck requires the module directive of changed go.mod files to be
+// all lowercase and, when a prefix is configured, to start with it. Mixed-case
+// paths such as GitHub.com/Org/repo import fine on case-insensitive file
+// systems and then break elsewhere.
+type ModulePathCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+	prefix    string
+}
+
+// NewModulePathCheck creates a new go.mod module path check
+func NewModulePathCheck() *ModulePathCheck {
+	return &ModulePathCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewModulePathCheckWithSharedContext creates a new go.mod module path check with shared context
+func NewModulePathCheckWithSharedContext(sharedCtx *shared.Context) *ModulePathCheck {
+	return &ModulePathCheck{
+		sharedCtx: sharedCtx,
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewModulePathCheckWithFullConfig creates a new go.mod module path check with full configuration
+func NewModulePathCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *ModulePathCheck {
+	check := NewModulePathCheckWithSharedContext(sharedCtx)
+	if cfg != nil {
+		if cfg.CheckTimeouts.ModulePath > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.ModulePath) * time.Second
+		}
+		check.prefix = strings.Trim(cfg.CheckBehaviors.ModulePathPrefix, "/")
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *ModulePathCheck) Name() string {
+	return "module-path"
+}
+
+// Description returns a brief description of the check
+func (c *ModulePathCheck) Description() string {
+	return "Require lowercase module paths under the configured prefix"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *ModulePathCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "module-path",
+		Description:       "Require go.mod module paths to be all lowercase and start with the configured prefix",
+		FilePatterns:      []string{fileGoMod},
+		EstimatedDuration: 50 * time.Millisecond,
+		Dependencies:      []string{}, // Pure Go, no external tools
+		DefaultTimeout:    c.timeout,
+		Category:          "dependencies",
+		RequiresFiles:     true,
+	}
+}
+
+// Run executes the module path check, reporting each module whose path
+// breaks the naming policy
+func (c *ModulePathCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	repoRoot := repoRootOrEmpty(ctx, c.sharedCtx)
+
+	var issues []string
+	var issueFiles []string
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		modulePath, err := shared.ReadModulePath(resolveRepoPath(repoRoot, file))
+		if os.IsNotExist(err) {
+			// A go.mod removed by the change has no module path left to check
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if modulePath == "" {
+			continue
+		}
+
+		if problems := c.violations(modulePath); len(problems) > 0 {
+			issues = append(issues, fmt.Sprintf("%s: module %s: %s", file, modulePath, strings.Join(problems, "; ")))
+			issueFiles = append(issueFiles, file)
+		}
+	}
+
+	if len(issues) == 0 {
+		return nil
+	}
+
+	suggestion := "Rename the module with lowercase letters (go mod edit -module=<path>) and update its imports"
+	if c.prefix != "" {
+		suggestion = fmt.Sprintf("Rename the module to a lowercase path under %s (go mod edit -module=<path>) and update its imports", c.prefix)
+	}
+
+	return &prerrors.CheckError{
+		Err:        prerrors.ErrModulePath,
+		Message:    fmt.Sprintf("%d module(s) have a module path that breaks the naming policy", len(issues)),
+		Suggestion: suggestion,
+		Output:     strings.Join(issues, "\n"),
+		Files:      issueFiles,
+	}
+}
+
+// violations lists how modulePath breaks the naming policy. The prefix is
+// compared case-insensitively so a mixed-case path is reported only once.
+func (c *ModulePathCheck) violations(modulePath string) []string {
+	var problems []string
+
+	host, rest, _ := strings.Cut(modulePath, "/")
+	if host != strings.ToLower(host) {
+		problems = append(problems, fmt.Sprintf("host %s is not lowercase", host))
+	}
+	if rest != strings.ToLower(rest) {
+		problems = append(problems, fmt.Sprintf("path %s is not lowercase", rest))
+	}
+
+	lower := strings.ToLower(modulePath)
+	if c.prefix != "" && lower != c.prefix && !strings.HasPrefix(lower, c.prefix+"/") {
+		problems = append(problems, fmt.Sprintf("not under %s", c.prefix))
+	}
+	return problems
+}
+
+// FilterFiles filters to go.mod files outside vendor and testdata directories
+func (c *ModulePathCheck) FilterFiles(files []string) []string {
+	return filterGoModFiles(files)
+}
@@ -0,0 +1,89 @@
+package gotools
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// newModulePathCheck returns a module path check requiring prefix
+func newModulePathCheck(prefix string) *ModulePathCheck {
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.ModulePathPrefix = prefix
+	return NewModulePathCheckWithFullConfig(shared.NewContext(), cfg)
+}
+
+func TestModulePathCheckMetadata(t *testing.T) {
+	check := NewModulePathCheck()
+
+	assert.Equal(t, "module-path", check.Name())
+	assert.NotEmpty(t, check.Description())
+	assert.Empty(t, check.prefix)
+
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "module-path", metadata.Name)
+	assert.Equal(t, 30*time.Second, metadata.DefaultTimeout)
+	assert.Equal(t, []string{fileGoMod, "api/go.mod"},
+		check.FilterFiles([]string{fileGoMod, "api/go.mod", "main.go", "vendor/x/go.mod"}))
+	assert.Equal(t, "github.com/acme", newModulePathCheck("github.com/acme/").prefix)
+}
+
+func TestModulePathCheck_Violations(t *testing.T) {
+	tests := []struct {
+		name       string
+		prefix     string
+		modulePath string
+		want       []string
+	}{
+		{name: "valid path", prefix: "github.com/acme", modulePath: "github.com/acme/app"},
+		{name: "prefix itself", prefix: "github.com/acme", modulePath: "github.com/acme"},
+		{name: "no prefix configured", modulePath: "example.com/anything/v2"},
+		{name: "mixed-case host", prefix: "github.com/acme", modulePath: "GitHub.com/acme/app", want: []string{"host GitHub.com is not lowercase"}},
+		{name: "mixed-case path", modulePath: "github.com/Acme/App", want: []string{"path Acme/App is not lowercase"}},
+		{name: "wrong prefix", prefix: "github.com/acme", modulePath: "github.com/other/app", want: []string{"not under github.com/acme"}},
+		{name: "prefix is not a path boundary", prefix: "github.com/acme", modulePath: "github.com/acmecorp/app", want: []string{"not under github.com/acme"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, newModulePathCheck(tt.prefix).violations(tt.modulePath))
+		})
+	}
+}
+
+func TestModulePathCheck_Run(t *testing.T) {
+	setupMultiModuleRepo(t, map[string]string{
+		".":     "module github.com/acme/app\n\ngo 1.22.0\n",
+		"tools": "module GitHub.com/acme/app/tools\n\ngo 1.22.0\n",
+		"fork":  "module github.com/other/fork\n\ngo 1.22.0\n",
+	})
+
+	t.Run("valid path passes", func(t *testing.T) {
+		require.NoError(t, newModulePathCheck("github.com/acme").Run(context.Background(), []string{fileGoMod}))
+	})
+
+	t.Run("reports each module", func(t *testing.T) {
+		err := newModulePathCheck("github.com/acme").Run(context.Background(), []string{fileGoMod, "tools/go.mod", "fork/go.mod"})
+		require.ErrorIs(t, err, prerrors.ErrModulePath)
+
+		var checkErr *prerrors.CheckError
+		require.ErrorAs(t, err, &checkErr)
+		assert.Equal(t, []string{"tools/go.mod", "fork/go.mod"}, checkErr.Files)
+		assert.Equal(t, "tools/go.mod: module GitHub.com/acme/app/tools: host GitHub.com is not lowercase\n"+
+			"fork/go.mod: module github.com/other/fork: not under github.com/acme", checkErr.Output)
+		assert.Contains(t, checkErr.Message, "2 module(s)")
+		assert.Contains(t, checkErr.Suggestion, "github.com/acme")
+	})
+
+	t.Run("deleted go.mod is skipped", func(t *testing.T) {
+		require.NoError(t, newModulePathCheck("github.com/acme").Run(context.Background(), []string{"gone/go.mod"}))
+	})
+}
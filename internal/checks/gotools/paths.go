@@ -0,0 +1,30 @@
+package gotools
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// repoRootOrEmpty returns the repository root, or an empty string when it
+// cannot be determined so relative paths resolve against the working directory
+func repoRootOrEmpty(ctx context.Context, sharedCtx *shared.Context) string {
+	if sharedCtx == nil {
+		return ""
+	}
+	root, err := sharedCtx.GetRepoRoot(ctx)
+	if err != nil {
+		return ""
+	}
+	return root
+}
+
+// resolveRepoPath joins a repository-relative file path onto repoRoot,
+// leaving absolute paths untouched
+func resolveRepoPath(repoRoot, file string) string {
+	if filepath.IsAbs(file) || repoRoot == "" {
+		return file
+	}
+	return filepath.Join(repoRoot, file)
+}
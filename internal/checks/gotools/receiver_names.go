@@ -0,0 +1,262 @@
+package gotools
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// genericReceiverNames are receiver names borrowed from other languages that
+// say nothing about the type, flagged whatever the configured length
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var genericReceiverNames = map[string]bool{"self": true, "this": true, "me": true}
+
+// ReceiverNameCheck flags methods whose receiver name differs from the one
+// the type's other methods use, names like self or this, and names longer
+// than the configured maximum. Methods are grouped by receiver type within
+// each package directory of the staged files. Generated files are skipped.
+type ReceiverNameCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+	maxLength int
+}
+
+// receiverUse is one method's receiver, as declared
+type receiverUse struct {
+	file   string
+	line   int
+	group  string // directory, package, and receiver type the method belongs to
+	method string // display name, such as "(*Store).Put"
+	name   string
+}
+
+// receiverNameIssue is one method with a badly named receiver
+type receiverNameIssue struct {
+	receiverUse
+
+	expected  string // the name the type's other methods use; empty for other issues
+	maxLength int    // set when the name is too long
+}
+
+// NewReceiverNameCheck creates a new receiver name check
+func NewReceiverNameCheck() *ReceiverNameCheck {
+	return &ReceiverNameCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   30 * time.Second,
+		maxLength: 2,
+	}
+}
+
+// NewReceiverNameCheckWithSharedContext creates a new receiver name check with shared context
+func NewReceiverNameCheckWithSharedContext(sharedCtx *shared.Context) *ReceiverNameCheck {
+	return &ReceiverNameCheck{
+		sharedCtx: sharedCtx,
+		timeout:   30 * time.Second,
+		maxLength: 2,
+	}
+}
+
+// NewReceiverNameCheckWithFullConfig creates a new receiver name check with full configuration
+func NewReceiverNameCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *ReceiverNameCheck {
+	check := NewReceiverNameCheckWithSharedContext(sharedCtx)
+	if cfg != nil {
+		if cfg.CheckTimeouts.ReceiverNames > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.ReceiverNames) * time.Second
+		}
+		check.maxLength = cfg.CheckBehaviors.ReceiverNameMaxLength
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *ReceiverNameCheck) Name() string {
+	return "receiver-names"
+}
+
+// Description returns a brief description of the check
+func (c *ReceiverNameCheck) Description() string {
+	return "Require short, consistent method receiver names"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *ReceiverNameCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "receiver-names",
+		Description:       "Require each type's methods to share one short receiver name",
+		FilePatterns:      []string{"*.go"},
+		EstimatedDuration: 1 * time.Second,
+		Dependencies:      []string{}, // Pure Go, no external tools
+		DefaultTimeout:    c.timeout,
+		Category:          "style",
+		RequiresFiles:     true,
+		Cacheable:         true,
+		CacheScope:        "package",
+	}
+}
+
+// Run executes the receiver name check
+func (c *ReceiverNameCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	found := &findings{}
+	var uses []receiverUse
+	err := readGoSources(ctx, repoRootOrEmpty(ctx, c.sharedCtx), files, nil, found, func(file string, content []byte) {
+		fileUses, err := collectReceivers(file, content)
+		if err != nil {
+			// Unparsable files are the compiler's and formatter's concern
+			return
+		}
+		uses = append(uses, fileUses...)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, issue := range findReceiverNameIssues(uses, c.maxLength) {
+		found.add(issue.file, issue.String())
+	}
+
+	return checkReport{
+		err:        prerrors.ErrReceiverName,
+		message:    "%d method receiver(s) are inconsistently or poorly named",
+		suggestion: "Give every method of a type the same short receiver name, such as \"s\" for *Store, rather than self or this",
+	}.result(found)
+}
+
+// FilterFiles filters to Go files
+func (c *ReceiverNameCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if strings.HasSuffix(file, ".go") {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// String formats the issue as an inconsistent, generic, or long receiver name
+func (i receiverNameIssue) String() string {
+	switch {
+	case i.expected != "":
+		return fmt.Sprintf("%s:%d: receiver %q of %s differs from %q used by the type's other methods",
+			i.file, i.line, i.name, i.method, i.expected)
+	case i.maxLength > 0:
+		return fmt.Sprintf("%s:%d: receiver %q of %s is longer than %d characters",
+			i.file, i.line, i.name, i.method, i.maxLength)
+	default:
+		return fmt.Sprintf("%s:%d: receiver %q of %s should be named after the type, not %s",
+			i.file, i.line, i.name, i.method, i.name)
+	}
+}
+
+// collectReceivers parses a Go file and returns the named receivers of its
+// methods. Generated files have none, and blank or omitted receiver names
+// are left out since they are never referenced.
+func collectReceivers(filename string, content []byte) ([]receiverUse, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, content, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	if ast.IsGenerated(file) {
+		return nil, nil
+	}
+
+	var uses []receiverUse
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || len(fn.Recv.List) == 0 {
+			continue
+		}
+		recv := fn.Recv.List[0]
+		if len(recv.Names) == 0 || recv.Names[0].Name == "_" {
+			continue
+		}
+		uses = append(uses, receiverUse{
+			file:   filename,
+			line:   fset.Position(recv.Names[0].Pos()).Line,
+			group:  filepath.Dir(filename) + "\x00" + file.Name.Name + "\x00" + receiverBaseType(recv.Type),
+			method: funcDisplayName(fn),
+			name:   recv.Names[0].Name,
+		})
+	}
+	return uses, nil
+}
+
+// findReceiverNameIssues reports receivers named self or this, longer than
+// maxLength characters (0 disables the limit), or different from the name
+// most of their type's methods use, with ties going to the first declared.
+// Each receiver is reported once, in the order given.
+func findReceiverNameIssues(uses []receiverUse, maxLength int) []receiverNameIssue {
+	counts := make(map[string]map[string]int) // group -> name -> methods using it
+	first := make(map[string][]string)        // group -> names in order of first use
+	for _, use := range uses {
+		if counts[use.group] == nil {
+			counts[use.group] = make(map[string]int)
+		}
+		if counts[use.group][use.name] == 0 {
+			first[use.group] = append(first[use.group], use.name)
+		}
+		counts[use.group][use.name]++
+	}
+
+	expected := make(map[string]string, len(first))
+	for group, names := range first {
+		best := names[0]
+		for _, name := range names[1:] {
+			if counts[group][name] > counts[group][best] {
+				best = name
+			}
+		}
+		expected[group] = best
+	}
+
+	var issues []receiverNameIssue
+	for _, use := range uses {
+		switch {
+		case use.name != expected[use.group]:
+			issues = append(issues, receiverNameIssue{receiverUse: use, expected: expected[use.group]})
+		case genericReceiverNames[use.name]:
+			issues = append(issues, receiverNameIssue{receiverUse: use})
+		case maxLength > 0 && len(use.name) > maxLength:
+			issues = append(issues, receiverNameIssue{receiverUse: use, maxLength: maxLength})
+		}
+	}
+	return issues
+}
+
+// receiverBaseType returns the receiver's type name without a pointer or
+// type parameters, so (s *Store) and (s Store) group together
+func receiverBaseType(expr ast.Expr) string {
+	for {
+		switch t := expr.(type) {
+		case *ast.StarExpr:
+			expr = t.X
+		case *ast.ParenExpr:
+			expr = t.X
+		case *ast.IndexExpr:
+			expr = t.X
+		case *ast.IndexListExpr:
+			expr = t.X
+		case *ast.Ident:
+			return t.Name
+		default:
+			return fmt.Sprintf("%T", expr)
+		}
+	}
+}
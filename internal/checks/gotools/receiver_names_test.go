@@ -0,0 +1,216 @@
+package gotools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+// receiverIssues collects and checks the receivers of the given files, all
+// in one package directory, returning the formatted issues
+func receiverIssues(t *testing.T, maxLength int, sources map[string]string) []string {
+	t.Helper()
+	var uses []receiverUse
+	for _, name := range []string{"store.go", "store_sql.go"} {
+		src, ok := sources[name]
+		if !ok {
+			continue
+		}
+		fileUses, err := collectReceivers(name, []byte(src))
+		require.NoError(t, err)
+		uses = append(uses, fileUses...)
+	}
+
+	return findingStrings(findReceiverNameIssues(uses, maxLength))
+}
+
+func TestFindReceiverNameIssues(t *testing.T) {
+	tests := []struct {
+		name      string
+		maxLength int
+		sources   map[string]string
+		want      []string
+	}{
+		{
+			name:      "consistent",
+			maxLength: 2,
+			sources: map[string]string{"store.go": `package store
+
+type Store struct{}
+
+func (s *Store) Put() {}
+
+func (s Store) Len() int { return 0 }
+
+func (_ *Store) Close() {}
+
+func (*Store) Reset() {}
+
+type list[T any] []T
+
+func (l list[T]) Len() int { return len(l) }
+
+func (l *list[T]) Push(v T) {}
+`},
+		},
+		{
+			name:      "inconsistent within a file",
+			maxLength: 2,
+			sources: map[string]string{"store.go": `package store
+
+type Store struct{}
+
+func (s *Store) Put() {}
+
+func (st *Store) Get() {}
+
+func (s *Store) Delete() {}
+`},
+			want: []string{`store.go:7: receiver "st" of (*Store).Get differs from "s" used by the type's other methods`},
+		},
+		{
+			name:      "inconsistent across files of a package",
+			maxLength: 2,
+			sources: map[string]string{
+				"store.go":     "package store\n\ntype Store struct{}\n\nfunc (s *Store) Put() {}\n",
+				"store_sql.go": "package store\n\nfunc (db *Store) Query() {}\n",
+			},
+			want: []string{`store_sql.go:3: receiver "db" of (*Store).Query differs from "s" used by the type's other methods`},
+		},
+		{
+			name:      "self and this",
+			maxLength: 0,
+			sources: map[string]string{"store.go": `package store
+
+type Store struct{}
+
+func (self *Store) Put() {}
+
+type Queue struct{}
+
+func (this Queue) Len() int { return 0 }
+`},
+			want: []string{
+				`store.go:5: receiver "self" of (*Store).Put should be named after the type, not self`,
+				`store.go:9: receiver "this" of Queue.Len should be named after the type, not this`,
+			},
+		},
+		{
+			name:      "too long",
+			maxLength: 2,
+			sources: map[string]string{"store.go": `package store
+
+type Store struct{}
+
+func (store *Store) Put() {}
+
+func (store *Store) Get() {}
+`},
+			want: []string{
+				`store.go:5: receiver "store" of (*Store).Put is longer than 2 characters`,
+				`store.go:7: receiver "store" of (*Store).Get is longer than 2 characters`,
+			},
+		},
+		{
+			name:      "no length limit",
+			maxLength: 0,
+			sources:   map[string]string{"store.go": "package store\n\ntype Store struct{}\n\nfunc (store *Store) Put() {}\n"},
+		},
+		{
+			name:      "generated files are skipped",
+			maxLength: 2,
+			sources: map[string]string{
+				"store.go": "// Code generated by mockgen. DO NOT EDIT.\n\npackage store\n\ntype Store struct{}\n\nfunc (this *Store) Put() {}\n",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, receiverIssues(t, tt.maxLength, tt.sources))
+		})
+	}
+}
+
+func TestFindReceiverNameIssues_MostCommonWins(t *testing.T) {
+	got := receiverIssues(t, 2, map[string]string{"store.go": `package store
+
+type Store struct{}
+
+func (st *Store) Put() {}
+
+func (s *Store) Get() {}
+
+func (s *Store) Delete() {}
+`})
+	assert.Equal(t, []string{`store.go:5: receiver "st" of (*Store).Put differs from "s" used by the type's other methods`}, got)
+}
+
+func TestCollectReceivers_GroupsByPackage(t *testing.T) {
+	a, err := collectReceivers("a/store.go", []byte("package store\n\ntype Store struct{}\n\nfunc (s *Store) Put() {}\n"))
+	require.NoError(t, err)
+	b, err := collectReceivers("b/store.go", []byte("package store\n\ntype Store struct{}\n\nfunc (x *Store) Put() {}\n"))
+	require.NoError(t, err)
+	c, err := collectReceivers("a/store_test.go", []byte("package store_test\n\ntype Store struct{}\n\nfunc (y Store) Put() {}\n"))
+	require.NoError(t, err)
+
+	assert.Empty(t, findReceiverNameIssues(append(append(a, b...), c...), 2), "same type names in different packages are unrelated")
+
+	_, err = collectReceivers("bad.go", []byte("package p\nfunc {"))
+	require.Error(t, err)
+}
+
+func TestReceiverNameCheck_Run(t *testing.T) {
+	dir := t.TempDir()
+	store := filepath.Join(dir, "store.go")
+	other := filepath.Join(dir, "other.go")
+	require.NoError(t, os.WriteFile(store, []byte("package p\n\ntype Store struct{}\n\nfunc (s *Store) Put() {}\n"), 0o600))
+	require.NoError(t, os.WriteFile(other, []byte("package p\n\nfunc (self *Store) Get() {}\n\nfunc (self *Store) Delete() {}\n"), 0o600))
+
+	check := NewReceiverNameCheck()
+	require.NoError(t, check.Run(context.Background(), nil))
+	require.NoError(t, check.Run(context.Background(), []string{store}))
+
+	err := check.Run(context.Background(), []string{store, other})
+	require.ErrorIs(t, err, prerrors.ErrReceiverName)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Equal(t, []string{store, other}, checkErr.Files)
+	assert.Contains(t, checkErr.Output, `receiver "s" of (*Store).Put differs from "self"`)
+	assert.Contains(t, checkErr.Output, `receiver "self" of (*Store).Get should be named after the type, not self`)
+}
+
+func TestReceiverNameCheck_FullConfig(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.ReceiverNames = 5
+	cfg.CheckBehaviors.ReceiverNameMaxLength = 5
+
+	check := NewReceiverNameCheckWithFullConfig(nil, cfg)
+	assert.Equal(t, 5, check.maxLength)
+	assert.Equal(t, 5, int(check.timeout.Seconds()))
+
+	file := filepath.Join(t.TempDir(), "store.go")
+	require.NoError(t, os.WriteFile(file, []byte("package p\n\ntype Store struct{}\n\nfunc (store *Store) Put() {}\n"), 0o600))
+	require.NoError(t, check.Run(context.Background(), []string{file}))
+	require.ErrorIs(t, NewReceiverNameCheck().Run(context.Background(), []string{file}), prerrors.ErrReceiverName)
+
+	assert.Equal(t, []string{"a.go", "a_test.go"}, check.FilterFiles([]string{"a.go", "a_test.go", "README.md"}))
+}
+
+func TestReceiverNameCheck_Metadata(t *testing.T) {
+	check := NewReceiverNameCheck()
+	assert.Equal(t, "receiver-names", check.Name())
+	assert.NotEmpty(t, check.Description())
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "receiver-names", metadata.Name)
+	assert.Equal(t, 2, check.maxLength)
+}
@@ -0,0 +1,263 @@
+package gotools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// Struct tag syntax errors, matching the problems go vet's structtag pass reports
+var (
+	errTagSyntax      = errors.New("bad syntax for struct tag pair")
+	errTagKeySyntax   = errors.New("bad syntax for struct tag key")
+	errTagValueSyntax = errors.New("bad syntax for struct tag value")
+	errTagSpace       = errors.New("key:\"value\" pairs not separated by spaces")
+)
+
+// StructTagCheck flags struct tags that reflect.StructTag cannot read, such
+// as `json:name` without quotes, which compile but are silently ignored.
+// With an allowlist of keys configured it also flags unknown keys.
+// Generated files are skipped.
+type StructTagCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+	keys      []string // allowed tag keys; empty allows any key
+}
+
+// structTagIssue is one flagged struct tag
+type structTagIssue struct {
+	file    string
+	line    int
+	field   string
+	problem string
+}
+
+// NewStructTagCheck creates a new struct tag check
+func NewStructTagCheck() *StructTagCheck {
+	return &StructTagCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewStructTagCheckWithSharedContext creates a new struct tag check with shared context
+func NewStructTagCheckWithSharedContext(sharedCtx *shared.Context) *StructTagCheck {
+	return &StructTagCheck{
+		sharedCtx: sharedCtx,
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewStructTagCheckWithFullConfig creates a new struct tag check with full configuration
+func NewStructTagCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *StructTagCheck {
+	check := NewStructTagCheckWithSharedContext(sharedCtx)
+	if cfg != nil {
+		if cfg.CheckTimeouts.StructTags > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.StructTags) * time.Second
+		}
+		check.keys = cfg.CheckBehaviors.StructTagKeys
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *StructTagCheck) Name() string {
+	return "struct-tags"
+}
+
+// Description returns a brief description of the check
+func (c *StructTagCheck) Description() string {
+	return "Flag malformed struct tags"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *StructTagCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "struct-tags",
+		Description:       "Require struct tags to follow the key:\"value\" convention reflect.StructTag reads",
+		FilePatterns:      []string{"*.go"},
+		EstimatedDuration: 1 * time.Second,
+		Dependencies:      []string{}, // Pure Go, no external tools
+		DefaultTimeout:    c.timeout,
+		Category:          "correctness",
+		RequiresFiles:     true,
+		Cacheable:         true,
+	}
+}
+
+// Run executes the struct tag check
+func (c *StructTagCheck) Run(ctx context.Context, files []string) error {
+	suggestion := "Write tags as space-separated key:\"value\" pairs, such as `json:\"name,omitempty\" yaml:\"name\"`"
+	if len(c.keys) > 0 {
+		suggestion += ", or add the key to GO_PRE_COMMIT_STRUCT_TAG_KEYS"
+	}
+
+	return astCheck[structTagIssue]{
+		checkReport: checkReport{
+			err:        prerrors.ErrStructTag,
+			message:    "%d struct tag(s) are malformed or use unknown keys",
+			suggestion: suggestion,
+		},
+		sharedCtx: c.sharedCtx,
+		timeout:   c.timeout,
+		find: func(file string, content []byte) ([]structTagIssue, error) {
+			return findStructTagIssues(file, content, c.keys)
+		},
+	}.run(ctx, files)
+}
+
+// FilterFiles filters to Go files
+func (c *StructTagCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if strings.HasSuffix(file, ".go") {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// String formats the issue with the field it belongs to
+func (i structTagIssue) String() string {
+	return fmt.Sprintf("%s:%d: struct field %s: %s", i.file, i.line, i.field, i.problem)
+}
+
+// findStructTagIssues parses a Go file and returns the struct tags that are
+// malformed, repeat a key, or use a key outside keys when keys is not empty.
+// Generated files are skipped.
+func findStructTagIssues(filename string, content []byte, keys []string) ([]structTagIssue, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, content, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	if ast.IsGenerated(file) {
+		return nil, nil
+	}
+
+	var issues []structTagIssue
+	ast.Inspect(file, func(n ast.Node) bool {
+		st, ok := n.(*ast.StructType)
+		if !ok {
+			return true
+		}
+		for _, field := range st.Fields.List {
+			if field.Tag == nil {
+				continue
+			}
+			tag, unquoteErr := strconv.Unquote(field.Tag.Value)
+			if unquoteErr != nil {
+				continue
+			}
+			for _, problem := range structTagProblems(tag, keys) {
+				issues = append(issues, structTagIssue{
+					file:    filename,
+					line:    fset.Position(field.Tag.Pos()).Line,
+					field:   fieldDisplayName(field),
+					problem: problem,
+				})
+			}
+		}
+		return true
+	})
+	return issues, nil
+}
+
+// structTagProblems describes what is wrong with tag: a syntax error stops
+// the scan, while duplicate and unknown keys are all reported
+func structTagProblems(tag string, keys []string) []string {
+	pairs, err := parseStructTag(tag)
+	if err != nil {
+		return []string{fmt.Sprintf("%v in `%s`", err, tag)}
+	}
+
+	var problems []string
+	seen := make(map[string]bool, len(pairs))
+	for _, key := range pairs {
+		if seen[key] {
+			problems = append(problems, fmt.Sprintf("duplicate tag key %q", key))
+			continue
+		}
+		seen[key] = true
+		if len(keys) > 0 && !slices.Contains(keys, key) {
+			problems = append(problems, fmt.Sprintf("unknown tag key %q", key))
+		}
+	}
+	return problems
+}
+
+// parseStructTag returns the keys of a conventional struct tag, following the
+// grammar reflect.StructTag.Lookup reads: space-separated key:"value" pairs
+// where the key has no spaces, quotes, colons, or control characters and the
+// value is a double-quoted Go string
+func parseStructTag(tag string) ([]string, error) {
+	var keys []string
+	for tag != "" {
+		trimmed := strings.TrimLeft(tag, " ")
+		if len(keys) > 0 && trimmed == tag {
+			return nil, errTagSpace
+		}
+		tag = trimmed
+		if tag == "" {
+			break
+		}
+
+		i := 0
+		for i < len(tag) && tag[i] > ' ' && tag[i] != ':' && tag[i] != '"' && tag[i] != 0x7f {
+			i++
+		}
+		if i == 0 {
+			return nil, errTagKeySyntax
+		}
+		if i+1 >= len(tag) || tag[i] != ':' {
+			return nil, errTagSyntax
+		}
+		if tag[i+1] != '"' {
+			return nil, errTagValueSyntax
+		}
+		key := tag[:i]
+		tag = tag[i+1:]
+
+		// Scan to the closing quote, skipping escaped characters
+		i = 1
+		for i < len(tag) && tag[i] != '"' {
+			if tag[i] == '\\' {
+				i++
+			}
+			i++
+		}
+		if i >= len(tag) {
+			return nil, errTagValueSyntax
+		}
+		if _, err := strconv.Unquote(tag[:i+1]); err != nil {
+			return nil, errTagValueSyntax
+		}
+		keys = append(keys, key)
+		tag = tag[i+1:]
+	}
+	return keys, nil
+}
+
+// fieldDisplayName returns the field's names, or its type for an embedded field
+func fieldDisplayName(field *ast.Field) string {
+	if len(field.Names) == 0 {
+		return types.ExprString(field.Type)
+	}
+	names := make([]string, 0, len(field.Names))
+	for _, name := range field.Names {
+		names = append(names, name.Name)
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,160 @@
+package gotools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+func TestParseStructTag(t *testing.T) {
+	tests := []struct {
+		tag     string
+		want    []string
+		wantErr error
+	}{
+		{tag: ``},
+		{tag: `json:"name"`, want: []string{"json"}},
+		{tag: `json:"name,omitempty" yaml:"name"  db:"name"`, want: []string{"json", "yaml", "db"}},
+		{tag: `json:"a\"b"`, want: []string{"json"}},
+		{tag: `json:name`, wantErr: errTagValueSyntax},
+		{tag: `json:"name"yaml:"name"`, wantErr: errTagSpace},
+		{tag: `json`, wantErr: errTagSyntax},
+		{tag: `json :"name"`, wantErr: errTagSyntax},
+		{tag: `:"name"`, wantErr: errTagKeySyntax},
+		{tag: `json:"name`, wantErr: errTagValueSyntax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			keys, err := parseStructTag(tt.tag)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, keys)
+		})
+	}
+}
+
+func TestFindStructTagIssues(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		keys    []string
+		want    []string
+	}{
+		{
+			name: "valid tags",
+			content: "package p\n\ntype User struct {\n" +
+				"\tID   int    `json:\"id\" db:\"id\"`\n" +
+				"\tName string `json:\"name,omitempty\"`\n" +
+				"\tNote string\n}\n",
+		},
+		{
+			name: "malformed tags",
+			content: "package p\n\ntype User struct {\n" +
+				"\tID   int    `json:id`\n" +
+				"\tName string `json:\"name\"yaml:\"name\"`\n" +
+				"\tBase `json:\"base\"`\n}\n\ntype Base struct{}\n",
+			want: []string{
+				"a.go:4: struct field ID: bad syntax for struct tag value in `json:id`",
+				"a.go:5: struct field Name: key:\"value\" pairs not separated by spaces in `json:\"name\"yaml:\"name\"`",
+			},
+		},
+		{
+			name: "duplicate key and anonymous struct",
+			content: "package p\n\nvar v struct {\n" +
+				"\tA, B int `json:\"a\" json:\"b\"`\n}\n",
+			want: []string{`a.go:4: struct field A, B: duplicate tag key "json"`},
+		},
+		{
+			name: "unknown key with allowlist",
+			content: "package p\n\ntype T struct {\n" +
+				"\tA int `json:\"a\" xml:\"a\"`\n" +
+				"\t*Embedded `yml:\"e\"`\n}\n\ntype Embedded struct{}\n",
+			keys: []string{"json", "yaml"},
+			want: []string{
+				`a.go:4: struct field A: unknown tag key "xml"`,
+				`a.go:5: struct field *Embedded: unknown tag key "yml"`,
+			},
+		},
+		{
+			name: "generated file",
+			content: "// Code generated by sqlc. DO NOT EDIT.\n\npackage p\n\n" +
+				"type T struct {\n\tA int `json:a`\n}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			found, err := findStructTagIssues("a.go", []byte(tt.content), tt.keys)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, findingStrings(found))
+		})
+	}
+}
+
+func TestFindStructTagIssues_ParseError(t *testing.T) {
+	_, err := findStructTagIssues("a.go", []byte("package p\ntype T struct {"), nil)
+	require.Error(t, err)
+}
+
+func TestStructTagCheck_Run(t *testing.T) {
+	dir := t.TempDir()
+	bad := filepath.Join(dir, "bad.go")
+	good := filepath.Join(dir, "good.go")
+	require.NoError(t, os.WriteFile(bad, []byte("package p\n\ntype T struct {\n\tA int `json:a`\n}\n"), 0o600))
+	require.NoError(t, os.WriteFile(good, []byte("package p\n\ntype T struct {\n\tA int `json:\"a\"`\n}\n"), 0o600))
+
+	check := NewStructTagCheck()
+	require.NoError(t, check.Run(context.Background(), nil))
+	require.NoError(t, check.Run(context.Background(), []string{good}))
+
+	err := check.Run(context.Background(), []string{bad, good})
+	require.ErrorIs(t, err, prerrors.ErrStructTag)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Equal(t, []string{bad}, checkErr.Files)
+	assert.Contains(t, checkErr.Output, "bad.go:4: struct field A: bad syntax for struct tag value")
+	assert.NotContains(t, checkErr.Suggestion, "GO_PRE_COMMIT_STRUCT_TAG_KEYS")
+}
+
+func TestStructTagCheck_FullConfig(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.StructTags = 5
+	cfg.CheckBehaviors.StructTagKeys = []string{"json"}
+
+	check := NewStructTagCheckWithFullConfig(nil, cfg)
+	assert.Equal(t, []string{"json"}, check.keys)
+	assert.Equal(t, 5, int(check.timeout.Seconds()))
+
+	file := filepath.Join(t.TempDir(), "a.go")
+	require.NoError(t, os.WriteFile(file, []byte("package p\n\ntype T struct {\n\tA int `yaml:\"a\"`\n}\n"), 0o600))
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, check.Run(context.Background(), []string{file}), &checkErr)
+	assert.Contains(t, checkErr.Output, `unknown tag key "yaml"`)
+	assert.Contains(t, checkErr.Suggestion, "GO_PRE_COMMIT_STRUCT_TAG_KEYS")
+
+	assert.Equal(t, []string{"a.go", "a_test.go"}, check.FilterFiles([]string{"a.go", "a_test.go", "README.md"}))
+}
+
+func TestStructTagCheck_Metadata(t *testing.T) {
+	check := NewStructTagCheck()
+	assert.Equal(t, "struct-tags", check.Name())
+	assert.NotEmpty(t, check.Description())
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "struct-tags", metadata.Name)
+	assert.Empty(t, check.keys)
+}
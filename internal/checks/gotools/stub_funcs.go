@@ -0,0 +1,254 @@
+package gotools
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// stubFuncDirective keeps a stub when it appears in the function's doc
+// comment or on the line of its signature
+const stubFuncDirective = "go-pre-commit:allow-stub"
+
+// StubFuncsCheck flags exported functions and methods that are still stubs:
+// an empty body, a body holding only a TODO comment, or a body that only
+// panics with "TODO" or "not implemented". Test and generated files are
+// skipped. Findings are warn-only unless the severity is set to error.
+type StubFuncsCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+	blocking  bool
+}
+
+// stubFunc is one flagged function
+type stubFunc struct {
+	file     string
+	line     int
+	function string // the function name, qualified by its receiver for methods
+	reason   string
+}
+
+// NewStubFuncsCheck creates a new stub function check
+func NewStubFuncsCheck() *StubFuncsCheck {
+	return &StubFuncsCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewStubFuncsCheckWithSharedContext creates a new stub function check with shared context
+func NewStubFuncsCheckWithSharedContext(sharedCtx *shared.Context) *StubFuncsCheck {
+	return &StubFuncsCheck{
+		sharedCtx: sharedCtx,
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewStubFuncsCheckWithFullConfig creates a new stub function check with full configuration
+func NewStubFuncsCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *StubFuncsCheck {
+	check := NewStubFuncsCheckWithSharedContext(sharedCtx)
+	if cfg != nil {
+		if cfg.CheckTimeouts.StubFuncs > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.StubFuncs) * time.Second
+		}
+		check.blocking = cfg.CheckBehaviors.StubFuncsSeverity == config.LintSeverityError
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *StubFuncsCheck) Name() string {
+	return "stub-funcs"
+}
+
+// Description returns a brief description of the check
+func (c *StubFuncsCheck) Description() string {
+	return "Flag exported Go functions that are still stubs"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *StubFuncsCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "stub-funcs",
+		Description:       "Warn when an exported function is empty, TODO-only, or only panics with \"not implemented\"",
+		FilePatterns:      []string{"*.go"},
+		EstimatedDuration: 1 * time.Second,
+		Dependencies:      []string{}, // Pure Go, no external tools
+		DefaultTimeout:    c.timeout,
+		Category:          "style",
+		RequiresFiles:     true,
+		Cacheable:         true,
+	}
+}
+
+// Run executes the stub function check
+func (c *StubFuncsCheck) Run(ctx context.Context, files []string) error {
+	return astCheck[stubFunc]{
+		checkReport: checkReport{
+			err:     prerrors.ErrStubFunctions,
+			message: "%d exported function(s) look like unfinished stubs",
+			suggestion: "Implement the function, or add //" + stubFuncDirective +
+				" to its doc comment if the stub is intentional",
+			warnOnly: !c.blocking,
+		},
+		sharedCtx: c.sharedCtx,
+		timeout:   c.timeout,
+		find:      findStubFuncs,
+	}.run(ctx, files)
+}
+
+// FilterFiles filters to Go files, leaving out tests
+func (c *StubFuncsCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if strings.HasSuffix(file, ".go") && !strings.HasSuffix(file, "_test.go") {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// String formats the finding with the reason it looks like a stub
+func (s stubFunc) String() string {
+	return fmt.Sprintf("%s:%d: %s %s", s.file, s.line, s.function, s.reason)
+}
+
+// findStubFuncs parses a Go file and returns its exported functions and
+// methods whose bodies are stubs. Generated files, bodyless declarations,
+// and suppressed functions are skipped.
+func findStubFuncs(filename string, content []byte) ([]stubFunc, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, content, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	if ast.IsGenerated(file) {
+		return nil, nil
+	}
+
+	suppressed := make(map[int]bool)
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			if strings.Contains(comment.Text, stubFuncDirective) {
+				suppressed[fset.Position(comment.Slash).Line] = true
+			}
+		}
+	}
+
+	var stubs []stubFunc
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || !fn.Name.IsExported() || fn.Body == nil {
+			// A declaration without a body is implemented in assembly or linked in
+			continue
+		}
+
+		reason := stubReason(fn.Body, file.Comments)
+		if reason == "" {
+			continue
+		}
+
+		line := fset.Position(fn.Name.Pos()).Line
+		if suppressed[line] || commentHasDirective(fn.Doc, stubFuncDirective) {
+			continue
+		}
+		stubs = append(stubs, stubFunc{
+			file:     filename,
+			line:     line,
+			function: funcDisplayName(fn),
+			reason:   reason,
+		})
+	}
+	return stubs, nil
+}
+
+// stubReason describes why body is a stub, or returns "" when it is not.
+// An empty body that explains itself with a comment is an intentional no-op
+// unless the comment is a TODO.
+func stubReason(body *ast.BlockStmt, comments []*ast.CommentGroup) string {
+	switch len(body.List) {
+	case 0:
+		inner := bodyComments(body, comments)
+		if len(inner) == 0 {
+			return "has an empty body"
+		}
+		for _, text := range inner {
+			if isTodoText(text) {
+				return "has only a TODO comment"
+			}
+		}
+		return ""
+	case 1:
+		if message, ok := panicMessage(body.List[0]); ok && isTodoText(message) {
+			return "only panics with " + strconv.Quote(message)
+		}
+	}
+	return ""
+}
+
+// bodyComments returns the text of the comments inside body
+func bodyComments(body *ast.BlockStmt, comments []*ast.CommentGroup) []string {
+	var texts []string
+	for _, group := range comments {
+		if group.Pos() > body.Lbrace && group.End() < body.Rbrace {
+			texts = append(texts, group.Text())
+		}
+	}
+	return texts
+}
+
+// panicMessage returns the string literal passed to panic when stmt is a
+// panic call with one
+func panicMessage(stmt ast.Stmt) (string, bool) {
+	expr, ok := stmt.(*ast.ExprStmt)
+	if !ok {
+		return "", false
+	}
+	call, ok := expr.X.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return "", false
+	}
+	if ident, isIdent := call.Fun.(*ast.Ident); !isIdent || ident.Name != "panic" {
+		return "", false
+	}
+	lit, ok := call.Args[0].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	message, err := strconv.Unquote(lit.Value)
+	return message, err == nil
+}
+
+// isTodoText reports whether text marks unfinished work, such as "TODO",
+// "FIXME", "not implemented", or "unimplemented"
+func isTodoText(text string) bool {
+	text = strings.ToLower(text)
+	for _, marker := range []string{"todo", "fixme", "not implemented", "unimplemented"} {
+		if strings.Contains(text, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// commentHasDirective reports whether a doc comment carries directive
+func commentHasDirective(doc *ast.CommentGroup, directive string) bool {
+	if doc == nil {
+		return false
+	}
+	for _, comment := range doc.List {
+		if strings.Contains(comment.Text, directive) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,165 @@
+package gotools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+func TestFindStubFuncs(t *testing.T) {
+	runFinderCases(t, []finderCase{
+		{
+			name: "real implementations",
+			content: `package p
+func Sum(a, b int) int { return a + b }
+func (s *Store) Close() error {
+	panic(fmt.Sprintf("closing %s", s.name))
+}
+func Must(err error) {
+	if err != nil {
+		panic("not implemented: " + err.Error())
+	}
+}
+`,
+		},
+		{
+			name: "empty body",
+			content: `package p
+func Handle() {}
+func (s Store) Flush() {
+}
+`,
+			want: []string{
+				"a.go:2: Handle has an empty body",
+				"a.go:3: Store.Flush has an empty body",
+			},
+		},
+		{
+			name: "TODO-only body",
+			content: `package p
+func Migrate() error {
+	// TODO: write the migration
+}
+`,
+			want: []string{"a.go:2: Migrate has only a TODO comment"},
+		},
+		{
+			name: "commented no-op",
+			content: `package p
+
+// Close is a no-op; the store holds no resources
+func (s *Store) Close() {
+	// Nothing to release
+}
+`,
+		},
+		{
+			name: "panics with a placeholder",
+			content: `package p
+func Parse(s string) (int, error) {
+	panic("not implemented")
+}
+func (s *Store) Sync() { panic("TODO") }
+func Render() { panic(` + "`unimplemented`" + `) }
+`,
+			want: []string{
+				`a.go:2: Parse only panics with "not implemented"`,
+				`a.go:5: (*Store).Sync only panics with "TODO"`,
+				`a.go:6: Render only panics with "unimplemented"`,
+			},
+		},
+		{
+			name: "panics with a real message",
+			content: `package p
+func Unreachable() { panic("unreachable") }
+`,
+		},
+		{
+			name: "unexported and bodyless functions are ignored",
+			content: `package p
+func handle() {}
+func (s *Store) sync() { panic("TODO") }
+func Add(a, b int) int
+`,
+		},
+		{
+			name: "suppressed in the doc comment",
+			content: `package p
+
+// Handle satisfies the Handler interface
+//
+//go-pre-commit:allow-stub
+func Handle() {}
+`,
+		},
+		{
+			name: "suppressed on the signature line",
+			content: `package p
+func Handle() {} //go-pre-commit:allow-stub
+`,
+		},
+		{
+			name: "generated file",
+			content: `// Code generated by mockgen. DO NOT EDIT.
+
+package p
+func Handle() {}
+`,
+		},
+	}, findStubFuncs)
+}
+
+func TestFindStubFuncs_ParseError(t *testing.T) {
+	_, err := findStubFuncs("a.go", []byte("package p\nfunc {"))
+	require.Error(t, err)
+}
+
+func TestStubFuncsCheck_Run(t *testing.T) {
+	dir := t.TempDir()
+	stub := filepath.Join(dir, "stub.go")
+	impl := filepath.Join(dir, "real.go")
+	require.NoError(t, os.WriteFile(stub, []byte("package p\n\n// Load loads\nfunc Load() error {\n\tpanic(\"not implemented\")\n}\n"), 0o600))
+	require.NoError(t, os.WriteFile(impl, []byte("package p\n\n// Save saves\nfunc Save() error {\n\treturn nil\n}\n"), 0o600))
+
+	check := NewStubFuncsCheck()
+	require.NoError(t, check.Run(context.Background(), nil))
+	require.NoError(t, check.Run(context.Background(), []string{impl}))
+
+	err := check.Run(context.Background(), []string{stub, impl})
+	require.ErrorIs(t, err, prerrors.ErrStubFunctions)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.True(t, checkErr.WarnOnly, "warn-only by default")
+	assert.Equal(t, []string{stub}, checkErr.Files)
+	assert.Contains(t, checkErr.Output, `stub.go:4: Load only panics with "not implemented"`)
+	assert.Contains(t, checkErr.Suggestion, "//go-pre-commit:allow-stub")
+
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.StubFuncsSeverity = config.LintSeverityError
+	err = NewStubFuncsCheckWithFullConfig(nil, cfg).Run(context.Background(), []string{stub})
+	require.ErrorAs(t, err, &checkErr)
+	assert.False(t, checkErr.WarnOnly, "severity=error blocks")
+}
+
+func TestStubFuncsCheck_FilterFilesAndMetadata(t *testing.T) {
+	check := NewStubFuncsCheck()
+	assert.Equal(t, []string{"a.go"}, check.FilterFiles([]string{"a.go", "a_test.go", "b.py"}))
+
+	assert.Equal(t, "stub-funcs", check.Name())
+	assert.NotEmpty(t, check.Description())
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "stub-funcs", metadata.Name)
+
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.StubFuncs = 5
+	assert.Equal(t, 5, int(NewStubFuncsCheckWithFullConfig(nil, cfg).timeout.Seconds()))
+}
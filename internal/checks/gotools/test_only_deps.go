@@ -0,0 +1,330 @@
+package gotools
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// defaultTestOnlyDepsAllow lists modules that are test-only by design
+var defaultTestOnlyDepsAllow = []string{"github.com/stretchr/testify"}
+
+// TestOnlyDepsCheck reports modules a go.mod requires directly although only
+// _test.go files import them, which usually means a test helper leaked into
+// the dependency list production code is judged by. Each affected module's
+// packages are parsed, outside vendor, testdata, and nested modules, and
+// every direct requirement is matched to the imports it provides. Modules on
+// the allowlist are expected to be test-only. Findings are warn-only.
+type TestOnlyDepsCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+	allow     []string
+}
+
+// goModRequire is one require directive of a go.mod file
+type goModRequire struct {
+	path     string
+	line     int
+	indirect bool
+}
+
+// testOnlyDep is one direct requirement imported only by tests
+type testOnlyDep struct {
+	goMod    string
+	line     int
+	module   string
+	testFile string // the first test file importing it, relative to the module
+}
+
+// NewTestOnlyDepsCheck creates a new test-only dependency check
+func NewTestOnlyDepsCheck() *TestOnlyDepsCheck {
+	return &TestOnlyDepsCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   30 * time.Second,
+		allow:     defaultTestOnlyDepsAllow,
+	}
+}
+
+// NewTestOnlyDepsCheckWithSharedContext creates a new test-only dependency check with shared context
+func NewTestOnlyDepsCheckWithSharedContext(sharedCtx *shared.Context) *TestOnlyDepsCheck {
+	return &TestOnlyDepsCheck{
+		sharedCtx: sharedCtx,
+		timeout:   30 * time.Second,
+		allow:     defaultTestOnlyDepsAllow,
+	}
+}
+
+// NewTestOnlyDepsCheckWithFullConfig creates a new test-only dependency check with full configuration
+func NewTestOnlyDepsCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *TestOnlyDepsCheck {
+	check := NewTestOnlyDepsCheckWithSharedContext(sharedCtx)
+	if cfg != nil {
+		if cfg.CheckTimeouts.TestOnlyDeps > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.TestOnlyDeps) * time.Second
+		}
+		if len(cfg.CheckBehaviors.TestOnlyDepsAllow) > 0 {
+			check.allow = cfg.CheckBehaviors.TestOnlyDepsAllow
+		}
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *TestOnlyDepsCheck) Name() string {
+	return "test-only-deps"
+}
+
+// Description returns a brief description of the check
+func (c *TestOnlyDepsCheck) Description() string {
+	return "Flag direct requirements only imported by tests"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *TestOnlyDepsCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "test-only-deps",
+		Description:       "Warn when a go.mod directly requires a module that only _test.go files import",
+		FilePatterns:      []string{"*.go", fileGoMod},
+		EstimatedDuration: 1 * time.Second,
+		Dependencies:      []string{}, // Pure Go, no external tools
+		DefaultTimeout:    c.timeout,
+		Category:          "dependencies",
+		RequiresFiles:     true,
+		Scope:             "module",
+		Cacheable:         true,
+		CacheScope:        "module",
+	}
+}
+
+// Run executes the test-only dependency check. Entries are module roots, as
+// the runner passes them, or files, which stand for the module holding them.
+func (c *TestOnlyDepsCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	repoRoot := repoRootOrEmpty(ctx, c.sharedCtx)
+
+	found := &findings{}
+	for _, moduleDir := range c.moduleDirs(repoRoot, files) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		goMod := filepath.Join(moduleDir, fileGoMod)
+		if rel, err := filepath.Rel(repoRoot, goMod); repoRoot != "" && err == nil {
+			goMod = filepath.ToSlash(rel)
+		}
+
+		deps, err := findTestOnlyDeps(moduleDir, goMod, c.allow)
+		if err != nil {
+			return fmt.Errorf("failed to inspect module %s: %w", moduleDir, err)
+		}
+		for _, dep := range deps {
+			found.add(goMod, dep.String())
+		}
+	}
+
+	return checkReport{
+		err:     prerrors.ErrTestOnlyDeps,
+		message: "%d direct requirement(s) only imported by tests",
+		suggestion: "Check that production code is not meant to use the module, or add it to " +
+			"GO_PRE_COMMIT_TEST_ONLY_DEPS_ALLOW if it is a test-only dependency by design",
+		warnOnly: true,
+	}.result(found)
+}
+
+// FilterFiles filters to Go files and go.mod files outside vendor and testdata directories
+func (c *TestOnlyDepsCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if !strings.HasSuffix(file, ".go") && filepath.Base(file) != fileGoMod {
+			continue
+		}
+		if slices.ContainsFunc(strings.Split(filepath.ToSlash(filepath.Dir(file)), "/"), func(part string) bool {
+			return part == "vendor" || part == "testdata"
+		}) {
+			continue
+		}
+		filtered = append(filtered, file)
+	}
+	return filtered
+}
+
+// moduleDirs returns the absolute root of each module the entries stand for,
+// in first-seen order with duplicates removed
+func (c *TestOnlyDepsCheck) moduleDirs(repoRoot string, entries []string) []string {
+	seen := make(map[string]bool)
+	var dirs []string
+	for _, entry := range entries {
+		path := resolveRepoPath(repoRoot, entry)
+		dir := path
+		if !isGoModule(dir) {
+			dir = findGoModuleRoot(filepath.Dir(path), repoRoot)
+		}
+		if dir == "" || seen[dir] {
+			continue
+		}
+		seen[dir] = true
+		dirs = append(dirs, dir)
+	}
+	return dirs
+}
+
+// String formats the requirement with the test file that imports it
+func (d testOnlyDep) String() string {
+	return fmt.Sprintf("%s:%d: %s is a direct requirement only imported by tests (e.g. %s)", d.goMod, d.line, d.module, d.testFile)
+}
+
+// findTestOnlyDeps returns the direct requirements of the module in
+// moduleDir that its _test.go files import and its other Go files do not.
+// goMod is the go.mod path used in findings. Requirements matching an
+// allowlist entry are left out.
+func findTestOnlyDeps(moduleDir, goMod string, allow []string) ([]testOnlyDep, error) {
+	content, err := os.ReadFile(filepath.Join(moduleDir, fileGoMod)) //nolint:gosec // go.mod of a module in the repository
+	if err != nil {
+		return nil, err
+	}
+
+	var direct []goModRequire
+	for _, req := range readRequires(content) {
+		if _, allowed := matchImportRule(req.path, allow); !req.indirect && !allowed {
+			direct = append(direct, req)
+		}
+	}
+	if len(direct) == 0 {
+		return nil, nil
+	}
+
+	usedByCode := make(map[string]bool)
+	usedByTests := make(map[string]string)
+	err = walkModuleGoFiles(moduleDir, func(path string, isTest bool) error {
+		fileContent, readErr := os.ReadFile(path) //nolint:gosec // file found walking the module
+		if readErr != nil {
+			return readErr
+		}
+		file, parseErr := parser.ParseFile(token.NewFileSet(), path, fileContent, parser.ImportsOnly)
+		if parseErr != nil {
+			// Unparsable files are the compiler's and formatter's concern
+			return nil //nolint:nilerr // skip the file and keep walking
+		}
+		for _, spec := range file.Imports {
+			importPath, unquoteErr := strconv.Unquote(spec.Path.Value)
+			if unquoteErr != nil {
+				continue
+			}
+			module := requiredModuleOf(importPath, direct)
+			switch {
+			case module == "":
+			case !isTest:
+				usedByCode[module] = true
+			case usedByTests[module] == "":
+				rel, _ := filepath.Rel(moduleDir, path)
+				usedByTests[module] = filepath.ToSlash(rel)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var found []testOnlyDep
+	for _, req := range direct {
+		if testFile := usedByTests[req.path]; testFile != "" && !usedByCode[req.path] {
+			found = append(found, testOnlyDep{goMod: goMod, line: req.line, module: req.path, testFile: testFile})
+		}
+	}
+	return found, nil
+}
+
+// requiredModuleOf returns the longest module path of requires that provides
+// importPath, or "" when none does
+func requiredModuleOf(importPath string, requires []goModRequire) string {
+	best := ""
+	for _, req := range requires {
+		if (importPath == req.path || strings.HasPrefix(importPath, req.path+"/")) && len(req.path) > len(best) {
+			best = req.path
+		}
+	}
+	return best
+}
+
+// walkModuleGoFiles calls fn for each Go file of the module in moduleDir, in
+// lexical order, skipping the directories the go command ignores (vendor,
+// testdata, and names starting with . or _) and nested modules
+func walkModuleGoFiles(moduleDir string, fn func(path string, isTest bool) error) error {
+	return filepath.WalkDir(moduleDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name := entry.Name()
+		if entry.IsDir() {
+			if path == moduleDir {
+				return nil
+			}
+			if name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") || isGoModule(path) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(name, ".go") {
+			return nil
+		}
+		return fn(path, strings.HasSuffix(name, "_test.go"))
+	})
+}
+
+// readRequires returns the require directives of a go.mod file, in order,
+// from both the single-line and the block form
+func readRequires(content []byte) []goModRequire {
+	var requires []goModRequire
+	inBlock := false
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for line := 1; scanner.Scan(); line++ {
+		code, comment, _ := strings.Cut(scanner.Text(), "//")
+		fields := strings.Fields(code)
+		switch {
+		case inBlock && len(fields) == 1 && fields[0] == ")":
+			inBlock = false
+			continue
+		case inBlock:
+		case len(fields) == 2 && fields[0] == "require" && fields[1] == "(":
+			inBlock = true
+			continue
+		case len(fields) > 0 && fields[0] == "require":
+			fields = fields[1:]
+		default:
+			continue
+		}
+		if len(fields) < 2 {
+			continue
+		}
+		modulePath := fields[0]
+		if unquoted, err := strconv.Unquote(modulePath); err == nil {
+			modulePath = unquoted
+		}
+		requires = append(requires, goModRequire{
+			path:     modulePath,
+			line:     line,
+			indirect: strings.Contains(comment, "indirect"),
+		})
+	}
+	return requires
+}
@@ -0,0 +1,132 @@
+package gotools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// testOnlyDepsGoMod requires a production dependency, a test-only one listed
+// as direct, and an indirect one
+const testOnlyDepsGoMod = `module example.com/repo
+
+go 1.22
+
+require (
+	example.com/prod v1.0.0
+	example.com/testhelp v1.2.0
+	example.com/transitive v0.1.0 // indirect
+)
+
+require github.com/stretchr/testify v1.11.1
+`
+
+// writeModuleFiles writes files, relative to the working directory
+func writeModuleFiles(t *testing.T, files map[string]string) {
+	t.Helper()
+	for file, content := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(file), 0o750))
+		require.NoError(t, os.WriteFile(file, []byte(content), 0o600))
+	}
+}
+
+func TestReadRequires(t *testing.T) {
+	requires := readRequires([]byte(testOnlyDepsGoMod))
+	assert.Equal(t, []goModRequire{
+		{path: "example.com/prod", line: 6},
+		{path: "example.com/testhelp", line: 7},
+		{path: "example.com/transitive", line: 8, indirect: true},
+		{path: "github.com/stretchr/testify", line: 11},
+	}, requires)
+}
+
+func TestRequiredModuleOf(t *testing.T) {
+	requires := []goModRequire{{path: "example.com/a"}, {path: "example.com/a/b"}}
+	assert.Equal(t, "example.com/a", requiredModuleOf("example.com/a/pkg", requires))
+	assert.Equal(t, "example.com/a/b", requiredModuleOf("example.com/a/b/c", requires), "longest module wins")
+	assert.Empty(t, requiredModuleOf("example.com/ab", requires))
+}
+
+func TestTestOnlyDepsCheck_Run(t *testing.T) {
+	setupMultiModuleRepo(t, map[string]string{".": testOnlyDepsGoMod})
+	writeModuleFiles(t, map[string]string{
+		"app/app.go":           "package app\n\nimport _ \"example.com/prod/client\"\n",
+		"app/app_test.go":      "package app\n\nimport (\n\t_ \"example.com/testhelp/fake\"\n\t_ \"github.com/stretchr/testify/assert\"\n)\n",
+		"testdata/x/x.go":      "package x\n\nimport _ \"example.com/testhelp\"\n",
+		"internal/util/log.go": "package util\n",
+	})
+
+	check := NewTestOnlyDepsCheckWithSharedContext(shared.NewContext())
+	err := check.Run(context.Background(), []string{"app/app_test.go", "internal/util/log.go"})
+	require.ErrorIs(t, err, prerrors.ErrTestOnlyDeps)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.True(t, checkErr.WarnOnly)
+	assert.Equal(t, []string{"go.mod"}, checkErr.Files)
+	assert.Equal(t, "go.mod:7: example.com/testhelp is a direct requirement only imported by tests (e.g. app/app_test.go)", checkErr.Output)
+
+	// The runner passes module roots rather than files
+	require.ErrorIs(t, check.Run(context.Background(), []string{"."}), prerrors.ErrTestOnlyDeps)
+
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.TestOnlyDepsAllow = []string{"github.com/stretchr/testify", "example.com/testhelp"}
+	require.NoError(t, NewTestOnlyDepsCheckWithFullConfig(shared.NewContext(), cfg).Run(context.Background(), []string{"."}))
+}
+
+func TestTestOnlyDepsCheck_UsedByProductionCode(t *testing.T) {
+	setupMultiModuleRepo(t, map[string]string{".": testOnlyDepsGoMod})
+	writeModuleFiles(t, map[string]string{
+		"app/app_test.go": "package app\n\nimport _ \"example.com/testhelp/fake\"\n",
+		"cmd/gen/main.go": "package main\n\nimport _ \"example.com/testhelp\"\n",
+	})
+
+	check := NewTestOnlyDepsCheckWithSharedContext(shared.NewContext())
+	require.NoError(t, check.Run(context.Background(), []string{"app/app_test.go"}))
+}
+
+func TestTestOnlyDepsCheck_NestedModule(t *testing.T) {
+	setupMultiModuleRepo(t, map[string]string{
+		".":     "module example.com/repo\n\ngo 1.22\n",
+		"tools": "module example.com/repo/tools\n\ngo 1.22\n\nrequire example.com/testhelp v1.2.0\n",
+	})
+	writeModuleFiles(t, map[string]string{
+		"main.go":             "package main\n\nimport _ \"example.com/testhelp\"\n",
+		"tools/gen_test.go":   "package tools\n\nimport _ \"example.com/testhelp\"\n",
+		"tools/gen/main.go":   "package main\n",
+		"tools/.cache/x.go":   "package x\n\nimport _ \"example.com/testhelp\"\n",
+		"tools/_old/old.go":   "package old\n\nimport _ \"example.com/testhelp\"\n",
+		"tools/vendor/v/v.go": "package v\n\nimport _ \"example.com/testhelp\"\n",
+	})
+
+	err := NewTestOnlyDepsCheckWithSharedContext(shared.NewContext()).Run(context.Background(), []string{"tools"})
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.Equal(t, "tools/go.mod:5: example.com/testhelp is a direct requirement only imported by tests (e.g. gen_test.go)", checkErr.Output,
+		"the root module's import and ignored directories do not count")
+}
+
+func TestTestOnlyDepsCheck_FilterFilesAndMetadata(t *testing.T) {
+	check := NewTestOnlyDepsCheck()
+	assert.Equal(t, []string{"a.go", "a_test.go", "go.mod"},
+		check.FilterFiles([]string{"a.go", "a_test.go", "go.mod", "go.sum", "vendor/x/go.mod", "testdata/b.go"}))
+
+	assert.Equal(t, "test-only-deps", check.Name())
+	assert.NotEmpty(t, check.Description())
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "test-only-deps", metadata.Name)
+	assert.Equal(t, "module", metadata.Scope)
+
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.TestOnlyDeps = 5
+	assert.Equal(t, 5, int(NewTestOnlyDepsCheckWithFullConfig(nil, cfg).timeout.Seconds()))
+}
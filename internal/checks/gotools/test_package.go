@@ -0,0 +1,213 @@
+package gotools
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/build/constraint"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// TestPackageCheck flags _test.go files whose package is neither the package
+// of the directory nor its external test package (<pkg>_test). The directory's
+// package comes from its non-test Go files; directories holding only tests,
+// or non-test files that disagree, are left to the compiler.
+type TestPackageCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+}
+
+// NewTestPackageCheck creates a new test package check
+func NewTestPackageCheck() *TestPackageCheck {
+	return &TestPackageCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewTestPackageCheckWithSharedContext creates a new test package check with shared context
+func NewTestPackageCheckWithSharedContext(sharedCtx *shared.Context) *TestPackageCheck {
+	return &TestPackageCheck{
+		sharedCtx: sharedCtx,
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewTestPackageCheckWithFullConfig creates a new test package check with full configuration
+func NewTestPackageCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *TestPackageCheck {
+	check := NewTestPackageCheckWithSharedContext(sharedCtx)
+	if cfg != nil && cfg.CheckTimeouts.TestPackage > 0 {
+		check.timeout = time.Duration(cfg.CheckTimeouts.TestPackage) * time.Second
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *TestPackageCheck) Name() string {
+	return "test-package"
+}
+
+// Description returns a brief description of the check
+func (c *TestPackageCheck) Description() string {
+	return "Require _test.go files to be in <pkg> or <pkg>_test"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *TestPackageCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "test-package",
+		Description:       "Flag _test.go files whose package is neither the directory's package nor its _test package",
+		FilePatterns:      []string{"*_test.go"},
+		EstimatedDuration: 1 * time.Second,
+		Dependencies:      []string{}, // Pure Go, no external tools
+		DefaultTimeout:    c.timeout,
+		Category:          "style",
+		RequiresFiles:     true,
+		Cacheable:         true,
+		CacheScope:        "package",
+	}
+}
+
+// Run executes the test package check
+func (c *TestPackageCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	repoRoot := repoRootOrEmpty(ctx, c.sharedCtx)
+
+	// Several staged tests usually share a directory; read its package once
+	dirPackages := make(map[string]string)
+
+	var issues []string
+	var issueFiles []string
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		path := resolveRepoPath(repoRoot, file)
+		content, err := os.ReadFile(path) //nolint:gosec // File from staged file list
+		if err != nil {
+			issues = append(issues, fmt.Sprintf("%s: failed to read file: %v", file, err))
+			issueFiles = append(issueFiles, file)
+			continue
+		}
+
+		dir := filepath.Dir(path)
+		pkg, seen := dirPackages[dir]
+		if !seen {
+			pkg = directoryPackage(dir)
+			dirPackages[dir] = pkg
+		}
+		if pkg == "" {
+			continue
+		}
+
+		if issue := testPackageMismatch(file, content, pkg); issue != "" {
+			issues = append(issues, issue)
+			issueFiles = append(issueFiles, file)
+		}
+	}
+
+	if len(issues) == 0 {
+		return nil
+	}
+
+	return &prerrors.CheckError{
+		Err:        prerrors.ErrTestPackageMismatch,
+		Message:    fmt.Sprintf("%d test file(s) declare the wrong package", len(issues)),
+		Suggestion: "Declare the package of the code under test, or <pkg>_test for a black-box test",
+		Output:     strings.Join(issues, "\n"),
+		Files:      issueFiles,
+	}
+}
+
+// FilterFiles filters to Go test files
+func (c *TestPackageCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// testPackageMismatch describes the problem when the test file declares a
+// package other than pkg or pkg_test, or returns "" when it does not.
+// Generated and unparsable files are skipped.
+func testPackageMismatch(filename string, content []byte, pkg string) string {
+	file, err := parser.ParseFile(token.NewFileSet(), filename, content, parser.PackageClauseOnly|parser.ParseComments)
+	if err != nil || ast.IsGenerated(file) {
+		return ""
+	}
+	name := file.Name.Name
+	if name == pkg || name == pkg+"_test" {
+		return ""
+	}
+	return fmt.Sprintf("%s: package %s, want %s or %s_test", filename, name, pkg, pkg)
+}
+
+// directoryPackage returns the package declared by the non-test Go files in
+// dir, or "" when there are none or they disagree. Files excluded with
+// //go:build ignore, such as generator programs, do not count.
+func directoryPackage(dir string) string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return ""
+	}
+
+	pkg := ""
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, parseErr := parser.ParseFile(token.NewFileSet(), filepath.Join(dir, name), nil, parser.PackageClauseOnly|parser.ParseComments)
+		if parseErr != nil || isIgnoredByBuildTag(file) {
+			continue
+		}
+		switch {
+		case pkg == "":
+			pkg = file.Name.Name
+		case pkg != file.Name.Name:
+			return ""
+		}
+	}
+	return pkg
+}
+
+// isIgnoredByBuildTag reports whether the file carries //go:build ignore
+func isIgnoredByBuildTag(file *ast.File) bool {
+	for _, group := range file.Comments {
+		if group.Pos() > file.Package {
+			break
+		}
+		for _, comment := range group.List {
+			if !constraint.IsGoBuild(comment.Text) {
+				continue
+			}
+			expr, err := constraint.Parse(comment.Text)
+			if err != nil {
+				continue
+			}
+			if tag, ok := expr.(*constraint.TagExpr); ok && tag.Tag == "ignore" {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package gotools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+func TestTestPackageMismatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "internal test",
+			content: "package store\n\nimport \"testing\"\n",
+		},
+		{
+			name:    "external test",
+			content: "package store_test\n",
+		},
+		{
+			name:    "mismatched package",
+			content: "package storage\n",
+			want:    "store/a_test.go: package storage, want store or store_test",
+		},
+		{
+			name:    "mismatched external test",
+			content: "package storage_test\n",
+			want:    "store/a_test.go: package storage_test, want store or store_test",
+		},
+		{
+			name:    "generated file",
+			content: "// Code generated by mockgen. DO NOT EDIT.\n\npackage mocks\n",
+		},
+		{
+			name:    "unparsable file",
+			content: "packge store\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, testPackageMismatch("store/a_test.go", []byte(tt.content), "store"))
+		})
+	}
+}
+
+func TestDirectoryPackage(t *testing.T) {
+	write := func(dir, name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+
+	dir := t.TempDir()
+	write(dir, "store.go", "package store\n")
+	write(dir, "store_test.go", "package other\n")
+	write(dir, "gen.go", "//go:build ignore\n\npackage main\n")
+	assert.Equal(t, "store", directoryPackage(dir), "tests and ignored generators do not count")
+
+	testsOnly := t.TempDir()
+	write(testsOnly, "e2e_test.go", "package e2e\n")
+	assert.Empty(t, directoryPackage(testsOnly))
+
+	conflicting := t.TempDir()
+	write(conflicting, "a.go", "package a\n")
+	write(conflicting, "b.go", "package b\n")
+	assert.Empty(t, directoryPackage(conflicting), "conflicting packages are the compiler's concern")
+}
+
+func TestTestPackageCheck_Run(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "store.go"), []byte("package store\n"), 0o600))
+	internal := filepath.Join(dir, "store_test.go")
+	external := filepath.Join(dir, "example_test.go")
+	wrong := filepath.Join(dir, "cache_test.go")
+	require.NoError(t, os.WriteFile(internal, []byte("package store\n"), 0o600))
+	require.NoError(t, os.WriteFile(external, []byte("package store_test\n"), 0o600))
+	require.NoError(t, os.WriteFile(wrong, []byte("package cache\n"), 0o600))
+
+	check := NewTestPackageCheck()
+	require.NoError(t, check.Run(context.Background(), nil))
+	require.NoError(t, check.Run(context.Background(), []string{internal, external}))
+
+	err := check.Run(context.Background(), []string{internal, external, wrong})
+	require.ErrorIs(t, err, prerrors.ErrTestPackageMismatch)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.False(t, checkErr.WarnOnly)
+	assert.Equal(t, []string{wrong}, checkErr.Files)
+	assert.Contains(t, checkErr.Output, "cache_test.go: package cache, want store or store_test")
+}
+
+func TestTestPackageCheck_FilterFilesAndMetadata(t *testing.T) {
+	check := NewTestPackageCheck()
+	assert.Equal(t, []string{"a_test.go"}, check.FilterFiles([]string{"a.go", "a_test.go", "b.py"}))
+
+	assert.Equal(t, "test-package", check.Name())
+	assert.NotEmpty(t, check.Description())
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "test-package", metadata.Name)
+
+	cfg := &config.Config{}
+	cfg.CheckTimeouts.TestPackage = 5
+	assert.Equal(t, 5, int(NewTestPackageCheckWithFullConfig(nil, cfg).timeout.Seconds()))
+}
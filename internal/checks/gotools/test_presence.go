@@ -0,0 +1,157 @@
+package gotools
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// TestPresenceCheck flags changed Go packages whose directory has no
+// _test.go file. It nudges rather than blocks by default: findings are
+// warn-only unless the severity is set to error.
+type TestPresenceCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+	blocking  bool
+}
+
+// NewTestPresenceCheck creates a new test file presence check
+func NewTestPresenceCheck() *TestPresenceCheck {
+	return &TestPresenceCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewTestPresenceCheckWithSharedContext creates a new test file presence check with shared context
+func NewTestPresenceCheckWithSharedContext(sharedCtx *shared.Context) *TestPresenceCheck {
+	return &TestPresenceCheck{
+		sharedCtx: sharedCtx,
+		timeout:   30 * time.Second,
+	}
+}
+
+// NewTestPresenceCheckWithFullConfig creates a new test file presence check with full configuration
+func NewTestPresenceCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *TestPresenceCheck {
+	check := NewTestPresenceCheckWithSharedContext(sharedCtx)
+	if cfg != nil {
+		if cfg.CheckTimeouts.TestPresence > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.TestPresence) * time.Second
+		}
+		check.blocking = cfg.CheckBehaviors.TestPresenceSeverity == config.LintSeverityError
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *TestPresenceCheck) Name() string {
+	return "test-presence"
+}
+
+// Description returns a brief description of the check
+func (c *TestPresenceCheck) Description() string {
+	return "Flag changed Go packages without test files"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *TestPresenceCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "test-presence",
+		Description:       "Warn when a changed Go package directory has no _test.go file",
+		FilePatterns:      []string{"*.go"},
+		EstimatedDuration: 100 * time.Millisecond,
+		Dependencies:      []string{}, // Pure Go, no external tools
+		DefaultTimeout:    c.timeout,
+		Category:          "testing",
+		RequiresFiles:     true,
+	}
+}
+
+// Run executes the test file presence check
+func (c *TestPresenceCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	repoRoot := repoRootOrEmpty(ctx, c.sharedCtx)
+
+	changedByDir := make(map[string][]string)
+	for _, file := range files {
+		dir := filepath.Dir(file)
+		changedByDir[dir] = append(changedByDir[dir], filepath.Base(file))
+	}
+
+	var issues []string
+	var issueFiles []string
+	for _, dir := range slices.Sorted(maps.Keys(changedByDir)) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		hasTests, err := dirHasTestFile(resolveRepoPath(repoRoot, dir))
+		if err != nil || hasTests {
+			// A directory removed by the change has nothing left to test
+			continue
+		}
+
+		changed := changedByDir[dir]
+		issues = append(issues, fmt.Sprintf("%s: no _test.go file (changed: %s)", dir, strings.Join(changed, ", ")))
+		for _, name := range changed {
+			issueFiles = append(issueFiles, filepath.Join(dir, name))
+		}
+	}
+
+	if len(issues) == 0 {
+		return nil
+	}
+
+	return &prerrors.CheckError{
+		Err:        prerrors.ErrMissingTests,
+		Message:    fmt.Sprintf("%d changed package(s) have no test files", len(issues)),
+		Suggestion: "Add a _test.go file covering the change, or set GO_PRE_COMMIT_TEST_PRESENCE_SEVERITY=warning to report without blocking",
+		Output:     strings.Join(issues, "\n"),
+		Files:      issueFiles,
+		WarnOnly:   !c.blocking,
+	}
+}
+
+// FilterFiles filters to non-test Go files outside testdata directories
+func (c *TestPresenceCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if !strings.HasSuffix(file, ".go") || strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		if slices.Contains(strings.Split(filepath.ToSlash(filepath.Dir(file)), "/"), "testdata") {
+			continue
+		}
+		filtered = append(filtered, file)
+	}
+	return filtered
+}
+
+// dirHasTestFile reports whether dir contains a _test.go file
+func dirHasTestFile(dir string) (bool, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false, err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), "_test.go") {
+			return true, nil
+		}
+	}
+	return false, nil
+}
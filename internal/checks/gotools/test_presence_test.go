@@ -0,0 +1,78 @@
+package gotools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+// writeTestPresenceFiles creates the named files, with Go content, under dir
+func writeTestPresenceFiles(t *testing.T, dir string, names ...string) {
+	t.Helper()
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+		require.NoError(t, os.WriteFile(path, []byte("package p\n"), 0o600))
+	}
+}
+
+func TestTestPresenceCheck_Run(t *testing.T) {
+	dir := t.TempDir()
+	writeTestPresenceFiles(t, dir,
+		"tested/code.go", "tested/code_test.go",
+		"untested/a.go", "untested/b.go",
+	)
+	tested := filepath.Join(dir, "tested", "code.go")
+	untestedA := filepath.Join(dir, "untested", "a.go")
+	untestedB := filepath.Join(dir, "untested", "b.go")
+
+	check := NewTestPresenceCheck()
+	require.NoError(t, check.Run(context.Background(), []string{tested}), "a package with tests passes")
+
+	err := check.Run(context.Background(), []string{tested, untestedA, untestedB})
+	require.ErrorIs(t, err, prerrors.ErrMissingTests)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.True(t, checkErr.WarnOnly, "missing tests warn by default")
+	assert.Equal(t, []string{untestedA, untestedB}, checkErr.Files)
+	assert.Equal(t, filepath.Join(dir, "untested")+": no _test.go file (changed: a.go, b.go)", checkErr.Output)
+}
+
+func TestTestPresenceCheck_ErrorSeverityBlocks(t *testing.T) {
+	dir := t.TempDir()
+	writeTestPresenceFiles(t, dir, "untested/a.go")
+
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.TestPresenceSeverity = config.LintSeverityError
+	check := NewTestPresenceCheckWithFullConfig(nil, cfg)
+
+	err := check.Run(context.Background(), []string{filepath.Join(dir, "untested", "a.go")})
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.False(t, checkErr.WarnOnly)
+}
+
+func TestTestPresenceCheck_RemovedDirectoryIsIgnored(t *testing.T) {
+	check := NewTestPresenceCheck()
+	require.NoError(t, check.Run(context.Background(), []string{filepath.Join(t.TempDir(), "gone", "a.go")}))
+}
+
+func TestTestPresenceCheck_FilterFilesAndMetadata(t *testing.T) {
+	check := NewTestPresenceCheck()
+	files := []string{"a.go", "a_test.go", "b.py", "pkg/testdata/fixture.go", "pkg/c.go"}
+	assert.Equal(t, []string{"a.go", "pkg/c.go"}, check.FilterFiles(files))
+
+	assert.Equal(t, "test-presence", check.Name())
+	assert.NotEmpty(t, check.Description())
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "test-presence", metadata.Name)
+}
@@ -0,0 +1,195 @@
+package gotools
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// ToolchainCheck enforces a policy on the toolchain directive of changed
+// go.mod files: either no module may declare one, or every module must
+// declare the configured toolchain
+type ToolchainCheck struct {
+	sharedCtx *shared.Context
+	timeout   time.Duration
+	policy    string
+	version   string
+}
+
+// NewToolchainCheck creates a new go.mod toolchain policy check
+func NewToolchainCheck() *ToolchainCheck {
+	return &ToolchainCheck{
+		sharedCtx: shared.NewContext(),
+		timeout:   30 * time.Second,
+		policy:    config.ToolchainPolicyForbid,
+	}
+}
+
+// NewToolchainCheckWithSharedContext creates a new go.mod toolchain policy check with shared context
+func NewToolchainCheckWithSharedContext(sharedCtx *shared.Context) *ToolchainCheck {
+	return &ToolchainCheck{
+		sharedCtx: sharedCtx,
+		timeout:   30 * time.Second,
+		policy:    config.ToolchainPolicyForbid,
+	}
+}
+
+// NewToolchainCheckWithFullConfig creates a new go.mod toolchain policy check with full configuration
+func NewToolchainCheckWithFullConfig(sharedCtx *shared.Context, cfg *config.Config) *ToolchainCheck {
+	check := NewToolchainCheckWithSharedContext(sharedCtx)
+	if cfg != nil {
+		if cfg.CheckTimeouts.Toolchain > 0 {
+			check.timeout = time.Duration(cfg.CheckTimeouts.Toolchain) * time.Second
+		}
+		if cfg.CheckBehaviors.ToolchainPolicy != "" {
+			check.policy = cfg.CheckBehaviors.ToolchainPolicy
+		}
+		check.version = cfg.CheckBehaviors.ToolchainVersion
+	}
+	return check
+}
+
+// Name returns the name of the check
+func (c *ToolchainCheck) Name() string {
+	return "toolchain"
+}
+
+// Description returns a brief description of the check
+func (c *ToolchainCheck) Description() string {
+	return "Enforce the go.mod toolchain directive policy"
+}
+
+// Metadata returns comprehensive metadata about the check
+func (c *ToolchainCheck) Metadata() any {
+	return CheckMetadata{
+		Name:              "toolchain",
+		Description:       "Forbid go.mod toolchain directives, or require them to match a configured toolchain",
+		FilePatterns:      []string{fileGoMod},
+		EstimatedDuration: 50 * time.Millisecond,
+		Dependencies:      []string{}, // Pure Go, no external tools
+		DefaultTimeout:    c.timeout,
+		Category:          "dependencies",
+		RequiresFiles:     true,
+	}
+}
+
+// Run executes the toolchain policy check, reporting each module that breaks
+// the policy
+func (c *ToolchainCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	repoRoot := repoRootOrEmpty(ctx, c.sharedCtx)
+
+	var issues []string
+	var issueFiles []string
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		toolchain, err := readToolchainDirective(resolveRepoPath(repoRoot, file))
+		if os.IsNotExist(err) {
+			// A go.mod removed by the change has no directive left to check
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if issue := c.violation(toolchain); issue != "" {
+			issues = append(issues, fmt.Sprintf("%s: %s", file, issue))
+			issueFiles = append(issueFiles, file)
+		}
+	}
+
+	if len(issues) == 0 {
+		return nil
+	}
+
+	message := fmt.Sprintf("%d module(s) declare a toolchain directive, which is forbidden", len(issues))
+	suggestion := "Remove the toolchain line (go mod edit -toolchain=none)"
+	if c.policy == config.ToolchainPolicyRequire {
+		message = fmt.Sprintf("%d module(s) do not declare toolchain %s", len(issues), c.version)
+		suggestion = fmt.Sprintf("Set the toolchain in each go.mod (go mod edit -toolchain=%s)", c.version)
+	}
+
+	return &prerrors.CheckError{
+		Err:        prerrors.ErrToolchainPolicy,
+		Message:    message,
+		Suggestion: suggestion,
+		Output:     strings.Join(issues, "\n"),
+		Files:      issueFiles,
+	}
+}
+
+// violation describes how toolchain breaks the policy, or returns "" when it
+// complies. An empty toolchain means go.mod has no toolchain directive.
+func (c *ToolchainCheck) violation(toolchain string) string {
+	switch {
+	case c.policy == config.ToolchainPolicyRequire && toolchain == "":
+		return fmt.Sprintf("no toolchain directive, want %s", c.version)
+	case c.policy == config.ToolchainPolicyRequire && toolchain != c.version:
+		return fmt.Sprintf("toolchain %s, want %s", toolchain, c.version)
+	case c.policy == config.ToolchainPolicyForbid && toolchain != "":
+		return fmt.Sprintf("toolchain %s is not allowed", toolchain)
+	default:
+		return ""
+	}
+}
+
+// FilterFiles filters to go.mod files outside vendor and testdata directories
+func (c *ToolchainCheck) FilterFiles(files []string) []string {
+	return filterGoModFiles(files)
+}
+
+// filterGoModFiles returns the go.mod files outside vendor and testdata
+// directories, which are not modules of the repository
+func filterGoModFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if filepath.Base(file) != fileGoMod {
+			continue
+		}
+		if slices.ContainsFunc(strings.Split(filepath.ToSlash(filepath.Dir(file)), "/"), func(part string) bool {
+			return part == "vendor" || part == "testdata"
+		}) {
+			continue
+		}
+		filtered = append(filtered, file)
+	}
+	return filtered
+}
+
+// readToolchainDirective returns the toolchain named by the toolchain
+// directive of a go.mod file, or an empty string when the file has none
+func readToolchainDirective(path string) (string, error) {
+	content, err := os.ReadFile(path) //nolint:gosec // path comes from the checked file list
+	if err != nil {
+		return "", err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "//")
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == "toolchain" {
+			return fields[1], nil
+		}
+	}
+	return "", scanner.Err()
+}
@@ -0,0 +1,115 @@
+package gotools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// newToolchainCheck returns a toolchain check with the given policy
+func newToolchainCheck(policy, version string) *ToolchainCheck {
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.ToolchainPolicy = policy
+	cfg.CheckBehaviors.ToolchainVersion = version
+	return NewToolchainCheckWithFullConfig(shared.NewContext(), cfg)
+}
+
+func TestToolchainCheckMetadata(t *testing.T) {
+	check := NewToolchainCheck()
+
+	assert.Equal(t, "toolchain", check.Name())
+	assert.NotEmpty(t, check.Description())
+	assert.Equal(t, config.ToolchainPolicyForbid, check.policy)
+
+	metadata, ok := check.Metadata().(CheckMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "toolchain", metadata.Name)
+	assert.Equal(t, 30*time.Second, metadata.DefaultTimeout)
+	assert.Equal(t, []string{fileGoMod, "api/go.mod"},
+		check.FilterFiles([]string{fileGoMod, "api/go.mod", "main.go", "vendor/x/go.mod", "pkg/testdata/m/go.mod"}))
+}
+
+func TestReadToolchainDirective(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{name: "present", content: "module example.com/a\n\ngo 1.22.0\n\ntoolchain go1.22.5\n", want: "go1.22.5"},
+		{name: "with comment", content: "module example.com/a\n\ngo 1.22.0\ntoolchain go1.23.1 // pinned\n", want: "go1.23.1"},
+		{name: "absent", content: "module example.com/a\n\ngo 1.22\n"},
+		{name: "commented out", content: "module example.com/a\n// toolchain go1.22.5\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), fileGoMod)
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+
+			got, err := readToolchainDirective(path)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestToolchainCheck_Forbid(t *testing.T) {
+	setupMultiModuleRepo(t, map[string]string{
+		".":     "module example.com/root\n\ngo 1.22.0\n",
+		"tools": "module example.com/root/tools\n\ngo 1.22.0\n\ntoolchain go1.22.5\n",
+	})
+	files := []string{fileGoMod, "tools/go.mod"}
+
+	t.Run("forbidden toolchain present", func(t *testing.T) {
+		err := newToolchainCheck(config.ToolchainPolicyForbid, "").Run(context.Background(), files)
+		require.ErrorIs(t, err, prerrors.ErrToolchainPolicy)
+
+		var checkErr *prerrors.CheckError
+		require.ErrorAs(t, err, &checkErr)
+		assert.Equal(t, []string{"tools/go.mod"}, checkErr.Files)
+		assert.Equal(t, "tools/go.mod: toolchain go1.22.5 is not allowed", checkErr.Output)
+		assert.Contains(t, checkErr.Suggestion, "-toolchain=none")
+	})
+
+	t.Run("no toolchain passes", func(t *testing.T) {
+		require.NoError(t, newToolchainCheck(config.ToolchainPolicyForbid, "").Run(context.Background(), []string{fileGoMod}))
+	})
+}
+
+func TestToolchainCheck_Require(t *testing.T) {
+	setupMultiModuleRepo(t, map[string]string{
+		".":     "module example.com/root\n\ngo 1.22.0\n\ntoolchain go1.22.5\n",
+		"api":   "module example.com/root/api\n\ngo 1.22.0\n\ntoolchain go1.22.1\n",
+		"tools": "module example.com/root/tools\n\ngo 1.22.0\n",
+	})
+
+	t.Run("required toolchain matches", func(t *testing.T) {
+		require.NoError(t, newToolchainCheck(config.ToolchainPolicyRequire, "go1.22.5").Run(context.Background(), []string{fileGoMod}))
+	})
+
+	t.Run("required toolchain mismatches", func(t *testing.T) {
+		err := newToolchainCheck(config.ToolchainPolicyRequire, "go1.22.5").Run(context.Background(), []string{fileGoMod, "api/go.mod", "tools/go.mod"})
+		require.ErrorIs(t, err, prerrors.ErrToolchainPolicy)
+
+		var checkErr *prerrors.CheckError
+		require.ErrorAs(t, err, &checkErr)
+		assert.Equal(t, []string{"api/go.mod", "tools/go.mod"}, checkErr.Files)
+		assert.Equal(t, "api/go.mod: toolchain go1.22.1, want go1.22.5\ntools/go.mod: no toolchain directive, want go1.22.5", checkErr.Output)
+		assert.Contains(t, checkErr.Message, "2 module(s)")
+		assert.Contains(t, checkErr.Suggestion, "-toolchain=go1.22.5")
+	})
+}
+
+func TestToolchainCheck_DeletedGoMod(t *testing.T) {
+	setupMultiModuleRepo(t, map[string]string{".": "module example.com/root\n\ngo 1.22.0\n"})
+	require.NoError(t, newToolchainCheck(config.ToolchainPolicyForbid, "").Run(context.Background(), []string{"gone/go.mod"}))
+}
@@ -0,0 +1,121 @@
+package gotools
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+const (
+	// fileGoWork is the Go workspace file name
+	fileGoWork = "go.work"
+
+	// fileGoWorkSum is the checksum file written next to go.work
+	fileGoWorkSum = "go.work.sum"
+)
+
+// goWorkspace is a go.work file and the module directories its use
+// directives list
+type goWorkspace struct {
+	path    string   // absolute path of go.work
+	modules []string // absolute module directories, in file order
+}
+
+// loadGoWorkspace reads go.work at the repository root. It returns nil
+// without an error when the repository has no workspace.
+func loadGoWorkspace(repoRoot string) (*goWorkspace, error) {
+	path := filepath.Join(repoRoot, fileGoWork)
+	content, err := os.ReadFile(path) //nolint:gosec // fixed file name under the repository root
+	if os.IsNotExist(err) {
+		return nil, nil //nolint:nilnil // no workspace is not an error
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	workspace := &goWorkspace{path: path}
+	inBlock := false
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "//")
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch {
+		case inBlock && fields[0] == ")":
+			inBlock = false
+			continue
+		case inBlock:
+		case fields[0] == "use" && len(fields) == 2 && fields[1] == "(":
+			inBlock = true
+			continue
+		case fields[0] == "use" && len(fields) == 2:
+			fields = fields[1:]
+		default:
+			continue
+		}
+
+		dir := fields[0]
+		if unquoted, unquoteErr := strconv.Unquote(dir); unquoteErr == nil {
+			dir = unquoted
+		}
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(repoRoot, dir)
+		}
+		workspace.modules = append(workspace.modules, filepath.Clean(dir))
+	}
+	return workspace, scanner.Err()
+}
+
+// configuredWorkspace returns the repository's Go workspace when
+// GO_PRE_COMMIT_GO_WORKSPACE is enabled, or nil. An unreadable go.work counts
+// as no workspace; the go command reports it when the checks run.
+func configuredWorkspace(cfg *config.Config, repoRoot string) *goWorkspace {
+	if cfg == nil || !cfg.CheckBehaviors.GoWorkspace {
+		return nil
+	}
+	workspace, err := loadGoWorkspace(repoRoot)
+	if err != nil {
+		return nil
+	}
+	return workspace
+}
+
+// moduleFor returns the workspace module containing dir, preferring the
+// deepest one when modules are nested, or an empty string when dir is outside
+// every module the workspace uses
+func (w *goWorkspace) moduleFor(dir string) string {
+	var match string
+	for _, module := range w.modules {
+		rel, err := filepath.Rel(module, dir)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		if len(module) > len(match) {
+			match = module
+		}
+	}
+	return match
+}
+
+// env returns the GOWORK setting for a go command run in moduleDir: the
+// workspace file for its modules, and off for modules the workspace does not
+// use, which the go command would otherwise reject
+func (w *goWorkspace) env(moduleDir string) string {
+	if w.moduleFor(moduleDir) != "" {
+		return "GOWORK=" + w.path
+	}
+	return "GOWORK=off"
+}
+
+// isGoWorkFile reports whether file is the root go.work or go.work.sum
+func isGoWorkFile(file string) bool {
+	return file == fileGoWork || file == fileGoWorkSum
+}
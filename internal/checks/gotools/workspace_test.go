@@ -0,0 +1,146 @@
+package gotools
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// workspaceConfig returns a config with GO_PRE_COMMIT_GO_WORKSPACE enabled
+func workspaceConfig() *config.Config {
+	cfg := &config.Config{}
+	cfg.CheckBehaviors.GoWorkspace = true
+	return cfg
+}
+
+// setupWorkspaceRepo creates a repository with modules a, b, and c and a
+// go.work using a and b
+func setupWorkspaceRepo(t *testing.T) string {
+	t.Helper()
+
+	setupMultiModuleRepo(t, map[string]string{
+		"a": "module example.com/a\n\ngo 1.22\n",
+		"b": "module example.com/b\n\ngo 1.22\n",
+		"c": "module example.com/c\n\ngo 1.22\n",
+	})
+	require.NoError(t, os.WriteFile(fileGoWork, []byte("go 1.22\n\nuse (\n\t./a\n\t./b // shared types\n)\n"), 0o600))
+	for _, module := range []string{"a", "b", "c"} {
+		require.NoError(t, os.WriteFile(filepath.Join(module, module+".go"), []byte("package "+module+"\n"), 0o600))
+	}
+
+	repoRoot, err := os.Getwd()
+	require.NoError(t, err)
+	return repoRoot
+}
+
+func TestLoadGoWorkspace(t *testing.T) {
+	repoRoot := t.TempDir()
+
+	workspace, err := loadGoWorkspace(repoRoot)
+	require.NoError(t, err)
+	assert.Nil(t, workspace, "no go.work is no workspace")
+
+	content := "go 1.22\n\n" +
+		"use ./tools // single line\n" +
+		"use (\n" +
+		"\t.\n" +
+		"\t\"./services/api\"\n" +
+		"\t// ./disabled\n" +
+		")\n\n" +
+		"replace example.com/x => ./x\n"
+	require.NoError(t, os.WriteFile(filepath.Join(repoRoot, fileGoWork), []byte(content), 0o600))
+
+	workspace, err = loadGoWorkspace(repoRoot)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(repoRoot, fileGoWork), workspace.path)
+	assert.Equal(t, []string{
+		filepath.Join(repoRoot, "tools"),
+		repoRoot,
+		filepath.Join(repoRoot, "services", "api"),
+	}, workspace.modules)
+}
+
+func TestGoWorkspace_ModuleFor(t *testing.T) {
+	workspace := &goWorkspace{
+		path:    "/repo/go.work",
+		modules: []string{"/repo", "/repo/services/api"},
+	}
+
+	assert.Equal(t, "/repo/services/api", workspace.moduleFor("/repo/services/api/handlers"), "deepest module wins")
+	assert.Equal(t, "/repo", workspace.moduleFor("/repo/services/apiv2"))
+	assert.Empty(t, workspace.moduleFor("/other"))
+	assert.Equal(t, "GOWORK=/repo/go.work", workspace.env("/repo/services/api"))
+
+	workspace.modules = []string{"/repo/a"}
+	assert.Equal(t, "GOWORK=off", workspace.env("/repo/c"), "modules outside the workspace build on their own")
+}
+
+func TestConfiguredWorkspace(t *testing.T) {
+	repoRoot := setupWorkspaceRepo(t)
+
+	assert.Nil(t, configuredWorkspace(nil, repoRoot))
+	assert.Nil(t, configuredWorkspace(&config.Config{}, repoRoot), "workspace mode is opt-in")
+	require.NotNil(t, configuredWorkspace(workspaceConfig(), repoRoot))
+	assert.Nil(t, configuredWorkspace(workspaceConfig(), t.TempDir()))
+}
+
+func TestModTidyCheck_WorkspaceChangeTidiesEveryModule(t *testing.T) {
+	setupWorkspaceRepo(t)
+
+	t.Run("disabled", func(t *testing.T) {
+		check := NewModTidyCheckWithConfig(shared.NewContext(), &config.Config{}, 30*time.Second)
+		assert.Empty(t, check.FilterFiles([]string{fileGoWork, fileGoWorkSum}))
+	})
+
+	check := NewModTidyCheckWithConfig(shared.NewContext(), workspaceConfig(), 30*time.Second)
+	files := check.FilterFiles([]string{fileGoWork, "README.md"})
+	assert.Equal(t, []string{fileGoWork}, files)
+
+	var commands bytes.Buffer
+	ctx := shared.WithCommandLog(context.Background(), &commands)
+	require.NoError(t, check.Run(ctx, files))
+
+	log := commands.String()
+	assert.Contains(t, log, string(filepath.Separator)+"a && go mod tidy")
+	assert.Contains(t, log, string(filepath.Separator)+"b && go mod tidy")
+	assert.NotContains(t, log, string(filepath.Separator)+"c && go mod tidy", "c is not in the workspace")
+}
+
+func TestLintCheck_WorkspaceSetsGOWORK(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script as the fake golangci-lint")
+	}
+	repoRoot := setupWorkspaceRepo(t)
+
+	// A fake golangci-lint that records where it ran and which workspace it saw
+	binDir := t.TempDir()
+	logPath := filepath.Join(binDir, "runs.log")
+	script := "#!/bin/sh\necho \"$(pwd) GOWORK=$GOWORK\" >> \"" + logPath + "\"\nexit 0\n"
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "golangci-lint"), []byte(script), 0o755)) //nolint:gosec // executable test fixture
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv("GOWORK", "")
+
+	check := NewLintCheckWithConfig(shared.NewContext(), workspaceConfig(), 30*time.Second)
+	require.NoError(t, check.Run(context.Background(), []string{"a/a.go", "b/b.go", "c/c.go"}))
+
+	content, err := os.ReadFile(logPath) //nolint:gosec // test path
+	require.NoError(t, err)
+	runs := strings.Split(strings.TrimSpace(string(content)), "\n")
+	goWork := "GOWORK=" + filepath.Join(repoRoot, fileGoWork)
+	assert.ElementsMatch(t, []string{
+		filepath.Join(repoRoot, "a") + " " + goWork,
+		filepath.Join(repoRoot, "b") + " " + goWork,
+		filepath.Join(repoRoot, "c") + " GOWORK=off",
+	}, runs)
+}
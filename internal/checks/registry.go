@@ -57,20 +57,72 @@ func NewRegistryWithConfig(cfg *config.Config) *Registry {
 		checks:    make(map[string]Check),
 		sharedCtx: shared.NewContext(),
 	}
+	r.sharedCtx.SetTempRoot(cfg.Performance.TempDir)
 
 	// Register built-in checks with full config
 	r.Register(builtin.NewWhitespaceCheckWithConfig(cfg))
-	r.Register(builtin.NewEOFCheckWithTimeout(time.Duration(cfg.CheckTimeouts.EOF) * time.Second))
+	r.Register(builtin.NewEOFCheckWithConfig(cfg))
 
 	// Register Go tool checks with shared context, config, and timeouts
-	r.Register(gotools.NewFumptCheckWithConfig(r.sharedCtx, time.Duration(cfg.CheckTimeouts.Fumpt)*time.Second))
+	r.Register(gotools.NewFumptCheckWithFullConfig(r.sharedCtx, cfg))
 	r.Register(gotools.NewLintCheckWithConfig(r.sharedCtx, cfg, time.Duration(cfg.CheckTimeouts.Lint)*time.Second))
 	r.Register(gotools.NewModTidyCheckWithConfig(r.sharedCtx, cfg, time.Duration(cfg.CheckTimeouts.ModTidy)*time.Second))
 	r.Register(gotools.NewGitleaksCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewBuildTagCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewGoIndentCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewGoVersionCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewErrorCompareCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewTestPresenceCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewGoGenerateCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewToolchainCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewModulePathCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewGoDirectiveToolchainCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewStubFuncsCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewTestPackageCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewModPairCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewErrorWrapCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewInternalImportCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewFunctionLengthCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewEnvAccessCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewTestOnlyDepsCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewGlobalVarCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewFileHeaderOrderCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewContextBackgroundCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewMajorVersionConflictCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewBuildConstraintCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(builtin.NewRepoFilesCheckWithConfig(cfg))
+	r.Register(gotools.NewErrorVarNamingCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(builtin.NewEmptyCommitCheckWithConfig(cfg))
+	r.Register(builtin.NewLargeDiffsCheckWithConfig(cfg))
+	r.Register(builtin.NewCommitSizeCheckWithConfig(cfg))
+	r.Register(builtin.NewBuildArtifactsCheckWithConfig(cfg))
+	r.Register(builtin.NewOSJunkCheckWithConfig(cfg))
+	r.Register(builtin.NewFilePermissionCheckWithConfig(cfg))
+	r.Register(builtin.NewFilenameCaseCheckWithConfig(cfg))
+	r.Register(builtin.NewDataFormatCheckWithConfig(cfg))
+	r.Register(builtin.NewShellcheckCheckWithConfig(cfg))
+	r.Register(builtin.NewDockerfileCheckWithConfig(cfg))
+	r.Register(gotools.NewContextFirstCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewForbiddenImportsCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewStructTagCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewDocCommentCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewReceiverNameCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewEmbeddedBlobCheckWithFullConfig(r.sharedCtx, cfg))
+	r.Register(gotools.NewGosecCheckWithFullConfig(r.sharedCtx, cfg))
+
+	// Register the configured external checks
+	for name, command := range cfg.ExternalChecks {
+		r.Register(plugins.NewExternalCheck(r.sharedCtx, name, command, time.Duration(cfg.Plugins.Timeout)*time.Second))
+	}
 
 	return r
 }
 
+// SharedContext returns the context shared by the registry's checks
+func (r *Registry) SharedContext() *shared.Context {
+	return r.sharedCtx
+}
+
 // Register adds a check to the registry
 func (r *Registry) Register(check Check) {
 	r.mu.Lock()
@@ -115,6 +167,26 @@ func (r *Registry) GetMetadata(name string) (CheckMetadata, bool) {
 	return r.convertMetadata(check.Metadata()), true
 }
 
+// RequiresFiles reports whether check needs at least one file to run. Checks
+// whose metadata cannot be read are assumed to need files.
+func (r *Registry) RequiresFiles(check Check) bool {
+	return r.convertMetadata(check.Metadata()).RequiresFiles
+}
+
+// NeedsNetwork reports whether the check's metadata marks it as reaching
+// the network
+func (r *Registry) NeedsNetwork(check Check) bool {
+	return r.convertMetadata(check.Metadata()).NeedsNetwork
+}
+
+// Scope returns the check's scope, ScopeFiles when its metadata sets none
+func (r *Registry) Scope(check Check) string {
+	if scope := r.convertMetadata(check.Metadata()).Scope; scope != "" {
+		return scope
+	}
+	return ScopeFiles
+}
+
 // GetAllMetadata returns metadata for all registered checks
 func (r *Registry) GetAllMetadata() []CheckMetadata {
 	r.mu.RLock()
@@ -210,6 +282,12 @@ func (e *CheckDependencyError) Error() string {
 		e.CheckName, e.DependencyType, e.Dependency)
 }
 
+// EstimatedDuration returns how long check is expected to take, from its
+// metadata
+func (r *Registry) EstimatedDuration(check Check) time.Duration {
+	return r.convertMetadata(check.Metadata()).EstimatedDuration
+}
+
 // GetEstimatedDuration returns the total estimated duration for running all checks
 func (r *Registry) GetEstimatedDuration() time.Duration {
 	r.mu.RLock()
@@ -307,6 +385,32 @@ func (r *Registry) convertMetadataReflection(checkMetadata any) CheckMetadata {
 		result.RequiresFiles = field.Bool()
 	}
 
+	if field := val.FieldByName("NeedsNetwork"); field.IsValid() && field.Kind() == reflect.Bool {
+		result.NeedsNetwork = field.Bool()
+	}
+
+	if field := val.FieldByName("Scope"); field.IsValid() && field.Kind() == reflect.String {
+		result.Scope = field.String()
+	}
+
+	if field := val.FieldByName("Cacheable"); field.IsValid() && field.Kind() == reflect.Bool {
+		result.Cacheable = field.Bool()
+	}
+
+	if field := val.FieldByName("CacheScope"); field.IsValid() && field.Kind() == reflect.String {
+		result.CacheScope = field.String()
+	}
+
+	if field := val.FieldByName("ConfigFiles"); field.IsValid() && field.Kind() == reflect.Slice {
+		files := make([]string, field.Len())
+		for i := 0; i < field.Len(); i++ {
+			if file := field.Index(i); file.Kind() == reflect.String {
+				files[i] = file.String()
+			}
+		}
+		result.ConfigFiles = files
+	}
+
 	return result
 }
 
@@ -115,12 +115,53 @@ func TestNewRegistryWithConfig(t *testing.T) {
 			name: "config with custom timeouts",
 			config: &config.Config{
 				CheckTimeouts: struct {
-					Fumpt      int
-					Lint       int
-					ModTidy    int
-					Whitespace int
-					EOF        int
-					Gitleaks   int
+					Fumpt                int
+					Lint                 int
+					ModTidy              int
+					Whitespace           int
+					EOF                  int
+					Gitleaks             int
+					BuildTags            int
+					GoIndent             int
+					EmptyCommit          int
+					GoVersion            int
+					ErrorCompare         int
+					TestPresence         int
+					GoGenerate           int
+					LargeDiffs           int
+					Toolchain            int
+					ModulePath           int
+					BuildArtifacts       int
+					OSJunk               int
+					ContextFirst         int
+					ForbiddenImports     int
+					StructTags           int
+					DocComments          int
+					ReceiverNames        int
+					FilePermissions      int
+					EmbeddedBlobs        int
+					DataFormat           int
+					Gosec                int
+					Shellcheck           int
+					Hadolint             int
+					GoDirective          int
+					StubFuncs            int
+					CommitSize           int
+					TestPackage          int
+					ModPair              int
+					ErrorWrap            int
+					InternalImport       int
+					FuncLength           int
+					EnvAccess            int
+					TestOnlyDeps         int
+					GlobalVars           int
+					FileHeaderOrder      int
+					ContextBackground    int
+					MajorVersionConflict int
+					FilenameCase         int
+					BuildConstraints     int
+					RepoFiles            int
+					ErrorVarNaming       int
 				}{
 					Fumpt:      60,
 					Lint:       120,
@@ -133,7 +174,7 @@ func TestNewRegistryWithConfig(t *testing.T) {
 			verify: func(t *testing.T, r *Registry) {
 				assert.NotNil(t, r)
 				checks := r.GetChecks()
-				assert.Len(t, checks, 6)
+				assert.Len(t, checks, 47)
 
 				// Verify all expected checks are present
 				checkNames := r.Names()
@@ -148,12 +189,53 @@ func TestNewRegistryWithConfig(t *testing.T) {
 			name: "config with zero timeouts uses defaults",
 			config: &config.Config{
 				CheckTimeouts: struct {
-					Fumpt      int
-					Lint       int
-					ModTidy    int
-					Whitespace int
-					EOF        int
-					Gitleaks   int
+					Fumpt                int
+					Lint                 int
+					ModTidy              int
+					Whitespace           int
+					EOF                  int
+					Gitleaks             int
+					BuildTags            int
+					GoIndent             int
+					EmptyCommit          int
+					GoVersion            int
+					ErrorCompare         int
+					TestPresence         int
+					GoGenerate           int
+					LargeDiffs           int
+					Toolchain            int
+					ModulePath           int
+					BuildArtifacts       int
+					OSJunk               int
+					ContextFirst         int
+					ForbiddenImports     int
+					StructTags           int
+					DocComments          int
+					ReceiverNames        int
+					FilePermissions      int
+					EmbeddedBlobs        int
+					DataFormat           int
+					Gosec                int
+					Shellcheck           int
+					Hadolint             int
+					GoDirective          int
+					StubFuncs            int
+					CommitSize           int
+					TestPackage          int
+					ModPair              int
+					ErrorWrap            int
+					InternalImport       int
+					FuncLength           int
+					EnvAccess            int
+					TestOnlyDeps         int
+					GlobalVars           int
+					FileHeaderOrder      int
+					ContextBackground    int
+					MajorVersionConflict int
+					FilenameCase         int
+					BuildConstraints     int
+					RepoFiles            int
+					ErrorVarNaming       int
 				}{
 					Fumpt:      0,
 					Lint:       0,
@@ -166,7 +248,7 @@ func TestNewRegistryWithConfig(t *testing.T) {
 			verify: func(t *testing.T, r *Registry) {
 				assert.NotNil(t, r)
 				checks := r.GetChecks()
-				assert.Len(t, checks, 6)
+				assert.Len(t, checks, 47)
 			},
 		},
 	}
@@ -230,6 +312,41 @@ func TestRegistry_GetMetadata(t *testing.T) {
 	assert.Equal(t, CheckMetadata{}, metadata)
 }
 
+// Test RequiresFiles function
+func TestRegistry_RequiresFiles(t *testing.T) {
+	r := &Registry{checks: make(map[string]Check)}
+
+	assert.True(t, r.RequiresFiles(&mockCheckWithBuiltinMetadata{name: "builtin"}))
+	assert.False(t, r.RequiresFiles(&mockCheckWithPointerMetadata{name: "pointer"}))
+	assert.True(t, r.RequiresFiles(&mockCheckWithInvalidMetadata{name: "invalid"}), "unreadable metadata should require files")
+}
+
+func TestRegistry_NeedsNetwork(t *testing.T) {
+	r := NewRegistry()
+
+	modTidy, ok := r.Get("mod-tidy")
+	require.True(t, ok)
+	assert.True(t, r.NeedsNetwork(modTidy), "go mod tidy may download modules")
+
+	whitespace, ok := r.Get("whitespace")
+	require.True(t, ok)
+	assert.False(t, r.NeedsNetwork(whitespace))
+	assert.False(t, r.NeedsNetwork(&mockCheckWithInvalidMetadata{name: "invalid"}))
+}
+
+func TestRegistry_Scope(t *testing.T) {
+	r := NewRegistry()
+
+	modTidy, ok := r.Get("mod-tidy")
+	require.True(t, ok)
+	assert.Equal(t, ScopeModule, r.Scope(modTidy), "go mod tidy runs per module")
+
+	whitespace, ok := r.Get("whitespace")
+	require.True(t, ok)
+	assert.Equal(t, ScopeFiles, r.Scope(whitespace))
+	assert.Equal(t, ScopeFiles, r.Scope(&mockCheckWithInvalidMetadata{name: "invalid"}))
+}
+
 // Test GetAllMetadata function
 func TestRegistry_GetAllMetadata(t *testing.T) {
 	r := &Registry{
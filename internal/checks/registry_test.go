@@ -2,9 +2,17 @@ package checks
 
 import (
 	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
 )
 
 // Mock check for testing
@@ -149,3 +157,42 @@ func TestCheckInterface(_ *testing.T) {
 	// Ensure our mock implements the Check interface
 	var _ Check = (*mockCheck)(nil)
 }
+
+func TestNewRegistryWithConfig_FumptAutoStage(t *testing.T) {
+	if _, err := exec.LookPath("gofumpt"); err != nil {
+		t.Skip("gofumpt not installed")
+	}
+
+	for _, autoStage := range []bool{false, true} {
+		t.Run(map[bool]string{false: "report", true: "auto-stage"}[autoStage], func(t *testing.T) {
+			dir := t.TempDir()
+			git := func(args ...string) string {
+				cmd := exec.CommandContext(context.Background(), "git", args...)
+				cmd.Dir = dir
+				out, err := cmd.CombinedOutput()
+				require.NoError(t, err, string(out))
+				return strings.TrimSpace(string(out))
+			}
+			git("init", "-q")
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/fumpt\n\ngo 1.21\n"), 0o600))
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "messy.go"), []byte("package fumpt\n\nfunc  F()  {\n}\n"), 0o600))
+			git("add", ".")
+			t.Chdir(dir)
+
+			cfg := &config.Config{}
+			cfg.CheckTimeouts.Fumpt = 30
+			cfg.CheckBehaviors.FumptAutoStage = autoStage
+			fumpt, ok := NewRegistryWithConfig(cfg).Get("fumpt")
+			require.True(t, ok)
+
+			err := fumpt.Run(context.Background(), []string{"messy.go"})
+			if autoStage {
+				require.NoError(t, err)
+				assert.Empty(t, git("diff", "--name-only"), "the formatted file is staged")
+			} else {
+				require.ErrorIs(t, err, prerrors.ErrFmtIssues)
+				assert.Equal(t, "messy.go", git("diff", "--name-only"), "the formatted file is left for the user to stage")
+			}
+		})
+	}
+}
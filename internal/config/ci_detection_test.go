@@ -211,12 +211,53 @@ func TestApplyCITimeoutAdjustments(t *testing.T) {
 					Timeout: 300,
 				},
 				CheckTimeouts: struct {
-					Fumpt      int
-					Lint       int
-					ModTidy    int
-					Whitespace int
-					EOF        int
-					Gitleaks   int
+					Fumpt                int
+					Lint                 int
+					ModTidy              int
+					Whitespace           int
+					EOF                  int
+					Gitleaks             int
+					BuildTags            int
+					GoIndent             int
+					EmptyCommit          int
+					GoVersion            int
+					ErrorCompare         int
+					TestPresence         int
+					GoGenerate           int
+					LargeDiffs           int
+					Toolchain            int
+					ModulePath           int
+					BuildArtifacts       int
+					OSJunk               int
+					ContextFirst         int
+					ForbiddenImports     int
+					StructTags           int
+					DocComments          int
+					ReceiverNames        int
+					FilePermissions      int
+					EmbeddedBlobs        int
+					DataFormat           int
+					Gosec                int
+					Shellcheck           int
+					Hadolint             int
+					GoDirective          int
+					StubFuncs            int
+					CommitSize           int
+					TestPackage          int
+					ModPair              int
+					ErrorWrap            int
+					InternalImport       int
+					FuncLength           int
+					EnvAccess            int
+					TestOnlyDeps         int
+					GlobalVars           int
+					FileHeaderOrder      int
+					ContextBackground    int
+					MajorVersionConflict int
+					FilenameCase         int
+					BuildConstraints     int
+					RepoFiles            int
+					ErrorVarNaming       int
 				}{
 					Fumpt:      30,
 					Lint:       600,
@@ -246,12 +287,53 @@ func TestApplyCITimeoutAdjustments(t *testing.T) {
 					Timeout: 180, // Custom timeout
 				},
 				CheckTimeouts: struct {
-					Fumpt      int
-					Lint       int
-					ModTidy    int
-					Whitespace int
-					EOF        int
-					Gitleaks   int
+					Fumpt                int
+					Lint                 int
+					ModTidy              int
+					Whitespace           int
+					EOF                  int
+					Gitleaks             int
+					BuildTags            int
+					GoIndent             int
+					EmptyCommit          int
+					GoVersion            int
+					ErrorCompare         int
+					TestPresence         int
+					GoGenerate           int
+					LargeDiffs           int
+					Toolchain            int
+					ModulePath           int
+					BuildArtifacts       int
+					OSJunk               int
+					ContextFirst         int
+					ForbiddenImports     int
+					StructTags           int
+					DocComments          int
+					ReceiverNames        int
+					FilePermissions      int
+					EmbeddedBlobs        int
+					DataFormat           int
+					Gosec                int
+					Shellcheck           int
+					Hadolint             int
+					GoDirective          int
+					StubFuncs            int
+					CommitSize           int
+					TestPackage          int
+					ModPair              int
+					ErrorWrap            int
+					InternalImport       int
+					FuncLength           int
+					EnvAccess            int
+					TestOnlyDeps         int
+					GlobalVars           int
+					FileHeaderOrder      int
+					ContextBackground    int
+					MajorVersionConflict int
+					FilenameCase         int
+					BuildConstraints     int
+					RepoFiles            int
+					ErrorVarNaming       int
 				}{
 					Fumpt:      30,  // Default - should be adjusted
 					Lint:       120, // Custom timeout
@@ -495,12 +577,53 @@ func BenchmarkApplyCITimeoutAdjustments(b *testing.B) {
 			Timeout: 300,
 		},
 		CheckTimeouts: struct {
-			Fumpt      int
-			Lint       int
-			ModTidy    int
-			Whitespace int
-			EOF        int
-			Gitleaks   int
+			Fumpt                int
+			Lint                 int
+			ModTidy              int
+			Whitespace           int
+			EOF                  int
+			Gitleaks             int
+			BuildTags            int
+			GoIndent             int
+			EmptyCommit          int
+			GoVersion            int
+			ErrorCompare         int
+			TestPresence         int
+			GoGenerate           int
+			LargeDiffs           int
+			Toolchain            int
+			ModulePath           int
+			BuildArtifacts       int
+			OSJunk               int
+			ContextFirst         int
+			ForbiddenImports     int
+			StructTags           int
+			DocComments          int
+			ReceiverNames        int
+			FilePermissions      int
+			EmbeddedBlobs        int
+			DataFormat           int
+			Gosec                int
+			Shellcheck           int
+			Hadolint             int
+			GoDirective          int
+			StubFuncs            int
+			CommitSize           int
+			TestPackage          int
+			ModPair              int
+			ErrorWrap            int
+			InternalImport       int
+			FuncLength           int
+			EnvAccess            int
+			TestOnlyDeps         int
+			GlobalVars           int
+			FileHeaderOrder      int
+			ContextBackground    int
+			MajorVersionConflict int
+			FilenameCase         int
+			BuildConstraints     int
+			RepoFiles            int
+			ErrorVarNaming       int
 		}{
 			Fumpt:      30,
 			Lint:       60,
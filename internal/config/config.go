@@ -3,13 +3,19 @@ package config
 
 import (
 	"fmt"
+	"maps"
 	"os"
+	"path"
 	"path/filepath"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/mrz1836/go-pre-commit/internal/envfile"
 	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/output"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
 )
 
 const (
@@ -17,6 +23,115 @@ const (
 	defaultStrValue = "default"
 )
 
+// Lint severities for GO_PRE_COMMIT_LINT_SEVERITY. Only error findings block
+// a commit; warning and info findings are reported as advisory.
+const (
+	LintSeverityError   = "error"
+	LintSeverityWarning = "warning"
+	LintSeverityInfo    = "info"
+)
+
+// Built-in check profiles, selected with "run --profile"
+const (
+	ProfileFast = "fast"
+	ProfileFull = "full"
+)
+
+// File scopes for GO_PRE_COMMIT_DEFAULT_SCOPE: which files "run" checks when
+// no file source is given on the command line
+const (
+	ScopeChanged = "changed"
+	ScopeAll     = "all"
+)
+
+// Report policies for GO_PRE_COMMIT_REPORT_ON and "run --report-on": show
+// every check's result, or only the summary unless something failed
+const (
+	ReportOnAlways  = "always"
+	ReportOnFailure = "failure"
+)
+
+// Policies for GO_PRE_COMMIT_TOOLCHAIN_POLICY: forbid any toolchain directive
+// in go.mod, or require every module to declare GO_PRE_COMMIT_TOOLCHAIN_VERSION
+const (
+	ToolchainPolicyForbid  = "forbid"
+	ToolchainPolicyRequire = "require"
+)
+
+// Modes for GO_PRE_COMMIT_MOD_TIDY_DIFF: pick "go mod tidy -diff" from the
+// installed Go version, always use it, or always run go mod tidy and compare
+// go.mod and go.sum afterwards
+const (
+	ModTidyDiffAuto   = "auto"
+	ModTidyDiffAlways = "always"
+	ModTidyDiffNever  = "never"
+)
+
+// Styles for GO_PRE_COMMIT_DATA_FORMAT_STYLE: only require JSON and YAML
+// files to parse, also enforce indentation while keeping key order, or also
+// require sorted keys
+const (
+	DataFormatNone      = "none"
+	DataFormatIndent    = "indent"
+	DataFormatCanonical = "canonical"
+)
+
+// Severities for GO_PRE_COMMIT_GOSEC_SEVERITY: the lowest gosec finding
+// severity that fails the gosec check
+const (
+	GosecSeverityLow    = "low"
+	GosecSeverityMedium = "medium"
+	GosecSeverityHigh   = "high"
+)
+
+// Severities for GO_PRE_COMMIT_SHELLCHECK_SEVERITY, passed to shellcheck's
+// --severity as the lowest finding level it reports. hadolint uses the same
+// levels, so GO_PRE_COMMIT_HADOLINT_SEVERITY takes them too.
+const (
+	ShellcheckSeverityError   = "error"
+	ShellcheckSeverityWarning = "warning"
+	ShellcheckSeverityInfo    = "info"
+	ShellcheckSeverityStyle   = "style"
+)
+
+// Categories for GO_PRE_COMMIT_COMMIT_SIZE_EXEMPT: staged files that do not
+// count toward the commit-size limit. None counts every file.
+const (
+	CommitSizeExemptGenerated = "generated"
+	CommitSizeExemptVendored  = "vendored"
+	CommitSizeExemptTestdata  = "testdata"
+	CommitSizeExemptNone      = "none"
+)
+
+// Policies for GO_PRE_COMMIT_WHITESPACE_EMPTY_FILE_POLICY: what the
+// whitespace fix leaves of a file holding nothing but whitespace, whatever
+// its length
+const (
+	WhitespaceEmptyFileEmpty   = "empty"
+	WhitespaceEmptyFileNewline = "single-newline"
+)
+
+// Backends for GO_PRE_COMMIT_GIT_BACKEND: shell out to the git binary, or
+// read the repository in-process with go-git
+const (
+	GitBackendExec  = "exec"
+	GitBackendGoGit = "go-git"
+)
+
+// PinnableTools lists the tools GO_PRE_COMMIT_TOOLS_PIN can pin
+//
+//nolint:gochecknoglobals // Read-only list shared with the runner
+var PinnableTools = []string{"go", "gofumpt", "gitleaks", "golangci-lint"}
+
+// pinVersionPattern matches pinned versions such as v2.4.0, 2.4, or go1.25.0
+var pinVersionPattern = regexp.MustCompile(`^(v|go)?\d+(\.\d+){0,2}$`)
+
+// profileEnvPrefix starts the variables that define check profiles
+const profileEnvPrefix = "GO_PRE_COMMIT_PROFILE_"
+
+// externalCheckEnvPrefix starts the variables that define external checks
+const externalCheckEnvPrefix = "GO_PRE_COMMIT_EXTERNAL_CHECK_"
+
 // Config holds the configuration for the pre-commit system
 type Config struct {
 	// Core settings
@@ -26,23 +141,126 @@ type Config struct {
 	MaxFileSize  int64  // GO_PRE_COMMIT_MAX_FILE_SIZE_MB
 	MaxFilesOpen int    // GO_PRE_COMMIT_MAX_FILES_OPEN
 	Timeout      int    // GO_PRE_COMMIT_TIMEOUT_SECONDS
+	DefaultScope string // GO_PRE_COMMIT_DEFAULT_SCOPE (changed or all; default: changed)
 
 	// Check configurations
 	Checks struct {
-		Fumpt            bool // GO_PRE_COMMIT_ENABLE_FUMPT
-		Lint             bool // GO_PRE_COMMIT_ENABLE_LINT
-		ModTidy          bool // GO_PRE_COMMIT_ENABLE_MOD_TIDY
-		Whitespace       bool // GO_PRE_COMMIT_ENABLE_WHITESPACE
-		EOF              bool // GO_PRE_COMMIT_ENABLE_EOF
-		Gitleaks         bool // GO_PRE_COMMIT_ENABLE_GITLEAKS
-		GitleaksAllFiles bool // GO_PRE_COMMIT_GITLEAKS_ALL_FILES
+		Fumpt                bool // GO_PRE_COMMIT_ENABLE_FUMPT
+		Lint                 bool // GO_PRE_COMMIT_ENABLE_LINT
+		ModTidy              bool // GO_PRE_COMMIT_ENABLE_MOD_TIDY
+		Whitespace           bool // GO_PRE_COMMIT_ENABLE_WHITESPACE
+		EOF                  bool // GO_PRE_COMMIT_ENABLE_EOF
+		Gitleaks             bool // GO_PRE_COMMIT_ENABLE_GITLEAKS
+		GitleaksAllFiles     bool // GO_PRE_COMMIT_GITLEAKS_ALL_FILES
+		BuildTags            bool // GO_PRE_COMMIT_ENABLE_BUILD_TAGS
+		GoIndent             bool // GO_PRE_COMMIT_ENABLE_GO_INDENT
+		EmptyCommit          bool // GO_PRE_COMMIT_ENABLE_EMPTY_COMMIT
+		GoVersion            bool // GO_PRE_COMMIT_ENABLE_GO_VERSION
+		ErrorCompare         bool // GO_PRE_COMMIT_ENABLE_ERROR_COMPARE
+		TestPresence         bool // GO_PRE_COMMIT_ENABLE_TEST_PRESENCE
+		GoGenerate           bool // GO_PRE_COMMIT_ENABLE_GO_GENERATE
+		LargeDiffs           bool // GO_PRE_COMMIT_ENABLE_LARGE_DIFFS
+		Toolchain            bool // GO_PRE_COMMIT_ENABLE_TOOLCHAIN
+		ModulePath           bool // GO_PRE_COMMIT_ENABLE_MODULE_PATH
+		BuildArtifacts       bool // GO_PRE_COMMIT_ENABLE_BUILD_ARTIFACTS
+		OSJunk               bool // GO_PRE_COMMIT_ENABLE_OS_JUNK
+		ContextFirst         bool // GO_PRE_COMMIT_ENABLE_CONTEXT_FIRST
+		ForbiddenImports     bool // GO_PRE_COMMIT_ENABLE_FORBIDDEN_IMPORTS
+		StructTags           bool // GO_PRE_COMMIT_ENABLE_STRUCT_TAGS
+		DocComments          bool // GO_PRE_COMMIT_ENABLE_DOC_COMMENTS
+		ReceiverNames        bool // GO_PRE_COMMIT_ENABLE_RECEIVER_NAMES
+		FilePermissions      bool // GO_PRE_COMMIT_ENABLE_FILE_PERMISSIONS
+		EmbeddedBlobs        bool // GO_PRE_COMMIT_ENABLE_EMBEDDED_BLOBS
+		DataFormat           bool // GO_PRE_COMMIT_ENABLE_DATA_FORMAT
+		Gosec                bool // GO_PRE_COMMIT_ENABLE_GOSEC
+		Shellcheck           bool // GO_PRE_COMMIT_ENABLE_SHELLCHECK
+		Hadolint             bool // GO_PRE_COMMIT_ENABLE_HADOLINT
+		GoDirective          bool // GO_PRE_COMMIT_ENABLE_GO_DIRECTIVE
+		StubFuncs            bool // GO_PRE_COMMIT_ENABLE_STUB_FUNCS
+		CommitSize           bool // GO_PRE_COMMIT_ENABLE_COMMIT_SIZE
+		TestPackage          bool // GO_PRE_COMMIT_ENABLE_TEST_PACKAGE
+		ModPair              bool // GO_PRE_COMMIT_ENABLE_MOD_PAIR
+		ErrorWrap            bool // GO_PRE_COMMIT_ENABLE_ERROR_WRAP
+		InternalImport       bool // GO_PRE_COMMIT_ENABLE_INTERNAL_IMPORT
+		FuncLength           bool // GO_PRE_COMMIT_ENABLE_FUNC_LENGTH
+		EnvAccess            bool // GO_PRE_COMMIT_ENABLE_ENV_ACCESS
+		TestOnlyDeps         bool // GO_PRE_COMMIT_ENABLE_TEST_ONLY_DEPS
+		GlobalVars           bool // GO_PRE_COMMIT_ENABLE_GLOBAL_VARS
+		FileHeaderOrder      bool // GO_PRE_COMMIT_ENABLE_FILE_HEADER_ORDER
+		ContextBackground    bool // GO_PRE_COMMIT_ENABLE_CONTEXT_BACKGROUND
+		MajorVersionConflict bool // GO_PRE_COMMIT_ENABLE_MAJOR_VERSION_CONFLICT
+		FilenameCase         bool // GO_PRE_COMMIT_ENABLE_FILENAME_CASE
+		BuildConstraints     bool // GO_PRE_COMMIT_ENABLE_BUILD_CONSTRAINTS
+		RepoFiles            bool // GO_PRE_COMMIT_ENABLE_REPO_FILES
+		ErrorVarNaming       bool // GO_PRE_COMMIT_ENABLE_ERROR_VAR_NAMING
 	}
 
 	// Check behaviors
 	CheckBehaviors struct {
-		FumptAutoStage      bool // GO_PRE_COMMIT_FUMPT_AUTO_STAGE
-		WhitespaceAutoStage bool // GO_PRE_COMMIT_WHITESPACE_AUTO_STAGE
-		EOFAutoStage        bool // GO_PRE_COMMIT_EOF_AUTO_STAGE
+		FumptAutoStage            bool              // GO_PRE_COMMIT_FUMPT_AUTO_STAGE
+		WhitespaceAutoStage       bool              // GO_PRE_COMMIT_WHITESPACE_AUTO_STAGE
+		EOFAutoStage              bool              // GO_PRE_COMMIT_EOF_AUTO_STAGE
+		BuildTagsAutoFix          bool              // GO_PRE_COMMIT_BUILD_TAGS_AUTO_FIX
+		WarnOnly                  []string          // GO_PRE_COMMIT_WARN_ONLY_CHECKS
+		MaxLines                  map[string]int    // GO_PRE_COMMIT_CHECK_MAX_LINES (e.g. "whitespace=5000,eof=5000")
+		WorkDir                   map[string]string // GO_PRE_COMMIT_CHECK_WORKDIR (e.g. "frontend=web") - directory, relative to the repository root, a check's commands run in
+		GoVersionAllow            []string          // GO_PRE_COMMIT_GO_VERSION_ALLOW (module dirs that may declare a different go version)
+		ErrorCompareSkipTests     bool              // GO_PRE_COMMIT_ERROR_COMPARE_SKIP_TESTS (default: false)
+		LintPackageThreshold      int               // GO_PRE_COMMIT_LINT_PACKAGE_THRESHOLD (default: 100, 0 disables)
+		LintSeverity              map[string]string // GO_PRE_COMMIT_LINT_SEVERITY (e.g. "gosec=error,revive=warning"; unmapped linters are errors)
+		TestPresenceSeverity      string            // GO_PRE_COMMIT_TEST_PRESENCE_SEVERITY (warning or error; default: warning)
+		MaxDiffLines              int               // GO_PRE_COMMIT_MAX_DIFF_LINES (default: 1000)
+		LargeDiffsSeverity        string            // GO_PRE_COMMIT_LARGE_DIFFS_SEVERITY (warning or error; default: warning)
+		ToolchainPolicy           string            // GO_PRE_COMMIT_TOOLCHAIN_POLICY (forbid or require; default: forbid)
+		ToolchainVersion          string            // GO_PRE_COMMIT_TOOLCHAIN_VERSION (required toolchain, e.g. go1.22.5)
+		ModulePathPrefix          string            // GO_PRE_COMMIT_MODULE_PATH_PREFIX (required module path prefix, e.g. github.com/acme)
+		ResolveFormatterConflicts bool              // GO_PRE_COMMIT_RESOLVE_FORMATTER_CONFLICTS (default: false) - skip fumpt when .golangci.yml enables gofumpt, gci, or goimports
+		ReRunValidatorsAfterFix   bool              // GO_PRE_COMMIT_RERUN_VALIDATORS_AFTER_FIX (default: true) - run formatting checks first so validators see the fixed files
+		GoWorkspace               bool              // GO_PRE_COMMIT_GO_WORKSPACE (default: false) - run lint and mod-tidy per module listed in go.work
+		BuildArtifactPatterns     []string          // GO_PRE_COMMIT_BUILD_ARTIFACT_PATTERNS (default: coverage.out,*.prof,*.pprof,*.test)
+		OSJunkPatterns            []string          // GO_PRE_COMMIT_OS_JUNK_PATTERNS (default: .DS_Store,Thumbs.db,desktop.ini)
+		OSJunkAutoFix             bool              // GO_PRE_COMMIT_OS_JUNK_AUTO_FIX (default: false) - unstage junk files with git rm --cached
+		ModTidyDiff               string            // GO_PRE_COMMIT_MOD_TIDY_DIFF (auto, always, or never; default: auto)
+		EOFExempt                 []string          // GO_PRE_COMMIT_EOF_EXEMPT (e.g. "*.golden.json,testdata/raw.txt") - files that may lack a final newline
+		ForbiddenImports          []string          // GO_PRE_COMMIT_FORBIDDEN_IMPORTS (default: io/ioutil) - exact paths, globs, or "path/..." prefixes
+		ForbiddenImportsAllow     []string          // GO_PRE_COMMIT_FORBIDDEN_IMPORTS_ALLOW - files that may import anything on the deny list
+		StructTagKeys             []string          // GO_PRE_COMMIT_STRUCT_TAG_KEYS (e.g. "json,yaml,db") - allowed struct tag keys; empty allows any
+		DocCommentsSkipMain       bool              // GO_PRE_COMMIT_DOC_COMMENTS_SKIP_MAIN (default: true) - leave main packages out of doc-comments
+		FixChmodWritable          bool              // GO_PRE_COMMIT_FIX_CHMOD_WRITABLE (default: false) - let whitespace and eof fix read-only files, restoring their mode
+		ReceiverNameMaxLength     int               // GO_PRE_COMMIT_RECEIVER_NAME_MAX_LENGTH (default: 2, 0 = no limit) - longest receiver name receiver-names accepts
+		ContinueOnError           []string          // GO_PRE_COMMIT_CONTINUE_ON_ERROR_CHECKS - checks whose failures are recorded and reported as failures without failing the run
+		FilePermissionModes       []string          // GO_PRE_COMMIT_FILE_PERMISSION_MODES (default: 100644,100755) - git modes file-permissions allows
+		FilePermissionsAutoFix    bool              // GO_PRE_COMMIT_FILE_PERMISSIONS_AUTO_FIX (default: false) - fix modes with git update-index --chmod and remove world write permission
+		EmbeddedBlobMinLength     int               // GO_PRE_COMMIT_EMBEDDED_BLOB_MIN_LENGTH (default: 1024) - shortest base64 string literal embedded-blobs flags
+		WhitespacePreserveMtime   bool              // GO_PRE_COMMIT_WHITESPACE_PRESERVE_MTIME (default: false) - keep the modification time of files the whitespace fix rewrites
+		DataFormatStyle           string            // GO_PRE_COMMIT_DATA_FORMAT_STYLE (none, indent, or canonical; default: indent)
+		DataFormatIndent          int               // GO_PRE_COMMIT_DATA_FORMAT_INDENT (default: 2) - spaces per JSON and YAML indentation level
+		GosecSeverity             string            // GO_PRE_COMMIT_GOSEC_SEVERITY (low, medium, or high; default: medium) - lowest gosec severity that fails the check
+		ShellcheckSeverity        string            // GO_PRE_COMMIT_SHELLCHECK_SEVERITY (error, warning, info, or style; default: style) - lowest shellcheck level reported
+		HadolintSeverity          string            // GO_PRE_COMMIT_HADOLINT_SEVERITY (error, warning, info, or style; default: info) - lowest hadolint level that fails the check
+		StubFuncsSeverity         string            // GO_PRE_COMMIT_STUB_FUNCS_SEVERITY (warning or error; default: warning)
+		MaxStagedFiles            int               // GO_PRE_COMMIT_MAX_STAGED_FILES (default: 50)
+		CommitSizeSeverity        string            // GO_PRE_COMMIT_COMMIT_SIZE_SEVERITY (warning or error; default: warning)
+		CommitSizeExempt          []string          // GO_PRE_COMMIT_COMMIT_SIZE_EXEMPT (generated, vendored, testdata, or none; default: generated) - categories not counted
+		WhitespaceMarkdownBreaks  bool              // GO_PRE_COMMIT_WHITESPACE_MARKDOWN_HARD_BREAKS (default: true) - keep two trailing spaces that end a Markdown line as a hard line break
+		WhitespaceSeverity        string            // GO_PRE_COMMIT_WHITESPACE_SEVERITY (warning or error; default: error) - warning still fixes files but does not block
+		WhitespaceEmptyFilePolicy string            // GO_PRE_COMMIT_WHITESPACE_EMPTY_FILE_POLICY (empty or single-newline; default: single-newline) - what a whitespace-only file becomes
+		WhitespaceAddFinalNewline bool              // GO_PRE_COMMIT_WHITESPACE_ADD_FINAL_NEWLINE (default: false) - end files the whitespace fix rewrites with a newline when they lack one
+		EOFSeverity               string            // GO_PRE_COMMIT_EOF_SEVERITY (warning or error; default: error) - warning still fixes files but does not block
+		InternalImportAllow       []string          // GO_PRE_COMMIT_INTERNAL_IMPORT_ALLOW - internal import paths any module may import: exact, globs, or "path/..." prefixes
+		FuncLengthMaxLines        int               // GO_PRE_COMMIT_FUNC_LENGTH_MAX_LINES (default: 80, 0 = no limit)
+		FuncLengthMaxStatements   int               // GO_PRE_COMMIT_FUNC_LENGTH_MAX_STATEMENTS (default: 50, 0 = no limit)
+		FuncLengthSeverity        string            // GO_PRE_COMMIT_FUNC_LENGTH_SEVERITY (warning or error; default: warning)
+		EnvAccessAllow            []string          // GO_PRE_COMMIT_ENV_ACCESS_ALLOW (default: internal/config) - package directories that may read the environment: exact, globs, or "dir/..." prefixes
+		TestOnlyDepsAllow         []string          // GO_PRE_COMMIT_TEST_ONLY_DEPS_ALLOW (default: github.com/stretchr/testify) - modules expected to be imported only by tests: exact, globs, or "path/..." prefixes
+		GlobalVarsAllow           []string          // GO_PRE_COMMIT_GLOBAL_VARS_ALLOW (default: version,commit) - package-level variable names global-vars accepts: exact or globs
+		GlobalVarsSeverity        string            // GO_PRE_COMMIT_GLOBAL_VARS_SEVERITY (warning or error; default: warning)
+		FileHeaderLicenseMarkers  []string          // GO_PRE_COMMIT_FILE_HEADER_LICENSE_MARKERS (default: Copyright,SPDX-License-Identifier) - text that makes a comment a license comment, matched regardless of case
+		ContextBackgroundAllow    []string          // GO_PRE_COMMIT_CONTEXT_BACKGROUND_ALLOW (default: none) - package directories that may call context.Background or context.TODO: exact, globs, or "dir/..." prefixes
+		ContextBackgroundSeverity string            // GO_PRE_COMMIT_CONTEXT_BACKGROUND_SEVERITY (warning or error; default: warning)
+		MajorVersionConflictAllow []string          // GO_PRE_COMMIT_MAJOR_VERSION_CONFLICT_ALLOW (default: none) - modules, without their major suffix, that may be imported at several major versions: exact, globs, or "path/..." prefixes
+		RepoFilesRequired         []string          // GO_PRE_COMMIT_REPO_FILES_REQUIRED (default: LICENSE*,README*) - globs each matching a file at the repository root, regardless of case
+		ErrorVarNamingSeverity    string            // GO_PRE_COMMIT_ERROR_VAR_NAMING_SEVERITY (warning or error; default: warning)
 	}
 
 	// Tool versions
@@ -50,28 +268,112 @@ type Config struct {
 		Fumpt        string // GO_PRE_COMMIT_FUMPT_VERSION
 		GolangciLint string // GO_PRE_COMMIT_GOLANGCI_LINT_VERSION
 		Gitleaks     string // GO_PRE_COMMIT_GITLEAKS_VERSION
+
+		// Pin maps tool names (go, golangci-lint, gofumpt, gitleaks) to the
+		// versions checks must run with, so local runs match CI
+		Pin map[string]string // GO_PRE_COMMIT_TOOLS_PIN (e.g. "golangci-lint=v2.4.0,go=1.25")
+	}
+
+	// Runner settings
+	Runner struct {
+		OverallTimeout   int  // GO_PRE_COMMIT_OVERALL_TIMEOUT_SECONDS (default: 0, uses GO_PRE_COMMIT_TIMEOUT_SECONDS) - cancels every check when reached
+		PerCheckTimeout  int  // GO_PRE_COMMIT_PER_CHECK_TIMEOUT_SECONDS (default: 0, each check's own timeout) - stops one check without affecting the others
+		Offline          bool // GO_PRE_COMMIT_OFFLINE (default: false) - skip checks that need the network
+		MaxCommitTime    int  // GO_PRE_COMMIT_MAX_COMMIT_TIME_SECONDS (default: 0, no budget) - run quick checks first and skip slow ones that would not fit
+		SkipMissingTools bool // GO_PRE_COMMIT_SKIP_MISSING_TOOLS (default: false) - skip checks whose tool is not installed instead of failing
+
+		// AutoSelect runs only the checks whose file patterns match the files
+		// being checked; AutoSelectChecks bounds the checks it may pick and
+		// defaults to the enabled ones
+		AutoSelect       bool     // GO_PRE_COMMIT_AUTO_SELECT (default: false)
+		AutoSelectChecks []string // GO_PRE_COMMIT_AUTO_SELECT_CHECKS (default: the enabled checks)
+	}
+
+	// Exit codes for "run"; a clean run always exits 0 and 0 here keeps the default
+	ExitCodes struct {
+		Failure int // GO_PRE_COMMIT_EXIT_CODE_FAILURE (default: 1) - a check failed and nothing could fix it
+		Fixed   int // GO_PRE_COMMIT_EXIT_CODE_FIXED (default: 2) - checks fixed every problem; re-stage and commit again
+		Setup   int // GO_PRE_COMMIT_EXIT_CODE_SETUP (default: 3) - configuration or environment error before checks ran
+		NoFiles int // GO_PRE_COMMIT_EXIT_CODE_NO_FILES (default: 0) - no files to check, used with GO_PRE_COMMIT_EMPTY_COMMIT_NOTICE
 	}
 
 	// Performance settings
 	Performance struct {
-		ParallelWorkers int  // GO_PRE_COMMIT_PARALLEL_WORKERS
-		FailFast        bool // GO_PRE_COMMIT_FAIL_FAST
+		ParallelWorkers int    // GO_PRE_COMMIT_PARALLEL_WORKERS
+		FailFast        bool   // GO_PRE_COMMIT_FAIL_FAST
+		MaxLineSizeKB   int    // GO_PRE_COMMIT_MAX_LINE_SIZE_KB (default: 10240)
+		LoadAware       bool   // GO_PRE_COMMIT_LOAD_AWARE (default: false)
+		TempDir         string // GO_PRE_COMMIT_TEMP_DIR (default: system temp directory) - where checks create scratch workspaces
 	}
 
 	// Check timeouts (in seconds)
 	CheckTimeouts struct {
-		Fumpt      int // GO_PRE_COMMIT_FUMPT_TIMEOUT (default: 30)
-		Lint       int // GO_PRE_COMMIT_LINT_TIMEOUT (default: 600)
-		ModTidy    int // GO_PRE_COMMIT_MOD_TIDY_TIMEOUT (default: 60)
-		Whitespace int // GO_PRE_COMMIT_WHITESPACE_TIMEOUT (default: 30)
-		EOF        int // GO_PRE_COMMIT_EOF_TIMEOUT (default: 30)
-		Gitleaks   int // GO_PRE_COMMIT_GITLEAKS_TIMEOUT (default: 60)
+		Fumpt                int // GO_PRE_COMMIT_FUMPT_TIMEOUT (default: 30)
+		Lint                 int // GO_PRE_COMMIT_LINT_TIMEOUT (default: 600)
+		ModTidy              int // GO_PRE_COMMIT_MOD_TIDY_TIMEOUT (default: 60)
+		Whitespace           int // GO_PRE_COMMIT_WHITESPACE_TIMEOUT (default: 30)
+		EOF                  int // GO_PRE_COMMIT_EOF_TIMEOUT (default: 30)
+		Gitleaks             int // GO_PRE_COMMIT_GITLEAKS_TIMEOUT (default: 60)
+		BuildTags            int // GO_PRE_COMMIT_BUILD_TAGS_TIMEOUT (default: 30)
+		GoIndent             int // GO_PRE_COMMIT_GO_INDENT_TIMEOUT (default: 30)
+		EmptyCommit          int // GO_PRE_COMMIT_EMPTY_COMMIT_TIMEOUT (default: 10)
+		GoVersion            int // GO_PRE_COMMIT_GO_VERSION_TIMEOUT (default: 30)
+		ErrorCompare         int // GO_PRE_COMMIT_ERROR_COMPARE_TIMEOUT (default: 30)
+		TestPresence         int // GO_PRE_COMMIT_TEST_PRESENCE_TIMEOUT (default: 30)
+		GoGenerate           int // GO_PRE_COMMIT_GO_GENERATE_TIMEOUT (default: 120)
+		LargeDiffs           int // GO_PRE_COMMIT_LARGE_DIFFS_TIMEOUT (default: 10)
+		Toolchain            int // GO_PRE_COMMIT_TOOLCHAIN_TIMEOUT (default: 30)
+		ModulePath           int // GO_PRE_COMMIT_MODULE_PATH_TIMEOUT (default: 30)
+		BuildArtifacts       int // GO_PRE_COMMIT_BUILD_ARTIFACTS_TIMEOUT (default: 10)
+		OSJunk               int // GO_PRE_COMMIT_OS_JUNK_TIMEOUT (default: 10)
+		ContextFirst         int // GO_PRE_COMMIT_CONTEXT_FIRST_TIMEOUT (default: 30)
+		ForbiddenImports     int // GO_PRE_COMMIT_FORBIDDEN_IMPORTS_TIMEOUT (default: 30)
+		StructTags           int // GO_PRE_COMMIT_STRUCT_TAGS_TIMEOUT (default: 30)
+		DocComments          int // GO_PRE_COMMIT_DOC_COMMENTS_TIMEOUT (default: 30)
+		ReceiverNames        int // GO_PRE_COMMIT_RECEIVER_NAMES_TIMEOUT (default: 30)
+		FilePermissions      int // GO_PRE_COMMIT_FILE_PERMISSIONS_TIMEOUT (default: 10)
+		EmbeddedBlobs        int // GO_PRE_COMMIT_EMBEDDED_BLOBS_TIMEOUT (default: 30)
+		DataFormat           int // GO_PRE_COMMIT_DATA_FORMAT_TIMEOUT (default: 30)
+		Gosec                int // GO_PRE_COMMIT_GOSEC_TIMEOUT (default: 120)
+		Shellcheck           int // GO_PRE_COMMIT_SHELLCHECK_TIMEOUT (default: 60)
+		Hadolint             int // GO_PRE_COMMIT_HADOLINT_TIMEOUT (default: 60)
+		GoDirective          int // GO_PRE_COMMIT_GO_DIRECTIVE_TIMEOUT (default: 30)
+		StubFuncs            int // GO_PRE_COMMIT_STUB_FUNCS_TIMEOUT (default: 30)
+		CommitSize           int // GO_PRE_COMMIT_COMMIT_SIZE_TIMEOUT (default: 10)
+		TestPackage          int // GO_PRE_COMMIT_TEST_PACKAGE_TIMEOUT (default: 30)
+		ModPair              int // GO_PRE_COMMIT_MOD_PAIR_TIMEOUT (default: 60)
+		ErrorWrap            int // GO_PRE_COMMIT_ERROR_WRAP_TIMEOUT (default: 30)
+		InternalImport       int // GO_PRE_COMMIT_INTERNAL_IMPORT_TIMEOUT (default: 30)
+		FuncLength           int // GO_PRE_COMMIT_FUNC_LENGTH_TIMEOUT (default: 30)
+		EnvAccess            int // GO_PRE_COMMIT_ENV_ACCESS_TIMEOUT (default: 30)
+		TestOnlyDeps         int // GO_PRE_COMMIT_TEST_ONLY_DEPS_TIMEOUT (default: 60)
+		GlobalVars           int // GO_PRE_COMMIT_GLOBAL_VARS_TIMEOUT (default: 30)
+		FileHeaderOrder      int // GO_PRE_COMMIT_FILE_HEADER_ORDER_TIMEOUT (default: 30)
+		ContextBackground    int // GO_PRE_COMMIT_CONTEXT_BACKGROUND_TIMEOUT (default: 30)
+		MajorVersionConflict int // GO_PRE_COMMIT_MAJOR_VERSION_CONFLICT_TIMEOUT (default: 30)
+		FilenameCase         int // GO_PRE_COMMIT_FILENAME_CASE_TIMEOUT (default: 10)
+		BuildConstraints     int // GO_PRE_COMMIT_BUILD_CONSTRAINTS_TIMEOUT (default: 30)
+		RepoFiles            int // GO_PRE_COMMIT_REPO_FILES_TIMEOUT (default: 10)
+		ErrorVarNaming       int // GO_PRE_COMMIT_ERROR_VAR_NAMING_TIMEOUT (default: 30)
 	}
 
 	// Git settings
 	Git struct {
-		HooksPath       string   // GO_PRE_COMMIT_HOOKS_PATH (default: .git/hooks)
-		ExcludePatterns []string // GO_PRE_COMMIT_EXCLUDE_PATTERNS
+		HooksPath              string   // GO_PRE_COMMIT_HOOKS_PATH (default: .git/hooks)
+		ExcludePatterns        []string // GO_PRE_COMMIT_EXCLUDE_PATTERNS
+		SkipDotfiles           bool     // GO_PRE_COMMIT_SKIP_DOTFILES (default: false) - skip hidden files and directories
+		DotfileIncludes        []string // GO_PRE_COMMIT_DOTFILE_INCLUDES - hidden paths still checked when skipping dotfiles
+		ContentSniffExtensions []string // GO_PRE_COMMIT_CONTENT_SNIFF_EXTENSIONS - known text extensions still classified by content (e.g. ".txt,.csv")
+		Backend                string   // GO_PRE_COMMIT_GIT_BACKEND (exec or go-git; default: exec) - how staged files and status are read
+		ExcludeDirs            []string // GO_PRE_COMMIT_EXCLUDE_DIRS - repository-relative directories no check looks at, whatever else selects their files
+		LockAttempts           int      // GO_PRE_COMMIT_GIT_LOCK_ATTEMPTS (default: 3; 0 uses the default) - tries of a git index command while another git process holds index.lock
+	}
+
+	// Commands run before and after the checks; each list is separated by
+	// semicolons and every command runs with sh -c from the repository root
+	Hooks struct {
+		PreRun  []string // GO_PRE_COMMIT_PRE_RUN - a failing command aborts the run
+		PostRun []string // GO_PRE_COMMIT_POST_RUN - failures are reported as warnings
 	}
 
 	// Go module settings
@@ -81,7 +383,15 @@ type Config struct {
 
 	// UI settings
 	UI struct {
-		ColorOutput bool // GO_PRE_COMMIT_COLOR_OUTPUT (default: true)
+		ColorOutput       bool   // GO_PRE_COMMIT_COLOR_OUTPUT (default: true)
+		MaxFilesInSummary int    // GO_PRE_COMMIT_MAX_FILES_IN_SUMMARY (default: 20; 0 lists every file)
+		HeartbeatInterval int    // GO_PRE_COMMIT_HEARTBEAT_INTERVAL (default: 5 seconds; 0 disables)
+		EmptyCommitNotice bool   // GO_PRE_COMMIT_EMPTY_COMMIT_NOTICE (default: false) - say "No files to check" when no enabled check had files
+		Theme             string // GO_PRE_COMMIT_OUTPUT_THEME (default, high-contrast, or monochrome; default: default) - colors used when color is on
+		ProgressBar       bool   // GO_PRE_COMMIT_PROGRESS_BAR (default: false) - show a progress bar instead of a line per passing check
+		DiffContextLines  int    // GO_PRE_COMMIT_DIFF_CONTEXT_LINES (default: 3; -1 keeps all) - context lines shown around each change in diffs
+		DiffMaxLinesShown int    // GO_PRE_COMMIT_DIFF_MAX_LINES_SHOWN (default: 100; 0 shows every line) - diff lines shown per file before "(N more lines changed)"
+		RedactDiffSecrets bool   // GO_PRE_COMMIT_REDACT_DIFF_SECRETS (default: true) - show diff lines holding secrets, such as private keys, as "****"
 	}
 
 	// Tool installation settings
@@ -102,21 +412,62 @@ type Config struct {
 		Directory string // GO_PRE_COMMIT_PLUGIN_DIR
 		Timeout   int    // GO_PRE_COMMIT_PLUGIN_TIMEOUT
 	}
+
+	// Result cache settings
+	Cache struct {
+		Enabled bool   // GO_PRE_COMMIT_ENABLE_RESULT_CACHE (default: false)
+		File    string // GO_PRE_COMMIT_RESULT_CACHE_FILE (default: .git/go-pre-commit-cache.json)
+	}
+
+	// Reporting settings
+	Reporting struct {
+		GitHubReview bool   // GO_PRE_COMMIT_GITHUB_REVIEW (default: false) - post diagnostics as pull request review comments
+		ReportOn     string // GO_PRE_COMMIT_REPORT_ON (always or failure; default: always) - when to show per-check results
+	}
+
+	// Check profiles: named sets of checks that replace the enable flags for a run
+	Profiles map[string][]string // GO_PRE_COMMIT_PROFILE_<NAME> (e.g. GO_PRE_COMMIT_PROFILE_FAST="whitespace,eof")
+
+	// External checks: commands run as checks, enabled by being defined and
+	// bounded by GO_PRE_COMMIT_PLUGIN_TIMEOUT
+	ExternalChecks map[string]string // GO_PRE_COMMIT_EXTERNAL_CHECK_<NAME> (e.g. GO_PRE_COMMIT_EXTERNAL_CHECK_LICENSES="./scripts/licenses.sh")
+
+	// Required checks: checks every run must actually execute, so CI notices
+	// one left disabled, skipped, or without files or its tool
+	RequiredChecks []string // GO_PRE_COMMIT_REQUIRED_CHECKS (e.g. "lint,gitleaks")
 }
 
-// Load reads configuration from modular .github/env/*.env files or legacy .github/.env.base
+// Load reads configuration from modular .github/env/*.env files or legacy
+// .github/.env.base, then fills in settings the environment leaves unset from
+// a .go-pre-commit.{yml,yaml,toml,json} config file. A config file alone is
+// enough; the env files are optional when one is found.
 func Load() (*Config, error) {
+	cfg, err := loadSettings(findConfigFile(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate configuration
+	if err = cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("configuration validation failed: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// loadSettings reads the env files and, when applyFile is set, the config
+// file at configFile into a Config without validating it. An unapplied
+// configFile still counts as configuration, so a repository configured only
+// by a broken file is not reported as unconfigured.
+func loadSettings(configFile string, applyFile bool) (*Config, error) {
+
 	// Try modular mode first (preferred)
 	if envDir := findEnvDir(); envDir != "" {
 		if err := envfile.LoadDir(envDir, isCI()); err != nil {
 			return nil, fmt.Errorf("failed to load modular configuration from %s: %w", envDir, err)
 		}
-	} else {
+	} else if basePath, err := findBaseEnvFile(); err == nil {
 		// Fall back to legacy mode
-		basePath, err := findBaseEnvFile()
-		if err != nil {
-			return nil, fmt.Errorf("failed to load configuration: %w", err)
-		}
 		if loadErr := envfile.Load(basePath); loadErr != nil {
 			return nil, fmt.Errorf("failed to load %s: %w", basePath, loadErr)
 		}
@@ -126,6 +477,14 @@ func Load() (*Config, error) {
 				return nil, fmt.Errorf("failed to load %s: %w", customPath, overloadErr)
 			}
 		}
+	} else if configFile == "" {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	if applyFile {
+		if err := applyConfigFiles(configFile); err != nil {
+			return nil, err
+		}
 	}
 
 	cfg := &Config{
@@ -138,6 +497,28 @@ func Load() (*Config, error) {
 	cfg.MaxFileSize = int64(getIntEnv("GO_PRE_COMMIT_MAX_FILE_SIZE_MB", 10)) * 1024 * 1024
 	cfg.MaxFilesOpen = getIntEnv("GO_PRE_COMMIT_MAX_FILES_OPEN", 100)
 	cfg.Timeout = getIntEnv("GO_PRE_COMMIT_TIMEOUT_SECONDS", 720) // Global timeout in seconds (updated default)
+	cfg.DefaultScope = strings.ToLower(getStringEnv("GO_PRE_COMMIT_DEFAULT_SCOPE", ScopeChanged))
+
+	// Runner settings
+	cfg.Runner.OverallTimeout = getIntEnv("GO_PRE_COMMIT_OVERALL_TIMEOUT_SECONDS", 0)
+	cfg.Runner.PerCheckTimeout = getIntEnv("GO_PRE_COMMIT_PER_CHECK_TIMEOUT_SECONDS", 0)
+	cfg.Runner.MaxCommitTime = getIntEnv("GO_PRE_COMMIT_MAX_COMMIT_TIME_SECONDS", 0)
+	cfg.Runner.Offline = getBoolEnv("GO_PRE_COMMIT_OFFLINE", false)
+	cfg.Runner.SkipMissingTools = getBoolEnv("GO_PRE_COMMIT_SKIP_MISSING_TOOLS", false)
+	cfg.Runner.AutoSelect = getBoolEnv("GO_PRE_COMMIT_AUTO_SELECT", false)
+	if autoSelectChecks := getStringEnv("GO_PRE_COMMIT_AUTO_SELECT_CHECKS", ""); autoSelectChecks != "" {
+		for _, name := range strings.Split(autoSelectChecks, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				cfg.Runner.AutoSelectChecks = append(cfg.Runner.AutoSelectChecks, name)
+			}
+		}
+	}
+
+	// Exit codes
+	cfg.ExitCodes.Failure = getIntEnv("GO_PRE_COMMIT_EXIT_CODE_FAILURE", 1)
+	cfg.ExitCodes.Fixed = getIntEnv("GO_PRE_COMMIT_EXIT_CODE_FIXED", 2)
+	cfg.ExitCodes.Setup = getIntEnv("GO_PRE_COMMIT_EXIT_CODE_SETUP", 3)
+	cfg.ExitCodes.NoFiles = getIntEnv("GO_PRE_COMMIT_EXIT_CODE_NO_FILES", 0)
 
 	// Check configurations
 	cfg.Checks.Fumpt = getBoolEnv("GO_PRE_COMMIT_ENABLE_FUMPT", true)
@@ -147,20 +528,208 @@ func Load() (*Config, error) {
 	cfg.Checks.EOF = getBoolEnv("GO_PRE_COMMIT_ENABLE_EOF", true)
 	cfg.Checks.Gitleaks = getBoolEnv("GO_PRE_COMMIT_ENABLE_GITLEAKS", false)
 	cfg.Checks.GitleaksAllFiles = getBoolEnv("GO_PRE_COMMIT_GITLEAKS_ALL_FILES", false)
+	cfg.Checks.BuildTags = getBoolEnv("GO_PRE_COMMIT_ENABLE_BUILD_TAGS", false)
+	cfg.Checks.GoIndent = getBoolEnv("GO_PRE_COMMIT_ENABLE_GO_INDENT", false)
+	cfg.Checks.EmptyCommit = getBoolEnv("GO_PRE_COMMIT_ENABLE_EMPTY_COMMIT", false)
+	cfg.Checks.GoVersion = getBoolEnv("GO_PRE_COMMIT_ENABLE_GO_VERSION", false)
+	cfg.Checks.ErrorCompare = getBoolEnv("GO_PRE_COMMIT_ENABLE_ERROR_COMPARE", false)
+	cfg.Checks.TestPresence = getBoolEnv("GO_PRE_COMMIT_ENABLE_TEST_PRESENCE", false)
+	cfg.Checks.GoGenerate = getBoolEnv("GO_PRE_COMMIT_ENABLE_GO_GENERATE", false)
+	cfg.Checks.LargeDiffs = getBoolEnv("GO_PRE_COMMIT_ENABLE_LARGE_DIFFS", false)
+	cfg.Checks.Toolchain = getBoolEnv("GO_PRE_COMMIT_ENABLE_TOOLCHAIN", false)
+	cfg.Checks.ModulePath = getBoolEnv("GO_PRE_COMMIT_ENABLE_MODULE_PATH", false)
+	cfg.Checks.BuildArtifacts = getBoolEnv("GO_PRE_COMMIT_ENABLE_BUILD_ARTIFACTS", false)
+	cfg.Checks.OSJunk = getBoolEnv("GO_PRE_COMMIT_ENABLE_OS_JUNK", false)
+	cfg.Checks.ContextFirst = getBoolEnv("GO_PRE_COMMIT_ENABLE_CONTEXT_FIRST", false)
+	cfg.Checks.ForbiddenImports = getBoolEnv("GO_PRE_COMMIT_ENABLE_FORBIDDEN_IMPORTS", false)
+	cfg.Checks.StructTags = getBoolEnv("GO_PRE_COMMIT_ENABLE_STRUCT_TAGS", false)
+	cfg.Checks.DocComments = getBoolEnv("GO_PRE_COMMIT_ENABLE_DOC_COMMENTS", false)
+	cfg.Checks.ReceiverNames = getBoolEnv("GO_PRE_COMMIT_ENABLE_RECEIVER_NAMES", false)
+	cfg.Checks.FilePermissions = getBoolEnv("GO_PRE_COMMIT_ENABLE_FILE_PERMISSIONS", false)
+	cfg.Checks.EmbeddedBlobs = getBoolEnv("GO_PRE_COMMIT_ENABLE_EMBEDDED_BLOBS", false)
+	cfg.Checks.DataFormat = getBoolEnv("GO_PRE_COMMIT_ENABLE_DATA_FORMAT", false)
+	cfg.Checks.Gosec = getBoolEnv("GO_PRE_COMMIT_ENABLE_GOSEC", false)
+	cfg.Checks.Shellcheck = getBoolEnv("GO_PRE_COMMIT_ENABLE_SHELLCHECK", false)
+	cfg.Checks.Hadolint = getBoolEnv("GO_PRE_COMMIT_ENABLE_HADOLINT", false)
+	cfg.Checks.GoDirective = getBoolEnv("GO_PRE_COMMIT_ENABLE_GO_DIRECTIVE", false)
+	cfg.Checks.StubFuncs = getBoolEnv("GO_PRE_COMMIT_ENABLE_STUB_FUNCS", false)
+	cfg.Checks.CommitSize = getBoolEnv("GO_PRE_COMMIT_ENABLE_COMMIT_SIZE", false)
+	cfg.Checks.TestPackage = getBoolEnv("GO_PRE_COMMIT_ENABLE_TEST_PACKAGE", false)
+	cfg.Checks.ModPair = getBoolEnv("GO_PRE_COMMIT_ENABLE_MOD_PAIR", false)
+	cfg.Checks.ErrorWrap = getBoolEnv("GO_PRE_COMMIT_ENABLE_ERROR_WRAP", false)
+	cfg.Checks.InternalImport = getBoolEnv("GO_PRE_COMMIT_ENABLE_INTERNAL_IMPORT", false)
+	cfg.Checks.FuncLength = getBoolEnv("GO_PRE_COMMIT_ENABLE_FUNC_LENGTH", false)
+	cfg.Checks.EnvAccess = getBoolEnv("GO_PRE_COMMIT_ENABLE_ENV_ACCESS", false)
+	cfg.Checks.TestOnlyDeps = getBoolEnv("GO_PRE_COMMIT_ENABLE_TEST_ONLY_DEPS", false)
+	cfg.Checks.GlobalVars = getBoolEnv("GO_PRE_COMMIT_ENABLE_GLOBAL_VARS", false)
+	cfg.Checks.FileHeaderOrder = getBoolEnv("GO_PRE_COMMIT_ENABLE_FILE_HEADER_ORDER", false)
+	cfg.Checks.ContextBackground = getBoolEnv("GO_PRE_COMMIT_ENABLE_CONTEXT_BACKGROUND", false)
+	cfg.Checks.MajorVersionConflict = getBoolEnv("GO_PRE_COMMIT_ENABLE_MAJOR_VERSION_CONFLICT", false)
+	cfg.Checks.FilenameCase = getBoolEnv("GO_PRE_COMMIT_ENABLE_FILENAME_CASE", false)
+	cfg.Checks.BuildConstraints = getBoolEnv("GO_PRE_COMMIT_ENABLE_BUILD_CONSTRAINTS", false)
+	cfg.Checks.RepoFiles = getBoolEnv("GO_PRE_COMMIT_ENABLE_REPO_FILES", false)
+	cfg.Checks.ErrorVarNaming = getBoolEnv("GO_PRE_COMMIT_ENABLE_ERROR_VAR_NAMING", false)
 
 	// Check behaviors
-	cfg.CheckBehaviors.FumptAutoStage = getBoolEnv("GO_PRE_COMMIT_FUMPT_AUTO_STAGE", true)
+	cfg.CheckBehaviors.FumptAutoStage = getBoolEnv("GO_PRE_COMMIT_FUMPT_AUTO_STAGE", false)
 	cfg.CheckBehaviors.WhitespaceAutoStage = getBoolEnv("GO_PRE_COMMIT_WHITESPACE_AUTO_STAGE", true)
 	cfg.CheckBehaviors.EOFAutoStage = getBoolEnv("GO_PRE_COMMIT_EOF_AUTO_STAGE", true)
+	cfg.CheckBehaviors.BuildTagsAutoFix = getBoolEnv("GO_PRE_COMMIT_BUILD_TAGS_AUTO_FIX", false)
+	if warnOnly := getStringEnv("GO_PRE_COMMIT_WARN_ONLY_CHECKS", ""); warnOnly != "" {
+		for _, name := range strings.Split(warnOnly, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				cfg.CheckBehaviors.WarnOnly = append(cfg.CheckBehaviors.WarnOnly, name)
+			}
+		}
+	}
+	if continueOnError := getStringEnv("GO_PRE_COMMIT_CONTINUE_ON_ERROR_CHECKS", ""); continueOnError != "" {
+		for _, name := range strings.Split(continueOnError, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				cfg.CheckBehaviors.ContinueOnError = append(cfg.CheckBehaviors.ContinueOnError, name)
+			}
+		}
+	}
+	cfg.CheckBehaviors.MaxLines = parseCheckLimits(getStringEnv("GO_PRE_COMMIT_CHECK_MAX_LINES", ""))
+	cfg.CheckBehaviors.WorkDir = parseCheckWorkDirs(getStringEnv("GO_PRE_COMMIT_CHECK_WORKDIR", ""))
+	if allow := getStringEnv("GO_PRE_COMMIT_GO_VERSION_ALLOW", ""); allow != "" {
+		for _, dir := range strings.Split(allow, ",") {
+			if dir = strings.Trim(strings.TrimSpace(dir), "/"); dir != "" {
+				cfg.CheckBehaviors.GoVersionAllow = append(cfg.CheckBehaviors.GoVersionAllow, dir)
+			}
+		}
+	}
+	cfg.CheckBehaviors.ErrorCompareSkipTests = getBoolEnv("GO_PRE_COMMIT_ERROR_COMPARE_SKIP_TESTS", false)
+	cfg.CheckBehaviors.LintPackageThreshold = getIntEnv("GO_PRE_COMMIT_LINT_PACKAGE_THRESHOLD", 100)
+	cfg.CheckBehaviors.TestPresenceSeverity = strings.ToLower(getStringEnv("GO_PRE_COMMIT_TEST_PRESENCE_SEVERITY", LintSeverityWarning))
+	cfg.CheckBehaviors.LintSeverity = parseLintSeverity(getStringEnv("GO_PRE_COMMIT_LINT_SEVERITY", ""))
+	cfg.CheckBehaviors.MaxDiffLines = getIntEnv("GO_PRE_COMMIT_MAX_DIFF_LINES", 1000)
+	cfg.CheckBehaviors.LargeDiffsSeverity = strings.ToLower(getStringEnv("GO_PRE_COMMIT_LARGE_DIFFS_SEVERITY", LintSeverityWarning))
+	cfg.CheckBehaviors.ToolchainPolicy = strings.ToLower(getStringEnv("GO_PRE_COMMIT_TOOLCHAIN_POLICY", ToolchainPolicyForbid))
+	cfg.CheckBehaviors.ToolchainVersion = getStringEnv("GO_PRE_COMMIT_TOOLCHAIN_VERSION", "")
+	cfg.CheckBehaviors.ModulePathPrefix = strings.Trim(getStringEnv("GO_PRE_COMMIT_MODULE_PATH_PREFIX", ""), "/")
+	cfg.CheckBehaviors.GoWorkspace = getBoolEnv("GO_PRE_COMMIT_GO_WORKSPACE", false)
+	cfg.CheckBehaviors.ResolveFormatterConflicts = getBoolEnv("GO_PRE_COMMIT_RESOLVE_FORMATTER_CONFLICTS", false)
+	cfg.CheckBehaviors.ReRunValidatorsAfterFix = getBoolEnv("GO_PRE_COMMIT_RERUN_VALIDATORS_AFTER_FIX", true)
+	for _, pattern := range strings.Split(getStringEnv("GO_PRE_COMMIT_BUILD_ARTIFACT_PATTERNS", "coverage.out,*.prof,*.pprof,*.test"), ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			cfg.CheckBehaviors.BuildArtifactPatterns = append(cfg.CheckBehaviors.BuildArtifactPatterns, pattern)
+		}
+	}
+	for _, pattern := range strings.Split(getStringEnv("GO_PRE_COMMIT_OS_JUNK_PATTERNS", ".DS_Store,Thumbs.db,desktop.ini"), ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			cfg.CheckBehaviors.OSJunkPatterns = append(cfg.CheckBehaviors.OSJunkPatterns, pattern)
+		}
+	}
+	cfg.CheckBehaviors.OSJunkAutoFix = getBoolEnv("GO_PRE_COMMIT_OS_JUNK_AUTO_FIX", false)
+	cfg.CheckBehaviors.ModTidyDiff = strings.ToLower(getStringEnv("GO_PRE_COMMIT_MOD_TIDY_DIFF", ModTidyDiffAuto))
+	for _, pattern := range strings.Split(getStringEnv("GO_PRE_COMMIT_EOF_EXEMPT", ""), ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			cfg.CheckBehaviors.EOFExempt = append(cfg.CheckBehaviors.EOFExempt, pattern)
+		}
+	}
+	for _, rule := range strings.Split(getStringEnv("GO_PRE_COMMIT_FORBIDDEN_IMPORTS", "io/ioutil"), ",") {
+		if rule = strings.TrimSpace(rule); rule != "" {
+			cfg.CheckBehaviors.ForbiddenImports = append(cfg.CheckBehaviors.ForbiddenImports, rule)
+		}
+	}
+	for _, pattern := range strings.Split(getStringEnv("GO_PRE_COMMIT_FORBIDDEN_IMPORTS_ALLOW", ""), ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			cfg.CheckBehaviors.ForbiddenImportsAllow = append(cfg.CheckBehaviors.ForbiddenImportsAllow, pattern)
+		}
+	}
+	cfg.CheckBehaviors.DocCommentsSkipMain = getBoolEnv("GO_PRE_COMMIT_DOC_COMMENTS_SKIP_MAIN", true)
+	cfg.CheckBehaviors.FixChmodWritable = getBoolEnv("GO_PRE_COMMIT_FIX_CHMOD_WRITABLE", false)
+	cfg.CheckBehaviors.WhitespacePreserveMtime = getBoolEnv("GO_PRE_COMMIT_WHITESPACE_PRESERVE_MTIME", false)
+	cfg.CheckBehaviors.WhitespaceMarkdownBreaks = getBoolEnv("GO_PRE_COMMIT_WHITESPACE_MARKDOWN_HARD_BREAKS", true)
+	cfg.CheckBehaviors.WhitespaceSeverity = strings.ToLower(getStringEnv("GO_PRE_COMMIT_WHITESPACE_SEVERITY", LintSeverityError))
+	cfg.CheckBehaviors.WhitespaceEmptyFilePolicy = strings.ToLower(getStringEnv("GO_PRE_COMMIT_WHITESPACE_EMPTY_FILE_POLICY", WhitespaceEmptyFileNewline))
+	cfg.CheckBehaviors.WhitespaceAddFinalNewline = getBoolEnv("GO_PRE_COMMIT_WHITESPACE_ADD_FINAL_NEWLINE", false)
+	cfg.CheckBehaviors.EOFSeverity = strings.ToLower(getStringEnv("GO_PRE_COMMIT_EOF_SEVERITY", LintSeverityError))
+	for _, rule := range strings.Split(getStringEnv("GO_PRE_COMMIT_INTERNAL_IMPORT_ALLOW", ""), ",") {
+		if rule = strings.TrimSpace(rule); rule != "" {
+			cfg.CheckBehaviors.InternalImportAllow = append(cfg.CheckBehaviors.InternalImportAllow, rule)
+		}
+	}
+	cfg.CheckBehaviors.FuncLengthMaxLines = getIntEnv("GO_PRE_COMMIT_FUNC_LENGTH_MAX_LINES", 80)
+	cfg.CheckBehaviors.FuncLengthMaxStatements = getIntEnv("GO_PRE_COMMIT_FUNC_LENGTH_MAX_STATEMENTS", 50)
+	cfg.CheckBehaviors.FuncLengthSeverity = strings.ToLower(getStringEnv("GO_PRE_COMMIT_FUNC_LENGTH_SEVERITY", LintSeverityWarning))
+	for _, rule := range strings.Split(getStringEnv("GO_PRE_COMMIT_ENV_ACCESS_ALLOW", "internal/config"), ",") {
+		if rule = strings.TrimSpace(rule); rule != "" {
+			cfg.CheckBehaviors.EnvAccessAllow = append(cfg.CheckBehaviors.EnvAccessAllow, rule)
+		}
+	}
+	for _, rule := range strings.Split(getStringEnv("GO_PRE_COMMIT_TEST_ONLY_DEPS_ALLOW", "github.com/stretchr/testify"), ",") {
+		if rule = strings.TrimSpace(rule); rule != "" {
+			cfg.CheckBehaviors.TestOnlyDepsAllow = append(cfg.CheckBehaviors.TestOnlyDepsAllow, rule)
+		}
+	}
+	for _, name := range strings.Split(getStringEnv("GO_PRE_COMMIT_GLOBAL_VARS_ALLOW", "version,commit"), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			cfg.CheckBehaviors.GlobalVarsAllow = append(cfg.CheckBehaviors.GlobalVarsAllow, name)
+		}
+	}
+	cfg.CheckBehaviors.GlobalVarsSeverity = strings.ToLower(getStringEnv("GO_PRE_COMMIT_GLOBAL_VARS_SEVERITY", LintSeverityWarning))
+	for _, marker := range strings.Split(getStringEnv("GO_PRE_COMMIT_FILE_HEADER_LICENSE_MARKERS", "Copyright,SPDX-License-Identifier"), ",") {
+		if marker = strings.TrimSpace(marker); marker != "" {
+			cfg.CheckBehaviors.FileHeaderLicenseMarkers = append(cfg.CheckBehaviors.FileHeaderLicenseMarkers, marker)
+		}
+	}
+	for _, rule := range strings.Split(getStringEnv("GO_PRE_COMMIT_CONTEXT_BACKGROUND_ALLOW", ""), ",") {
+		if rule = strings.TrimSpace(rule); rule != "" {
+			cfg.CheckBehaviors.ContextBackgroundAllow = append(cfg.CheckBehaviors.ContextBackgroundAllow, rule)
+		}
+	}
+	cfg.CheckBehaviors.ContextBackgroundSeverity = strings.ToLower(getStringEnv("GO_PRE_COMMIT_CONTEXT_BACKGROUND_SEVERITY", LintSeverityWarning))
+	for _, rule := range strings.Split(getStringEnv("GO_PRE_COMMIT_MAJOR_VERSION_CONFLICT_ALLOW", ""), ",") {
+		if rule = strings.TrimSpace(rule); rule != "" {
+			cfg.CheckBehaviors.MajorVersionConflictAllow = append(cfg.CheckBehaviors.MajorVersionConflictAllow, rule)
+		}
+	}
+	for _, pattern := range strings.Split(getStringEnv("GO_PRE_COMMIT_REPO_FILES_REQUIRED", "LICENSE*,README*"), ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			cfg.CheckBehaviors.RepoFilesRequired = append(cfg.CheckBehaviors.RepoFilesRequired, pattern)
+		}
+	}
+	cfg.CheckBehaviors.ErrorVarNamingSeverity = strings.ToLower(getStringEnv("GO_PRE_COMMIT_ERROR_VAR_NAMING_SEVERITY", LintSeverityWarning))
+	cfg.CheckBehaviors.ReceiverNameMaxLength = getIntEnv("GO_PRE_COMMIT_RECEIVER_NAME_MAX_LENGTH", 2)
+	for _, mode := range strings.Split(getStringEnv("GO_PRE_COMMIT_FILE_PERMISSION_MODES", "100644,100755"), ",") {
+		if mode = strings.TrimSpace(mode); mode != "" {
+			cfg.CheckBehaviors.FilePermissionModes = append(cfg.CheckBehaviors.FilePermissionModes, normalizeGitFileMode(mode))
+		}
+	}
+	cfg.CheckBehaviors.FilePermissionsAutoFix = getBoolEnv("GO_PRE_COMMIT_FILE_PERMISSIONS_AUTO_FIX", false)
+	cfg.CheckBehaviors.EmbeddedBlobMinLength = getIntEnv("GO_PRE_COMMIT_EMBEDDED_BLOB_MIN_LENGTH", 1024)
+	cfg.CheckBehaviors.DataFormatStyle = strings.ToLower(getStringEnv("GO_PRE_COMMIT_DATA_FORMAT_STYLE", DataFormatIndent))
+	cfg.CheckBehaviors.DataFormatIndent = getIntEnv("GO_PRE_COMMIT_DATA_FORMAT_INDENT", 2)
+	cfg.CheckBehaviors.GosecSeverity = strings.ToLower(getStringEnv("GO_PRE_COMMIT_GOSEC_SEVERITY", GosecSeverityMedium))
+	cfg.CheckBehaviors.ShellcheckSeverity = strings.ToLower(getStringEnv("GO_PRE_COMMIT_SHELLCHECK_SEVERITY", ShellcheckSeverityStyle))
+	cfg.CheckBehaviors.HadolintSeverity = strings.ToLower(getStringEnv("GO_PRE_COMMIT_HADOLINT_SEVERITY", ShellcheckSeverityInfo))
+	cfg.CheckBehaviors.StubFuncsSeverity = strings.ToLower(getStringEnv("GO_PRE_COMMIT_STUB_FUNCS_SEVERITY", LintSeverityWarning))
+	cfg.CheckBehaviors.MaxStagedFiles = getIntEnv("GO_PRE_COMMIT_MAX_STAGED_FILES", 50)
+	cfg.CheckBehaviors.CommitSizeSeverity = strings.ToLower(getStringEnv("GO_PRE_COMMIT_COMMIT_SIZE_SEVERITY", LintSeverityWarning))
+	for _, category := range strings.Split(getStringEnv("GO_PRE_COMMIT_COMMIT_SIZE_EXEMPT", CommitSizeExemptGenerated), ",") {
+		if category = strings.ToLower(strings.TrimSpace(category)); category != "" {
+			cfg.CheckBehaviors.CommitSizeExempt = append(cfg.CheckBehaviors.CommitSizeExempt, category)
+		}
+	}
+	for _, key := range strings.Split(getStringEnv("GO_PRE_COMMIT_STRUCT_TAG_KEYS", ""), ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			cfg.CheckBehaviors.StructTagKeys = append(cfg.CheckBehaviors.StructTagKeys, key)
+		}
+	}
 
 	// Tool versions
 	cfg.ToolVersions.Fumpt = getStringEnv("GO_PRE_COMMIT_FUMPT_VERSION", "latest")
 	cfg.ToolVersions.GolangciLint = getStringEnv("GO_PRE_COMMIT_GOLANGCI_LINT_VERSION", "latest")
 	cfg.ToolVersions.Gitleaks = getStringEnv("GO_PRE_COMMIT_GITLEAKS_VERSION", "v8.29.0")
+	cfg.ToolVersions.Pin = parseToolPins(getStringEnv("GO_PRE_COMMIT_TOOLS_PIN", ""))
 
 	// Performance settings
 	cfg.Performance.ParallelWorkers = getIntEnv("GO_PRE_COMMIT_PARALLEL_WORKERS", 0) // 0 = auto
 	cfg.Performance.FailFast = getBoolEnv("GO_PRE_COMMIT_FAIL_FAST", false)
+	cfg.Performance.MaxLineSizeKB = getIntEnv("GO_PRE_COMMIT_MAX_LINE_SIZE_KB", 10240)
+	cfg.Performance.LoadAware = getBoolEnv("GO_PRE_COMMIT_LOAD_AWARE", false)
+	cfg.Performance.TempDir = getStringEnv("GO_PRE_COMMIT_TEMP_DIR", "")
 
 	// Check timeouts
 	cfg.CheckTimeouts.Fumpt = getIntEnv("GO_PRE_COMMIT_FUMPT_TIMEOUT", 30)
@@ -169,6 +738,47 @@ func Load() (*Config, error) {
 	cfg.CheckTimeouts.Whitespace = getIntEnv("GO_PRE_COMMIT_WHITESPACE_TIMEOUT", 30)
 	cfg.CheckTimeouts.EOF = getIntEnv("GO_PRE_COMMIT_EOF_TIMEOUT", 30)
 	cfg.CheckTimeouts.Gitleaks = getIntEnv("GO_PRE_COMMIT_GITLEAKS_TIMEOUT", 60)
+	cfg.CheckTimeouts.BuildTags = getIntEnv("GO_PRE_COMMIT_BUILD_TAGS_TIMEOUT", 30)
+	cfg.CheckTimeouts.GoIndent = getIntEnv("GO_PRE_COMMIT_GO_INDENT_TIMEOUT", 30)
+	cfg.CheckTimeouts.EmptyCommit = getIntEnv("GO_PRE_COMMIT_EMPTY_COMMIT_TIMEOUT", 10)
+	cfg.CheckTimeouts.GoVersion = getIntEnv("GO_PRE_COMMIT_GO_VERSION_TIMEOUT", 30)
+	cfg.CheckTimeouts.ErrorCompare = getIntEnv("GO_PRE_COMMIT_ERROR_COMPARE_TIMEOUT", 30)
+	cfg.CheckTimeouts.TestPresence = getIntEnv("GO_PRE_COMMIT_TEST_PRESENCE_TIMEOUT", 30)
+	cfg.CheckTimeouts.GoGenerate = getIntEnv("GO_PRE_COMMIT_GO_GENERATE_TIMEOUT", 120)
+	cfg.CheckTimeouts.LargeDiffs = getIntEnv("GO_PRE_COMMIT_LARGE_DIFFS_TIMEOUT", 10)
+	cfg.CheckTimeouts.Toolchain = getIntEnv("GO_PRE_COMMIT_TOOLCHAIN_TIMEOUT", 30)
+	cfg.CheckTimeouts.ModulePath = getIntEnv("GO_PRE_COMMIT_MODULE_PATH_TIMEOUT", 30)
+	cfg.CheckTimeouts.BuildArtifacts = getIntEnv("GO_PRE_COMMIT_BUILD_ARTIFACTS_TIMEOUT", 10)
+	cfg.CheckTimeouts.OSJunk = getIntEnv("GO_PRE_COMMIT_OS_JUNK_TIMEOUT", 10)
+	cfg.CheckTimeouts.ContextFirst = getIntEnv("GO_PRE_COMMIT_CONTEXT_FIRST_TIMEOUT", 30)
+	cfg.CheckTimeouts.ForbiddenImports = getIntEnv("GO_PRE_COMMIT_FORBIDDEN_IMPORTS_TIMEOUT", 30)
+	cfg.CheckTimeouts.StructTags = getIntEnv("GO_PRE_COMMIT_STRUCT_TAGS_TIMEOUT", 30)
+	cfg.CheckTimeouts.DocComments = getIntEnv("GO_PRE_COMMIT_DOC_COMMENTS_TIMEOUT", 30)
+	cfg.CheckTimeouts.ReceiverNames = getIntEnv("GO_PRE_COMMIT_RECEIVER_NAMES_TIMEOUT", 30)
+	cfg.CheckTimeouts.FilePermissions = getIntEnv("GO_PRE_COMMIT_FILE_PERMISSIONS_TIMEOUT", 10)
+	cfg.CheckTimeouts.EmbeddedBlobs = getIntEnv("GO_PRE_COMMIT_EMBEDDED_BLOBS_TIMEOUT", 30)
+	cfg.CheckTimeouts.DataFormat = getIntEnv("GO_PRE_COMMIT_DATA_FORMAT_TIMEOUT", 30)
+	cfg.CheckTimeouts.Gosec = getIntEnv("GO_PRE_COMMIT_GOSEC_TIMEOUT", 120)
+	cfg.CheckTimeouts.Shellcheck = getIntEnv("GO_PRE_COMMIT_SHELLCHECK_TIMEOUT", 60)
+	cfg.CheckTimeouts.Hadolint = getIntEnv("GO_PRE_COMMIT_HADOLINT_TIMEOUT", 60)
+	cfg.CheckTimeouts.GoDirective = getIntEnv("GO_PRE_COMMIT_GO_DIRECTIVE_TIMEOUT", 30)
+	cfg.CheckTimeouts.StubFuncs = getIntEnv("GO_PRE_COMMIT_STUB_FUNCS_TIMEOUT", 30)
+	cfg.CheckTimeouts.CommitSize = getIntEnv("GO_PRE_COMMIT_COMMIT_SIZE_TIMEOUT", 10)
+	cfg.CheckTimeouts.TestPackage = getIntEnv("GO_PRE_COMMIT_TEST_PACKAGE_TIMEOUT", 30)
+	cfg.CheckTimeouts.ModPair = getIntEnv("GO_PRE_COMMIT_MOD_PAIR_TIMEOUT", 60)
+	cfg.CheckTimeouts.ErrorWrap = getIntEnv("GO_PRE_COMMIT_ERROR_WRAP_TIMEOUT", 30)
+	cfg.CheckTimeouts.InternalImport = getIntEnv("GO_PRE_COMMIT_INTERNAL_IMPORT_TIMEOUT", 30)
+	cfg.CheckTimeouts.FuncLength = getIntEnv("GO_PRE_COMMIT_FUNC_LENGTH_TIMEOUT", 30)
+	cfg.CheckTimeouts.EnvAccess = getIntEnv("GO_PRE_COMMIT_ENV_ACCESS_TIMEOUT", 30)
+	cfg.CheckTimeouts.TestOnlyDeps = getIntEnv("GO_PRE_COMMIT_TEST_ONLY_DEPS_TIMEOUT", 60)
+	cfg.CheckTimeouts.GlobalVars = getIntEnv("GO_PRE_COMMIT_GLOBAL_VARS_TIMEOUT", 30)
+	cfg.CheckTimeouts.FileHeaderOrder = getIntEnv("GO_PRE_COMMIT_FILE_HEADER_ORDER_TIMEOUT", 30)
+	cfg.CheckTimeouts.ContextBackground = getIntEnv("GO_PRE_COMMIT_CONTEXT_BACKGROUND_TIMEOUT", 30)
+	cfg.CheckTimeouts.MajorVersionConflict = getIntEnv("GO_PRE_COMMIT_MAJOR_VERSION_CONFLICT_TIMEOUT", 30)
+	cfg.CheckTimeouts.FilenameCase = getIntEnv("GO_PRE_COMMIT_FILENAME_CASE_TIMEOUT", 10)
+	cfg.CheckTimeouts.BuildConstraints = getIntEnv("GO_PRE_COMMIT_BUILD_CONSTRAINTS_TIMEOUT", 30)
+	cfg.CheckTimeouts.RepoFiles = getIntEnv("GO_PRE_COMMIT_REPO_FILES_TIMEOUT", 10)
+	cfg.CheckTimeouts.ErrorVarNaming = getIntEnv("GO_PRE_COMMIT_ERROR_VAR_NAMING_TIMEOUT", 30)
 
 	// Git settings
 	cfg.Git.HooksPath = getStringEnv("GO_PRE_COMMIT_HOOKS_PATH", ".git/hooks")
@@ -179,12 +789,46 @@ func Load() (*Config, error) {
 			cfg.Git.ExcludePatterns[i] = strings.TrimSpace(cfg.Git.ExcludePatterns[i])
 		}
 	}
+	for _, dir := range strings.Split(getStringEnv("GO_PRE_COMMIT_EXCLUDE_DIRS", ""), ",") {
+		if dir = strings.TrimSpace(dir); dir != "" {
+			cfg.Git.ExcludeDirs = append(cfg.Git.ExcludeDirs, path.Clean(filepath.ToSlash(dir)))
+		}
+	}
+	cfg.Git.SkipDotfiles = getBoolEnv("GO_PRE_COMMIT_SKIP_DOTFILES", false)
+	if includes := getStringEnv("GO_PRE_COMMIT_DOTFILE_INCLUDES", ""); includes != "" {
+		for _, pattern := range strings.Split(includes, ",") {
+			if pattern = strings.TrimSpace(pattern); pattern != "" {
+				cfg.Git.DotfileIncludes = append(cfg.Git.DotfileIncludes, pattern)
+			}
+		}
+	}
+	if extensions := getStringEnv("GO_PRE_COMMIT_CONTENT_SNIFF_EXTENSIONS", ""); extensions != "" {
+		for _, ext := range strings.Split(extensions, ",") {
+			if ext = strings.ToLower(strings.TrimSpace(ext)); ext != "" {
+				cfg.Git.ContentSniffExtensions = append(cfg.Git.ContentSniffExtensions, "."+strings.TrimPrefix(ext, "."))
+			}
+		}
+	}
+	cfg.Git.Backend = strings.ToLower(getStringEnv("GO_PRE_COMMIT_GIT_BACKEND", GitBackendExec))
+	cfg.Git.LockAttempts = getIntEnv("GO_PRE_COMMIT_GIT_LOCK_ATTEMPTS", 3)
+
+	// Pre-run and post-run commands
+	cfg.Hooks.PreRun = splitCommands(getStringEnv("GO_PRE_COMMIT_PRE_RUN", ""))
+	cfg.Hooks.PostRun = splitCommands(getStringEnv("GO_PRE_COMMIT_POST_RUN", ""))
 
 	// Go module settings
 	cfg.Module.GoSumFile = getStringEnv("GO_SUM_FILE", "go.sum")
 
 	// UI settings
 	cfg.UI.ColorOutput = getBoolEnv("GO_PRE_COMMIT_COLOR_OUTPUT", true)
+	cfg.UI.MaxFilesInSummary = getIntEnv("GO_PRE_COMMIT_MAX_FILES_IN_SUMMARY", 20)
+	cfg.UI.HeartbeatInterval = getIntEnv("GO_PRE_COMMIT_HEARTBEAT_INTERVAL", 5)
+	cfg.UI.EmptyCommitNotice = getBoolEnv("GO_PRE_COMMIT_EMPTY_COMMIT_NOTICE", false)
+	cfg.UI.Theme = strings.ToLower(getStringEnv("GO_PRE_COMMIT_OUTPUT_THEME", output.ThemeDefault))
+	cfg.UI.ProgressBar = getBoolEnv("GO_PRE_COMMIT_PROGRESS_BAR", false)
+	cfg.UI.DiffContextLines = getIntEnv("GO_PRE_COMMIT_DIFF_CONTEXT_LINES", 3)
+	cfg.UI.DiffMaxLinesShown = getIntEnv("GO_PRE_COMMIT_DIFF_MAX_LINES_SHOWN", 100)
+	cfg.UI.RedactDiffSecrets = getBoolEnv("GO_PRE_COMMIT_REDACT_DIFF_SECRETS", true)
 
 	// Tool installation settings
 	cfg.ToolInstallation.Timeout = getIntEnv("GO_PRE_COMMIT_TOOL_INSTALL_TIMEOUT", 300)
@@ -203,9 +847,25 @@ func Load() (*Config, error) {
 	cfg.Plugins.Directory = getStringEnv("GO_PRE_COMMIT_PLUGIN_DIR", ".pre-commit-plugins")
 	cfg.Plugins.Timeout = getIntEnv("GO_PRE_COMMIT_PLUGIN_TIMEOUT", 60)
 
-	// Validate configuration
-	if err := cfg.Validate(); err != nil {
-		return nil, fmt.Errorf("configuration validation failed: %w", err)
+	// Result cache settings
+	cfg.Cache.Enabled = getBoolEnv("GO_PRE_COMMIT_ENABLE_RESULT_CACHE", false)
+	cfg.Cache.File = getStringEnv("GO_PRE_COMMIT_RESULT_CACHE_FILE", ".git/go-pre-commit-cache.json")
+
+	// Reporting settings
+	cfg.Reporting.GitHubReview = getBoolEnv("GO_PRE_COMMIT_GITHUB_REVIEW", false)
+	cfg.Reporting.ReportOn = strings.ToLower(getStringEnv("GO_PRE_COMMIT_REPORT_ON", ReportOnAlways))
+
+	// Check profiles
+	cfg.Profiles = loadProfiles()
+
+	// External checks
+	cfg.ExternalChecks = loadExternalChecks()
+
+	// Required checks
+	for _, name := range strings.Split(getStringEnv("GO_PRE_COMMIT_REQUIRED_CHECKS", ""), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			cfg.RequiredChecks = append(cfg.RequiredChecks, name)
+		}
 	}
 
 	return cfg, nil
@@ -220,6 +880,34 @@ func (c *Config) Validate() error {
 		errors = append(errors, "GO_PRE_COMMIT_TIMEOUT_SECONDS must be greater than 0")
 	}
 
+	if c.Runner.OverallTimeout < 0 {
+		errors = append(errors, "GO_PRE_COMMIT_OVERALL_TIMEOUT_SECONDS must not be negative (0 uses GO_PRE_COMMIT_TIMEOUT_SECONDS)")
+	}
+
+	if c.Runner.PerCheckTimeout < 0 {
+		errors = append(errors, "GO_PRE_COMMIT_PER_CHECK_TIMEOUT_SECONDS must not be negative (0 disables it)")
+	}
+
+	if c.Runner.MaxCommitTime < 0 {
+		errors = append(errors, "GO_PRE_COMMIT_MAX_COMMIT_TIME_SECONDS must not be negative (0 disables it)")
+	}
+
+	// 0 keeps the default, and codes above 125 are reserved by shells
+	exitCodes := []struct {
+		name string
+		code int
+	}{
+		{"GO_PRE_COMMIT_EXIT_CODE_FAILURE", c.ExitCodes.Failure},
+		{"GO_PRE_COMMIT_EXIT_CODE_FIXED", c.ExitCodes.Fixed},
+		{"GO_PRE_COMMIT_EXIT_CODE_SETUP", c.ExitCodes.Setup},
+		{"GO_PRE_COMMIT_EXIT_CODE_NO_FILES", c.ExitCodes.NoFiles},
+	}
+	for _, exitCode := range exitCodes {
+		if exitCode.code < 0 || exitCode.code > 125 {
+			errors = append(errors, fmt.Sprintf("%s must be between 1 and 125 (0 uses the default)", exitCode.name))
+		}
+	}
+
 	if c.ToolInstallation.Timeout <= 0 {
 		errors = append(errors, "GO_PRE_COMMIT_TOOL_INSTALL_TIMEOUT must be greater than 0")
 	}
@@ -236,18 +924,412 @@ func (c *Config) Validate() error {
 		errors = append(errors, "GO_PRE_COMMIT_MOD_TIDY_TIMEOUT must be greater than 0")
 	}
 
+	switch c.CheckBehaviors.ModTidyDiff {
+	case "", ModTidyDiffAuto, ModTidyDiffAlways, ModTidyDiffNever:
+	default:
+		errors = append(errors, "GO_PRE_COMMIT_MOD_TIDY_DIFF must be auto, always, or never")
+	}
+
 	if c.CheckTimeouts.Whitespace <= 0 {
 		errors = append(errors, "GO_PRE_COMMIT_WHITESPACE_TIMEOUT must be greater than 0")
 	}
 
+	if c.Checks.Whitespace && c.CheckBehaviors.WhitespaceSeverity != LintSeverityWarning && c.CheckBehaviors.WhitespaceSeverity != LintSeverityError {
+		errors = append(errors, "GO_PRE_COMMIT_WHITESPACE_SEVERITY must be warning or error")
+	}
+	if c.Checks.Whitespace && c.CheckBehaviors.WhitespaceEmptyFilePolicy != WhitespaceEmptyFileEmpty && c.CheckBehaviors.WhitespaceEmptyFilePolicy != WhitespaceEmptyFileNewline {
+		errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_WHITESPACE_EMPTY_FILE_POLICY must be %s or %s (got: '%s')",
+			WhitespaceEmptyFileEmpty, WhitespaceEmptyFileNewline, c.CheckBehaviors.WhitespaceEmptyFilePolicy))
+	}
+
 	if c.CheckTimeouts.EOF <= 0 {
 		errors = append(errors, "GO_PRE_COMMIT_EOF_TIMEOUT must be greater than 0")
 	}
 
+	if c.Checks.EOF && c.CheckBehaviors.EOFSeverity != LintSeverityWarning && c.CheckBehaviors.EOFSeverity != LintSeverityError {
+		errors = append(errors, "GO_PRE_COMMIT_EOF_SEVERITY must be warning or error")
+	}
+
+	for _, pattern := range c.CheckBehaviors.EOFExempt {
+		if _, err := path.Match(pattern, ""); err != nil {
+			errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_EOF_EXEMPT has an invalid pattern '%s': %v", pattern, err))
+		}
+	}
+
 	if c.CheckTimeouts.Gitleaks <= 0 {
 		errors = append(errors, "GO_PRE_COMMIT_GITLEAKS_TIMEOUT must be greater than 0")
 	}
 
+	if c.Checks.BuildTags && c.CheckTimeouts.BuildTags <= 0 {
+		errors = append(errors, "GO_PRE_COMMIT_BUILD_TAGS_TIMEOUT must be greater than 0")
+	}
+
+	if c.Checks.GoIndent && c.CheckTimeouts.GoIndent <= 0 {
+		errors = append(errors, "GO_PRE_COMMIT_GO_INDENT_TIMEOUT must be greater than 0")
+	}
+
+	if c.Checks.EmptyCommit && c.CheckTimeouts.EmptyCommit <= 0 {
+		errors = append(errors, "GO_PRE_COMMIT_EMPTY_COMMIT_TIMEOUT must be greater than 0")
+	}
+
+	if c.Checks.GoVersion && c.CheckTimeouts.GoVersion <= 0 {
+		errors = append(errors, "GO_PRE_COMMIT_GO_VERSION_TIMEOUT must be greater than 0")
+	}
+	if c.Checks.ErrorCompare && c.CheckTimeouts.ErrorCompare <= 0 {
+		errors = append(errors, "GO_PRE_COMMIT_ERROR_COMPARE_TIMEOUT must be greater than 0")
+	}
+	if c.Checks.TestPresence {
+		if c.CheckTimeouts.TestPresence <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_TEST_PRESENCE_TIMEOUT must be greater than 0")
+		}
+		if c.CheckBehaviors.TestPresenceSeverity != LintSeverityWarning && c.CheckBehaviors.TestPresenceSeverity != LintSeverityError {
+			errors = append(errors, "GO_PRE_COMMIT_TEST_PRESENCE_SEVERITY must be warning or error")
+		}
+	}
+	if c.Checks.GoGenerate && c.CheckTimeouts.GoGenerate <= 0 {
+		errors = append(errors, "GO_PRE_COMMIT_GO_GENERATE_TIMEOUT must be greater than 0")
+	}
+	if c.Checks.LargeDiffs {
+		if c.CheckTimeouts.LargeDiffs <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_LARGE_DIFFS_TIMEOUT must be greater than 0")
+		}
+		if c.CheckBehaviors.MaxDiffLines <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_MAX_DIFF_LINES must be greater than 0")
+		}
+		if c.CheckBehaviors.LargeDiffsSeverity != LintSeverityWarning && c.CheckBehaviors.LargeDiffsSeverity != LintSeverityError {
+			errors = append(errors, "GO_PRE_COMMIT_LARGE_DIFFS_SEVERITY must be warning or error")
+		}
+	}
+
+	if c.Checks.Toolchain {
+		if c.CheckTimeouts.Toolchain <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_TOOLCHAIN_TIMEOUT must be greater than 0")
+		}
+		switch c.CheckBehaviors.ToolchainPolicy {
+		case ToolchainPolicyForbid:
+		case ToolchainPolicyRequire:
+			if c.CheckBehaviors.ToolchainVersion == "" {
+				errors = append(errors, "GO_PRE_COMMIT_TOOLCHAIN_VERSION must be set when GO_PRE_COMMIT_TOOLCHAIN_POLICY=require")
+			}
+		default:
+			errors = append(errors, "GO_PRE_COMMIT_TOOLCHAIN_POLICY must be forbid or require")
+		}
+	}
+
+	if c.Checks.ModulePath {
+		if c.CheckTimeouts.ModulePath <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_MODULE_PATH_TIMEOUT must be greater than 0")
+		}
+		if prefix := c.CheckBehaviors.ModulePathPrefix; prefix != strings.ToLower(prefix) {
+			errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_MODULE_PATH_PREFIX must be lowercase (got: '%s')", prefix))
+		}
+	}
+
+	if c.Checks.BuildArtifacts {
+		if c.CheckTimeouts.BuildArtifacts <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_BUILD_ARTIFACTS_TIMEOUT must be greater than 0")
+		}
+		for _, pattern := range c.CheckBehaviors.BuildArtifactPatterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_BUILD_ARTIFACT_PATTERNS has an invalid pattern '%s': %v", pattern, err))
+			}
+		}
+	}
+
+	if c.Checks.OSJunk {
+		if c.CheckTimeouts.OSJunk <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_OS_JUNK_TIMEOUT must be greater than 0")
+		}
+		for _, pattern := range c.CheckBehaviors.OSJunkPatterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_OS_JUNK_PATTERNS has an invalid pattern '%s': %v", pattern, err))
+			}
+		}
+	}
+
+	if c.Checks.ContextFirst && c.CheckTimeouts.ContextFirst <= 0 {
+		errors = append(errors, "GO_PRE_COMMIT_CONTEXT_FIRST_TIMEOUT must be greater than 0")
+	}
+
+	if c.Checks.ForbiddenImports {
+		if c.CheckTimeouts.ForbiddenImports <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_FORBIDDEN_IMPORTS_TIMEOUT must be greater than 0")
+		}
+		for _, rule := range c.CheckBehaviors.ForbiddenImports {
+			if _, err := path.Match(strings.TrimSuffix(rule, "/..."), ""); err != nil {
+				errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_FORBIDDEN_IMPORTS has an invalid pattern '%s': %v", rule, err))
+			}
+		}
+		for _, pattern := range c.CheckBehaviors.ForbiddenImportsAllow {
+			if _, err := path.Match(pattern, ""); err != nil {
+				errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_FORBIDDEN_IMPORTS_ALLOW has an invalid pattern '%s': %v", pattern, err))
+			}
+		}
+	}
+
+	if c.Checks.StructTags {
+		if c.CheckTimeouts.StructTags <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_STRUCT_TAGS_TIMEOUT must be greater than 0")
+		}
+		for _, key := range c.CheckBehaviors.StructTagKeys {
+			if strings.ContainsAny(key, ` :"`) {
+				errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_STRUCT_TAG_KEYS has an invalid key '%s'", key))
+			}
+		}
+	}
+
+	if c.Checks.DocComments && c.CheckTimeouts.DocComments <= 0 {
+		errors = append(errors, "GO_PRE_COMMIT_DOC_COMMENTS_TIMEOUT must be greater than 0")
+	}
+	if c.Checks.ReceiverNames && c.CheckTimeouts.ReceiverNames <= 0 {
+		errors = append(errors, "GO_PRE_COMMIT_RECEIVER_NAMES_TIMEOUT must be greater than 0")
+	}
+	if c.CheckBehaviors.ReceiverNameMaxLength < 0 {
+		errors = append(errors, "GO_PRE_COMMIT_RECEIVER_NAME_MAX_LENGTH cannot be negative")
+	}
+
+	if c.Checks.FilePermissions {
+		if c.CheckTimeouts.FilePermissions <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_FILE_PERMISSIONS_TIMEOUT must be greater than 0")
+		}
+		for _, mode := range c.CheckBehaviors.FilePermissionModes {
+			if mode != "100644" && mode != "100755" {
+				errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_FILE_PERMISSION_MODES has '%s'; git only records 100644 and 100755", mode))
+			}
+		}
+	}
+
+	if c.Checks.EmbeddedBlobs {
+		if c.CheckTimeouts.EmbeddedBlobs <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_EMBEDDED_BLOBS_TIMEOUT must be greater than 0")
+		}
+		if c.CheckBehaviors.EmbeddedBlobMinLength <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_EMBEDDED_BLOB_MIN_LENGTH must be greater than 0")
+		}
+	}
+
+	if c.Checks.DataFormat {
+		if c.CheckTimeouts.DataFormat <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_DATA_FORMAT_TIMEOUT must be greater than 0")
+		}
+		switch c.CheckBehaviors.DataFormatStyle {
+		case DataFormatNone, DataFormatIndent, DataFormatCanonical:
+		default:
+			errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_DATA_FORMAT_STYLE must be %s, %s, or %s (got: '%s')",
+				DataFormatNone, DataFormatIndent, DataFormatCanonical, c.CheckBehaviors.DataFormatStyle))
+		}
+		if c.CheckBehaviors.DataFormatIndent <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_DATA_FORMAT_INDENT must be greater than 0")
+		}
+	}
+
+	if c.Checks.Gosec {
+		if c.CheckTimeouts.Gosec <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_GOSEC_TIMEOUT must be greater than 0")
+		}
+		switch c.CheckBehaviors.GosecSeverity {
+		case GosecSeverityLow, GosecSeverityMedium, GosecSeverityHigh:
+		default:
+			errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_GOSEC_SEVERITY must be %s, %s, or %s (got: '%s')",
+				GosecSeverityLow, GosecSeverityMedium, GosecSeverityHigh, c.CheckBehaviors.GosecSeverity))
+		}
+	}
+
+	if c.Checks.Shellcheck {
+		if c.CheckTimeouts.Shellcheck <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_SHELLCHECK_TIMEOUT must be greater than 0")
+		}
+		switch c.CheckBehaviors.ShellcheckSeverity {
+		case ShellcheckSeverityError, ShellcheckSeverityWarning, ShellcheckSeverityInfo, ShellcheckSeverityStyle:
+		default:
+			errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_SHELLCHECK_SEVERITY must be %s, %s, %s, or %s (got: '%s')",
+				ShellcheckSeverityError, ShellcheckSeverityWarning, ShellcheckSeverityInfo, ShellcheckSeverityStyle,
+				c.CheckBehaviors.ShellcheckSeverity))
+		}
+	}
+
+	if c.Checks.Hadolint {
+		if c.CheckTimeouts.Hadolint <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_HADOLINT_TIMEOUT must be greater than 0")
+		}
+		switch c.CheckBehaviors.HadolintSeverity {
+		case ShellcheckSeverityError, ShellcheckSeverityWarning, ShellcheckSeverityInfo, ShellcheckSeverityStyle:
+		default:
+			errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_HADOLINT_SEVERITY must be %s, %s, %s, or %s (got: '%s')",
+				ShellcheckSeverityError, ShellcheckSeverityWarning, ShellcheckSeverityInfo, ShellcheckSeverityStyle,
+				c.CheckBehaviors.HadolintSeverity))
+		}
+	}
+
+	if c.Checks.GoDirective && c.CheckTimeouts.GoDirective <= 0 {
+		errors = append(errors, "GO_PRE_COMMIT_GO_DIRECTIVE_TIMEOUT must be greater than 0")
+	}
+
+	if c.Checks.StubFuncs {
+		if c.CheckTimeouts.StubFuncs <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_STUB_FUNCS_TIMEOUT must be greater than 0")
+		}
+		if c.CheckBehaviors.StubFuncsSeverity != LintSeverityWarning && c.CheckBehaviors.StubFuncsSeverity != LintSeverityError {
+			errors = append(errors, "GO_PRE_COMMIT_STUB_FUNCS_SEVERITY must be warning or error")
+		}
+	}
+
+	if c.Checks.CommitSize {
+		if c.CheckTimeouts.CommitSize <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_COMMIT_SIZE_TIMEOUT must be greater than 0")
+		}
+		if c.CheckBehaviors.MaxStagedFiles <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_MAX_STAGED_FILES must be greater than 0")
+		}
+		if c.CheckBehaviors.CommitSizeSeverity != LintSeverityWarning && c.CheckBehaviors.CommitSizeSeverity != LintSeverityError {
+			errors = append(errors, "GO_PRE_COMMIT_COMMIT_SIZE_SEVERITY must be warning or error")
+		}
+		for _, category := range c.CheckBehaviors.CommitSizeExempt {
+			switch category {
+			case CommitSizeExemptGenerated, CommitSizeExemptVendored, CommitSizeExemptTestdata, CommitSizeExemptNone:
+			default:
+				errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_COMMIT_SIZE_EXEMPT must list %s, %s, %s, or %s (got: '%s')",
+					CommitSizeExemptGenerated, CommitSizeExemptVendored, CommitSizeExemptTestdata, CommitSizeExemptNone, category))
+			}
+		}
+	}
+
+	if c.Checks.TestPackage && c.CheckTimeouts.TestPackage <= 0 {
+		errors = append(errors, "GO_PRE_COMMIT_TEST_PACKAGE_TIMEOUT must be greater than 0")
+	}
+
+	if c.Checks.ModPair && c.CheckTimeouts.ModPair <= 0 {
+		errors = append(errors, "GO_PRE_COMMIT_MOD_PAIR_TIMEOUT must be greater than 0")
+	}
+
+	if c.Checks.ErrorWrap && c.CheckTimeouts.ErrorWrap <= 0 {
+		errors = append(errors, "GO_PRE_COMMIT_ERROR_WRAP_TIMEOUT must be greater than 0")
+	}
+
+	if c.Checks.InternalImport {
+		if c.CheckTimeouts.InternalImport <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_INTERNAL_IMPORT_TIMEOUT must be greater than 0")
+		}
+		for _, rule := range c.CheckBehaviors.InternalImportAllow {
+			if _, err := path.Match(strings.TrimSuffix(rule, "/..."), ""); err != nil {
+				errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_INTERNAL_IMPORT_ALLOW has an invalid pattern '%s': %v", rule, err))
+			}
+		}
+	}
+
+	if c.Checks.FuncLength {
+		if c.CheckTimeouts.FuncLength <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_FUNC_LENGTH_TIMEOUT must be greater than 0")
+		}
+		if c.CheckBehaviors.FuncLengthMaxLines < 0 || c.CheckBehaviors.FuncLengthMaxStatements < 0 {
+			errors = append(errors, "GO_PRE_COMMIT_FUNC_LENGTH_MAX_LINES and GO_PRE_COMMIT_FUNC_LENGTH_MAX_STATEMENTS must not be negative (0 = no limit)")
+		}
+		if c.CheckBehaviors.FuncLengthSeverity != LintSeverityWarning && c.CheckBehaviors.FuncLengthSeverity != LintSeverityError {
+			errors = append(errors, "GO_PRE_COMMIT_FUNC_LENGTH_SEVERITY must be warning or error")
+		}
+	}
+
+	if c.Checks.EnvAccess {
+		if c.CheckTimeouts.EnvAccess <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_ENV_ACCESS_TIMEOUT must be greater than 0")
+		}
+		for _, rule := range c.CheckBehaviors.EnvAccessAllow {
+			if _, err := path.Match(strings.TrimSuffix(rule, "/..."), ""); err != nil {
+				errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_ENV_ACCESS_ALLOW has an invalid pattern '%s': %v", rule, err))
+			}
+		}
+	}
+
+	if c.Checks.TestOnlyDeps {
+		if c.CheckTimeouts.TestOnlyDeps <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_TEST_ONLY_DEPS_TIMEOUT must be greater than 0")
+		}
+		for _, rule := range c.CheckBehaviors.TestOnlyDepsAllow {
+			if _, err := path.Match(strings.TrimSuffix(rule, "/..."), ""); err != nil {
+				errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_TEST_ONLY_DEPS_ALLOW has an invalid pattern '%s': %v", rule, err))
+			}
+		}
+	}
+
+	if c.Checks.GlobalVars {
+		if c.CheckTimeouts.GlobalVars <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_GLOBAL_VARS_TIMEOUT must be greater than 0")
+		}
+		for _, pattern := range c.CheckBehaviors.GlobalVarsAllow {
+			if _, err := path.Match(pattern, ""); err != nil {
+				errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_GLOBAL_VARS_ALLOW has an invalid pattern '%s': %v", pattern, err))
+			}
+		}
+		if c.CheckBehaviors.GlobalVarsSeverity != LintSeverityWarning && c.CheckBehaviors.GlobalVarsSeverity != LintSeverityError {
+			errors = append(errors, "GO_PRE_COMMIT_GLOBAL_VARS_SEVERITY must be warning or error")
+		}
+	}
+
+	if c.Checks.FileHeaderOrder && c.CheckTimeouts.FileHeaderOrder <= 0 {
+		errors = append(errors, "GO_PRE_COMMIT_FILE_HEADER_ORDER_TIMEOUT must be greater than 0")
+	}
+
+	if c.Checks.ContextBackground {
+		if c.CheckTimeouts.ContextBackground <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_CONTEXT_BACKGROUND_TIMEOUT must be greater than 0")
+		}
+		for _, rule := range c.CheckBehaviors.ContextBackgroundAllow {
+			if _, err := path.Match(strings.TrimSuffix(rule, "/..."), ""); err != nil {
+				errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_CONTEXT_BACKGROUND_ALLOW has an invalid pattern '%s': %v", rule, err))
+			}
+		}
+		if c.CheckBehaviors.ContextBackgroundSeverity != LintSeverityWarning && c.CheckBehaviors.ContextBackgroundSeverity != LintSeverityError {
+			errors = append(errors, "GO_PRE_COMMIT_CONTEXT_BACKGROUND_SEVERITY must be warning or error")
+		}
+	}
+
+	if c.Checks.MajorVersionConflict {
+		if c.CheckTimeouts.MajorVersionConflict <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_MAJOR_VERSION_CONFLICT_TIMEOUT must be greater than 0")
+		}
+		for _, rule := range c.CheckBehaviors.MajorVersionConflictAllow {
+			if _, err := path.Match(strings.TrimSuffix(rule, "/..."), ""); err != nil {
+				errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_MAJOR_VERSION_CONFLICT_ALLOW has an invalid pattern '%s': %v", rule, err))
+			}
+		}
+	}
+
+	if c.Checks.FilenameCase && c.CheckTimeouts.FilenameCase <= 0 {
+		errors = append(errors, "GO_PRE_COMMIT_FILENAME_CASE_TIMEOUT must be greater than 0")
+	}
+
+	if c.Checks.BuildConstraints && c.CheckTimeouts.BuildConstraints <= 0 {
+		errors = append(errors, "GO_PRE_COMMIT_BUILD_CONSTRAINTS_TIMEOUT must be greater than 0")
+	}
+
+	if c.Checks.RepoFiles {
+		if c.CheckTimeouts.RepoFiles <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_REPO_FILES_TIMEOUT must be greater than 0")
+		}
+		for _, pattern := range c.CheckBehaviors.RepoFilesRequired {
+			if _, err := path.Match(pattern, ""); err != nil {
+				errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_REPO_FILES_REQUIRED has an invalid pattern '%s': %v", pattern, err))
+			}
+		}
+	}
+
+	if c.Checks.ErrorVarNaming {
+		if c.CheckTimeouts.ErrorVarNaming <= 0 {
+			errors = append(errors, "GO_PRE_COMMIT_ERROR_VAR_NAMING_TIMEOUT must be greater than 0")
+		}
+		if c.CheckBehaviors.ErrorVarNamingSeverity != LintSeverityWarning && c.CheckBehaviors.ErrorVarNamingSeverity != LintSeverityError {
+			errors = append(errors, "GO_PRE_COMMIT_ERROR_VAR_NAMING_SEVERITY must be warning or error")
+		}
+	}
+
+	if c.DefaultScope != "" && c.DefaultScope != ScopeChanged && c.DefaultScope != ScopeAll {
+		errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_DEFAULT_SCOPE must be %s or %s (got: '%s')", ScopeChanged, ScopeAll, c.DefaultScope))
+	}
+
+	if c.Reporting.ReportOn != "" && c.Reporting.ReportOn != ReportOnAlways && c.Reporting.ReportOn != ReportOnFailure {
+		errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_REPORT_ON must be %s or %s (got: '%s')", ReportOnAlways, ReportOnFailure, c.Reporting.ReportOn))
+	}
+
 	// Validate file size limits
 	if c.MaxFileSize <= 0 {
 		errors = append(errors, "GO_PRE_COMMIT_MAX_FILE_SIZE_MB must be greater than 0")
@@ -257,11 +1339,82 @@ func (c *Config) Validate() error {
 		errors = append(errors, "GO_PRE_COMMIT_MAX_FILES_OPEN must be greater than 0")
 	}
 
+	if c.UI.MaxFilesInSummary < 0 {
+		errors = append(errors, "GO_PRE_COMMIT_MAX_FILES_IN_SUMMARY must be 0 (no limit) or positive")
+	}
+
+	if c.UI.DiffContextLines < -1 {
+		errors = append(errors, "GO_PRE_COMMIT_DIFF_CONTEXT_LINES must be -1 (keep all) or greater")
+	}
+
+	if c.UI.DiffMaxLinesShown < 0 {
+		errors = append(errors, "GO_PRE_COMMIT_DIFF_MAX_LINES_SHOWN must be 0 (no limit) or positive")
+	}
+
+	if c.UI.HeartbeatInterval < 0 {
+		errors = append(errors, "GO_PRE_COMMIT_HEARTBEAT_INTERVAL must be 0 (disabled) or positive")
+	}
+
+	if _, ok := output.LookupTheme(c.UI.Theme); !ok {
+		errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_OUTPUT_THEME must be one of %s (got: '%s')", strings.Join(output.ThemeNames(), ", "), c.UI.Theme))
+	}
+
 	// Validate performance settings
 	if c.Performance.ParallelWorkers < 0 {
 		errors = append(errors, "GO_PRE_COMMIT_PARALLEL_WORKERS must be 0 (auto) or positive")
 	}
 
+	if c.Performance.MaxLineSizeKB < 0 {
+		errors = append(errors, "GO_PRE_COMMIT_MAX_LINE_SIZE_KB must be 0 (default) or positive")
+	}
+
+	if c.CheckBehaviors.LintPackageThreshold < 0 {
+		errors = append(errors, "GO_PRE_COMMIT_LINT_PACKAGE_THRESHOLD must be 0 (disabled) or positive")
+	}
+
+	for name, limit := range c.CheckBehaviors.MaxLines {
+		if name == "" || limit <= 0 {
+			errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_CHECK_MAX_LINES entry %q must be <check>=<positive line count>", name))
+		}
+	}
+
+	for name, dir := range c.CheckBehaviors.WorkDir {
+		switch {
+		case name == "" || dir == "":
+			errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_CHECK_WORKDIR entry %q must be <check>=<directory>", name))
+		case !filepath.IsLocal(dir):
+			errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_CHECK_WORKDIR for %q must be a directory inside the repository (got: '%s')", name, dir))
+		}
+	}
+
+	for name, checks := range c.Profiles {
+		if len(checks) == 0 {
+			errors = append(errors, fmt.Sprintf("%s%s must list at least one check", profileEnvPrefix, strings.ToUpper(strings.ReplaceAll(name, "-", "_"))))
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(c.ExternalChecks)) {
+		if isBuiltinCheck(name) {
+			errors = append(errors, fmt.Sprintf("%s%s cannot replace the built-in %s check",
+				externalCheckEnvPrefix, strings.ToUpper(strings.ReplaceAll(name, "-", "_")), name))
+		}
+	}
+
+	for _, name := range c.RequiredChecks {
+		if _, external := c.ExternalChecks[name]; !external && !isBuiltinCheck(name) {
+			errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_REQUIRED_CHECKS names an unknown check '%s'", name))
+		}
+	}
+
+	for linter, severity := range c.CheckBehaviors.LintSeverity {
+		switch {
+		case linter == "":
+			errors = append(errors, "GO_PRE_COMMIT_LINT_SEVERITY entries must be <linter>=<severity>")
+		case severity != LintSeverityError && severity != LintSeverityWarning && severity != LintSeverityInfo:
+			errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_LINT_SEVERITY for %q must be error, warning, or info", linter))
+		}
+	}
+
 	// Validate log level
 	validLogLevels := map[string]bool{
 		"trace": true,
@@ -289,6 +1442,21 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	for tool, version := range c.ToolVersions.Pin {
+		switch {
+		case !slices.Contains(PinnableTools, tool):
+			errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_TOOLS_PIN has unknown tool %q (expected one of: %s)", tool, strings.Join(PinnableTools, ", ")))
+		case !pinVersionPattern.MatchString(version):
+			errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_TOOLS_PIN for %q must be a version such as v1.2.3 (got: '%s')", tool, version))
+		}
+	}
+
+	if c.Performance.TempDir != "" {
+		if info, err := os.Stat(c.Performance.TempDir); err != nil || !info.IsDir() {
+			errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_TEMP_DIR '%s' is not an existing directory", c.Performance.TempDir))
+		}
+	}
+
 	// Validate exclude patterns
 	for i, pattern := range c.Git.ExcludePatterns {
 		if strings.TrimSpace(pattern) == "" {
@@ -296,6 +1464,26 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	for _, dir := range c.Git.ExcludeDirs {
+		if dir == "." || dir == ".." || strings.HasPrefix(dir, "../") || filepath.IsAbs(dir) {
+			errors = append(errors, fmt.Sprintf("GO_PRE_COMMIT_EXCLUDE_DIRS entry '%s' must be a directory inside the repository", dir))
+		}
+	}
+
+	switch c.Git.Backend {
+	case "", GitBackendExec, GitBackendGoGit:
+	default:
+		errors = append(errors, "GO_PRE_COMMIT_GIT_BACKEND must be exec or go-git")
+	}
+	if c.Git.LockAttempts < 0 {
+		errors = append(errors, "GO_PRE_COMMIT_GIT_LOCK_ATTEMPTS must be 1 or more (0 uses the default)")
+	}
+
+	// Validate result cache settings
+	if c.Cache.Enabled && strings.TrimSpace(c.Cache.File) == "" {
+		errors = append(errors, "GO_PRE_COMMIT_RESULT_CACHE_FILE must not be empty when the result cache is enabled")
+	}
+
 	if len(errors) > 0 {
 		return &ValidationError{
 			Errors: errors,
@@ -351,6 +1539,18 @@ Core Settings:
   GO_PRE_COMMIT_MAX_FILE_SIZE_MB=10         Maximum file size to process (MB)
   GO_PRE_COMMIT_MAX_FILES_OPEN=100          Maximum files to keep open
   GO_PRE_COMMIT_TIMEOUT_SECONDS=300         Global timeout in seconds
+  GO_PRE_COMMIT_OVERALL_TIMEOUT_SECONDS=0   Run-wide deadline that cancels every check (0 = GO_PRE_COMMIT_TIMEOUT_SECONDS)
+  GO_PRE_COMMIT_PER_CHECK_TIMEOUT_SECONDS=0 Deadline for each check on its own; other checks keep running (0 = off)
+  GO_PRE_COMMIT_MAX_COMMIT_TIME_SECONDS=0   Commit time budget: quick checks run first, slow ones that no longer fit are skipped (0 = off)
+  GO_PRE_COMMIT_OFFLINE=false               Skip checks that need the network, such as mod-tidy (same as run --offline)
+  GO_PRE_COMMIT_SKIP_MISSING_TOOLS=false    Skip checks whose tool, such as golangci-lint, is not installed (same as run --skip-missing-tools)
+  GO_PRE_COMMIT_AUTO_SELECT=false           Run only the checks whose file patterns match the files being checked
+  GO_PRE_COMMIT_AUTO_SELECT_CHECKS=""       Checks auto-selection may pick, even if disabled (e.g. "whitespace,eof,data-format"; default: the enabled checks)
+  GO_PRE_COMMIT_EXIT_CODE_FAILURE=1         Exit code when a check fails and nothing fixed it
+  GO_PRE_COMMIT_EXIT_CODE_FIXED=2           Exit code when checks fixed every problem (re-stage and commit)
+  GO_PRE_COMMIT_EXIT_CODE_SETUP=3           Exit code for configuration or environment errors
+  GO_PRE_COMMIT_EXIT_CODE_NO_FILES=0        Exit code when no files were checked (needs EMPTY_COMMIT_NOTICE)
+  GO_PRE_COMMIT_DEFAULT_SCOPE=changed       Files "run" checks by default: changed (staged) or all
   GO_PRE_COMMIT_TOOL_INSTALL_TIMEOUT=300   Tool installation timeout in seconds
   GO_PRE_COMMIT_AUTO_ADJUST_CI_TIMEOUTS=true   Auto-adjust timeouts for CI environments
 
@@ -361,19 +1561,126 @@ Check Configuration:
   GO_PRE_COMMIT_ENABLE_WHITESPACE=true      Enable whitespace check
   GO_PRE_COMMIT_ENABLE_EOF=true             Enable EOF newline check
   GO_PRE_COMMIT_ENABLE_GITLEAKS=false       Enable gitleaks secret scanning
+  GO_PRE_COMMIT_ENABLE_BUILD_TAGS=false     Flag legacy // +build lines without //go:build
+  GO_PRE_COMMIT_ENABLE_GO_INDENT=false      Flag Go files indented with spaces (fast format gate)
+  GO_PRE_COMMIT_ENABLE_EMPTY_COMMIT=false   Fail when fixes leave nothing staged to commit
+  GO_PRE_COMMIT_ENABLE_GO_VERSION=false     Require the same go directive in every go.mod
+  GO_PRE_COMMIT_ENABLE_ERROR_COMPARE=false  Flag == comparisons against sentinel errors
+  GO_PRE_COMMIT_ENABLE_TEST_PRESENCE=false  Flag changed Go packages that have no _test.go file
+  GO_PRE_COMMIT_ENABLE_GO_GENERATE=false    Fail when go generate output is out of date (slow)
+  GO_PRE_COMMIT_ENABLE_LARGE_DIFFS=false    Warn when one staged file changes more lines than allowed
+  GO_PRE_COMMIT_ENABLE_TOOLCHAIN=false      Enforce a policy on go.mod toolchain directives
+  GO_PRE_COMMIT_ENABLE_MODULE_PATH=false    Require lowercase go.mod module paths under a configured prefix
+  GO_PRE_COMMIT_ENABLE_BUILD_ARTIFACTS=false  Block staged coverage profiles, CPU profiles, and test binaries
+  GO_PRE_COMMIT_ENABLE_OS_JUNK=false        Block staged .DS_Store, Thumbs.db, and desktop.ini files
+  GO_PRE_COMMIT_ENABLE_CONTEXT_FIRST=false  Require context.Context as the first parameter of exported functions
+  GO_PRE_COMMIT_ENABLE_FORBIDDEN_IMPORTS=false  Block imports of packages on the deny list
+  GO_PRE_COMMIT_ENABLE_STRUCT_TAGS=false    Flag malformed struct tags
+  GO_PRE_COMMIT_ENABLE_DOC_COMMENTS=false   Require doc comments on exported symbols
+  GO_PRE_COMMIT_ENABLE_RECEIVER_NAMES=false  Require short, consistent method receiver names
+  GO_PRE_COMMIT_ENABLE_FILE_PERMISSIONS=false  Block world-writable files and file modes outside the allowlist
+  GO_PRE_COMMIT_ENABLE_EMBEDDED_BLOBS=false  Flag large base64 string literals that belong in //go:embed files
+  GO_PRE_COMMIT_ENABLE_DATA_FORMAT=false    Enforce JSON and YAML indentation and, optionally, sorted keys
+  GO_PRE_COMMIT_ENABLE_GOSEC=false          Scan changed Go modules for security problems with gosec
+  GO_PRE_COMMIT_ENABLE_SHELLCHECK=false     Lint staged shell scripts with shellcheck
+  GO_PRE_COMMIT_ENABLE_HADOLINT=false       Lint staged Dockerfiles with hadolint
+  GO_PRE_COMMIT_ENABLE_GO_DIRECTIVE=false   Fail when a go.mod go directive is newer than the installed Go (skipped offline)
+  GO_PRE_COMMIT_ENABLE_STUB_FUNCS=false     Flag exported functions that are empty, TODO-only, or panic("not implemented")
+  GO_PRE_COMMIT_ENABLE_COMMIT_SIZE=false    Warn when a commit stages more files than allowed
+  GO_PRE_COMMIT_ENABLE_TEST_PACKAGE=false   Require _test.go files to be in <pkg> or <pkg>_test
+  GO_PRE_COMMIT_ENABLE_MOD_PAIR=false       Require go.mod and go.sum changes to be staged together
+  GO_PRE_COMMIT_ENABLE_ERROR_WRAP=false     Require %w when fmt.Errorf formats an error
+  GO_PRE_COMMIT_ENABLE_INTERNAL_IMPORT=false  Block imports of another module's internal packages
+  GO_PRE_COMMIT_ENABLE_FUNC_LENGTH=false    Flag functions over the line or statement limit
+  GO_PRE_COMMIT_ENABLE_ENV_ACCESS=false     Flag os.Getenv calls outside the config packages
+  GO_PRE_COMMIT_ENABLE_TEST_ONLY_DEPS=false  Flag direct requirements only imported by tests
+  GO_PRE_COMMIT_ENABLE_GLOBAL_VARS=false    Flag mutable package-level variables
+  GO_PRE_COMMIT_ENABLE_FILE_HEADER_ORDER=false  Check the order of license, build constraint, and package doc comments
+  GO_PRE_COMMIT_ENABLE_CONTEXT_BACKGROUND=false  Flag context.Background and context.TODO outside main and tests
+  GO_PRE_COMMIT_ENABLE_MAJOR_VERSION_CONFLICT=false  Block packages importing two major versions of one module
+  GO_PRE_COMMIT_ENABLE_FILENAME_CASE=false  Block paths that differ only in case from another tracked path
+  GO_PRE_COMMIT_ENABLE_BUILD_CONSTRAINTS=false  Flag //go:build constraints no build can satisfy
+  GO_PRE_COMMIT_ENABLE_REPO_FILES=false   Require LICENSE, README, and other files at the repository root
+  GO_PRE_COMMIT_ENABLE_ERROR_VAR_NAMING=false  Require package-level error variables to be named ErrXxx or errXxx
 
 Check Behaviors:
-  GO_PRE_COMMIT_FUMPT_AUTO_STAGE=true       Auto-stage files after fumpt fixes
+  GO_PRE_COMMIT_FUMPT_AUTO_STAGE=false      Auto-stage whole files after fumpt fixes, unstaged hunks included
   GO_PRE_COMMIT_WHITESPACE_AUTO_STAGE=true  Auto-stage files after whitespace fixes
   GO_PRE_COMMIT_EOF_AUTO_STAGE=true         Auto-stage files after EOF fixes
+  GO_PRE_COMMIT_BUILD_TAGS_AUTO_FIX=false   Insert the matching //go:build line automatically
+  GO_PRE_COMMIT_FIX_CHMOD_WRITABLE=false    Let whitespace/eof fixes make read-only files writable, then restore their mode
+  GO_PRE_COMMIT_WHITESPACE_PRESERVE_MTIME=false  Keep the modification time of files the whitespace fix rewrites
+  GO_PRE_COMMIT_WHITESPACE_MARKDOWN_HARD_BREAKS=true  Keep exactly two trailing spaces (a hard line break) in Markdown files
+  GO_PRE_COMMIT_WHITESPACE_SEVERITY=error   Whether whitespace fixes block or only warn (warning, error)
+  GO_PRE_COMMIT_WHITESPACE_EMPTY_FILE_POLICY=single-newline  What a whitespace-only file becomes (empty, single-newline)
+  GO_PRE_COMMIT_WHITESPACE_ADD_FINAL_NEWLINE=false  Also add a missing final newline to files the whitespace fix rewrites
+  GO_PRE_COMMIT_EOF_SEVERITY=error          Whether EOF fixes block or only warn (warning, error)
+  GO_PRE_COMMIT_WARN_ONLY_CHECKS=""         Checks whose failures warn without blocking (e.g. "lint,gitleaks")
+  GO_PRE_COMMIT_CONTINUE_ON_ERROR_CHECKS="" Checks whose failures are reported as failures but exit 0 (e.g. "gitleaks")
+  GO_PRE_COMMIT_REQUIRED_CHECKS=""          Checks that must run; the run fails when one is disabled or skipped (e.g. "lint,gitleaks")
+  GO_PRE_COMMIT_CHECK_MAX_LINES=""          Skip files over a line count per check (e.g. "whitespace=5000")
+  GO_PRE_COMMIT_CHECK_WORKDIR=""            Directory a plugin check's command runs in, relative to the repo root (e.g. "frontend=web")
+  GO_PRE_COMMIT_GO_VERSION_ALLOW=""         Module dirs allowed a different go version (e.g. "tools,examples/legacy")
+  GO_PRE_COMMIT_ERROR_COMPARE_SKIP_TESTS=false  Skip _test.go files in the error-compare check
+  GO_PRE_COMMIT_TEST_PRESENCE_SEVERITY=warning  Whether packages without tests warn or block (warning, error)
+  GO_PRE_COMMIT_LINT_PACKAGE_THRESHOLD=100  Lint affected packages in one run above this many Go files (0=off)
+  GO_PRE_COMMIT_LINT_SEVERITY=""            Severity per linter: error blocks, warning/info do not (e.g. "gosec=error,revive=warning")
+  GO_PRE_COMMIT_MAX_DIFF_LINES=1000         Added+removed lines one staged file may change before large-diffs warns
+  GO_PRE_COMMIT_LARGE_DIFFS_SEVERITY=warning  Whether oversized diffs warn or block (warning, error)
+  GO_PRE_COMMIT_TOOLCHAIN_POLICY=forbid     forbid any toolchain directive, or require GO_PRE_COMMIT_TOOLCHAIN_VERSION
+  GO_PRE_COMMIT_TOOLCHAIN_VERSION=""        Toolchain every go.mod must declare under the require policy (e.g. go1.22.5)
+  GO_PRE_COMMIT_MODULE_PATH_PREFIX=""       Prefix every module path must start with (e.g. github.com/acme; empty = casing only)
+  GO_PRE_COMMIT_GO_WORKSPACE=false         Run lint and mod-tidy per module listed in a root go.work
+  GO_PRE_COMMIT_BUILD_ARTIFACT_PATTERNS="coverage.out,*.prof,*.pprof,*.test"  Staged paths build-artifacts blocks (a pattern without / matches file names)
+  GO_PRE_COMMIT_OS_JUNK_PATTERNS=".DS_Store,Thumbs.db,desktop.ini"  Staged files os-junk blocks
+  GO_PRE_COMMIT_OS_JUNK_AUTO_FIX=false      Unstage junk files with git rm --cached instead of only failing
+  GO_PRE_COMMIT_FORBIDDEN_IMPORTS="io/ioutil"  Denied import paths: exact, globs, or "path/..." for a whole tree
+  GO_PRE_COMMIT_FORBIDDEN_IMPORTS_ALLOW=""  Files that may use denied imports (a pattern without / matches file names)
+  GO_PRE_COMMIT_INTERNAL_IMPORT_ALLOW=""    Internal packages any module may import: exact, globs, or "path/..."
+  GO_PRE_COMMIT_FUNC_LENGTH_MAX_LINES=80    Longest function func-length accepts, in lines (0 = no limit)
+  GO_PRE_COMMIT_FUNC_LENGTH_MAX_STATEMENTS=50  Most statements a function may hold (0 = no limit)
+  GO_PRE_COMMIT_FUNC_LENGTH_SEVERITY=warning  Whether long functions warn or block (warning, error)
+  GO_PRE_COMMIT_ENV_ACCESS_ALLOW="internal/config"  Package directories that may read the environment: exact, globs, or "dir/..."
+  GO_PRE_COMMIT_TEST_ONLY_DEPS_ALLOW="github.com/stretchr/testify"  Modules expected to be imported only by tests
+  GO_PRE_COMMIT_GLOBAL_VARS_ALLOW="version,commit"  Package-level variable names global-vars accepts: exact or globs
+  GO_PRE_COMMIT_GLOBAL_VARS_SEVERITY=warning  Whether mutable globals warn or block (warning, error)
+  GO_PRE_COMMIT_FILE_HEADER_LICENSE_MARKERS="Copyright,SPDX-License-Identifier"  Text that marks a license comment for file-header-order
+  GO_PRE_COMMIT_CONTEXT_BACKGROUND_ALLOW=""  Package directories that may start new contexts: exact, globs, or "dir/..."
+  GO_PRE_COMMIT_CONTEXT_BACKGROUND_SEVERITY=warning  Whether new contexts in library code warn or block (warning, error)
+  GO_PRE_COMMIT_MAJOR_VERSION_CONFLICT_ALLOW=""  Modules (without /vN) a package may import at several major versions
+  GO_PRE_COMMIT_REPO_FILES_REQUIRED="LICENSE*,README*"  Globs repo-files requires at the repository root, ignoring case
+  GO_PRE_COMMIT_ERROR_VAR_NAMING_SEVERITY=warning  Whether misnamed error variables warn or block (warning, error)
+  GO_PRE_COMMIT_STRUCT_TAG_KEYS=""          Allowed struct tag keys (e.g. "json,yaml,db"); empty allows any key
+  GO_PRE_COMMIT_DOC_COMMENTS_SKIP_MAIN=true  Leave main packages out of the doc-comments check
+  GO_PRE_COMMIT_RECEIVER_NAME_MAX_LENGTH=2  Longest receiver name receiver-names accepts (0 = no limit)
+  GO_PRE_COMMIT_FILE_PERMISSION_MODES="100644,100755"  Git modes file-permissions allows (644 and 0644 mean 100644)
+  GO_PRE_COMMIT_FILE_PERMISSIONS_AUTO_FIX=false  Fix modes with git update-index --chmod and remove world write permission
+  GO_PRE_COMMIT_EMBEDDED_BLOB_MIN_LENGTH=1024  Shortest base64 string literal embedded-blobs flags
+  GO_PRE_COMMIT_DATA_FORMAT_STYLE=indent    JSON/YAML formatting: none (must parse), indent (keep key order), canonical (also sort keys)
+  GO_PRE_COMMIT_DATA_FORMAT_INDENT=2        Spaces per JSON and YAML indentation level
+  GO_PRE_COMMIT_GOSEC_SEVERITY=medium       Lowest gosec severity that fails the check: low, medium, or high (lower ones warn)
+  GO_PRE_COMMIT_SHELLCHECK_SEVERITY=style   Lowest shellcheck level reported: error, warning, info, or style (every reported finding fails)
+  GO_PRE_COMMIT_HADOLINT_SEVERITY=info      Lowest hadolint level that fails: error, warning, info, or style (lower ones warn)
+  GO_PRE_COMMIT_STUB_FUNCS_SEVERITY=warning  Whether stub functions warn or block (warning, error)
+  GO_PRE_COMMIT_MAX_STAGED_FILES=50         Staged files a commit may hold before commit-size warns
+  GO_PRE_COMMIT_COMMIT_SIZE_SEVERITY=warning  Whether oversized commits warn or block (warning, error)
+  GO_PRE_COMMIT_COMMIT_SIZE_EXEMPT=generated  Staged files not counted: generated, vendored, testdata, or none
+  GO_PRE_COMMIT_EOF_EXEMPT=""               Files the eof check never flags (e.g. "*.golden.json,testdata/raw.txt")
+  GO_PRE_COMMIT_MOD_TIDY_DIFF=auto          How mod-tidy checks modules: auto (-diff on Go 1.23+), always (-diff), never (tidy and compare)
+  GO_PRE_COMMIT_RESOLVE_FORMATTER_CONFLICTS=false  Skip fumpt when .golangci.yml enables gofumpt, gci, or goimports
+  GO_PRE_COMMIT_RERUN_VALIDATORS_AFTER_FIX=true  Run formatting checks before the others so validators see fixed files
 
 Tool Versions:
   GO_PRE_COMMIT_FUMPT_VERSION=latest        gofumpt version
   GO_PRE_COMMIT_GOLANGCI_LINT_VERSION=latest  golangci-lint version
+  GO_PRE_COMMIT_TOOLS_PIN=""                Fail checks whose tool differs from a pinned version (e.g. "golangci-lint=v2.4.0,go=1.25")
 
 Performance Settings:
   GO_PRE_COMMIT_PARALLEL_WORKERS=0          Parallel workers (0=auto)
   GO_PRE_COMMIT_FAIL_FAST=false             Stop on first failure
+  GO_PRE_COMMIT_MAX_LINE_SIZE_KB=10240      Longest line the streaming text checks will buffer (KB)
+  GO_PRE_COMMIT_LOAD_AWARE=false            Reduce workers when CPU load or free memory is constrained
+  GO_PRE_COMMIT_TEMP_DIR=""                 Directory for scratch workspaces (default: system temp directory)
 
 Check Timeouts (seconds):
   GO_PRE_COMMIT_FUMPT_TIMEOUT=30            gofumpt timeout
@@ -382,13 +1689,92 @@ Check Timeouts (seconds):
   GO_PRE_COMMIT_WHITESPACE_TIMEOUT=30       whitespace check timeout
   GO_PRE_COMMIT_EOF_TIMEOUT=30              EOF check timeout
   GO_PRE_COMMIT_GITLEAKS_TIMEOUT=60         gitleaks scan timeout
+  GO_PRE_COMMIT_BUILD_TAGS_TIMEOUT=30       build tag check timeout
+  GO_PRE_COMMIT_GO_INDENT_TIMEOUT=30        Go indentation check timeout
+  GO_PRE_COMMIT_EMPTY_COMMIT_TIMEOUT=10     Empty commit check timeout
+  GO_PRE_COMMIT_GO_VERSION_TIMEOUT=30       go directive consistency check timeout
+  GO_PRE_COMMIT_ERROR_COMPARE_TIMEOUT=30    Sentinel error comparison check timeout
+  GO_PRE_COMMIT_TEST_PRESENCE_TIMEOUT=30    Test file presence check timeout
+  GO_PRE_COMMIT_GO_GENERATE_TIMEOUT=120     go generate freshness check timeout
+  GO_PRE_COMMIT_LARGE_DIFFS_TIMEOUT=10      Large diff check timeout
+  GO_PRE_COMMIT_TOOLCHAIN_TIMEOUT=30        go.mod toolchain policy check timeout
+  GO_PRE_COMMIT_MODULE_PATH_TIMEOUT=30      go.mod module path check timeout
+  GO_PRE_COMMIT_BUILD_ARTIFACTS_TIMEOUT=10  Build artifact check timeout
+  GO_PRE_COMMIT_OS_JUNK_TIMEOUT=10          OS junk file check timeout
+  GO_PRE_COMMIT_CONTEXT_FIRST_TIMEOUT=30    context.Context parameter position check timeout
+  GO_PRE_COMMIT_FORBIDDEN_IMPORTS_TIMEOUT=30  Forbidden import check timeout
+  GO_PRE_COMMIT_STRUCT_TAGS_TIMEOUT=30      Struct tag check timeout
+  GO_PRE_COMMIT_DOC_COMMENTS_TIMEOUT=30     Doc comment check timeout
+  GO_PRE_COMMIT_RECEIVER_NAMES_TIMEOUT=30   Receiver name check timeout
+  GO_PRE_COMMIT_FILE_PERMISSIONS_TIMEOUT=10  File permission check timeout
+  GO_PRE_COMMIT_EMBEDDED_BLOBS_TIMEOUT=30   Embedded blob check timeout
+  GO_PRE_COMMIT_DATA_FORMAT_TIMEOUT=30      Data format check timeout
+  GO_PRE_COMMIT_GOSEC_TIMEOUT=120           Gosec check timeout
+  GO_PRE_COMMIT_SHELLCHECK_TIMEOUT=60       Shellcheck check timeout
+  GO_PRE_COMMIT_HADOLINT_TIMEOUT=60         Hadolint check timeout
+  GO_PRE_COMMIT_GO_DIRECTIVE_TIMEOUT=30     Go directive toolchain check timeout
+  GO_PRE_COMMIT_STUB_FUNCS_TIMEOUT=30       Stub function check timeout
+  GO_PRE_COMMIT_COMMIT_SIZE_TIMEOUT=10      Commit size check timeout
+  GO_PRE_COMMIT_TEST_PACKAGE_TIMEOUT=30     Test file package check timeout
+  GO_PRE_COMMIT_MOD_PAIR_TIMEOUT=60         go.mod/go.sum pair check timeout
+  GO_PRE_COMMIT_ERROR_WRAP_TIMEOUT=30       Error wrapping check timeout
+  GO_PRE_COMMIT_INTERNAL_IMPORT_TIMEOUT=30  Internal import check timeout
+  GO_PRE_COMMIT_FUNC_LENGTH_TIMEOUT=30      Function length check timeout
+  GO_PRE_COMMIT_ENV_ACCESS_TIMEOUT=30       Environment access check timeout
+  GO_PRE_COMMIT_TEST_ONLY_DEPS_TIMEOUT=60   Test-only dependency check timeout
+  GO_PRE_COMMIT_GLOBAL_VARS_TIMEOUT=30      Global variable check timeout
+  GO_PRE_COMMIT_FILE_HEADER_ORDER_TIMEOUT=30  File header order check timeout
+  GO_PRE_COMMIT_CONTEXT_BACKGROUND_TIMEOUT=30  Context background check timeout
+  GO_PRE_COMMIT_MAJOR_VERSION_CONFLICT_TIMEOUT=30  Major version conflict check timeout
+  GO_PRE_COMMIT_FILENAME_CASE_TIMEOUT=10    Filename case check timeout
+  GO_PRE_COMMIT_BUILD_CONSTRAINTS_TIMEOUT=30  Build constraint check timeout
+  GO_PRE_COMMIT_REPO_FILES_TIMEOUT=10     Repository files check timeout
+  GO_PRE_COMMIT_ERROR_VAR_NAMING_TIMEOUT=30  Error variable naming check timeout
 
 Git Settings:
   GO_PRE_COMMIT_HOOKS_PATH=.git/hooks       Git hooks directory
   GO_PRE_COMMIT_EXCLUDE_PATTERNS="vendor/,node_modules/,.git/"  Exclude patterns
+  GO_PRE_COMMIT_EXCLUDE_DIRS=""             Directories no check looks at (e.g. "third_party,web/dist")
+  GO_PRE_COMMIT_SKIP_DOTFILES=false         Skip hidden files and directories (.*)
+  GO_PRE_COMMIT_DOTFILE_INCLUDES=""         Hidden paths checked anyway (e.g. ".github/,.golangci.yml")
+  GO_PRE_COMMIT_CONTENT_SNIFF_EXTENSIONS=""  Known text extensions still judged binary by content (e.g. ".txt,.csv")
+  GO_PRE_COMMIT_GIT_BACKEND=exec            Read staged files with the git binary (exec) or in-process (go-git)
+  GO_PRE_COMMIT_GIT_LOCK_ATTEMPTS=3         Tries of a git index command while another git process holds index.lock
+  GO_PRE_COMMIT_REPO_ROOT=""                Repository root to use instead of asking git (process environment or --repo-root only)
+
+Run Hooks:
+  GO_PRE_COMMIT_PRE_RUN=""                  Commands run before the checks, separated by ";" (a failure aborts the run)
+  GO_PRE_COMMIT_POST_RUN=""                 Commands run after the checks, separated by ";" (failures are warnings)
 
 UI Settings:
   GO_PRE_COMMIT_COLOR_OUTPUT=true           Enable colored output
+  GO_PRE_COMMIT_OUTPUT_THEME=default        Colors used when color is on (default, high-contrast, monochrome)
+  GO_PRE_COMMIT_MAX_FILES_IN_SUMMARY=20     Files listed per check before "... and N more" (0 = all)
+  GO_PRE_COMMIT_HEARTBEAT_INTERVAL=5        Seconds between "still running" lines for slow checks (0 = off; terminal only)
+  GO_PRE_COMMIT_EMPTY_COMMIT_NOTICE=false   Say "No files to check" when no enabled check had files
+  GO_PRE_COMMIT_PROGRESS_BAR=false          Show a progress bar instead of a line per passing check (terminal only)
+  GO_PRE_COMMIT_DIFF_CONTEXT_LINES=3        Context lines shown around each change in diffs (-1 = all)
+  GO_PRE_COMMIT_DIFF_MAX_LINES_SHOWN=100    Diff lines shown per file before "(N more lines changed)" (0 = all)
+  GO_PRE_COMMIT_REDACT_DIFF_SECRETS=true    Show diff lines holding secrets, such as private keys, as "****"
+
+Result Cache:
+  GO_PRE_COMMIT_ENABLE_RESULT_CACHE=false   Skip cacheable checks whose files, packages, or modules already passed unchanged
+  GO_PRE_COMMIT_RESULT_CACHE_FILE=.git/go-pre-commit-cache.json  Cache file (relative to repo root)
+
+Reporting:
+  GO_PRE_COMMIT_GITHUB_REVIEW=false         Post lint/vet diagnostics as inline pull request review comments
+                                            (needs GITHUB_TOKEN, GITHUB_REPOSITORY, and GO_PRE_COMMIT_PR_NUMBER or a pull request GITHUB_REF)
+  GO_PRE_COMMIT_REPORT_ON=always            Show every check's result (always), or only the summary unless something fails (failure)
+
+Check Profiles (select with "run --profile <name>"):
+  GO_PRE_COMMIT_PROFILE_FAST="whitespace,eof"  Checks in the fast profile
+  GO_PRE_COMMIT_PROFILE_FULL="whitespace,eof,fumpt,lint,mod-tidy"  Checks in the full profile
+  GO_PRE_COMMIT_PROFILE_<NAME>=""           Define another profile (PRE_PUSH becomes "pre-push")
+
+External Checks (JSON diagnostics contract in the README's Plugin System section):
+  GO_PRE_COMMIT_EXTERNAL_CHECK_<NAME>=""    Run a shell command as the check NAME (LICENSE_HEADERS becomes "license-headers");
+                                            it reads file paths on stdin, prints {"diagnostics": [...]} on stdout,
+                                            and is bounded by GO_PRE_COMMIT_PLUGIN_TIMEOUT
 
 Configuration Methods (auto-detected):
 
@@ -402,6 +1788,11 @@ Configuration Methods (auto-detected):
   Detection: If .github/env/ exists with >=1 .env file, modular mode is used.
   Otherwise, falls back to legacy .env.base/.env.custom.
 
+  Config file: .go-pre-commit.yml, .yaml, .toml, or .json (first found, in that order)
+    Sets enabled, timeout, exclude_patterns, and per-check enabled/timeout.
+    The env files and the environment override it; it overrides the defaults.
+    GO_PRE_COMMIT_ENV=ci (or --env ci) merges .go-pre-commit.ci.yml over it first.
+
 Example .github/env/ (modular):
   00-core.env:
     ENABLE_GO_PRE_COMMIT=true
@@ -443,12 +1834,14 @@ func findBaseEnvFile() (string, error) {
 	}
 
 	// First, check if we're already in the right place
-	if _, err := os.Stat(".github/.env.base"); err == nil {
-		return ".github/.env.base", nil
+	if os.Getenv(shared.RepoRootEnv) == "" {
+		if _, err := os.Stat(".github/.env.base"); err == nil {
+			return ".github/.env.base", nil
+		}
 	}
 
 	// Walk up the directory tree looking for .github/.env.base
-	cwd, err := os.Getwd()
+	cwd, err := envSearchDir()
 	if err != nil {
 		return "", fmt.Errorf("failed to get current directory: %w", err)
 	}
@@ -494,12 +1887,12 @@ func findEnvDir() string {
 	}
 
 	// Check .github/env relative to cwd
-	if hasEnvFiles(".github/env") {
+	if os.Getenv(shared.RepoRootEnv) == "" && hasEnvFiles(".github/env") {
 		return ".github/env"
 	}
 
 	// Walk up directory tree
-	cwd, err := os.Getwd()
+	cwd, err := envSearchDir()
 	if err != nil {
 		return ""
 	}
@@ -520,6 +1913,15 @@ func findEnvDir() string {
 	return ""
 }
 
+// envSearchDir returns the directory the configuration search walks up from:
+// the GO_PRE_COMMIT_REPO_ROOT override when set, otherwise the working directory
+func envSearchDir() (string, error) {
+	if root := os.Getenv(shared.RepoRootEnv); root != "" {
+		return filepath.Abs(root)
+	}
+	return os.Getwd()
+}
+
 // hasEnvFiles checks if dirPath exists, is a directory, and contains >=1 *.env file.
 func hasEnvFiles(dirPath string) bool {
 	info, err := os.Stat(dirPath) // #nosec G703 - path is validated by caller
@@ -630,6 +2032,164 @@ func getIntEnv(key string, defaultValue int) int {
 	return i
 }
 
+// defaultProfiles returns the built-in check profiles: fast for commits, and
+// full, which adds the slower Go checks, for pre-push and CI
+func defaultProfiles() map[string][]string {
+	return map[string][]string{
+		ProfileFast: {"whitespace", "eof"},
+		ProfileFull: {"whitespace", "eof", "fumpt", "lint", "mod-tidy"},
+	}
+}
+
+// loadProfiles returns the built-in profiles overlaid with every
+// GO_PRE_COMMIT_PROFILE_<NAME> variable. NAME is lowercased and underscores
+// become dashes, so GO_PRE_COMMIT_PROFILE_PRE_PUSH defines "pre-push".
+func loadProfiles() map[string][]string {
+	profiles := defaultProfiles()
+	for _, entry := range os.Environ() {
+		key, value, _ := strings.Cut(entry, "=")
+		stem, ok := strings.CutPrefix(key, profileEnvPrefix)
+		if !ok || stem == "" {
+			continue
+		}
+		name := strings.ReplaceAll(strings.ToLower(stem), "_", "-")
+		checks := []string{}
+		for _, check := range strings.Split(stripComments(value), ",") {
+			if check = strings.TrimSpace(check); check != "" {
+				checks = append(checks, check)
+			}
+		}
+		profiles[name] = checks
+	}
+	return profiles
+}
+
+// loadExternalChecks returns the command of every
+// GO_PRE_COMMIT_EXTERNAL_CHECK_<NAME> variable, keyed by check name. NAME is
+// lowercased and underscores become dashes, as for profiles. A variable left
+// empty defines no check.
+func loadExternalChecks() map[string]string {
+	external := make(map[string]string)
+	for _, entry := range os.Environ() {
+		key, value, _ := strings.Cut(entry, "=")
+		stem, ok := strings.CutPrefix(key, externalCheckEnvPrefix)
+		if !ok || stem == "" {
+			continue
+		}
+		if command := strings.TrimSpace(stripComments(value)); command != "" {
+			external[strings.ReplaceAll(strings.ToLower(stem), "_", "-")] = command
+		}
+	}
+	return external
+}
+
+// isBuiltinCheck reports whether name is one of the checks go-pre-commit ships
+func isBuiltinCheck(name string) bool {
+	_, known := checkEnvStem(name)
+	return known
+}
+
+// parseCheckLimits parses a comma-separated list of <check>=<count> entries.
+// Malformed counts are kept as 0 so Validate can report them.
+// normalizeGitFileMode expands a permission such as 644 or 0644 to the git
+// mode 100644; anything else is returned unchanged for Validate to report
+func normalizeGitFileMode(mode string) string {
+	if trimmed := strings.TrimLeft(mode, "0"); len(trimmed) == 3 {
+		return "100" + trimmed
+	}
+	return mode
+}
+
+func parseCheckLimits(value string) map[string]int {
+	if value == "" {
+		return nil
+	}
+
+	limits := make(map[string]int)
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		name, count, _ := strings.Cut(entry, "=")
+		limit, err := strconv.Atoi(strings.TrimSpace(count))
+		if err != nil {
+			limit = 0
+		}
+		limits[strings.TrimSpace(name)] = limit
+	}
+	return limits
+}
+
+// parseCheckWorkDirs parses a comma-separated list of <check>=<directory>
+// entries, cleaning each directory. Entries missing a directory are kept
+// empty so Validate can report them.
+func parseCheckWorkDirs(value string) map[string]string {
+	if value == "" {
+		return nil
+	}
+
+	dirs := make(map[string]string)
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		name, dir, _ := strings.Cut(entry, "=")
+		if dir = strings.TrimSpace(dir); dir != "" {
+			dir = filepath.Clean(dir)
+		}
+		dirs[strings.TrimSpace(name)] = dir
+	}
+	return dirs
+}
+
+// splitCommands splits a semicolon-separated list of shell commands, dropping
+// empty entries
+func splitCommands(value string) []string {
+	var commands []string
+	for _, command := range strings.Split(value, ";") {
+		if command = strings.TrimSpace(command); command != "" {
+			commands = append(commands, command)
+		}
+	}
+	return commands
+}
+
+// parseLintSeverity parses a comma-separated list of linter=severity entries
+// such as "gosec=error,revive=warning". Severities are lowercased; invalid
+// ones are kept so Validate can report them.
+func parseLintSeverity(value string) map[string]string {
+	if value == "" {
+		return nil
+	}
+
+	severities := make(map[string]string)
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		linter, severity, _ := strings.Cut(entry, "=")
+		severities[strings.TrimSpace(linter)] = strings.ToLower(strings.TrimSpace(severity))
+	}
+	return severities
+}
+
+// parseToolPins parses a comma-separated list of tool=version entries
+func parseToolPins(value string) map[string]string {
+	if value == "" {
+		return nil
+	}
+
+	pins := make(map[string]string)
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		tool, version, _ := strings.Cut(entry, "=")
+		pins[strings.TrimSpace(tool)] = strings.TrimSpace(version)
+	}
+	return pins
+}
+
 func getStringEnv(key, defaultValue string) string {
 	val := os.Getenv(key)
 	if val == "" {
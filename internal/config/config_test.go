@@ -227,7 +227,179 @@ func (s *ConfigTestSuite) clearEnvVars() {
 		"GO_PRE_COMMIT_EOF_TIMEOUT",
 		"GO_PRE_COMMIT_HOOKS_PATH",
 		"GO_PRE_COMMIT_EXCLUDE_PATTERNS",
+		"GO_PRE_COMMIT_SKIP_DOTFILES",
+		"GO_PRE_COMMIT_DOTFILE_INCLUDES",
+		"GO_PRE_COMMIT_EXCLUDE_DIRS",
+		"GO_PRE_COMMIT_WARN_ONLY_CHECKS",
+		"GO_PRE_COMMIT_CONTINUE_ON_ERROR_CHECKS",
+		"GO_PRE_COMMIT_MAX_LINE_SIZE_KB",
+		"GO_PRE_COMMIT_CHECK_MAX_LINES",
+		"GO_PRE_COMMIT_CHECK_WORKDIR",
+		"GO_PRE_COMMIT_FIX_CHMOD_WRITABLE",
+		"GO_PRE_COMMIT_WHITESPACE_PRESERVE_MTIME",
+		"GO_PRE_COMMIT_WHITESPACE_MARKDOWN_HARD_BREAKS",
+		"GO_PRE_COMMIT_WHITESPACE_SEVERITY",
+		"GO_PRE_COMMIT_WHITESPACE_EMPTY_FILE_POLICY",
+		"GO_PRE_COMMIT_WHITESPACE_ADD_FINAL_NEWLINE",
+		"GO_PRE_COMMIT_EOF_SEVERITY",
+		"GO_PRE_COMMIT_GO_VERSION_ALLOW",
+		"GO_PRE_COMMIT_ERROR_COMPARE_SKIP_TESTS",
+		"GO_PRE_COMMIT_LINT_PACKAGE_THRESHOLD",
+		"GO_PRE_COMMIT_LINT_SEVERITY",
+		"GO_PRE_COMMIT_ENABLE_TEST_PRESENCE",
+		"GO_PRE_COMMIT_TEST_PRESENCE_SEVERITY",
+		"GO_PRE_COMMIT_LOAD_AWARE",
+		"GO_PRE_COMMIT_TEST_PRESENCE_TIMEOUT",
+		"GO_PRE_COMMIT_ENABLE_GO_GENERATE",
+		"GO_PRE_COMMIT_GO_GENERATE_TIMEOUT",
+		"GO_PRE_COMMIT_DEFAULT_SCOPE",
+		"GO_PRE_COMMIT_GITHUB_REVIEW",
+		"GO_PRE_COMMIT_REPORT_ON",
+		"GO_PRE_COMMIT_ENV",
+		"GO_PRE_COMMIT_OVERALL_TIMEOUT_SECONDS",
+		"GO_PRE_COMMIT_PER_CHECK_TIMEOUT_SECONDS",
+		"GO_PRE_COMMIT_MAX_COMMIT_TIME_SECONDS",
+		"GO_PRE_COMMIT_ENABLE_TOOLCHAIN",
+		"GO_PRE_COMMIT_ENABLE_MODULE_PATH",
+		"GO_PRE_COMMIT_MODULE_PATH_PREFIX",
+		"GO_PRE_COMMIT_RESOLVE_FORMATTER_CONFLICTS",
+		"GO_PRE_COMMIT_RERUN_VALIDATORS_AFTER_FIX",
+		"GO_PRE_COMMIT_GO_WORKSPACE",
+		"GO_PRE_COMMIT_CONTENT_SNIFF_EXTENSIONS",
+		"GO_PRE_COMMIT_EXIT_CODE_FAILURE",
+		"GO_PRE_COMMIT_EXIT_CODE_FIXED",
+		"GO_PRE_COMMIT_EXIT_CODE_SETUP",
+		"GO_PRE_COMMIT_EXIT_CODE_NO_FILES",
+		"GO_PRE_COMMIT_ENABLE_BUILD_ARTIFACTS",
+		"GO_PRE_COMMIT_BUILD_ARTIFACT_PATTERNS",
+		"GO_PRE_COMMIT_BUILD_ARTIFACTS_TIMEOUT",
+		"GO_PRE_COMMIT_OFFLINE",
+		"GO_PRE_COMMIT_SKIP_MISSING_TOOLS",
+		"GO_PRE_COMMIT_AUTO_SELECT",
+		"GO_PRE_COMMIT_AUTO_SELECT_CHECKS",
+		"GO_PRE_COMMIT_ENABLE_OS_JUNK",
+		"GO_PRE_COMMIT_OS_JUNK_PATTERNS",
+		"GO_PRE_COMMIT_OS_JUNK_AUTO_FIX",
+		"GO_PRE_COMMIT_OS_JUNK_TIMEOUT",
+		"GO_PRE_COMMIT_MOD_TIDY_DIFF",
+		"GO_PRE_COMMIT_EOF_EXEMPT",
+		"GO_PRE_COMMIT_GIT_BACKEND",
+		"GO_PRE_COMMIT_GIT_LOCK_ATTEMPTS",
+		"GO_PRE_COMMIT_ENABLE_CONTEXT_FIRST",
+		"GO_PRE_COMMIT_CONTEXT_FIRST_TIMEOUT",
+		"GO_PRE_COMMIT_PRE_RUN",
+		"GO_PRE_COMMIT_TEMP_DIR",
+		"GO_PRE_COMMIT_ENABLE_STRUCT_TAGS",
+		"GO_PRE_COMMIT_STRUCT_TAG_KEYS",
+		"GO_PRE_COMMIT_STRUCT_TAGS_TIMEOUT",
+		"GO_PRE_COMMIT_TOOLS_PIN",
+		"GO_PRE_COMMIT_ENABLE_DOC_COMMENTS",
+		"GO_PRE_COMMIT_DOC_COMMENTS_TIMEOUT",
+		"GO_PRE_COMMIT_DOC_COMMENTS_SKIP_MAIN",
+		"GO_PRE_COMMIT_ENABLE_RECEIVER_NAMES",
+		"GO_PRE_COMMIT_RECEIVER_NAMES_TIMEOUT",
+		"GO_PRE_COMMIT_RECEIVER_NAME_MAX_LENGTH",
+		"GO_PRE_COMMIT_ENABLE_FILE_PERMISSIONS",
+		"GO_PRE_COMMIT_FILE_PERMISSION_MODES",
+		"GO_PRE_COMMIT_FILE_PERMISSIONS_AUTO_FIX",
+		"GO_PRE_COMMIT_FILE_PERMISSIONS_TIMEOUT",
+		"GO_PRE_COMMIT_ENABLE_EMBEDDED_BLOBS",
+		"GO_PRE_COMMIT_EMBEDDED_BLOB_MIN_LENGTH",
+		"GO_PRE_COMMIT_EMBEDDED_BLOBS_TIMEOUT",
+		"GO_PRE_COMMIT_ENABLE_DATA_FORMAT",
+		"GO_PRE_COMMIT_DATA_FORMAT_STYLE",
+		"GO_PRE_COMMIT_DATA_FORMAT_INDENT",
+		"GO_PRE_COMMIT_DATA_FORMAT_TIMEOUT",
+		"GO_PRE_COMMIT_ENABLE_GOSEC",
+		"GO_PRE_COMMIT_GOSEC_SEVERITY",
+		"GO_PRE_COMMIT_GOSEC_TIMEOUT",
+		"GO_PRE_COMMIT_ENABLE_SHELLCHECK",
+		"GO_PRE_COMMIT_SHELLCHECK_SEVERITY",
+		"GO_PRE_COMMIT_SHELLCHECK_TIMEOUT",
+		"GO_PRE_COMMIT_ENABLE_HADOLINT",
+		"GO_PRE_COMMIT_HADOLINT_SEVERITY",
+		"GO_PRE_COMMIT_HADOLINT_TIMEOUT",
+		"GO_PRE_COMMIT_ENABLE_GO_DIRECTIVE",
+		"GO_PRE_COMMIT_GO_DIRECTIVE_TIMEOUT",
+		"GO_PRE_COMMIT_ENABLE_STUB_FUNCS",
+		"GO_PRE_COMMIT_STUB_FUNCS_SEVERITY",
+		"GO_PRE_COMMIT_STUB_FUNCS_TIMEOUT",
+		"GO_PRE_COMMIT_ENABLE_TEST_PACKAGE",
+		"GO_PRE_COMMIT_TEST_PACKAGE_TIMEOUT",
+		"GO_PRE_COMMIT_ENABLE_MOD_PAIR",
+		"GO_PRE_COMMIT_MOD_PAIR_TIMEOUT",
+		"GO_PRE_COMMIT_ENABLE_ERROR_WRAP",
+		"GO_PRE_COMMIT_ERROR_WRAP_TIMEOUT",
+		"GO_PRE_COMMIT_ENABLE_INTERNAL_IMPORT",
+		"GO_PRE_COMMIT_INTERNAL_IMPORT_TIMEOUT",
+		"GO_PRE_COMMIT_INTERNAL_IMPORT_ALLOW",
+		"GO_PRE_COMMIT_ENABLE_FUNC_LENGTH",
+		"GO_PRE_COMMIT_FUNC_LENGTH_TIMEOUT",
+		"GO_PRE_COMMIT_FUNC_LENGTH_MAX_LINES",
+		"GO_PRE_COMMIT_FUNC_LENGTH_MAX_STATEMENTS",
+		"GO_PRE_COMMIT_FUNC_LENGTH_SEVERITY",
+		"GO_PRE_COMMIT_ENABLE_ENV_ACCESS",
+		"GO_PRE_COMMIT_ENV_ACCESS_TIMEOUT",
+		"GO_PRE_COMMIT_ENV_ACCESS_ALLOW",
+		"GO_PRE_COMMIT_ENABLE_TEST_ONLY_DEPS",
+		"GO_PRE_COMMIT_TEST_ONLY_DEPS_TIMEOUT",
+		"GO_PRE_COMMIT_TEST_ONLY_DEPS_ALLOW",
+		"GO_PRE_COMMIT_ENABLE_GLOBAL_VARS",
+		"GO_PRE_COMMIT_GLOBAL_VARS_TIMEOUT",
+		"GO_PRE_COMMIT_GLOBAL_VARS_ALLOW",
+		"GO_PRE_COMMIT_GLOBAL_VARS_SEVERITY",
+		"GO_PRE_COMMIT_ENABLE_FILE_HEADER_ORDER",
+		"GO_PRE_COMMIT_FILE_HEADER_ORDER_TIMEOUT",
+		"GO_PRE_COMMIT_FILE_HEADER_LICENSE_MARKERS",
+		"GO_PRE_COMMIT_ENABLE_CONTEXT_BACKGROUND",
+		"GO_PRE_COMMIT_CONTEXT_BACKGROUND_TIMEOUT",
+		"GO_PRE_COMMIT_CONTEXT_BACKGROUND_ALLOW",
+		"GO_PRE_COMMIT_CONTEXT_BACKGROUND_SEVERITY",
+		"GO_PRE_COMMIT_ENABLE_MAJOR_VERSION_CONFLICT",
+		"GO_PRE_COMMIT_MAJOR_VERSION_CONFLICT_TIMEOUT",
+		"GO_PRE_COMMIT_MAJOR_VERSION_CONFLICT_ALLOW",
+		"GO_PRE_COMMIT_ENABLE_FILENAME_CASE",
+		"GO_PRE_COMMIT_FILENAME_CASE_TIMEOUT",
+		"GO_PRE_COMMIT_ENABLE_BUILD_CONSTRAINTS",
+		"GO_PRE_COMMIT_BUILD_CONSTRAINTS_TIMEOUT",
+		"GO_PRE_COMMIT_ENABLE_REPO_FILES",
+		"GO_PRE_COMMIT_REPO_FILES_TIMEOUT",
+		"GO_PRE_COMMIT_ENABLE_ERROR_VAR_NAMING",
+		"GO_PRE_COMMIT_ERROR_VAR_NAMING_TIMEOUT",
+		"GO_PRE_COMMIT_ERROR_VAR_NAMING_SEVERITY",
+		"GO_PRE_COMMIT_REPO_FILES_REQUIRED",
+		"GO_PRE_COMMIT_ENABLE_COMMIT_SIZE",
+		"GO_PRE_COMMIT_MAX_STAGED_FILES",
+		"GO_PRE_COMMIT_COMMIT_SIZE_SEVERITY",
+		"GO_PRE_COMMIT_COMMIT_SIZE_EXEMPT",
+		"GO_PRE_COMMIT_COMMIT_SIZE_TIMEOUT",
+		"GO_PRE_COMMIT_ENABLE_FORBIDDEN_IMPORTS",
+		"GO_PRE_COMMIT_FORBIDDEN_IMPORTS",
+		"GO_PRE_COMMIT_FORBIDDEN_IMPORTS_ALLOW",
+		"GO_PRE_COMMIT_FORBIDDEN_IMPORTS_TIMEOUT",
+		"GO_PRE_COMMIT_POST_RUN",
+		"GO_PRE_COMMIT_MODULE_PATH_TIMEOUT",
+		"GO_PRE_COMMIT_TOOLCHAIN_POLICY",
+		"GO_PRE_COMMIT_TOOLCHAIN_VERSION",
+		"GO_PRE_COMMIT_TOOLCHAIN_TIMEOUT",
+		"GO_PRE_COMMIT_ENABLE_LARGE_DIFFS",
+		"GO_PRE_COMMIT_MAX_DIFF_LINES",
+		"GO_PRE_COMMIT_LARGE_DIFFS_SEVERITY",
+		"GO_PRE_COMMIT_LARGE_DIFFS_TIMEOUT",
+		"GO_PRE_COMMIT_PROFILE_FAST",
+		"GO_PRE_COMMIT_PROFILE_PRE_PUSH",
+		"GO_PRE_COMMIT_EXTERNAL_CHECK_LICENSE_HEADERS",
+		"GO_PRE_COMMIT_EXTERNAL_CHECK_LINT",
+		"GO_PRE_COMMIT_REQUIRED_CHECKS",
 		"GO_PRE_COMMIT_COLOR_OUTPUT",
+		"GO_PRE_COMMIT_MAX_FILES_IN_SUMMARY",
+		"GO_PRE_COMMIT_HEARTBEAT_INTERVAL",
+		"GO_PRE_COMMIT_EMPTY_COMMIT_NOTICE",
+		"GO_PRE_COMMIT_OUTPUT_THEME",
+		"GO_PRE_COMMIT_PROGRESS_BAR",
+		"GO_PRE_COMMIT_DIFF_CONTEXT_LINES",
+		"GO_PRE_COMMIT_REDACT_DIFF_SECRETS",
+		"GO_PRE_COMMIT_DIFF_MAX_LINES_SHOWN",
 		// CI-related environment variables
 		"CI",
 		"GITHUB_ACTIONS",
@@ -288,6 +460,8 @@ GO_PRE_COMMIT_EOF_TIMEOUT=10
 GO_PRE_COMMIT_HOOKS_PATH=.git/custom-hooks
 GO_PRE_COMMIT_EXCLUDE_PATTERNS=vendor/,dist/,build/
 GO_PRE_COMMIT_COLOR_OUTPUT=false
+GO_PRE_COMMIT_MAX_FILES_IN_SUMMARY=5
+GO_PRE_COMMIT_HEARTBEAT_INTERVAL=2
 `
 	s.createEnvFile(envContent)
 
@@ -330,6 +504,8 @@ GO_PRE_COMMIT_COLOR_OUTPUT=false
 
 	// UI settings
 	s.False(cfg.UI.ColorOutput)
+	s.Equal(5, cfg.UI.MaxFilesInSummary)
+	s.Equal(2, cfg.UI.HeartbeatInterval)
 
 	// Directory should be empty for PATH-based binary lookup approach
 	// We no longer use directory-based approach, binary is found via PATH
@@ -352,6 +528,8 @@ func (s *ConfigTestSuite) TestLoadWithMinimalConfiguration() {
 	s.Equal(int64(10*1024*1024), cfg.MaxFileSize)
 	s.Equal(100, cfg.MaxFilesOpen)
 	s.Equal(720, cfg.Timeout)
+	s.Equal(20, cfg.UI.MaxFilesInSummary)
+	s.Equal(5, cfg.UI.HeartbeatInterval)
 	s.True(cfg.Checks.Fumpt)
 	s.True(cfg.Checks.Lint)
 	s.True(cfg.Checks.ModTidy)
@@ -388,6 +566,1609 @@ GO_PRE_COMMIT_EXCLUDE_PATTERNS=vendor/ , node_modules/ , .git/
 	s.Equal([]string{"vendor/", "node_modules/", ".git/"}, cfg.Git.ExcludePatterns)
 }
 
+// TestLoadWarnOnlyChecks tests parsing of the advisory check list
+func (s *ConfigTestSuite) TestLoadWarnOnlyChecks() {
+	envContent := `ENABLE_GO_PRE_COMMIT=true
+GO_PRE_COMMIT_WARN_ONLY_CHECKS=lint , gitleaks,,
+`
+	s.createEnvFile(envContent)
+
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Equal([]string{"lint", "gitleaks"}, cfg.CheckBehaviors.WarnOnly)
+}
+
+// TestLoadContinueOnErrorChecks tests parsing of the continue-on-error check list
+func (s *ConfigTestSuite) TestLoadContinueOnErrorChecks() {
+	s.createEnvFile("ENABLE_GO_PRE_COMMIT=true\n")
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Empty(cfg.CheckBehaviors.ContinueOnError)
+
+	s.T().Setenv("GO_PRE_COMMIT_CONTINUE_ON_ERROR_CHECKS", " gitleaks,, lint ")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal([]string{"gitleaks", "lint"}, cfg.CheckBehaviors.ContinueOnError)
+}
+
+// TestLoadAutoSelect tests auto-selection and the checks it may pick
+func (s *ConfigTestSuite) TestLoadAutoSelect() {
+	s.createEnvFile("ENABLE_GO_PRE_COMMIT=true\n")
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Runner.AutoSelect, "the enabled checks run by default")
+	s.Empty(cfg.Runner.AutoSelectChecks)
+
+	s.T().Setenv("GO_PRE_COMMIT_AUTO_SELECT", "true")
+	s.T().Setenv("GO_PRE_COMMIT_AUTO_SELECT_CHECKS", " whitespace,, data-format ")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.True(cfg.Runner.AutoSelect)
+	s.Equal([]string{"whitespace", "data-format"}, cfg.Runner.AutoSelectChecks)
+}
+
+// TestLoadMaxLineSize tests the line buffer limit and its validation
+func (s *ConfigTestSuite) TestLoadMaxLineSize() {
+	s.createEnvFile("ENABLE_GO_PRE_COMMIT=true\n")
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Equal(10240, cfg.Performance.MaxLineSizeKB)
+
+	s.T().Setenv("GO_PRE_COMMIT_MAX_LINE_SIZE_KB", "-1")
+	cfg, err = Load()
+	s.Require().Error(err)
+	s.Nil(cfg)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_MAX_LINE_SIZE_KB")
+}
+
+// TestLoadCheckMaxLines tests parsing and validation of per-check line limits
+func (s *ConfigTestSuite) TestLoadCheckMaxLines() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+GO_PRE_COMMIT_CHECK_MAX_LINES=whitespace=5000, eof = 200,
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Equal(map[string]int{"whitespace": 5000, "eof": 200}, cfg.CheckBehaviors.MaxLines)
+
+	for _, invalid := range []string{"whitespace", "whitespace=lots", "eof=0", "=10"} {
+		s.T().Setenv("GO_PRE_COMMIT_CHECK_MAX_LINES", invalid)
+		cfg, err = Load()
+		s.Require().Error(err, invalid)
+		s.Nil(cfg)
+		s.Contains(err.Error(), "GO_PRE_COMMIT_CHECK_MAX_LINES")
+	}
+}
+
+// TestLoadFixChmodWritable tests the read-only file handling of fixers
+func (s *ConfigTestSuite) TestLoadFixChmodWritable() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.CheckBehaviors.FixChmodWritable, "off by default")
+
+	s.T().Setenv("GO_PRE_COMMIT_FIX_CHMOD_WRITABLE", "true")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.True(cfg.CheckBehaviors.FixChmodWritable)
+}
+
+// TestLoadWhitespacePreserveMtime tests the whitespace fix's modification time handling
+func (s *ConfigTestSuite) TestLoadWhitespacePreserveMtime() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.CheckBehaviors.WhitespacePreserveMtime, "off by default")
+
+	s.T().Setenv("GO_PRE_COMMIT_WHITESPACE_PRESERVE_MTIME", "true")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.True(cfg.CheckBehaviors.WhitespacePreserveMtime)
+}
+
+// TestLoadWhitespaceMarkdownBreaks tests keeping Markdown hard line breaks in the whitespace fix
+func (s *ConfigTestSuite) TestLoadWhitespaceMarkdownBreaks() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.True(cfg.CheckBehaviors.WhitespaceMarkdownBreaks, "on by default")
+
+	s.T().Setenv("GO_PRE_COMMIT_WHITESPACE_MARKDOWN_HARD_BREAKS", "false")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.False(cfg.CheckBehaviors.WhitespaceMarkdownBreaks)
+}
+
+// TestLoadWhitespaceEmptyFilePolicy tests what the whitespace fix leaves of whitespace-only files
+func (s *ConfigTestSuite) TestLoadWhitespaceEmptyFilePolicy() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Equal(WhitespaceEmptyFileNewline, cfg.CheckBehaviors.WhitespaceEmptyFilePolicy)
+
+	s.T().Setenv("GO_PRE_COMMIT_WHITESPACE_EMPTY_FILE_POLICY", "Empty")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal(WhitespaceEmptyFileEmpty, cfg.CheckBehaviors.WhitespaceEmptyFilePolicy)
+
+	s.T().Setenv("GO_PRE_COMMIT_WHITESPACE_EMPTY_FILE_POLICY", "delete")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_WHITESPACE_EMPTY_FILE_POLICY must be empty or single-newline (got: 'delete')")
+}
+
+// TestLoadWhitespaceAddFinalNewline tests whether the whitespace fix adds a missing final newline
+func (s *ConfigTestSuite) TestLoadWhitespaceAddFinalNewline() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.CheckBehaviors.WhitespaceAddFinalNewline, "off by default")
+
+	s.T().Setenv("GO_PRE_COMMIT_WHITESPACE_ADD_FINAL_NEWLINE", "true")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.True(cfg.CheckBehaviors.WhitespaceAddFinalNewline)
+}
+
+// TestLoadFixSeverity tests the whitespace and EOF severities
+func (s *ConfigTestSuite) TestLoadFixSeverity() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Equal(LintSeverityError, cfg.CheckBehaviors.WhitespaceSeverity, "fixes block by default")
+	s.Equal(LintSeverityError, cfg.CheckBehaviors.EOFSeverity, "fixes block by default")
+
+	s.T().Setenv("GO_PRE_COMMIT_WHITESPACE_SEVERITY", "Warning")
+	s.T().Setenv("GO_PRE_COMMIT_EOF_SEVERITY", "warning")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal(LintSeverityWarning, cfg.CheckBehaviors.WhitespaceSeverity)
+	s.Equal(LintSeverityWarning, cfg.CheckBehaviors.EOFSeverity)
+
+	s.T().Setenv("GO_PRE_COMMIT_EOF_SEVERITY", "info")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_EOF_SEVERITY must be warning or error")
+}
+
+// TestLoadCheckWorkDir tests parsing and validation of per-check working directories
+func (s *ConfigTestSuite) TestLoadCheckWorkDir() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+GO_PRE_COMMIT_CHECK_WORKDIR=frontend=web/, docs = ./site,
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Equal(map[string]string{"frontend": "web", "docs": "site"}, cfg.CheckBehaviors.WorkDir)
+
+	for _, invalid := range []string{"frontend", "frontend=", "=web", "frontend=../web", "frontend=/srv/web"} {
+		s.T().Setenv("GO_PRE_COMMIT_CHECK_WORKDIR", invalid)
+		cfg, err = Load()
+		s.Require().Error(err, invalid)
+		s.Nil(cfg)
+		s.Contains(err.Error(), "GO_PRE_COMMIT_CHECK_WORKDIR")
+	}
+}
+
+// TestLoadGoVersionAllow tests parsing of the go directive allowlist
+func (s *ConfigTestSuite) TestLoadGoVersionAllow() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+GO_PRE_COMMIT_ENABLE_GO_VERSION=true
+GO_PRE_COMMIT_GO_VERSION_ALLOW=tools/ , examples/legacy,,
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.True(cfg.Checks.GoVersion)
+	s.Equal(30, cfg.CheckTimeouts.GoVersion)
+	s.Equal([]string{"tools", "examples/legacy"}, cfg.CheckBehaviors.GoVersionAllow)
+
+	s.T().Setenv("GO_PRE_COMMIT_GO_VERSION_TIMEOUT", "0")
+	cfg, err = Load()
+	s.Require().Error(err)
+	s.Nil(cfg)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_GO_VERSION_TIMEOUT")
+}
+
+// TestLoadErrorCompare tests the error-compare check settings
+func (s *ConfigTestSuite) TestLoadErrorCompare() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+GO_PRE_COMMIT_ENABLE_ERROR_COMPARE=true
+GO_PRE_COMMIT_ERROR_COMPARE_SKIP_TESTS=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.True(cfg.Checks.ErrorCompare)
+	s.True(cfg.CheckBehaviors.ErrorCompareSkipTests)
+	s.Equal(30, cfg.CheckTimeouts.ErrorCompare)
+
+	s.T().Setenv("GO_PRE_COMMIT_ERROR_COMPARE_TIMEOUT", "0")
+	cfg, err = Load()
+	s.Require().Error(err)
+	s.Nil(cfg)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_ERROR_COMPARE_TIMEOUT")
+}
+
+// TestLoadLintPackageThreshold tests the package linting threshold setting
+func (s *ConfigTestSuite) TestLoadLintPackageThreshold() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Equal(100, cfg.CheckBehaviors.LintPackageThreshold)
+
+	s.T().Setenv("GO_PRE_COMMIT_LINT_PACKAGE_THRESHOLD", "0")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Zero(cfg.CheckBehaviors.LintPackageThreshold, "0 disables package linting")
+
+	s.T().Setenv("GO_PRE_COMMIT_LINT_PACKAGE_THRESHOLD", "-1")
+	cfg, err = Load()
+	s.Require().Error(err)
+	s.Nil(cfg)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_LINT_PACKAGE_THRESHOLD")
+}
+
+// TestLoadLintSeverity tests parsing and validation of the per-linter severity map
+func (s *ConfigTestSuite) TestLoadLintSeverity() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+GO_PRE_COMMIT_LINT_SEVERITY=gosec=error, revive=Warning,godot=info
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Equal(map[string]string{"gosec": "error", "revive": "warning", "godot": "info"}, cfg.CheckBehaviors.LintSeverity)
+
+	s.T().Setenv("GO_PRE_COMMIT_LINT_SEVERITY", "gosec=fatal")
+	cfg, err = Load()
+	s.Require().Error(err)
+	s.Nil(cfg)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_LINT_SEVERITY")
+}
+
+// TestLoadTestPresenceSettings tests the test file presence check settings
+func (s *ConfigTestSuite) TestLoadTestPresenceSettings() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+GO_PRE_COMMIT_ENABLE_TEST_PRESENCE=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.True(cfg.Checks.TestPresence)
+	s.Equal(LintSeverityWarning, cfg.CheckBehaviors.TestPresenceSeverity)
+	s.Equal(30, cfg.CheckTimeouts.TestPresence)
+
+	s.T().Setenv("GO_PRE_COMMIT_TEST_PRESENCE_SEVERITY", "Error")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal(LintSeverityError, cfg.CheckBehaviors.TestPresenceSeverity)
+
+	s.T().Setenv("GO_PRE_COMMIT_TEST_PRESENCE_SEVERITY", "info")
+	cfg, err = Load()
+	s.Require().Error(err)
+	s.Nil(cfg)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_TEST_PRESENCE_SEVERITY")
+}
+
+// TestLoadDefaultScope tests the default file scope of the run command
+func (s *ConfigTestSuite) TestLoadDefaultScope() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Equal(ScopeChanged, cfg.DefaultScope)
+
+	s.T().Setenv("GO_PRE_COMMIT_DEFAULT_SCOPE", "All")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal(ScopeAll, cfg.DefaultScope)
+
+	s.T().Setenv("GO_PRE_COMMIT_DEFAULT_SCOPE", "staged")
+	cfg, err = Load()
+	s.Require().Error(err)
+	s.Nil(cfg)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_DEFAULT_SCOPE")
+}
+
+// TestLoadReportOn tests the report policy of the run command
+func (s *ConfigTestSuite) TestLoadReportOn() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Equal(ReportOnAlways, cfg.Reporting.ReportOn)
+
+	s.T().Setenv("GO_PRE_COMMIT_REPORT_ON", "Failure")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal(ReportOnFailure, cfg.Reporting.ReportOn)
+
+	s.T().Setenv("GO_PRE_COMMIT_REPORT_ON", "never")
+	cfg, err = Load()
+	s.Require().Error(err)
+	s.Nil(cfg)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_REPORT_ON")
+}
+
+// TestLoadRunnerTimeouts tests the overall and per-check runner timeouts, offline mode, and skipping missing tools
+func (s *ConfigTestSuite) TestLoadRunnerTimeouts() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Zero(cfg.Runner.OverallTimeout, "0 falls back to GO_PRE_COMMIT_TIMEOUT_SECONDS")
+	s.Zero(cfg.Runner.PerCheckTimeout, "no per-check timeout by default")
+	s.False(cfg.Runner.Offline, "network checks run by default")
+	s.False(cfg.Runner.SkipMissingTools, "missing tools are installed or fail by default")
+	s.Zero(cfg.Runner.MaxCommitTime, "no commit time budget by default")
+
+	s.T().Setenv("GO_PRE_COMMIT_OVERALL_TIMEOUT_SECONDS", "900")
+	s.T().Setenv("GO_PRE_COMMIT_PER_CHECK_TIMEOUT_SECONDS", "120")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal(900, cfg.Runner.OverallTimeout)
+	s.Equal(120, cfg.Runner.PerCheckTimeout)
+
+	s.T().Setenv("GO_PRE_COMMIT_MAX_COMMIT_TIME_SECONDS", "10")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal(10, cfg.Runner.MaxCommitTime)
+
+	s.T().Setenv("GO_PRE_COMMIT_OFFLINE", "true")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.True(cfg.Runner.Offline)
+
+	s.T().Setenv("GO_PRE_COMMIT_SKIP_MISSING_TOOLS", "true")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.True(cfg.Runner.SkipMissingTools)
+
+	s.T().Setenv("GO_PRE_COMMIT_PER_CHECK_TIMEOUT_SECONDS", "-1")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_PER_CHECK_TIMEOUT_SECONDS")
+
+	s.T().Setenv("GO_PRE_COMMIT_PER_CHECK_TIMEOUT_SECONDS", "0")
+	s.T().Setenv("GO_PRE_COMMIT_MAX_COMMIT_TIME_SECONDS", "-5")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_MAX_COMMIT_TIME_SECONDS")
+}
+
+// TestLoadBuildArtifacts tests the build artifact check settings
+func (s *ConfigTestSuite) TestLoadBuildArtifacts() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.BuildArtifacts, "opt-in")
+	s.Equal([]string{"coverage.out", "*.prof", "*.pprof", "*.test"}, cfg.CheckBehaviors.BuildArtifactPatterns)
+	s.Equal(10, cfg.CheckTimeouts.BuildArtifacts)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_BUILD_ARTIFACTS", "true")
+	s.T().Setenv("GO_PRE_COMMIT_BUILD_ARTIFACT_PATTERNS", " *.out, ,dist/* ")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal([]string{"*.out", "dist/*"}, cfg.CheckBehaviors.BuildArtifactPatterns)
+
+	s.T().Setenv("GO_PRE_COMMIT_BUILD_ARTIFACT_PATTERNS", "[.out")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_BUILD_ARTIFACT_PATTERNS")
+}
+
+// TestLoadOSJunk tests the OS junk check settings
+func (s *ConfigTestSuite) TestLoadOSJunk() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.OSJunk, "opt-in")
+	s.Equal([]string{".DS_Store", "Thumbs.db", "desktop.ini"}, cfg.CheckBehaviors.OSJunkPatterns)
+	s.False(cfg.CheckBehaviors.OSJunkAutoFix)
+	s.Equal(10, cfg.CheckTimeouts.OSJunk)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_OS_JUNK", "true")
+	s.T().Setenv("GO_PRE_COMMIT_OS_JUNK_PATTERNS", ".DS_Store, ._*")
+	s.T().Setenv("GO_PRE_COMMIT_OS_JUNK_AUTO_FIX", "true")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal([]string{".DS_Store", "._*"}, cfg.CheckBehaviors.OSJunkPatterns)
+	s.True(cfg.CheckBehaviors.OSJunkAutoFix)
+
+	s.T().Setenv("GO_PRE_COMMIT_OS_JUNK_TIMEOUT", "0")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_OS_JUNK_TIMEOUT")
+}
+
+// TestLoadModTidyDiff tests the mod-tidy -diff mode
+func (s *ConfigTestSuite) TestLoadModTidyDiff() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Equal(ModTidyDiffAuto, cfg.CheckBehaviors.ModTidyDiff)
+
+	s.T().Setenv("GO_PRE_COMMIT_MOD_TIDY_DIFF", "Never")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal(ModTidyDiffNever, cfg.CheckBehaviors.ModTidyDiff)
+
+	s.T().Setenv("GO_PRE_COMMIT_MOD_TIDY_DIFF", "sometimes")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_MOD_TIDY_DIFF must be auto, always, or never")
+}
+
+// TestLoadEOFExempt tests the eof exemption patterns
+func (s *ConfigTestSuite) TestLoadEOFExempt() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Empty(cfg.CheckBehaviors.EOFExempt)
+
+	s.T().Setenv("GO_PRE_COMMIT_EOF_EXEMPT", "*.golden.json, testdata/raw.txt,")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal([]string{"*.golden.json", "testdata/raw.txt"}, cfg.CheckBehaviors.EOFExempt)
+
+	s.T().Setenv("GO_PRE_COMMIT_EOF_EXEMPT", "[")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_EOF_EXEMPT has an invalid pattern")
+}
+
+// TestLoadGitBackend tests the git backend selection
+func (s *ConfigTestSuite) TestLoadGitBackend() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Equal(GitBackendExec, cfg.Git.Backend)
+
+	s.T().Setenv("GO_PRE_COMMIT_GIT_BACKEND", "Go-Git")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal(GitBackendGoGit, cfg.Git.Backend)
+
+	s.T().Setenv("GO_PRE_COMMIT_GIT_BACKEND", "libgit2")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_GIT_BACKEND must be exec or go-git")
+}
+
+// TestLoadGitLockAttempts tests how often git index commands are tried while the index is locked
+func (s *ConfigTestSuite) TestLoadGitLockAttempts() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Equal(3, cfg.Git.LockAttempts)
+
+	s.T().Setenv("GO_PRE_COMMIT_GIT_LOCK_ATTEMPTS", "5")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal(5, cfg.Git.LockAttempts)
+
+	s.T().Setenv("GO_PRE_COMMIT_GIT_LOCK_ATTEMPTS", "-1")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_GIT_LOCK_ATTEMPTS must be 1 or more (0 uses the default)")
+}
+
+// TestLoadContextFirst tests the context-first check settings
+func (s *ConfigTestSuite) TestLoadContextFirst() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.ContextFirst, "opt-in")
+	s.Equal(30, cfg.CheckTimeouts.ContextFirst)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_CONTEXT_FIRST", "true")
+	s.T().Setenv("GO_PRE_COMMIT_CONTEXT_FIRST_TIMEOUT", "0")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_CONTEXT_FIRST_TIMEOUT")
+}
+
+// TestLoadTestPackage tests the test-package check settings
+func (s *ConfigTestSuite) TestLoadTestPackage() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.TestPackage, "opt-in")
+	s.Equal(30, cfg.CheckTimeouts.TestPackage)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_TEST_PACKAGE", "true")
+	s.T().Setenv("GO_PRE_COMMIT_TEST_PACKAGE_TIMEOUT", "0")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_TEST_PACKAGE_TIMEOUT must be greater than 0")
+}
+
+// TestLoadModPair tests the mod-pair check settings
+func (s *ConfigTestSuite) TestLoadModPair() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.ModPair, "opt-in")
+	s.Equal(60, cfg.CheckTimeouts.ModPair)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_MOD_PAIR", "true")
+	s.T().Setenv("GO_PRE_COMMIT_MOD_PAIR_TIMEOUT", "0")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_MOD_PAIR_TIMEOUT must be greater than 0")
+}
+
+// TestLoadErrorWrap tests the error-wrap check settings
+func (s *ConfigTestSuite) TestLoadErrorWrap() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.ErrorWrap, "opt-in")
+	s.Equal(30, cfg.CheckTimeouts.ErrorWrap)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_ERROR_WRAP", "true")
+	s.T().Setenv("GO_PRE_COMMIT_ERROR_WRAP_TIMEOUT", "0")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_ERROR_WRAP_TIMEOUT must be greater than 0")
+}
+
+// TestLoadInternalImport tests the internal-import check settings
+func (s *ConfigTestSuite) TestLoadInternalImport() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+GO_PRE_COMMIT_INTERNAL_IMPORT_ALLOW=example.com/repo/internal/testutil/..., example.com/repo/internal/version
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.InternalImport, "opt-in")
+	s.Equal(30, cfg.CheckTimeouts.InternalImport)
+	s.Equal([]string{"example.com/repo/internal/testutil/...", "example.com/repo/internal/version"}, cfg.CheckBehaviors.InternalImportAllow)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_INTERNAL_IMPORT", "true")
+	s.T().Setenv("GO_PRE_COMMIT_INTERNAL_IMPORT_ALLOW", "example.com/[")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_INTERNAL_IMPORT_ALLOW has an invalid pattern 'example.com/['")
+}
+
+// TestLoadFuncLength tests the function length check settings and their validation
+func (s *ConfigTestSuite) TestLoadFuncLength() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+GO_PRE_COMMIT_ENABLE_FUNC_LENGTH=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.True(cfg.Checks.FuncLength)
+	s.Equal(30, cfg.CheckTimeouts.FuncLength)
+	s.Equal(80, cfg.CheckBehaviors.FuncLengthMaxLines)
+	s.Equal(50, cfg.CheckBehaviors.FuncLengthMaxStatements)
+	s.Equal(LintSeverityWarning, cfg.CheckBehaviors.FuncLengthSeverity)
+
+	s.T().Setenv("GO_PRE_COMMIT_FUNC_LENGTH_MAX_LINES", "0")
+	s.T().Setenv("GO_PRE_COMMIT_FUNC_LENGTH_SEVERITY", "Error")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Zero(cfg.CheckBehaviors.FuncLengthMaxLines)
+	s.Equal(LintSeverityError, cfg.CheckBehaviors.FuncLengthSeverity)
+
+	s.T().Setenv("GO_PRE_COMMIT_FUNC_LENGTH_MAX_STATEMENTS", "-1")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "must not be negative")
+
+	s.T().Setenv("GO_PRE_COMMIT_FUNC_LENGTH_MAX_STATEMENTS", "40")
+	s.T().Setenv("GO_PRE_COMMIT_FUNC_LENGTH_SEVERITY", "info")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_FUNC_LENGTH_SEVERITY must be warning or error")
+}
+
+// TestLoadEnvAccess tests the environment access check settings and their validation
+func (s *ConfigTestSuite) TestLoadEnvAccess() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.EnvAccess, "opt-in")
+	s.Equal(30, cfg.CheckTimeouts.EnvAccess)
+	s.Equal([]string{"internal/config"}, cfg.CheckBehaviors.EnvAccessAllow)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_ENV_ACCESS", "true")
+	s.T().Setenv("GO_PRE_COMMIT_ENV_ACCESS_ALLOW", "internal/config, cmd/...")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal([]string{"internal/config", "cmd/..."}, cfg.CheckBehaviors.EnvAccessAllow)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENV_ACCESS_ALLOW", "internal/[")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_ENV_ACCESS_ALLOW has an invalid pattern 'internal/['")
+}
+
+// TestLoadTestOnlyDeps tests the test-only dependency check settings and their validation
+func (s *ConfigTestSuite) TestLoadTestOnlyDeps() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.TestOnlyDeps, "opt-in")
+	s.Equal(60, cfg.CheckTimeouts.TestOnlyDeps)
+	s.Equal([]string{"github.com/stretchr/testify"}, cfg.CheckBehaviors.TestOnlyDepsAllow)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_TEST_ONLY_DEPS", "true")
+	s.T().Setenv("GO_PRE_COMMIT_TEST_ONLY_DEPS_ALLOW", "github.com/stretchr/testify, github.com/onsi/...")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal([]string{"github.com/stretchr/testify", "github.com/onsi/..."}, cfg.CheckBehaviors.TestOnlyDepsAllow)
+
+	s.T().Setenv("GO_PRE_COMMIT_TEST_ONLY_DEPS_ALLOW", "github.com/[")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_TEST_ONLY_DEPS_ALLOW has an invalid pattern 'github.com/['")
+}
+
+// TestLoadGlobalVars tests the global variable check settings and their validation
+func (s *ConfigTestSuite) TestLoadGlobalVars() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.GlobalVars, "opt-in")
+	s.Equal(30, cfg.CheckTimeouts.GlobalVars)
+	s.Equal([]string{"version", "commit"}, cfg.CheckBehaviors.GlobalVarsAllow)
+	s.Equal(LintSeverityWarning, cfg.CheckBehaviors.GlobalVarsSeverity)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_GLOBAL_VARS", "true")
+	s.T().Setenv("GO_PRE_COMMIT_GLOBAL_VARS_ALLOW", "version, default*")
+	s.T().Setenv("GO_PRE_COMMIT_GLOBAL_VARS_SEVERITY", "ERROR")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal([]string{"version", "default*"}, cfg.CheckBehaviors.GlobalVarsAllow)
+	s.Equal(LintSeverityError, cfg.CheckBehaviors.GlobalVarsSeverity)
+
+	s.T().Setenv("GO_PRE_COMMIT_GLOBAL_VARS_ALLOW", "default[")
+	s.T().Setenv("GO_PRE_COMMIT_GLOBAL_VARS_SEVERITY", "fatal")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_GLOBAL_VARS_ALLOW has an invalid pattern 'default['")
+	s.Contains(err.Error(), "GO_PRE_COMMIT_GLOBAL_VARS_SEVERITY must be warning or error")
+}
+
+// TestLoadFileHeaderOrder tests the file header order check settings and their validation
+func (s *ConfigTestSuite) TestLoadFileHeaderOrder() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.FileHeaderOrder, "opt-in")
+	s.Equal(30, cfg.CheckTimeouts.FileHeaderOrder)
+	s.Equal([]string{"Copyright", "SPDX-License-Identifier"}, cfg.CheckBehaviors.FileHeaderLicenseMarkers)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_FILE_HEADER_ORDER", "true")
+	s.T().Setenv("GO_PRE_COMMIT_FILE_HEADER_LICENSE_MARKERS", " Licensed under , ")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal([]string{"Licensed under"}, cfg.CheckBehaviors.FileHeaderLicenseMarkers)
+
+	s.T().Setenv("GO_PRE_COMMIT_FILE_HEADER_ORDER_TIMEOUT", "0")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_FILE_HEADER_ORDER_TIMEOUT must be greater than 0")
+}
+
+// TestLoadContextBackground tests the context-background check settings
+func (s *ConfigTestSuite) TestLoadContextBackground() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.ContextBackground, "opt-in")
+	s.Equal(30, cfg.CheckTimeouts.ContextBackground)
+	s.Empty(cfg.CheckBehaviors.ContextBackgroundAllow)
+	s.Equal(LintSeverityWarning, cfg.CheckBehaviors.ContextBackgroundSeverity)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_CONTEXT_BACKGROUND", "true")
+	s.T().Setenv("GO_PRE_COMMIT_CONTEXT_BACKGROUND_ALLOW", " internal/server/... , cmd/* ,")
+	s.T().Setenv("GO_PRE_COMMIT_CONTEXT_BACKGROUND_SEVERITY", "ERROR")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal([]string{"internal/server/...", "cmd/*"}, cfg.CheckBehaviors.ContextBackgroundAllow)
+	s.Equal(LintSeverityError, cfg.CheckBehaviors.ContextBackgroundSeverity)
+
+	s.T().Setenv("GO_PRE_COMMIT_CONTEXT_BACKGROUND_ALLOW", "internal/[")
+	s.T().Setenv("GO_PRE_COMMIT_CONTEXT_BACKGROUND_SEVERITY", "fatal")
+	s.T().Setenv("GO_PRE_COMMIT_CONTEXT_BACKGROUND_TIMEOUT", "0")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_CONTEXT_BACKGROUND_TIMEOUT must be greater than 0")
+	s.Contains(err.Error(), "GO_PRE_COMMIT_CONTEXT_BACKGROUND_ALLOW has an invalid pattern 'internal/['")
+	s.Contains(err.Error(), "GO_PRE_COMMIT_CONTEXT_BACKGROUND_SEVERITY must be warning or error")
+}
+
+// TestLoadMajorVersionConflict tests the major-version-conflict check settings
+func (s *ConfigTestSuite) TestLoadMajorVersionConflict() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.MajorVersionConflict, "opt-in")
+	s.Equal(30, cfg.CheckTimeouts.MajorVersionConflict)
+	s.Empty(cfg.CheckBehaviors.MajorVersionConflictAllow)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_MAJOR_VERSION_CONFLICT", "true")
+	s.T().Setenv("GO_PRE_COMMIT_MAJOR_VERSION_CONFLICT_ALLOW", " github.com/example/sdk , gopkg.in/* ,")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.True(cfg.Checks.MajorVersionConflict)
+	s.Equal([]string{"github.com/example/sdk", "gopkg.in/*"}, cfg.CheckBehaviors.MajorVersionConflictAllow)
+
+	s.T().Setenv("GO_PRE_COMMIT_MAJOR_VERSION_CONFLICT_ALLOW", "github.com/[")
+	s.T().Setenv("GO_PRE_COMMIT_MAJOR_VERSION_CONFLICT_TIMEOUT", "0")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_MAJOR_VERSION_CONFLICT_TIMEOUT must be greater than 0")
+	s.Contains(err.Error(), "GO_PRE_COMMIT_MAJOR_VERSION_CONFLICT_ALLOW has an invalid pattern 'github.com/['")
+}
+
+// TestLoadFilenameCase tests the filename-case check settings
+func (s *ConfigTestSuite) TestLoadFilenameCase() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.FilenameCase, "opt-in")
+	s.Equal(10, cfg.CheckTimeouts.FilenameCase)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_FILENAME_CASE", "true")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.True(cfg.Checks.FilenameCase)
+
+	s.T().Setenv("GO_PRE_COMMIT_FILENAME_CASE_TIMEOUT", "0")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_FILENAME_CASE_TIMEOUT must be greater than 0")
+}
+
+// TestLoadBuildConstraints tests the build-constraints check settings
+func (s *ConfigTestSuite) TestLoadBuildConstraints() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.BuildConstraints, "opt-in")
+	s.Equal(30, cfg.CheckTimeouts.BuildConstraints)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_BUILD_CONSTRAINTS", "true")
+	s.T().Setenv("GO_PRE_COMMIT_BUILD_CONSTRAINTS_TIMEOUT", "0")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_BUILD_CONSTRAINTS_TIMEOUT must be greater than 0")
+}
+
+// TestLoadRepoFiles tests the repo-files check settings
+func (s *ConfigTestSuite) TestLoadRepoFiles() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.RepoFiles, "opt-in")
+	s.Equal(10, cfg.CheckTimeouts.RepoFiles)
+	s.Equal([]string{"LICENSE*", "README*"}, cfg.CheckBehaviors.RepoFilesRequired)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_REPO_FILES", "true")
+	s.T().Setenv("GO_PRE_COMMIT_REPO_FILES_REQUIRED", " LICENSE , README.md, SECURITY.md ,")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.True(cfg.Checks.RepoFiles)
+	s.Equal([]string{"LICENSE", "README.md", "SECURITY.md"}, cfg.CheckBehaviors.RepoFilesRequired)
+
+	s.T().Setenv("GO_PRE_COMMIT_REPO_FILES_REQUIRED", "LICENSE[")
+	s.T().Setenv("GO_PRE_COMMIT_REPO_FILES_TIMEOUT", "0")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_REPO_FILES_TIMEOUT must be greater than 0")
+	s.Contains(err.Error(), "GO_PRE_COMMIT_REPO_FILES_REQUIRED has an invalid pattern 'LICENSE['")
+}
+
+// TestLoadErrorVarNaming tests the error variable naming check settings
+func (s *ConfigTestSuite) TestLoadErrorVarNaming() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.ErrorVarNaming, "opt-in")
+	s.Equal(30, cfg.CheckTimeouts.ErrorVarNaming)
+	s.Equal(LintSeverityWarning, cfg.CheckBehaviors.ErrorVarNamingSeverity)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_ERROR_VAR_NAMING", "true")
+	s.T().Setenv("GO_PRE_COMMIT_ERROR_VAR_NAMING_SEVERITY", "Error")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal(LintSeverityError, cfg.CheckBehaviors.ErrorVarNamingSeverity)
+
+	s.T().Setenv("GO_PRE_COMMIT_ERROR_VAR_NAMING_SEVERITY", "fatal")
+	s.T().Setenv("GO_PRE_COMMIT_ERROR_VAR_NAMING_TIMEOUT", "0")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_ERROR_VAR_NAMING_TIMEOUT must be greater than 0")
+	s.Contains(err.Error(), "GO_PRE_COMMIT_ERROR_VAR_NAMING_SEVERITY must be warning or error")
+}
+
+// TestLoadHooks tests the pre-run and post-run command lists
+func (s *ConfigTestSuite) TestLoadHooks() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Empty(cfg.Hooks.PreRun)
+	s.Empty(cfg.Hooks.PostRun)
+
+	s.T().Setenv("GO_PRE_COMMIT_PRE_RUN", "make warm-cache; ./scripts/check-env.sh --strict ;")
+	s.T().Setenv("GO_PRE_COMMIT_POST_RUN", "./scripts/upload-logs.sh")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal([]string{"make warm-cache", "./scripts/check-env.sh --strict"}, cfg.Hooks.PreRun)
+	s.Equal([]string{"./scripts/upload-logs.sh"}, cfg.Hooks.PostRun)
+}
+
+// TestLoadForbiddenImports tests the forbidden-imports check settings
+func (s *ConfigTestSuite) TestLoadForbiddenImports() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.ForbiddenImports, "opt-in")
+	s.Equal([]string{"io/ioutil"}, cfg.CheckBehaviors.ForbiddenImports)
+	s.Empty(cfg.CheckBehaviors.ForbiddenImportsAllow)
+	s.Equal(30, cfg.CheckTimeouts.ForbiddenImports)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_FORBIDDEN_IMPORTS", "true")
+	s.T().Setenv("GO_PRE_COMMIT_FORBIDDEN_IMPORTS", "io/ioutil, github.com/example/legacy/...,")
+	s.T().Setenv("GO_PRE_COMMIT_FORBIDDEN_IMPORTS_ALLOW", "internal/compat/*.go")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal([]string{"io/ioutil", "github.com/example/legacy/..."}, cfg.CheckBehaviors.ForbiddenImports)
+	s.Equal([]string{"internal/compat/*.go"}, cfg.CheckBehaviors.ForbiddenImportsAllow)
+
+	s.T().Setenv("GO_PRE_COMMIT_FORBIDDEN_IMPORTS", "github.com/[")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_FORBIDDEN_IMPORTS has an invalid pattern")
+}
+
+// TestLoadTempDir tests the scratch workspace directory
+func (s *ConfigTestSuite) TestLoadTempDir() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Empty(cfg.Performance.TempDir, "the system temp directory by default")
+
+	dir := s.T().TempDir()
+	s.T().Setenv("GO_PRE_COMMIT_TEMP_DIR", dir)
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal(dir, cfg.Performance.TempDir)
+
+	s.T().Setenv("GO_PRE_COMMIT_TEMP_DIR", filepath.Join(dir, "missing"))
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_TEMP_DIR")
+}
+
+// TestLoadStructTags tests the struct-tags check settings
+func (s *ConfigTestSuite) TestLoadStructTags() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.StructTags, "opt-in")
+	s.Empty(cfg.CheckBehaviors.StructTagKeys)
+	s.Equal(30, cfg.CheckTimeouts.StructTags)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_STRUCT_TAGS", "true")
+	s.T().Setenv("GO_PRE_COMMIT_STRUCT_TAG_KEYS", "json, yaml,db,")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal([]string{"json", "yaml", "db"}, cfg.CheckBehaviors.StructTagKeys)
+
+	s.T().Setenv("GO_PRE_COMMIT_STRUCT_TAG_KEYS", "json:")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_STRUCT_TAG_KEYS has an invalid key 'json:'")
+}
+
+// TestLoadDocComments tests doc-comments configuration loading
+func (s *ConfigTestSuite) TestLoadDocComments() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.DocComments, "opt-in")
+	s.True(cfg.CheckBehaviors.DocCommentsSkipMain)
+	s.Equal(30, cfg.CheckTimeouts.DocComments)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_DOC_COMMENTS", "true")
+	s.T().Setenv("GO_PRE_COMMIT_DOC_COMMENTS_SKIP_MAIN", "false")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.True(cfg.Checks.DocComments)
+	s.False(cfg.CheckBehaviors.DocCommentsSkipMain)
+
+	s.T().Setenv("GO_PRE_COMMIT_DOC_COMMENTS_TIMEOUT", "0")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_DOC_COMMENTS_TIMEOUT must be greater than 0")
+}
+
+// TestLoadReceiverNames tests receiver-names configuration loading
+func (s *ConfigTestSuite) TestLoadReceiverNames() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.ReceiverNames, "opt-in")
+	s.Equal(2, cfg.CheckBehaviors.ReceiverNameMaxLength)
+	s.Equal(30, cfg.CheckTimeouts.ReceiverNames)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_RECEIVER_NAMES", "true")
+	s.T().Setenv("GO_PRE_COMMIT_RECEIVER_NAME_MAX_LENGTH", "0")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.True(cfg.Checks.ReceiverNames)
+	s.Equal(0, cfg.CheckBehaviors.ReceiverNameMaxLength)
+
+	s.T().Setenv("GO_PRE_COMMIT_RECEIVER_NAME_MAX_LENGTH", "-1")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_RECEIVER_NAME_MAX_LENGTH cannot be negative")
+
+	s.T().Setenv("GO_PRE_COMMIT_RECEIVER_NAME_MAX_LENGTH", "2")
+	s.T().Setenv("GO_PRE_COMMIT_RECEIVER_NAMES_TIMEOUT", "0")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_RECEIVER_NAMES_TIMEOUT must be greater than 0")
+}
+
+// TestLoadFilePermissions tests the file-permissions settings
+func (s *ConfigTestSuite) TestLoadFilePermissions() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.FilePermissions, "opt-in")
+	s.Equal([]string{"100644", "100755"}, cfg.CheckBehaviors.FilePermissionModes)
+	s.False(cfg.CheckBehaviors.FilePermissionsAutoFix)
+	s.Equal(10, cfg.CheckTimeouts.FilePermissions)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_FILE_PERMISSIONS", "true")
+	s.T().Setenv("GO_PRE_COMMIT_FILE_PERMISSION_MODES", " 644 ")
+	s.T().Setenv("GO_PRE_COMMIT_FILE_PERMISSIONS_AUTO_FIX", "true")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.True(cfg.Checks.FilePermissions)
+	s.Equal([]string{"100644"}, cfg.CheckBehaviors.FilePermissionModes)
+	s.True(cfg.CheckBehaviors.FilePermissionsAutoFix)
+
+	s.T().Setenv("GO_PRE_COMMIT_FILE_PERMISSION_MODES", "0644,0600")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_FILE_PERMISSION_MODES has '100600'")
+
+	s.T().Setenv("GO_PRE_COMMIT_FILE_PERMISSION_MODES", "100755")
+	s.T().Setenv("GO_PRE_COMMIT_FILE_PERMISSIONS_TIMEOUT", "0")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_FILE_PERMISSIONS_TIMEOUT must be greater than 0")
+}
+
+func (s *ConfigTestSuite) TestLoadEmbeddedBlobs() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.EmbeddedBlobs, "opt-in")
+	s.Equal(1024, cfg.CheckBehaviors.EmbeddedBlobMinLength)
+	s.Equal(30, cfg.CheckTimeouts.EmbeddedBlobs)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_EMBEDDED_BLOBS", "true")
+	s.T().Setenv("GO_PRE_COMMIT_EMBEDDED_BLOB_MIN_LENGTH", "4096")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.True(cfg.Checks.EmbeddedBlobs)
+	s.Equal(4096, cfg.CheckBehaviors.EmbeddedBlobMinLength)
+
+	s.T().Setenv("GO_PRE_COMMIT_EMBEDDED_BLOB_MIN_LENGTH", "0")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_EMBEDDED_BLOB_MIN_LENGTH must be greater than 0")
+}
+
+func (s *ConfigTestSuite) TestLoadDataFormat() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.DataFormat, "opt-in")
+	s.Equal(DataFormatIndent, cfg.CheckBehaviors.DataFormatStyle)
+	s.Equal(2, cfg.CheckBehaviors.DataFormatIndent)
+	s.Equal(30, cfg.CheckTimeouts.DataFormat)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_DATA_FORMAT", "true")
+	s.T().Setenv("GO_PRE_COMMIT_DATA_FORMAT_STYLE", "Canonical")
+	s.T().Setenv("GO_PRE_COMMIT_DATA_FORMAT_INDENT", "4")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.True(cfg.Checks.DataFormat)
+	s.Equal(DataFormatCanonical, cfg.CheckBehaviors.DataFormatStyle)
+	s.Equal(4, cfg.CheckBehaviors.DataFormatIndent)
+
+	s.T().Setenv("GO_PRE_COMMIT_DATA_FORMAT_STYLE", "sorted")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_DATA_FORMAT_STYLE must be none, indent, or canonical")
+}
+
+func (s *ConfigTestSuite) TestLoadGosec() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.Gosec, "opt-in")
+	s.Equal(GosecSeverityMedium, cfg.CheckBehaviors.GosecSeverity)
+	s.Equal(120, cfg.CheckTimeouts.Gosec)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_GOSEC", "true")
+	s.T().Setenv("GO_PRE_COMMIT_GOSEC_SEVERITY", "HIGH")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.True(cfg.Checks.Gosec)
+	s.Equal(GosecSeverityHigh, cfg.CheckBehaviors.GosecSeverity)
+
+	s.T().Setenv("GO_PRE_COMMIT_GOSEC_SEVERITY", "critical")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_GOSEC_SEVERITY must be low, medium, or high")
+}
+
+func (s *ConfigTestSuite) TestLoadShellcheck() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.Shellcheck, "opt-in")
+	s.Equal(ShellcheckSeverityStyle, cfg.CheckBehaviors.ShellcheckSeverity)
+	s.Equal(60, cfg.CheckTimeouts.Shellcheck)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_SHELLCHECK", "true")
+	s.T().Setenv("GO_PRE_COMMIT_SHELLCHECK_SEVERITY", "Warning")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.True(cfg.Checks.Shellcheck)
+	s.Equal(ShellcheckSeverityWarning, cfg.CheckBehaviors.ShellcheckSeverity)
+
+	s.T().Setenv("GO_PRE_COMMIT_SHELLCHECK_SEVERITY", "fatal")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_SHELLCHECK_SEVERITY must be error, warning, info, or style")
+}
+
+func (s *ConfigTestSuite) TestLoadHadolint() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.Hadolint, "opt-in")
+	s.Equal(ShellcheckSeverityInfo, cfg.CheckBehaviors.HadolintSeverity)
+	s.Equal(60, cfg.CheckTimeouts.Hadolint)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_HADOLINT", "true")
+	s.T().Setenv("GO_PRE_COMMIT_HADOLINT_SEVERITY", "ERROR")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.True(cfg.Checks.Hadolint)
+	s.Equal(ShellcheckSeverityError, cfg.CheckBehaviors.HadolintSeverity)
+
+	s.T().Setenv("GO_PRE_COMMIT_HADOLINT_SEVERITY", "ignore")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_HADOLINT_SEVERITY must be error, warning, info, or style")
+}
+
+func (s *ConfigTestSuite) TestLoadGoDirective() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Checks.GoDirective, "opt-in")
+	s.Equal(30, cfg.CheckTimeouts.GoDirective)
+
+	s.T().Setenv("GO_PRE_COMMIT_ENABLE_GO_DIRECTIVE", "true")
+	s.T().Setenv("GO_PRE_COMMIT_GO_DIRECTIVE_TIMEOUT", "0")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_GO_DIRECTIVE_TIMEOUT must be greater than 0")
+}
+
+func (s *ConfigTestSuite) TestLoadStubFuncs() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+GO_PRE_COMMIT_ENABLE_STUB_FUNCS=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.True(cfg.Checks.StubFuncs)
+	s.Equal(LintSeverityWarning, cfg.CheckBehaviors.StubFuncsSeverity)
+	s.Equal(30, cfg.CheckTimeouts.StubFuncs)
+
+	s.T().Setenv("GO_PRE_COMMIT_STUB_FUNCS_SEVERITY", "Error")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal(LintSeverityError, cfg.CheckBehaviors.StubFuncsSeverity)
+
+	s.T().Setenv("GO_PRE_COMMIT_STUB_FUNCS_SEVERITY", "info")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_STUB_FUNCS_SEVERITY must be warning or error")
+}
+
+// TestLoadCommitSize tests the commit-size check settings
+func (s *ConfigTestSuite) TestLoadCommitSize() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+GO_PRE_COMMIT_ENABLE_COMMIT_SIZE=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.True(cfg.Checks.CommitSize)
+	s.Equal(50, cfg.CheckBehaviors.MaxStagedFiles)
+	s.Equal(LintSeverityWarning, cfg.CheckBehaviors.CommitSizeSeverity)
+	s.Equal([]string{CommitSizeExemptGenerated}, cfg.CheckBehaviors.CommitSizeExempt)
+	s.Equal(10, cfg.CheckTimeouts.CommitSize)
+
+	s.T().Setenv("GO_PRE_COMMIT_MAX_STAGED_FILES", "20")
+	s.T().Setenv("GO_PRE_COMMIT_COMMIT_SIZE_SEVERITY", "Error")
+	s.T().Setenv("GO_PRE_COMMIT_COMMIT_SIZE_EXEMPT", "Generated, vendored,testdata")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal(20, cfg.CheckBehaviors.MaxStagedFiles)
+	s.Equal(LintSeverityError, cfg.CheckBehaviors.CommitSizeSeverity)
+	s.Equal([]string{CommitSizeExemptGenerated, CommitSizeExemptVendored, CommitSizeExemptTestdata}, cfg.CheckBehaviors.CommitSizeExempt)
+
+	s.T().Setenv("GO_PRE_COMMIT_COMMIT_SIZE_EXEMPT", "docs")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_COMMIT_SIZE_EXEMPT must list")
+
+	s.T().Setenv("GO_PRE_COMMIT_COMMIT_SIZE_EXEMPT", CommitSizeExemptNone)
+	s.T().Setenv("GO_PRE_COMMIT_MAX_STAGED_FILES", "0")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_MAX_STAGED_FILES must be greater than 0")
+}
+
+// TestLoadToolPins tests GO_PRE_COMMIT_TOOLS_PIN parsing and validation
+func (s *ConfigTestSuite) TestLoadToolPins() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Nil(cfg.ToolVersions.Pin)
+
+	s.T().Setenv("GO_PRE_COMMIT_TOOLS_PIN", "golangci-lint=v2.4.0, go = go1.25 ,gofumpt=0.8,")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal(map[string]string{"golangci-lint": "v2.4.0", "go": "go1.25", "gofumpt": "0.8"}, cfg.ToolVersions.Pin)
+
+	s.T().Setenv("GO_PRE_COMMIT_TOOLS_PIN", "goimports=v0.1.0")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), `GO_PRE_COMMIT_TOOLS_PIN has unknown tool "goimports"`)
+
+	s.T().Setenv("GO_PRE_COMMIT_TOOLS_PIN", "gitleaks=latest")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), `GO_PRE_COMMIT_TOOLS_PIN for "gitleaks" must be a version`)
+}
+
+// TestLoadExitCodes tests the configurable exit codes for "run"
+func (s *ConfigTestSuite) TestLoadExitCodes() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Equal(1, cfg.ExitCodes.Failure)
+	s.Equal(2, cfg.ExitCodes.Fixed)
+	s.Equal(3, cfg.ExitCodes.Setup)
+	s.Equal(0, cfg.ExitCodes.NoFiles)
+	s.False(cfg.UI.EmptyCommitNotice)
+
+	s.T().Setenv("GO_PRE_COMMIT_EXIT_CODE_FIXED", "1")
+	s.T().Setenv("GO_PRE_COMMIT_EXIT_CODE_SETUP", "78")
+	s.T().Setenv("GO_PRE_COMMIT_EXIT_CODE_NO_FILES", "7")
+	s.T().Setenv("GO_PRE_COMMIT_EMPTY_COMMIT_NOTICE", "true")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal(1, cfg.ExitCodes.Fixed, "fixes can be treated as plain failures")
+	s.Equal(78, cfg.ExitCodes.Setup)
+	s.Equal(7, cfg.ExitCodes.NoFiles)
+	s.True(cfg.UI.EmptyCommitNotice)
+
+	for _, invalid := range []string{"-1", "126"} {
+		s.T().Setenv("GO_PRE_COMMIT_EXIT_CODE_FAILURE", invalid)
+		_, err = Load()
+		s.Require().Error(err)
+		s.Contains(err.Error(), "GO_PRE_COMMIT_EXIT_CODE_FAILURE")
+	}
+}
+
+// TestLoadGitHubReview tests the pull request review reporter setting
+func (s *ConfigTestSuite) TestLoadGitHubReview() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Reporting.GitHubReview, "the reporter is opt-in")
+
+	s.T().Setenv("GO_PRE_COMMIT_GITHUB_REVIEW", "true")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.True(cfg.Reporting.GitHubReview)
+}
+
+// TestLoadModulePathSettings tests the go.mod module path check settings
+func (s *ConfigTestSuite) TestLoadModulePathSettings() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+GO_PRE_COMMIT_ENABLE_MODULE_PATH=true
+GO_PRE_COMMIT_MODULE_PATH_PREFIX=github.com/acme/
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.True(cfg.Checks.ModulePath)
+	s.Equal("github.com/acme", cfg.CheckBehaviors.ModulePathPrefix)
+	s.Equal(30, cfg.CheckTimeouts.ModulePath)
+
+	s.T().Setenv("GO_PRE_COMMIT_MODULE_PATH_PREFIX", "GitHub.com/acme")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_MODULE_PATH_PREFIX must be lowercase")
+}
+
+// TestLoadResolveFormatterConflicts tests the golangci-lint formatter overlap setting
+func (s *ConfigTestSuite) TestLoadResolveFormatterConflicts() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.CheckBehaviors.ResolveFormatterConflicts, "overlap only warns by default")
+
+	s.T().Setenv("GO_PRE_COMMIT_RESOLVE_FORMATTER_CONFLICTS", "true")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.True(cfg.CheckBehaviors.ResolveFormatterConflicts)
+}
+
+// TestLoadReRunValidatorsAfterFix tests the fixers-before-validators setting
+func (s *ConfigTestSuite) TestLoadReRunValidatorsAfterFix() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.True(cfg.CheckBehaviors.ReRunValidatorsAfterFix, "validators wait for fixers by default")
+
+	s.T().Setenv("GO_PRE_COMMIT_RERUN_VALIDATORS_AFTER_FIX", "false")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.False(cfg.CheckBehaviors.ReRunValidatorsAfterFix)
+}
+
+// TestLoadGoWorkspace tests the go.work workspace mode setting
+func (s *ConfigTestSuite) TestLoadGoWorkspace() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.CheckBehaviors.GoWorkspace)
+
+	s.T().Setenv("GO_PRE_COMMIT_GO_WORKSPACE", "true")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.True(cfg.CheckBehaviors.GoWorkspace)
+}
+
+// TestLoadContentSniffExtensions tests that extensions are normalized to
+// lowercase with a leading dot
+func (s *ConfigTestSuite) TestLoadContentSniffExtensions() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+GO_PRE_COMMIT_CONTENT_SNIFF_EXTENSIONS=".txt, CSV,,.Proto"
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Equal([]string{".txt", ".csv", ".proto"}, cfg.Git.ContentSniffExtensions)
+}
+
+// TestLoadToolchainSettings tests the go.mod toolchain policy check settings
+func (s *ConfigTestSuite) TestLoadToolchainSettings() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+GO_PRE_COMMIT_ENABLE_TOOLCHAIN=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.True(cfg.Checks.Toolchain)
+	s.Equal(ToolchainPolicyForbid, cfg.CheckBehaviors.ToolchainPolicy)
+	s.Empty(cfg.CheckBehaviors.ToolchainVersion)
+	s.Equal(30, cfg.CheckTimeouts.Toolchain)
+
+	s.T().Setenv("GO_PRE_COMMIT_TOOLCHAIN_POLICY", "Require")
+	cfg, err = Load()
+	s.Require().Error(err, "require needs a version")
+	s.Nil(cfg)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_TOOLCHAIN_VERSION")
+
+	s.T().Setenv("GO_PRE_COMMIT_TOOLCHAIN_VERSION", "go1.22.5")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal(ToolchainPolicyRequire, cfg.CheckBehaviors.ToolchainPolicy)
+	s.Equal("go1.22.5", cfg.CheckBehaviors.ToolchainVersion)
+
+	s.T().Setenv("GO_PRE_COMMIT_TOOLCHAIN_POLICY", "pin")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_TOOLCHAIN_POLICY")
+}
+
+// TestLoadLargeDiffsSettings tests the large diff check settings
+func (s *ConfigTestSuite) TestLoadLargeDiffsSettings() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+GO_PRE_COMMIT_ENABLE_LARGE_DIFFS=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.True(cfg.Checks.LargeDiffs)
+	s.Equal(1000, cfg.CheckBehaviors.MaxDiffLines)
+	s.Equal(LintSeverityWarning, cfg.CheckBehaviors.LargeDiffsSeverity)
+	s.Equal(10, cfg.CheckTimeouts.LargeDiffs)
+
+	s.T().Setenv("GO_PRE_COMMIT_MAX_DIFF_LINES", "250")
+	s.T().Setenv("GO_PRE_COMMIT_LARGE_DIFFS_SEVERITY", "Error")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal(250, cfg.CheckBehaviors.MaxDiffLines)
+	s.Equal(LintSeverityError, cfg.CheckBehaviors.LargeDiffsSeverity)
+
+	s.T().Setenv("GO_PRE_COMMIT_MAX_DIFF_LINES", "0")
+	cfg, err = Load()
+	s.Require().Error(err)
+	s.Nil(cfg)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_MAX_DIFF_LINES")
+
+	s.T().Setenv("GO_PRE_COMMIT_MAX_DIFF_LINES", "250")
+	s.T().Setenv("GO_PRE_COMMIT_LARGE_DIFFS_SEVERITY", "info")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_LARGE_DIFFS_SEVERITY")
+}
+
+// TestLoadGoGenerateSettings tests the go generate check settings
+func (s *ConfigTestSuite) TestLoadGoGenerateSettings() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+GO_PRE_COMMIT_ENABLE_GO_GENERATE=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.True(cfg.Checks.GoGenerate)
+	s.Equal(120, cfg.CheckTimeouts.GoGenerate)
+
+	s.T().Setenv("GO_PRE_COMMIT_GO_GENERATE_TIMEOUT", "0")
+	cfg, err = Load()
+	s.Require().Error(err)
+	s.Nil(cfg)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_GO_GENERATE_TIMEOUT")
+}
+
+// TestLoadLoadAware tests the load-aware parallelism toggle
+func (s *ConfigTestSuite) TestLoadLoadAware() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Performance.LoadAware, "load awareness is off by default")
+
+	s.T().Setenv("GO_PRE_COMMIT_LOAD_AWARE", "true")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.True(cfg.Performance.LoadAware)
+}
+
+// TestLoadProfiles tests the built-in check profiles and their env overrides
+func (s *ConfigTestSuite) TestLoadProfiles() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Equal([]string{"whitespace", "eof"}, cfg.Profiles[ProfileFast])
+	s.Equal([]string{"whitespace", "eof", "fumpt", "lint", "mod-tidy"}, cfg.Profiles[ProfileFull])
+
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+GO_PRE_COMMIT_PROFILE_FAST=whitespace, eof, go-indent
+GO_PRE_COMMIT_PROFILE_PRE_PUSH=lint,mod-tidy # slower checks
+`)
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal([]string{"whitespace", "eof", "go-indent"}, cfg.Profiles[ProfileFast])
+	s.Equal([]string{"lint", "mod-tidy"}, cfg.Profiles["pre-push"])
+	s.Equal([]string{"whitespace", "eof", "fumpt", "lint", "mod-tidy"}, cfg.Profiles[ProfileFull], "unset profiles keep their defaults")
+
+	s.T().Setenv("GO_PRE_COMMIT_PROFILE_PRE_PUSH", " , ")
+	cfg, err = Load()
+	s.Require().Error(err)
+	s.Nil(cfg)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_PROFILE_PRE_PUSH")
+}
+
+// TestLoadExternalChecks tests parsing and validation of external checks
+func (s *ConfigTestSuite) TestLoadExternalChecks() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Empty(cfg.ExternalChecks)
+
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+GO_PRE_COMMIT_EXTERNAL_CHECK_LICENSE_HEADERS=./scripts/license-headers.sh --json # checks headers
+`)
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal(map[string]string{"license-headers": "./scripts/license-headers.sh --json"}, cfg.ExternalChecks)
+
+	s.T().Setenv("GO_PRE_COMMIT_EXTERNAL_CHECK_LICENSE_HEADERS", " ")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Empty(cfg.ExternalChecks, "an empty command defines no check")
+
+	s.T().Setenv("GO_PRE_COMMIT_EXTERNAL_CHECK_LINT", "./scripts/lint.sh")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "cannot replace the built-in lint check")
+}
+
+// TestLoadRequiredChecks tests the checks a run must execute
+func (s *ConfigTestSuite) TestLoadRequiredChecks() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+GO_PRE_COMMIT_EXTERNAL_CHECK_LICENSE_HEADERS=./scripts/license-headers.sh
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Empty(cfg.RequiredChecks)
+
+	s.T().Setenv("GO_PRE_COMMIT_REQUIRED_CHECKS", " lint,, gitleaks , license-headers")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal([]string{"lint", "gitleaks", "license-headers"}, cfg.RequiredChecks, "built-in and external checks")
+
+	s.T().Setenv("GO_PRE_COMMIT_REQUIRED_CHECKS", "lint,lnit")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_REQUIRED_CHECKS names an unknown check 'lnit'")
+}
+
+// TestLoadOutputTheme tests the color theme setting
+func (s *ConfigTestSuite) TestLoadOutputTheme() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Equal("default", cfg.UI.Theme)
+
+	s.T().Setenv("GO_PRE_COMMIT_OUTPUT_THEME", "High-Contrast")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal("high-contrast", cfg.UI.Theme)
+
+	s.T().Setenv("GO_PRE_COMMIT_OUTPUT_THEME", "solarized")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_OUTPUT_THEME must be one of default, high-contrast, monochrome (got: 'solarized')")
+}
+
+// TestLoadProgressBar tests the opt-in progress bar setting
+func (s *ConfigTestSuite) TestLoadProgressBar() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.UI.ProgressBar)
+
+	s.T().Setenv("GO_PRE_COMMIT_PROGRESS_BAR", "true")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.True(cfg.UI.ProgressBar)
+}
+
+// TestLoadDiffLimits tests the diff context and per-file line limits
+func (s *ConfigTestSuite) TestLoadDiffLimits() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Equal(3, cfg.UI.DiffContextLines)
+	s.Equal(100, cfg.UI.DiffMaxLinesShown)
+
+	s.T().Setenv("GO_PRE_COMMIT_DIFF_CONTEXT_LINES", "-1")
+	s.T().Setenv("GO_PRE_COMMIT_DIFF_MAX_LINES_SHOWN", "0")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal(-1, cfg.UI.DiffContextLines, "keeps all context")
+	s.Zero(cfg.UI.DiffMaxLinesShown, "no limit")
+
+	s.T().Setenv("GO_PRE_COMMIT_DIFF_CONTEXT_LINES", "-2")
+	s.T().Setenv("GO_PRE_COMMIT_DIFF_MAX_LINES_SHOWN", "-1")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_DIFF_CONTEXT_LINES must be -1 (keep all) or greater")
+	s.Contains(err.Error(), "GO_PRE_COMMIT_DIFF_MAX_LINES_SHOWN must be 0 (no limit) or positive")
+}
+
+// TestLoadRedactDiffSecrets tests whether diffs hide lines holding secrets
+func (s *ConfigTestSuite) TestLoadRedactDiffSecrets() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.True(cfg.UI.RedactDiffSecrets, "on by default")
+
+	s.T().Setenv("GO_PRE_COMMIT_REDACT_DIFF_SECRETS", "false")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.False(cfg.UI.RedactDiffSecrets)
+}
+
+// TestLoadDotfileSettings tests parsing of the dotfile skip option and its includes
+func (s *ConfigTestSuite) TestLoadDotfileSettings() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.False(cfg.Git.SkipDotfiles, "dotfiles are checked by default")
+	s.Empty(cfg.Git.DotfileIncludes)
+
+	s.T().Setenv("GO_PRE_COMMIT_SKIP_DOTFILES", "true")
+	s.T().Setenv("GO_PRE_COMMIT_DOTFILE_INCLUDES", " .github/ , .golangci.yml,,")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.True(cfg.Git.SkipDotfiles)
+	s.Equal([]string{".github/", ".golangci.yml"}, cfg.Git.DotfileIncludes)
+}
+
+// TestLoadExcludeDirs tests parsing and validation of the excluded directories
+func (s *ConfigTestSuite) TestLoadExcludeDirs() {
+	s.createEnvFile(`ENABLE_GO_PRE_COMMIT=true
+`)
+	cfg, err := Load()
+	s.Require().NoError(err)
+	s.Empty(cfg.Git.ExcludeDirs)
+
+	s.T().Setenv("GO_PRE_COMMIT_EXCLUDE_DIRS", " third_party/ , ./web/dist,,")
+	cfg, err = Load()
+	s.Require().NoError(err)
+	s.Equal([]string{"third_party", "web/dist"}, cfg.Git.ExcludeDirs)
+
+	s.T().Setenv("GO_PRE_COMMIT_EXCLUDE_DIRS", "../shared")
+	_, err = Load()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "GO_PRE_COMMIT_EXCLUDE_DIRS entry '../shared' must be a directory inside the repository")
+}
+
 // TestLoadMissingEnvFile tests behavior when .env.base file is not found
 func (s *ConfigTestSuite) TestLoadMissingEnvFile() {
 	// Don't create .env.base file
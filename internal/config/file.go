@@ -0,0 +1,331 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// ErrUnsupportedConfigFormat is returned for config files whose extension has no decoder
+	ErrUnsupportedConfigFormat = errors.New("unsupported config file format")
+
+	// ErrUnknownConfigKey is returned for keys FileConfig has no field for
+	ErrUnknownConfigKey = errors.New("unknown config key")
+
+	// ErrUnknownConfigCheck is returned for check names the config file cannot set
+	ErrUnknownConfigCheck = errors.New("unknown check")
+
+	// ErrInvalidExternalCheckName is returned for external check names that
+	// are not lowercase letters, digits, and dashes
+	ErrInvalidExternalCheckName = errors.New("invalid external check name")
+
+	// ErrConfigOverlayNotFound is returned when GO_PRE_COMMIT_ENV names an
+	// environment that has no overlay file
+	ErrConfigOverlayNotFound = errors.New("config overlay not found")
+)
+
+// OverlayEnv names the environment whose overlay file, such as
+// .go-pre-commit.ci.yml for "ci", is merged over the config file; the --env
+// flag sets it
+const OverlayEnv = "GO_PRE_COMMIT_ENV"
+
+// configFileNames are the config files Load looks for in each directory it
+// searches, in the order they are tried; the first one found is used
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var configFileNames = []string{
+	DefaultConfigFile,
+	".go-pre-commit.yaml",
+	".go-pre-commit.toml",
+	".go-pre-commit.json",
+}
+
+// externalCheckNamePattern matches the external check names the config file
+// accepts
+var externalCheckNamePattern = regexp.MustCompile(`^[a-z0-9-]+$`) //nolint:gochecknoglobals // Compiled once
+
+// ReadConfigFile decodes a config file with the decoder its extension calls
+// for: YAML for .yml and .yaml, TOML for .toml, and JSON for .json. Keys
+// FileConfig does not know and unknown check names are errors, so a typo
+// does not silently leave a setting at its default.
+func ReadConfigFile(path string) (*FileConfig, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // Path found by the config file search
+	if err != nil {
+		return nil, err
+	}
+
+	var file FileConfig
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yml", ".yaml":
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err = decoder.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+	case ".toml":
+		metadata, decodeErr := toml.Decode(string(data), &file)
+		if decodeErr != nil {
+			return nil, decodeErr
+		}
+		for _, key := range metadata.Undecoded() {
+			// UnmarshalTOML rejects unknown keys of the checks section itself
+			if key[0] == "checks" {
+				continue
+			}
+			return nil, fmt.Errorf("%w %q", ErrUnknownConfigKey, key.String())
+		}
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err = decoder.Decode(&file); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnsupportedConfigFormat, ext)
+	}
+
+	for name := range file.Checks.Builtin {
+		if _, known := checkEnvStem(name); !known {
+			return nil, fmt.Errorf("%w %q in checks", ErrUnknownConfigCheck, name)
+		}
+	}
+	for _, external := range file.Checks.External {
+		if !validExternalCheckName(external.Name) {
+			return nil, fmt.Errorf("%w %q in checks.external: use lowercase letters, digits, and dashes", ErrInvalidExternalCheckName, external.Name)
+		}
+	}
+	return &file, nil
+}
+
+// validExternalCheckName reports whether name survives the trip through its
+// GO_PRE_COMMIT_EXTERNAL_CHECK_<NAME> variable unchanged: lowercase letters,
+// digits, and dashes only
+func validExternalCheckName(name string) bool {
+	return externalCheckNamePattern.MatchString(name)
+}
+
+// UnmarshalJSON decodes the checks section: "external" lists the external
+// checks, and every other key names a built-in check. Unknown settings are
+// errors, as they are in the rest of the file.
+func (c *ChecksFileConfig) UnmarshalJSON(data []byte) error {
+	var sections map[string]json.RawMessage
+	if err := json.Unmarshal(data, &sections); err != nil {
+		return err
+	}
+
+	*c = ChecksFileConfig{}
+	for _, name := range slices.Sorted(maps.Keys(sections)) {
+		if name == externalChecksKey {
+			if err := decodeStrictJSON(sections[name], &c.External); err != nil {
+				return fmt.Errorf("checks.%s: %w", name, err)
+			}
+			continue
+		}
+		var check *CheckFileConfig
+		if err := decodeStrictJSON(sections[name], &check); err != nil {
+			return fmt.Errorf("checks.%s: %w", name, err)
+		}
+		if c.Builtin == nil {
+			c.Builtin = make(map[string]*CheckFileConfig)
+		}
+		c.Builtin[name] = check
+	}
+	return nil
+}
+
+// MarshalJSON encodes the checks section the way UnmarshalJSON reads it
+func (c ChecksFileConfig) MarshalJSON() ([]byte, error) {
+	sections := make(map[string]any, len(c.Builtin)+1)
+	for name, check := range c.Builtin {
+		sections[name] = check
+	}
+	if len(c.External) > 0 {
+		sections[externalChecksKey] = c.External
+	}
+	return json.Marshal(sections)
+}
+
+// UnmarshalTOML decodes the checks section, which the TOML decoder hands
+// over as tables, through UnmarshalJSON
+func (c *ChecksFileConfig) UnmarshalTOML(data any) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return c.UnmarshalJSON(encoded)
+}
+
+// decodeStrictJSON decodes data into v, rejecting keys v has no field for
+func decodeStrictJSON(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
+
+// Env returns the settings of the file as the environment variables they
+// stand for, the inverse of MigrateEnv
+func (f *FileConfig) Env() map[string]string {
+	vars := make(map[string]string)
+	if f.Enabled != nil {
+		vars["ENABLE_GO_PRE_COMMIT"] = strconv.FormatBool(*f.Enabled)
+	}
+	if f.Timeout != nil {
+		vars["GO_PRE_COMMIT_TIMEOUT_SECONDS"] = strconv.Itoa(*f.Timeout)
+	}
+	if f.ExcludePatterns != nil {
+		vars["GO_PRE_COMMIT_EXCLUDE_PATTERNS"] = strings.Join(f.ExcludePatterns, ",")
+	}
+	if f.RequiredChecks != nil {
+		vars["GO_PRE_COMMIT_REQUIRED_CHECKS"] = strings.Join(f.RequiredChecks, ",")
+	}
+	for _, external := range f.Checks.External {
+		if validExternalCheckName(external.Name) {
+			vars[externalCheckEnvPrefix+strings.ToUpper(strings.ReplaceAll(external.Name, "-", "_"))] = external.Command
+		}
+	}
+	for name, check := range f.Checks.Builtin {
+		stem, known := checkEnvStem(name)
+		if !known || check == nil {
+			continue
+		}
+		if check.Enabled != nil {
+			vars["GO_PRE_COMMIT_ENABLE_"+stem] = strconv.FormatBool(*check.Enabled)
+		}
+		if check.Timeout != nil {
+			vars["GO_PRE_COMMIT_"+stem+"_TIMEOUT"] = strconv.Itoa(*check.Timeout)
+		}
+	}
+	return vars
+}
+
+// checkEnvStem returns the part of a check's environment variable names
+// that names it, e.g. MOD_TIDY for mod-tidy
+func checkEnvStem(name string) (string, bool) {
+	for stem, checkName := range migratableChecks {
+		if checkName == name {
+			return stem, true
+		}
+	}
+	return "", false
+}
+
+// findConfigFile locates the config file, walking up the directory tree the
+// same way findEnvDir does. It returns "" when there is none.
+func findConfigFile() string {
+	return findFileNamed(configFileNames)
+}
+
+// findOverlayFile locates the overlay file of environment env: next to
+// configFile when there is one, and searched for like the config file
+// otherwise. It returns "" when env is empty.
+func findOverlayFile(env, configFile string) (string, error) {
+	if env == "" {
+		return "", nil
+	}
+	if env != filepath.Base(env) || env == "." || env == ".." {
+		return "", fmt.Errorf("%w: %s must be a plain name (got: '%s')", ErrConfigOverlayNotFound, OverlayEnv, env)
+	}
+
+	names := make([]string, 0, len(configFileNames))
+	for _, name := range configFileNames {
+		ext := filepath.Ext(name)
+		names = append(names, strings.TrimSuffix(name, ext)+"."+env+ext)
+	}
+
+	var path string
+	if configFile != "" {
+		path = fileNamedIn(filepath.Dir(configFile), names)
+	} else {
+		path = findFileNamed(names)
+	}
+	if path == "" {
+		return "", fmt.Errorf("%w: no %s for environment %q", ErrConfigOverlayNotFound, strings.Join(names, ", "), env)
+	}
+	return path, nil
+}
+
+// findFileNamed walks up the directory tree the same way findEnvDir does and
+// returns the first of names found, or ""
+func findFileNamed(names []string) string {
+	if testConfigDir := os.Getenv("GO_PRE_COMMIT_TEST_CONFIG_DIR"); testConfigDir != "" {
+		return fileNamedIn(testConfigDir, names)
+	}
+
+	dir, err := envSearchDir()
+	if err != nil {
+		return ""
+	}
+	for {
+		if path := fileNamedIn(dir, names); path != "" {
+			return path
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// fileNamedIn returns the first of names present in dir, or ""
+func fileNamedIn(dir string, names []string) string {
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() { // #nosec G703 - path built from the search directory
+			return path
+		}
+	}
+	return ""
+}
+
+// applyConfigFiles applies the overlay file of the environment OverlayEnv
+// names, if any, and then the config file, so the overlay overrides the
+// config file and the environment still overrides both
+func applyConfigFiles(configFile string) error {
+	overlayFile, err := findOverlayFile(os.Getenv(OverlayEnv), configFile)
+	if err != nil {
+		return err
+	}
+	for _, path := range []string{overlayFile, configFile} {
+		if path == "" {
+			continue
+		}
+		if err = applyConfigFile(path); err != nil {
+			return fmt.Errorf("failed to load %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// applyConfigFile sets the environment variables the config file at path
+// stands for, leaving any the env files or the process already set alone:
+// the environment overrides the config file, and the config file overrides
+// the built-in defaults
+func applyConfigFile(path string) error {
+	file, err := ReadConfigFile(path)
+	if err != nil {
+		return err
+	}
+	for key, value := range file.Env() {
+		if os.Getenv(key) != "" {
+			continue
+		}
+		if err := os.Setenv(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,252 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// equivalentConfigFiles holds the same settings in every supported format
+//
+//nolint:gochecknoglobals // Test fixtures
+var equivalentConfigFiles = map[string]string{
+	".go-pre-commit.yml": `enabled: true
+timeout: 300
+exclude_patterns: [vendor/, testdata/]
+required_checks: [gitleaks, license-headers]
+checks:
+  lint:
+    enabled: false
+    timeout: 90
+  gitleaks:
+    enabled: true
+  mod-tidy:
+    timeout: 45
+  external:
+    - name: license-headers
+      command: ./scripts/license-headers.sh
+`,
+	".go-pre-commit.yaml": `enabled: true
+timeout: 300
+exclude_patterns:
+  - vendor/
+  - testdata/
+required_checks:
+  - gitleaks
+  - license-headers
+checks:
+  gitleaks: {enabled: true}
+  lint: {enabled: false, timeout: 90}
+  mod-tidy: {timeout: 45}
+  external:
+    - {name: license-headers, command: ./scripts/license-headers.sh}
+`,
+	".go-pre-commit.toml": `enabled = true
+timeout = 300
+exclude_patterns = ["vendor/", "testdata/"]
+required_checks = ["gitleaks", "license-headers"]
+
+[checks.lint]
+enabled = false
+timeout = 90
+
+[checks.gitleaks]
+enabled = true
+
+[checks.mod-tidy]
+timeout = 45
+
+[[checks.external]]
+name = "license-headers"
+command = "./scripts/license-headers.sh"
+`,
+	".go-pre-commit.json": `{
+  "enabled": true,
+  "timeout": 300,
+  "exclude_patterns": ["vendor/", "testdata/"],
+  "required_checks": ["gitleaks", "license-headers"],
+  "checks": {
+    "lint": {"enabled": false, "timeout": 90},
+    "gitleaks": {"enabled": true},
+    "mod-tidy": {"timeout": 45},
+    "external": [{"name": "license-headers", "command": "./scripts/license-headers.sh"}]
+  }
+}
+`,
+}
+
+// configFileEnv lists the variables the fixtures set, so each test starts
+// without them and has them restored afterwards
+//
+//nolint:gochecknoglobals // Test fixtures
+var configFileEnv = []string{
+	"ENABLE_GO_PRE_COMMIT",
+	"GO_PRE_COMMIT_TIMEOUT_SECONDS",
+	"GO_PRE_COMMIT_EXCLUDE_PATTERNS",
+	"GO_PRE_COMMIT_ENABLE_LINT",
+	"GO_PRE_COMMIT_LINT_TIMEOUT",
+	"GO_PRE_COMMIT_ENABLE_GITLEAKS",
+	"GO_PRE_COMMIT_MOD_TIDY_TIMEOUT",
+	"GO_PRE_COMMIT_EXTERNAL_CHECK_LICENSE_HEADERS",
+	"GO_PRE_COMMIT_REQUIRED_CHECKS",
+}
+
+// configFileDir returns a directory Load searches alone, holding the given
+// files and no env files
+func configFileDir(t *testing.T, files map[string]string) string {
+	t.Helper()
+	for _, key := range configFileEnv {
+		t.Setenv(key, "")
+	}
+
+	dir := t.TempDir()
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+	t.Setenv("GO_PRE_COMMIT_TEST_CONFIG_DIR", dir)
+	return dir
+}
+
+func TestLoad_ConfigFileFormats(t *testing.T) {
+	var loaded []*Config
+	for name, content := range equivalentConfigFiles {
+		t.Run(name, func(t *testing.T) {
+			configFileDir(t, map[string]string{name: content})
+
+			cfg, err := Load()
+			require.NoError(t, err)
+			assert.True(t, cfg.Enabled)
+			assert.Equal(t, 300, cfg.Timeout)
+			assert.Equal(t, []string{"vendor/", "testdata/"}, cfg.Git.ExcludePatterns)
+			assert.False(t, cfg.Checks.Lint)
+			assert.Equal(t, 90, cfg.CheckTimeouts.Lint)
+			assert.True(t, cfg.Checks.Gitleaks)
+			assert.True(t, cfg.Checks.ModTidy, "unset keys keep their defaults")
+			assert.Equal(t, 45, cfg.CheckTimeouts.ModTidy)
+			assert.Equal(t, map[string]string{"license-headers": "./scripts/license-headers.sh"}, cfg.ExternalChecks)
+			assert.Equal(t, []string{"gitleaks", "license-headers"}, cfg.RequiredChecks)
+			loaded = append(loaded, cfg)
+		})
+	}
+
+	require.Len(t, loaded, len(equivalentConfigFiles))
+	for _, cfg := range loaded[1:] {
+		assert.Equal(t, loaded[0], cfg, "every format loads the same configuration")
+	}
+}
+
+func TestLoad_ConfigFileDiscoveryOrder(t *testing.T) {
+	configFileDir(t, map[string]string{
+		".go-pre-commit.json": `{"timeout": 100}`,
+		".go-pre-commit.toml": "timeout = 200\n",
+		".go-pre-commit.yaml": "timeout: 300\n",
+	})
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, 300, cfg.Timeout, ".yaml comes before .toml and .json")
+}
+
+func TestLoad_EnvOverridesConfigFile(t *testing.T) {
+	dir := configFileDir(t, map[string]string{".go-pre-commit.yml": "timeout: 300\nchecks:\n  lint:\n    timeout: 90\n"})
+	envDir := filepath.Join(dir, ".github", "env")
+	require.NoError(t, os.MkdirAll(envDir, 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(envDir, "10-pre-commit.env"), []byte("GO_PRE_COMMIT_LINT_TIMEOUT=120\n"), 0o600))
+	t.Setenv("GO_PRE_COMMIT_TIMEOUT_SECONDS", "600")
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, 600, cfg.Timeout, "the process environment wins")
+	assert.Equal(t, 120, cfg.CheckTimeouts.Lint, "env files win")
+}
+
+func TestLoad_ConfigOverlay(t *testing.T) {
+	dir := configFileDir(t, map[string]string{
+		".go-pre-commit.yml":    "timeout: 300\nchecks:\n  lint:\n    timeout: 90\n  gitleaks:\n    enabled: false\n  mod-tidy:\n    timeout: 45\n",
+		".go-pre-commit.ci.yml": "checks:\n  lint:\n    timeout: 120\n  gitleaks:\n    enabled: true\n  mod-tidy:\n    timeout: 60\n",
+	})
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, 90, cfg.CheckTimeouts.Lint, "the overlay is only merged for its environment")
+	assert.False(t, cfg.Checks.Gitleaks)
+
+	for _, key := range configFileEnv {
+		t.Setenv(key, "")
+	}
+	t.Setenv(OverlayEnv, "ci")
+	t.Setenv("GO_PRE_COMMIT_MOD_TIDY_TIMEOUT", "75")
+	cfg, err = Load()
+	require.NoError(t, err)
+	assert.Equal(t, 300, cfg.Timeout, "keys the overlay leaves out come from the config file")
+	assert.Equal(t, 120, cfg.CheckTimeouts.Lint, "the overlay overrides the config file")
+	assert.True(t, cfg.Checks.Gitleaks)
+	assert.Equal(t, 75, cfg.CheckTimeouts.ModTidy, "the environment overrides the overlay")
+
+	t.Setenv(OverlayEnv, "staging")
+	_, err = Load()
+	require.ErrorIs(t, err, ErrConfigOverlayNotFound)
+
+	t.Setenv(OverlayEnv, "../ci")
+	_, err = Load()
+	require.ErrorIs(t, err, ErrConfigOverlayNotFound)
+
+	require.NoError(t, os.Remove(filepath.Join(dir, ".go-pre-commit.ci.yml")))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".go-pre-commit.ci.toml"), []byte("[checks.lint]\ntimeout = 150\n"), 0o600))
+	for _, key := range configFileEnv {
+		t.Setenv(key, "")
+	}
+	t.Setenv(OverlayEnv, "ci")
+	cfg, err = Load()
+	require.NoError(t, err)
+	assert.Equal(t, 150, cfg.CheckTimeouts.Lint, "overlays can use any config file format")
+}
+
+func TestReadConfigFile_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{name: ".go-pre-commit.yml", content: "timeout: 300\ntimout: 30\n"},
+		{name: ".go-pre-commit.toml", content: "timeout = 300\ntimout = 30\n", wantErr: ErrUnknownConfigKey},
+		{name: ".go-pre-commit.json", content: `{"timeout": 300, "timout": 30}`},
+		{name: ".go-pre-commit.yml", content: "checks:\n  lnit:\n    enabled: true\n", wantErr: ErrUnknownConfigCheck},
+		{name: ".go-pre-commit.yml", content: "checks:\n  lint:\n    enabeld: true\n"},
+		{name: ".go-pre-commit.json", content: `{"checks": {"lint": {"enabeld": true}}}`},
+		{name: ".go-pre-commit.toml", content: "[checks.lint]\nenabeld = true\n"},
+		{name: ".go-pre-commit.yml", content: "checks:\n  external:\n    - {name: License_Headers, command: ./lh.sh}\n", wantErr: ErrInvalidExternalCheckName},
+		{name: ".go-pre-commit.toml", content: "[[checks.external]]\nname = \"lh.v2\"\ncommand = \"./lh.sh\"\n", wantErr: ErrInvalidExternalCheckName},
+		{name: ".go-pre-commit.json", content: `{"checks": {"external": [{"command": "./lh.sh"}]}}`, wantErr: ErrInvalidExternalCheckName},
+		{name: ".go-pre-commit.yml", content: "external:\n  - {name: lh, command: ./lh.sh}\n"},
+		{name: ".go-pre-commit.ini", content: "timeout=300\n", wantErr: ErrUnsupportedConfigFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.name)
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+
+			_, err := ReadConfigFile(path)
+			require.Error(t, err)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFileConfig_EnvRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".go-pre-commit.toml")
+	require.NoError(t, os.WriteFile(path, []byte(equivalentConfigFiles[".go-pre-commit.toml"]), 0o600))
+
+	file, err := ReadConfigFile(path)
+	require.NoError(t, err)
+
+	migration := MigrateEnv(file.Env())
+	assert.Empty(t, migration.Unmapped)
+	assert.Equal(t, *file, migration.Config)
+}
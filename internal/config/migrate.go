@@ -0,0 +1,270 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultConfigFile is the YAML configuration file written by migrate-config,
+// and the first name Load looks for
+const DefaultConfigFile = ".go-pre-commit.yml"
+
+var (
+	// ErrNoYAMLEquivalent is reported for variables the YAML file has no key for
+	ErrNoYAMLEquivalent = errors.New("no YAML equivalent")
+
+	// ErrUnparsableValue is reported for variables whose value cannot be converted
+	ErrUnparsableValue = errors.New("invalid value")
+)
+
+// migratableChecks maps the check part of GO_PRE_COMMIT_ENABLE_<CHECK> and
+// GO_PRE_COMMIT_<CHECK>_TIMEOUT to the check name used in YAML
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var migratableChecks = map[string]string{
+	"FUMPT":                  "fumpt",
+	"LINT":                   "lint",
+	"MOD_TIDY":               "mod-tidy",
+	"WHITESPACE":             "whitespace",
+	"EOF":                    "eof",
+	"GITLEAKS":               "gitleaks",
+	"BUILD_TAGS":             "build-tags",
+	"GO_INDENT":              "go-indent",
+	"EMPTY_COMMIT":           "empty-commit",
+	"GO_VERSION":             "go-version",
+	"ERROR_COMPARE":          "error-compare",
+	"TEST_PRESENCE":          "test-presence",
+	"GO_GENERATE":            "go-generate",
+	"LARGE_DIFFS":            "large-diffs",
+	"TOOLCHAIN":              "toolchain",
+	"MODULE_PATH":            "module-path",
+	"BUILD_ARTIFACTS":        "build-artifacts",
+	"OS_JUNK":                "os-junk",
+	"CONTEXT_FIRST":          "context-first",
+	"FORBIDDEN_IMPORTS":      "forbidden-imports",
+	"STRUCT_TAGS":            "struct-tags",
+	"DOC_COMMENTS":           "doc-comments",
+	"EMBEDDED_BLOBS":         "embedded-blobs",
+	"DATA_FORMAT":            "data-format",
+	"GOSEC":                  "gosec",
+	"SHELLCHECK":             "shellcheck",
+	"HADOLINT":               "hadolint",
+	"GO_DIRECTIVE":           "go-directive",
+	"STUB_FUNCS":             "stub-funcs",
+	"TEST_PACKAGE":           "test-package",
+	"MOD_PAIR":               "mod-pair",
+	"ERROR_WRAP":             "error-wrap",
+	"INTERNAL_IMPORT":        "internal-import",
+	"FUNC_LENGTH":            "func-length",
+	"ENV_ACCESS":             "env-access",
+	"TEST_ONLY_DEPS":         "test-only-deps",
+	"GLOBAL_VARS":            "global-vars",
+	"FILE_HEADER_ORDER":      "file-header-order",
+	"CONTEXT_BACKGROUND":     "context-background",
+	"MAJOR_VERSION_CONFLICT": "major-version-conflict",
+	"FILENAME_CASE":          "filename-case",
+	"BUILD_CONSTRAINTS":      "build-constraints",
+	"REPO_FILES":             "repo-files",
+	"ERROR_VAR_NAMING":       "error-var-naming",
+	"COMMIT_SIZE":            "commit-size",
+	"FILE_PERMISSIONS":       "file-permissions",
+	"RECEIVER_NAMES":         "receiver-names",
+}
+
+// FileConfig is the config file form of the settings migrate-config carries
+// over. Load reads it from YAML, TOML, or JSON; migrate-config writes YAML.
+// Unset fields are omitted so the file only records what the env set.
+// The description, default, and minimum tags feed the JSON Schema printed by
+// "config schema".
+type FileConfig struct {
+	Enabled         *bool            `yaml:"enabled,omitempty" json:"enabled,omitempty" toml:"enabled,omitempty" description:"Run go-pre-commit at all (ENABLE_GO_PRE_COMMIT)" default:"true"`
+	Timeout         *int             `yaml:"timeout,omitempty" json:"timeout,omitempty" toml:"timeout,omitempty" description:"Timeout for the whole run in seconds (GO_PRE_COMMIT_TIMEOUT_SECONDS)" default:"720" minimum:"1"`
+	Checks          ChecksFileConfig `yaml:"checks,omitempty" json:"checks,omitempty" toml:"checks,omitempty" description:"Per-check settings, keyed by check name, and the external checks under external"`
+	ExcludePatterns []string         `yaml:"exclude_patterns,omitempty" json:"exclude_patterns,omitempty" toml:"exclude_patterns,omitempty" description:"Paths no check looks at (GO_PRE_COMMIT_EXCLUDE_PATTERNS)" default:"vendor/,node_modules/,.git/"`
+	RequiredChecks  []string         `yaml:"required_checks,omitempty" json:"required_checks,omitempty" toml:"required_checks,omitempty" description:"Checks every run must execute; the run fails when one is disabled or skipped (GO_PRE_COMMIT_REQUIRED_CHECKS)"`
+}
+
+// externalChecksKey is the key of the external checks in the checks section
+const externalChecksKey = "external"
+
+// ChecksFileConfig holds the checks section of the config file: the settings
+// of built-in checks keyed by check name, and the external checks listed
+// under "external". JSON and TOML are decoded by UnmarshalJSON and
+// UnmarshalTOML; YAML inlines Builtin.
+type ChecksFileConfig struct {
+	External []ExternalCheckFileConfig   `yaml:"external,omitempty" json:"-" toml:"-" description:"Commands run as checks that report JSON diagnostics (GO_PRE_COMMIT_EXTERNAL_CHECK_<NAME>)"`
+	Builtin  map[string]*CheckFileConfig `yaml:",inline" json:"-" toml:"-"`
+}
+
+// ExternalCheckFileConfig holds the config file settings of one external check
+type ExternalCheckFileConfig struct {
+	Name    string `yaml:"name" json:"name" toml:"name" description:"Check name, used in output and with --only and --skip; lowercase letters, digits, and dashes" pattern:"^[a-z0-9-]+$"`
+	Command string `yaml:"command" json:"command" toml:"command" description:"Shell command run from the repository root with the files on stdin"`
+}
+
+// CheckFileConfig holds the config file settings of one check
+type CheckFileConfig struct {
+	Enabled *bool `yaml:"enabled,omitempty" json:"enabled,omitempty" toml:"enabled,omitempty" description:"Run this check (GO_PRE_COMMIT_ENABLE_<CHECK>); the default depends on the check"`
+	Timeout *int  `yaml:"timeout,omitempty" json:"timeout,omitempty" toml:"timeout,omitempty" description:"Timeout for this check in seconds (GO_PRE_COMMIT_<CHECK>_TIMEOUT)" minimum:"1"`
+}
+
+// MigratedKey records where an environment variable went in the YAML file
+type MigratedKey struct {
+	Env  string
+	Path string
+}
+
+// UnmappedKey records an environment variable that could not be migrated
+type UnmappedKey struct {
+	Env    string
+	Reason string
+}
+
+// Migration is the result of converting env variables to a FileConfig
+type Migration struct {
+	Config   FileConfig
+	Migrated []MigratedKey
+	Unmapped []UnmappedKey
+}
+
+// MigrateEnv converts the GO_PRE_COMMIT_* variables in vars (plus
+// ENABLE_GO_PRE_COMMIT) to a FileConfig. Enable flags, timeouts, exclude
+// patterns, external checks, and required checks are carried over; any other
+// pre-commit variable, or one whose value does not parse, is reported as
+// unmapped. Other variables are ignored.
+func MigrateEnv(vars map[string]string) *Migration {
+	m := &Migration{}
+
+	keys := make([]string, 0, len(vars))
+	for key := range vars {
+		if key == "ENABLE_GO_PRE_COMMIT" || strings.HasPrefix(key, "GO_PRE_COMMIT_") {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		path, err := m.migrateKey(key, strings.TrimSpace(vars[key]))
+		if err != nil {
+			m.Unmapped = append(m.Unmapped, UnmappedKey{Env: key, Reason: err.Error()})
+			continue
+		}
+		m.Migrated = append(m.Migrated, MigratedKey{Env: key, Path: path})
+	}
+	return m
+}
+
+// migrateKey sets the FileConfig field for key and returns its YAML path
+func (m *Migration) migrateKey(key, value string) (string, error) {
+	switch key {
+	case "ENABLE_GO_PRE_COMMIT":
+		enabled, err := parseMigratedBool(value)
+		if err != nil {
+			return "", err
+		}
+		m.Config.Enabled = &enabled
+		return "enabled", nil
+	case "GO_PRE_COMMIT_TIMEOUT_SECONDS":
+		timeout, err := parseMigratedTimeout(value)
+		if err != nil {
+			return "", err
+		}
+		m.Config.Timeout = &timeout
+		return "timeout", nil
+	case "GO_PRE_COMMIT_EXCLUDE_PATTERNS":
+		m.Config.ExcludePatterns = []string{}
+		for _, pattern := range strings.Split(value, ",") {
+			if pattern = strings.TrimSpace(pattern); pattern != "" {
+				m.Config.ExcludePatterns = append(m.Config.ExcludePatterns, pattern)
+			}
+		}
+		return "exclude_patterns", nil
+	case "GO_PRE_COMMIT_REQUIRED_CHECKS":
+		m.Config.RequiredChecks = []string{}
+		for _, name := range strings.Split(value, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				m.Config.RequiredChecks = append(m.Config.RequiredChecks, name)
+			}
+		}
+		return "required_checks", nil
+	}
+
+	if stem, ok := strings.CutPrefix(key, externalCheckEnvPrefix); ok && stem != "" {
+		name := strings.ReplaceAll(strings.ToLower(stem), "_", "-")
+		m.Config.Checks.External = append(m.Config.Checks.External, ExternalCheckFileConfig{Name: name, Command: value})
+		return "checks.external." + name, nil
+	}
+
+	if stem, ok := strings.CutPrefix(key, "GO_PRE_COMMIT_ENABLE_"); ok {
+		if name, known := migratableChecks[stem]; known {
+			enabled, err := parseMigratedBool(value)
+			if err != nil {
+				return "", err
+			}
+			m.check(name).Enabled = &enabled
+			return "checks." + name + ".enabled", nil
+		}
+	}
+
+	if stem, ok := strings.CutSuffix(strings.TrimPrefix(key, "GO_PRE_COMMIT_"), "_TIMEOUT"); ok {
+		if name, known := migratableChecks[stem]; known {
+			timeout, err := parseMigratedTimeout(value)
+			if err != nil {
+				return "", err
+			}
+			m.check(name).Timeout = &timeout
+			return "checks." + name + ".timeout", nil
+		}
+	}
+
+	return "", ErrNoYAMLEquivalent
+}
+
+// check returns the settings for the named check, creating them if needed
+func (m *Migration) check(name string) *CheckFileConfig {
+	if m.Config.Checks.Builtin == nil {
+		m.Config.Checks.Builtin = make(map[string]*CheckFileConfig)
+	}
+	if m.Config.Checks.Builtin[name] == nil {
+		m.Config.Checks.Builtin[name] = &CheckFileConfig{}
+	}
+	return m.Config.Checks.Builtin[name]
+}
+
+// YAML renders the migrated configuration
+func (m *Migration) YAML() ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&m.Config); err != nil {
+		return nil, fmt.Errorf("encoding %s: %w", DefaultConfigFile, err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("encoding %s: %w", DefaultConfigFile, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// parseMigratedBool parses a boolean the way getBoolEnv does
+func parseMigratedBool(value string) (bool, error) {
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("%w: %q is not a boolean", ErrUnparsableValue, value)
+	}
+	return enabled, nil
+}
+
+// parseMigratedTimeout parses a timeout in seconds
+func parseMigratedTimeout(value string) (int, error) {
+	timeout, err := strconv.Atoi(value)
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("%w: %q is not a positive number of seconds", ErrUnparsableValue, value)
+	}
+	return timeout, nil
+}
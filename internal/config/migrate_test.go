@@ -0,0 +1,89 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestMigrateEnv_RoundTrip(t *testing.T) {
+	vars := map[string]string{
+		"ENABLE_GO_PRE_COMMIT":           "true",
+		"GO_PRE_COMMIT_TIMEOUT_SECONDS":  "720",
+		"GO_PRE_COMMIT_ENABLE_FUMPT":     "true",
+		"GO_PRE_COMMIT_ENABLE_GITLEAKS":  "false",
+		"GO_PRE_COMMIT_ENABLE_MOD_TIDY":  "true",
+		"GO_PRE_COMMIT_LINT_TIMEOUT":     "600",
+		"GO_PRE_COMMIT_MOD_TIDY_TIMEOUT": "60",
+		"GO_PRE_COMMIT_EXCLUDE_PATTERNS": "vendor/, node_modules/,,.git/",
+		"GO_PRE_COMMIT_REQUIRED_CHECKS":  "lint, gitleaks",
+		"GO_PRE_COMMIT_LOG_LEVEL":        "debug",
+		"GO_PRE_COMMIT_ENABLE_LINT":      "sometimes",
+		"GO_PRE_COMMIT_EOF_TIMEOUT":      "0",
+		"GO_COVERAGE_THRESHOLD":          "90",
+	}
+
+	migration := MigrateEnv(vars)
+
+	assert.Equal(t, []MigratedKey{
+		{Env: "ENABLE_GO_PRE_COMMIT", Path: "enabled"},
+		{Env: "GO_PRE_COMMIT_ENABLE_FUMPT", Path: "checks.fumpt.enabled"},
+		{Env: "GO_PRE_COMMIT_ENABLE_GITLEAKS", Path: "checks.gitleaks.enabled"},
+		{Env: "GO_PRE_COMMIT_ENABLE_MOD_TIDY", Path: "checks.mod-tidy.enabled"},
+		{Env: "GO_PRE_COMMIT_EXCLUDE_PATTERNS", Path: "exclude_patterns"},
+		{Env: "GO_PRE_COMMIT_LINT_TIMEOUT", Path: "checks.lint.timeout"},
+		{Env: "GO_PRE_COMMIT_MOD_TIDY_TIMEOUT", Path: "checks.mod-tidy.timeout"},
+		{Env: "GO_PRE_COMMIT_REQUIRED_CHECKS", Path: "required_checks"},
+		{Env: "GO_PRE_COMMIT_TIMEOUT_SECONDS", Path: "timeout"},
+	}, migration.Migrated)
+
+	unmapped := make(map[string]string, len(migration.Unmapped))
+	for _, key := range migration.Unmapped {
+		unmapped[key.Env] = key.Reason
+	}
+	assert.Len(t, unmapped, 3, "non-pre-commit variables are ignored")
+	assert.Contains(t, unmapped["GO_PRE_COMMIT_LOG_LEVEL"], ErrNoYAMLEquivalent.Error())
+	assert.Contains(t, unmapped["GO_PRE_COMMIT_ENABLE_LINT"], "not a boolean")
+	assert.Contains(t, unmapped["GO_PRE_COMMIT_EOF_TIMEOUT"], "not a positive number")
+
+	data, err := migration.YAML()
+	require.NoError(t, err)
+
+	var decoded FileConfig
+	require.NoError(t, yaml.Unmarshal(data, &decoded))
+	assert.Equal(t, migration.Config, decoded)
+
+	require.NotNil(t, decoded.Enabled)
+	assert.True(t, *decoded.Enabled)
+	require.NotNil(t, decoded.Timeout)
+	assert.Equal(t, 720, *decoded.Timeout)
+	assert.Equal(t, []string{"vendor/", "node_modules/", ".git/"}, decoded.ExcludePatterns)
+	assert.Equal(t, []string{"lint", "gitleaks"}, decoded.RequiredChecks)
+	require.Contains(t, decoded.Checks.Builtin, "mod-tidy")
+	assert.True(t, *decoded.Checks.Builtin["mod-tidy"].Enabled)
+	assert.Equal(t, 60, *decoded.Checks.Builtin["mod-tidy"].Timeout)
+	assert.False(t, *decoded.Checks.Builtin["gitleaks"].Enabled)
+	assert.Nil(t, decoded.Checks.Builtin["lint"].Enabled, "the invalid lint flag is not carried over")
+	assert.NotContains(t, decoded.Checks.Builtin, "eof")
+}
+
+func TestMigrateEnv_YAMLLayout(t *testing.T) {
+	data, err := MigrateEnv(map[string]string{
+		"GO_PRE_COMMIT_ENABLE_EOF":  "true",
+		"GO_PRE_COMMIT_EOF_TIMEOUT": "30",
+	}).YAML()
+	require.NoError(t, err)
+	assert.Equal(t, "checks:\n  eof:\n    enabled: true\n    timeout: 30\n", string(data))
+}
+
+func TestMigrateEnv_Empty(t *testing.T) {
+	migration := MigrateEnv(nil)
+	assert.Empty(t, migration.Migrated)
+	assert.Empty(t, migration.Unmapped)
+
+	data, err := migration.YAML()
+	require.NoError(t, err)
+	assert.Equal(t, "{}\n", string(data))
+}
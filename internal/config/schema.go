@@ -0,0 +1,137 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// SchemaDraft is the JSON Schema dialect Schema describes the config file in
+const SchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema returns a JSON Schema for DefaultConfigFile so editors can validate
+// and complete it. The schema is derived from FileConfig at runtime: yaml tags
+// name the keys, Go types give their JSON types, and the description,
+// default, and minimum tags fill in the rest. Unknown keys and check names
+// are rejected.
+func Schema() ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(configSchema()); err != nil {
+		return nil, fmt.Errorf("encoding config schema: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// configSchema builds the schema Schema encodes and ValidateConfigFile
+// checks files against
+func configSchema() map[string]any {
+	schema := typeSchema(reflect.TypeFor[FileConfig]())
+	schema["$schema"] = SchemaDraft
+	schema["title"] = DefaultConfigFile
+
+	checkNames := make([]string, 0, len(migratableChecks))
+	for _, name := range migratableChecks {
+		checkNames = append(checkNames, name)
+	}
+	slices.Sort(checkNames)
+	checkNames = append(checkNames, externalChecksKey)
+	if properties, ok := schema["properties"].(map[string]any); ok {
+		if checks, isObject := properties["checks"].(map[string]any); isObject {
+			checks["propertyNames"] = map[string]any{"enum": checkNames}
+		}
+	}
+	return schema
+}
+
+// typeSchema describes a Go type. Structs become closed objects whose
+// properties are their yaml-tagged fields, and maps become objects whose
+// values all share the element's schema. A map inlined into a struct gives
+// the struct's other keys its element's schema.
+func typeSchema(t reflect.Type) map[string]any {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() { //nolint:exhaustive // FileConfig only uses these kinds
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int64:
+		return map[string]any{"type": "integer"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice:
+		return map[string]any{"type": "array", "items": typeSchema(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": typeSchema(t.Elem())}
+	case reflect.Struct:
+		properties := make(map[string]any, t.NumField())
+		var additional any = false
+		for i := range t.NumField() {
+			field := t.Field(i)
+			name, options, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+			if options == "inline" && field.Type.Kind() == reflect.Map {
+				additional = typeSchema(field.Type.Elem())
+				continue
+			}
+			if name == "" || name == "-" {
+				continue
+			}
+			properties[name] = fieldSchema(field)
+		}
+		return map[string]any{"type": "object", "properties": properties, "additionalProperties": additional}
+	default:
+		return map[string]any{}
+	}
+}
+
+// fieldSchema describes a struct field, adding its description, default,
+// minimum, and pattern tags to the schema of its type
+func fieldSchema(field reflect.StructField) map[string]any {
+	schema := typeSchema(field.Type)
+	if description := field.Tag.Get("description"); description != "" {
+		schema["description"] = description
+	}
+	if pattern := field.Tag.Get("pattern"); pattern != "" {
+		schema["pattern"] = pattern
+	}
+	if minimum := field.Tag.Get("minimum"); minimum != "" {
+		if value, err := strconv.Atoi(minimum); err == nil {
+			schema["minimum"] = value
+		}
+	}
+	if value, ok := field.Tag.Lookup("default"); ok {
+		schema["default"] = schemaDefault(schema["type"], value)
+	}
+	return schema
+}
+
+// schemaDefault converts a default tag to the JSON value of the field's type;
+// lists are comma-separated like their environment variables
+func schemaDefault(schemaType any, value string) any {
+	switch schemaType {
+	case "boolean":
+		if parsed, err := strconv.ParseBool(value); err == nil {
+			return parsed
+		}
+	case "integer":
+		if parsed, err := strconv.Atoi(value); err == nil {
+			return parsed
+		}
+	case "array":
+		items := make([]string, 0)
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		return items
+	}
+	return value
+}
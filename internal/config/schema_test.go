@@ -0,0 +1,187 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// errSchemaMismatch is returned when a value does not match the schema
+var errSchemaMismatch = errors.New("schema mismatch")
+
+// validateSchema checks value against the subset of JSON Schema that Schema
+// emits: type, properties, additionalProperties, propertyNames enums, items,
+// minimum, and pattern
+func validateSchema(schema map[string]any, value any, path string) error {
+	switch schema["type"] {
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%w: %s: expected an object, got %T", errSchemaMismatch, path, value)
+		}
+		properties, _ := schema["properties"].(map[string]any)
+		for key, item := range object {
+			if names, ok := schema["propertyNames"].(map[string]any); ok && !slices.Contains(names["enum"].([]any), any(key)) {
+				return fmt.Errorf("%w: %s: unknown name %q", errSchemaMismatch, path, key)
+			}
+			itemSchema, known := properties[key].(map[string]any)
+			if !known {
+				switch additional := schema["additionalProperties"].(type) {
+				case bool:
+					return fmt.Errorf("%w: %s: unknown key %q", errSchemaMismatch, path, key)
+				case map[string]any:
+					itemSchema = additional
+				}
+			}
+			if err := validateSchema(itemSchema, item, path+"."+key); err != nil {
+				return err
+			}
+		}
+	case "array":
+		list, ok := value.([]any)
+		if !ok {
+			return fmt.Errorf("%w: %s: expected an array, got %T", errSchemaMismatch, path, value)
+		}
+		for i, item := range list {
+			if err := validateSchema(schema["items"].(map[string]any), item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%w: %s: expected a boolean, got %T", errSchemaMismatch, path, value)
+		}
+	case "integer":
+		number, ok := value.(float64)
+		if !ok || number != float64(int(number)) {
+			return fmt.Errorf("%w: %s: expected an integer, got %v", errSchemaMismatch, path, value)
+		}
+		if minimum, ok := schema["minimum"].(float64); ok && number < minimum {
+			return fmt.Errorf("%w: %s: %v is below the minimum %v", errSchemaMismatch, path, number, minimum)
+		}
+	case "string":
+		text, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%w: %s: expected a string, got %T", errSchemaMismatch, path, value)
+		}
+		if pattern, ok := schema["pattern"].(string); ok && !regexp.MustCompile(pattern).MatchString(text) {
+			return fmt.Errorf("%w: %s: %q does not match %s", errSchemaMismatch, path, text, pattern)
+		}
+	}
+	return nil
+}
+
+// validateYAML decodes a config file and checks it against the schema
+func validateYAML(t *testing.T, schema map[string]any, document string) error {
+	t.Helper()
+	var decoded any
+	require.NoError(t, yaml.Unmarshal([]byte(document), &decoded))
+	// Round-trip through JSON so values have the types a JSON validator sees
+	data, err := json.Marshal(decoded)
+	require.NoError(t, err)
+	var value any
+	require.NoError(t, json.Unmarshal(data, &value))
+	return validateSchema(schema, value, "$")
+}
+
+func loadSchema(t *testing.T) map[string]any {
+	t.Helper()
+	data, err := Schema()
+	require.NoError(t, err)
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(data, &schema))
+	return schema
+}
+
+func TestSchema_Layout(t *testing.T) {
+	schema := loadSchema(t)
+
+	assert.Equal(t, SchemaDraft, schema["$schema"])
+	assert.Equal(t, DefaultConfigFile, schema["title"])
+	assert.Equal(t, false, schema["additionalProperties"])
+
+	properties := schema["properties"].(map[string]any)
+	assert.Len(t, properties, 5)
+
+	timeout := properties["timeout"].(map[string]any)
+	assert.Equal(t, "integer", timeout["type"])
+	assert.InDelta(t, 720, timeout["default"], 0)
+	assert.InDelta(t, 1, timeout["minimum"], 0)
+	assert.Contains(t, timeout["description"], "GO_PRE_COMMIT_TIMEOUT_SECONDS")
+
+	assert.Equal(t, true, properties["enabled"].(map[string]any)["default"])
+	assert.Equal(t, []any{"vendor/", "node_modules/", ".git/"}, properties["exclude_patterns"].(map[string]any)["default"])
+
+	checks := properties["checks"].(map[string]any)
+	names := checks["propertyNames"].(map[string]any)["enum"].([]any)
+	assert.Len(t, names, len(migratableChecks)+1)
+	assert.Contains(t, names, "external")
+	assert.Contains(t, names, "mod-tidy")
+	assert.Contains(t, names, "file-permissions")
+	check := checks["additionalProperties"].(map[string]any)
+	assert.Equal(t, false, check["additionalProperties"])
+	assert.Contains(t, check["properties"], "timeout")
+
+	external := checks["properties"].(map[string]any)["external"].(map[string]any)
+	assert.Equal(t, "array", external["type"])
+	assert.Contains(t, external["items"].(map[string]any)["properties"], "command")
+}
+
+func TestSchema_ValidatesConfig(t *testing.T) {
+	schema := loadSchema(t)
+
+	good := `enabled: true
+timeout: 600
+checks:
+  lint:
+    enabled: false
+    timeout: 300
+  mod-tidy:
+    enabled: true
+  external:
+    - name: license-headers
+      command: ./scripts/license-headers.sh
+exclude_patterns:
+  - vendor/
+  - testdata/
+`
+	require.NoError(t, validateYAML(t, schema, good))
+
+	// What migrate-config writes is always valid
+	migrated, err := MigrateEnv(map[string]string{
+		"ENABLE_GO_PRE_COMMIT":                         "true",
+		"GO_PRE_COMMIT_ENABLE_EOF":                     "true",
+		"GO_PRE_COMMIT_GITLEAKS_TIMEOUT":               "60",
+		"GO_PRE_COMMIT_EXTERNAL_CHECK_LICENSE_HEADERS": "./scripts/license-headers.sh",
+	}).YAML()
+	require.NoError(t, err)
+	require.NoError(t, validateYAML(t, schema, string(migrated)))
+
+	tests := []struct {
+		name     string
+		document string
+		want     string
+	}{
+		{name: "unknown key", document: "enabled: true\nlog_level: debug\n", want: `unknown key "log_level"`},
+		{name: "unknown check setting", document: "checks:\n  lint:\n    severity: error\n", want: `$.checks.lint: unknown key "severity"`},
+		{name: "unknown check", document: "checks:\n  golint:\n    enabled: true\n", want: `unknown name "golint"`},
+		{name: "wrong type", document: "timeout: soon\n", want: "expected an integer"},
+		{name: "below minimum", document: "checks:\n  eof:\n    timeout: 0\n", want: "below the minimum"},
+		{name: "external check name", document: "checks:\n  external:\n    - {name: License_Headers, command: ./lh.sh}\n", want: `"License_Headers" does not match`},
+		{name: "top-level external", document: "external:\n  - {name: lh, command: ./lh.sh}\n", want: `unknown key "external"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateYAML(t, schema, tt.document)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Check loads the configuration the way Load does but collects every problem
+// instead of stopping at the first: the config file and the overlay of the
+// environment OverlayEnv names, if there are any, are checked against the
+// schema of "config schema", and the settings the env files and valid config
+// files add up to go through Validate. The returned Config is nil when the
+// settings could not be read at all.
+func Check() (*Config, []string) {
+	var problems []string
+	configFile := findConfigFile()
+	overlayFile, err := findOverlayFile(os.Getenv(OverlayEnv), configFile)
+	if err != nil {
+		problems = append(problems, err.Error())
+	}
+	for _, path := range []string{configFile, overlayFile} {
+		if path == "" {
+			continue
+		}
+		for _, problem := range ValidateConfigFile(path) {
+			problems = append(problems, path+": "+problem)
+		}
+	}
+
+	// Broken config files are left out so their problems are not reported twice
+	cfg, err := loadSettings(configFile, len(problems) == 0)
+	if err != nil {
+		return nil, append(problems, err.Error())
+	}
+
+	var validationErr *ValidationError
+	if err = cfg.Validate(); errors.As(err, &validationErr) {
+		return cfg, append(problems, validationErr.Errors...)
+	}
+	return cfg, problems
+}
+
+// ValidateConfigFile checks a config file against the schema "config schema"
+// prints and returns every problem found, in key order: unknown keys
+// and check names, values of the wrong type, and numbers below their minimum.
+// A file that does not parse has a single problem.
+func ValidateConfigFile(path string) []string {
+	data, err := os.ReadFile(path) //nolint:gosec // Path of the config file being validated
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	var document any
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yml", ".yaml":
+		err = yaml.Unmarshal(data, &document)
+	case ".toml":
+		var table map[string]any
+		_, err = toml.Decode(string(data), &table)
+		document = table
+	case ".json":
+		err = json.Unmarshal(data, &document)
+	default:
+		return []string{fmt.Sprintf("%v %q", ErrUnsupportedConfigFormat, ext)}
+	}
+	if err != nil {
+		return []string{fmt.Sprintf("failed to parse: %v", err)}
+	}
+	if document == nil {
+		return nil
+	}
+	return schemaProblems(configSchema(), document, "")
+}
+
+// schemaProblems returns where value breaks schema, naming each location by
+// its dotted key path from at. Only the parts of JSON Schema that typeSchema
+// and configSchema produce are understood.
+func schemaProblems(schema map[string]any, value any, at string) []string {
+	if value == nil {
+		return nil // An empty key leaves the setting at its default
+	}
+
+	switch schema["type"] {
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			return []string{schemaLocation(at) + "must be a mapping"}
+		}
+		return objectProblems(schema, object, at)
+	case "array":
+		items, ok := value.([]any)
+		if tables, isTables := value.([]map[string]any); isTables {
+			// TOML decodes an array of tables to a typed slice
+			items, ok = make([]any, 0, len(tables)), true
+			for _, table := range tables {
+				items = append(items, table)
+			}
+		}
+		if !ok {
+			return []string{schemaLocation(at) + "must be a list"}
+		}
+		itemSchema, _ := schema["items"].(map[string]any)
+		var problems []string
+		for i, item := range items {
+			problems = append(problems, schemaProblems(itemSchema, item, fmt.Sprintf("%s[%d]", at, i))...)
+		}
+		return problems
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return []string{schemaLocation(at) + "must be true or false"}
+		}
+	case "string":
+		text, ok := value.(string)
+		if !ok {
+			return []string{schemaLocation(at) + "must be a string"}
+		}
+		if pattern, hasPattern := schema["pattern"].(string); hasPattern && !regexp.MustCompile(pattern).MatchString(text) {
+			return []string{fmt.Sprintf("%smust match %s (got: '%s')", schemaLocation(at), pattern, text)}
+		}
+	case "integer":
+		number, ok := schemaInteger(value)
+		if !ok {
+			return []string{schemaLocation(at) + "must be a whole number"}
+		}
+		if minimum, hasMinimum := schema["minimum"].(int); hasMinimum && number < int64(minimum) {
+			return []string{fmt.Sprintf("%smust be at least %d (got %d)", schemaLocation(at), minimum, number)}
+		}
+	}
+	return nil
+}
+
+// objectProblems checks the keys of a mapping, in sorted order, against the
+// properties, propertyNames, and additionalProperties of schema
+func objectProblems(schema, object map[string]any, at string) []string {
+	properties, _ := schema["properties"].(map[string]any)
+	var allowedNames []string
+	if propertyNames, ok := schema["propertyNames"].(map[string]any); ok {
+		allowedNames, _ = propertyNames["enum"].([]string)
+	}
+
+	var problems []string
+	for _, key := range slices.Sorted(maps.Keys(object)) {
+		location := key
+		if at != "" {
+			location = at + "." + key
+		}
+		switch {
+		case allowedNames != nil && !slices.Contains(allowedNames, key):
+			problems = append(problems, fmt.Sprintf("%sunknown check %q", schemaLocation(at), key))
+		case properties[key] != nil:
+			propertySchema, _ := properties[key].(map[string]any)
+			problems = append(problems, schemaProblems(propertySchema, object[key], location)...)
+		default:
+			valueSchema, ok := schema["additionalProperties"].(map[string]any)
+			if !ok {
+				problems = append(problems, fmt.Sprintf("%sunknown key %q", schemaLocation(at), key))
+				continue
+			}
+			problems = append(problems, schemaProblems(valueSchema, object[key], location)...)
+		}
+	}
+	return problems
+}
+
+// schemaInteger returns value as an integer when the decoder produced a
+// whole number: YAML decodes to int, TOML to int64, and JSON to float64
+func schemaInteger(value any) (int64, bool) {
+	switch number := value.(type) {
+	case int:
+		return int64(number), true
+	case int64:
+		return number, true
+	case float64:
+		if number == math.Trunc(number) {
+			return int64(number), true
+		}
+	}
+	return 0, false
+}
+
+// schemaLocation prefixes a problem with its key path, if it has one
+func schemaLocation(at string) string {
+	if at == "" {
+		return ""
+	}
+	return at + ": "
+}
@@ -0,0 +1,109 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateConfigFile(t *testing.T) {
+	for name, content := range equivalentConfigFiles {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), name)
+			require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+			assert.Empty(t, ValidateConfigFile(path))
+		})
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{
+			name: ".go-pre-commit.yml",
+			content: `enabled: yes please
+timout: 30
+exclude_patterns: vendor/
+checks:
+  lnit:
+    enabled: true
+  lint:
+    timeout: 0
+    enable: true
+`,
+			want: []string{
+				`checks.lint: unknown key "enable"`,
+				"checks.lint.timeout: must be at least 1 (got 0)",
+				`checks: unknown check "lnit"`,
+				"enabled: must be true or false",
+				"exclude_patterns: must be a list",
+				`unknown key "timout"`,
+			},
+		},
+		{
+			name:    ".go-pre-commit.toml",
+			content: "timeout = 1.5\ntimout = 30\n\n[checks.gitleaks]\ntimeout = -1\n",
+			want: []string{
+				"checks.gitleaks.timeout: must be at least 1 (got -1)",
+				"timeout: must be a whole number",
+				`unknown key "timout"`,
+			},
+		},
+		{
+			name:    ".go-pre-commit.json",
+			content: `{"timeout": "300", "exclude_patterns": ["vendor/", 7]}`,
+			want:    []string{"exclude_patterns[1]: must be a string", "timeout: must be a whole number"},
+		},
+		{
+			name:    ".go-pre-commit.yml",
+			content: "checks:\n  external:\n    - {name: lh.v2, command: ./lh.sh}\nexternal: []\n",
+			want: []string{
+				"checks.external[0].name: must match ^[a-z0-9-]+$ (got: 'lh.v2')",
+				`unknown key "external"`,
+			},
+		},
+		{
+			name:    ".go-pre-commit.yml",
+			content: "timeout: [300\n",
+			want:    []string{"failed to parse: yaml: line 1: did not find expected ',' or ']'"},
+		},
+		{name: ".go-pre-commit.yml", content: "# nothing set yet\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.name)
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+			assert.Equal(t, tt.want, ValidateConfigFile(path))
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	dir := configFileDir(t, map[string]string{".go-pre-commit.yml": "timeout: 300\n"})
+	cfg, problems := Check()
+	require.NotNil(t, cfg)
+	assert.Empty(t, problems)
+	assert.Equal(t, 300, cfg.Timeout)
+
+	configFile := filepath.Join(dir, ".go-pre-commit.yml")
+	require.NoError(t, os.WriteFile(configFile, []byte("timeout: 0\ntimout: 30\n"), 0o600))
+	t.Setenv("GO_PRE_COMMIT_TIMEOUT_SECONDS", "") // set from the config file by the first load
+	t.Setenv("GO_PRE_COMMIT_ENABLE_ENV_ACCESS", "true")
+	t.Setenv("GO_PRE_COMMIT_ENV_ACCESS_ALLOW", "internal/[config")
+	t.Setenv("GO_PRE_COMMIT_LOG_LEVEL", "loud")
+
+	cfg, problems = Check()
+	require.NotNil(t, cfg, "the env settings are still checked")
+	assert.Equal(t, []string{
+		configFile + ": timeout: must be at least 1 (got 0)",
+		configFile + `: unknown key "timout"`,
+		"GO_PRE_COMMIT_ENV_ACCESS_ALLOW has an invalid pattern 'internal/[config': syntax error in pattern",
+		"GO_PRE_COMMIT_LOG_LEVEL must be one of: trace, debug, info, warn, error (got: 'loud')",
+	}, problems)
+	assert.Equal(t, 720, cfg.Timeout, "the broken config file is left out")
+}
@@ -31,6 +31,16 @@ func Overload(filename string) error {
 	return loadFile(filename, true)
 }
 
+// Read parses a .env file into a map without touching the environment
+func Read(filename string) (map[string]string, error) {
+	// #nosec G304 - filename is provided by the caller, intentional file read
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %s: %w", filename, err)
+	}
+	return parse(string(data)), nil
+}
+
 // LoadDir loads all *.env files from dirPath in lexicographic sort order.
 // Each file overrides variables set by previous files (last wins).
 // If skipLocal is true, 99-local.env is skipped (CI environments).
@@ -129,6 +129,20 @@ func (s *EnvFileTestSuite) TestLoad_FileNotFound() {
 	s.Contains(err.Error(), "failed to read file")
 }
 
+// TestRead tests reading a file without modifying the environment
+func (s *EnvFileTestSuite) TestRead() {
+	envFile := filepath.Join(s.tempDir, "read.env")
+	s.Require().NoError(os.WriteFile(envFile, []byte("READ_ONLY_VAR=value # comment\n"), 0o600))
+
+	vars, err := Read(envFile)
+	s.Require().NoError(err)
+	s.Equal(map[string]string{"READ_ONLY_VAR": "value"}, vars)
+	s.Empty(os.Getenv("READ_ONLY_VAR"))
+
+	_, err = Read(filepath.Join(s.tempDir, "nonexistent.env"))
+	s.Require().Error(err)
+}
+
 // TestParse_EmptyLines tests parsing with empty lines
 func (s *EnvFileTestSuite) TestParse_EmptyLines() {
 	content := `
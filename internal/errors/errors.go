@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/output"
 )
 
 // Common errors
@@ -12,6 +14,10 @@ var (
 	// ErrChecksFailed is returned when one or more checks fail
 	ErrChecksFailed = errors.New("checks failed")
 
+	// ErrRequiredChecksNotRun is returned when a check listed in
+	// GO_PRE_COMMIT_REQUIRED_CHECKS was disabled or skipped
+	ErrRequiredChecksNotRun = errors.New("required checks did not run")
+
 	// ErrNoChecksToRun is returned when no checks are configured to run
 	ErrNoChecksToRun = errors.New("no checks to run")
 
@@ -21,6 +27,9 @@ var (
 	// ErrRepositoryRootNotFound is returned when git repository root cannot be determined
 	ErrRepositoryRootNotFound = errors.New("unable to determine repository root")
 
+	// ErrInvalidRepoRoot is returned when the --repo-root override is not an existing directory
+	ErrInvalidRepoRoot = errors.New("repository root override is not a directory")
+
 	// ErrToolNotFound is returned when a required tool is not available
 	ErrToolNotFound = errors.New("required tool not found")
 
@@ -48,6 +57,145 @@ var (
 	// ErrAIAttributionFound is returned when AI attribution is detected
 	ErrAIAttributionFound = errors.New("AI attribution detected")
 
+	// ErrBuildTagIssues is returned when files use legacy build constraint syntax
+	ErrBuildTagIssues = errors.New("legacy build tags found")
+
+	// ErrIndentationIssues is returned when Go files are indented with spaces
+	ErrIndentationIssues = errors.New("space indentation found in Go files")
+
+	// ErrEmptyCommit is returned when no staged changes remain after fixes
+	ErrEmptyCommit = errors.New("nothing staged to commit")
+
+	// ErrGoVersionMismatch is returned when modules declare different go directive versions
+	ErrGoVersionMismatch = errors.New("go directive versions differ across modules")
+
+	// ErrToolchainPolicy is returned when a go.mod toolchain directive breaks the configured policy
+	ErrToolchainPolicy = errors.New("go.mod toolchain directive violates policy")
+
+	// ErrModulePath is returned when a go.mod module path is not lowercase or lacks the configured prefix
+	ErrModulePath = errors.New("go.mod module path violates naming policy")
+
+	// ErrErrorComparison is returned when errors are compared to sentinels with == or !=
+	ErrErrorComparison = errors.New("sentinel errors compared with == or !=")
+
+	// ErrMissingTests is returned when changed Go packages have no test files
+	ErrMissingTests = errors.New("changed packages have no test files")
+
+	// ErrGeneratedCodeStale is returned when go generate changes committed files
+	ErrGeneratedCodeStale = errors.New("generated code is out of date")
+
+	// ErrLargeDiff is returned when a staged file changes more lines than allowed
+	ErrLargeDiff = errors.New("staged file diff exceeds line limit")
+
+	// ErrBuildArtifact is returned when coverage profiles, CPU profiles, or test binaries are staged
+	ErrBuildArtifact = errors.New("build artifacts staged for commit")
+
+	// ErrOSJunk is returned when .DS_Store, Thumbs.db, or similar operating system files are staged
+	ErrOSJunk = errors.New("operating system metadata files staged for commit")
+
+	// ErrContextNotFirst is returned when an exported function takes context.Context after another parameter
+	ErrContextNotFirst = errors.New("context.Context is not the first parameter")
+
+	// ErrForbiddenImport is returned when a Go file imports a package on the deny list
+	ErrForbiddenImport = errors.New("forbidden package imported")
+
+	// ErrStructTag is returned when a struct tag is malformed or uses a key outside the allowlist
+	ErrStructTag = errors.New("invalid struct tag")
+
+	// ErrMissingDocComment is returned when an exported symbol lacks a doc comment starting with its name
+	ErrMissingDocComment = errors.New("exported symbol missing doc comment")
+
+	// ErrReceiverName is returned when a method receiver name is inconsistent with its type's other methods or too long
+	ErrReceiverName = errors.New("inconsistent method receiver name")
+
+	// ErrFilePermissions is returned when a staged file is world-writable or has a git mode outside the allowlist
+	ErrFilePermissions = errors.New("staged file has disallowed permissions")
+
+	// ErrEmbeddedBlob is returned when a Go file embeds a large base64 string literal
+	ErrEmbeddedBlob = errors.New("large base64 blob embedded in source")
+
+	// ErrDataFormat is returned when a JSON or YAML file does not parse or does not match the formatting style
+	ErrDataFormat = errors.New("data file is not formatted")
+
+	// ErrGosecIssues is returned when gosec reports security findings
+	ErrGosecIssues = errors.New("gosec found security issues")
+
+	// ErrShellcheckIssues is returned when shellcheck reports findings in shell scripts
+	ErrShellcheckIssues = errors.New("shellcheck found issues")
+
+	// ErrHadolintIssues is returned when hadolint reports findings in Dockerfiles
+	ErrHadolintIssues = errors.New("hadolint found issues")
+
+	// ErrGoDirectiveTooNew is returned when a go.mod go directive is newer than the installed Go toolchain
+	ErrGoDirectiveTooNew = errors.New("go directive is newer than the installed go")
+
+	// ErrStubFunctions is returned when exported functions are still stubs
+	ErrStubFunctions = errors.New("stub functions found")
+
+	// ErrCommitTooLarge is returned when a commit stages more files than allowed
+	ErrCommitTooLarge = errors.New("too many staged files")
+
+	// ErrTestPackageMismatch is returned when a test file is in neither the
+	// package under test nor its _test package
+	ErrTestPackageMismatch = errors.New("test file package mismatch")
+
+	// ErrModPairMismatch is returned when go.mod or go.sum is staged without
+	// the other and the other needs to change too
+	ErrModPairMismatch = errors.New("go.mod and go.sum staged separately")
+
+	// ErrErrorNotWrapped is returned when fmt.Errorf formats an error with %v
+	// or %s instead of wrapping it with %w
+	ErrErrorNotWrapped = errors.New("error formatted without %w")
+
+	// ErrInternalImport is returned when a Go file imports an internal package
+	// of another module in the repository
+	ErrInternalImport = errors.New("another module's internal package imported")
+
+	// ErrLongFunctions is returned when functions exceed the length limits
+	ErrLongFunctions = errors.New("functions over the length limit")
+
+	// ErrEnvAccess is returned when environment variables are read outside
+	// the allowlisted packages
+	ErrEnvAccess = errors.New("environment read outside the config packages")
+
+	// ErrTestOnlyDeps is returned when a go.mod directly requires modules
+	// only tests import
+	ErrTestOnlyDeps = errors.New("direct requirements only imported by tests")
+
+	// ErrGlobalVars is returned when packages declare mutable global variables
+	ErrGlobalVars = errors.New("mutable global variables")
+
+	// ErrFileHeaderOrder is returned when Go file headers are out of order
+	ErrFileHeaderOrder = errors.New("file header out of order")
+
+	// ErrContextBackground is returned when library code starts a new context
+	// instead of propagating the caller's
+	ErrContextBackground = errors.New("context not propagated")
+
+	// ErrMajorVersionConflict is returned when a package imports two major
+	// versions of one module
+	ErrMajorVersionConflict = errors.New("major version conflict")
+
+	// ErrFilenameCase is returned when a staged path differs only in case
+	// from another path in the index
+	ErrFilenameCase = errors.New("filename case collision")
+
+	// ErrUnsatisfiableConstraint is returned when a file's build constraint
+	// is always false
+	ErrUnsatisfiableConstraint = errors.New("unsatisfiable build constraint")
+
+	// ErrMissingRepoFiles is returned when no file at the repository root
+	// matches a required file pattern
+	ErrMissingRepoFiles = errors.New("required repository files missing")
+
+	// ErrErrorVarNaming is returned when a package-level errors.New or
+	// fmt.Errorf variable is not named with an Err or err prefix
+	ErrErrorVarNaming = errors.New("error variable naming")
+
+	// ErrExternalCheckFindings is returned when an external check reports
+	// diagnostics
+	ErrExternalCheckFindings = errors.New("external check reported findings")
+
 	// ErrSecretsFound is returned when gitleaks finds secrets
 	ErrSecretsFound = errors.New("secrets found")
 
@@ -63,6 +211,9 @@ var (
 	// ErrTimeout is returned when an operation times out
 	ErrTimeout = errors.New("operation timed out")
 
+	// ErrFixDeclined is returned when the user declines an interactive auto-fix
+	ErrFixDeclined = errors.New("auto-fix declined")
+
 	// Git-related errors
 	ErrNotGitRepository      = errors.New("not a git repository")
 	ErrGitBaseCommitNotFound = errors.New("could not determine git base commit")
@@ -70,6 +221,7 @@ var (
 	ErrPreCommitDirNotExist  = errors.New("pre-commit directory does not exist")
 	ErrHookNotExecutable     = errors.New("hook file is not executable")
 	ErrHookMarkerMissing     = errors.New("installed hook does not contain expected marker")
+	ErrGitIndexLocked        = errors.New("git index is locked by another git process")
 )
 
 // CheckError represents an enhanced error with context and suggestions
@@ -94,6 +246,16 @@ type CheckError struct {
 
 	// Whether this error allows graceful degradation
 	CanSkip bool
+
+	// Whether the findings are advisory and must not block the commit
+	WarnOnly bool
+
+	// Whether the check rewrote the files to resolve every finding, so the
+	// only thing left to do is review and re-stage them
+	Fixed bool
+
+	// Findings parsed from the tool output, for structured reporters
+	Diagnostics []output.Diagnostic
 }
 
 // TimeoutError represents a timeout error with detailed context
@@ -198,6 +360,28 @@ func NewToolExecutionError(command, output, suggestion string) *CheckError {
 	}
 }
 
+// NewFixedError creates an error for a check that fixed every finding in
+// files itself; the failure only asks for the changes to be re-staged
+func NewFixedError(err error, files []string) *CheckError {
+	return &CheckError{
+		Err:   err,
+		Files: files,
+		Fixed: true,
+	}
+}
+
+// NewWarnOnlyFixedError creates an error for a fix that is advisory: the
+// files were rewritten, but the commit is not blocked
+func NewWarnOnlyFixedError(err error, message string, files []string) *CheckError {
+	return &CheckError{
+		Err:      err,
+		Message:  message,
+		Files:    files,
+		Fixed:    true,
+		WarnOnly: true,
+	}
+}
+
 // NewGracefulSkipError creates an error for gracefully skipped checks
 func NewGracefulSkipError(reason string) *CheckError {
 	return &CheckError{
@@ -248,6 +432,88 @@ func NewCheckTimeoutError(checkName string, timeout, elapsed time.Duration) *Tim
 		configVar = "GO_PRE_COMMIT_EOF_TIMEOUT"
 	case "gitleaks":
 		configVar = "GO_PRE_COMMIT_GITLEAKS_TIMEOUT"
+	case "build-tags":
+		configVar = "GO_PRE_COMMIT_BUILD_TAGS_TIMEOUT"
+	case "go-indent":
+		configVar = "GO_PRE_COMMIT_GO_INDENT_TIMEOUT"
+	case "empty-commit":
+		configVar = "GO_PRE_COMMIT_EMPTY_COMMIT_TIMEOUT"
+	case "go-version":
+		configVar = "GO_PRE_COMMIT_GO_VERSION_TIMEOUT"
+	case "error-compare":
+		configVar = "GO_PRE_COMMIT_ERROR_COMPARE_TIMEOUT"
+	case "test-presence":
+		configVar = "GO_PRE_COMMIT_TEST_PRESENCE_TIMEOUT"
+	case "go-generate":
+		configVar = "GO_PRE_COMMIT_GO_GENERATE_TIMEOUT"
+	case "large-diffs":
+		configVar = "GO_PRE_COMMIT_LARGE_DIFFS_TIMEOUT"
+	case "toolchain":
+		configVar = "GO_PRE_COMMIT_TOOLCHAIN_TIMEOUT"
+	case "module-path":
+		configVar = "GO_PRE_COMMIT_MODULE_PATH_TIMEOUT"
+	case "build-artifacts":
+		configVar = "GO_PRE_COMMIT_BUILD_ARTIFACTS_TIMEOUT"
+	case "os-junk":
+		configVar = "GO_PRE_COMMIT_OS_JUNK_TIMEOUT"
+	case "context-first":
+		configVar = "GO_PRE_COMMIT_CONTEXT_FIRST_TIMEOUT"
+	case "forbidden-imports":
+		configVar = "GO_PRE_COMMIT_FORBIDDEN_IMPORTS_TIMEOUT"
+	case "struct-tags":
+		configVar = "GO_PRE_COMMIT_STRUCT_TAGS_TIMEOUT"
+	case "doc-comments":
+		configVar = "GO_PRE_COMMIT_DOC_COMMENTS_TIMEOUT"
+	case "receiver-names":
+		configVar = "GO_PRE_COMMIT_RECEIVER_NAMES_TIMEOUT"
+	case "file-permissions":
+		configVar = "GO_PRE_COMMIT_FILE_PERMISSIONS_TIMEOUT"
+	case "embedded-blobs":
+		configVar = "GO_PRE_COMMIT_EMBEDDED_BLOBS_TIMEOUT"
+	case "data-format":
+		configVar = "GO_PRE_COMMIT_DATA_FORMAT_TIMEOUT"
+	case "gosec":
+		configVar = "GO_PRE_COMMIT_GOSEC_TIMEOUT"
+	case "shellcheck":
+		configVar = "GO_PRE_COMMIT_SHELLCHECK_TIMEOUT"
+	case "hadolint":
+		configVar = "GO_PRE_COMMIT_HADOLINT_TIMEOUT"
+	case "go-directive":
+		configVar = "GO_PRE_COMMIT_GO_DIRECTIVE_TIMEOUT"
+	case "stub-funcs":
+		configVar = "GO_PRE_COMMIT_STUB_FUNCS_TIMEOUT"
+	case "commit-size":
+		configVar = "GO_PRE_COMMIT_COMMIT_SIZE_TIMEOUT"
+	case "test-package":
+		configVar = "GO_PRE_COMMIT_TEST_PACKAGE_TIMEOUT"
+	case "mod-pair":
+		configVar = "GO_PRE_COMMIT_MOD_PAIR_TIMEOUT"
+	case "error-wrap":
+		configVar = "GO_PRE_COMMIT_ERROR_WRAP_TIMEOUT"
+	case "internal-import":
+		configVar = "GO_PRE_COMMIT_INTERNAL_IMPORT_TIMEOUT"
+	case "func-length":
+		configVar = "GO_PRE_COMMIT_FUNC_LENGTH_TIMEOUT"
+	case "env-access":
+		configVar = "GO_PRE_COMMIT_ENV_ACCESS_TIMEOUT"
+	case "test-only-deps":
+		configVar = "GO_PRE_COMMIT_TEST_ONLY_DEPS_TIMEOUT"
+	case "global-vars":
+		configVar = "GO_PRE_COMMIT_GLOBAL_VARS_TIMEOUT"
+	case "file-header-order":
+		configVar = "GO_PRE_COMMIT_FILE_HEADER_ORDER_TIMEOUT"
+	case "context-background":
+		configVar = "GO_PRE_COMMIT_CONTEXT_BACKGROUND_TIMEOUT"
+	case "major-version-conflict":
+		configVar = "GO_PRE_COMMIT_MAJOR_VERSION_CONFLICT_TIMEOUT"
+	case "filename-case":
+		configVar = "GO_PRE_COMMIT_FILENAME_CASE_TIMEOUT"
+	case "build-constraints":
+		configVar = "GO_PRE_COMMIT_BUILD_CONSTRAINTS_TIMEOUT"
+	case "repo-files":
+		configVar = "GO_PRE_COMMIT_REPO_FILES_TIMEOUT"
+	case "error-var-naming":
+		configVar = "GO_PRE_COMMIT_ERROR_VAR_NAMING_TIMEOUT"
 	}
 
 	return NewTimeoutError("Check execution", checkName, timeout, elapsed, configVar)
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"unicode/utf8"
 
@@ -18,6 +19,23 @@ const (
 	fileTypeUnknown = "unknown"
 )
 
+// Languages DetectLanguage reports that checks select files by
+const (
+	LanguageShell    = fileTypeShell
+	LanguageDocker   = "docker"
+	LanguageMarkdown = "markdown"
+)
+
+// Reasons a classified file is not a plain checkable text file
+const (
+	ReasonExcludedPattern = "excluded-pattern" // matches a default or configured exclude pattern
+	ReasonExcludedDir     = "excluded-dir"     // under a directory in GO_PRE_COMMIT_EXCLUDE_DIRS or --exclude-dir
+	ReasonDotfile         = "dotfile"          // hidden path skipped by GO_PRE_COMMIT_SKIP_DOTFILES
+	ReasonTooLarge        = "too-large"        // larger than GO_PRE_COMMIT_MAX_FILE_SIZE_MB
+	ReasonGenerated       = "generated"        // generated code
+	ReasonBinary          = "binary"           // binary content
+)
+
 // FileClassifier provides intelligent file classification and filtering
 type FileClassifier struct {
 	config *config.Config
@@ -38,6 +56,13 @@ type FileInfo struct {
 	IsGoFile  bool
 	Generated bool
 	Excluded  bool
+	Reason    string // why the file is excluded, generated, or binary; empty for plain text
+
+	// ExcludeReason explains an excluded file, such as "matched exclude
+	// pattern vendor/"; SkipReason explains a generated or binary file,
+	// such as "generated marker" or "binary content"
+	ExcludeReason string
+	SkipReason    string
 }
 
 // ClassifyFiles analyzes and classifies a list of files
@@ -121,6 +146,18 @@ func (fc *FileClassifier) ExcludeByPatterns(files, patterns []string) []string {
 	return filtered
 }
 
+// FilterExcluded removes files matching the default or configured exclude
+// patterns used during classification
+func (fc *FileClassifier) FilterExcluded(files []string) []string {
+	filtered := make([]string, 0, len(files))
+	for _, file := range files {
+		if !fc.isExcludedPath(file) && !fc.IsSkippedDotfile(file) {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
 // classifyFile analyzes a single file
 func (fc *FileClassifier) classifyFile(filePath string) (FileInfo, error) {
 	info := FileInfo{
@@ -138,24 +175,48 @@ func (fc *FileClassifier) classifyFile(filePath string) (FileInfo, error) {
 	// Classify by extension and name (always do this regardless of size)
 	info.IsGoFile = fc.isGoFile(filePath)
 	info.Language = fc.detectLanguage(filePath)
-	info.Generated = fc.isGeneratedFile(filePath)
-	info.Excluded = fc.isExcludedPath(filePath)
+	info.SkipReason = fc.generatedReason(filePath)
+	info.Generated = info.SkipReason != ""
+	if pattern := fc.excludePattern(filePath); pattern != "" {
+		info.Excluded = true
+		info.ExcludeReason = "matched exclude pattern " + pattern
+	}
 
 	// Check if file exceeds size limit
 	if fc.config != nil && info.Size > fc.config.MaxFileSize {
+		info.Reason = ReasonTooLarge
+		if info.Excluded {
+			info.Reason = ReasonExcludedPattern
+		} else {
+			info.ExcludeReason = fmt.Sprintf("size %d bytes exceeds the %d byte limit", info.Size, fc.config.MaxFileSize)
+		}
 		info.Excluded = true
 		return info, nil
 	}
 
-	// Detect if file is text or binary
+	// Detect if file is text or binary. A known text extension wins over
+	// content sniffing, so a .proto with a few stray control bytes stays text.
 	if info.Size > 0 && info.Size < 1024*1024 { // Only check files up to 1MB
-		content, err := fc.readFileHead(filePath, 512) // Read first 512 bytes
-		if err == nil {
+		if info.Language != fileTypeUnknown && !fc.sniffsContent(filePath) {
+			info.IsText = true
+		} else if content, err := fc.readFileHead(filePath, 512); err == nil { // Read first 512 bytes
 			info.IsText = fc.isTextContent(content)
 			info.IsBinary = !info.IsText
 		}
 	}
 
+	switch {
+	case info.Excluded:
+		info.Reason = ReasonExcludedPattern
+	case info.Generated:
+		info.Reason = ReasonGenerated
+	case info.IsBinary:
+		info.Reason = ReasonBinary
+	}
+	if info.SkipReason == "" && info.IsBinary {
+		info.SkipReason = "binary content"
+	}
+
 	return info, nil
 }
 
@@ -178,52 +239,53 @@ func (fc *FileClassifier) detectLanguage(filePath string) string {
 	ext := strings.ToLower(filepath.Ext(filePath))
 
 	languageMap := map[string]string{
-		".go":    "go",
-		".mod":   "go-mod",
-		".sum":   "go-sum",
-		".py":    "python",
-		".js":    "javascript",
-		".ts":    "typescript",
-		".jsx":   "javascript",
-		".tsx":   "typescript",
-		".java":  "java",
-		".c":     "c",
-		".cpp":   "cpp",
-		".cc":    "cpp",
-		".cxx":   "cpp",
-		".h":     "c",
-		".hpp":   "cpp",
-		".rs":    "rust",
-		".rb":    "ruby",
-		".php":   "php",
-		".swift": "swift",
-		".kt":    "kotlin",
-		".scala": "scala",
-		".cs":    "csharp",
-		".sh":    fileTypeShell,
-		".bash":  fileTypeShell,
-		".zsh":   fileTypeShell,
-		".fish":  fileTypeShell,
-		".ps1":   "powershell",
-		".sql":   "sql",
-		".md":    "markdown",
-		".txt":   "text",
-		".yml":   "yaml",
-		".yaml":  "yaml",
-		".json":  "json",
-		".xml":   "xml",
-		".html":  "html",
-		".htm":   "html",
-		".css":   "css",
-		".scss":  "scss",
-		".sass":  "sass",
-		".less":  "less",
-		".proto": "protobuf",
-		".toml":  "toml",
-		".ini":   "ini",
-		".cfg":   "config",
-		".conf":  "config",
-		".env":   "env",
+		".go":         "go",
+		".mod":        "go-mod",
+		".sum":        "go-sum",
+		".py":         "python",
+		".js":         "javascript",
+		".ts":         "typescript",
+		".jsx":        "javascript",
+		".tsx":        "typescript",
+		".java":       "java",
+		".c":          "c",
+		".cpp":        "cpp",
+		".cc":         "cpp",
+		".cxx":        "cpp",
+		".h":          "c",
+		".hpp":        "cpp",
+		".rs":         "rust",
+		".rb":         "ruby",
+		".php":        "php",
+		".swift":      "swift",
+		".kt":         "kotlin",
+		".scala":      "scala",
+		".cs":         "csharp",
+		".sh":         fileTypeShell,
+		".bash":       fileTypeShell,
+		".zsh":        fileTypeShell,
+		".fish":       fileTypeShell,
+		".ps1":        "powershell",
+		".sql":        "sql",
+		".md":         LanguageMarkdown,
+		".txt":        "text",
+		".yml":        "yaml",
+		".yaml":       "yaml",
+		".json":       "json",
+		".xml":        "xml",
+		".html":       "html",
+		".htm":        "html",
+		".css":        "css",
+		".scss":       "scss",
+		".sass":       "sass",
+		".less":       "less",
+		".proto":      "protobuf",
+		".toml":       "toml",
+		".ini":        "ini",
+		".cfg":        "config",
+		".conf":       "config",
+		".env":        "env",
+		".dockerfile": LanguageDocker,
 	}
 
 	if lang, exists := languageMap[ext]; exists {
@@ -234,23 +296,48 @@ func (fc *FileClassifier) detectLanguage(filePath string) string {
 	base := strings.ToLower(filepath.Base(filePath))
 	specialFiles := map[string]string{
 		"makefile":      "make",
-		"dockerfile":    "docker",
+		"dockerfile":    LanguageDocker,
+		"containerfile": LanguageDocker,
 		"jenkinsfile":   "groovy",
 		"vagrantfile":   "ruby",
 		".gitignore":    "gitignore",
 		".dockerignore": "dockerignore",
 		".editorconfig": "editorconfig",
+		"go.work":       "go-mod",
 	}
 
 	if lang, exists := specialFiles[base]; exists {
 		return lang
 	}
 
+	// Variants such as Dockerfile.prod
+	if strings.HasPrefix(base, "dockerfile.") || strings.HasPrefix(base, "containerfile.") {
+		return LanguageDocker
+	}
+
 	return fileTypeUnknown
 }
 
+// DetectLanguage returns the language of filePath judged by its extension
+// or well-known name, or "unknown"
+func (fc *FileClassifier) DetectLanguage(filePath string) string {
+	return fc.detectLanguage(filePath)
+}
+
+// IsGenerated reports whether filePath is generated code, judged by its name
+// or a generated-code marker near the top of a Go file
+func (fc *FileClassifier) IsGenerated(filePath string) bool {
+	return fc.isGeneratedFile(filePath)
+}
+
 // isGeneratedFile checks if a file is generated code
 func (fc *FileClassifier) isGeneratedFile(filePath string) bool {
+	return fc.generatedReason(filePath) != ""
+}
+
+// generatedReason describes why a file is generated code, or returns an
+// empty string when it is not
+func (fc *FileClassifier) generatedReason(filePath string) string {
 	// Common generated file patterns
 	generatedPatterns := []string{
 		"*.pb.go",         // Protocol buffer generated files
@@ -275,18 +362,18 @@ func (fc *FileClassifier) isGeneratedFile(filePath string) bool {
 	fileName := filepath.Base(filePath)
 	for _, pattern := range generatedPatterns {
 		if fc.matchesPattern(fileName, pattern) {
-			return true
+			return "generated file name pattern " + pattern
 		}
 	}
 
 	// Check for generated file markers in the first few lines
 	if strings.HasSuffix(filePath, ".go") {
 		if fc.hasGeneratedMarker(filePath) {
-			return true
+			return "generated marker"
 		}
 	}
 
-	return false
+	return ""
 }
 
 // hasGeneratedMarker checks if a Go file has standard generated file markers
@@ -324,6 +411,12 @@ func (fc *FileClassifier) hasGeneratedMarker(filePath string) bool {
 
 // isExcludedPath checks if a file path should be excluded
 func (fc *FileClassifier) isExcludedPath(filePath string) bool {
+	return fc.excludePattern(filePath) != ""
+}
+
+// excludePattern returns the default or configured exclude pattern filePath
+// matches, or an empty string when it matches none
+func (fc *FileClassifier) excludePattern(filePath string) string {
 	// Default exclude patterns
 	defaultExcludes := []string{
 		"vendor/",
@@ -354,7 +447,7 @@ func (fc *FileClassifier) isExcludedPath(filePath string) bool {
 	// Check default excludes
 	for _, pattern := range defaultExcludes {
 		if fc.matchesPattern(filePath, pattern) {
-			return true
+			return pattern
 		}
 	}
 
@@ -362,14 +455,51 @@ func (fc *FileClassifier) isExcludedPath(filePath string) bool {
 	if fc.config != nil {
 		for _, pattern := range fc.config.Git.ExcludePatterns {
 			if fc.matchesPattern(filePath, pattern) {
-				return true
+				return pattern
 			}
 		}
 	}
 
+	return ""
+}
+
+// IsSkippedDotfile reports whether GO_PRE_COMMIT_SKIP_DOTFILES excludes a
+// repository-relative path: a hidden file, or any file under a hidden
+// directory, that does not match GO_PRE_COMMIT_DOTFILE_INCLUDES
+func (fc *FileClassifier) IsSkippedDotfile(filePath string) bool {
+	if fc.config == nil || !fc.config.Git.SkipDotfiles || !isHiddenPath(filePath) {
+		return false
+	}
+
+	slashPath := filepath.ToSlash(filePath)
+	for _, pattern := range fc.config.Git.DotfileIncludes {
+		if fc.matchesPattern(slashPath, pattern) || fc.matchesPattern(filepath.Base(filePath), pattern) {
+			return false
+		}
+	}
+	return true
+}
+
+// isHiddenPath reports whether any element of filePath starts with a dot
+func isHiddenPath(filePath string) bool {
+	for _, part := range strings.Split(filepath.ToSlash(filepath.Clean(filePath)), "/") {
+		if strings.HasPrefix(part, ".") && part != "." && part != ".." {
+			return true
+		}
+	}
 	return false
 }
 
+// sniffsContent reports whether filePath has an extension listed in
+// GO_PRE_COMMIT_CONTENT_SNIFF_EXTENSIONS, whose content decides between text
+// and binary even though the extension is a known text type
+func (fc *FileClassifier) sniffsContent(filePath string) bool {
+	if fc.config == nil {
+		return false
+	}
+	return slices.Contains(fc.config.Git.ContentSniffExtensions, strings.ToLower(filepath.Ext(filePath)))
+}
+
 // isTextContent determines if content is text or binary
 func (fc *FileClassifier) isTextContent(content []byte) bool {
 	// Empty files are considered text
@@ -407,6 +537,11 @@ func (fc *FileClassifier) isTextContent(content []byte) bool {
 	return true
 }
 
+// ReadFileHead returns up to the first n bytes of the file at filePath
+func (fc *FileClassifier) ReadFileHead(filePath string, n int) ([]byte, error) {
+	return fc.readFileHead(filePath, n)
+}
+
 // readFileHead reads the first n bytes of a file
 func (fc *FileClassifier) readFileHead(filePath string, n int) ([]byte, error) {
 	file, err := os.Open(filePath) //nolint:gosec // File path from git
@@ -550,3 +685,30 @@ func (fc *FileClassifier) GetFileStats(ctx context.Context, files []string) (map
 
 	return stats, nil
 }
+
+// FormatStats renders GetFileStats counts one per line as "name: count" in a
+// stable order, so reports are reproducible: total, text, binary, go,
+// generated, and excluded (0 when absent), then the "lang_" counts sorted by
+// language, then any other keys sorted by name.
+func (fc *FileClassifier) FormatStats(stats map[string]int) string {
+	fixed := []string{"total", "text", "binary", "go", "generated", "excluded"}
+
+	var languages, others []string
+	for key := range stats {
+		switch {
+		case slices.Contains(fixed, key):
+		case strings.HasPrefix(key, "lang_"):
+			languages = append(languages, key)
+		default:
+			others = append(others, key)
+		}
+	}
+	slices.Sort(languages)
+	slices.Sort(others)
+
+	var b strings.Builder
+	for _, key := range slices.Concat(fixed, languages, others) {
+		fmt.Fprintf(&b, "%s: %d\n", key, stats[key])
+	}
+	return b.String()
+}
@@ -128,10 +128,15 @@ func TestDetectLanguage(t *testing.T) {
 		{"Makefile lower", "makefile", "make"},
 		{"Dockerfile", "Dockerfile", "docker"},
 		{"Dockerfile lower", "dockerfile", "docker"},
+		{"Dockerfile variant", "deploy/Dockerfile.prod", "docker"},
+		{"Dockerfile extension", "api.dockerfile", "docker"},
+		{"Containerfile", "Containerfile", "docker"},
 		{"Jenkinsfile", "Jenkinsfile", "groovy"},
 		{"Jenkinsfile lower", "jenkinsfile", "groovy"},
 		{"Vagrantfile", "Vagrantfile", "ruby"},
 		{"Vagrantfile lower", "vagrantfile", "ruby"},
+		{"Go workspace", "go.work", "go-mod"},
+		{"Go workspace sum", "go.work.sum", "go-sum"},
 		{"Gitignore", ".gitignore", "gitignore"},
 		{"Dockerignore", ".dockerignore", "dockerignore"},
 		{"Editorconfig", ".editorconfig", "editorconfig"},
@@ -219,6 +224,7 @@ func TestIsGeneratedFile(t *testing.T) {
 
 			result := fc.isGeneratedFile(filePath)
 			assert.Equal(t, tt.generated, result, "File: %s", tt.fileName)
+			assert.Equal(t, result, fc.IsGenerated(filePath))
 		})
 	}
 }
@@ -267,8 +273,14 @@ func TestIsExcludedPath(t *testing.T) {
 			"build/output.txt",
 			&config.Config{
 				Git: struct {
-					HooksPath       string
-					ExcludePatterns []string
+					HooksPath              string
+					ExcludePatterns        []string
+					SkipDotfiles           bool
+					DotfileIncludes        []string
+					ContentSniffExtensions []string
+					Backend                string
+					ExcludeDirs            []string
+					LockAttempts           int
 				}{
 					HooksPath:       ".git/hooks",
 					ExcludePatterns: []string{"build/"},
@@ -281,8 +293,14 @@ func TestIsExcludedPath(t *testing.T) {
 			"test.custom",
 			&config.Config{
 				Git: struct {
-					HooksPath       string
-					ExcludePatterns []string
+					HooksPath              string
+					ExcludePatterns        []string
+					SkipDotfiles           bool
+					DotfileIncludes        []string
+					ContentSniffExtensions []string
+					Backend                string
+					ExcludeDirs            []string
+					LockAttempts           int
 				}{
 					HooksPath:       ".git/hooks",
 					ExcludePatterns: []string{"*.custom", "dist/"},
@@ -301,6 +319,52 @@ func TestIsExcludedPath(t *testing.T) {
 	}
 }
 
+// TestIsSkippedDotfile tests the GO_PRE_COMMIT_SKIP_DOTFILES option and its includes
+func TestIsSkippedDotfile(t *testing.T) {
+	skipping := &config.Config{}
+	skipping.Git.SkipDotfiles = true
+	skipping.Git.DotfileIncludes = []string{".github/", ".golangci.yml"}
+
+	tests := []struct {
+		name    string
+		path    string
+		config  *config.Config
+		skipped bool
+	}{
+		{"Default keeps dotfile", ".editorconfig", nil, false},
+		{"Option off keeps dotfile", ".editorconfig", &config.Config{}, false},
+		{"Hidden file", ".editorconfig", skipping, true},
+		{"Nested hidden file", "pkg/.env.example", skipping, true},
+		{"File in hidden dir", ".vscode/settings.json", skipping, true},
+		{"Included dir", ".github/workflows/ci.yml", skipping, false},
+		{"Included file", ".golangci.yml", skipping, false},
+		{"Included file nested", "tools/.golangci.yml", skipping, false},
+		{"Regular file", "internal/git/files.go", skipping, false},
+		{"Relative prefix", "./main.go", skipping, false},
+		{"Parent prefix", "../other/main.go", skipping, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.skipped, NewFileClassifier(tt.config).IsSkippedDotfile(tt.path))
+		})
+	}
+}
+
+// TestFilterExcludedSkipsDotfiles tests that FilterExcluded honors the dotfile option
+func TestFilterExcludedSkipsDotfiles(t *testing.T) {
+	cfg := &config.Config{}
+	files := []string{".editorconfig", ".github/dependabot.yml", testFileMainGo}
+
+	assert.Equal(t, files, NewFileClassifier(cfg).FilterExcluded(files))
+
+	cfg.Git.SkipDotfiles = true
+	assert.Equal(t, []string{testFileMainGo}, NewFileClassifier(cfg).FilterExcluded(files))
+
+	cfg.Git.DotfileIncludes = []string{".github/"}
+	assert.Equal(t, []string{".github/dependabot.yml", testFileMainGo}, NewFileClassifier(cfg).FilterExcluded(files))
+}
+
 // TestIsTextContent tests text vs binary detection
 func TestIsTextContent(t *testing.T) {
 	fc := NewFileClassifier(nil)
@@ -340,6 +404,43 @@ func TestIsTextContent(t *testing.T) {
 	}
 }
 
+// TestClassifyFileKnownExtensionWinsOverContent tests that known text
+// extensions are not classified binary by content sniffing unless listed in
+// GO_PRE_COMMIT_CONTENT_SNIFF_EXTENSIONS
+func TestClassifyFileKnownExtensionWinsOverContent(t *testing.T) {
+	dir := t.TempDir()
+	content := append(bytes.Repeat([]byte{0x01}, 35), bytes.Repeat([]byte("a"), 65)...) // 35% control characters
+	for _, name := range []string{"notes.txt", "schema.proto", "blob.dat"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), content, 0o600))
+	}
+
+	cfg := &config.Config{MaxFileSize: 10 * 1024 * 1024}
+	fc := NewFileClassifier(cfg)
+
+	classify := func(name string) FileInfo {
+		info, err := fc.classifyFile(filepath.Join(dir, name))
+		require.NoError(t, err)
+		return info
+	}
+
+	for _, name := range []string{"notes.txt", "schema.proto"} {
+		info := classify(name)
+		assert.True(t, info.IsText, name)
+		assert.False(t, info.IsBinary, name)
+		assert.Empty(t, info.Reason, name)
+	}
+
+	info := classify("blob.dat")
+	assert.True(t, info.IsBinary, "unknown extensions are still sniffed")
+	assert.Equal(t, ReasonBinary, info.Reason)
+
+	cfg.Git.ContentSniffExtensions = []string{".txt"}
+	info = classify("notes.txt")
+	assert.True(t, info.IsBinary, "listed extensions trust the content")
+	assert.Equal(t, ReasonBinary, info.Reason)
+	assert.True(t, classify("schema.proto").IsText)
+}
+
 // TestMatchesPattern tests pattern matching
 func TestMatchesPattern(t *testing.T) {
 	fc := NewFileClassifier(nil)
@@ -533,13 +634,30 @@ func TestClassifyFile(t *testing.T) {
 			fileName: "types_string.go",
 			content:  "// Code generated by stringer. DO NOT EDIT.\npackage main",
 			expected: FileInfo{
-				Path:      filepath.Join(tempDir, "types_string.go"),
-				IsText:    true,
-				IsBinary:  false,
-				Language:  "go",
-				IsGoFile:  true,
-				Generated: true,
-				Excluded:  false,
+				Path:       filepath.Join(tempDir, "types_string.go"),
+				IsText:     true,
+				IsBinary:   false,
+				Language:   "go",
+				IsGoFile:   true,
+				Generated:  true,
+				Excluded:   false,
+				Reason:     ReasonGenerated,
+				SkipReason: "generated file name pattern *_string.go",
+			},
+			createFile: true,
+		},
+		{
+			name:     "Generated marker",
+			fileName: "tables.go",
+			content:  "// Code generated by go-bindata. DO NOT EDIT.\npackage main",
+			expected: FileInfo{
+				Path:       filepath.Join(tempDir, "tables.go"),
+				IsText:     true,
+				Language:   "go",
+				IsGoFile:   true,
+				Generated:  true,
+				Reason:     ReasonGenerated,
+				SkipReason: "generated marker",
 			},
 			createFile: true,
 		},
@@ -548,13 +666,15 @@ func TestClassifyFile(t *testing.T) {
 			fileName: "binary.dat",
 			content:  string([]byte{0x00, 0x01, 0x02, 0x03, 0xFF}),
 			expected: FileInfo{
-				Path:      filepath.Join(tempDir, "binary.dat"),
-				IsText:    false,
-				IsBinary:  true,
-				Language:  "unknown",
-				IsGoFile:  false,
-				Generated: false,
-				Excluded:  false,
+				Path:       filepath.Join(tempDir, "binary.dat"),
+				IsText:     false,
+				IsBinary:   true,
+				Language:   "unknown",
+				IsGoFile:   false,
+				Generated:  false,
+				Excluded:   false,
+				Reason:     ReasonBinary,
+				SkipReason: "binary content",
 			},
 			createFile: true,
 		},
@@ -563,13 +683,34 @@ func TestClassifyFile(t *testing.T) {
 			fileName: "file.tmp",
 			content:  "temporary",
 			expected: FileInfo{
-				Path:      filepath.Join(tempDir, "file.tmp"),
-				IsText:    true,
-				IsBinary:  false,
-				Language:  "unknown",
-				IsGoFile:  false,
-				Generated: false,
-				Excluded:  true,
+				Path:          filepath.Join(tempDir, "file.tmp"),
+				IsText:        true,
+				IsBinary:      false,
+				Language:      "unknown",
+				IsGoFile:      false,
+				Generated:     false,
+				Excluded:      true,
+				Reason:        ReasonExcludedPattern,
+				ExcludeReason: "matched exclude pattern *.tmp",
+			},
+			createFile: true,
+		},
+		{
+			name:     "Configured exclude pattern",
+			fileName: "fixture.txt",
+			content:  "fixture\n",
+			config: func() *config.Config {
+				cfg := &config.Config{MaxFileSize: 1024}
+				cfg.Git.ExcludePatterns = []string{"fixture"}
+				return cfg
+			}(),
+			expected: FileInfo{
+				Path:          filepath.Join(tempDir, "fixture.txt"),
+				IsText:        true,
+				Language:      "text",
+				Excluded:      true,
+				Reason:        ReasonExcludedPattern,
+				ExcludeReason: "matched exclude pattern fixture",
 			},
 			createFile: true,
 		},
@@ -581,9 +722,11 @@ func TestClassifyFile(t *testing.T) {
 				MaxFileSize: 10,
 			},
 			expected: FileInfo{
-				Path:     filepath.Join(tempDir, "large.txt"),
-				Excluded: true,
-				Language: "text",
+				Path:          filepath.Join(tempDir, "large.txt"),
+				Excluded:      true,
+				Language:      "text",
+				Reason:        ReasonTooLarge,
+				ExcludeReason: "size 13 bytes exceeds the 10 byte limit",
 			},
 			createFile: true,
 		},
@@ -625,6 +768,9 @@ func TestClassifyFile(t *testing.T) {
 			assert.Equal(t, tt.expected.IsGoFile, result.IsGoFile)
 			assert.Equal(t, tt.expected.Generated, result.Generated)
 			assert.Equal(t, tt.expected.Excluded, result.Excluded)
+			assert.Equal(t, tt.expected.Reason, result.Reason)
+			assert.Equal(t, tt.expected.ExcludeReason, result.ExcludeReason)
+			assert.Equal(t, tt.expected.SkipReason, result.SkipReason)
 			assert.Equal(t, tt.expected.Size, result.Size)
 		})
 	}
@@ -951,6 +1097,37 @@ func TestGetFileStats(t *testing.T) {
 	assert.False(t, hasUnknown)
 }
 
+// TestFormatStats tests that stats render in the documented order every time
+func TestFormatStats(t *testing.T) {
+	fc := NewFileClassifier(nil)
+	stats := map[string]int{
+		"lang_python":   1,
+		"excluded":      2,
+		"lang_go":       4,
+		"total":         9,
+		"go":            4,
+		"text":          7,
+		"lang_markdown": 1,
+		"custom":        3,
+	}
+
+	want := "total: 9\n" +
+		"text: 7\n" +
+		"binary: 0\n" +
+		"go: 4\n" +
+		"generated: 0\n" +
+		"excluded: 2\n" +
+		"lang_go: 4\n" +
+		"lang_markdown: 1\n" +
+		"lang_python: 1\n" +
+		"custom: 3\n"
+
+	// Map iteration order varies between runs; the output must not
+	for range 50 {
+		assert.Equal(t, want, fc.FormatStats(stats))
+	}
+}
+
 // TestHasGeneratedMarker tests generated file marker detection
 func TestHasGeneratedMarker(t *testing.T) {
 	tempDir := t.TempDir()
@@ -1196,8 +1373,14 @@ func TestFileClassifierIntegration(t *testing.T) {
 	cfg := &config.Config{
 		MaxFileSize: 1024 * 1024, // 1MB
 		Git: struct {
-			HooksPath       string
-			ExcludePatterns []string
+			HooksPath              string
+			ExcludePatterns        []string
+			SkipDotfiles           bool
+			DotfileIncludes        []string
+			ContentSniffExtensions []string
+			Backend                string
+			ExcludeDirs            []string
+			LockAttempts           int
 		}{
 			HooksPath:       ".git/hooks",
 			ExcludePatterns: []string{"test-data/"},
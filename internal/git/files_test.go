@@ -174,6 +174,13 @@ func (ts *FileClassifierTestSuite) TestExcludeByPatternsEmptyPatterns() {
 	ts.Require().Equal(files, filtered)
 }
 
+func (ts *FileClassifierTestSuite) TestFilterExcluded() {
+	files := []string{testFileMainGo, "debug.log", "vendor/lib.go", "data.excluded", "excluded/a.go", "docs/readme.md"}
+
+	filtered := ts.classifier.FilterExcluded(files)
+	ts.Require().Equal([]string{testFileMainGo, "docs/readme.md"}, filtered)
+}
+
 func (ts *FileClassifierTestSuite) TestIsGoFile() {
 	tests := []struct {
 		name     string
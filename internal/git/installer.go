@@ -9,8 +9,13 @@ import (
 
 	"github.com/mrz1836/go-pre-commit/internal/config"
 	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
 )
 
+// HookMarkerEnv is set by the installed hook script so go-pre-commit can
+// tell a run started by git from one started by hand
+const HookMarkerEnv = "GO_PRE_COMMIT_FROM_HOOK"
+
 // hookScriptTemplate is the template for generating git hook scripts
 const hookScriptTemplate = `#!/bin/bash
 # Go Pre-commit Hook
@@ -81,7 +86,9 @@ cd "$REPO_ROOT" || {
 }
 
 # Execute the pre-commit system
-# Pass through environment variables including SKIP
+# Pass through environment variables including SKIP, and mark the run as
+# started by the hook
+export GO_PRE_COMMIT_FROM_HOOK=1
 exec "$BINARY_PATH" run
 `
 
@@ -109,6 +116,17 @@ func NewInstallerWithConfig(repoRoot, preCommitDir string, cfg *config.Config) *
 	}
 }
 
+// hooksDir returns the hooks directory of the repository. A linked
+// worktree's .git is a file redirecting to its git directory, and hooks live
+// in the directory shared by all worktrees.
+func (i *Installer) hooksDir() string {
+	gitDir, err := shared.ResolveGitDir(i.repoRoot)
+	if err != nil {
+		return filepath.Join(i.repoRoot, ".git", "hooks")
+	}
+	return filepath.Join(shared.CommonGitDir(gitDir), "hooks")
+}
+
 // InstallHook installs a git hook with enhanced validation and conflict resolution
 func (i *Installer) InstallHook(hookType string, force bool) error {
 	// Pre-installation validation
@@ -116,7 +134,7 @@ func (i *Installer) InstallHook(hookType string, force bool) error {
 		return fmt.Errorf("installation validation failed: %w", err)
 	}
 
-	hookPath := filepath.Join(i.repoRoot, ".git", "hooks", hookType)
+	hookPath := filepath.Join(i.hooksDir(), hookType)
 
 	// Handle existing hooks
 	if err := i.handleExistingHook(hookPath, force); err != nil {
@@ -147,7 +165,7 @@ func (i *Installer) InstallHook(hookType string, force bool) error {
 
 // UninstallHook removes a git hook if it was installed by us
 func (i *Installer) UninstallHook(hookType string) (bool, error) {
-	hookPath := filepath.Join(i.repoRoot, ".git", "hooks", hookType)
+	hookPath := filepath.Join(i.hooksDir(), hookType)
 
 	// Check if hook exists
 	content, err := os.ReadFile(hookPath) //nolint:gosec // Path is validated
@@ -179,7 +197,7 @@ func (i *Installer) UninstallHook(hookType string) (bool, error) {
 
 // IsHookInstalled checks if a hook is installed
 func (i *Installer) IsHookInstalled(hookType string) bool {
-	hookPath := filepath.Join(i.repoRoot, ".git", "hooks", hookType)
+	hookPath := filepath.Join(i.hooksDir(), hookType)
 
 	content, err := os.ReadFile(hookPath) //nolint:gosec // Path is validated
 	if err != nil {
@@ -317,7 +335,7 @@ func (i *Installer) restoreBackupIfExists(hookPath string) error {
 
 // GetInstallationStatus returns detailed information about hook installation status
 func (i *Installer) GetInstallationStatus(hookType string) (*InstallationStatus, error) {
-	hookPath := filepath.Join(i.repoRoot, ".git", "hooks", hookType)
+	hookPath := filepath.Join(i.hooksDir(), hookType)
 	status := &InstallationStatus{
 		HookType: hookType,
 		HookPath: hookPath,
@@ -71,6 +71,30 @@ func TestInstaller_InstallHook(t *testing.T) {
 	assert.Contains(t, string(content), "Go Pre-commit Hook")
 }
 
+// TestInstaller_InstallHookWorktree installs from a linked worktree, whose
+// .git is a file; the hook goes into the hooks directory all worktrees share
+func TestInstaller_InstallHookWorktree(t *testing.T) {
+	base := t.TempDir()
+	mainGitDir := filepath.Join(base, "main", ".git")
+	worktreeRoot := filepath.Join(base, "wt")
+	require.NoError(t, os.MkdirAll(filepath.Join(mainGitDir, "worktrees", "wt"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(mainGitDir, "worktrees", "wt", "commondir"), []byte("../..\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(mainGitDir, "worktrees", "wt", "HEAD"), []byte("ref: refs/heads/wt\n"), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(worktreeRoot, ".github", "pre-commit"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(worktreeRoot, ".git"), []byte("gitdir: "+filepath.Join(mainGitDir, "worktrees", "wt")+"\n"), 0o600))
+
+	installer := NewInstaller(worktreeRoot, ".github/pre-commit")
+	require.NoError(t, installer.InstallHook("pre-commit", false))
+
+	hookPath := filepath.Join(mainGitDir, "hooks", "pre-commit")
+	assert.FileExists(t, hookPath)
+	assert.True(t, installer.IsHookInstalled("pre-commit"))
+
+	status, err := installer.GetInstallationStatus("pre-commit")
+	require.NoError(t, err)
+	assert.Equal(t, hookPath, status.HookPath)
+}
+
 func TestInstaller_UninstallHook(t *testing.T) {
 	// Create a temporary directory for testing
 	tmpDir := t.TempDir()
@@ -164,6 +188,7 @@ func TestHookScript(t *testing.T) {
 	assert.Contains(t, hookScript, "Go Pre-commit Hook")
 	assert.Contains(t, hookScript, "go-pre-commit")
 	assert.Contains(t, hookScript, "exec")
+	assert.Contains(t, hookScript, "export "+HookMarkerEnv+"=1", "the hook marks its runs")
 }
 
 func TestInstaller_InstallHook_ErrorCases(t *testing.T) {
@@ -0,0 +1,58 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+// defaultLockAttempts is how many times a git command that finds the index
+// locked is tried when no configuration says otherwise
+const defaultLockAttempts = 3
+
+// lockRetryDelay is the wait before the second attempt; each later wait doubles
+const lockRetryDelay = 100 * time.Millisecond
+
+// lockRetrySleep waits between attempts. It is a package variable so tests
+// can release a lock between attempts without waiting.
+//
+//nolint:gochecknoglobals // Injectable seam so tests need not sleep
+var lockRetrySleep = time.Sleep
+
+// retryIndexLock runs a git command up to attempts times while it fails
+// because another git process holds index.lock, as an editor or a concurrent
+// "git add" briefly does, waiting longer before each retry. Other failures
+// are returned at once. When the lock outlasts every attempt the error wraps
+// ErrGitIndexLocked and tells how to clear a stale lock.
+func retryIndexLock(attempts int, run func() ([]byte, error)) ([]byte, error) {
+	attempts = max(attempts, 1)
+	delay := lockRetryDelay
+	for attempt := 1; ; attempt++ {
+		output, err := run()
+		if err == nil || !isIndexLockError(err, output) {
+			return output, err
+		}
+		if attempt == attempts {
+			return output, fmt.Errorf("%w after %d attempt(s); if no other git process is running, remove .git/index.lock: %w",
+				prerrors.ErrGitIndexLocked, attempts, err)
+		}
+		lockRetrySleep(delay)
+		delay *= 2
+	}
+}
+
+// isIndexLockError reports whether a git command failed because index.lock
+// exists. git names the lock file on stderr, which exec keeps in the
+// ExitError when only stdout was captured, and in output otherwise.
+func isIndexLockError(err error, output []byte) bool {
+	stderr := string(output)
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		stderr += string(exitErr.Stderr)
+	}
+	return strings.Contains(stderr, "index.lock")
+}
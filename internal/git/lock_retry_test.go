@@ -0,0 +1,114 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+var errGitFailed = errors.New("exit status 128")
+
+// lockedOutput is what git prints when another process holds the index lock
+const lockedOutput = "fatal: Unable to create '/repo/.git/index.lock': File exists."
+
+// recordSleeps replaces the wait between attempts, calling release on each
+// one, and returns the waits asked for
+func recordSleeps(t *testing.T, release func()) *[]time.Duration {
+	t.Helper()
+	var sleeps []time.Duration
+	original := lockRetrySleep
+	lockRetrySleep = func(d time.Duration) {
+		sleeps = append(sleeps, d)
+		release()
+	}
+	t.Cleanup(func() { lockRetrySleep = original })
+	return &sleeps
+}
+
+func TestRetryIndexLock(t *testing.T) {
+	t.Run("transient lock succeeds on retry", func(t *testing.T) {
+		sleeps := recordSleeps(t, func() {})
+		calls := 0
+		output, err := retryIndexLock(3, func() ([]byte, error) {
+			calls++
+			if calls < 3 {
+				return []byte(lockedOutput), errGitFailed
+			}
+			return []byte("ok"), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "ok", string(output))
+		assert.Equal(t, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond}, *sleeps, "the wait doubles")
+	})
+
+	t.Run("persistent lock", func(t *testing.T) {
+		sleeps := recordSleeps(t, func() {})
+		_, err := retryIndexLock(2, func() ([]byte, error) {
+			return []byte(lockedOutput), errGitFailed
+		})
+		require.ErrorIs(t, err, prerrors.ErrGitIndexLocked)
+		require.ErrorIs(t, err, errGitFailed)
+		assert.Contains(t, err.Error(), "after 2 attempt(s)")
+		assert.Contains(t, err.Error(), "remove .git/index.lock")
+		assert.Len(t, *sleeps, 1)
+	})
+
+	t.Run("other failures are not retried", func(t *testing.T) {
+		sleeps := recordSleeps(t, func() {})
+		calls := 0
+		_, err := retryIndexLock(3, func() ([]byte, error) {
+			calls++
+			return []byte("fatal: pathspec 'x' did not match any files"), errGitFailed
+		})
+		require.ErrorIs(t, err, errGitFailed)
+		assert.NotErrorIs(t, err, prerrors.ErrGitIndexLocked)
+		assert.Equal(t, 1, calls)
+		assert.Empty(t, *sleeps)
+	})
+}
+
+func TestIsIndexLockError(t *testing.T) {
+	assert.True(t, isIndexLockError(errGitFailed, []byte(lockedOutput)), "combined output")
+	assert.True(t, isIndexLockError(&exec.ExitError{Stderr: []byte(lockedOutput)}, nil), "stderr kept by Output")
+	assert.False(t, isIndexLockError(errGitFailed, []byte("fatal: not a git repository")))
+}
+
+func TestStageFiles_TransientIndexLock(t *testing.T) {
+	tmpDir := setupStatusRepo(t)
+	lock := filepath.Join(tmpDir, ".git", "index.lock")
+	require.NoError(t, os.WriteFile(lock, nil, 0o600))
+
+	// Another git process finishes and releases the lock during the first wait
+	sleeps := recordSleeps(t, func() { _ = os.Remove(lock) })
+
+	repo := NewRepository(tmpDir)
+	require.NoError(t, repo.StageFiles(context.Background(), []string{"edited.go"}))
+	assert.Len(t, *sleeps, 1)
+
+	staged, err := repo.GetStagedFiles()
+	require.NoError(t, err)
+	assert.Contains(t, staged, "edited.go")
+}
+
+func TestStageFiles_PersistentIndexLock(t *testing.T) {
+	tmpDir := setupStatusRepo(t)
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, ".git", "index.lock"), nil, 0o600))
+	sleeps := recordSleeps(t, func() {})
+
+	cfg := &config.Config{}
+	cfg.Git.LockAttempts = 4
+	err := NewRepositoryWithConfig(tmpDir, cfg).StageFiles(context.Background(), []string{"edited.go"})
+	require.ErrorIs(t, err, prerrors.ErrGitIndexLocked)
+	assert.Contains(t, err.Error(), "after 4 attempt(s)")
+	assert.Len(t, *sleeps, 3)
+}
@@ -9,31 +9,53 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 
-	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
 )
 
 // Repository represents a Git repository
 type Repository struct {
-	root string
+	root         string
+	status       statusReader
+	lockAttempts int // tries of a git command that finds the index locked
 }
 
-// NewRepository creates a new Repository instance
+// NewRepository creates a new Repository instance that reads status with the
+// git binary
 func NewRepository(root string) *Repository {
-	return &Repository{root: root}
+	return newExecRepository(root, defaultLockAttempts)
 }
 
-// GetStagedFiles returns all files staged for commit
-func (r *Repository) GetStagedFiles() ([]string, error) {
-	cmd := exec.CommandContext(context.Background(), "git", "diff", "--cached", "--name-only", "--diff-filter=ACMR")
-	cmd.Dir = r.root
-
-	output, err := cmd.Output()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get staged files: %w", err)
+// NewRepositoryWithConfig creates a new Repository instance that reads staged
+// files and status with the backend cfg selects and retries git commands that
+// find the index locked as often as cfg allows. A nil cfg uses the git binary.
+func NewRepositoryWithConfig(root string, cfg *config.Config) *Repository {
+	if cfg == nil {
+		return NewRepository(root)
+	}
+	attempts := defaultLockAttempts
+	if cfg.Git.LockAttempts > 0 {
+		attempts = cfg.Git.LockAttempts
+	}
+	// go-git always reads .git/index, while git commit -a, --only, and
+	// commits of named paths run the hook with GIT_INDEX_FILE pointing at a
+	// temporary index that only the git binary honors
+	if cfg.Git.Backend == config.GitBackendGoGit && os.Getenv("GIT_INDEX_FILE") == "" {
+		return &Repository{root: root, status: newGoGitStatus(root), lockAttempts: attempts}
 	}
+	return newExecRepository(root, attempts)
+}
 
-	return parseFileList(output), nil
+// newExecRepository creates a Repository that reads status with the git binary
+func newExecRepository(root string, lockAttempts int) *Repository {
+	return &Repository{root: root, status: execStatus{root: root, lockAttempts: lockAttempts}, lockAttempts: lockAttempts}
+}
+
+// GetStagedFiles returns all files staged for commit
+func (r *Repository) GetStagedFiles() ([]string, error) {
+	return r.status.stagedFiles()
 }
 
 // GetAllFiles returns all tracked files in the repository
@@ -49,6 +71,29 @@ func (r *Repository) GetAllFiles() ([]string, error) {
 	return parseFileList(output), nil
 }
 
+// GetFilesModifiedSince returns tracked files whose modification time on disk
+// is at or after the cutoff, regardless of their git status. Tracked files
+// missing from the working tree are ignored.
+func (r *Repository) GetFilesModifiedSince(cutoff time.Time) ([]string, error) {
+	tracked, err := r.GetAllFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]string, 0, len(tracked))
+	for _, file := range tracked {
+		info, statErr := os.Stat(filepath.Join(r.root, file))
+		if statErr != nil || info.IsDir() {
+			continue
+		}
+		if !info.ModTime().Before(cutoff) {
+			files = append(files, file)
+		}
+	}
+
+	return files, nil
+}
+
 // GetModifiedFiles returns all modified files (staged and unstaged)
 func (r *Repository) GetModifiedFiles() ([]string, error) {
 	// Get staged files
@@ -58,16 +103,11 @@ func (r *Repository) GetModifiedFiles() ([]string, error) {
 	}
 
 	// Get unstaged modifications
-	cmd := exec.CommandContext(context.Background(), "git", "diff", "--name-only", "--diff-filter=ACMR")
-	cmd.Dir = r.root
-
-	output, err := cmd.Output()
+	unstaged, err := r.status.unstagedFiles()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get modified files: %w", err)
+		return nil, err
 	}
 
-	unstaged := parseFileList(output)
-
 	// Merge and deduplicate
 	fileMap := make(map[string]bool)
 	for _, f := range staged {
@@ -85,6 +125,12 @@ func (r *Repository) GetModifiedFiles() ([]string, error) {
 	return files, nil
 }
 
+// GetDeletedFiles returns tracked files that are deleted, whether the
+// deletion is staged or only made in the working tree
+func (r *Repository) GetDeletedFiles() ([]string, error) {
+	return r.status.deletedFiles()
+}
+
 // GetFileContent returns the content of a file from the index (staged version)
 func (r *Repository) GetFileContent(path string) ([]byte, error) {
 	// Try to get staged version first
@@ -110,25 +156,83 @@ func (r *Repository) IsFileTracked(path string) bool {
 	return err == nil
 }
 
+// StageFiles adds the given paths to the index, retrying while another git
+// process holds the index lock
+func (r *Repository) StageFiles(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	args := append([]string{"add", "--"}, files...)
+	output, err := retryIndexLock(r.lockAttempts, func() ([]byte, error) {
+		cmd := exec.CommandContext(ctx, "git", args...) //nolint:gosec // Git command with file list from the repository
+		cmd.Dir = r.root
+		return cmd.CombinedOutput()
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stage files: %w (output: %s)", err, strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
 // GetRoot returns the repository root directory
 func (r *Repository) GetRoot() string {
 	return r.root
 }
 
-// FindRepositoryRoot finds the root directory of the Git repository
-func FindRepositoryRoot() (string, error) {
-	cmd := exec.CommandContext(context.Background(), "git", "rev-parse", "--show-toplevel")
+// GetCurrentBranch returns the checked-out branch name, or "HEAD" when the
+// head is detached
+func (r *Repository) GetCurrentBranch() (string, error) {
+	return r.revParse("--abbrev-ref", "HEAD")
+}
+
+// GetHeadCommit returns the full hash of the HEAD commit
+func (r *Repository) GetHeadCommit() (string, error) {
+	return r.revParse("HEAD")
+}
+
+// GetGitDir returns the absolute path of the git directory, which is not
+// <root>/.git in linked worktrees
+func (r *Repository) GetGitDir() (string, error) {
+	return r.revParse("--absolute-git-dir")
+}
+
+// GetRemoteURL returns the URL of the named remote
+func (r *Repository) GetRemoteURL(remote string) (string, error) {
+	cmd := exec.CommandContext(context.Background(), "git", "remote", "get-url", remote) //nolint:gosec // remote name is provided by the caller
+	cmd.Dir = r.root
 
 	output, err := cmd.Output()
 	if err != nil {
-		return "", fmt.Errorf("not in a git repository: %w", err)
+		return "", fmt.Errorf("failed to get URL of remote %s: %w", remote, err)
 	}
+	return strings.TrimSpace(string(output)), nil
+}
 
-	root := strings.TrimSpace(string(output))
-	if root == "" {
-		return "", prerrors.ErrRepositoryRootNotFound
+// revParse runs git rev-parse with args and returns its trimmed output
+func (r *Repository) revParse(args ...string) (string, error) {
+	cmd := exec.CommandContext(context.Background(), "git", append([]string{"rev-parse"}, args...)...) //nolint:gosec // fixed git arguments
+	cmd.Dir = r.root
+
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("git rev-parse %s failed: %w", strings.Join(args, " "), err)
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// FindRepositoryRoot finds the root directory of the Git repository.
+// GO_PRE_COMMIT_REPO_ROOT (or --repo-root) takes precedence over git.
+func FindRepositoryRoot() (string, error) {
+	if root, err := shared.RepoRootOverride(); err != nil || root != "" {
+		return root, err
 	}
 
+	root, err := shared.GitRepoRoot(context.Background())
+	if err != nil {
+		return "", fmt.Errorf("not in a git repository: %w", err)
+	}
 	return root, nil
 }
 
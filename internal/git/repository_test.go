@@ -1,12 +1,18 @@
 package git
 
 import (
+	"context"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
 )
 
 func TestFindRepositoryRoot(t *testing.T) {
@@ -210,6 +216,21 @@ func TestFindRepositoryRoot_ErrorCases(t *testing.T) {
 	assert.Contains(t, err.Error(), "not in a git repository")
 }
 
+func TestFindRepositoryRoot_Override(t *testing.T) {
+	// A directory without .git is accepted when set as the override
+	root := t.TempDir()
+	t.Chdir(t.TempDir())
+	t.Setenv(shared.RepoRootEnv, root)
+
+	found, err := FindRepositoryRoot()
+	require.NoError(t, err)
+	assert.Equal(t, root, found)
+
+	t.Setenv(shared.RepoRootEnv, filepath.Join(root, "missing"))
+	_, err = FindRepositoryRoot()
+	require.ErrorIs(t, err, prerrors.ErrInvalidRepoRoot)
+}
+
 func TestRepository_ErrorHandling(t *testing.T) {
 	// Test with repository that doesn't exist
 	repo := NewRepository("/nonexistent/path")
@@ -228,3 +249,143 @@ func TestRepository_ErrorHandling(t *testing.T) {
 	_, err = repo.GetModifiedFiles()
 	require.Error(t, err)
 }
+
+func TestRepository_GetFilesModifiedSince(t *testing.T) {
+	tmpDir := t.TempDir()
+	runGit := func(args ...string) {
+		t.Helper()
+		cmd := exec.CommandContext(context.Background(), "git", args...)
+		cmd.Dir = tmpDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	runGit("init")
+
+	now := time.Now()
+	files := map[string]time.Time{
+		"recent.go":      now.Add(-time.Hour),
+		"old.go":         now.Add(-72 * time.Hour),
+		"docs/recent.md": now.Add(-2 * time.Hour),
+		"gone.txt":       now,
+	}
+	for name, mtime := range files {
+		path := filepath.Join(tmpDir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+		require.NoError(t, os.WriteFile(path, []byte("x\n"), 0o600))
+		require.NoError(t, os.Chtimes(path, mtime, mtime))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "untracked.go"), []byte("x\n"), 0o600))
+	runGit("add", "recent.go", "old.go", "docs/recent.md", "gone.txt")
+
+	// Tracked but deleted from the working tree
+	require.NoError(t, os.Remove(filepath.Join(tmpDir, "gone.txt")))
+
+	result, err := NewRepository(tmpDir).GetFilesModifiedSince(now.Add(-24 * time.Hour))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"recent.go", "docs/recent.md"}, result)
+
+	result, err = NewRepository(tmpDir).GetFilesModifiedSince(now.Add(-90 * time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"recent.go"}, result)
+}
+
+func TestRepository_StageFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	cmd := exec.CommandContext(context.Background(), "git", "init")
+	cmd.Dir = tmpDir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "a.txt"), []byte("a\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "b.txt"), []byte("b\n"), 0o600))
+
+	repo := NewRepository(tmpDir)
+	require.NoError(t, repo.StageFiles(context.Background(), nil))
+	require.NoError(t, repo.StageFiles(context.Background(), []string{"a.txt"}))
+
+	staged, err := repo.GetStagedFiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a.txt"}, staged)
+
+	err = repo.StageFiles(context.Background(), []string{"missing.txt"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to stage files")
+}
+
+func TestRepository_HeadMetadata(t *testing.T) {
+	tmpDir := t.TempDir()
+	runGit := func(args ...string) string {
+		t.Helper()
+		cmd := exec.CommandContext(context.Background(), "git", args...)
+		cmd.Dir = tmpDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return string(out)
+	}
+	runGit("init", "-q", "-b", "feature/webhooks")
+
+	repo := NewRepository(tmpDir)
+	_, err := repo.GetHeadCommit()
+	require.Error(t, err, "no commit yet")
+	_, err = repo.GetRemoteURL("origin")
+	require.Error(t, err, "no remote yet")
+
+	runGit("-c", "user.email=test@example.com", "-c", "user.name=Test", "commit", "-q", "--allow-empty", "-m", "init")
+	runGit("remote", "add", "origin", "https://github.com/example/project.git")
+
+	branch, err := repo.GetCurrentBranch()
+	require.NoError(t, err)
+	assert.Equal(t, "feature/webhooks", branch)
+
+	commit, err := repo.GetHeadCommit()
+	require.NoError(t, err)
+	assert.Len(t, commit, 40)
+
+	url, err := repo.GetRemoteURL("origin")
+	require.NoError(t, err)
+	assert.Equal(t, "https://github.com/example/project.git", url)
+}
+
+func TestRepository_GetGitDir(t *testing.T) {
+	tmpDir := t.TempDir()
+	cmd := exec.CommandContext(context.Background(), "git", "init", "-q")
+	cmd.Dir = tmpDir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	gitDir, err := NewRepository(tmpDir).GetGitDir()
+	require.NoError(t, err)
+	resolved, err := filepath.EvalSymlinks(tmpDir)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(resolved, ".git"), gitDir)
+
+	_, err = NewRepository(t.TempDir()).GetGitDir()
+	require.Error(t, err, "not a repository")
+}
+
+func TestRepository_GetDeletedFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	runGit := func(args ...string) {
+		t.Helper()
+		cmd := exec.CommandContext(context.Background(), "git", args...)
+		cmd.Dir = tmpDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	runGit("init", "-q")
+	for _, name := range []string{"staged.txt", "unstaged.txt", "kept.txt"} {
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, name), []byte(name+"\n"), 0o600))
+	}
+	runGit("add", ".")
+	runGit("-c", "user.email=test@example.com", "-c", "user.name=Test", "commit", "-q", "-m", "init")
+
+	runGit("rm", "-q", "staged.txt")
+	require.NoError(t, os.Remove(filepath.Join(tmpDir, "unstaged.txt")))
+
+	deleted, err := NewRepository(tmpDir).GetDeletedFiles()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"staged.txt", "unstaged.txt"}, deleted)
+
+	_, err = NewRepository(t.TempDir()).GetDeletedFiles()
+	require.Error(t, err, "not a repository")
+}
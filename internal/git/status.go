@@ -0,0 +1,239 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"sort"
+	"sync"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// statusReader lists the files a Repository reports as staged, modified, or
+// deleted. Paths are relative to the repository root.
+type statusReader interface {
+	// stagedFiles returns files added, copied, modified, or renamed in the index
+	stagedFiles() ([]string, error)
+	// unstagedFiles returns files modified in the working tree but not staged
+	unstagedFiles() ([]string, error)
+	// deletedFiles returns tracked files deleted in the index or working tree
+	deletedFiles() ([]string, error)
+}
+
+// execStatus reads status by running the git binary
+type execStatus struct {
+	root         string
+	lockAttempts int // tries of a command that finds the index locked
+}
+
+func (s execStatus) stagedFiles() ([]string, error) {
+	output, err := s.git("diff", "--cached", "--name-only", "--diff-filter=ACMR")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get staged files: %w", err)
+	}
+	return parseFileList(output), nil
+}
+
+func (s execStatus) unstagedFiles() ([]string, error) {
+	output, err := s.git("diff", "--name-only", "--diff-filter=ACMR")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get modified files: %w", err)
+	}
+	return parseFileList(output), nil
+}
+
+func (s execStatus) deletedFiles() ([]string, error) {
+	stagedOutput, err := s.git("diff", "--cached", "--name-only", "--diff-filter=D")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get staged deletions: %w", err)
+	}
+
+	unstagedOutput, err := s.git("ls-files", "--deleted")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted files: %w", err)
+	}
+
+	return append(parseFileList(stagedOutput), parseFileList(unstagedOutput)...), nil
+}
+
+// git runs git with args in the repository root and returns its output,
+// retrying while another git process holds the index lock
+func (s execStatus) git(args ...string) ([]byte, error) {
+	return retryIndexLock(s.lockAttempts, func() ([]byte, error) {
+		cmd := exec.CommandContext(context.Background(), "git", args...) //nolint:gosec // fixed git arguments
+		cmd.Dir = s.root
+		return cmd.Output()
+	})
+}
+
+// goGitStatus reads status in-process with go-git, without starting a git
+// process per query
+type goGitStatus struct {
+	open func() (*gogit.Repository, error) // opens the repository on first use
+}
+
+// newGoGitStatus creates a goGitStatus that opens the repository at root once
+// and reuses it for every query. The index and HEAD are read again each time.
+func newGoGitStatus(root string) *goGitStatus {
+	return &goGitStatus{
+		open: sync.OnceValues(func() (*gogit.Repository, error) {
+			repo, err := gogit.PlainOpenWithOptions(root, &gogit.PlainOpenOptions{
+				DetectDotGit:          true,
+				EnableDotGitCommonDir: true,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to open repository: %w", err)
+			}
+			return repo, nil
+		}),
+	}
+}
+
+// stagedFiles compares the index with the HEAD tree, as git diff --cached
+// does, rather than computing the status of the whole working tree
+func (s *goGitStatus) stagedFiles() ([]string, error) {
+	files, err := s.indexChanges()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get staged files: %w", err)
+	}
+	return files, nil
+}
+
+// indexChanges returns the sorted paths whose index entry is new or differs
+// in content or mode from HEAD. Unmerged entries and changes between a file,
+// a symlink, and a submodule are left out, as --diff-filter=ACMR leaves them.
+func (s *goGitStatus) indexChanges() ([]string, error) {
+	repo, err := s.open()
+	if err != nil {
+		return nil, err
+	}
+
+	idx, err := repo.Storer.Index()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read index: %w", err)
+	}
+
+	head, err := headTreeEntries(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, entry := range idx.Entries {
+		if entry.Stage != 0 {
+			continue
+		}
+		committed, ok := head[entry.Name]
+		switch {
+		case !ok:
+			files = append(files, entry.Name)
+		case !sameFileKind(committed.Mode, entry.Mode):
+			continue
+		case committed.Hash != entry.Hash || committed.Mode != entry.Mode:
+			files = append(files, entry.Name)
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// headTreeEntries returns the entries of the HEAD commit's tree by path, or
+// none before the first commit
+func headTreeEntries(repo *gogit.Repository) (map[string]object.TreeEntry, error) {
+	entries := make(map[string]object.TreeEntry)
+
+	ref, err := repo.Head()
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return entries, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve HEAD: %w", err)
+	}
+
+	commit, err := repo.CommitObject(ref.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read HEAD commit: %w", err)
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read HEAD tree: %w", err)
+	}
+
+	walker := object.NewTreeWalker(tree, true, nil)
+	defer walker.Close()
+	for {
+		name, entry, err := walker.Next()
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read HEAD tree: %w", err)
+		}
+		if entry.Mode != filemode.Dir {
+			entries[name] = entry
+		}
+	}
+}
+
+// sameFileKind reports whether two modes are the same kind of entry, where
+// regular and executable files are one kind
+func sameFileKind(a, b filemode.FileMode) bool {
+	regular := func(mode filemode.FileMode) bool {
+		return mode == filemode.Regular || mode == filemode.Executable || mode == filemode.Deprecated
+	}
+	return a == b || (regular(a) && regular(b))
+}
+
+func (s *goGitStatus) unstagedFiles() ([]string, error) {
+	files, err := s.filter(func(status *gogit.FileStatus) bool {
+		return status.Worktree == gogit.Modified
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get modified files: %w", err)
+	}
+	return files, nil
+}
+
+func (s *goGitStatus) deletedFiles() ([]string, error) {
+	files, err := s.filter(func(status *gogit.FileStatus) bool {
+		return status.Staging == gogit.Deleted || status.Worktree == gogit.Deleted
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted files: %w", err)
+	}
+	return files, nil
+}
+
+// filter returns the sorted paths whose status matches keep, matching the
+// order git prints them in
+func (s *goGitStatus) filter(keep func(*gogit.FileStatus) bool) ([]string, error) {
+	repo, err := s.open()
+	if err != nil {
+		return nil, err
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open worktree: %w", err)
+	}
+
+	status, err := worktree.Status()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read status: %w", err)
+	}
+
+	files := make([]string, 0, len(status))
+	for file, fileStatus := range status {
+		if keep(fileStatus) {
+			files = append(files, file)
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
@@ -0,0 +1,196 @@
+package git
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+// setupStatusRepo creates a repository with a commit followed by staged,
+// unstaged, deleted, and untracked changes
+func setupStatusRepo(t *testing.T) string {
+	t.Helper()
+	tmpDir := t.TempDir()
+	runGit := func(args ...string) {
+		t.Helper()
+		cmd := exec.CommandContext(context.Background(), "git", args...)
+		cmd.Dir = tmpDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	write := func(name, content string) {
+		t.Helper()
+		path := filepath.Join(tmpDir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+
+	runGit("init", "-q")
+	for _, name := range []string{"kept.txt", "edited.go", "staged.go", "pkg/nested.go", "removed.txt", "deleted.txt"} {
+		write(name, name+"\n")
+	}
+	runGit("add", ".")
+	runGit("-c", "user.email=test@example.com", "-c", "user.name=Test", "commit", "-q", "-m", "init")
+
+	write("edited.go", "edited in the working tree\n")
+	write("staged.go", "staged change\n")
+	write("pkg/nested.go", "staged nested change\n")
+	write("added.go", "new file\n")
+	write("untracked.txt", "never added\n")
+	runGit("add", "staged.go", "pkg/nested.go", "added.go")
+	runGit("rm", "-q", "removed.txt")
+	require.NoError(t, os.Remove(filepath.Join(tmpDir, "deleted.txt")))
+
+	return tmpDir
+}
+
+func TestRepository_Backends(t *testing.T) {
+	tmpDir := setupStatusRepo(t)
+
+	goGitConfig := &config.Config{}
+	goGitConfig.Git.Backend = config.GitBackendGoGit
+
+	backends := map[string]*Repository{
+		config.GitBackendExec:  NewRepository(tmpDir),
+		config.GitBackendGoGit: NewRepositoryWithConfig(tmpDir, goGitConfig),
+	}
+	for name, repo := range backends {
+		t.Run(name, func(t *testing.T) {
+			staged, err := repo.GetStagedFiles()
+			require.NoError(t, err)
+			assert.Equal(t, []string{"added.go", "pkg/nested.go", "staged.go"}, staged)
+
+			modified, err := repo.GetModifiedFiles()
+			require.NoError(t, err)
+			assert.ElementsMatch(t, []string{"added.go", "edited.go", "pkg/nested.go", "staged.go"}, modified)
+
+			deleted, err := repo.GetDeletedFiles()
+			require.NoError(t, err)
+			assert.ElementsMatch(t, []string{"deleted.txt", "removed.txt"}, deleted)
+		})
+	}
+}
+
+func TestNewRepositoryWithConfig(t *testing.T) {
+	assert.IsType(t, execStatus{}, NewRepositoryWithConfig("/repo", nil).status)
+
+	cfg := &config.Config{}
+	assert.IsType(t, execStatus{}, NewRepositoryWithConfig("/repo", cfg).status, "exec is the default")
+
+	cfg.Git.Backend = config.GitBackendGoGit
+	repo := NewRepositoryWithConfig("/repo", cfg)
+	assert.IsType(t, &goGitStatus{}, repo.status)
+	assert.Equal(t, "/repo", repo.GetRoot())
+}
+
+func TestGoGitStatus_NotARepository(t *testing.T) {
+	status := newGoGitStatus(t.TempDir())
+
+	_, err := status.stagedFiles()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to get staged files")
+
+	_, err = status.unstagedFiles()
+	require.Error(t, err)
+
+	_, err = status.deletedFiles()
+	require.Error(t, err)
+}
+
+// runTestGit runs git in dir with a fixed identity
+func runTestGit(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.CommandContext(context.Background(), "git", append([]string{"-c", "user.email=test@example.com", "-c", "user.name=Test"}, args...)...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+}
+
+func TestRepository_Backends_StagedEdgeCases(t *testing.T) {
+	t.Run("before the first commit", func(t *testing.T) {
+		dir := t.TempDir()
+		runTestGit(t, dir, "init", "-q")
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "b.go"), []byte("b\n"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "a.go"), []byte("a\n"), 0o600))
+		runTestGit(t, dir, "add", ".")
+
+		assertStagedFiles(t, dir, []string{"a.go", "b.go"})
+	})
+
+	t.Run("mode changes and renames", func(t *testing.T) {
+		dir := t.TempDir()
+		runTestGit(t, dir, "init", "-q")
+		for _, name := range []string{"script.sh", "old.go", "same.go"} {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(name+"\n"), 0o600))
+		}
+		runTestGit(t, dir, "add", ".")
+		runTestGit(t, dir, "commit", "-q", "-m", "init")
+
+		require.NoError(t, os.Chmod(filepath.Join(dir, "script.sh"), 0o700)) //nolint:gosec // executable on purpose
+		runTestGit(t, dir, "add", "script.sh")
+		runTestGit(t, dir, "mv", "old.go", "new.go")
+
+		assertStagedFiles(t, dir, []string{"new.go", "script.sh"})
+	})
+
+	t.Run("temporary index from GIT_INDEX_FILE", func(t *testing.T) {
+		dir := t.TempDir()
+		runTestGit(t, dir, "init", "-q")
+		for _, name := range []string{"a.go", "b.go", "c.go"} {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(name+"\n"), 0o600))
+		}
+		runTestGit(t, dir, "add", "a.go")
+		runTestGit(t, dir, "commit", "-q", "-m", "init")
+		runTestGit(t, dir, "add", "b.go")
+
+		// As git commit --only c.go does: HEAD plus the named paths
+		t.Setenv("GIT_INDEX_FILE", filepath.Join(dir, ".git", "next-index-1.lock"))
+		runTestGit(t, dir, "read-tree", "HEAD")
+		runTestGit(t, dir, "add", "c.go")
+
+		assertStagedFiles(t, dir, []string{"c.go"})
+	})
+}
+
+// assertStagedFiles checks that both backends report want as staged in dir
+func assertStagedFiles(t *testing.T, dir string, want []string) {
+	t.Helper()
+	goGitConfig := &config.Config{}
+	goGitConfig.Git.Backend = config.GitBackendGoGit
+
+	for name, repo := range map[string]*Repository{
+		config.GitBackendExec:  NewRepository(dir),
+		config.GitBackendGoGit: NewRepositoryWithConfig(dir, goGitConfig),
+	} {
+		staged, err := repo.GetStagedFiles()
+		require.NoError(t, err, name)
+		assert.Equal(t, want, staged, name)
+	}
+}
+
+func TestGoGitStatus_ReusesRepository(t *testing.T) {
+	dir := setupStatusRepo(t)
+	status := newGoGitStatus(dir)
+
+	first, err := status.open()
+	require.NoError(t, err)
+	_, err = status.stagedFiles()
+	require.NoError(t, err)
+	second, err := status.open()
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+
+	// A file staged after the repository was opened is still seen
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "later.go"), []byte("later\n"), 0o600))
+	runTestGit(t, dir, "add", "later.go")
+	staged, err := status.stagedFiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"added.go", "later.go", "pkg/nested.go", "staged.go"}, staged)
+}
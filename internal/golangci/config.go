@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"gopkg.in/yaml.v3"
@@ -14,7 +15,12 @@ import (
 
 // Config represents the relevant portions of golangci-lint configuration.
 type Config struct {
+	// Linters holds the v1 layout, where formatters are enabled as linters.
+	Linters struct {
+		Enable []string `json:"enable" yaml:"enable"`
+	} `json:"linters" yaml:"linters"`
 	Formatters struct {
+		Enable   []string `json:"enable" yaml:"enable"`
 		Settings struct {
 			Gofumpt struct {
 				ModulePath string `json:"module-path" yaml:"module-path"`
@@ -34,6 +40,60 @@ var (
 	ErrModuleDirectiveNotFound = errors.New("module directive not found in go.mod")
 )
 
+// overlappingFormatters are the golangci-lint formatters that rewrite the same
+// code as the standalone fumpt check. Running both can flip a file back and
+// forth, for example when gci and gofumpt disagree on import grouping.
+var overlappingFormatters = []string{"gci", "gofumpt", "goimports"}
+
+// FindFormatterOverlap returns the name of the golangci-lint config file in
+// repoRoot and the formatters it enables that overlap the standalone fumpt
+// check. Config files are tried in the same order as ReadGofumptModulePath.
+// Returns ErrNoConfigFound when the repository has no golangci-lint config.
+func FindFormatterOverlap(repoRoot string) (string, []string, error) {
+	for _, filename := range []string{".golangci.json", ".golangci.yml", ".golangci.yaml"} {
+		path := filepath.Join(repoRoot, filename)
+		if !fileExists(path) {
+			continue
+		}
+
+		config, err := loadConfig(path)
+		if err != nil {
+			return filename, nil, err
+		}
+
+		var overlap []string
+		for _, formatter := range overlappingFormatters {
+			if slices.Contains(config.Formatters.Enable, formatter) || slices.Contains(config.Linters.Enable, formatter) {
+				overlap = append(overlap, formatter)
+			}
+		}
+		return filename, overlap, nil
+	}
+	return "", nil, ErrNoConfigFound
+}
+
+// loadConfig reads a golangci-lint config file, choosing the parser by its
+// extension.
+func loadConfig(path string) (Config, error) {
+	var config Config
+
+	data, err := os.ReadFile(path) // #nosec G304 -- Path is validated by caller
+	if err != nil {
+		return config, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	if filepath.Ext(path) == ".json" {
+		if err = json.Unmarshal(data, &config); err != nil {
+			return config, fmt.Errorf("failed to parse JSON config: %w", err)
+		}
+		return config, nil
+	}
+	if err = yaml.Unmarshal(data, &config); err != nil {
+		return config, fmt.Errorf("failed to parse YAML config: %w", err)
+	}
+	return config, nil
+}
+
 // ReadGofumptModulePath attempts to read the gofumpt module-path setting from golangci-lint configuration.
 // It tries the following in order:
 //  1. .golangci.json
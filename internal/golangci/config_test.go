@@ -1,6 +1,7 @@
 package golangci
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"strings"
@@ -367,3 +368,75 @@ func TestParseGoMod_ReadError(t *testing.T) {
 		t.Errorf("Expected read error, got: %v", err)
 	}
 }
+
+func TestFindFormatterOverlap(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		content  string
+		want     []string
+	}{
+		{
+			name:     "v2 formatters",
+			filename: ".golangci.yml",
+			content:  "version: \"2\"\nformatters:\n  enable:\n    - gofumpt\n    - gci\n    - gofmt\n",
+			want:     []string{"gci", "gofumpt"},
+		},
+		{
+			name:     "v1 linters",
+			filename: ".golangci.yaml",
+			content:  "linters:\n  enable:\n    - govet\n    - goimports\n",
+			want:     []string{"goimports"},
+		},
+		{
+			name:     "json",
+			filename: ".golangci.json",
+			content:  `{"formatters": {"enable": ["gofumpt"]}}`,
+			want:     []string{"gofumpt"},
+		},
+		{
+			name:     "no overlap",
+			filename: ".golangci.yml",
+			content:  "linters:\n  enable:\n    - govet\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			// #nosec G306 -- Test file, 0644 is acceptable
+			if err := os.WriteFile(filepath.Join(tmpDir, tt.filename), []byte(tt.content), 0o644); err != nil {
+				t.Fatalf("Failed to write test config: %v", err)
+			}
+
+			filename, overlap, err := FindFormatterOverlap(tmpDir)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if filename != tt.filename {
+				t.Errorf("Expected config file %q, got %q", tt.filename, filename)
+			}
+			if strings.Join(overlap, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("Expected overlap %v, got %v", tt.want, overlap)
+			}
+		})
+	}
+}
+
+func TestFindFormatterOverlap_NoConfig(t *testing.T) {
+	if _, _, err := FindFormatterOverlap(t.TempDir()); !errors.Is(err, ErrNoConfigFound) {
+		t.Errorf("Expected ErrNoConfigFound, got: %v", err)
+	}
+}
+
+func TestFindFormatterOverlap_InvalidConfig(t *testing.T) {
+	tmpDir := t.TempDir()
+	// #nosec G306 -- Test file, 0644 is acceptable
+	if err := os.WriteFile(filepath.Join(tmpDir, ".golangci.yml"), []byte("formatters: [unclosed"), 0o644); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+
+	if _, _, err := FindFormatterOverlap(tmpDir); err == nil {
+		t.Error("Expected a parse error")
+	}
+}
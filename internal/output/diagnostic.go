@@ -0,0 +1,300 @@
+package output
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Diagnostic severities
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+	SeverityInfo    = "info"
+)
+
+// Diagnostic is one finding a tool reported at a position in a file. It is
+// the single shape every machine-readable reporter consumes, whichever tool
+// produced the finding.
+type Diagnostic struct {
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	Col      int    `json:"col,omitempty"`
+	Severity string `json:"severity"`
+	Rule     string `json:"rule,omitempty"`
+	Message  string `json:"message"`
+}
+
+// DiagnosticGroup holds the diagnostics reported in one file, in order
+type DiagnosticGroup struct {
+	File        string
+	Diagnostics []Diagnostic
+}
+
+// CompareDiagnostics orders diagnostics by file, line, column, and rule. The
+// message and severity break the remaining ties, so the order never depends
+// on the order the tools reported them in.
+func CompareDiagnostics(a, b Diagnostic) int {
+	return cmp.Or(
+		strings.Compare(a.File, b.File),
+		cmp.Compare(a.Line, b.Line),
+		cmp.Compare(a.Col, b.Col),
+		strings.Compare(a.Rule, b.Rule),
+		strings.Compare(a.Message, b.Message),
+		strings.Compare(a.Severity, b.Severity),
+	)
+}
+
+// SortDiagnostics sorts diagnostics in place with CompareDiagnostics
+func SortDiagnostics(diagnostics []Diagnostic) {
+	slices.SortFunc(diagnostics, CompareDiagnostics)
+}
+
+// GroupDiagnosticsByFile returns the diagnostics grouped by file, with the
+// groups and the diagnostics in each sorted. The input is left unchanged.
+func GroupDiagnosticsByFile(diagnostics []Diagnostic) []DiagnosticGroup {
+	sorted := slices.Clone(diagnostics)
+	SortDiagnostics(sorted)
+
+	var groups []DiagnosticGroup
+	for _, diagnostic := range sorted {
+		if len(groups) == 0 || groups[len(groups)-1].File != diagnostic.File {
+			groups = append(groups, DiagnosticGroup{File: diagnostic.File})
+		}
+		last := &groups[len(groups)-1]
+		last.Diagnostics = append(last.Diagnostics, diagnostic)
+	}
+	return groups
+}
+
+// FileCount is the number of diagnostics reported in one file
+type FileCount struct {
+	File  string `json:"file"`
+	Count int    `json:"count"`
+}
+
+// RankFilesByDiagnostics returns the n files with the most diagnostics, most
+// first, with ties broken by file name. Diagnostics without a file are not
+// counted. An n of zero or less returns every file.
+func RankFilesByDiagnostics(diagnostics []Diagnostic, n int) []FileCount {
+	counts := make(map[string]int)
+	for _, diagnostic := range diagnostics {
+		if diagnostic.File != "" {
+			counts[diagnostic.File]++
+		}
+	}
+
+	ranked := make([]FileCount, 0, len(counts))
+	for file, count := range counts {
+		ranked = append(ranked, FileCount{File: file, Count: count})
+	}
+	slices.SortFunc(ranked, func(a, b FileCount) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), strings.Compare(a.File, b.File))
+	})
+	if n > 0 && len(ranked) > n {
+		ranked = ranked[:n]
+	}
+	return ranked
+}
+
+// Position returns the diagnostic's "line:col" position, or just the line
+// when the column is unknown
+func (d Diagnostic) Position() string {
+	if d.Col > 0 {
+		return fmt.Sprintf("%d:%d", d.Line, d.Col)
+	}
+	return strconv.Itoa(d.Line)
+}
+
+// positionPattern matches "file:line: message" and "file:line:col: message"
+var positionPattern = regexp.MustCompile(`^(\S[^:]*):(\d+)(?::(\d+))?:\s*(.*)$`)
+
+// staticcheckCodePattern matches the check code staticcheck appends to a
+// message, such as " (SA4006)"
+var staticcheckCodePattern = regexp.MustCompile(`\s+\(([A-Z]+[0-9]+)\)$`)
+
+// golangciLintReport is the subset of golangci-lint's JSON output parsed into
+// diagnostics
+type golangciLintReport struct {
+	Issues []struct {
+		FromLinter string `json:"FromLinter"`
+		Text       string `json:"Text"`
+		Severity   string `json:"Severity"`
+		Pos        struct {
+			Filename string `json:"Filename"`
+			Line     int    `json:"Line"`
+			Column   int    `json:"Column"`
+		} `json:"Pos"`
+	} `json:"Issues"`
+}
+
+// ParseGolangciLintJSON parses the JSON report golangci-lint prints with
+// --output.json.path=stdout (v2) or --out-format=json (v1). Other output may
+// surround the report. Issues without a severity are errors. It returns false
+// when no report is found.
+func ParseGolangciLintJSON(output string) ([]Diagnostic, bool) {
+	for start := strings.Index(output, `{"Issues"`); start >= 0; {
+		var report golangciLintReport
+		if err := json.NewDecoder(strings.NewReader(output[start:])).Decode(&report); err == nil {
+			diagnostics := make([]Diagnostic, 0, len(report.Issues))
+			for _, issue := range report.Issues {
+				diagnostics = append(diagnostics, Diagnostic{
+					File:     issue.Pos.Filename,
+					Line:     issue.Pos.Line,
+					Col:      issue.Pos.Column,
+					Severity: normalizeSeverity(issue.Severity),
+					Rule:     issue.FromLinter,
+					Message:  issue.Text,
+				})
+			}
+			return diagnostics, true
+		}
+
+		next := strings.Index(output[start+1:], `{"Issues"`)
+		if next < 0 {
+			break
+		}
+		start += next + 1
+	}
+	return nil, false
+}
+
+// ParseGoVet parses go vet's text output. Package headers ("# pkg") and the
+// "vet: " prefix are dropped, and indented lines continue the message above
+// them. go vet reports every finding as an error.
+func ParseGoVet(output string) []Diagnostic {
+	return parsePositionLines(output, func(line string) (string, bool) {
+		if strings.HasPrefix(line, "#") {
+			return "", false
+		}
+		return strings.TrimPrefix(line, "vet: "), true
+	}, func(d *Diagnostic) {
+		d.Severity = SeverityError
+		d.Rule = "vet"
+	})
+}
+
+// ParseStaticcheck parses staticcheck output in its default text format, where
+// each message ends with the check code ("(SA4006)") and indented lines carry
+// related information, or its line-delimited JSON format (-f json)
+func ParseStaticcheck(output string) []Diagnostic {
+	if trimmed := strings.TrimSpace(output); strings.HasPrefix(trimmed, "{") {
+		return parseStaticcheckJSON(trimmed)
+	}
+	return parsePositionLines(output, func(line string) (string, bool) {
+		return line, true
+	}, func(d *Diagnostic) {
+		d.Severity = SeverityError
+		if match := staticcheckCodePattern.FindStringSubmatch(d.Message); match != nil {
+			d.Rule = match[1]
+			d.Message = strings.TrimSuffix(d.Message, match[0])
+		}
+	})
+}
+
+// ParseOutputLines parses the "file:line[:col]: message" lines a check
+// prints, giving each diagnostic severity. Other lines are ignored.
+func ParseOutputLines(output, severity string) []Diagnostic {
+	return parsePositionLines(output, func(line string) (string, bool) {
+		return line, true
+	}, func(d *Diagnostic) {
+		d.Severity = severity
+	})
+}
+
+// parseStaticcheckJSON parses staticcheck's line-delimited JSON output
+func parseStaticcheckJSON(output string) []Diagnostic {
+	var diagnostics []Diagnostic
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		var problem struct {
+			Code     string `json:"code"`
+			Severity string `json:"severity"`
+			Message  string `json:"message"`
+			Location struct {
+				File   string `json:"file"`
+				Line   int    `json:"line"`
+				Column int    `json:"column"`
+			} `json:"location"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &problem); err != nil || problem.Location.File == "" {
+			continue
+		}
+		diagnostics = append(diagnostics, Diagnostic{
+			File:     problem.Location.File,
+			Line:     problem.Location.Line,
+			Col:      problem.Location.Column,
+			Severity: normalizeSeverity(problem.Severity),
+			Rule:     problem.Code,
+			Message:  problem.Message,
+		})
+	}
+	return diagnostics
+}
+
+// parsePositionLines parses "file:line[:col]: message" lines. prepare cleans
+// a line or rejects it, describe fills in the rest of the diagnostic from its
+// first line, and indented lines are appended to the previous message.
+func parsePositionLines(output string, prepare func(string) (string, bool), describe func(*Diagnostic)) []Diagnostic {
+	var diagnostics []Diagnostic
+	var current *Diagnostic
+	flush := func() {
+		if current != nil {
+			diagnostics = append(diagnostics, *current)
+			current = nil
+		}
+	}
+
+	for _, raw := range strings.Split(output, "\n") {
+		line := strings.TrimRight(raw, "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if current != nil && (line[0] == ' ' || line[0] == '\t') {
+			current.Message += "\n" + strings.TrimSpace(line)
+			continue
+		}
+
+		flush()
+		line, ok := prepare(line)
+		if !ok {
+			continue
+		}
+		match := positionPattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		lineNumber, err := strconv.Atoi(match[2])
+		if err != nil {
+			continue
+		}
+		col, _ := strconv.Atoi(match[3])
+		current = &Diagnostic{
+			File:    strings.TrimPrefix(match[1], "./"),
+			Line:    lineNumber,
+			Col:     col,
+			Message: match[4],
+		}
+		describe(current)
+	}
+	flush()
+	return diagnostics
+}
+
+// normalizeSeverity maps a tool's severity name onto error, warning, or info;
+// an empty or unknown severity is an error
+func normalizeSeverity(severity string) string {
+	switch strings.ToLower(severity) {
+	case SeverityWarning, "warn":
+		return SeverityWarning
+	case SeverityInfo, "ignored", "note":
+		return SeverityInfo
+	default:
+		return SeverityError
+	}
+}
@@ -0,0 +1,170 @@
+package output
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGolangciLintJSON(t *testing.T) {
+	report := `level=warning msg="[runner] deprecated option"
+{"Issues":[` +
+		`{"FromLinter":"errcheck","Text":"Error return value of ` + "`os.Remove`" + ` is not checked","Severity":"","Pos":{"Filename":"internal/git/files.go","Line":89,"Column":11}},` +
+		`{"FromLinter":"revive","Text":"exported function Run should have comment\nor be unexported","Severity":"warning","Pos":{"Filename":"cmd/main.go","Line":3,"Column":1}}` +
+		`],"Report":{}}
+2 issues.`
+
+	diagnostics, ok := ParseGolangciLintJSON(report)
+	require.True(t, ok)
+	assert.Equal(t, []Diagnostic{
+		{File: "internal/git/files.go", Line: 89, Col: 11, Severity: SeverityError, Rule: "errcheck", Message: "Error return value of `os.Remove` is not checked"},
+		{File: "cmd/main.go", Line: 3, Col: 1, Severity: SeverityWarning, Rule: "revive", Message: "exported function Run should have comment\nor be unexported"},
+	}, diagnostics)
+
+	diagnostics, ok = ParseGolangciLintJSON(`{"Issues":[],"Report":{}}`)
+	require.True(t, ok)
+	assert.Empty(t, diagnostics)
+
+	_, ok = ParseGolangciLintJSON("main.go:1:1: text output (errcheck)")
+	assert.False(t, ok)
+}
+
+func TestParseGoVet(t *testing.T) {
+	output := `# example.com/app/internal/store
+internal/store/store.go:42:2: fmt.Printf format %d has arg name of wrong type string
+# example.com/app/cmd
+vet: ./cmd/main.go:7:6: unreachable code
+./cmd/main.go:12: struct field tag ` + "`json:\"id\" xml`" + ` not compatible with reflect.StructTag.Get
+	bad syntax for struct tag pair
+`
+
+	assert.Equal(t, []Diagnostic{
+		{File: "internal/store/store.go", Line: 42, Col: 2, Severity: SeverityError, Rule: "vet", Message: "fmt.Printf format %d has arg name of wrong type string"},
+		{File: "cmd/main.go", Line: 7, Col: 6, Severity: SeverityError, Rule: "vet", Message: "unreachable code"},
+		{File: "cmd/main.go", Line: 12, Severity: SeverityError, Rule: "vet", Message: "struct field tag `json:\"id\" xml` not compatible with reflect.StructTag.Get\nbad syntax for struct tag pair"},
+	}, ParseGoVet(output))
+
+	assert.Empty(t, ParseGoVet(""))
+	assert.Empty(t, ParseGoVet("# example.com/app\nok\n"))
+}
+
+func TestParseStaticcheck(t *testing.T) {
+	t.Run("text", func(t *testing.T) {
+		output := "main.go:10:2: this value of err is never used (SA4006)\n" +
+			"pkg/util.go:3:1: should omit comparison to bool constant (S1002)\n" +
+			"\tpkg/util.go:4:5: related information: the comparison is here\n" +
+			"pkg/util.go:8:1: message without a code\n"
+
+		assert.Equal(t, []Diagnostic{
+			{File: "main.go", Line: 10, Col: 2, Severity: SeverityError, Rule: "SA4006", Message: "this value of err is never used"},
+			{File: "pkg/util.go", Line: 3, Col: 1, Severity: SeverityError, Rule: "S1002", Message: "should omit comparison to bool constant\npkg/util.go:4:5: related information: the comparison is here"},
+			{File: "pkg/util.go", Line: 8, Col: 1, Severity: SeverityError, Message: "message without a code"},
+		}, ParseStaticcheck(output))
+	})
+
+	t.Run("json", func(t *testing.T) {
+		output := `{"code":"SA4006","severity":"error","location":{"file":"/repo/main.go","line":10,"column":2},"end":{"file":"/repo/main.go","line":10,"column":5},"message":"this value of err is never used"}
+{"code":"ST1003","severity":"warning","location":{"file":"/repo/util.go","line":1,"column":9},"message":"should not use underscores in package names"}
+not json
+{"code":"compile","severity":"error","location":{"file":"","line":0,"column":0},"message":"no location"}
+`
+		assert.Equal(t, []Diagnostic{
+			{File: "/repo/main.go", Line: 10, Col: 2, Severity: SeverityError, Rule: "SA4006", Message: "this value of err is never used"},
+			{File: "/repo/util.go", Line: 1, Col: 9, Severity: SeverityWarning, Rule: "ST1003", Message: "should not use underscores in package names"},
+		}, ParseStaticcheck(output))
+	})
+}
+
+func TestParseOutputLines(t *testing.T) {
+	output := "internal/s/s.go:3: global variable registry is mutable\n" +
+		"2 mutable global variable(s)\n" +
+		"./main.go:7:6: unreachable code\n"
+
+	assert.Equal(t, []Diagnostic{
+		{File: "internal/s/s.go", Line: 3, Severity: SeverityWarning, Message: "global variable registry is mutable"},
+		{File: "main.go", Line: 7, Col: 6, Severity: SeverityWarning, Message: "unreachable code"},
+	}, ParseOutputLines(output, SeverityWarning))
+
+	assert.Empty(t, ParseOutputLines("all good\n", SeverityError))
+}
+
+func TestNormalizeSeverity(t *testing.T) {
+	assert.Equal(t, SeverityError, normalizeSeverity(""))
+	assert.Equal(t, SeverityError, normalizeSeverity("Error"))
+	assert.Equal(t, SeverityError, normalizeSeverity("critical"))
+	assert.Equal(t, SeverityWarning, normalizeSeverity("warn"))
+	assert.Equal(t, SeverityInfo, normalizeSeverity("ignored"))
+}
+
+func TestSortDiagnostics(t *testing.T) {
+	want := []Diagnostic{
+		{File: "a.go", Line: 1, Col: 1, Rule: "errcheck", Message: "b"},
+		{File: "a.go", Line: 1, Col: 1, Rule: "govet", Message: "a"},
+		{File: "a.go", Line: 1, Col: 2, Rule: "errcheck", Message: "a"},
+		{File: "a.go", Line: 2, Rule: "vet", Message: "same position, same rule", Severity: SeverityError},
+		{File: "a.go", Line: 2, Rule: "vet", Message: "same position, same rule", Severity: SeverityWarning},
+		{File: "a.go", Line: 10, Col: 1, Message: "line 10 sorts after line 2"},
+		{File: "cmd/main.go", Line: 1, Message: "a"},
+		{File: "cmd/main.go", Line: 1, Message: "b"},
+		{File: "z.go", Message: "no position"},
+	}
+
+	rng := rand.New(rand.NewPCG(1, 2)) //nolint:gosec // deterministic shuffles for the test
+	for range 20 {
+		shuffled := slices.Clone(want)
+		rng.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+
+		SortDiagnostics(shuffled)
+		require.Equal(t, want, shuffled)
+	}
+
+	SortDiagnostics(nil)
+}
+
+func TestGroupDiagnosticsByFile(t *testing.T) {
+	diagnostics := []Diagnostic{
+		{File: "b.go", Line: 3},
+		{File: "a.go", Line: 9},
+		{File: "b.go", Line: 1},
+		{File: "a.go", Line: 2},
+	}
+	input := slices.Clone(diagnostics)
+
+	assert.Equal(t, []DiagnosticGroup{
+		{File: "a.go", Diagnostics: []Diagnostic{{File: "a.go", Line: 2}, {File: "a.go", Line: 9}}},
+		{File: "b.go", Diagnostics: []Diagnostic{{File: "b.go", Line: 1}, {File: "b.go", Line: 3}}},
+	}, GroupDiagnosticsByFile(diagnostics))
+	assert.Equal(t, input, diagnostics, "the input is left unchanged")
+	assert.Empty(t, GroupDiagnosticsByFile(nil))
+}
+
+func TestRankFilesByDiagnostics(t *testing.T) {
+	diagnostics := []Diagnostic{
+		{File: "b.go", Line: 1, Rule: "vet"},
+		{File: "a.go", Line: 4, Rule: "lint"},
+		{File: "c.go", Line: 2, Rule: "lint"},
+		{File: "b.go", Line: 7, Rule: "lint"},
+		{File: "d.go", Line: 3, Rule: "vet"},
+		{File: "c.go", Line: 9, Rule: "vet"},
+		{File: "b.go", Line: 9, Rule: "global-vars"},
+		{File: "", Message: "no file to blame"},
+		{File: "e.go", Line: 5},
+	}
+
+	assert.Equal(t, []FileCount{
+		{File: "b.go", Count: 3},
+		{File: "c.go", Count: 2},
+		{File: "a.go", Count: 1},
+	}, RankFilesByDiagnostics(diagnostics, 3), "ties are ordered by file name")
+	assert.Len(t, RankFilesByDiagnostics(diagnostics, 0), 5, "zero ranks every file")
+	assert.Len(t, RankFilesByDiagnostics(diagnostics, 10), 5)
+	assert.Empty(t, RankFilesByDiagnostics(nil, 3))
+}
+
+func TestDiagnosticPosition(t *testing.T) {
+	assert.Equal(t, "12:5", Diagnostic{Line: 12, Col: 5}.Position())
+	assert.Equal(t, "12", Diagnostic{Line: 12}.Position())
+}
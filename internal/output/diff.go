@@ -0,0 +1,201 @@
+package output
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// hunkHeaderPattern matches a unified diff hunk header such as
+// "@@ -12,7 +12,8 @@ func main() {"
+var hunkHeaderPattern = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@(.*)$`)
+
+// hunk is one hunk of a unified diff
+type hunk struct {
+	oldStart, newStart int
+	section            string   // text after the closing @@, such as the enclosing function
+	header             string   // the header line as given
+	body               []string // the context, removed, and added lines
+}
+
+// Diff prints a unified diff as a code block, colored like CodeBlock. Context
+// lines further than SetDiffLimits allows from a change are dropped, splitting
+// hunks where needed, and each file stops after its line limit with a
+// "(N more lines changed)" notice. Unless SetRedactSecrets turned it off,
+// lines matching a gitleaks rule, such as private keys and access tokens, are
+// shown as "****".
+func (f *Formatter) Diff(text string) {
+	lines := strings.Split(text, "\n")
+	if f.redactSecrets {
+		lines = redactSecrets(lines, f.secretPatterns)
+	}
+	f.printCode(limitDiff(lines, f.diffContext, f.maxDiffLines), true)
+}
+
+// limitDiff keeps at most context lines of context around each change, or
+// all of them when context is negative, and at most maxPerFile lines of hunks
+// for each file, or all of them when maxPerFile is 0 or less. Lines outside
+// hunks, such as the ---/+++ file headers, are always kept.
+func limitDiff(lines []string, context, maxPerFile int) []string {
+	if context < 0 && maxPerFile <= 0 {
+		return lines
+	}
+
+	var out []string
+	shown, hidden := 0, 0
+	endFile := func() {
+		if hidden > 0 {
+			out = append(out, fmt.Sprintf("(%d more lines changed)", hidden))
+		}
+		shown, hidden = 0, 0
+	}
+	emit := func(line string) {
+		if maxPerFile > 0 && shown >= maxPerFile {
+			if isChangeLine(line) {
+				hidden++
+			}
+			return
+		}
+		out = append(out, line)
+		shown++
+	}
+
+	inHeader := false
+	for i := 0; i < len(lines); {
+		h, next, ok := parseHunk(lines, i)
+		if !ok {
+			// A header line after hunks starts the next file
+			if !inHeader {
+				endFile()
+				inHeader = true
+			}
+			out = append(out, lines[i])
+			i++
+			continue
+		}
+		inHeader = false
+		for _, line := range h.trim(context) {
+			emit(line)
+		}
+		i = next
+	}
+	endFile()
+	return out
+}
+
+// parseHunk parses the hunk whose header is lines[i], returning it and the
+// index of the line after it
+func parseHunk(lines []string, i int) (hunk, int, bool) {
+	m := hunkHeaderPattern.FindStringSubmatch(lines[i])
+	if m == nil {
+		return hunk{}, i, false
+	}
+	h := hunk{header: lines[i], section: m[5]}
+	h.oldStart, _ = strconv.Atoi(m[1])
+	h.newStart, _ = strconv.Atoi(m[3])
+	oldLeft, newLeft := hunkCount(m[2]), hunkCount(m[4])
+
+	j := i + 1
+	for ; j < len(lines); j++ {
+		line := lines[j]
+		switch {
+		case strings.HasPrefix(line, `\`):
+			// "\ No newline at end of file" belongs to the line before it
+		case oldLeft <= 0 && newLeft <= 0:
+			return h, j, true
+		case strings.HasPrefix(line, "-"):
+			oldLeft--
+		case strings.HasPrefix(line, "+"):
+			newLeft--
+		case line == "" || strings.HasPrefix(line, " "):
+			oldLeft--
+			newLeft--
+		default:
+			return h, j, true
+		}
+		h.body = append(h.body, line)
+	}
+	return h, j, true
+}
+
+// hunkCount returns a hunk header line count, which is 1 when omitted
+func hunkCount(s string) int {
+	if s == "" {
+		return 1
+	}
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+// trim returns the hunk with context lines further than context from every
+// change dropped. Where lines are dropped from its middle, the hunk is split
+// in two with headers numbered for the lines each part keeps. A hunk that
+// keeps every line is returned as given.
+func (h hunk) trim(context int) []string {
+	kept := make([]bool, len(h.body))
+	all := true
+	for k, line := range h.body {
+		kept[k] = context < 0 || h.changeWithin(k, context) || (strings.HasPrefix(line, `\`) && k > 0 && kept[k-1])
+		all = all && kept[k]
+	}
+	if all {
+		return append([]string{h.header}, h.body...)
+	}
+
+	var out []string
+	oldLine, newLine := h.oldStart, h.newStart
+	for k := 0; k < len(h.body); {
+		if !kept[k] {
+			oldLine, newLine = advanceHunkLine(h.body[k], oldLine, newLine)
+			k++
+			continue
+		}
+
+		start := k
+		groupOld, groupNew := oldLine, newLine
+		for ; k < len(h.body) && kept[k]; k++ {
+			oldLine, newLine = advanceHunkLine(h.body[k], oldLine, newLine)
+		}
+		oldCount, newCount := oldLine-groupOld, newLine-groupNew
+		if oldCount == 0 {
+			groupOld--
+		}
+		if newCount == 0 {
+			groupNew--
+		}
+		out = append(out, fmt.Sprintf("@@ -%d,%d +%d,%d @@%s", groupOld, oldCount, groupNew, newCount, h.section))
+		out = append(out, h.body[start:k]...)
+	}
+	return out
+}
+
+// changeWithin reports whether body line k is a change or lies within
+// distance lines of one
+func (h hunk) changeWithin(k, distance int) bool {
+	for i := max(0, k-distance); i <= min(len(h.body)-1, k+distance); i++ {
+		if isChangeLine(h.body[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// advanceHunkLine returns the old and new line numbers after line
+func advanceHunkLine(line string, oldLine, newLine int) (int, int) {
+	switch {
+	case strings.HasPrefix(line, `\`):
+		return oldLine, newLine
+	case strings.HasPrefix(line, "-"):
+		return oldLine + 1, newLine
+	case strings.HasPrefix(line, "+"):
+		return oldLine, newLine + 1
+	default:
+		return oldLine + 1, newLine + 1
+	}
+}
+
+// isChangeLine reports whether a hunk line adds or removes a line
+func isChangeLine(line string) bool {
+	return strings.HasPrefix(line, "+") || strings.HasPrefix(line, "-")
+}
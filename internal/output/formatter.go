@@ -9,15 +9,37 @@ import (
 	"strings"
 	"time"
 
-	"github.com/fatih/color"
 	"github.com/mattn/go-isatty"
 )
 
+// Verbosity selects how much detail the leveled formatter methods print
+type Verbosity int
+
+const (
+	// VerbosityNormal prints results, failures, and suggestions only
+	VerbosityNormal Verbosity = iota
+	// VerbosityChecks (-v) adds a summary of each check
+	VerbosityChecks
+	// VerbosityFiles (-vv) adds the files each check received
+	VerbosityFiles
+	// VerbosityCommands (-vvv) adds tool command lines and full tool output
+	VerbosityCommands
+)
+
 // Formatter handles all output formatting for the pre-commit system
 type Formatter struct {
 	colorEnabled bool
 	out          io.Writer
 	err          io.Writer
+	sink         *sink // destination opened by Open, released by Close
+	verbosity    Verbosity
+	maxFiles     int   // file list limit for FileListAt; 0 lists every file
+	theme        Theme // colors of each role when color is enabled
+	diffContext  int   // context lines Diff keeps around each change; negative keeps all
+	maxDiffLines int   // hunk lines Diff prints per file; 0 prints every line
+
+	redactSecrets  bool             // Diff prints lines holding secrets as "****"
+	secretPatterns []*regexp.Regexp // project gitleaks rules added to the defaults
 }
 
 // Options for configuring the formatter
@@ -25,6 +47,10 @@ type Options struct {
 	ColorEnabled bool
 	Out          io.Writer
 	Err          io.Writer
+	Sink         string // Named destination (stdout, stderr, file:<path>, syslog); resolved by Open
+	Verbosity    Verbosity
+	MaxFiles     int   // Files FileListAt prints before "... and N more"; 0 means no limit
+	Theme        Theme // Colors of each role when color is enabled; the zero Theme is the default
 }
 
 // New creates a new formatter with the given options
@@ -33,6 +59,12 @@ func New(opts Options) *Formatter {
 		colorEnabled: opts.ColorEnabled,
 		out:          opts.Out,
 		err:          opts.Err,
+		verbosity:    opts.Verbosity,
+		maxFiles:     opts.MaxFiles,
+		theme:        opts.Theme,
+		diffContext:  -1,
+
+		redactSecrets: true,
 	}
 
 	// Default to stdout/stderr if not specified
@@ -49,6 +81,39 @@ func New(opts Options) *Formatter {
 	return f
 }
 
+// Open creates a formatter writing to the destination named by opts.Sink.
+// Explicit Out and Err writers take precedence over the sink, and color is
+// disabled for file and syslog destinations. Call Close when done.
+func Open(opts Options) (*Formatter, error) {
+	s, err := openSink(opts.Sink)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Out == nil {
+		opts.Out = s.out
+	}
+	if opts.Err == nil {
+		opts.Err = s.err
+	}
+	if !s.interactive {
+		opts.ColorEnabled = false
+	}
+
+	f := New(opts)
+	f.sink = s
+	return f, nil
+}
+
+// Close releases the destination opened by Open. It is a no-op for
+// formatters created with New.
+func (f *Formatter) Close() error {
+	if f.sink == nil {
+		return nil
+	}
+	return f.sink.close()
+}
+
 // ColorMode represents the color output mode
 type ColorMode int
 
@@ -84,6 +149,15 @@ func NewWithColorMode(mode ColorMode) *Formatter {
 	})
 }
 
+// OpenWithColorMode creates a formatter with the specified color mode writing
+// to the named sink
+func OpenWithColorMode(mode ColorMode, sinkName string) (*Formatter, error) {
+	return Open(Options{
+		ColorEnabled: shouldUseColor(mode),
+		Sink:         sinkName,
+	})
+}
+
 // shouldUseColor determines if color output should be enabled based on the mode
 func shouldUseColor(mode ColorMode) bool {
 	switch mode {
@@ -143,15 +217,30 @@ func isCI() bool {
 
 // isTTY checks if stdout is connected to a terminal
 func isTTY() bool {
-	// os.Stdout is already *os.File, no need for type assertion
-	return isatty.IsTerminal(os.Stdout.Fd())
+	return isTerminal(os.Stdout)
+}
+
+// IsOutputTTY checks if stdout is connected to a terminal, meaning a person
+// is likely reading the output
+func IsOutputTTY() bool {
+	return isTTY()
+}
+
+// IsInputTTY checks if stdin is connected to a terminal, meaning the user can
+// answer interactive prompts
+func IsInputTTY() bool {
+	return isTerminal(os.Stdin)
+}
+
+// isTerminal checks if the given file is connected to a terminal
+func isTerminal(f *os.File) bool {
+	return isatty.IsTerminal(f.Fd())
 }
 
 // Success prints a success message with green checkmark
 func (f *Formatter) Success(format string, args ...any) {
 	if f.colorEnabled {
-		c := color.New(color.FgGreen)
-		c.SetWriter(f.out)
+		c := f.theme.Color(RoleSuccess)
 		_, _ = c.Fprintf(f.out, "✓ "+format+"\n", args...)
 	} else {
 		_, _ = fmt.Fprintf(f.out, "✓ "+format+"\n", args...)
@@ -161,8 +250,7 @@ func (f *Formatter) Success(format string, args ...any) {
 // Error prints an error message with red X
 func (f *Formatter) Error(format string, args ...any) {
 	if f.colorEnabled {
-		c := color.New(color.FgRed)
-		c.SetWriter(f.err)
+		c := f.theme.Color(RoleError)
 		_, _ = c.Fprintf(f.err, "✗ "+format+"\n", args...)
 	} else {
 		_, _ = fmt.Fprintf(f.err, "✗ "+format+"\n", args...)
@@ -172,8 +260,7 @@ func (f *Formatter) Error(format string, args ...any) {
 // Warning prints a warning message with yellow warning symbol
 func (f *Formatter) Warning(format string, args ...any) {
 	if f.colorEnabled {
-		c := color.New(color.FgYellow)
-		c.SetWriter(f.err)
+		c := f.theme.Color(RoleWarning)
 		_, _ = c.Fprintf(f.err, "⚠ "+format+"\n", args...)
 	} else {
 		_, _ = fmt.Fprintf(f.err, "⚠ "+format+"\n", args...)
@@ -183,8 +270,7 @@ func (f *Formatter) Warning(format string, args ...any) {
 // Info prints an info message with blue info symbol
 func (f *Formatter) Info(format string, args ...any) {
 	if f.colorEnabled {
-		c := color.New(color.FgBlue)
-		c.SetWriter(f.out)
+		c := f.theme.Color(RoleInfo)
 		_, _ = c.Fprintf(f.out, "ℹ "+format+"\n", args...)
 	} else {
 		_, _ = fmt.Fprintf(f.out, "ℹ "+format+"\n", args...)
@@ -194,8 +280,7 @@ func (f *Formatter) Info(format string, args ...any) {
 // Progress prints a progress message with spinning indicator
 func (f *Formatter) Progress(format string, args ...any) {
 	if f.colorEnabled {
-		c := color.New(color.FgCyan)
-		c.SetWriter(f.out)
+		c := f.theme.Color(RoleProgress)
 		_, _ = c.Fprintf(f.out, "⏳ "+format+"\n", args...)
 	} else {
 		_, _ = fmt.Fprintf(f.out, "⏳ "+format+"\n", args...)
@@ -205,11 +290,9 @@ func (f *Formatter) Progress(format string, args ...any) {
 // Header prints a section header
 func (f *Formatter) Header(text string) {
 	if f.colorEnabled {
-		c1 := color.New(color.FgCyan, color.Bold)
-		c1.SetWriter(f.out)
+		c1 := f.theme.Color(RoleHeader)
 		_, _ = c1.Fprintf(f.out, "\n%s\n", text)
-		c2 := color.New(color.FgCyan)
-		c2.SetWriter(f.out)
+		c2 := f.theme.Color(RoleRule)
 		_, _ = c2.Fprintf(f.out, "%s\n", strings.Repeat("─", len(text)))
 	} else {
 		_, _ = fmt.Fprintf(f.out, "\n%s\n%s\n", text, strings.Repeat("─", len(text)))
@@ -219,8 +302,7 @@ func (f *Formatter) Header(text string) {
 // Subheader prints a subsection header
 func (f *Formatter) Subheader(text string) {
 	if f.colorEnabled {
-		c := color.New(color.FgWhite, color.Bold)
-		c.SetWriter(f.out)
+		c := f.theme.Color(RoleSubheader)
 		_, _ = c.Fprintf(f.out, "\n%s:\n", text)
 	} else {
 		_, _ = fmt.Fprintf(f.out, "\n%s:\n", text)
@@ -232,6 +314,116 @@ func (f *Formatter) Detail(format string, args ...any) {
 	_, _ = fmt.Fprintf(f.out, "  "+format+"\n", args...)
 }
 
+// SetVerbosity sets the level the leveled methods compare against
+func (f *Formatter) SetVerbosity(level Verbosity) {
+	f.verbosity = level
+}
+
+// SetMaxFiles sets how many files FileListAt prints before summarizing the
+// rest; 0 or less lists every file
+func (f *Formatter) SetMaxFiles(limit int) {
+	f.maxFiles = limit
+}
+
+// SetDiffLimits sets how many context lines Diff keeps around each change,
+// where a negative count keeps them all, and how many hunk lines it prints
+// for each file, where 0 or less prints every line
+func (f *Formatter) SetDiffLimits(contextLines, maxLinesPerFile int) {
+	f.diffContext = contextLines
+	f.maxDiffLines = maxLinesPerFile
+}
+
+// SetRedactSecrets sets whether Diff hides lines holding secrets, which it
+// does by default
+func (f *Formatter) SetRedactSecrets(enabled bool) {
+	f.redactSecrets = enabled
+}
+
+// SetTheme sets the colors the formatter prints each role with
+func (f *Formatter) SetTheme(theme Theme) {
+	f.theme = theme
+}
+
+// colorize returns text in the color of role when color is enabled
+func (f *Formatter) colorize(role Role, text string) string {
+	if !f.colorEnabled {
+		return text
+	}
+	return f.theme.Color(role).Sprint(text)
+}
+
+// Verbose reports whether output at level is shown
+func (f *Formatter) Verbose(level Verbosity) bool {
+	return f.verbosity >= level
+}
+
+// DetailAt prints like Detail when the verbosity is at least level
+func (f *Formatter) DetailAt(level Verbosity, format string, args ...any) {
+	if f.Verbose(level) {
+		f.Detail(format, args...)
+	}
+}
+
+// FileListAt prints files one per detail line when the verbosity is at least
+// level. Past the SetMaxFiles limit the rest collapse into "... and N more".
+func (f *Formatter) FileListAt(level Verbosity, files []string) {
+	if !f.Verbose(level) {
+		return
+	}
+	shown := files
+	if f.maxFiles > 0 && len(files) > f.maxFiles {
+		shown = files[:f.maxFiles]
+	}
+	for _, file := range shown {
+		f.Detail("  %s", file)
+	}
+	if len(shown) < len(files) {
+		f.Detail("  ... and %d more", len(files)-len(shown))
+	}
+}
+
+// Diagnostics prints diagnostics grouped by file, in file, line, column,
+// and rule order: a "path (N issues)" header for each file, counting all of
+// its findings, then one "line:col rule message" line per finding. At most
+// limit findings are printed, 0 or less meaning all of them; it reports
+// whether any were left out.
+func (f *Formatter) Diagnostics(diagnostics []Diagnostic, limit int) bool {
+	shown := 0
+	for _, group := range GroupDiagnosticsByFile(diagnostics) {
+		if limit > 0 && shown >= limit {
+			break
+		}
+		f.Detail("  %s (%s)", f.colorize(RolePath, group.File), issueCount(len(group.Diagnostics)))
+		for _, diagnostic := range group.Diagnostics {
+			if limit > 0 && shown >= limit {
+				break
+			}
+			message := strings.ReplaceAll(diagnostic.Message, "\n", " ")
+			if diagnostic.Rule != "" {
+				message = diagnostic.Rule + " " + message
+			}
+			f.Detail("    %s %s", f.colorize(RoleLineNumber, diagnostic.Position()), message)
+			shown++
+		}
+	}
+	return shown < len(diagnostics)
+}
+
+// issueCount returns "1 issue" or "N issues"
+func issueCount(n int) string {
+	if n == 1 {
+		return "1 issue"
+	}
+	return fmt.Sprintf("%d issues", n)
+}
+
+// CodeBlockAt prints like CodeBlock when the verbosity is at least level
+func (f *Formatter) CodeBlockAt(level Verbosity, text string) {
+	if f.Verbose(level) {
+		f.CodeBlock(text)
+	}
+}
+
 // Duration formats and prints a duration
 func (f *Formatter) Duration(d time.Duration) string {
 	if d < time.Millisecond {
@@ -323,10 +515,33 @@ func (f *Formatter) parseFumptError(output string) (string, string) {
 			"Fix syntax errors in your Go files before running fumpt."
 	}
 
+	// "gofumpt -l" output is a plain list of the files needing formatting
+	if listed := listedGoFiles(output); len(listed) > 0 {
+		return fmt.Sprintf("%d file(s) need formatting: %s", len(listed), strings.Join(listed, ", ")),
+			"Run 'gofumpt -w' on the listed files and stage the changes."
+	}
+
 	return "Formatting failed",
 		"Run 'gofumpt -w .' manually to see detailed errors."
 }
 
+// listedGoFiles returns the lines of output when every non-empty line is a
+// bare .go path, as printed by "gofmt -l" style listings
+func listedGoFiles(output string) []string {
+	var listed []string
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if !strings.HasSuffix(line, ".go") || strings.ContainsAny(line, " \t") {
+			return nil
+		}
+		listed = append(listed, line)
+	}
+	return listed
+}
+
 // parseModTidyError analyzes go mod tidy output
 func (f *Formatter) parseModTidyError(output string) (string, string) {
 	if strings.Contains(output, "no go.mod file") {
@@ -395,7 +610,7 @@ func (f *Formatter) FormatExecutionStats(passed, failed, skipped int, duration t
 
 	if passed > 0 {
 		if f.colorEnabled {
-			c := color.New(color.FgGreen)
+			c := f.theme.Color(RoleSuccess)
 			stats = append(stats, c.Sprintf("%d passed", passed))
 		} else {
 			stats = append(stats, fmt.Sprintf("%d passed", passed))
@@ -404,7 +619,7 @@ func (f *Formatter) FormatExecutionStats(passed, failed, skipped int, duration t
 
 	if failed > 0 {
 		if f.colorEnabled {
-			c := color.New(color.FgRed)
+			c := f.theme.Color(RoleError)
 			stats = append(stats, c.Sprintf("%d failed", failed))
 		} else {
 			stats = append(stats, fmt.Sprintf("%d failed", failed))
@@ -413,7 +628,7 @@ func (f *Formatter) FormatExecutionStats(passed, failed, skipped int, duration t
 
 	if skipped > 0 {
 		if f.colorEnabled {
-			c := color.New(color.FgYellow)
+			c := f.theme.Color(RoleWarning)
 			stats = append(stats, c.Sprintf("%d skipped", skipped))
 		} else {
 			stats = append(stats, fmt.Sprintf("%d skipped", skipped))
@@ -434,17 +649,31 @@ func (f *Formatter) Highlight(text, highlight string) string {
 	if !f.colorEnabled {
 		return text
 	}
-	c := color.New(color.FgYellow)
+	c := f.theme.Color(RoleWarning)
 	return strings.ReplaceAll(text, highlight, c.Sprint(highlight))
 }
 
-// CodeBlock formats text as a code block
+// CodeBlock formats text as a code block. A unified diff is printed with
+// Diff, which colors its added, removed, and header lines.
 func (f *Formatter) CodeBlock(text string) {
 	lines := strings.Split(text, "\n")
+	if isUnifiedDiff(lines) {
+		f.Diff(text)
+		return
+	}
+	f.printCode(lines, false)
+}
+
+// printCode prints lines indented as a code block, colored as diff lines
+// when diff is set
+func (f *Formatter) printCode(lines []string, diff bool) {
 	for _, line := range lines {
 		if f.colorEnabled {
-			c := color.New(color.FgWhite, color.Faint)
-			c.SetWriter(f.out)
+			role := RoleCode
+			if diff {
+				role = diffLineRole(line)
+			}
+			c := f.theme.Color(role)
 			_, _ = c.Fprintf(f.out, "    %s\n", line)
 		} else {
 			_, _ = fmt.Fprintf(f.out, "    %s\n", line)
@@ -452,11 +681,47 @@ func (f *Formatter) CodeBlock(text string) {
 	}
 }
 
+// isUnifiedDiff reports whether lines hold a unified diff, such as the
+// output of "go mod tidy -diff", recognized by a hunk header
+func isUnifiedDiff(lines []string) bool {
+	for _, line := range lines {
+		if strings.HasPrefix(line, "@@ ") {
+			return true
+		}
+	}
+	return false
+}
+
+// diffLineRole returns the role of one line of a unified diff
+func diffLineRole(line string) Role {
+	switch {
+	case strings.HasPrefix(line, "+++ "), strings.HasPrefix(line, "--- "),
+		strings.HasPrefix(line, "@@ "), strings.HasPrefix(line, "diff "):
+		return RoleDiffHunk
+	case strings.HasPrefix(line, "+"):
+		return RoleDiffAdded
+	case strings.HasPrefix(line, "-"):
+		return RoleDiffRemoved
+	default:
+		return RoleCode
+	}
+}
+
+// CheckOutput prints the output one check captured while running as a single
+// block under its name, so output from concurrent checks stays grouped
+func (f *Formatter) CheckOutput(checkName, text string) {
+	text = strings.TrimRight(text, "\n")
+	if text == "" {
+		return
+	}
+	f.Detail("%s output:", checkName)
+	f.CodeBlock(text)
+}
+
 // SuggestAction prints an actionable suggestion
 func (f *Formatter) SuggestAction(action string) {
 	if f.colorEnabled {
-		c := color.New(color.FgMagenta)
-		c.SetWriter(f.out)
+		c := f.theme.Color(RoleSuggestion)
 		_, _ = c.Fprintf(f.out, "💡 %s\n", action)
 	} else {
 		_, _ = fmt.Fprintf(f.out, "💡 %s\n", action)
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"os"
+	"slices"
 	"testing"
 	"time"
 
@@ -258,6 +259,12 @@ func TestParseFumptError(t *testing.T) {
 			expectedMessage:    "Go syntax errors prevent formatting",
 			expectedSuggestion: "Fix syntax errors in your Go files before running fumpt.",
 		},
+		{
+			name:               "ListedFiles",
+			output:             "main.go\npkg/util.go\n",
+			expectedMessage:    "2 file(s) need formatting: main.go, pkg/util.go",
+			expectedSuggestion: "Run 'gofumpt -w' on the listed files and stage the changes.",
+		},
 		{
 			name:               "UnknownError",
 			output:             "some unknown error",
@@ -563,6 +570,135 @@ func TestCodeBlock(t *testing.T) {
 	})
 }
 
+func TestCheckOutput(t *testing.T) {
+	var out bytes.Buffer
+	f := New(Options{
+		ColorEnabled: false,
+		Out:          &out,
+	})
+
+	f.CheckOutput("lint", "first\nsecond\n")
+	assert.Equal(t, "  lint output:\n    first\n    second\n", out.String())
+
+	out.Reset()
+	f.CheckOutput("lint", "\n")
+	assert.Empty(t, out.String(), "empty output prints nothing")
+}
+
+func TestVerbosityLevels(t *testing.T) {
+	var out bytes.Buffer
+	f := New(Options{ColorEnabled: false, Out: &out, Verbosity: VerbosityFiles})
+
+	assert.True(t, f.Verbose(VerbosityNormal))
+	assert.True(t, f.Verbose(VerbosityChecks))
+	assert.True(t, f.Verbose(VerbosityFiles))
+	assert.False(t, f.Verbose(VerbosityCommands))
+
+	f.DetailAt(VerbosityFiles, "file %s", "main.go")
+	f.DetailAt(VerbosityCommands, "$ %s", "gofumpt -l main.go")
+	f.CodeBlockAt(VerbosityChecks, "shown")
+	f.CodeBlockAt(VerbosityCommands, "hidden")
+	assert.Equal(t, "  file main.go\n    shown\n", out.String())
+
+	out.Reset()
+	f.SetVerbosity(VerbosityNormal)
+	f.DetailAt(VerbosityChecks, "hidden")
+	assert.Empty(t, out.String())
+	assert.False(t, f.Verbose(VerbosityChecks))
+}
+
+func TestFileListAt(t *testing.T) {
+	var out bytes.Buffer
+	f := New(Options{ColorEnabled: false, Out: &out, Verbosity: VerbosityFiles, MaxFiles: 2})
+	files := []string{"a.go", "b.go", "c.go", "d.go"}
+
+	f.FileListAt(VerbosityFiles, files)
+	assert.Equal(t, "    a.go\n    b.go\n    ... and 2 more\n", out.String())
+
+	out.Reset()
+	f.FileListAt(VerbosityFiles, files[:2])
+	assert.Equal(t, "    a.go\n    b.go\n", out.String(), "a list at the limit is not truncated")
+
+	out.Reset()
+	f.SetMaxFiles(0)
+	f.FileListAt(VerbosityFiles, files)
+	assert.Equal(t, "    a.go\n    b.go\n    c.go\n    d.go\n", out.String(), "0 lists every file")
+
+	out.Reset()
+	f.FileListAt(VerbosityCommands, files)
+	assert.Empty(t, out.String())
+}
+
+func TestFormatterDiagnostics(t *testing.T) {
+	var out bytes.Buffer
+	f := New(Options{ColorEnabled: false, Out: &out})
+	diagnostics := []Diagnostic{
+		{File: "b.go", Line: 3, Col: 1, Rule: "unused", Message: "unused import"},
+		{File: "a.go", Line: 10, Message: "first line\nsecond line"},
+		{File: "a.go", Line: 2, Col: 5, Rule: "errcheck", Message: "unchecked error"},
+	}
+
+	assert.False(t, f.Diagnostics(diagnostics, 0))
+	assert.Equal(t, "    a.go (2 issues)\n"+
+		"      2:5 errcheck unchecked error\n"+
+		"      10 first line second line\n"+
+		"    b.go (1 issue)\n"+
+		"      3:1 unused unused import\n", out.String())
+
+	out.Reset()
+	assert.True(t, f.Diagnostics(diagnostics, 2), "reports that findings were left out")
+	assert.Equal(t, "    a.go (2 issues)\n"+
+		"      2:5 errcheck unchecked error\n"+
+		"      10 first line second line\n", out.String())
+}
+
+func TestFormatterDiagnosticsGroupsByFile(t *testing.T) {
+	diagnostics := []Diagnostic{
+		{File: "internal/z/z.go", Line: 7, Col: 2, Rule: "govet", Message: "unreachable code"},
+		{File: "cmd/main.go", Line: 12, Col: 4, Rule: "errcheck", Message: "unchecked error"},
+		{File: "internal/a/a.go", Line: 3, Col: 1, Rule: "unused", Message: "unused func"},
+		{File: "cmd/main.go", Line: 12, Col: 4, Rule: "dupword", Message: "duplicate word"},
+		{File: "internal/z/z.go", Line: 1, Col: 9, Rule: "revive", Message: "missing package comment"},
+		{File: "cmd/main.go", Line: 2, Col: 1, Rule: "gofmt", Message: "file is not formatted"},
+	}
+	expected := "    cmd/main.go (3 issues)\n" +
+		"      2:1 gofmt file is not formatted\n" +
+		"      12:4 dupword duplicate word\n" +
+		"      12:4 errcheck unchecked error\n" +
+		"    internal/a/a.go (1 issue)\n" +
+		"      3:1 unused unused func\n" +
+		"    internal/z/z.go (2 issues)\n" +
+		"      1:9 revive missing package comment\n" +
+		"      7:2 govet unreachable code\n"
+
+	var out bytes.Buffer
+	f := New(Options{Out: &out})
+	assert.False(t, f.Diagnostics(diagnostics, 0))
+	assert.Equal(t, expected, out.String())
+
+	// The order does not depend on the order the tools reported findings in
+	reversed := slices.Clone(diagnostics)
+	slices.Reverse(reversed)
+	out.Reset()
+	f.Diagnostics(reversed, 0)
+	assert.Equal(t, expected, out.String())
+
+	out.Reset()
+	assert.True(t, f.Diagnostics(diagnostics, 4))
+	assert.Equal(t, "    cmd/main.go (3 issues)\n"+
+		"      2:1 gofmt file is not formatted\n"+
+		"      12:4 dupword duplicate word\n"+
+		"      12:4 errcheck unchecked error\n"+
+		"    internal/a/a.go (1 issue)\n"+
+		"      3:1 unused unused func\n", out.String())
+
+	out.Reset()
+	f.Diagnostics(diagnostics, 2)
+	assert.Equal(t, "    cmd/main.go (3 issues)\n"+
+		"      2:1 gofmt file is not formatted\n"+
+		"      12:4 dupword duplicate word\n", out.String(), "the count is the file's total when findings are left out")
+}
+
 func TestSuggestAction(t *testing.T) {
 	t.Run("ColorDisabled", func(t *testing.T) {
 		var out bytes.Buffer
@@ -0,0 +1,85 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// progressBarWidth is the number of cells in a live progress bar
+const progressBarWidth = 30
+
+// progressLineStep is the percentage between lines when the bar cannot be
+// redrawn in place
+const progressLineStep = 10
+
+// ProgressBar shows how many of a run's checks have finished. On a terminal
+// it is one line redrawn in place; otherwise it prints a progress line each
+// time another tenth of the checks finishes. It is safe for concurrent use.
+type ProgressBar struct {
+	mu       sync.Mutex
+	f        *Formatter
+	live     bool
+	total    int
+	files    int
+	done     int
+	lastStep int  // the last percentage printed as a line
+	drawn    bool // the live bar is on screen
+}
+
+// NewProgressBar returns a progress bar for total checks over files files,
+// redrawn in place when live is set
+func (f *Formatter) NewProgressBar(total, files int, live bool) *ProgressBar {
+	return &ProgressBar{f: f, live: live, total: total, files: files}
+}
+
+// Advance records that the named check finished and shows the new progress
+func (b *ProgressBar) Advance(name string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.total <= 0 || b.done >= b.total {
+		return
+	}
+	b.done++
+	percent := b.done * 100 / b.total
+
+	if b.live {
+		b.draw(percent, name)
+		return
+	}
+	if step := percent - percent%progressLineStep; step > b.lastStep {
+		b.lastStep = step
+		b.f.Progress("Checked %d%% (%d of %d checks, %d files)", percent, b.done, b.total, b.files)
+	}
+}
+
+// Clear erases the live bar so a message can be printed in its place; the
+// next Advance draws it again
+func (b *ProgressBar) Clear() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.clear()
+}
+
+// Finish erases the live bar once the run is over
+func (b *ProgressBar) Finish() {
+	b.Clear()
+}
+
+// draw replaces the current line with the bar
+func (b *ProgressBar) draw(percent int, name string) {
+	filled := percent * progressBarWidth / 100
+	bar := strings.Repeat("█", filled) + strings.Repeat("░", progressBarWidth-filled)
+	line := fmt.Sprintf("%s %3d%% %d/%d checks · %d files · %s", bar, percent, b.done, b.total, b.files, name)
+	_, _ = fmt.Fprint(b.f.out, "\r\x1b[K"+b.f.colorize(RoleProgress, line))
+	b.drawn = true
+}
+
+// clear erases the live bar when it is drawn
+func (b *ProgressBar) clear() {
+	if b.drawn {
+		_, _ = fmt.Fprint(b.f.out, "\r\x1b[K")
+		b.drawn = false
+	}
+}
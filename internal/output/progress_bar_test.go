@@ -0,0 +1,68 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgressBarLive(t *testing.T) {
+	var buf bytes.Buffer
+	formatter := New(Options{Out: &buf, Err: &buf})
+	bar := formatter.NewProgressBar(4, 120, true)
+
+	bar.Advance("fumpt")
+	assert.Equal(t, "\r\x1b[K███████░░░░░░░░░░░░░░░░░░░░░░░  25% 1/4 checks · 120 files · fumpt", buf.String())
+
+	buf.Reset()
+	bar.Advance("lint")
+	assert.Contains(t, buf.String(), " 50% 2/4 checks · 120 files · lint")
+
+	buf.Reset()
+	bar.Clear()
+	bar.Clear()
+	assert.Equal(t, "\r\x1b[K", buf.String(), "clearing twice erases once")
+
+	buf.Reset()
+	bar.Advance("eof")
+	bar.Advance("whitespace")
+	bar.Advance("extra")
+	assert.Contains(t, buf.String(), "██████████████████████████████ 100% 4/4 checks")
+	assert.NotContains(t, buf.String(), "extra", "progress stops at the total")
+
+	buf.Reset()
+	bar.Finish()
+	assert.Equal(t, "\r\x1b[K", buf.String())
+}
+
+func TestProgressBarLines(t *testing.T) {
+	var buf bytes.Buffer
+	formatter := New(Options{Out: &buf, Err: &buf})
+	bar := formatter.NewProgressBar(15, 3000, false)
+
+	for range 15 {
+		bar.Advance("check")
+	}
+	bar.Finish()
+
+	assert.Equal(t, "⏳ Checked 13% (2 of 15 checks, 3000 files)\n"+
+		"⏳ Checked 20% (3 of 15 checks, 3000 files)\n"+
+		"⏳ Checked 33% (5 of 15 checks, 3000 files)\n"+
+		"⏳ Checked 40% (6 of 15 checks, 3000 files)\n"+
+		"⏳ Checked 53% (8 of 15 checks, 3000 files)\n"+
+		"⏳ Checked 60% (9 of 15 checks, 3000 files)\n"+
+		"⏳ Checked 73% (11 of 15 checks, 3000 files)\n"+
+		"⏳ Checked 80% (12 of 15 checks, 3000 files)\n"+
+		"⏳ Checked 93% (14 of 15 checks, 3000 files)\n"+
+		"⏳ Checked 100% (15 of 15 checks, 3000 files)\n", buf.String())
+}
+
+func TestProgressBarNoChecks(t *testing.T) {
+	var buf bytes.Buffer
+	formatter := New(Options{Out: &buf, Err: &buf})
+	bar := formatter.NewProgressBar(0, 0, true)
+	bar.Advance("lint")
+	bar.Finish()
+	assert.Empty(t, buf.String())
+}
@@ -0,0 +1,70 @@
+package output
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// fixDecision records a standing all/none answer for the remaining prompts
+type fixDecision int
+
+const (
+	fixDecisionAsk fixDecision = iota
+	fixDecisionAll
+	fixDecisionNone
+)
+
+// FixPrompter asks the user to confirm auto-fixes one file at a time. Answering
+// "all" or "none" applies that choice to every remaining file without asking.
+// It is safe for concurrent use by checks running in parallel.
+type FixPrompter struct {
+	mu       sync.Mutex
+	in       *bufio.Reader
+	out      io.Writer
+	decision fixDecision
+}
+
+// NewFixPrompter creates a prompter reading answers from in and writing prompts to out
+func NewFixPrompter(in io.Reader, out io.Writer) *FixPrompter {
+	return &FixPrompter{
+		in:  bufio.NewReader(in),
+		out: out,
+	}
+}
+
+// Confirm asks whether checkName may fix file. Unrecognized input re-prompts,
+// and end of input declines so an abandoned prompt never rewrites files.
+func (p *FixPrompter) Confirm(checkName, file string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for {
+		switch p.decision {
+		case fixDecisionAll:
+			return true
+		case fixDecisionNone:
+			return false
+		case fixDecisionAsk:
+		}
+
+		_, _ = fmt.Fprintf(p.out, "Apply %s fix to %s? [y]es/[n]o/[a]ll/[N]one: ", checkName, file)
+		answer, err := p.in.ReadString('\n')
+		answer = strings.TrimSpace(answer)
+
+		switch {
+		case answer == "y" || strings.EqualFold(answer, "yes"):
+			return true
+		case answer == "n" || strings.EqualFold(answer, "no"):
+			return false
+		case answer == "a" || strings.EqualFold(answer, "all"):
+			p.decision = fixDecisionAll
+		case answer == "N" || strings.EqualFold(answer, "none"):
+			p.decision = fixDecisionNone
+		case err != nil:
+			p.decision = fixDecisionNone
+		}
+	}
+}
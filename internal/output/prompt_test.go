@@ -0,0 +1,41 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixPrompter_Confirm(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		files   int
+		want    []bool
+		prompts int
+	}{
+		{name: "yes then no", input: "y\nn\n", files: 2, want: []bool{true, false}, prompts: 2},
+		{name: "long form answers", input: "YES\nNo\n", files: 2, want: []bool{true, false}, prompts: 2},
+		{name: "all applies to remaining files", input: "a\n", files: 3, want: []bool{true, true, true}, prompts: 1},
+		{name: "none declines remaining files", input: "n\nN\n", files: 3, want: []bool{false, false, false}, prompts: 2},
+		{name: "unrecognized input re-prompts", input: "maybe\ny\n", files: 1, want: []bool{true}, prompts: 2},
+		{name: "end of input declines", input: "", files: 2, want: []bool{false, false}, prompts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			p := NewFixPrompter(strings.NewReader(tt.input), &out)
+
+			got := make([]bool, 0, tt.files)
+			for i := 0; i < tt.files; i++ {
+				got = append(got, p.Confirm("whitespace", "file.go"))
+			}
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.prompts, strings.Count(out.String(), "Apply whitespace fix to file.go?"))
+		})
+	}
+}
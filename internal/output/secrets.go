@@ -0,0 +1,152 @@
+package output
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+// redactedLine replaces the text of a diff line that holds a secret
+const redactedLine = "****"
+
+// defaultGitleaksRules holds the gitleaks default rules Diff redacts with
+//
+//go:embed gitleaks_rules.toml
+var defaultGitleaksRules []byte
+
+//nolint:gochecknoglobals // Read-only detection rules
+var (
+	// secretPatterns match single-line credentials, compiled from the
+	// gitleaks default rules
+	secretPatterns = mustParseGitleaksRules(defaultGitleaksRules)
+
+	// pemBegin and pemEnd match the lines that open and close a PEM private
+	// key, which gitleaks can only match within a single line
+	pemBegin = regexp.MustCompile(`-----BEGIN [A-Z0-9 ]*PRIVATE KEY( BLOCK)?-----`)
+	pemEnd   = regexp.MustCompile(`-----END [A-Z0-9 ]*PRIVATE KEY( BLOCK)?-----`)
+)
+
+// gitleaksConfig is the part of a gitleaks configuration file redaction uses
+type gitleaksConfig struct {
+	Rules []struct {
+		ID    string `toml:"id"`
+		Regex string `toml:"regex"`
+	} `toml:"rules"`
+}
+
+// parseGitleaksRules compiles the regex of each rule in a gitleaks
+// configuration. Rules without a regex, such as path-only rules, are skipped.
+func parseGitleaksRules(data []byte) ([]*regexp.Regexp, error) {
+	var cfg gitleaksConfig
+	if err := toml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	patterns := make([]*regexp.Regexp, 0, len(cfg.Rules))
+	for _, rule := range cfg.Rules {
+		if rule.Regex == "" {
+			continue
+		}
+		pattern, err := regexp.Compile(rule.Regex)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: %w", rule.ID, err)
+		}
+		patterns = append(patterns, pattern)
+	}
+	return patterns, nil
+}
+
+// mustParseGitleaksRules is parseGitleaksRules for the embedded rules
+func mustParseGitleaksRules(data []byte) []*regexp.Regexp {
+	patterns, err := parseGitleaksRules(data)
+	if err != nil {
+		panic(fmt.Sprintf("invalid embedded gitleaks rules: %v", err))
+	}
+	return patterns
+}
+
+// LoadGitleaksRules adds the rules of a gitleaks configuration file, such as
+// a project's .gitleaks.toml, to the ones Diff redacts with
+func (f *Formatter) LoadGitleaksRules(path string) error {
+	data, err := os.ReadFile(path) //nolint:gosec // path is a gitleaks config found in the repository
+	if err != nil {
+		return err
+	}
+	patterns, err := parseGitleaksRules(data)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	f.secretPatterns = append(f.secretPatterns, patterns...)
+	return nil
+}
+
+// redactSecrets returns lines of a unified diff with every line that holds a
+// secret replaced by "****" after its diff marker, so the change still shows
+// as removed, added, or context. A line holds a secret when it matches a
+// default rule or one of extra. A PEM private key is redacted from its BEGIN
+// line through its END line, following the old and new sides of the diff
+// separately since their lines interleave. Lines without secrets are returned
+// unchanged.
+func redactSecrets(lines []string, extra []*regexp.Regexp) []string {
+	var out []string
+	var inKey [2]bool // in a PEM key on the old side, the new side
+	for i, line := range lines {
+		secret := false
+		for _, side := range diffSides(line) {
+			switch {
+			case inKey[side]:
+				secret = true
+				inKey[side] = !pemEnd.MatchString(line)
+			case pemBegin.MatchString(line) && !pemEnd.MatchString(line):
+				secret = true
+				inKey[side] = true
+			}
+		}
+		if !secret && !containsSecret(line, secretPatterns) && !containsSecret(line, extra) {
+			continue
+		}
+		if out == nil {
+			out = append([]string(nil), lines...)
+		}
+		out[i] = redact(line)
+	}
+	if out == nil {
+		return lines
+	}
+	return out
+}
+
+// diffSides returns the sides of a diff a line belongs to: 0 for the old
+// file, 1 for the new one. Removed lines are only in the old file and added
+// lines only in the new one; context and any other lines are in both.
+func diffSides(line string) []int {
+	switch {
+	case strings.HasPrefix(line, "-"):
+		return []int{0}
+	case strings.HasPrefix(line, "+"):
+		return []int{1}
+	default:
+		return []int{0, 1}
+	}
+}
+
+// containsSecret reports whether line matches any of patterns
+func containsSecret(line string, patterns []*regexp.Regexp) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(line) {
+			return true
+		}
+	}
+	return false
+}
+
+// redact returns a diff line's marker followed by "****"
+func redact(line string) string {
+	if line != "" && strings.ContainsRune(" +-", rune(line[0])) {
+		return line[:1] + redactedLine
+	}
+	return redactedLine
+}
@@ -0,0 +1,81 @@
+package output
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Sink names accepted by Options.Sink and the --output-dest flag
+const (
+	SinkStdout     = "stdout"
+	SinkStderr     = "stderr"
+	SinkSyslog     = "syslog"
+	sinkFilePrefix = "file:"
+
+	// syslogTag identifies go-pre-commit entries in the system log
+	syslogTag = "go-pre-commit"
+)
+
+var (
+	// ErrUnknownSink is returned when a sink name is not recognized
+	ErrUnknownSink = errors.New("unknown output destination")
+
+	// ErrSyslogUnsupported is returned when syslog is requested on a platform without it
+	ErrSyslogUnsupported = errors.New("syslog output is not supported on this platform")
+)
+
+// sink is a resolved output destination. Informational output goes to out and
+// warnings and errors go to err, which may be the same writer.
+type sink struct {
+	out         io.Writer
+	err         io.Writer
+	closers     []io.Closer
+	interactive bool // writes to a terminal stream, so color is allowed
+}
+
+// IsValidSink reports whether name is a recognized sink. An empty name selects stdout.
+func IsValidSink(name string) bool {
+	switch name {
+	case "", SinkStdout, SinkStderr, SinkSyslog:
+		return true
+	default:
+		return strings.HasPrefix(name, sinkFilePrefix) && len(name) > len(sinkFilePrefix)
+	}
+}
+
+// openSink resolves a sink name into writers:
+//   - stdout (default): information to stdout, warnings and errors to stderr
+//   - stderr: everything to stderr
+//   - file:<path>: everything appended to path
+//   - syslog: information at LOG_INFO, warnings and errors at LOG_ERR
+func openSink(name string) (*sink, error) {
+	switch {
+	case name == "" || name == SinkStdout:
+		return &sink{out: os.Stdout, err: os.Stderr, interactive: true}, nil
+	case name == SinkStderr:
+		return &sink{out: os.Stderr, err: os.Stderr, interactive: true}, nil
+	case name == SinkSyslog:
+		return openSyslogSink()
+	case strings.HasPrefix(name, sinkFilePrefix) && len(name) > len(sinkFilePrefix):
+		path := strings.TrimPrefix(name, sinkFilePrefix)
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600) //nolint:gosec // path is chosen by the user
+		if err != nil {
+			return nil, fmt.Errorf("failed to open output file: %w", err)
+		}
+		return &sink{out: f, err: f, closers: []io.Closer{f}}, nil
+	default:
+		return nil, fmt.Errorf("%w: %q (use stdout, stderr, file:<path>, or syslog)", ErrUnknownSink, name)
+	}
+}
+
+// close releases the sink's underlying files or connections
+func (s *sink) close() error {
+	var errs []error
+	for _, c := range s.closers {
+		errs = append(errs, c.Close())
+	}
+	return errors.Join(errs...)
+}
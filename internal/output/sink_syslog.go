@@ -0,0 +1,23 @@
+//go:build !windows && !plan9
+
+package output
+
+import (
+	"fmt"
+	"io"
+	"log/syslog"
+)
+
+// openSyslogSink connects to the local syslog daemon
+func openSyslogSink() (*sink, error) {
+	info, err := syslog.New(syslog.LOG_INFO|syslog.LOG_USER, syslogTag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to syslog: %w", err)
+	}
+	errs, err := syslog.New(syslog.LOG_ERR|syslog.LOG_USER, syslogTag)
+	if err != nil {
+		_ = info.Close()
+		return nil, fmt.Errorf("failed to connect to syslog: %w", err)
+	}
+	return &sink{out: info, err: errs, closers: []io.Closer{info, errs}}, nil
+}
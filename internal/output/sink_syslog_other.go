@@ -0,0 +1,8 @@
+//go:build windows || plan9
+
+package output
+
+// openSyslogSink reports that syslog is unavailable on this platform
+func openSyslogSink() (*sink, error) {
+	return nil, ErrSyslogUnsupported
+}
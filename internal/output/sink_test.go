@@ -0,0 +1,90 @@
+package output
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsValidSink(t *testing.T) {
+	for _, name := range []string{"", SinkStdout, SinkStderr, SinkSyslog, "file:/tmp/out.log"} {
+		assert.True(t, IsValidSink(name), name)
+	}
+	for _, name := range []string{"file:", "stdout2", "tcp://localhost"} {
+		assert.False(t, IsValidSink(name), name)
+	}
+}
+
+func TestOpenFileSinkWritesAllOutput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pre-commit.log")
+	require.NoError(t, os.WriteFile(path, []byte("previous run\n"), 0o600))
+
+	f, err := Open(Options{ColorEnabled: true, Sink: "file:" + path})
+	require.NoError(t, err)
+	assert.False(t, f.colorEnabled, "file output must not contain color codes")
+
+	f.Info("checking %d files", 3)
+	f.Error("lint failed")
+	require.NoError(t, f.Close())
+
+	content, err := os.ReadFile(path) //nolint:gosec // test file in temp dir
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "previous run\n", "file sink appends")
+	assert.Contains(t, string(content), "checking 3 files")
+	assert.Contains(t, string(content), "lint failed")
+	assert.NotContains(t, string(content), "\x1b[")
+}
+
+func TestOpenFileSinkUnwritable(t *testing.T) {
+	_, err := Open(Options{Sink: "file:" + filepath.Join(t.TempDir(), "missing", "out.log")})
+	require.Error(t, err)
+}
+
+func TestOpenUnknownSink(t *testing.T) {
+	_, err := Open(Options{Sink: "carrier-pigeon"})
+	require.ErrorIs(t, err, ErrUnknownSink)
+	assert.Contains(t, err.Error(), "carrier-pigeon")
+}
+
+func TestOpenStdoutSinkRouting(t *testing.T) {
+	s, err := openSink(SinkStdout)
+	require.NoError(t, err)
+	assert.Same(t, os.Stdout, s.out)
+	assert.Same(t, os.Stderr, s.err, "errors stay on stderr")
+	assert.True(t, s.interactive)
+
+	s, err = openSink("")
+	require.NoError(t, err)
+	assert.Same(t, os.Stdout, s.out)
+	assert.Same(t, os.Stderr, s.err)
+}
+
+func TestOpenStderrSinkRouting(t *testing.T) {
+	s, err := openSink(SinkStderr)
+	require.NoError(t, err)
+	assert.Same(t, os.Stderr, s.out)
+	assert.Same(t, os.Stderr, s.err)
+}
+
+func TestOpenExplicitWritersTakePrecedence(t *testing.T) {
+	var out, errOut bytes.Buffer
+	f, err := Open(Options{Out: &out, Err: &errOut, Sink: SinkStderr})
+	require.NoError(t, err)
+	defer func() { _ = f.Close() }()
+
+	f.Info("info message")
+	f.Error("error message")
+
+	assert.Contains(t, out.String(), "info message")
+	assert.NotContains(t, out.String(), "error message")
+	assert.Contains(t, errOut.String(), "error message")
+	assert.NotContains(t, errOut.String(), "info message")
+}
+
+func TestCloseWithoutSink(t *testing.T) {
+	assert.NoError(t, New(Options{Out: &bytes.Buffer{}}).Close())
+}
@@ -0,0 +1,160 @@
+package output
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// Role is a part of the output a theme colors by what it means rather than
+// by where it is printed
+type Role int
+
+const (
+	// RoleSuccess colors passed checks and other good news
+	RoleSuccess Role = iota
+	// RoleError colors failures
+	RoleError
+	// RoleWarning colors warnings, skipped checks, and highlighted text
+	RoleWarning
+	// RoleInfo colors informational messages
+	RoleInfo
+	// RoleProgress colors progress messages
+	RoleProgress
+	// RoleHeader colors section headers
+	RoleHeader
+	// RoleRule colors the line under section headers
+	RoleRule
+	// RoleSubheader colors subsection headers
+	RoleSubheader
+	// RoleCode colors tool output shown as a code block
+	RoleCode
+	// RoleSuggestion colors suggested actions
+	RoleSuggestion
+	// RolePath colors file paths in diagnostics
+	RolePath
+	// RoleLineNumber colors line and column positions in diagnostics
+	RoleLineNumber
+	// RoleDiffAdded colors lines a diff adds
+	RoleDiffAdded
+	// RoleDiffRemoved colors lines a diff removes
+	RoleDiffRemoved
+	// RoleDiffHunk colors diff file and hunk headers
+	RoleDiffHunk
+)
+
+// Names of the built-in themes, selected with GO_PRE_COMMIT_OUTPUT_THEME
+const (
+	ThemeDefault      = "default"
+	ThemeHighContrast = "high-contrast"
+	ThemeMonochrome   = "monochrome"
+)
+
+// Theme maps roles to the color attributes they print with. The zero Theme
+// is the default theme.
+type Theme struct {
+	name  string
+	roles map[Role][]color.Attribute
+}
+
+// themes holds the built-in themes by name
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var themes = map[string]map[Role][]color.Attribute{
+	ThemeDefault: {
+		RoleSuccess:     {color.FgGreen},
+		RoleError:       {color.FgRed},
+		RoleWarning:     {color.FgYellow},
+		RoleInfo:        {color.FgBlue},
+		RoleProgress:    {color.FgCyan},
+		RoleHeader:      {color.FgCyan, color.Bold},
+		RoleRule:        {color.FgCyan},
+		RoleSubheader:   {color.FgWhite, color.Bold},
+		RoleCode:        {color.FgWhite, color.Faint},
+		RoleSuggestion:  {color.FgMagenta},
+		RolePath:        {color.FgCyan},
+		RoleLineNumber:  {color.FgYellow},
+		RoleDiffAdded:   {color.FgGreen},
+		RoleDiffRemoved: {color.FgRed},
+		RoleDiffHunk:    {color.FgCyan},
+	},
+	// Bright, bold colors for low-contrast terminals and color schemes
+	ThemeHighContrast: {
+		RoleSuccess:     {color.FgHiGreen, color.Bold},
+		RoleError:       {color.FgHiRed, color.Bold},
+		RoleWarning:     {color.FgHiYellow, color.Bold},
+		RoleInfo:        {color.FgHiCyan},
+		RoleProgress:    {color.FgHiCyan},
+		RoleHeader:      {color.FgHiWhite, color.Bold, color.Underline},
+		RoleRule:        {color.FgHiWhite},
+		RoleSubheader:   {color.FgHiWhite, color.Bold},
+		RoleCode:        {color.FgHiWhite},
+		RoleSuggestion:  {color.FgHiMagenta, color.Bold},
+		RolePath:        {color.FgHiCyan, color.Bold},
+		RoleLineNumber:  {color.FgHiYellow},
+		RoleDiffAdded:   {color.FgHiGreen, color.Bold},
+		RoleDiffRemoved: {color.FgHiRed, color.Bold},
+		RoleDiffHunk:    {color.FgHiCyan, color.Bold},
+	},
+	// Emphasis without hues, for color-blind readers and terminals that
+	// render colors poorly
+	ThemeMonochrome: {
+		RoleError:       {color.Bold},
+		RoleWarning:     {color.Bold},
+		RoleHeader:      {color.Bold, color.Underline},
+		RoleSubheader:   {color.Bold},
+		RoleCode:        {color.Faint},
+		RoleSuggestion:  {color.Italic},
+		RolePath:        {color.Underline},
+		RoleLineNumber:  {color.Faint},
+		RoleDiffAdded:   {color.Bold},
+		RoleDiffRemoved: {color.Faint},
+		RoleDiffHunk:    {color.Underline},
+	},
+}
+
+// LookupTheme returns the built-in theme with the given name, matched case
+// insensitively. An empty name is the default theme.
+func LookupTheme(name string) (Theme, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		name = ThemeDefault
+	}
+	roles, ok := themes[name]
+	if !ok {
+		return Theme{}, false
+	}
+	return Theme{name: name, roles: roles}, true
+}
+
+// ThemeNames returns the names of the built-in themes in sorted order
+func ThemeNames() []string {
+	return slices.Sorted(maps.Keys(themes))
+}
+
+// Name returns the name of the theme
+func (t Theme) Name() string {
+	if t.name == "" {
+		return ThemeDefault
+	}
+	return t.name
+}
+
+// Color returns the color the theme prints role with. Output that uses it
+// is colored whether or not stdout is a terminal; the formatter only asks
+// for it once color is enabled. Roles a theme leaves out print plainly.
+func (t Theme) Color(role Role) *color.Color {
+	roles := t.roles
+	if roles == nil {
+		roles = themes[ThemeDefault]
+	}
+	c := color.New(roles[role]...)
+	if len(roles[role]) == 0 {
+		c.DisableColor()
+		return c
+	}
+	c.EnableColor()
+	return c
+}
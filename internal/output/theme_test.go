@@ -0,0 +1,117 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newThemedFormatter returns a formatter with color forced on, writing
+// everything to one buffer
+func newThemedFormatter(t *testing.T, name string) (*Formatter, *bytes.Buffer) {
+	t.Helper()
+	theme, ok := LookupTheme(name)
+	require.True(t, ok, name)
+	var buf bytes.Buffer
+	return New(Options{ColorEnabled: true, Out: &buf, Err: &buf, Theme: theme}), &buf
+}
+
+func TestLookupTheme(t *testing.T) {
+	assert.Equal(t, []string{ThemeDefault, ThemeHighContrast, ThemeMonochrome}, ThemeNames())
+
+	for _, name := range []string{"", "default", " High-Contrast ", "monochrome"} {
+		_, ok := LookupTheme(name)
+		assert.True(t, ok, name)
+	}
+	_, ok := LookupTheme("solarized")
+	assert.False(t, ok)
+
+	theme, _ := LookupTheme("")
+	assert.Equal(t, ThemeDefault, theme.Name())
+	assert.Equal(t, ThemeDefault, Theme{}.Name(), "the zero Theme is the default")
+}
+
+func TestThemeColorCodes(t *testing.T) {
+	tests := []struct {
+		theme string
+		role  Role
+		want  string // the escape sequence that starts the text
+	}{
+		{theme: ThemeDefault, role: RoleError, want: "\x1b[31m"},
+		{theme: ThemeHighContrast, role: RoleError, want: "\x1b[91;1m"},
+		{theme: ThemeMonochrome, role: RoleError, want: "\x1b[1m"},
+		{theme: ThemeDefault, role: RolePath, want: "\x1b[36m"},
+		{theme: ThemeHighContrast, role: RolePath, want: "\x1b[96;1m"},
+		{theme: ThemeMonochrome, role: RolePath, want: "\x1b[4m"},
+		{theme: ThemeDefault, role: RoleLineNumber, want: "\x1b[33m"},
+		{theme: ThemeMonochrome, role: RoleLineNumber, want: "\x1b[2m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.theme, func(t *testing.T) {
+			theme, ok := LookupTheme(tt.theme)
+			require.True(t, ok)
+			assert.True(t, strings.HasPrefix(theme.Color(tt.role).Sprint("x"), tt.want+"x\x1b["))
+		})
+	}
+
+	monochrome, _ := LookupTheme(ThemeMonochrome)
+	assert.Equal(t, "x", monochrome.Color(RoleSuccess).Sprint("x"), "roles a theme leaves out print plainly")
+}
+
+func TestFormatterThemes(t *testing.T) {
+	t.Run("messages", func(t *testing.T) {
+		formatter, buf := newThemedFormatter(t, ThemeDefault)
+		formatter.Error("boom")
+		assert.Equal(t, "\x1b[31m✗ boom\n\x1b[0m", buf.String())
+
+		formatter, buf = newThemedFormatter(t, ThemeHighContrast)
+		formatter.Error("boom")
+		assert.Equal(t, "\x1b[91;1m✗ boom\n\x1b[0m", buf.String())
+
+		formatter.SetTheme(Theme{})
+		buf.Reset()
+		formatter.Warning("careful")
+		assert.Equal(t, "\x1b[33m⚠ careful\n\x1b[0m", buf.String())
+	})
+
+	t.Run("diagnostics", func(t *testing.T) {
+		diagnostics := []Diagnostic{{File: "a.go", Line: 3, Col: 2, Severity: SeverityError, Message: "bad"}}
+
+		formatter, buf := newThemedFormatter(t, ThemeDefault)
+		formatter.Diagnostics(diagnostics, 0)
+		assert.Contains(t, buf.String(), "    \x1b[36ma.go\x1b[")
+		assert.Contains(t, buf.String(), "      \x1b[33m3:2\x1b[")
+
+		formatter, buf = newThemedFormatter(t, ThemeMonochrome)
+		formatter.Diagnostics(diagnostics, 0)
+		assert.Contains(t, buf.String(), "    \x1b[4ma.go\x1b[")
+		assert.Contains(t, buf.String(), "      \x1b[2m3:2\x1b[")
+	})
+
+	t.Run("diffs", func(t *testing.T) {
+		formatter, buf := newThemedFormatter(t, ThemeDefault)
+		formatter.CodeBlock("--- a/go.mod\n+++ b/go.mod\n@@ -1 +1 @@\n-go 1.21\n+go 1.22")
+		assert.Equal(t, "\x1b[36m    --- a/go.mod\n\x1b[0m"+
+			"\x1b[36m    +++ b/go.mod\n\x1b[0m"+
+			"\x1b[36m    @@ -1 +1 @@\n\x1b[0m"+
+			"\x1b[31m    -go 1.21\n\x1b[0m"+
+			"\x1b[32m    +go 1.22\n\x1b[0m", buf.String())
+
+		buf.Reset()
+		formatter.CodeBlock("- not a diff")
+		assert.Equal(t, "\x1b[37;2m    - not a diff\n\x1b[0m", buf.String(), "lists stay code colored")
+	})
+
+	t.Run("color off ignores the theme", func(t *testing.T) {
+		theme, _ := LookupTheme(ThemeHighContrast)
+		var buf bytes.Buffer
+		formatter := New(Options{Out: &buf, Err: &buf, Theme: theme})
+		formatter.Error("boom")
+		formatter.Diagnostics([]Diagnostic{{File: "a.go", Line: 3, Message: "bad"}}, 0)
+		assert.Equal(t, "✗ boom\n    a.go (1 issue)\n      3 bad\n", buf.String())
+	})
+}
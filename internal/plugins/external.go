@@ -0,0 +1,242 @@
+package plugins
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/output"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// ErrInvalidExternalOutput is returned when an external check's stdout does
+// not follow the ExternalCheckResponse contract
+var ErrInvalidExternalOutput = errors.New("invalid external check output")
+
+// ExternalCheck runs a configured shell command as a check. The command is
+// run with "sh -c" from the repository root, gets the files to check on
+// stdin, one path per line relative to the root, and prints an
+// ExternalCheckResponse as JSON on stdout. It exits 0 whether or not it
+// found anything; any other exit status, a timeout, or output that breaks
+// the contract fails the check. What the command writes to stderr is kept
+// with the check's output.
+type ExternalCheck struct {
+	sharedCtx *shared.Context
+	name      string
+	command   string
+	timeout   time.Duration
+}
+
+// ExternalCheckResponse is the JSON an external check prints on stdout. An
+// empty stdout is read as no diagnostics. Each diagnostic needs a file and a
+// message; line and col are 1-based, or 0 when unknown, and severity is
+// error, warning, or info, defaulting to error. Any error diagnostic blocks
+// the commit; warnings and infos are reported without blocking it.
+type ExternalCheckResponse struct {
+	Diagnostics []output.Diagnostic `json:"diagnostics"`
+}
+
+// NewExternalCheck creates a check named name that runs command
+func NewExternalCheck(sharedCtx *shared.Context, name, command string, timeout time.Duration) *ExternalCheck {
+	if sharedCtx == nil {
+		sharedCtx = shared.NewContext()
+	}
+	if timeout <= 0 {
+		timeout = 60 * time.Second
+	}
+	return &ExternalCheck{
+		sharedCtx: sharedCtx,
+		name:      name,
+		command:   command,
+		timeout:   timeout,
+	}
+}
+
+// Name returns the name of the check
+func (e *ExternalCheck) Name() string {
+	return e.name
+}
+
+// Description returns a brief description of the check
+func (e *ExternalCheck) Description() string {
+	return "External check: " + e.command
+}
+
+// Metadata returns comprehensive metadata about the check
+func (e *ExternalCheck) Metadata() any {
+	return PluginMetadata{
+		Name:              e.name,
+		Description:       e.Description(),
+		EstimatedDuration: 5 * time.Second,
+		DefaultTimeout:    e.timeout,
+		Category:          "external",
+		RequiresFiles:     true,
+	}
+}
+
+// FilterFiles passes every file to the command, which picks its own
+func (e *ExternalCheck) FilterFiles(files []string) []string {
+	return files
+}
+
+// Run executes the command on files and reports the diagnostics it prints
+func (e *ExternalCheck) Run(ctx context.Context, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, e.timeout)
+	defer cancel()
+
+	repoRoot, err := e.sharedCtx.GetRepoRoot(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to find repository root: %w", err)
+	}
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", e.command) //nolint:gosec // Command from the repository's own configuration
+	cmd.Dir = repoRoot
+	// Killing sh on timeout can leave the command's children holding stdout
+	// open; stop waiting for them shortly after
+	cmd.WaitDelay = time.Second
+	shared.ApplyWorkDir(ctx, cmd)
+	shared.LogCommand(ctx, cmd)
+	cmd.Env = append(os.Environ(), "GO_PRE_COMMIT_CHECK="+e.name)
+	cmd.Stdin = strings.NewReader(strings.Join(relativeFiles(repoRoot, files), "\n") + "\n")
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err = cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return prerrors.NewToolExecutionError(
+				e.name,
+				stderr.String(),
+				fmt.Sprintf("External check timed out after %v; raise GO_PRE_COMMIT_PLUGIN_TIMEOUT if it needs longer", e.timeout),
+			)
+		}
+		return prerrors.NewToolExecutionError(
+			e.name,
+			strings.TrimSpace(stderr.String()+"\n"+stdout.String()),
+			fmt.Sprintf("External check failed (%v); it should exit 0 and report findings as JSON diagnostics", err),
+		)
+	}
+
+	// Diagnostics a successful command printed are kept with its result
+	// rather than written to the shared terminal
+	_, _ = shared.CheckOutput(ctx).Write(stderr.Bytes())
+
+	diagnostics, err := ParseExternalCheckOutput(stdout.Bytes())
+	if err != nil {
+		return &prerrors.CheckError{
+			Err:        err,
+			Command:    e.name,
+			Message:    fmt.Sprintf("%s printed output go-pre-commit cannot read", e.name),
+			Suggestion: `Print {"diagnostics": [{"file": "...", "line": 1, "severity": "error", "message": "..."}]} on stdout`,
+			Output:     stdout.String(),
+		}
+	}
+	if len(diagnostics) == 0 {
+		return nil
+	}
+	return e.findingsError(diagnostics)
+}
+
+// findingsError reports the diagnostics, blocking the commit only when one
+// of them is an error
+func (e *ExternalCheck) findingsError(diagnostics []output.Diagnostic) error {
+	output.SortDiagnostics(diagnostics)
+
+	blocking := 0
+	lines := make([]string, 0, len(diagnostics))
+	files := make([]string, 0, len(diagnostics))
+	seen := make(map[string]bool, len(diagnostics))
+	for _, d := range diagnostics {
+		line := fmt.Sprintf("%s:%d", d.File, d.Line)
+		if d.Col > 0 {
+			line += fmt.Sprintf(":%d", d.Col)
+		}
+		line += ": " + d.Message
+		if d.Rule != "" {
+			line += " (" + d.Rule + ")"
+		}
+		if d.Severity == output.SeverityError {
+			blocking++
+		} else {
+			line = "[" + d.Severity + "] " + line
+		}
+		lines = append(lines, line)
+		if !seen[d.File] {
+			seen[d.File] = true
+			files = append(files, d.File)
+		}
+	}
+
+	return &prerrors.CheckError{
+		Err:         prerrors.ErrExternalCheckFindings,
+		Message:     fmt.Sprintf("%s reported %d finding(s), %d error(s)", e.name, len(diagnostics), blocking),
+		Suggestion:  "Fix the findings the external check reported",
+		Output:      strings.Join(lines, "\n"),
+		Files:       files,
+		WarnOnly:    blocking == 0,
+		Diagnostics: diagnostics,
+	}
+}
+
+// relativeFiles returns files relative to repoRoot, as the command gets them
+func relativeFiles(repoRoot string, files []string) []string {
+	relative := make([]string, 0, len(files))
+	for _, file := range files {
+		if filepath.IsAbs(file) {
+			if rel, err := filepath.Rel(repoRoot, file); err == nil {
+				file = rel
+			}
+		}
+		relative = append(relative, filepath.ToSlash(file))
+	}
+	return relative
+}
+
+// ParseExternalCheckOutput decodes and validates the stdout of an external
+// check. Severities left empty become error.
+func ParseExternalCheckOutput(data []byte) ([]output.Diagnostic, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+
+	var response ExternalCheckResponse
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&response); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidExternalOutput, err)
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("%w: unexpected data after the JSON object", ErrInvalidExternalOutput)
+	}
+
+	for i := range response.Diagnostics {
+		d := &response.Diagnostics[i]
+		if d.Severity == "" {
+			d.Severity = output.SeverityError
+		}
+		switch {
+		case d.File == "":
+			return nil, fmt.Errorf("%w: diagnostics[%d] has no file", ErrInvalidExternalOutput, i)
+		case d.Message == "":
+			return nil, fmt.Errorf("%w: diagnostics[%d] has no message", ErrInvalidExternalOutput, i)
+		case d.Line < 0 || d.Col < 0:
+			return nil, fmt.Errorf("%w: diagnostics[%d] has a negative line or col", ErrInvalidExternalOutput, i)
+		case d.Severity != output.SeverityError && d.Severity != output.SeverityWarning && d.Severity != output.SeverityInfo:
+			return nil, fmt.Errorf("%w: diagnostics[%d] severity must be error, warning, or info (got: '%s')", ErrInvalidExternalOutput, i, d.Severity)
+		}
+	}
+	return response.Diagnostics, nil
+}
@@ -0,0 +1,153 @@
+package plugins
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/output"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// fakeExternalCheck writes script as an executable in a temporary repository
+// root, which GO_PRE_COMMIT_REPO_ROOT points the check at
+func fakeExternalCheck(t *testing.T, script string) string {
+	t.Helper()
+	root := t.TempDir()
+	t.Setenv(shared.RepoRootEnv, root)
+	require.NoError(t, os.WriteFile(filepath.Join(root, "check.sh"), []byte("#!/bin/sh\n"+script), 0o755)) //nolint:gosec // Test script must be executable
+	return root
+}
+
+func TestParseExternalCheckOutput(t *testing.T) {
+	tests := []struct {
+		name    string
+		stdout  string
+		want    []output.Diagnostic
+		wantErr string
+	}{
+		{name: "empty output", stdout: " \n"},
+		{name: "no diagnostics", stdout: `{"diagnostics": []}`, want: []output.Diagnostic{}},
+		{
+			name:   "severity defaults to error",
+			stdout: `{"diagnostics": [{"file": "a.go", "line": 3, "col": 2, "rule": "R1", "message": "bad"}, {"file": "b.go", "severity": "info", "message": "note"}]}`,
+			want: []output.Diagnostic{
+				{File: "a.go", Line: 3, Col: 2, Severity: output.SeverityError, Rule: "R1", Message: "bad"},
+				{File: "b.go", Severity: output.SeverityInfo, Message: "note"},
+			},
+		},
+		{name: "not JSON", stdout: "a.go:1: bad", wantErr: "invalid character"},
+		{name: "unknown field", stdout: `{"diagnostics": [{"file": "a.go", "column": 1, "message": "bad"}]}`, wantErr: `unknown field "column"`},
+		{name: "trailing data", stdout: `{"diagnostics": []} {}`, wantErr: "unexpected data"},
+		{name: "missing file", stdout: `{"diagnostics": [{"message": "bad"}]}`, wantErr: "diagnostics[0] has no file"},
+		{name: "missing message", stdout: `{"diagnostics": [{"file": "a.go"}]}`, wantErr: "diagnostics[0] has no message"},
+		{name: "negative line", stdout: `{"diagnostics": [{"file": "a.go", "line": -1, "message": "bad"}]}`, wantErr: "negative line"},
+		{name: "unknown severity", stdout: `{"diagnostics": [{"file": "a.go", "severity": "fatal", "message": "bad"}]}`, wantErr: "(got: 'fatal')"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseExternalCheckOutput([]byte(tt.stdout))
+			if tt.wantErr != "" {
+				require.ErrorIs(t, err, ErrInvalidExternalOutput)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExternalCheckRun(t *testing.T) {
+	// The fake check flags every file it is given, warns about go.mod, and
+	// proves it runs from the repository root with its name in the environment
+	fakeExternalCheck(t, `test -f check.sh || exit 9
+echo "checking as $GO_PRE_COMMIT_CHECK" >&2
+printf '{"diagnostics": ['
+sep=""
+while read -r file; do
+	severity=error
+	[ "$file" = go.mod ] && severity=warning
+	printf '%s{"file": "%s", "line": 2, "col": 5, "severity": "%s", "rule": "EX1", "message": "found it"}' "$sep" "$file" "$severity"
+	sep=","
+done
+printf ']}'
+`)
+	check := NewExternalCheck(shared.NewContext(), "license-headers", "./check.sh", time.Minute)
+
+	err := check.Run(context.Background(), []string{"main.go", "go.mod"})
+	require.ErrorIs(t, err, prerrors.ErrExternalCheckFindings)
+
+	var checkErr *prerrors.CheckError
+	require.ErrorAs(t, err, &checkErr)
+	assert.False(t, checkErr.WarnOnly, "an error diagnostic blocks the commit")
+	assert.Equal(t, []string{"go.mod", "main.go"}, checkErr.Files)
+	assert.Equal(t, "[warning] go.mod:2:5: found it (EX1)\nmain.go:2:5: found it (EX1)", checkErr.Output)
+	assert.Equal(t, []output.Diagnostic{
+		{File: "go.mod", Line: 2, Col: 5, Severity: output.SeverityWarning, Rule: "EX1", Message: "found it"},
+		{File: "main.go", Line: 2, Col: 5, Severity: output.SeverityError, Rule: "EX1", Message: "found it"},
+	}, checkErr.Diagnostics)
+
+	err = check.Run(context.Background(), []string{"go.mod"})
+	require.ErrorAs(t, err, &checkErr)
+	assert.True(t, checkErr.WarnOnly, "warnings alone do not block the commit")
+
+	require.NoError(t, check.Run(context.Background(), nil), "nothing to check")
+}
+
+func TestExternalCheckRunClean(t *testing.T) {
+	fakeExternalCheck(t, "cat >/dev/null\n")
+	check := NewExternalCheck(nil, "clean", "./check.sh", 0)
+
+	require.NoError(t, check.Run(context.Background(), []string{"main.go"}))
+	assert.Equal(t, 60*time.Second, check.timeout)
+}
+
+func TestExternalCheckRunFailures(t *testing.T) {
+	tests := []struct {
+		name    string
+		script  string
+		timeout time.Duration
+		wantErr error
+		want    string
+	}{
+		{name: "non-zero exit", script: "echo boom >&2\nexit 3\n", timeout: time.Minute, wantErr: prerrors.ErrToolExecutionFailed, want: "exit status 3"},
+		{name: "timeout", script: "exec sleep 5\n", timeout: 100 * time.Millisecond, wantErr: prerrors.ErrToolExecutionFailed, want: "timed out"},
+		{name: "broken contract", script: "echo 'not json'\n", timeout: time.Minute, wantErr: ErrInvalidExternalOutput, want: "diagnostics"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeExternalCheck(t, tt.script)
+			check := NewExternalCheck(shared.NewContext(), "fake", "./check.sh", tt.timeout)
+
+			err := check.Run(context.Background(), []string{"main.go"})
+			require.ErrorIs(t, err, tt.wantErr)
+
+			var checkErr *prerrors.CheckError
+			require.ErrorAs(t, err, &checkErr)
+			assert.False(t, checkErr.WarnOnly)
+			assert.Contains(t, checkErr.Suggestion, tt.want)
+		})
+	}
+}
+
+func TestExternalCheckMetadata(t *testing.T) {
+	check := NewExternalCheck(nil, "license-headers", "./check.sh", 5*time.Second)
+	assert.Equal(t, "license-headers", check.Name())
+	assert.Contains(t, check.Description(), "./check.sh")
+	assert.Equal(t, []string{"a.go", "README.md"}, check.FilterFiles([]string{"a.go", "README.md"}))
+
+	metadata, ok := check.Metadata().(PluginMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "external", metadata.Category)
+	assert.True(t, metadata.RequiresFiles)
+	assert.Equal(t, 5*time.Second, metadata.DefaultTimeout)
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -14,6 +15,7 @@ import (
 	"time"
 
 	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
 )
 
 // Define plugin errors
@@ -129,6 +131,11 @@ func (p *Plugin) Run(ctx context.Context, files []string) error {
 	if !filepath.IsAbs(execPath) {
 		execPath = filepath.Join(p.directory, execPath)
 	}
+	// An absolute path keeps resolving when a configured working directory
+	// moves the command out of the plugin directory
+	if absPath, err := filepath.Abs(execPath); err == nil {
+		execPath = absPath
+	}
 
 	// Check if executable exists
 	if _, err := os.Stat(execPath); err != nil {
@@ -144,6 +151,8 @@ func (p *Plugin) Run(ctx context.Context, files []string) error {
 	// Create command
 	cmd := exec.CommandContext(ctx, execPath, args...) //nolint:gosec // Plugin execution
 	cmd.Dir = p.directory
+	shared.ApplyWorkDir(ctx, cmd)
+	shared.LogCommand(ctx, cmd)
 
 	// Set environment variables
 	cmd.Env = os.Environ()
@@ -203,6 +212,11 @@ func (p *Plugin) Run(ctx context.Context, files []string) error {
 		)
 	}
 
+	// Diagnostics a successful plugin printed are kept with its result
+	// rather than written to the shared terminal
+	checkOutput := shared.CheckOutput(ctx)
+	_, _ = checkOutput.Write(stderr.Bytes())
+
 	// Parse successful response
 	var response PluginResponse
 	if err := json.Unmarshal(stdout.Bytes(), &response); err != nil {
@@ -229,6 +243,10 @@ func (p *Plugin) Run(ctx context.Context, files []string) error {
 		)
 	}
 
+	if response.Output != "" {
+		_, _ = io.WriteString(checkOutput, strings.TrimSuffix(response.Output, "\n")+"\n")
+	}
+
 	return nil
 }
 
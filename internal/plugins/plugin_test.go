@@ -1,15 +1,19 @@
 package plugins
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/shared"
 )
 
 func TestNewPlugin(t *testing.T) {
@@ -411,6 +415,70 @@ exit 0
 	assert.NoError(t, err) // Empty output with exit 0 should be treated as success
 }
 
+// TestPluginRunCapturesOutput tests that a successful plugin's output and
+// diagnostics go to the check output writer
+func TestPluginRunCapturesOutput(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	scriptPath := filepath.Join(tmpDir, "chatty.sh")
+	scriptContent := `#!/bin/bash
+read INPUT
+echo "scanning 1 file" >&2
+echo '{"success": true, "output": "all good"}'
+`
+	// #nosec G306 - Test script needs execute permission
+	err := os.WriteFile(scriptPath, []byte(scriptContent), 0o755)
+	require.NoError(t, err)
+
+	manifest := &PluginManifest{
+		Name:       "chatty-plugin",
+		Executable: "./chatty.sh",
+		Timeout:    "5s",
+	}
+
+	plugin, err := NewPlugin(manifest, tmpDir)
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	err = plugin.Run(shared.WithCheckOutput(context.Background(), &out), []string{"test.go"})
+	require.NoError(t, err)
+	assert.Equal(t, "scanning 1 file\nall good\n", out.String())
+}
+
+// TestPluginRunInWorkDir tests that the plugin command runs in the working
+// directory configured for its check
+func TestPluginRunInWorkDir(t *testing.T) {
+	tmpDir := t.TempDir()
+	workDir := filepath.Join(t.TempDir(), "web")
+	require.NoError(t, os.MkdirAll(workDir, 0o750))
+
+	scriptPath := filepath.Join(tmpDir, "pwd.sh")
+	scriptContent := `#!/bin/bash
+read INPUT
+echo "{\"success\": true, \"output\": \"$(pwd -P)\"}"
+`
+	// #nosec G306 - Test script needs execute permission
+	err := os.WriteFile(scriptPath, []byte(scriptContent), 0o755)
+	require.NoError(t, err)
+
+	plugin, err := NewPlugin(&PluginManifest{Name: "pwd-plugin", Executable: "./pwd.sh", Timeout: "5s"}, tmpDir)
+	require.NoError(t, err)
+
+	run := func(ctx context.Context) string {
+		var out bytes.Buffer
+		require.NoError(t, plugin.Run(shared.WithCheckOutput(ctx, &out), []string{"test.go"}))
+		return strings.TrimSpace(out.String())
+	}
+
+	wantPluginDir, err := filepath.EvalSymlinks(tmpDir)
+	require.NoError(t, err)
+	assert.Equal(t, wantPluginDir, run(context.Background()), "plugins run in their own directory by default")
+
+	wantWorkDir, err := filepath.EvalSymlinks(workDir)
+	require.NoError(t, err)
+	assert.Equal(t, wantWorkDir, run(shared.WithWorkDir(context.Background(), workDir)))
+}
+
 // TestPluginRunWithNonJSONOutput tests plugin with non-JSON output
 func TestPluginRunWithNonJSONOutput(t *testing.T) {
 	tmpDir := t.TempDir()
@@ -0,0 +1,388 @@
+// Package review posts check diagnostics as inline review comments on a
+// GitHub pull request, for bot-driven review in CI
+package review
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+)
+
+// DefaultTimeout bounds all API calls made for one review so a slow API
+// cannot hold up the run
+const DefaultTimeout = 30 * time.Second
+
+// defaultAPIURL is used when GITHUB_API_URL is not set
+const defaultAPIURL = "https://api.github.com"
+
+// perPage is the page size requested from list endpoints
+const perPage = 100
+
+var (
+	// ErrMissingTarget is returned when the environment does not name a token,
+	// repository, and pull request to review
+	ErrMissingTarget = errors.New("GitHub review needs GITHUB_TOKEN, GITHUB_REPOSITORY, and a pull request number")
+
+	// ErrAPIFailed is returned when the GitHub API answers with a non-2xx status
+	ErrAPIFailed = errors.New("GitHub API request failed")
+)
+
+// diagnosticPattern matches "path:line: message" and "path:line:col: message"
+var diagnosticPattern = regexp.MustCompile(`^([^\s:]+):(\d+)(?::\d+)?:\s+(.+)$`)
+
+// ansiPattern matches ANSI color escape sequences
+var ansiPattern = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// pullRefPattern extracts the number from a pull request GITHUB_REF
+var pullRefPattern = regexp.MustCompile(`^refs/pull/(\d+)/`)
+
+// Target identifies the pull request to review and the credentials to use
+type Target struct {
+	APIURL      string
+	Token       string
+	Repository  string // owner/name
+	PullRequest int
+}
+
+// TargetFromEnv reads the review target from the environment: GITHUB_TOKEN,
+// GITHUB_REPOSITORY, and GO_PRE_COMMIT_PR_NUMBER, falling back to the number
+// in a refs/pull/<n>/merge GITHUB_REF. GITHUB_API_URL overrides the API
+// endpoint for GitHub Enterprise.
+func TargetFromEnv(getenv func(string) string) (Target, error) {
+	target := Target{
+		APIURL:     strings.TrimSuffix(getenv("GITHUB_API_URL"), "/"),
+		Token:      getenv("GITHUB_TOKEN"),
+		Repository: getenv("GITHUB_REPOSITORY"),
+	}
+	if target.APIURL == "" {
+		target.APIURL = defaultAPIURL
+	}
+
+	number := getenv("GO_PRE_COMMIT_PR_NUMBER")
+	if number == "" {
+		if match := pullRefPattern.FindStringSubmatch(getenv("GITHUB_REF")); match != nil {
+			number = match[1]
+		}
+	}
+	target.PullRequest, _ = strconv.Atoi(number)
+
+	if target.Token == "" || !strings.Contains(target.Repository, "/") || target.PullRequest <= 0 {
+		return Target{}, ErrMissingTarget
+	}
+	return target, nil
+}
+
+// Diagnostic is one finding reported at a file and line
+type Diagnostic struct {
+	Check   string
+	Path    string
+	Line    int
+	Message string
+}
+
+// body returns the review comment text for d
+func (d Diagnostic) body() string {
+	return fmt.Sprintf("**%s**: %s", d.Check, d.Message)
+}
+
+// Diagnostics returns the findings reported by failed and warn-only checks:
+// the structured diagnostics a check attached to its result, or else the
+// "path:line[:col]: message" lines of its output. They are sorted by path,
+// line, and check.
+func Diagnostics(results *runner.Results) []Diagnostic {
+	var diagnostics []Diagnostic
+	seen := make(map[Diagnostic]bool)
+	add := func(diagnostic Diagnostic) {
+		if !seen[diagnostic] {
+			seen[diagnostic] = true
+			diagnostics = append(diagnostics, diagnostic)
+		}
+	}
+	for _, result := range results.CheckResults {
+		if result.Success || result.Skipped {
+			continue
+		}
+		if len(result.Diagnostics) > 0 {
+			for _, d := range result.Diagnostics {
+				if d.Line <= 0 {
+					continue
+				}
+				message := d.Message
+				if d.Rule != "" {
+					message += " (" + d.Rule + ")"
+				}
+				add(Diagnostic{Check: result.Name, Path: d.File, Line: d.Line, Message: message})
+			}
+			continue
+		}
+		for _, line := range strings.Split(result.Output+"\n"+result.Error, "\n") {
+			match := diagnosticPattern.FindStringSubmatch(strings.TrimSpace(ansiPattern.ReplaceAllString(line, "")))
+			if match == nil {
+				continue
+			}
+			lineNumber, err := strconv.Atoi(match[2])
+			if err != nil || lineNumber <= 0 {
+				continue
+			}
+			add(Diagnostic{
+				Check:   result.Name,
+				Path:    strings.TrimPrefix(match[1], "./"),
+				Line:    lineNumber,
+				Message: match[3],
+			})
+		}
+	}
+	slices.SortStableFunc(diagnostics, func(a, b Diagnostic) int {
+		return cmp.Or(strings.Compare(a.Path, b.Path), cmp.Compare(a.Line, b.Line), strings.Compare(a.Check, b.Check))
+	})
+	return diagnostics
+}
+
+// pullFile is a file changed by the pull request, from the list files API
+type pullFile struct {
+	Filename string `json:"filename"`
+	Patch    string `json:"patch"`
+}
+
+// pullComment is an existing review comment, from the list comments API
+type pullComment struct {
+	Path     string `json:"path"`
+	Position *int   `json:"position"`
+	Line     *int   `json:"line"`
+	Body     string `json:"body"`
+}
+
+// reviewComment is an inline comment in a create review request
+type reviewComment struct {
+	Path     string `json:"path"`
+	Position int    `json:"position"`
+	Body     string `json:"body"`
+}
+
+// reviewRequest is the body of a create review request
+type reviewRequest struct {
+	Event    string          `json:"event"`
+	Comments []reviewComment `json:"comments"`
+}
+
+// Post creates one pull request review holding an inline comment for each
+// diagnostic on a line the pull request changed. Diagnostics outside the
+// diff, and those already posted at the same place with the same text, are
+// left out. It returns the number of comments posted. A nil client uses
+// http.DefaultClient.
+func Post(ctx context.Context, client *http.Client, target Target, diagnostics []Diagnostic, version string) (int, error) {
+	if len(diagnostics) == 0 {
+		return 0, nil
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	api := &apiClient{client: client, target: target, version: version}
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultTimeout)
+	defer cancel()
+
+	var files []pullFile
+	if err := api.list(ctx, "files", &files); err != nil {
+		return 0, err
+	}
+	var existing []pullComment
+	if err := api.list(ctx, "comments", &existing); err != nil {
+		return 0, err
+	}
+
+	comments := newComments(files, existing, diagnostics)
+	if len(comments) == 0 {
+		return 0, nil
+	}
+	if err := api.do(ctx, http.MethodPost, api.pullURL("reviews"), reviewRequest{Event: "COMMENT", Comments: comments}, nil); err != nil {
+		return 0, err
+	}
+	return len(comments), nil
+}
+
+// newComments maps diagnostics onto diff positions, dropping those on
+// unchanged lines and those matching an existing comment
+func newComments(files []pullFile, existing []pullComment, diagnostics []Diagnostic) []reviewComment {
+	positions := make(map[string]map[int]int, len(files))
+	names := make([]string, 0, len(files))
+	for _, file := range files {
+		positions[file.Filename] = diffPositions(file.Patch)
+		names = append(names, file.Filename)
+	}
+
+	posted := make(map[string]bool, len(existing))
+	for _, comment := range existing {
+		if comment.Line != nil {
+			posted[commentKey(comment.Path, "line", *comment.Line, comment.Body)] = true
+		}
+		if comment.Position != nil {
+			posted[commentKey(comment.Path, "position", *comment.Position, comment.Body)] = true
+		}
+	}
+
+	var comments []reviewComment
+	for _, diagnostic := range diagnostics {
+		path := matchPath(diagnostic.Path, names)
+		if path == "" {
+			continue
+		}
+		position, ok := positions[path][diagnostic.Line]
+		if !ok {
+			continue
+		}
+		body := diagnostic.body()
+		if posted[commentKey(path, "line", diagnostic.Line, body)] || posted[commentKey(path, "position", position, body)] {
+			continue
+		}
+		posted[commentKey(path, "line", diagnostic.Line, body)] = true
+		comments = append(comments, reviewComment{Path: path, Position: position, Body: body})
+	}
+	return comments
+}
+
+// commentKey identifies a comment for de-duplication
+func commentKey(path, kind string, n int, body string) string {
+	return fmt.Sprintf("%s\x00%s\x00%d\x00%s", path, kind, n, body)
+}
+
+// matchPath returns the pull request file a diagnostic path refers to. Tools
+// run inside a module report paths relative to it, so a unique suffix match
+// is accepted when no file matches exactly.
+func matchPath(path string, files []string) string {
+	match := ""
+	for _, file := range files {
+		if file == path {
+			return file
+		}
+		if strings.HasSuffix(file, "/"+path) {
+			if match != "" {
+				return ""
+			}
+			match = file
+		}
+	}
+	return match
+}
+
+// hunkHeaderPattern extracts the new-file start line from a hunk header
+var hunkHeaderPattern = regexp.MustCompile(`^@@ -\d+(?:,\d+)? \+(\d+)(?:,\d+)? @@`)
+
+// diffPositions maps each new-file line a patch shows, added or context, to
+// its diff position: the number of lines below the first hunk header, which
+// keeps counting through later hunk headers
+func diffPositions(patch string) map[int]int {
+	positions := make(map[int]int)
+	position := -1
+	newLine := 0
+	for _, line := range strings.Split(patch, "\n") {
+		if position >= 0 {
+			position++
+		}
+		if match := hunkHeaderPattern.FindStringSubmatch(line); match != nil {
+			if position < 0 {
+				position = 0
+			}
+			newLine, _ = strconv.Atoi(match[1])
+			continue
+		}
+		if position < 0 || line == "" {
+			continue
+		}
+		switch line[0] {
+		case '+', ' ':
+			positions[newLine] = position
+			newLine++
+		}
+	}
+	return positions
+}
+
+// apiClient makes authenticated requests against one pull request
+type apiClient struct {
+	client  *http.Client
+	target  Target
+	version string
+}
+
+// pullURL returns the API URL of a pull request sub-resource
+func (a *apiClient) pullURL(resource string) string {
+	return fmt.Sprintf("%s/repos/%s/pulls/%d/%s", a.target.APIURL, a.target.Repository, a.target.PullRequest, resource)
+}
+
+// list reads every page of a pull request list endpoint into out
+func (a *apiClient) list(ctx context.Context, resource string, out any) error {
+	var all []json.RawMessage
+	for page := 1; ; page++ {
+		var items []json.RawMessage
+		url := fmt.Sprintf("%s?per_page=%d&page=%d", a.pullURL(resource), perPage, page)
+		if err := a.do(ctx, http.MethodGet, url, nil, &items); err != nil {
+			return err
+		}
+		all = append(all, items...)
+		if len(items) < perPage {
+			break
+		}
+	}
+
+	data, err := json.Marshal(all)
+	if err != nil {
+		return fmt.Errorf("collecting %s: %w", resource, err)
+	}
+	return json.Unmarshal(data, out)
+}
+
+// do sends one API request, encoding in as the body when set and decoding
+// the response into out when set
+func (a *apiClient) do(ctx context.Context, method, url string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("encoding GitHub request: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return fmt.Errorf("creating GitHub request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+a.target.Token)
+	req.Header.Set("User-Agent", "go-pre-commit/"+a.version)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("calling GitHub API: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return fmt.Errorf("%w: %s %s: %s", ErrAPIFailed, method, req.URL.Path, resp.Status)
+	}
+	if out == nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding GitHub response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+package review
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/output"
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+)
+
+// envMap returns a getenv function backed by vars
+func envMap(vars map[string]string) func(string) string {
+	return func(key string) string { return vars[key] }
+}
+
+func TestTargetFromEnv(t *testing.T) {
+	t.Run("explicit pull request number", func(t *testing.T) {
+		target, err := TargetFromEnv(envMap(map[string]string{
+			"GITHUB_TOKEN":            "secret",
+			"GITHUB_REPOSITORY":       "example/project",
+			"GO_PRE_COMMIT_PR_NUMBER": "42",
+			"GITHUB_REF":              "refs/pull/7/merge",
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, Target{APIURL: defaultAPIURL, Token: "secret", Repository: "example/project", PullRequest: 42}, target)
+	})
+
+	t.Run("number from GITHUB_REF", func(t *testing.T) {
+		target, err := TargetFromEnv(envMap(map[string]string{
+			"GITHUB_API_URL":    "https://ghe.example.com/api/v3/",
+			"GITHUB_TOKEN":      "secret",
+			"GITHUB_REPOSITORY": "example/project",
+			"GITHUB_REF":        "refs/pull/7/merge",
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, 7, target.PullRequest)
+		assert.Equal(t, "https://ghe.example.com/api/v3", target.APIURL)
+	})
+
+	for name, vars := range map[string]map[string]string{
+		"no token":        {"GITHUB_REPOSITORY": "example/project", "GO_PRE_COMMIT_PR_NUMBER": "1"},
+		"no repository":   {"GITHUB_TOKEN": "secret", "GO_PRE_COMMIT_PR_NUMBER": "1"},
+		"branch push ref": {"GITHUB_TOKEN": "secret", "GITHUB_REPOSITORY": "example/project", "GITHUB_REF": "refs/heads/main"},
+		"invalid number":  {"GITHUB_TOKEN": "secret", "GITHUB_REPOSITORY": "example/project", "GO_PRE_COMMIT_PR_NUMBER": "abc"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := TargetFromEnv(envMap(vars))
+			require.ErrorIs(t, err, ErrMissingTarget)
+		})
+	}
+}
+
+func TestDiagnostics(t *testing.T) {
+	results := &runner.Results{CheckResults: []runner.CheckResult{
+		{Name: "fumpt", Success: true, Output: "main.go:1:1: ignored because the check passed"},
+		{Name: "lint", Output: "Found 2 linting issue(s):\n" +
+			"internal/app/app.go:12:5: ineffectual assignment to err (ineffassign)\n" +
+			"\x1b[1m./cmd/main.go:3:\x1b[0m unused import (unused)\n" +
+			"internal/app/app.go:12:5: ineffectual assignment to err (ineffassign)"},
+		{Name: "vet", WarnOnly: true, Error: "app.go:7: printf call has arguments but no formatting directives"},
+		{Name: "mod-tidy", Skipped: true, Output: "go.mod:1: skipped"},
+	}}
+
+	assert.Equal(t, []Diagnostic{
+		{Check: "vet", Path: "app.go", Line: 7, Message: "printf call has arguments but no formatting directives"},
+		{Check: "lint", Path: "cmd/main.go", Line: 3, Message: "unused import (unused)"},
+		{Check: "lint", Path: "internal/app/app.go", Line: 12, Message: "ineffectual assignment to err (ineffassign)"},
+	}, Diagnostics(results))
+}
+
+func TestDiagnostics_PrefersStructuredFindings(t *testing.T) {
+	results := &runner.Results{CheckResults: []runner.CheckResult{{
+		Name:   "lint",
+		Output: "internal/app/app.go:12:5: text form is ignored (ineffassign)",
+		Diagnostics: []output.Diagnostic{
+			{File: "internal/app/app.go", Line: 12, Col: 5, Rule: "ineffassign", Message: "ineffectual assignment to err"},
+			{File: "internal/app/app.go", Line: 12, Col: 5, Rule: "ineffassign", Message: "ineffectual assignment to err"},
+			{File: "go.mod", Message: "no line to comment on"},
+		},
+	}}}
+
+	assert.Equal(t, []Diagnostic{
+		{Check: "lint", Path: "internal/app/app.go", Line: 12, Message: "ineffectual assignment to err (ineffassign)"},
+	}, Diagnostics(results))
+}
+
+func TestDiffPositions(t *testing.T) {
+	patch := "@@ -1,3 +1,4 @@\n" +
+		" package app\n" + // position 1, line 1
+		"+\n" + // position 2, line 2
+		"+import \"fmt\"\n" + // position 3, line 3
+		" \n" + // position 4, line 4
+		"@@ -10,3 +11,3 @@ func run() {\n" + // position 5
+		" \ta := 1\n" + // position 6, line 11
+		"-\tb := 2\n" + // position 7, removed
+		"+\tb := 3\n" + // position 8, line 12
+		"\\ No newline at end of file" // position 9
+
+	assert.Equal(t, map[int]int{1: 1, 2: 2, 3: 3, 4: 4, 11: 6, 12: 8}, diffPositions(patch))
+	assert.Empty(t, diffPositions(""))
+}
+
+func TestMatchPath(t *testing.T) {
+	files := []string{"internal/app/app.go", "services/api/app.go", "cmd/main.go"}
+	assert.Equal(t, "cmd/main.go", matchPath("cmd/main.go", files))
+	assert.Equal(t, "cmd/main.go", matchPath("main.go", files), "unique suffix")
+	assert.Equal(t, "internal/app/app.go", matchPath("app/app.go", files))
+	assert.Empty(t, matchPath("app.go", files), "ambiguous suffix")
+	assert.Empty(t, matchPath("other.go", files))
+}
+
+// fakeGitHub serves the pull request endpoints Post uses and records the
+// reviews it receives
+type fakeGitHub struct {
+	files    []pullFile
+	comments []pullComment
+	reviews  []reviewRequest
+	status   int // answer every request with this status when set
+	auth     string
+}
+
+func (f *fakeGitHub) handler(t *testing.T) http.Handler {
+	mux := http.NewServeMux()
+	page := func(w http.ResponseWriter, r *http.Request, items any) {
+		data, err := json.Marshal(items)
+		require.NoError(t, err)
+		var all []json.RawMessage
+		require.NoError(t, json.Unmarshal(data, &all))
+
+		n, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		size, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+		start := min((n-1)*size, len(all))
+		_ = json.NewEncoder(w).Encode(all[start:min(start+size, len(all))])
+	}
+	mux.HandleFunc("GET /repos/example/project/pulls/5/files", func(w http.ResponseWriter, r *http.Request) {
+		page(w, r, f.files)
+	})
+	mux.HandleFunc("GET /repos/example/project/pulls/5/comments", func(w http.ResponseWriter, r *http.Request) {
+		page(w, r, f.comments)
+	})
+	mux.HandleFunc("POST /repos/example/project/pulls/5/reviews", func(w http.ResponseWriter, r *http.Request) {
+		var req reviewRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		f.reviews = append(f.reviews, req)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"id":1}`))
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.auth = r.Header.Get("Authorization")
+		if f.status != 0 {
+			w.WriteHeader(f.status)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func newFakeGitHub(t *testing.T, fake *fakeGitHub) Target {
+	server := httptest.NewServer(fake.handler(t))
+	t.Cleanup(server.Close)
+	return Target{APIURL: server.URL, Token: "secret", Repository: "example/project", PullRequest: 5}
+}
+
+func intPtr(n int) *int { return &n }
+
+func TestPost_MapsAndDeduplicatesComments(t *testing.T) {
+	fake := &fakeGitHub{
+		files: []pullFile{
+			{Filename: "internal/app/app.go", Patch: "@@ -1,2 +1,3 @@\n package app\n+var x = 1\n \n@@ -20,2 +21,2 @@\n-\told()\n+\tnew()\n"},
+			{Filename: "README.md", Patch: "@@ -1 +1 @@\n-a\n+b\n"},
+		},
+		comments: []pullComment{
+			{Path: "internal/app/app.go", Line: intPtr(21), Body: "**lint**: already reported (revive)"},
+			{Path: "internal/app/app.go", Position: intPtr(2), Body: "**vet**: outdated line, same position"},
+		},
+	}
+	target := newFakeGitHub(t, fake)
+
+	diagnostics := []Diagnostic{
+		{Check: "lint", Path: "internal/app/app.go", Line: 2, Message: "x is unused (unused)"},
+		{Check: "lint", Path: "app/app.go", Line: 21, Message: "call to new (revive)"},
+		{Check: "lint", Path: "internal/app/app.go", Line: 21, Message: "already reported (revive)"},
+		{Check: "vet", Path: "internal/app/app.go", Line: 2, Message: "outdated line, same position"},
+		{Check: "lint", Path: "internal/app/app.go", Line: 40, Message: "outside the diff"},
+		{Check: "lint", Path: "other.go", Line: 1, Message: "file not in the pull request"},
+	}
+
+	posted, err := Post(context.Background(), nil, target, diagnostics, "v1.2.3")
+	require.NoError(t, err)
+	assert.Equal(t, 2, posted)
+	assert.Equal(t, "Bearer secret", fake.auth)
+
+	require.Len(t, fake.reviews, 1)
+	assert.Equal(t, "COMMENT", fake.reviews[0].Event)
+	assert.Equal(t, []reviewComment{
+		{Path: "internal/app/app.go", Position: 2, Body: "**lint**: x is unused (unused)"},
+		{Path: "internal/app/app.go", Position: 6, Body: "**lint**: call to new (revive)"},
+	}, fake.reviews[0].Comments)
+}
+
+func TestPost_ReadsEveryPage(t *testing.T) {
+	fake := &fakeGitHub{}
+	for i := range perPage + 1 {
+		fake.files = append(fake.files, pullFile{Filename: fmt.Sprintf("f%03d.go", i), Patch: "@@ -0,0 +1 @@\n+package f\n"})
+	}
+	target := newFakeGitHub(t, fake)
+
+	posted, err := Post(context.Background(), nil, target, []Diagnostic{{Check: "lint", Path: fmt.Sprintf("f%03d.go", perPage), Line: 1, Message: "last page"}}, "dev")
+	require.NoError(t, err)
+	assert.Equal(t, 1, posted)
+}
+
+func TestPost_NothingToPost(t *testing.T) {
+	fake := &fakeGitHub{files: []pullFile{{Filename: "a.go", Patch: "@@ -1 +1 @@\n-a\n+b\n"}}}
+	target := newFakeGitHub(t, fake)
+
+	posted, err := Post(context.Background(), nil, target, nil, "dev")
+	require.NoError(t, err)
+	assert.Zero(t, posted)
+
+	posted, err = Post(context.Background(), nil, target, []Diagnostic{{Check: "lint", Path: "a.go", Line: 9, Message: "unchanged line"}}, "dev")
+	require.NoError(t, err)
+	assert.Zero(t, posted)
+	assert.Empty(t, fake.reviews, "no review is created without comments")
+}
+
+func TestPost_APIError(t *testing.T) {
+	fake := &fakeGitHub{status: http.StatusForbidden}
+	target := newFakeGitHub(t, fake)
+
+	_, err := Post(context.Background(), nil, target, []Diagnostic{{Check: "lint", Path: "a.go", Line: 1, Message: "m"}}, "dev")
+	require.ErrorIs(t, err, ErrAPIFailed)
+	assert.Contains(t, err.Error(), "403")
+}
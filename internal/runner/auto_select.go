@@ -0,0 +1,41 @@
+package runner
+
+import (
+	"path"
+	"path/filepath"
+
+	"github.com/mrz1836/go-pre-commit/internal/checks"
+)
+
+// autoSelectChecks keeps, under GO_PRE_COMMIT_AUTO_SELECT, only the checks
+// whose metadata file patterns match at least one of the files. Checks that
+// declare no patterns always stay. An explicit --only or --force-all-checks
+// selection, or a run without files, is left as it is.
+func (r *Runner) autoSelectChecks(checksToRun []checks.Check, opts Options) []checks.Check {
+	if !r.config.Runner.AutoSelect || opts.ForceAllChecks || len(opts.OnlyChecks) > 0 || len(opts.Files) == 0 {
+		return checksToRun
+	}
+
+	selected := make([]checks.Check, 0, len(checksToRun))
+	for _, check := range checksToRun {
+		metadata, ok := r.registry.GetMetadata(check.Name())
+		if !ok || len(metadata.FilePatterns) == 0 || matchesAnyFile(metadata.FilePatterns, opts.Files) {
+			selected = append(selected, check)
+		}
+	}
+	return selected
+}
+
+// matchesAnyFile reports whether one of the files' base names matches one
+// of the patterns, such as "*.go" or "Dockerfile.*"
+func matchesAnyFile(patterns, files []string) bool {
+	for _, file := range files {
+		base := path.Base(filepath.ToSlash(file))
+		for _, pattern := range patterns {
+			if matched, err := path.Match(pattern, base); err == nil && matched {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,123 @@
+package runner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/checks"
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+// checkNames returns the names of the checks in order
+func checkNames(list []checks.Check) []string {
+	names := make([]string, 0, len(list))
+	for _, check := range list {
+		names = append(names, check.Name())
+	}
+	return names
+}
+
+// newAutoSelectRunner enables a YAML check, two Go checks, and whitespace
+// with auto-selection on
+func newAutoSelectRunner(t *testing.T) (*Runner, string) {
+	t.Helper()
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.DataFormat = true
+	cfg.Checks.Fumpt = true
+	cfg.Checks.Lint = true
+	cfg.Checks.Whitespace = true
+	cfg.Runner.AutoSelect = true
+
+	dir := t.TempDir()
+	return New(cfg, dir), dir
+}
+
+func TestAutoSelectChecks_OnlyYAMLStaged(t *testing.T) {
+	r, dir := newAutoSelectRunner(t)
+	opts := Options{Files: []string{writeRepoFile(t, dir, "config.yml")}}
+
+	checksToRun, err := r.determineChecks(opts)
+	require.NoError(t, err)
+
+	selected := checkNames(r.autoSelectChecks(checksToRun, opts))
+	assert.Contains(t, selected, checkNameDataFormat)
+	assert.Contains(t, selected, checkNameWhitespace)
+	assert.NotContains(t, selected, checkNameFumpt)
+	assert.NotContains(t, selected, checkNameLint)
+}
+
+func TestAutoSelectChecks_GoStaged(t *testing.T) {
+	r, dir := newAutoSelectRunner(t)
+	opts := Options{Files: []string{writeRepoFile(t, dir, "main.go")}}
+
+	checksToRun, err := r.determineChecks(opts)
+	require.NoError(t, err)
+
+	selected := checkNames(r.autoSelectChecks(checksToRun, opts))
+	assert.ElementsMatch(t, []string{checkNameFumpt, checkNameLint, checkNameWhitespace}, selected)
+}
+
+func TestAutoSelectChecks_AllowlistBoundsSelection(t *testing.T) {
+	r, dir := newAutoSelectRunner(t)
+	r.config.Checks = config.Config{}.Checks // nothing enabled on its own
+	r.config.Runner.AutoSelectChecks = []string{checkNameDataFormat, checkNameLint}
+	opts := Options{Files: []string{writeRepoFile(t, dir, "config.yaml")}}
+
+	checksToRun, err := r.determineChecks(opts)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{checkNameDataFormat, checkNameLint}, checkNames(checksToRun), "the allowlist replaces the enabled checks")
+
+	assert.Equal(t, []string{checkNameDataFormat}, checkNames(r.autoSelectChecks(checksToRun, opts)))
+}
+
+func TestAutoSelectChecks_ExplicitSelectionsAreKept(t *testing.T) {
+	r, dir := newAutoSelectRunner(t)
+	files := []string{writeRepoFile(t, dir, "config.yml")}
+
+	for name, opts := range map[string]Options{
+		"only":             {Files: files, OnlyChecks: []string{checkNameLint}},
+		"force all checks": {Files: files, ForceAllChecks: true},
+		"no files":         {},
+	} {
+		checksToRun, err := r.determineChecks(opts)
+		require.NoError(t, err, name)
+		assert.Equal(t, checkNames(checksToRun), checkNames(r.autoSelectChecks(checksToRun, opts)), name)
+	}
+
+	r.config.Runner.AutoSelect = false
+	opts := Options{Files: files}
+	checksToRun, err := r.determineChecks(opts)
+	require.NoError(t, err)
+	assert.Len(t, r.autoSelectChecks(checksToRun, opts), 4, "off by default")
+}
+
+func TestMatchesAnyFile(t *testing.T) {
+	assert.True(t, matchesAnyFile([]string{"*.yml", "*.yaml"}, []string{"main.go", "deploy/app.yaml"}))
+	assert.True(t, matchesAnyFile([]string{"Dockerfile", "Dockerfile.*"}, []string{"build/Dockerfile.dev"}))
+	assert.True(t, matchesAnyFile([]string{"*"}, []string{"LICENSE"}))
+	assert.False(t, matchesAnyFile([]string{"*.go"}, []string{"config.yml", "go.mod"}))
+}
+
+func TestRun_AutoSelectSkipsGoChecksForYAML(t *testing.T) {
+	r, dir := newAutoSelectRunner(t)
+	r.config.Checks.Whitespace = false
+	r.config.Checks.DataFormat = false
+	r.config.Checks.Fumpt = false
+
+	var ran []string
+	r.registry.Register(&metadataCheck{
+		mockCheck: mockCheck{name: checkNameLint, run: func(context.Context, []string) error {
+			ran = append(ran, checkNameLint)
+			return nil
+		}},
+		metadata: checks.CheckMetadata{FilePatterns: []string{"*.go"}},
+	})
+
+	results, err := r.Run(context.Background(), Options{Files: []string{writeRepoFile(t, dir, "config.yml")}})
+	require.NoError(t, err)
+	assert.Empty(t, ran, "lint is not selected for a YAML-only change")
+	assert.Empty(t, results.CheckResults, "unselected checks are not reported")
+}
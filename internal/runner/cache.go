@@ -0,0 +1,455 @@
+package runner
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/checks"
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	"github.com/mrz1836/go-pre-commit/internal/tools"
+)
+
+// resultCacheVersion is bumped whenever the on-disk cache layout changes so
+// stale files are discarded instead of misread.
+const resultCacheVersion = 2
+
+// resultCacheMaxEntries caps the number of passes the cache file keeps; the
+// least recently used are dropped when it is saved.
+const resultCacheMaxEntries = 5000
+
+// errHashObjectMismatch indicates git hash-object returned a different number
+// of OIDs than files requested.
+var errHashObjectMismatch = errors.New("git hash-object returned unexpected number of object IDs")
+
+// resultCache remembers the passes of cacheable checks. A pass is keyed on
+// the check fingerprint and the git blob OIDs of every input of the file,
+// package, or module it covers. Because blob OIDs are content addressed,
+// unchanged inputs keep their key and can be served from cache, while any
+// modification produces a new key and forces the check to run again.
+type resultCache struct {
+	mu           sync.Mutex
+	path         string
+	dirty        bool
+	toolVersions map[string]string // installed version of each tool, resolved once per run
+	Version      int               `json:"version"`
+	Entries      map[string]int64  `json:"entries"` // pass key -> unix nanoseconds it was last recorded or served
+}
+
+// cacheUnit is what one cached pass covers: a file, a package, or a module,
+// with the inputs whose content keys it
+type cacheUnit struct {
+	name   string
+	inputs []string
+}
+
+// loadResultCache reads the cache file at path. A missing, unreadable, or
+// outdated file yields an empty cache rather than an error.
+func loadResultCache(path string) *resultCache {
+	cache := &resultCache{
+		path:    path,
+		Version: resultCacheVersion,
+		Entries: make(map[string]int64),
+	}
+
+	data, err := os.ReadFile(path) //nolint:gosec // path comes from trusted configuration
+	if err != nil {
+		return cache
+	}
+
+	var stored resultCache
+	if err = json.Unmarshal(data, &stored); err != nil || stored.Version != resultCacheVersion || stored.Entries == nil {
+		return cache
+	}
+	cache.Entries = stored.Entries
+	return cache
+}
+
+// save writes the cache back to disk when it has changed.
+func (c *resultCache) save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.dirty {
+		return nil
+	}
+	c.prune(resultCacheMaxEntries)
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("failed to encode result cache: %w", err)
+	}
+	if err = os.MkdirAll(filepath.Dir(c.path), 0o750); err != nil {
+		return fmt.Errorf("failed to create result cache directory: %w", err)
+	}
+	if err = os.WriteFile(c.path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write result cache: %w", err)
+	}
+	c.dirty = false
+	return nil
+}
+
+// allPassed reports whether every key has a recorded pass, marking them as
+// used when they do.
+func (c *resultCache) allPassed(keys []string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(keys) == 0 {
+		return false
+	}
+	for _, key := range keys {
+		if _, ok := c.Entries[key]; !ok {
+			return false
+		}
+	}
+	c.touch(keys)
+	return true
+}
+
+// recordPass records a pass for every key.
+func (c *resultCache) recordPass(keys []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.touch(keys)
+}
+
+// touch marks keys as used now. The caller holds c.mu.
+func (c *resultCache) touch(keys []string) {
+	now := time.Now().UnixNano()
+	for _, key := range keys {
+		c.Entries[key] = now
+	}
+	c.dirty = c.dirty || len(keys) > 0
+}
+
+// prune drops the least recently used passes beyond limit. The caller holds
+// c.mu.
+func (c *resultCache) prune(limit int) {
+	if len(c.Entries) <= limit {
+		return
+	}
+	keys := make([]string, 0, len(c.Entries))
+	for key := range c.Entries {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if c.Entries[keys[i]] != c.Entries[keys[j]] {
+			return c.Entries[keys[i]] < c.Entries[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	for _, key := range keys[:len(keys)-limit] {
+		delete(c.Entries, key)
+	}
+}
+
+// installedToolVersion returns the version of an installed tool. It is a
+// package variable so tests can fake the version output.
+//
+//nolint:gochecknoglobals // Injectable seam so tests can avoid real exec
+var installedToolVersion = tools.InstalledVersion
+
+// checkFingerprint identifies what a check ran under: its own settings, the
+// Go toolchain, and, for checks that run a tool, the tool's installed
+// version. Changing one of them invalidates the check's cached passes, while
+// unrelated settings leave them in place. It returns false when the tool's
+// version cannot be resolved.
+func (r *Runner) checkFingerprint(ctx context.Context, checkName string, metadata checks.CheckMetadata) (string, bool) {
+	settings := checkSettings(r.config, checkName)
+	if tool := checkTools[checkName]; tool != "" && len(metadata.Dependencies) > 0 {
+		version, err := r.cache.toolVersion(ctx, tool)
+		if err != nil {
+			return "", false
+		}
+		settings += fmt.Sprintf("tool %s=%s\n", tool, version)
+	}
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%s", checkName, runtime.Version(), settings)))
+	return hex.EncodeToString(sum[:]), true
+}
+
+// checkSettings lists the settings of one check: the fields of Checks,
+// CheckTimeouts, and CheckBehaviors named after it, such as Checks.ModTidy
+// and CheckBehaviors.ModTidyDiff for mod-tidy, its working directory, and
+// the version pinned for its tool
+func checkSettings(cfg *config.Config, checkName string) string {
+	prefix := strings.ReplaceAll(checkName, "-", "")
+	var settings strings.Builder
+	groups := []struct {
+		name  string
+		value any
+	}{
+		{"Checks", cfg.Checks},
+		{"CheckTimeouts", cfg.CheckTimeouts},
+		{"CheckBehaviors", cfg.CheckBehaviors},
+	}
+	for _, group := range groups {
+		value := reflect.ValueOf(group.value)
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if strings.HasPrefix(strings.ToLower(field.Name), prefix) {
+				fmt.Fprintf(&settings, "%s.%s=%+v\n", group.name, field.Name, value.Field(i).Interface())
+			}
+		}
+	}
+	fmt.Fprintf(&settings, "workdir=%s\n", cfg.CheckBehaviors.WorkDir[checkName])
+	if tool := checkTools[checkName]; tool != "" {
+		fmt.Fprintf(&settings, "pin %s=%s\n", tool, cfg.ToolVersions.Pin[tool])
+	}
+	return settings.String()
+}
+
+// toolVersion returns the installed version of tool, querying it once per
+// run. Failures are not remembered: a check may install the tool.
+func (c *resultCache) toolVersion(ctx context.Context, tool string) (string, error) {
+	c.mu.Lock()
+	version, ok := c.toolVersions[tool]
+	c.mu.Unlock()
+	if ok {
+		return version, nil
+	}
+
+	version, err := installedToolVersion(ctx, tool)
+	if err != nil {
+		return "", err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.toolVersions == nil {
+		c.toolVersions = make(map[string]string)
+	}
+	c.toolVersions[tool] = version
+	return version, nil
+}
+
+// cacheKeys returns the keys of the passes that cover files for check: one
+// per file, package, or module, by the check's cache scope. It returns false
+// when the check is not cacheable or its inputs cannot be hashed.
+func (r *Runner) cacheKeys(ctx context.Context, check checks.Check, files []string) ([]string, bool) {
+	metadata, ok := r.registry.GetMetadata(check.Name())
+	if !ok || !metadata.Cacheable || len(files) == 0 {
+		return nil, false
+	}
+
+	units, err := r.cacheUnits(metadata, files)
+	if err != nil {
+		return nil, false
+	}
+
+	var inputs []string
+	for _, unit := range units {
+		inputs = append(inputs, unit.inputs...)
+	}
+	slices.Sort(inputs)
+	inputs = slices.Compact(inputs)
+	oids, err := hashObjects(ctx, r.repoRoot, inputs)
+	if err != nil {
+		return nil, false
+	}
+	oidOf := make(map[string]string, len(inputs))
+	for i, input := range inputs {
+		oidOf[input] = oids[i]
+	}
+
+	fingerprint, ok := r.checkFingerprint(ctx, check.Name(), metadata)
+	if !ok {
+		return nil, false
+	}
+	keys := make([]string, 0, len(units))
+	for _, unit := range units {
+		hash := sha256.New()
+		fmt.Fprintf(hash, "%s\n%s\n", fingerprint, unit.name)
+		for _, input := range unit.inputs {
+			fmt.Fprintf(hash, "%s\x00%s\n", input, oidOf[input])
+		}
+		keys = append(keys, hex.EncodeToString(hash.Sum(nil)))
+	}
+	return keys, true
+}
+
+// cacheUnits groups files into the units their passes cover, each with its
+// sorted inputs: the file itself for the files scope, the Go files of its
+// directory and the module's go.mod for the package scope, or every Go file
+// of its module with go.mod and go.sum for the module scope. Files outside a
+// module are covered by package. The check's configuration files found in
+// the module and repository roots are inputs of every unit.
+func (r *Runner) cacheUnits(metadata checks.CheckMetadata, files []string) ([]cacheUnit, error) {
+	byName := make(map[string]*cacheUnit)
+	var units []*cacheUnit
+	for _, file := range files {
+		moduleRoot, inModule := r.moduleRootOf(file)
+		scope := metadata.CacheScope
+		if scope == checks.CacheScopeModule && !inModule {
+			scope = checks.CacheScopePackage
+		}
+
+		name := scope + ":" + file
+		switch scope {
+		case checks.CacheScopePackage:
+			name = scope + ":" + filepath.Dir(file)
+		case checks.CacheScopeModule:
+			name = scope + ":" + moduleRoot
+		}
+		unit := byName[name]
+		if unit == nil {
+			inputs, err := r.cacheUnitInputs(scope, file, moduleRoot, inModule)
+			if err != nil {
+				return nil, err
+			}
+			for _, configFile := range metadata.ConfigFiles {
+				inputs = append(inputs, r.existingFiles(filepath.Join(moduleRoot, configFile), configFile)...)
+			}
+			unit = &cacheUnit{name: name, inputs: inputs}
+			byName[name] = unit
+			units = append(units, unit)
+		}
+		unit.inputs = append(unit.inputs, file)
+	}
+
+	sorted := make([]cacheUnit, len(units))
+	for i, unit := range units {
+		slices.Sort(unit.inputs)
+		sorted[i] = cacheUnit{name: unit.name, inputs: slices.Compact(unit.inputs)}
+	}
+	return sorted, nil
+}
+
+// cacheUnitInputs returns the inputs, beyond the files themselves, of the
+// unit covering file
+func (r *Runner) cacheUnitInputs(scope, file, moduleRoot string, inModule bool) ([]string, error) {
+	switch scope {
+	case checks.CacheScopePackage:
+		inputs, err := r.goFilesIn(filepath.Dir(file))
+		if err != nil || !inModule {
+			return inputs, err
+		}
+		return append(inputs, filepath.Join(moduleRoot, "go.mod")), nil
+	case checks.CacheScopeModule:
+		inputs, err := r.moduleGoFiles(moduleRoot)
+		if err != nil {
+			return nil, err
+		}
+		return append(inputs, r.existingFiles(filepath.Join(moduleRoot, "go.mod"), filepath.Join(moduleRoot, "go.sum"))...), nil
+	default:
+		return nil, nil
+	}
+}
+
+// goFilesIn returns the Go files directly in dir
+func (r *Runner) goFilesIn(dir string) ([]string, error) {
+	entries, err := os.ReadDir(r.resolvePath(dir))
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && strings.HasSuffix(entry.Name(), ".go") {
+			files = append(files, filepath.Join(dir, entry.Name()))
+		}
+	}
+	return files, nil
+}
+
+// moduleGoFiles returns the Go files of the module rooted at the
+// repository-relative moduleRoot, leaving out nested modules and the
+// directories the go tool ignores
+func (r *Runner) moduleGoFiles(moduleRoot string) ([]string, error) {
+	root := r.resolvePath(moduleRoot)
+	var files []string
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if path == root {
+				return nil
+			}
+			name := entry.Name()
+			if strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") || name == "testdata" || name == "vendor" {
+				return filepath.SkipDir
+			}
+			if _, statErr := os.Stat(filepath.Join(path, "go.mod")); statErr == nil {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if entry.Type().IsRegular() && strings.HasSuffix(entry.Name(), ".go") {
+			rel, relErr := filepath.Rel(root, path)
+			if relErr != nil {
+				return relErr
+			}
+			files = append(files, filepath.Join(moduleRoot, rel))
+		}
+		return nil
+	})
+	return files, err
+}
+
+// existingFiles returns those of files that exist
+func (r *Runner) existingFiles(files ...string) []string {
+	var existing []string
+	for _, file := range files {
+		if info, err := os.Stat(r.resolvePath(file)); err == nil && info.Mode().IsRegular() {
+			existing = append(existing, file)
+		}
+	}
+	return existing
+}
+
+// hashObjects returns the git blob OID for each file, in order, using a single
+// git hash-object invocation. Paths are passed on stdin so large modules do
+// not exceed argument limits.
+func hashObjects(ctx context.Context, repoRoot string, files []string) ([]string, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "hash-object", "--stdin-paths")
+	cmd.Dir = repoRoot
+	cmd.Stdin = strings.NewReader(strings.Join(files, "\n") + "\n")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git hash-object failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	oids := make([]string, 0, len(files))
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			oids = append(oids, line)
+		}
+	}
+	if len(oids) != len(files) {
+		return nil, fmt.Errorf("%w: got %d, want %d", errHashObjectMismatch, len(oids), len(files))
+	}
+	return oids, nil
+}
+
+// resolveCachePath returns the absolute location of the cache file.
+func resolveCachePath(repoRoot, file string) string {
+	if filepath.IsAbs(file) {
+		return file
+	}
+	return filepath.Join(repoRoot, file)
+}
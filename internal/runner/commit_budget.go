@@ -0,0 +1,76 @@
+package runner
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/checks"
+)
+
+// commitBudget tracks the time left under GO_PRE_COMMIT_MAX_COMMIT_TIME_SECONDS
+// and each check's estimated duration
+type commitBudget struct {
+	limit     time.Duration
+	deadline  time.Time
+	estimates map[string]time.Duration
+}
+
+// newCommitBudget starts the run's commit-time budget at start, or returns
+// nil when no budget is configured. Runs limited to named checks or a
+// profile, such as a pre-push run, ask for those checks explicitly and are
+// never trimmed.
+func (r *Runner) newCommitBudget(start time.Time, checksToRun []checks.Check, opts Options) *commitBudget {
+	if r.config.Runner.MaxCommitTime <= 0 || len(opts.OnlyChecks) > 0 || len(opts.ProfileChecks) > 0 {
+		return nil
+	}
+
+	limit := time.Duration(r.config.Runner.MaxCommitTime) * time.Second
+	budget := &commitBudget{
+		limit:     limit,
+		deadline:  start.Add(limit),
+		estimates: make(map[string]time.Duration, len(checksToRun)),
+	}
+	for _, check := range checksToRun {
+		budget.estimates[check.Name()] = r.registry.EstimatedDuration(check)
+	}
+	return budget
+}
+
+// fastestFirst orders checks by estimated duration, shortest first, so the
+// quick checks finish inside the budget before the slow ones are considered.
+// Checks with equal estimates keep their order.
+func (b *commitBudget) fastestFirst(checksToRun []checks.Check) []checks.Check {
+	if b == nil {
+		return checksToRun
+	}
+	ordered := slices.Clone(checksToRun)
+	slices.SortStableFunc(ordered, func(a, c checks.Check) int {
+		return cmp.Compare(b.estimates[a.Name()], b.estimates[c.Name()])
+	})
+	return ordered
+}
+
+// allows reports whether the named check is expected to finish before the
+// budget runs out; a nil budget allows everything
+func (b *commitBudget) allows(name string) bool {
+	if b == nil {
+		return true
+	}
+	return b.estimates[name] <= time.Until(b.deadline)
+}
+
+// skipResult is the result for a check left unrun because the budget could
+// not fit it
+func (b *commitBudget) skipResult(name string) CheckResult {
+	return CheckResult{
+		Name:    name,
+		Success: true,
+		Skipped: true,
+		Error: fmt.Sprintf("not run; estimated %s would exceed the %s commit time budget",
+			b.estimates[name], b.limit),
+		Suggestion: fmt.Sprintf("Run it before pushing (for example in a pre-push profile) or now with 'go-pre-commit run %s', "+
+			"or raise GO_PRE_COMMIT_MAX_COMMIT_TIME_SECONDS", name),
+	}
+}
@@ -0,0 +1,132 @@
+package runner
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/checks"
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+// newBudgetRunner returns a runner with a commitSeconds budget and one
+// enabled check per estimate, recording the order the checks ran in
+func newBudgetRunner(t *testing.T, commitSeconds int, estimates map[string]time.Duration, work map[string]time.Duration) (*Runner, func() []string) {
+	t.Helper()
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Runner.MaxCommitTime = commitSeconds
+	cfg.Checks.Lint, cfg.CheckTimeouts.Lint = true, 30
+	cfg.Checks.Fumpt, cfg.CheckTimeouts.Fumpt = true, 30
+	cfg.Checks.Whitespace, cfg.CheckTimeouts.Whitespace = true, 30
+
+	var mu sync.Mutex
+	var ran []string
+	r := New(cfg, t.TempDir())
+	for name, estimate := range estimates {
+		r.registry.Register(&metadataCheck{
+			mockCheck: mockCheck{name: name, run: func(context.Context, []string) error {
+				mu.Lock()
+				ran = append(ran, name)
+				mu.Unlock()
+				time.Sleep(work[name])
+				return nil
+			}},
+			metadata: checks.CheckMetadata{EstimatedDuration: estimate},
+		})
+	}
+	return r, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), ran...)
+	}
+}
+
+// resultByName returns the named check's result
+func resultByName(t *testing.T, results *Results, name string) CheckResult {
+	t.Helper()
+	for _, result := range results.CheckResults {
+		if result.Name == name {
+			return result
+		}
+	}
+	require.Failf(t, "missing result", "no result for %s", name)
+	return CheckResult{}
+}
+
+func TestRun_CommitBudgetSkipsSlowChecks(t *testing.T) {
+	estimates := map[string]time.Duration{
+		checkNameLint:       time.Minute,
+		checkNameFumpt:      200 * time.Millisecond,
+		checkNameWhitespace: 100 * time.Millisecond,
+	}
+
+	for _, parallel := range []int{1, 3} {
+		r, ran := newBudgetRunner(t, 2, estimates, nil)
+		results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}, Parallel: parallel})
+		require.NoError(t, err)
+
+		assert.ElementsMatch(t, []string{checkNameWhitespace, checkNameFumpt}, ran(), "parallel=%d", parallel)
+		assert.Equal(t, 2, results.Passed)
+		assert.Equal(t, 1, results.Skipped)
+		assert.Zero(t, results.Failed, "a budget skip never fails the commit")
+
+		lint := resultByName(t, results, checkNameLint)
+		assert.True(t, lint.Skipped)
+		assert.Contains(t, lint.Error, "estimated 1m0s would exceed the 2s commit time budget")
+		assert.Contains(t, lint.Suggestion, "go-pre-commit run lint")
+	}
+}
+
+func TestRun_CommitBudgetRunsFastestFirst(t *testing.T) {
+	// whitespace eats most of the budget, leaving too little for lint even
+	// though lint alone would have fit
+	r, ran := newBudgetRunner(t, 2,
+		map[string]time.Duration{
+			checkNameLint:       time.Second,
+			checkNameFumpt:      50 * time.Millisecond,
+			checkNameWhitespace: 100 * time.Millisecond,
+		},
+		map[string]time.Duration{checkNameWhitespace: 1500 * time.Millisecond},
+	)
+
+	results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}, FailFast: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{checkNameFumpt, checkNameWhitespace}, ran(), "ordered by estimate")
+	assert.True(t, resultByName(t, results, checkNameLint).Skipped, "the budget was nearly exhausted")
+}
+
+func TestRun_CommitBudgetNotAppliedToNamedChecks(t *testing.T) {
+	estimates := map[string]time.Duration{
+		checkNameLint:       time.Minute,
+		checkNameWhitespace: 100 * time.Millisecond,
+	}
+
+	r, ran := newBudgetRunner(t, 1, estimates, nil)
+	results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}, OnlyChecks: []string{checkNameLint}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{checkNameLint}, ran())
+	assert.Equal(t, 1, results.Passed)
+
+	r, ran = newBudgetRunner(t, 1, estimates, nil)
+	_, err = r.Run(context.Background(), Options{Files: []string{tempFile(t)}, ProfileChecks: []string{checkNameLint, checkNameWhitespace}})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{checkNameLint, checkNameWhitespace}, ran(), "profiles such as pre-push run everything")
+}
+
+func TestCommitBudget_Disabled(t *testing.T) {
+	r, ran := newBudgetRunner(t, 0, map[string]time.Duration{checkNameLint: time.Hour}, nil)
+	results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{checkNameLint}, ran())
+	assert.Zero(t, results.Skipped)
+
+	var budget *commitBudget
+	assert.True(t, budget.allows(checkNameLint))
+	list := []checks.Check{&mockCheck{name: "b"}, &mockCheck{name: "a"}}
+	assert.Equal(t, list, budget.fastestFirst(list))
+}
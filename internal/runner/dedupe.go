@@ -0,0 +1,62 @@
+package runner
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// dedupeFiles removes repeated paths, keeping the first occurrence of each in
+// its original order. Paths are compared relative to the repository root
+// after cleaning, so "./a.go", "a.go", and "<root>/a.go" are one file. Paths
+// that differ only in case are one file when they resolve to the same file on
+// disk, as they do on case-insensitive filesystems.
+func (r *Runner) dedupeFiles(files []string) []string {
+	if len(files) < 2 {
+		return files
+	}
+
+	seen := make(map[string]bool, len(files))
+	byFoldedKey := make(map[string][]string, len(files))
+	kept := make([]string, 0, len(files))
+	for _, file := range files {
+		key := filepath.ToSlash(filepath.Clean(r.repoRelative(file)))
+		if seen[key] {
+			continue
+		}
+		folded := strings.ToLower(key)
+		if r.sameFileAsAny(file, byFoldedKey[folded]) {
+			continue
+		}
+		seen[key] = true
+		byFoldedKey[folded] = append(byFoldedKey[folded], file)
+		kept = append(kept, file)
+	}
+	return kept
+}
+
+// sameFileAsAny reports whether file and one of others name the same file on
+// disk. Files that cannot be read are never considered the same.
+func (r *Runner) sameFileAsAny(file string, others []string) bool {
+	if len(others) == 0 {
+		return false
+	}
+	info, err := os.Stat(r.resolvePath(file))
+	if err != nil {
+		return false
+	}
+	for _, other := range others {
+		if otherInfo, otherErr := os.Stat(r.resolvePath(other)); otherErr == nil && os.SameFile(info, otherInfo) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolvePath returns file joined to the repository root unless it is absolute
+func (r *Runner) resolvePath(file string) string {
+	if filepath.IsAbs(file) || r.repoRoot == "" {
+		return file
+	}
+	return filepath.Join(r.repoRoot, file)
+}
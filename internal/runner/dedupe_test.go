@@ -0,0 +1,86 @@
+package runner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+// caseInsensitiveFS reports whether dir lives on a case-insensitive filesystem
+func caseInsensitiveFS(t *testing.T, dir string) bool {
+	t.Helper()
+	probe := filepath.Join(dir, "case-probe")
+	require.NoError(t, os.WriteFile(probe, nil, 0o600))
+	defer func() { _ = os.Remove(probe) }()
+	_, err := os.Stat(filepath.Join(dir, "CASE-PROBE"))
+	return err == nil
+}
+
+func TestDedupeFiles(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "pkg"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pkg", "util.go"), []byte("package pkg\n"), 0o600))
+
+	r := New(&config.Config{Enabled: true, Timeout: 60}, dir)
+
+	t.Run("same path spelled differently", func(t *testing.T) {
+		files := []string{"main.go", "pkg/util.go", "./main.go", filepath.Join(dir, "main.go"), "pkg//util.go", "main.go"}
+		assert.Equal(t, []string{"main.go", "pkg/util.go"}, r.dedupeFiles(files))
+	})
+
+	t.Run("keeps first-seen order", func(t *testing.T) {
+		files := []string{"pkg/util.go", "main.go", "pkg/util.go"}
+		assert.Equal(t, []string{"pkg/util.go", "main.go"}, r.dedupeFiles(files))
+	})
+
+	t.Run("differently-cased duplicate", func(t *testing.T) {
+		files := []string{"main.go", "MAIN.go", "pkg/Util.go", "pkg/util.go"}
+		if caseInsensitiveFS(t, dir) {
+			assert.Equal(t, []string{"main.go", "pkg/Util.go"}, r.dedupeFiles(files))
+		} else {
+			// MAIN.go and pkg/Util.go do not exist here, so they are distinct paths
+			assert.Equal(t, files, r.dedupeFiles(files))
+		}
+	})
+
+	t.Run("distinct files differing only in case", func(t *testing.T) {
+		if caseInsensitiveFS(t, dir) {
+			t.Skip("filesystem cannot hold names differing only in case")
+		}
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("upper\n"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "readme.md"), []byte("lower\n"), 0o600))
+		files := []string{"README.md", "readme.md", "README.md"}
+		assert.Equal(t, []string{"README.md", "readme.md"}, r.dedupeFiles(files))
+	})
+}
+
+func TestRun_DedupesFiles(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.txt"), []byte("b\n"), 0o600))
+
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Whitespace = true
+	cfg.CheckTimeouts.Whitespace = 30
+
+	r := New(cfg, dir)
+	var checked []string
+	r.registry.Register(&mockCheck{name: checkNameWhitespace, run: func(_ context.Context, files []string) error {
+		checked = files
+		return nil
+	}})
+
+	results, err := r.Run(context.Background(), Options{Files: []string{"b.txt", "a.txt", "./b.txt", "a.txt"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "b.txt"), filepath.Join(dir, "a.txt")}, checked, "resolved because the test does not run from the root")
+	assert.Equal(t, 4, results.InputFiles)
+	assert.Equal(t, 2, results.UniqueFiles)
+	assert.Equal(t, 2, results.TotalFiles)
+}
@@ -0,0 +1,34 @@
+package runner
+
+import (
+	"path/filepath"
+
+	"github.com/mrz1836/go-pre-commit/internal/git"
+)
+
+// dropDeletedFiles removes files git reports as deleted, staged or not, since
+// there is nothing left to check and reading them would fail. Outside a
+// repository the files are returned unchanged.
+func (r *Runner) dropDeletedFiles(files []string) []string {
+	if len(files) == 0 || r.repoRoot == "" {
+		return files
+	}
+
+	deleted, err := git.NewRepositoryWithConfig(r.repoRoot, r.config).GetDeletedFiles()
+	if err != nil || len(deleted) == 0 {
+		return files
+	}
+
+	isDeleted := make(map[string]bool, len(deleted))
+	for _, file := range deleted {
+		isDeleted[file] = true
+	}
+
+	kept := make([]string, 0, len(files))
+	for _, file := range files {
+		if !isDeleted[filepath.ToSlash(r.repoRelative(file))] {
+			kept = append(kept, file)
+		}
+	}
+	return kept
+}
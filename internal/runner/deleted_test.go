@@ -0,0 +1,57 @@
+package runner
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+func TestRun_SkipsDeletedFiles(t *testing.T) {
+	dir := t.TempDir()
+	runGit := func(args ...string) {
+		t.Helper()
+		cmd := exec.CommandContext(context.Background(), "git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	runGit("init", "-q")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "modified.txt"), []byte("one\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "removed.txt"), []byte("gone\n"), 0o600))
+	runGit("add", ".")
+	runGit("-c", "user.email=test@example.com", "-c", "user.name=Test", "commit", "-q", "-m", "init")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "modified.txt"), []byte("two\n"), 0o600))
+	runGit("add", "modified.txt")
+	runGit("rm", "-q", "removed.txt")
+
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Whitespace = true
+	cfg.CheckTimeouts.Whitespace = 30
+
+	r := New(cfg, dir)
+	var checked []string
+	r.registry.Register(&mockCheck{name: checkNameWhitespace, run: func(_ context.Context, files []string) error {
+		checked = files
+		return nil
+	}})
+
+	results, err := r.Run(context.Background(), Options{Files: []string{"modified.txt", "removed.txt"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "modified.txt")}, checked)
+	assert.Equal(t, 1, results.TotalFiles)
+	assert.Equal(t, 1, results.Passed)
+}
+
+func TestDropDeletedFiles_OutsideRepository(t *testing.T) {
+	r := New(&config.Config{Enabled: true, Timeout: 60}, t.TempDir())
+	files := []string{"a.txt", "b.txt"}
+	assert.Equal(t, files, r.dropDeletedFiles(files))
+}
@@ -0,0 +1,143 @@
+package runner
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/mrz1836/go-pre-commit/internal/git"
+)
+
+// Reasons a file is dropped from a check's input beyond those reported by
+// the file classifier
+const (
+	ReasonLanguageMismatch = "language-mismatch" // the check does not handle this file type
+	ReasonLineLimit        = "line-limit"        // over the check's GO_PRE_COMMIT_CHECK_MAX_LINES limit
+	ReasonNoGoChanges      = "no-go-changes"     // Go-specific check skipped for a change without Go files
+	ReasonInlineDisabled   = "inline-disabled"   // the file opts out with a go-pre-commit:disable directive
+)
+
+// ExcludedFile is a file left out of a check, with the reason why
+type ExcludedFile struct {
+	File   string
+	Reason string
+	Detail string // the classifier's explanation, such as "matched exclude pattern vendor/"
+}
+
+// CheckFileList describes how a check's file set is derived: the input files,
+// those excluded along the way, and the files the check would run on
+type CheckFileList struct {
+	Name     string
+	Input    []string
+	Excluded []ExcludedFile
+	Files    []string
+}
+
+// FileLists reports, for every check the options would run, which files it
+// would receive and why the others were excluded. It applies the same
+// filtering as Run without running any check.
+func (r *Runner) FileLists(ctx context.Context, opts Options) ([]CheckFileList, error) {
+	opts.SkipChecks = r.combineSkipSources(opts.SkipChecks)
+	opts.Files = r.dropDeletedFiles(r.dedupeFiles(r.normalizeFiles(opts.Files)))
+
+	checksToRun, err := r.determineChecks(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	_, skipped := r.partitionGoChecks(ctx, checksToRun, opts)
+	skippedNames := make(map[string]bool, len(skipped))
+	for _, result := range skipped {
+		skippedNames[result.Name] = true
+	}
+
+	lists := make([]CheckFileList, 0, len(checksToRun))
+	for _, check := range checksToRun {
+		list := CheckFileList{Name: check.Name(), Input: opts.Files}
+		if skippedNames[check.Name()] {
+			list.Excluded = excludeAll(opts.Files, ReasonNoGoChanges)
+			lists = append(lists, list)
+			continue
+		}
+
+		var candidates []string
+		for _, file := range opts.Files {
+			if reason := r.excludeReason(file); reason != "" {
+				list.Excluded = append(list.Excluded, ExcludedFile{File: file, Reason: reason})
+				continue
+			}
+			candidates = append(candidates, file)
+		}
+
+		filtered := check.FilterFiles(candidates)
+		list.Excluded = append(list.Excluded, r.filteredOutReasons(ctx, candidates, filtered)...)
+
+		filtered, inlineDisabled := r.applyInlineDisables(check.Name(), filtered)
+		list.Excluded = append(list.Excluded, excludeAll(inlineDisabled, ReasonInlineDisabled)...)
+		filtered, overLimit := r.applyLineLimit(check.Name(), filtered)
+		list.Excluded = append(list.Excluded, excludeAll(overLimit, ReasonLineLimit)...)
+		list.Files = filtered
+
+		lists = append(lists, list)
+	}
+	return lists, nil
+}
+
+// filteredOutReasons explains the candidates a check's FilterFiles dropped,
+// using the classifier's reason when it has one
+func (r *Runner) filteredOutReasons(ctx context.Context, candidates, filtered []string) []ExcludedFile {
+	keptSet := make(map[string]bool, len(filtered))
+	for _, file := range filtered {
+		keptSet[file] = true
+	}
+
+	var dropped []string
+	resolved := make(map[string]string)
+	for _, file := range candidates {
+		if keptSet[file] {
+			continue
+		}
+		dropped = append(dropped, file)
+		path := file
+		if !filepath.IsAbs(path) && r.repoRoot != "" {
+			path = filepath.Join(r.repoRoot, path)
+		}
+		resolved[path] = file
+	}
+	if len(dropped) == 0 {
+		return nil
+	}
+
+	paths := make([]string, 0, len(resolved))
+	for path := range resolved {
+		paths = append(paths, path)
+	}
+	infos, _ := git.NewFileClassifier(r.config).ClassifyFiles(ctx, paths)
+	classified := make(map[string]git.FileInfo, len(infos))
+	for _, info := range infos {
+		classified[resolved[info.Path]] = info
+	}
+
+	excluded := make([]ExcludedFile, 0, len(dropped))
+	for _, file := range dropped {
+		info := classified[file]
+		if info.Reason == "" {
+			excluded = append(excluded, ExcludedFile{File: file, Reason: ReasonLanguageMismatch})
+			continue
+		}
+		detail := info.ExcludeReason
+		if detail == "" {
+			detail = info.SkipReason
+		}
+		excluded = append(excluded, ExcludedFile{File: file, Reason: info.Reason, Detail: detail})
+	}
+	return excluded
+}
+
+// excludeAll marks every file as excluded for reason
+func excludeAll(files []string, reason string) []ExcludedFile {
+	excluded := make([]ExcludedFile, 0, len(files))
+	for _, file := range files {
+		excluded = append(excluded, ExcludedFile{File: file, Reason: reason})
+	}
+	return excluded
+}
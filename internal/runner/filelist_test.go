@@ -0,0 +1,128 @@
+package runner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	"github.com/mrz1836/go-pre-commit/internal/git"
+)
+
+// textOnlyCheck is a mock check that only accepts .txt files
+type textOnlyCheck struct {
+	mockCheck
+}
+
+func (c *textOnlyCheck) FilterFiles(files []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if strings.HasSuffix(file, ".txt") {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+func TestFileLists_ReportsExclusionReasons(t *testing.T) {
+	repoRoot := t.TempDir()
+	writeRepoFile := func(name string, content []byte) string {
+		path := filepath.Join(repoRoot, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+		require.NoError(t, os.WriteFile(path, content, 0o600))
+		return name
+	}
+
+	kept := writeRepoFile("notes.txt", []byte("hello\n"))
+	binary := writeRepoFile("image.png", []byte{0x00, 0x01, 0x02, 0xFF})
+	generated := writeRepoFile("gen.go", []byte("// Code generated by stringer. DO NOT EDIT.\npackage p\n"))
+	mismatch := writeRepoFile("README.md", []byte("# readme\n"))
+	excluded := writeRepoFile("third_party/lib.txt", []byte("vendored\n"))
+	dotfile := writeRepoFile(".env.txt", []byte("KEY=value\n"))
+
+	cfg := &config.Config{Enabled: true, Timeout: 60, MaxFileSize: 1024 * 1024}
+	cfg.Checks.Whitespace = true
+	cfg.Git.ExcludePatterns = []string{"third_party/"}
+	cfg.Git.SkipDotfiles = true
+
+	r := New(cfg, repoRoot)
+	r.registry.Register(&textOnlyCheck{mockCheck{name: checkNameWhitespace}})
+
+	input := []string{kept, binary, generated, mismatch, excluded, dotfile}
+	lists, err := r.FileLists(context.Background(), Options{Files: input})
+	require.NoError(t, err)
+	require.Len(t, lists, 1)
+
+	list := lists[0]
+	assert.Equal(t, checkNameWhitespace, list.Name)
+	assert.Equal(t, input, list.Input)
+	assert.Equal(t, []string{kept}, list.Files)
+	assert.ElementsMatch(t, []ExcludedFile{
+		{File: excluded, Reason: git.ReasonExcludedPattern},
+		{File: dotfile, Reason: git.ReasonDotfile},
+		{File: binary, Reason: git.ReasonBinary, Detail: "binary content"},
+		{File: generated, Reason: git.ReasonGenerated, Detail: "generated marker"},
+		{File: mismatch, Reason: ReasonLanguageMismatch},
+	}, list.Excluded)
+}
+
+func TestFileLists_LineLimitAndGoSkip(t *testing.T) {
+	repoRoot := t.TempDir()
+	long := filepath.Join(repoRoot, "long.txt")
+	require.NoError(t, os.WriteFile(long, []byte(strings.Repeat("line\n", 20)), 0o600))
+
+	cfg := &config.Config{Enabled: true, Timeout: 60, MaxFileSize: 1024 * 1024}
+	cfg.Checks.Whitespace = true
+	cfg.Checks.Fumpt = true
+	cfg.CheckBehaviors.MaxLines = map[string]int{checkNameWhitespace: 10}
+
+	r := New(cfg, repoRoot)
+	r.registry.Register(&mockCheck{name: checkNameWhitespace})
+	r.registry.Register(goCheck(mockCheck{name: checkNameFumpt}))
+
+	lists, err := r.FileLists(context.Background(), Options{Files: []string{long}})
+	require.NoError(t, err)
+
+	byName := make(map[string]CheckFileList, len(lists))
+	for _, list := range lists {
+		byName[list.Name] = list
+	}
+
+	require.Contains(t, byName, checkNameWhitespace)
+	assert.Empty(t, byName[checkNameWhitespace].Files)
+	assert.Equal(t, []ExcludedFile{{File: "long.txt", Reason: ReasonLineLimit}}, byName[checkNameWhitespace].Excluded)
+
+	require.Contains(t, byName, checkNameFumpt)
+	assert.Empty(t, byName[checkNameFumpt].Files)
+	assert.Equal(t, []ExcludedFile{{File: "long.txt", Reason: ReasonNoGoChanges}}, byName[checkNameFumpt].Excluded)
+}
+
+func TestFileLists_InlineDisabled(t *testing.T) {
+	repoRoot := t.TempDir()
+	optOut := filepath.Join(repoRoot, "opt-out.txt")
+	require.NoError(t, os.WriteFile(optOut, []byte("# go-pre-commit:disable whitespace\n"), 0o600))
+
+	cfg := &config.Config{Enabled: true, Timeout: 60, MaxFileSize: 1024 * 1024}
+	cfg.Checks.Whitespace = true
+	cfg.Checks.EOF = true
+
+	r := New(cfg, repoRoot)
+	r.registry.Register(&mockCheck{name: checkNameWhitespace})
+	r.registry.Register(&mockCheck{name: checkNameEOF})
+
+	lists, err := r.FileLists(context.Background(), Options{Files: []string{optOut}})
+	require.NoError(t, err)
+
+	byName := make(map[string]CheckFileList, len(lists))
+	for _, list := range lists {
+		byName[list.Name] = list
+	}
+
+	assert.Equal(t, []ExcludedFile{{File: "opt-out.txt", Reason: ReasonInlineDisabled}}, byName[checkNameWhitespace].Excluded)
+	assert.Equal(t, []string{"opt-out.txt"}, byName[checkNameEOF].Files, "listed relative to the root")
+}
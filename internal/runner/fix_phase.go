@@ -0,0 +1,34 @@
+package runner
+
+import (
+	"github.com/mrz1836/go-pre-commit/internal/checks"
+)
+
+// categoryFormatting is the metadata category of checks that rewrite the
+// files they check: fumpt, whitespace, eof, data-format, and go-indent
+const categoryFormatting = "formatting"
+
+// fixPhases splits the checks into the groups that run one after another.
+// With GO_PRE_COMMIT_RERUN_VALIDATORS_AFTER_FIX the formatting checks run
+// first and every other check starts once they are done, so lint, vet, and
+// the rest read the fixed files rather than what was staged. Otherwise, or
+// when either group is empty, all checks run together. The order within each
+// group is kept.
+func (r *Runner) fixPhases(checksToRun []checks.Check) [][]checks.Check {
+	if !r.config.CheckBehaviors.ReRunValidatorsAfterFix {
+		return [][]checks.Check{checksToRun}
+	}
+
+	var fixers, validators []checks.Check
+	for _, check := range checksToRun {
+		if metadata, ok := r.registry.GetMetadata(check.Name()); ok && metadata.Category == categoryFormatting {
+			fixers = append(fixers, check)
+			continue
+		}
+		validators = append(validators, check)
+	}
+	if len(fixers) == 0 || len(validators) == 0 {
+		return [][]checks.Check{checksToRun}
+	}
+	return [][]checks.Check{fixers, validators}
+}
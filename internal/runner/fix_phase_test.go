@@ -0,0 +1,101 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/checks"
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+var errUnformatted = errors.New("main.go: File is not properly formatted (gofumpt)")
+
+const (
+	unformattedSource = "package main\nfunc main() {  }\n"
+	formattedSource   = "package main\n\nfunc main() {}\n"
+)
+
+// newFixPhaseRunner returns a runner in a repository holding an unformatted
+// main.go, with a slow fumpt mock that formats it and a lint mock that fails
+// while it is unformatted
+func newFixPhaseRunner(t *testing.T, reRun bool) (*Runner, string) {
+	t.Helper()
+	repoRoot := t.TempDir()
+	file := filepath.Join(repoRoot, "main.go")
+	require.NoError(t, os.WriteFile(file, []byte(unformattedSource), 0o600))
+
+	cfg := &config.Config{Enabled: true, Timeout: 60, MaxFileSize: 1024 * 1024}
+	cfg.Checks.Fumpt, cfg.CheckTimeouts.Fumpt = true, 30
+	cfg.Checks.Lint, cfg.CheckTimeouts.Lint = true, 30
+	cfg.CheckBehaviors.ReRunValidatorsAfterFix = reRun
+
+	r := New(cfg, repoRoot)
+	r.registry.Register(&metadataCheck{
+		mockCheck: mockCheck{name: checkNameFumpt, run: func(_ context.Context, _ []string) error {
+			time.Sleep(50 * time.Millisecond)
+			return os.WriteFile(file, []byte(formattedSource), 0o600)
+		}},
+		metadata: checks.CheckMetadata{Category: "formatting", EstimatedDuration: time.Second},
+	})
+	r.registry.Register(&metadataCheck{
+		mockCheck: mockCheck{name: checkNameLint, run: func(_ context.Context, _ []string) error {
+			content, err := os.ReadFile(file) //nolint:gosec // test file
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(content, []byte(formattedSource)) {
+				return errUnformatted
+			}
+			return nil
+		}},
+		metadata: checks.CheckMetadata{Category: "linting", EstimatedDuration: time.Millisecond},
+	})
+	return r, file
+}
+
+func TestRun_ValidatorsSeeFixedFiles(t *testing.T) {
+	for _, failFast := range []bool{false, true} {
+		r, _ := newFixPhaseRunner(t, true)
+
+		results, err := r.Run(context.Background(), Options{Files: []string{"main.go"}, Parallel: 2, FailFast: failFast})
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, results.Passed, "fail-fast=%t", failFast)
+		assert.Zero(t, results.Failed, "lint reads main.go after fumpt formats it")
+	}
+}
+
+func TestRun_ValidatorsRunAlongsideFixersWhenDisabled(t *testing.T) {
+	r, _ := newFixPhaseRunner(t, false)
+
+	results, err := r.Run(context.Background(), Options{Files: []string{"main.go"}, Parallel: 2})
+	require.NoError(t, err)
+
+	require.Equal(t, 1, results.Failed, "lint reads main.go before fumpt formats it")
+	for _, result := range results.CheckResults {
+		if result.Name == checkNameLint {
+			assert.Contains(t, result.Error, errUnformatted.Error())
+		}
+	}
+}
+
+func TestFixPhases(t *testing.T) {
+	r, _ := newFixPhaseRunner(t, true)
+	fumpt, _ := r.registry.Get(checkNameFumpt)
+	lint, _ := r.registry.Get(checkNameLint)
+
+	assert.Equal(t, [][]checks.Check{{fumpt}, {lint}}, r.fixPhases([]checks.Check{lint, fumpt}))
+	assert.Equal(t, [][]checks.Check{{lint}}, r.fixPhases([]checks.Check{lint}), "no fixers, one group")
+	assert.Equal(t, [][]checks.Check{{fumpt}}, r.fixPhases([]checks.Check{fumpt}), "no validators, one group")
+
+	r.config.CheckBehaviors.ReRunValidatorsAfterFix = false
+	assert.Equal(t, [][]checks.Check{{lint, fumpt}}, r.fixPhases([]checks.Check{lint, fumpt}))
+}
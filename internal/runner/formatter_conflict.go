@@ -0,0 +1,50 @@
+package runner
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mrz1836/go-pre-commit/internal/checks"
+	"github.com/mrz1836/go-pre-commit/internal/golangci"
+)
+
+// resolveFormatterConflicts looks for golangci-lint formatters that rewrite
+// the same code as the standalone fumpt check when both fumpt and lint are
+// about to run. With GO_PRE_COMMIT_RESOLVE_FORMATTER_CONFLICTS the fumpt check
+// is skipped and golangci-lint does the formatting; otherwise both run and a
+// warning is returned.
+func (r *Runner) resolveFormatterConflicts(checksToRun []checks.Check) ([]checks.Check, []CheckResult, []string) {
+	names := make([]string, len(checksToRun))
+	for i, check := range checksToRun {
+		names[i] = check.Name()
+	}
+	if !slices.Contains(names, checkNameFumpt) || !slices.Contains(names, checkNameLint) {
+		return checksToRun, nil, nil
+	}
+
+	// A missing or unreadable config has nothing to compare; golangci-lint
+	// reports its own config errors when lint runs
+	configFile, overlap, err := golangci.FindFormatterOverlap(r.repoRoot)
+	if err != nil || len(overlap) == 0 {
+		return checksToRun, nil, nil
+	}
+
+	formatters := strings.Join(overlap, ", ")
+	if !r.config.CheckBehaviors.ResolveFormatterConflicts {
+		return checksToRun, nil, []string{fmt.Sprintf(
+			"%s enables %s, which can conflict with the standalone fumpt check; set GO_PRE_COMMIT_RESOLVE_FORMATTER_CONFLICTS=true to let golangci-lint format alone",
+			configFile, formatters)}
+	}
+
+	kept := slices.DeleteFunc(checksToRun, func(check checks.Check) bool {
+		return check.Name() == checkNameFumpt
+	})
+	return kept, []CheckResult{{
+		Name:       checkNameFumpt,
+		Success:    true,
+		Skipped:    true,
+		Error:      fmt.Sprintf("golangci-lint formats with %s (%s)", formatters, configFile),
+		Suggestion: "Set GO_PRE_COMMIT_RESOLVE_FORMATTER_CONFLICTS=false to run fumpt as well",
+	}}, nil
+}
@@ -0,0 +1,103 @@
+package runner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+// formatterConflictRunner returns a runner over a repository whose
+// .golangci.yml holds golangciConfig, with mocked fumpt and lint checks that
+// count how often fumpt runs
+func formatterConflictRunner(t *testing.T, golangciConfig string, resolve bool) (*Runner, *atomic.Int32) {
+	t.Helper()
+
+	repoRoot := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(repoRoot, "main.go"), []byte("package main\n"), 0o600))
+	if golangciConfig != "" {
+		require.NoError(t, os.WriteFile(filepath.Join(repoRoot, ".golangci.yml"), []byte(golangciConfig), 0o600))
+	}
+
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Fumpt = true
+	cfg.Checks.Lint = true
+	cfg.CheckTimeouts.Fumpt = 30
+	cfg.CheckTimeouts.Lint = 30
+	cfg.CheckBehaviors.ResolveFormatterConflicts = resolve
+
+	var fumptRuns atomic.Int32
+	r := New(cfg, repoRoot)
+	r.registry.Register(&mockCheck{name: checkNameFumpt, run: func(context.Context, []string) error {
+		fumptRuns.Add(1)
+		return nil
+	}})
+	r.registry.Register(&mockCheck{name: checkNameLint, run: func(context.Context, []string) error { return nil }})
+	return r, &fumptRuns
+}
+
+const gofumptGolangciConfig = "version: \"2\"\nformatters:\n  enable:\n    - gofumpt\n    - gci\n"
+
+func TestRun_FormatterConflictWarns(t *testing.T) {
+	r, fumptRuns := formatterConflictRunner(t, gofumptGolangciConfig, false)
+
+	results, err := r.Run(context.Background(), Options{Files: []string{"main.go"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(1), fumptRuns.Load(), "fumpt still runs when conflicts are not resolved")
+	assert.Equal(t, 2, results.Passed)
+	require.Len(t, results.Warnings, 1)
+	assert.Contains(t, results.Warnings[0], ".golangci.yml enables gci, gofumpt")
+	assert.Contains(t, results.Warnings[0], "GO_PRE_COMMIT_RESOLVE_FORMATTER_CONFLICTS=true")
+}
+
+func TestRun_FormatterConflictSkipsFumpt(t *testing.T) {
+	r, fumptRuns := formatterConflictRunner(t, gofumptGolangciConfig, true)
+
+	results, err := r.Run(context.Background(), Options{Files: []string{"main.go"}})
+	require.NoError(t, err)
+
+	assert.Zero(t, fumptRuns.Load())
+	assert.Empty(t, results.Warnings)
+	assert.Equal(t, 1, results.Passed)
+	assert.Equal(t, 1, results.Skipped)
+	for _, result := range results.CheckResults {
+		if result.Name == checkNameFumpt {
+			assert.True(t, result.Skipped)
+			assert.Equal(t, "golangci-lint formats with gci, gofumpt (.golangci.yml)", result.Error)
+		}
+	}
+}
+
+func TestRun_NoFormatterConflict(t *testing.T) {
+	tests := map[string]struct {
+		golangciConfig string
+		onlyFumpt      bool
+	}{
+		"no golangci config":  {},
+		"no overlap":          {golangciConfig: "linters:\n  enable:\n    - govet\n"},
+		"lint is not running": {golangciConfig: gofumptGolangciConfig, onlyFumpt: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r, fumptRuns := formatterConflictRunner(t, tt.golangciConfig, true)
+			opts := Options{Files: []string{"main.go"}}
+			if tt.onlyFumpt {
+				opts.OnlyChecks = []string{checkNameFumpt}
+			}
+
+			results, err := r.Run(context.Background(), opts)
+			require.NoError(t, err)
+			assert.Equal(t, int32(1), fumptRuns.Load())
+			assert.Empty(t, results.Warnings)
+			assert.Zero(t, results.Skipped)
+		})
+	}
+}
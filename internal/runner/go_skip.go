@@ -0,0 +1,86 @@
+package runner
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/mrz1836/go-pre-commit/internal/checks"
+	"github.com/mrz1836/go-pre-commit/internal/git"
+)
+
+// goSkipReason explains why a Go-specific check was not run
+const goSkipReason = "no Go files or go.mod changes to check"
+
+// goToolDependency is the dependency of checks that run the go tool
+const goToolDependency = "go"
+
+// isGoSpecific reports whether a check only makes sense when Go sources or
+// module files are part of the change: it runs the go tool, or every file
+// pattern in its metadata targets Go sources or module files
+func isGoSpecific(metadata checks.CheckMetadata) bool {
+	if slices.Contains(metadata.Dependencies, goToolDependency) {
+		return true
+	}
+	if len(metadata.FilePatterns) == 0 {
+		return false
+	}
+	for _, pattern := range metadata.FilePatterns {
+		if !isGoFilePattern(pattern) {
+			return false
+		}
+	}
+	return true
+}
+
+// isGoFilePattern reports whether a file pattern only matches Go sources,
+// such as "*.go" or "*_test.go", or module files
+func isGoFilePattern(pattern string) bool {
+	switch pattern {
+	case "go.mod", "go.sum", "go.work", "go.work.sum":
+		return true
+	default:
+		return strings.HasSuffix(pattern, ".go")
+	}
+}
+
+// hasGoChanges reports whether the files include Go sources or module files,
+// using the file classifier's language counts
+func (r *Runner) hasGoChanges(ctx context.Context, files []string) bool {
+	resolved := make([]string, len(files))
+	for i, file := range files {
+		resolved[i] = r.resolvePath(file)
+	}
+
+	stats, err := git.NewFileClassifier(r.config).GetFileStats(ctx, resolved)
+	if err != nil {
+		// When classification fails, fall back to running every check
+		return true
+	}
+	return stats["go"] > 0 || stats["lang_go-mod"] > 0
+}
+
+// partitionGoChecks splits off Go-specific checks when the change has no Go
+// files, returning the checks to run and skipped results for the rest
+func (r *Runner) partitionGoChecks(ctx context.Context, checksToRun []checks.Check, opts Options) ([]checks.Check, []CheckResult) {
+	if opts.ForceAllChecks || len(opts.Files) == 0 || r.hasGoChanges(ctx, opts.Files) {
+		return checksToRun, nil
+	}
+
+	kept := make([]checks.Check, 0, len(checksToRun))
+	var skipped []CheckResult
+	for _, check := range checksToRun {
+		if metadata, ok := r.registry.GetMetadata(check.Name()); !ok || !isGoSpecific(metadata) {
+			kept = append(kept, check)
+			continue
+		}
+		skipped = append(skipped, CheckResult{
+			Name:       check.Name(),
+			Success:    true,
+			Skipped:    true,
+			Error:      goSkipReason,
+			Suggestion: "Use --force-all-checks to run Go checks anyway",
+		})
+	}
+	return kept, skipped
+}
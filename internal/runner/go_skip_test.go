@@ -0,0 +1,158 @@
+package runner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/checks"
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+// newGoSkipRunner registers counting mock checks for a Go-specific check
+// (lint) and a language-agnostic one (whitespace)
+func newGoSkipRunner(t *testing.T, lintRuns, whitespaceRuns *int64) (*Runner, string) {
+	t.Helper()
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Lint = true
+	cfg.Checks.Whitespace = true
+
+	dir := t.TempDir()
+	r := New(cfg, dir)
+	r.registry.Register(goCheck(mockCheck{name: checkNameLint, run: func(context.Context, []string) error {
+		atomic.AddInt64(lintRuns, 1)
+		return nil
+	}}))
+	r.registry.Register(&mockCheck{name: checkNameWhitespace, run: func(context.Context, []string) error {
+		atomic.AddInt64(whitespaceRuns, 1)
+		return nil
+	}})
+	return r, dir
+}
+
+// goCheck gives a mock check the Go file patterns that make it Go-specific
+func goCheck(check mockCheck) *metadataCheck {
+	return &metadataCheck{mockCheck: check, metadata: checks.CheckMetadata{Name: check.name, FilePatterns: []string{"*.go"}}}
+}
+
+func writeRepoFile(t *testing.T, dir, name string) string {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("x\n"), 0o600))
+	return name
+}
+
+func TestRun_SkipsGoChecksWithoutGoFiles(t *testing.T) {
+	var lintRuns, whitespaceRuns int64
+	r, dir := newGoSkipRunner(t, &lintRuns, &whitespaceRuns)
+	files := []string{writeRepoFile(t, dir, "README.md"), writeRepoFile(t, dir, "script.sh")}
+
+	results, err := r.Run(context.Background(), Options{Files: files})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(0), atomic.LoadInt64(&lintRuns), "lint must not run for a Go-free commit")
+	assert.Equal(t, int64(1), atomic.LoadInt64(&whitespaceRuns))
+	assert.Equal(t, 1, results.Skipped)
+	assert.Equal(t, 1, results.Passed)
+	assert.Equal(t, 0, results.Failed)
+
+	var lint *CheckResult
+	for i := range results.CheckResults {
+		if results.CheckResults[i].Name == checkNameLint {
+			lint = &results.CheckResults[i]
+		}
+	}
+	require.NotNil(t, lint)
+	assert.True(t, lint.Skipped)
+	assert.True(t, lint.Success)
+	assert.Equal(t, goSkipReason, lint.Error)
+	assert.Contains(t, lint.Suggestion, "--force-all-checks")
+}
+
+func TestRun_MixedCommitRunsGoChecks(t *testing.T) {
+	tests := []struct {
+		name  string
+		files []string
+	}{
+		{name: "go source", files: []string{"README.md", "main.go"}},
+		{name: "go.mod only", files: []string{"README.md", "go.mod"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var lintRuns, whitespaceRuns int64
+			r, dir := newGoSkipRunner(t, &lintRuns, &whitespaceRuns)
+			for _, f := range tt.files {
+				writeRepoFile(t, dir, f)
+			}
+
+			results, err := r.Run(context.Background(), Options{Files: tt.files})
+			require.NoError(t, err)
+			assert.Equal(t, int64(1), atomic.LoadInt64(&lintRuns))
+			assert.Equal(t, int64(1), atomic.LoadInt64(&whitespaceRuns))
+			assert.Equal(t, 0, results.Skipped)
+			assert.Equal(t, 2, results.Passed)
+		})
+	}
+}
+
+func TestRun_ForceAllChecksRunsGoChecks(t *testing.T) {
+	var lintRuns, whitespaceRuns int64
+	r, dir := newGoSkipRunner(t, &lintRuns, &whitespaceRuns)
+	files := []string{writeRepoFile(t, dir, "README.md")}
+
+	results, err := r.Run(context.Background(), Options{Files: files, ForceAllChecks: true})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), atomic.LoadInt64(&lintRuns))
+	assert.Equal(t, 0, results.Skipped)
+	assert.Equal(t, 2, results.Passed)
+}
+
+func TestIsGoSpecific(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata checks.CheckMetadata
+		want     bool
+	}{
+		{name: "go sources", metadata: checks.CheckMetadata{FilePatterns: []string{"*.go"}}, want: true},
+		{name: "test files", metadata: checks.CheckMetadata{FilePatterns: []string{"*_test.go"}}, want: true},
+		{name: "module files", metadata: checks.CheckMetadata{FilePatterns: []string{"*.go", "go.mod", "go.sum"}}, want: true},
+		{name: "runs the go tool", metadata: checks.CheckMetadata{FilePatterns: []string{"*"}, Dependencies: []string{"go"}}, want: true},
+		{name: "any file", metadata: checks.CheckMetadata{FilePatterns: []string{"*"}}},
+		{name: "go and other sources", metadata: checks.CheckMetadata{FilePatterns: []string{"*.go", "*.md"}}},
+		{name: "no patterns", metadata: checks.CheckMetadata{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isGoSpecific(tt.metadata))
+		})
+	}
+}
+
+func TestIsGoSpecific_BuiltinChecks(t *testing.T) {
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	r := New(cfg, t.TempDir())
+
+	goChecks := []string{
+		checkNameFumpt, checkNameLint, checkNameModTidy, checkNameModPair, checkNameGoGenerate,
+		checkNameCtxFirst, checkNameForbidden, checkNameStructTags, checkNameDocComments,
+		checkNameReceiverNames, checkNameEmbeddedBlobs, checkNameGosec, checkNameGoDirective,
+		checkNameStubFuncs, checkNameTestPackage, checkNameToolchain, checkNameModulePath,
+	}
+	for _, name := range goChecks {
+		metadata, ok := r.registry.GetMetadata(name)
+		require.True(t, ok, name)
+		assert.True(t, isGoSpecific(metadata), name)
+	}
+
+	for _, name := range []string{checkNameWhitespace, checkNameEOF, checkNameGitleaks, checkNameEmptyCommit, checkNameShellcheck} {
+		metadata, ok := r.registry.GetMetadata(name)
+		require.True(t, ok, name)
+		assert.False(t, isGoSpecific(metadata), name)
+	}
+}
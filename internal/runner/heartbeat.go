@@ -0,0 +1,56 @@
+package runner
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/checks"
+)
+
+// progressHeartbeat is the progress status sent while a check is still
+// running, with the time it has run so far as the duration
+const progressHeartbeat = "heartbeat"
+
+// runCheckWithProgress reports the check as running, runs it, and while it
+// runs reports it again every opts.HeartbeatInterval so a slow check does not
+// look like a hung one
+func (r *Runner) runCheckWithProgress(ctx context.Context, check checks.Check, opts Options) CheckResult {
+	r.notifyProgress(opts, check.Name(), "running", 0)
+	stop := r.startHeartbeat(opts, check.Name())
+	defer stop()
+	return r.runCheck(ctx, check, opts.Files, opts.GracefulDegradation, opts.DebugTimeout)
+}
+
+// startHeartbeat sends a heartbeat progress update for the named check every
+// opts.HeartbeatInterval until the returned function is called; the function
+// returns once no more updates can be sent. An interval of 0, or no progress
+// callback, sends nothing.
+func (r *Runner) startHeartbeat(opts Options, name string) (stop func()) {
+	if opts.HeartbeatInterval <= 0 || opts.ProgressCallback == nil {
+		return func() {}
+	}
+
+	start := time.Now()
+	ticker := time.NewTicker(opts.HeartbeatInterval)
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				r.notifyProgress(opts, name, progressHeartbeat, time.Since(start))
+			}
+		}
+	}()
+
+	return func() {
+		ticker.Stop()
+		close(done)
+		wg.Wait()
+	}
+}
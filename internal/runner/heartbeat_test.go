@@ -0,0 +1,63 @@
+package runner
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+// runSlowCheck runs a whitespace check that takes 60ms with the given
+// heartbeat interval and returns the progress updates it sent, in order
+func runSlowCheck(t *testing.T, interval time.Duration) (statuses []string, elapsed []time.Duration) {
+	t.Helper()
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Whitespace = true
+
+	r := New(cfg, t.TempDir())
+	r.registry.Register(&mockCheck{name: checkNameWhitespace, run: func(context.Context, []string) error {
+		time.Sleep(60 * time.Millisecond)
+		return nil
+	}})
+
+	var mu sync.Mutex
+	_, err := r.Run(context.Background(), Options{
+		Files:             []string{tempFile(t)},
+		Parallel:          1,
+		HeartbeatInterval: interval,
+		ProgressCallback: func(name, status string, duration time.Duration) {
+			mu.Lock()
+			defer mu.Unlock()
+			statuses = append(statuses, status)
+			if status == progressHeartbeat {
+				elapsed = append(elapsed, duration)
+			}
+		},
+	})
+	require.NoError(t, err)
+	return statuses, elapsed
+}
+
+func TestRun_HeartbeatForSlowCheck(t *testing.T) {
+	statuses, elapsed := runSlowCheck(t, 10*time.Millisecond)
+
+	require.NotEmpty(t, elapsed, "a 60ms check sends heartbeats every 10ms")
+	assert.Equal(t, "running", statuses[0])
+	assert.Equal(t, "passed", statuses[len(statuses)-1], "no heartbeat follows the result")
+	for _, status := range statuses[1 : len(statuses)-1] {
+		assert.Equal(t, progressHeartbeat, status)
+	}
+	for i := 1; i < len(elapsed); i++ {
+		assert.Greater(t, elapsed[i], elapsed[i-1], "heartbeats carry the time elapsed so far")
+	}
+}
+
+func TestRun_HeartbeatDisabled(t *testing.T) {
+	statuses, _ := runSlowCheck(t, 0)
+	assert.Equal(t, []string{"running", "passed"}, statuses)
+}
@@ -0,0 +1,83 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Phases of the commands configured with GO_PRE_COMMIT_PRE_RUN and
+// GO_PRE_COMMIT_POST_RUN
+const (
+	HookPhasePreRun  = "pre-run"
+	HookPhasePostRun = "post-run"
+)
+
+// ErrPreRunFailed is returned when a pre-run command fails; no checks run
+var ErrPreRunFailed = errors.New("pre-run command failed")
+
+// HookResult is the outcome of one pre-run or post-run command
+type HookResult struct {
+	Phase    string
+	Command  string
+	Output   string // combined stdout and stderr
+	Duration time.Duration
+	Err      error
+}
+
+// HookCallback is called after each pre-run or post-run command finishes
+type HookCallback func(result HookResult)
+
+// runPreRunHooks runs the configured pre-run commands in order and stops at
+// the first one that fails
+func (r *Runner) runPreRunHooks(ctx context.Context, opts Options) error {
+	for _, command := range r.config.Hooks.PreRun {
+		result := r.runHook(ctx, HookPhasePreRun, command, nil)
+		if opts.HookCallback != nil {
+			opts.HookCallback(result)
+		}
+		if result.Err != nil {
+			return fmt.Errorf("%w: %s: %w", ErrPreRunFailed, command, result.Err)
+		}
+	}
+	return nil
+}
+
+// runPostRunHooks runs every configured post-run command in order. Failures
+// do not change the outcome of the run and are reported as warnings.
+func (r *Runner) runPostRunHooks(ctx context.Context, opts Options, results *Results) {
+	env := []string{"GO_PRE_COMMIT_FAILED_CHECKS=" + strconv.Itoa(results.Failed)}
+	for _, command := range r.config.Hooks.PostRun {
+		result := r.runHook(ctx, HookPhasePostRun, command, env)
+		if opts.HookCallback != nil {
+			opts.HookCallback(result)
+		}
+		if result.Err != nil {
+			results.Warnings = append(results.Warnings, fmt.Sprintf("post-run command %q failed: %v", command, result.Err))
+		}
+	}
+}
+
+// runHook runs one command with sh -c from the repository root. The command
+// sees the phase in GO_PRE_COMMIT_HOOK_PHASE, along with any extra env.
+func (r *Runner) runHook(ctx context.Context, phase, command string, env []string) HookResult {
+	start := time.Now()
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", command) //nolint:gosec // commands come from the user's own configuration
+	cmd.Dir = r.repoRoot
+	cmd.Env = append(append(os.Environ(), "GO_PRE_COMMIT_HOOK_PHASE="+phase), env...)
+	output, err := cmd.CombinedOutput()
+
+	return HookResult{
+		Phase:    phase,
+		Command:  command,
+		Output:   strings.TrimRight(string(output), "\n"),
+		Duration: time.Since(start),
+		Err:      err,
+	}
+}
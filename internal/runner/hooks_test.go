@@ -0,0 +1,98 @@
+package runner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+// newHookRunner returns a runner with a passing whitespace check whose runs
+// are counted
+func newHookRunner(t *testing.T, preRun, postRun []string) (*Runner, *int) {
+	t.Helper()
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Whitespace = true
+	cfg.Hooks.PreRun = preRun
+	cfg.Hooks.PostRun = postRun
+
+	runs := 0
+	r := New(cfg, t.TempDir())
+	r.registry.Register(&mockCheck{name: checkNameWhitespace, run: func(context.Context, []string) error {
+		runs++
+		return nil
+	}})
+	return r, &runs
+}
+
+func TestRun_HooksRunInOrder(t *testing.T) {
+	r, runs := newHookRunner(t,
+		[]string{"echo first >> hooks.log", "echo second >> hooks.log"},
+		[]string{`echo "post $GO_PRE_COMMIT_HOOK_PHASE $GO_PRE_COMMIT_FAILED_CHECKS" >> hooks.log`},
+	)
+
+	var reported []HookResult
+	results, err := r.Run(context.Background(), Options{
+		Files:        []string{tempFile(t)},
+		Parallel:     1,
+		HookCallback: func(result HookResult) { reported = append(reported, result) },
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, *runs)
+	assert.Equal(t, 1, results.Passed)
+	assert.Empty(t, results.Warnings)
+
+	log, err := os.ReadFile(filepath.Join(r.repoRoot, "hooks.log"))
+	require.NoError(t, err)
+	assert.Equal(t, "first\nsecond\npost post-run 0\n", string(log))
+
+	require.Len(t, reported, 3)
+	assert.Equal(t, HookPhasePreRun, reported[0].Phase)
+	assert.Equal(t, "echo first >> hooks.log", reported[0].Command)
+	assert.Equal(t, HookPhasePostRun, reported[2].Phase)
+	for _, result := range reported {
+		assert.NoError(t, result.Err)
+	}
+}
+
+func TestRun_FailingPreRunPreventsChecks(t *testing.T) {
+	r, runs := newHookRunner(t,
+		[]string{"echo warming up; exit 3", "touch never-ran"},
+		[]string{"touch post-ran"},
+	)
+
+	var reported []HookResult
+	results, err := r.Run(context.Background(), Options{
+		Files:        []string{tempFile(t)},
+		Parallel:     1,
+		HookCallback: func(result HookResult) { reported = append(reported, result) },
+	})
+	require.ErrorIs(t, err, ErrPreRunFailed)
+	assert.Contains(t, err.Error(), "echo warming up; exit 3")
+	assert.Nil(t, results)
+	assert.Zero(t, *runs, "no check runs after a failed pre-run command")
+
+	require.Len(t, reported, 1)
+	require.Error(t, reported[0].Err)
+	assert.Equal(t, "warming up", reported[0].Output)
+
+	assert.NoFileExists(t, filepath.Join(r.repoRoot, "never-ran"))
+	assert.NoFileExists(t, filepath.Join(r.repoRoot, "post-ran"))
+}
+
+func TestRun_FailingPostRunIsAWarning(t *testing.T) {
+	r, runs := newHookRunner(t, nil, []string{"exit 1", "touch post-ran"})
+
+	results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}, Parallel: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 1, *runs)
+	assert.Equal(t, 0, results.Failed)
+	require.Len(t, results.Warnings, 1)
+	assert.Contains(t, results.Warnings[0], `post-run command "exit 1" failed`)
+	assert.FileExists(t, filepath.Join(r.repoRoot, "post-ran"), "later post-run commands still run")
+}
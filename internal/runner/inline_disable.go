@@ -0,0 +1,72 @@
+package runner
+
+import (
+	"bufio"
+	"bytes"
+	"slices"
+	"strings"
+
+	"github.com/mrz1836/go-pre-commit/internal/git"
+)
+
+const (
+	// inlineDisableDirective exempts a file from the checks named after it,
+	// e.g. "// go-pre-commit:disable whitespace,eof"
+	inlineDisableDirective = "go-pre-commit:disable"
+
+	// inlineDirectiveHeadSize is how much of the top of a file is scanned
+	// for directives
+	inlineDirectiveHeadSize = 1024
+)
+
+// applyInlineDisables removes files whose head carries a
+// "go-pre-commit:disable" directive naming checkName. It returns the files to
+// check and the files that opted out.
+func (r *Runner) applyInlineDisables(checkName string, files []string) ([]string, []string) {
+	classifier := git.NewFileClassifier(r.config)
+	kept := make([]string, 0, len(files))
+	var disabled []string
+	for _, file := range files {
+		head, err := classifier.ReadFileHead(r.resolvePath(file), inlineDirectiveHeadSize)
+		if err == nil && slices.Contains(parseInlineDisables(head), checkName) {
+			disabled = append(disabled, file)
+			continue
+		}
+		kept = append(kept, file)
+	}
+	return kept, disabled
+}
+
+// parseInlineDisables returns the check names listed by every disable
+// directive in head. Names may be separated by commas or spaces, and any
+// comment syntax may surround the directive.
+func parseInlineDisables(head []byte) []string {
+	var names []string
+	scanner := bufio.NewScanner(bytes.NewReader(head))
+	for scanner.Scan() {
+		_, rest, found := strings.Cut(scanner.Text(), inlineDisableDirective)
+		if !found {
+			continue
+		}
+		for _, field := range strings.FieldsFunc(rest, func(c rune) bool { return c == ',' || c == ' ' || c == '\t' }) {
+			if isCheckNameToken(field) {
+				names = append(names, field)
+			}
+		}
+	}
+	return names
+}
+
+// isCheckNameToken reports whether s looks like a check name, which keeps
+// closing comment markers such as "-->" or "*/" out of the parsed list
+func isCheckNameToken(s string) bool {
+	if s == "" || s[0] == '-' {
+		return false
+	}
+	for _, c := range s {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' && c != '_' {
+			return false
+		}
+	}
+	return true
+}
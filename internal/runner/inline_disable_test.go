@@ -0,0 +1,108 @@
+package runner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+func TestParseInlineDisables(t *testing.T) {
+	tests := []struct {
+		name string
+		head string
+		want []string
+	}{
+		{"go comment", "// go-pre-commit:disable whitespace\npackage main\n", []string{"whitespace"}},
+		{"comma separated", "// go-pre-commit:disable whitespace,eof\n", []string{"whitespace", "eof"}},
+		{"space separated", "# go-pre-commit:disable whitespace eof\n", []string{"whitespace", "eof"}},
+		{"html comment", "<!-- go-pre-commit:disable eof -->\n", []string{"eof"}},
+		{"block comment", "/* go-pre-commit:disable go-indent, whitespace */\n", []string{"go-indent", "whitespace"}},
+		{"several directives", "// go-pre-commit:disable eof\n// go-pre-commit:disable lint\n", []string{"eof", "lint"}},
+		{"no directive", "package main\n// whitespace\n", nil},
+		{"directive without names", "// go-pre-commit:disable\n", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseInlineDisables([]byte(tt.head)))
+		})
+	}
+}
+
+func TestRun_InlineDisableSkipsNamedCheckOnly(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "gen.txt"), []byte("// go-pre-commit:disable whitespace\ntrailing  \n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "plain.txt"), []byte("plain\n"), 0o600))
+
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Whitespace = true
+	cfg.Checks.EOF = true
+	cfg.CheckTimeouts.Whitespace = 30
+	cfg.CheckTimeouts.EOF = 30
+
+	var whitespaceFiles, eofFiles []string
+	r := New(cfg, dir)
+	r.registry.Register(&mockCheck{name: checkNameWhitespace, run: func(_ context.Context, files []string) error {
+		whitespaceFiles = files
+		return nil
+	}})
+	r.registry.Register(&mockCheck{name: checkNameEOF, run: func(_ context.Context, files []string) error {
+		eofFiles = files
+		return nil
+	}})
+
+	results, err := r.Run(context.Background(), Options{Files: []string{"gen.txt", "plain.txt"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{filepath.Join(dir, "plain.txt")}, whitespaceFiles, "the directive exempts the file from whitespace")
+	assert.Equal(t, []string{filepath.Join(dir, "gen.txt"), filepath.Join(dir, "plain.txt")}, eofFiles, "checks the directive does not name still see the file")
+
+	for _, result := range results.CheckResults {
+		if result.Name == checkNameWhitespace {
+			assert.Equal(t, []string{"gen.txt"}, result.InlineDisabled)
+		} else {
+			assert.Empty(t, result.InlineDisabled)
+		}
+	}
+}
+
+func TestRun_InlineDisableOfEveryFileSkipsCheck(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("// go-pre-commit:disable whitespace,eof\n"), 0o600))
+
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Whitespace = true
+	cfg.CheckTimeouts.Whitespace = 30
+
+	r := New(cfg, dir)
+	ran := false
+	r.registry.Register(&mockCheck{name: checkNameWhitespace, run: func(context.Context, []string) error {
+		ran = true
+		return nil
+	}})
+
+	results, err := r.Run(context.Background(), Options{Files: []string{"a.txt"}})
+	require.NoError(t, err)
+	require.Len(t, results.CheckResults, 1)
+	assert.False(t, ran)
+	assert.True(t, results.CheckResults[0].Skipped)
+	assert.Equal(t, []string{"a.txt"}, results.CheckResults[0].InlineDisabled)
+}
+
+func TestApplyInlineDisables_DirectiveBeyondHead(t *testing.T) {
+	dir := t.TempDir()
+	content := strings.Repeat("x\n", inlineDirectiveHeadSize) + "// go-pre-commit:disable whitespace\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "late.txt"), []byte(content), 0o600))
+
+	r := New(&config.Config{Enabled: true, Timeout: 60}, dir)
+	kept, disabled := r.applyInlineDisables(checkNameWhitespace, []string{"late.txt", "missing.txt"})
+	assert.Equal(t, []string{"late.txt", "missing.txt"}, kept)
+	assert.Empty(t, disabled)
+}
@@ -0,0 +1,81 @@
+package runner
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// lineCountBufferSize is the chunk size used when counting lines
+const lineCountBufferSize = 32 * 1024
+
+// applyLineLimit removes files with more lines than the limit configured for
+// the named check (GO_PRE_COMMIT_CHECK_MAX_LINES). It returns the files to
+// check and the files that were skipped for being too long.
+func (r *Runner) applyLineLimit(checkName string, files []string) ([]string, []string) {
+	limit := r.config.CheckBehaviors.MaxLines[checkName]
+	if limit <= 0 {
+		return files, nil
+	}
+
+	kept := make([]string, 0, len(files))
+	var skipped []string
+	for _, file := range files {
+		path := file
+		if !filepath.IsAbs(path) && r.repoRoot != "" {
+			path = filepath.Join(r.repoRoot, path)
+		}
+		if exceedsLineCount(path, limit) {
+			skipped = append(skipped, file)
+			continue
+		}
+		kept = append(kept, file)
+	}
+	return kept, skipped
+}
+
+// exceedsLineCount reports whether the file has more than limit lines. It
+// counts newlines in fixed-size chunks and stops as soon as the limit is
+// passed, so huge files are never read in full. Unreadable files are not
+// skipped; the check itself reports the problem.
+func exceedsLineCount(path string, limit int) bool {
+	f, err := os.Open(path) //nolint:gosec // path comes from the checked file list
+	if err != nil {
+		return false
+	}
+	defer func() { _ = f.Close() }()
+
+	buf := make([]byte, lineCountBufferSize)
+	lines := 0
+	var last byte
+	for {
+		n, readErr := f.Read(buf)
+		if n > 0 {
+			lines += bytes.Count(buf[:n], []byte{'\n'})
+			last = buf[n-1]
+			if lines > limit {
+				return true
+			}
+		}
+		if readErr != nil {
+			if !errors.Is(readErr, io.EOF) {
+				return false
+			}
+			break
+		}
+	}
+
+	// An unterminated final line still counts as a line
+	if last != 0 && last != '\n' {
+		lines++
+	}
+	return lines > limit
+}
+
+// lineLimitSkipReason describes why a check did not run on any file
+func lineLimitSkipReason(skipped, limit int) string {
+	return fmt.Sprintf("all %d file(s) exceed the %d-line limit", skipped, limit)
+}
@@ -0,0 +1,125 @@
+package runner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+// writeLines creates name in dir with the given number of lines
+func writeLines(t *testing.T, dir, name string, lines int, terminated bool) string {
+	t.Helper()
+	content := strings.Repeat("line\n", lines)
+	if !terminated && lines > 0 {
+		content = strings.TrimSuffix(content, "\n")
+	}
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestExceedsLineCount(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name       string
+		lines      int
+		terminated bool
+		want       bool
+	}{
+		{"under threshold", 99, true, false},
+		{"at threshold", 100, true, false},
+		{"just over threshold", 101, true, true},
+		{"at threshold without final newline", 100, false, false},
+		{"over threshold without final newline", 101, false, true},
+		{"empty file", 0, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeLines(t, dir, strings.ReplaceAll(tt.name, " ", "_")+".txt", tt.lines, tt.terminated)
+			assert.Equal(t, tt.want, exceedsLineCount(path, 100))
+		})
+	}
+
+	t.Run("spans several read chunks", func(t *testing.T) {
+		path := writeLines(t, dir, "big.txt", lineCountBufferSize, true)
+		assert.False(t, exceedsLineCount(path, lineCountBufferSize))
+		assert.True(t, exceedsLineCount(path, lineCountBufferSize-1))
+	})
+
+	t.Run("missing file is not skipped", func(t *testing.T) {
+		assert.False(t, exceedsLineCount(filepath.Join(dir, "missing.txt"), 1))
+	})
+}
+
+func TestRun_SkipsFilesOverLineLimit(t *testing.T) {
+	dir := t.TempDir()
+	under := writeLines(t, dir, "under.go", 10, true)
+	over := writeLines(t, dir, "over.go", 11, true)
+
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Whitespace = true
+	cfg.Checks.EOF = true
+	cfg.CheckBehaviors.MaxLines = map[string]int{checkNameWhitespace: 10}
+
+	var whitespaceFiles, eofFiles []string
+	r := New(cfg, dir)
+	r.registry.Register(&mockCheck{name: checkNameWhitespace, run: func(_ context.Context, files []string) error {
+		whitespaceFiles = files
+		return nil
+	}})
+	r.registry.Register(&mockCheck{name: checkNameEOF, run: func(_ context.Context, files []string) error {
+		eofFiles = files
+		return nil
+	}})
+
+	results, err := r.Run(context.Background(), Options{Files: []string{under, over}, FailFast: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{under}, whitespaceFiles)
+	assert.Equal(t, []string{under, over}, eofFiles, "limits apply only to the configured check")
+
+	for _, result := range results.CheckResults {
+		if result.Name == checkNameWhitespace {
+			assert.True(t, result.Success)
+			assert.False(t, result.Skipped)
+			assert.Equal(t, []string{"over.go"}, result.LineLimitSkipped, "reported relative to the root")
+		}
+	}
+}
+
+func TestRun_AllFilesOverLineLimitSkipsCheck(t *testing.T) {
+	dir := t.TempDir()
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Whitespace = true
+	cfg.CheckBehaviors.MaxLines = map[string]int{checkNameWhitespace: 5}
+
+	ran := false
+	r := New(cfg, dir)
+	r.registry.Register(&mockCheck{name: checkNameWhitespace, run: func(context.Context, []string) error {
+		ran = true
+		return nil
+	}})
+
+	// Relative paths are resolved against the repository root
+	writeLines(t, dir, "generated.json", 6, true)
+	results, err := r.Run(context.Background(), Options{Files: []string{"generated.json"}})
+	require.NoError(t, err)
+
+	assert.False(t, ran)
+	require.Len(t, results.CheckResults, 1)
+	result := results.CheckResults[0]
+	assert.True(t, result.Skipped)
+	assert.Equal(t, []string{"generated.json"}, result.LineLimitSkipped)
+	assert.Contains(t, result.Error, "5-line limit")
+	assert.Equal(t, 1, results.Skipped)
+	assert.Equal(t, 0, results.Failed)
+}
@@ -0,0 +1,157 @@
+package runner
+
+import (
+	"bufio"
+	"bytes"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// memoryPerWorker is the memory budgeted for each concurrent check. Heavy
+// checks such as golangci-lint routinely hold several hundred megabytes.
+const memoryPerWorker = 512 << 20
+
+// systemLimits is a snapshot of the resources available to a run. Zero
+// values mean the limit is unknown and does not constrain the worker count.
+type systemLimits struct {
+	cpus      int     // usable CPUs, after any cgroup CPU quota
+	load      float64 // 1-minute load average
+	available uint64  // bytes of memory available, after any cgroup memory limit
+}
+
+// loadAwareWorkers reduces requested to what the limits can sustain: no more
+// workers than idle CPUs, and no more than available memory can hold at
+// memoryPerWorker each. At least one worker always runs.
+func loadAwareWorkers(requested int, limits systemLimits) int {
+	workers := requested
+
+	if limits.cpus > 0 {
+		idle := limits.cpus - int(math.Ceil(limits.load))
+		workers = min(workers, max(idle, 1))
+	}
+	if limits.available > 0 {
+		workers = min(workers, int(min(limits.available/memoryPerWorker, math.MaxInt32)))
+	}
+
+	return max(workers, 1)
+}
+
+// currentSystemLimits returns the limits of the running system. It is a
+// variable so tests can simulate a loaded machine.
+//
+//nolint:gochecknoglobals // Injectable seam for testing load-aware scheduling
+var currentSystemLimits = func() systemLimits {
+	return readSystemLimits("/")
+}
+
+// readSystemLimits reads CPU and memory limits under root, which is "/" on
+// a real system. cgroup v2 and v1 limits are honored so containers on shared
+// CI runners see their own quota rather than the host's capacity.
+func readSystemLimits(root string) systemLimits {
+	limits := systemLimits{cpus: runtime.NumCPU()}
+	if quota := readCgroupCPUQuota(root); quota > 0 && quota < limits.cpus {
+		limits.cpus = quota
+	}
+	limits.load = readLoadAverage(root)
+	limits.available = readAvailableMemory(root)
+	return limits
+}
+
+// readCgroupCPUQuota returns the cgroup CPU quota rounded up to whole CPUs,
+// or 0 when there is no quota
+func readCgroupCPUQuota(root string) int {
+	var quota, period float64
+	if fields := strings.Fields(readSysFile(root, "sys/fs/cgroup/cpu.max")); len(fields) == 2 {
+		quota, _ = strconv.ParseFloat(fields[0], 64) // "max" leaves the quota at 0
+		period, _ = strconv.ParseFloat(fields[1], 64)
+	} else {
+		quota, _ = strconv.ParseFloat(readSysFile(root, "sys/fs/cgroup/cpu/cpu.cfs_quota_us"), 64)
+		period, _ = strconv.ParseFloat(readSysFile(root, "sys/fs/cgroup/cpu/cpu.cfs_period_us"), 64)
+	}
+	if quota <= 0 || period <= 0 {
+		return 0
+	}
+	return int(math.Ceil(quota / period))
+}
+
+// readLoadAverage returns the 1-minute load average, or 0 when unknown
+func readLoadAverage(root string) float64 {
+	fields := strings.Fields(readSysFile(root, "proc/loadavg"))
+	if len(fields) == 0 {
+		return 0
+	}
+	load, _ := strconv.ParseFloat(fields[0], 64)
+	return load
+}
+
+// readAvailableMemory returns the smaller of the host's available memory and
+// the room left under the cgroup memory limit, or 0 when neither is known
+func readAvailableMemory(root string) uint64 {
+	available := readMemAvailable(root)
+
+	limit, usage := readCgroupMemory(root)
+	if limit > 0 && usage == 0 {
+		// Without the cgroup's usage, count at least this process's memory
+		var stats runtime.MemStats
+		runtime.ReadMemStats(&stats)
+		usage = stats.Sys
+	}
+	if limit > 0 {
+		room := uint64(0)
+		if limit > usage {
+			room = limit - usage
+		}
+		if available == 0 || room < available {
+			available = max(room, 1) // a cgroup at its limit has no room, but 0 means unknown
+		}
+	}
+	return available
+}
+
+// readMemAvailable returns MemAvailable from /proc/meminfo in bytes
+func readMemAvailable(root string) uint64 {
+	scanner := bufio.NewScanner(bytes.NewBufferString(readSysFile(root, "proc/meminfo")))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "MemAvailable:" {
+			kb, err := strconv.ParseUint(fields[1], 10, 64)
+			if err != nil {
+				return 0
+			}
+			return kb * 1024
+		}
+	}
+	return 0
+}
+
+// readCgroupMemory returns the cgroup memory limit and current usage in
+// bytes. The limit is 0 when the cgroup is unlimited or absent.
+func readCgroupMemory(root string) (limit, usage uint64) {
+	if raw := readSysFile(root, "sys/fs/cgroup/memory.max"); raw != "" {
+		limit, _ = strconv.ParseUint(raw, 10, 64) // "max" leaves the limit at 0
+		usage, _ = strconv.ParseUint(readSysFile(root, "sys/fs/cgroup/memory.current"), 10, 64)
+		return limit, usage
+	}
+
+	limit, _ = strconv.ParseUint(readSysFile(root, "sys/fs/cgroup/memory/memory.limit_in_bytes"), 10, 64)
+	usage, _ = strconv.ParseUint(readSysFile(root, "sys/fs/cgroup/memory/memory.usage_in_bytes"), 10, 64)
+	if limit >= math.MaxInt64/2 {
+		// cgroup v1 reports "unlimited" as a page-aligned near-max value
+		limit = 0
+	}
+	return limit, usage
+}
+
+// readSysFile returns the trimmed content of a file under root, or "" when
+// it cannot be read
+func readSysFile(root, name string) string {
+	data, err := os.ReadFile(filepath.Join(root, name)) //nolint:gosec // fixed system paths
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
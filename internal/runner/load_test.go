@@ -0,0 +1,138 @@
+package runner
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+const gib = 1 << 30
+
+func TestLoadAwareWorkers(t *testing.T) {
+	tests := []struct {
+		name      string
+		requested int
+		limits    systemLimits
+		want      int
+	}{
+		{
+			name:      "unknown limits keep the requested count",
+			requested: 8,
+			want:      8,
+		},
+		{
+			name:      "idle machine",
+			requested: 8,
+			limits:    systemLimits{cpus: 8, load: 0.2, available: 32 * gib},
+			want:      7,
+		},
+		{
+			name:      "busy CPUs",
+			requested: 8,
+			limits:    systemLimits{cpus: 8, load: 5.5, available: 32 * gib},
+			want:      2,
+		},
+		{
+			name:      "overloaded CPUs still run one worker",
+			requested: 8,
+			limits:    systemLimits{cpus: 4, load: 12},
+			want:      1,
+		},
+		{
+			name:      "memory bound",
+			requested: 8,
+			limits:    systemLimits{cpus: 16, available: 2 * gib},
+			want:      4,
+		},
+		{
+			name:      "less memory than one worker needs",
+			requested: 4,
+			limits:    systemLimits{cpus: 4, available: 100 << 20},
+			want:      1,
+		},
+		{
+			name:      "never raised above the request",
+			requested: 2,
+			limits:    systemLimits{cpus: 64, available: 256 * gib},
+			want:      2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, loadAwareWorkers(tt.requested, tt.limits))
+		})
+	}
+}
+
+// writeSysFiles creates a fake system root with the given files
+func writeSysFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	root := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+	return root
+}
+
+func TestReadSystemLimits_CgroupV2(t *testing.T) {
+	root := writeSysFiles(t, map[string]string{
+		"sys/fs/cgroup/cpu.max":        "150000 100000\n",
+		"sys/fs/cgroup/memory.max":     "4294967296\n",
+		"sys/fs/cgroup/memory.current": "1073741824\n",
+		"proc/loadavg":                 "0.75 0.50 0.25 1/100 12345\n",
+		"proc/meminfo":                 "MemTotal:       65536000 kB\nMemAvailable:   32768000 kB\n",
+	})
+
+	limits := readSystemLimits(root)
+	assert.Equal(t, min(2, runtime.NumCPU()), limits.cpus, "1.5 CPUs of quota round up to 2")
+	assert.InDelta(t, 0.75, limits.load, 0.001)
+	assert.Equal(t, uint64(3*gib), limits.available, "the cgroup has less room than the host")
+}
+
+func TestReadSystemLimits_CgroupV1AndUnlimited(t *testing.T) {
+	root := writeSysFiles(t, map[string]string{
+		"sys/fs/cgroup/cpu/cpu.cfs_quota_us":         "-1\n",
+		"sys/fs/cgroup/cpu/cpu.cfs_period_us":        "100000\n",
+		"sys/fs/cgroup/memory/memory.limit_in_bytes": "9223372036854771712\n",
+		"sys/fs/cgroup/memory/memory.usage_in_bytes": "1048576\n",
+		"proc/meminfo": "MemAvailable:   2097152 kB\n",
+	})
+
+	limits := readSystemLimits(root)
+	assert.Equal(t, runtime.NumCPU(), limits.cpus, "no quota")
+	assert.Zero(t, limits.load, "unknown load")
+	assert.Equal(t, uint64(2*gib), limits.available, "unlimited cgroup falls back to the host")
+}
+
+func TestReadSystemLimits_Unknown(t *testing.T) {
+	limits := readSystemLimits(t.TempDir())
+	assert.Equal(t, runtime.NumCPU(), limits.cpus)
+	assert.Zero(t, limits.load)
+	assert.Zero(t, limits.available)
+}
+
+func TestResolveParallelism_LoadAware(t *testing.T) {
+	original := currentSystemLimits
+	t.Cleanup(func() { currentSystemLimits = original })
+	currentSystemLimits = func() systemLimits {
+		return systemLimits{cpus: 8, load: 6, available: 16 * gib}
+	}
+
+	cfg := &config.Config{Enabled: true}
+	cfg.Performance.ParallelWorkers = 6
+	r := New(cfg, t.TempDir())
+	assert.Equal(t, 6, r.resolveParallelism(Options{}), "load awareness is off by default")
+
+	cfg.Performance.LoadAware = true
+	assert.Equal(t, 2, r.resolveParallelism(Options{}))
+	assert.Equal(t, 5, r.resolveParallelism(Options{Parallel: 5}), "an explicit worker count is honored")
+}
@@ -0,0 +1,46 @@
+package runner
+
+import (
+	"fmt"
+)
+
+// maxFailuresSkipReason explains why a check was not run after the run
+// reached its --max-failures limit
+func maxFailuresSkipReason(limit int) string {
+	return fmt.Sprintf("not run; %d check(s) already failed (--max-failures %d)", limit, limit)
+}
+
+// maxFailuresSkipResult is the result for a check left unrun once the
+// failure limit was reached
+func maxFailuresSkipResult(name string, limit int) CheckResult {
+	return CheckResult{
+		Name:       name,
+		Success:    true,
+		Skipped:    true,
+		Error:      maxFailuresSkipReason(limit),
+		Suggestion: "Fix the failing checks, or raise --max-failures to see more of them in one run",
+	}
+}
+
+// maxFailuresReached reports whether failed checks have reached the
+// --max-failures limit; a limit of 0 never stops the run
+func maxFailuresReached(opts Options, failed int) bool {
+	return opts.MaxFailures > 0 && failed >= opts.MaxFailures
+}
+
+// isHardFailure reports whether tallyResult counts result as a failure, so
+// parallel workers can track the limit before the results are tallied
+func (r *Runner) isHardFailure(result CheckResult, opts Options) bool {
+	switch {
+	case result.Skipped, result.Success:
+		return false
+	case result.CanSkip && opts.GracefulDegradation:
+		return false
+	case result.WarnOnly || r.isWarnOnly(result.Name):
+		return false
+	case r.continuesOnError(result.Name):
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,88 @@
+package runner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+// newFailingRunner returns a runner whose whitespace, eof, build-artifacts,
+// and os-junk checks all fail, and whose empty-commit check passes
+func newFailingRunner(t *testing.T) *Runner {
+	t.Helper()
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Whitespace = true
+	cfg.Checks.EOF = true
+	cfg.Checks.BuildArtifacts = true
+	cfg.Checks.OSJunk = true
+	cfg.Checks.EmptyCommit = true
+
+	failing := func(context.Context, []string) error { return errMockCheckFailed }
+	r := New(cfg, t.TempDir())
+	for _, name := range []string{checkNameWhitespace, checkNameEOF, checkNameArtifacts, checkNameOSJunk} {
+		r.registry.Register(&mockCheck{name: name, run: failing})
+	}
+	r.registry.Register(&mockCheck{name: checkNameEmptyCommit, run: func(context.Context, []string) error { return nil }})
+	return r
+}
+
+func TestRun_MaxFailuresSkipsRemainingChecks(t *testing.T) {
+	r := newFailingRunner(t)
+
+	results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}, Parallel: 1, MaxFailures: 2})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, results.Failed, "the run stops counting at the limit")
+	assert.Equal(t, 3, results.Skipped, "two regular checks and the final empty-commit check")
+	assert.Equal(t, 0, results.Passed)
+	require.Len(t, results.CheckResults, 5)
+
+	for _, result := range results.CheckResults {
+		if !result.Skipped {
+			assert.False(t, result.Success, result.Name)
+			continue
+		}
+		assert.True(t, result.Success, result.Name)
+		assert.Equal(t, "not run; 2 check(s) already failed (--max-failures 2)", result.Error)
+		assert.NotEmpty(t, result.Suggestion)
+	}
+}
+
+func TestRun_MaxFailuresUnlimited(t *testing.T) {
+	r := newFailingRunner(t)
+
+	results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}, Parallel: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 4, results.Failed, "0 runs every check")
+	assert.Equal(t, 1, results.Passed)
+	assert.Equal(t, 0, results.Skipped)
+}
+
+func TestRun_MaxFailuresIgnoresWarnings(t *testing.T) {
+	r := newFailingRunner(t)
+	r.config.CheckBehaviors.WarnOnly = []string{checkNameWhitespace, checkNameEOF}
+
+	results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}, Parallel: 1, MaxFailures: 3})
+	require.NoError(t, err)
+	assert.Equal(t, 2, results.Warned)
+	assert.Equal(t, 2, results.Failed)
+	assert.Equal(t, 0, results.Skipped, "warnings do not count toward the limit")
+	assert.Equal(t, 1, results.Passed)
+}
+
+func TestIsHardFailure(t *testing.T) {
+	r := New(&config.Config{}, t.TempDir())
+	r.config.CheckBehaviors.WarnOnly = []string{checkNameLint}
+
+	assert.True(t, r.isHardFailure(CheckResult{Name: checkNameEOF}, Options{}))
+	assert.False(t, r.isHardFailure(CheckResult{Name: checkNameEOF, Success: true}, Options{}))
+	assert.False(t, r.isHardFailure(CheckResult{Name: checkNameEOF, Skipped: true}, Options{}))
+	assert.False(t, r.isHardFailure(CheckResult{Name: checkNameEOF, WarnOnly: true}, Options{}))
+	assert.False(t, r.isHardFailure(CheckResult{Name: checkNameLint}, Options{}))
+	assert.True(t, r.isHardFailure(CheckResult{Name: checkNameEOF, CanSkip: true}, Options{}))
+	assert.False(t, r.isHardFailure(CheckResult{Name: checkNameEOF, CanSkip: true}, Options{GracefulDegradation: true}))
+}
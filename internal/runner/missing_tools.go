@@ -0,0 +1,49 @@
+package runner
+
+import (
+	"fmt"
+
+	"github.com/mrz1836/go-pre-commit/internal/checks"
+	"github.com/mrz1836/go-pre-commit/internal/tools"
+)
+
+// toolAvailable reports whether a tool can run without being installed first.
+// It is a package variable so tests can fake missing tools.
+//
+//nolint:gochecknoglobals // Injectable seam so tests can avoid PATH lookups
+var toolAvailable = tools.IsAvailable
+
+// partitionMissingTools splits off checks whose tool is not available when
+// the run skips missing tools, returning the checks to run, skipped results
+// for the rest, and a warning for each. Without the option those checks run
+// and install the tool or fail as usual.
+func (r *Runner) partitionMissingTools(checksToRun []checks.Check, opts Options) ([]checks.Check, []CheckResult, []string) {
+	if !opts.SkipMissingTools && !r.config.Runner.SkipMissingTools {
+		return checksToRun, nil, nil
+	}
+
+	kept := make([]checks.Check, 0, len(checksToRun))
+	var skipped []CheckResult
+	var warnings []string
+	for _, check := range checksToRun {
+		tool, ok := checkTools[check.Name()]
+		if !ok || toolAvailable(tool) {
+			kept = append(kept, check)
+			continue
+		}
+
+		suggestion := "Install " + tool + " to run this check"
+		if hint := tools.InstallHint(tool); hint != "" {
+			suggestion = "Install " + tool + ": " + hint
+		}
+		skipped = append(skipped, CheckResult{
+			Name:       check.Name(),
+			Success:    true,
+			Skipped:    true,
+			Error:      fmt.Sprintf("%s is not installed; skipped with --skip-missing-tools", tool),
+			Suggestion: suggestion,
+		})
+		warnings = append(warnings, fmt.Sprintf("%s skipped: %s is not installed", check.Name(), tool))
+	}
+	return kept, skipped, warnings
+}
@@ -0,0 +1,78 @@
+package runner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+// fakeMissingTools replaces the tool lookup so the named tools are missing
+// and every other tool is available, and counts the lookups per tool
+func fakeMissingTools(t *testing.T, missing ...string) map[string]int {
+	t.Helper()
+	calls := make(map[string]int)
+	original := toolAvailable
+	toolAvailable = func(tool string) bool {
+		calls[tool]++
+		for _, name := range missing {
+			if name == tool {
+				return false
+			}
+		}
+		return true
+	}
+	t.Cleanup(func() { toolAvailable = original })
+	return calls
+}
+
+func TestRun_SkipMissingTools(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		calls := fakeMissingTools(t, "golangci-lint")
+		r, ran := newPinnedRunner(t, nil)
+
+		results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}, Parallel: 1})
+		require.NoError(t, err)
+		assert.Equal(t, 3, results.Passed)
+		assert.True(t, ran[checkNameLint], "the check installs the tool or fails on its own")
+		assert.Empty(t, calls, "no lookups without the option")
+	})
+
+	for name, setup := range map[string]func(*config.Config, *Options){
+		"flag":   func(_ *config.Config, opts *Options) { opts.SkipMissingTools = true },
+		"config": func(cfg *config.Config, _ *Options) { cfg.Runner.SkipMissingTools = true },
+	} {
+		t.Run(name, func(t *testing.T) {
+			fakeMissingTools(t, "golangci-lint", "gofumpt")
+			r, ran := newPinnedRunner(t, nil)
+			opts := Options{Files: []string{tempFile(t)}, Parallel: 1}
+			setup(r.config, &opts)
+
+			results, err := r.Run(context.Background(), opts)
+			require.NoError(t, err)
+			assert.False(t, ran[checkNameLint], "lint is skipped without golangci-lint")
+			assert.False(t, ran[checkNameFumpt], "fumpt is skipped without gofumpt")
+			assert.True(t, ran[checkNameGitleaks], "checks with their tool still run")
+			assert.Equal(t, 1, results.Passed)
+			assert.Equal(t, 2, results.Skipped)
+			assert.Equal(t, 0, results.Failed)
+			assert.ElementsMatch(t, []string{
+				"fumpt skipped: gofumpt is not installed",
+				"lint skipped: golangci-lint is not installed",
+			}, results.Warnings)
+
+			for _, result := range results.CheckResults {
+				if result.Name != checkNameLint {
+					continue
+				}
+				assert.True(t, result.Skipped)
+				assert.True(t, result.Success)
+				assert.Contains(t, result.Error, "golangci-lint is not installed")
+				assert.Contains(t, result.Suggestion, "go install github.com/golangci/golangci-lint")
+			}
+		})
+	}
+}
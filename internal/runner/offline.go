@@ -0,0 +1,34 @@
+package runner
+
+import (
+	"github.com/mrz1836/go-pre-commit/internal/checks"
+)
+
+// offlineSkipReason explains why a network-dependent check was not run
+const offlineSkipReason = "needs network access; skipped in offline mode"
+
+// partitionNetworkChecks splits off checks whose metadata marks them as
+// needing the network when the run is offline, returning the checks to run
+// and skipped results for the rest
+func (r *Runner) partitionNetworkChecks(checksToRun []checks.Check, opts Options) ([]checks.Check, []CheckResult) {
+	if !opts.Offline && !r.config.Runner.Offline {
+		return checksToRun, nil
+	}
+
+	kept := make([]checks.Check, 0, len(checksToRun))
+	var skipped []CheckResult
+	for _, check := range checksToRun {
+		if !r.registry.NeedsNetwork(check) {
+			kept = append(kept, check)
+			continue
+		}
+		skipped = append(skipped, CheckResult{
+			Name:       check.Name(),
+			Success:    true,
+			Skipped:    true,
+			Error:      offlineSkipReason,
+			Suggestion: "Run without --offline or GO_PRE_COMMIT_OFFLINE once the network is available",
+		})
+	}
+	return kept, skipped
+}
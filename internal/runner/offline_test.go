@@ -0,0 +1,84 @@
+package runner
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/checks"
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+// networkCheck is a mock check whose metadata says it needs the network
+type networkCheck struct {
+	mockCheck
+}
+
+func (c *networkCheck) Metadata() any {
+	return checks.CheckMetadata{Name: c.name, RequiresFiles: true, NeedsNetwork: true}
+}
+
+// newOfflineTestRunner returns a runner with a network check (eof) and a
+// local one (whitespace) that count how often they run
+func newOfflineTestRunner(t *testing.T, cfg *config.Config) (*Runner, *atomic.Int32, *atomic.Int32) {
+	t.Helper()
+
+	cfg.Enabled = true
+	cfg.Timeout = 60
+	cfg.Checks.Whitespace = true
+	cfg.Checks.EOF = true
+
+	var networkRuns, localRuns atomic.Int32
+	r := New(cfg, t.TempDir())
+	r.registry.Register(&networkCheck{mockCheck{name: checkNameEOF, run: func(context.Context, []string) error {
+		networkRuns.Add(1)
+		return nil
+	}}})
+	r.registry.Register(&mockCheck{name: checkNameWhitespace, run: func(context.Context, []string) error {
+		localRuns.Add(1)
+		return nil
+	}})
+	return r, &networkRuns, &localRuns
+}
+
+func TestRun_OfflineSkipsNetworkChecks(t *testing.T) {
+	t.Run("online", func(t *testing.T) {
+		r, networkRuns, localRuns := newOfflineTestRunner(t, &config.Config{})
+		results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}})
+		require.NoError(t, err)
+		assert.Equal(t, 2, results.Passed)
+		assert.Equal(t, int32(1), networkRuns.Load())
+		assert.Equal(t, int32(1), localRuns.Load())
+	})
+
+	for name, setup := range map[string]func(*config.Config, *Options){
+		"flag":   func(_ *config.Config, opts *Options) { opts.Offline = true },
+		"config": func(cfg *config.Config, _ *Options) { cfg.Runner.Offline = true },
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg := &config.Config{}
+			opts := Options{Files: []string{tempFile(t)}}
+			setup(cfg, &opts)
+			r, networkRuns, localRuns := newOfflineTestRunner(t, cfg)
+
+			results, err := r.Run(context.Background(), opts)
+			require.NoError(t, err)
+			assert.Equal(t, int32(0), networkRuns.Load(), "network checks do not run offline")
+			assert.Equal(t, int32(1), localRuns.Load(), "local checks still run")
+			assert.Equal(t, 1, results.Passed)
+			assert.Equal(t, 1, results.Skipped)
+			assert.Equal(t, 0, results.Failed)
+
+			for _, result := range results.CheckResults {
+				if result.Name != checkNameEOF {
+					continue
+				}
+				assert.True(t, result.Skipped)
+				assert.Equal(t, offlineSkipReason, result.Error)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package runner
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// normalizeFiles rewrites input paths to the form git reports them in:
+// relative to the repository root, cleaned, with forward slashes. Absolute
+// paths inside the repository are made relative, so a file passed as
+// "<root>/a.go", "./a.go", or "a.go" is the same file to exclusions, the
+// cache, and every check. Paths outside the repository are kept as given.
+func (r *Runner) normalizeFiles(files []string) []string {
+	if len(files) == 0 {
+		return files
+	}
+	normalized := make([]string, len(files))
+	for i, file := range files {
+		normalized[i] = r.normalizeFile(file)
+	}
+	return normalized
+}
+
+// normalizeFile returns file relative to the repository root, or file
+// cleaned when it lies outside the repository
+func (r *Runner) normalizeFile(file string) string {
+	cleaned := filepath.Clean(file)
+	if !filepath.IsAbs(cleaned) {
+		return filepath.ToSlash(cleaned)
+	}
+	if r.repoRoot == "" {
+		return cleaned
+	}
+	if rel, ok := relativeTo(r.repoRoot, cleaned); ok {
+		return filepath.ToSlash(rel)
+	}
+	// The root or the file may be reached through a symlink, such as
+	// /var and /private/var on macOS
+	root, rootErr := filepath.EvalSymlinks(r.repoRoot)
+	dir, dirErr := filepath.EvalSymlinks(filepath.Dir(cleaned))
+	if rootErr == nil && dirErr == nil {
+		if rel, ok := relativeTo(root, filepath.Join(dir, filepath.Base(cleaned))); ok {
+			return filepath.ToSlash(rel)
+		}
+	}
+	return cleaned
+}
+
+// relativeTo returns path relative to root when path is inside root
+func relativeTo(root, path string) (string, bool) {
+	rel, err := filepath.Rel(root, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return rel, true
+}
+
+// checkPaths returns the paths to hand a check. Checks open files relative
+// to the working directory, so when the process runs from the repository
+// root, as git hooks do, the repo-relative paths are passed unchanged;
+// otherwise they are resolved to absolute paths under the root.
+func (r *Runner) checkPaths(files []string) []string {
+	if len(files) == 0 || r.repoRoot == "" || r.inRepoRoot() {
+		return files
+	}
+	resolved := make([]string, len(files))
+	for i, file := range files {
+		resolved[i] = r.resolvePath(filepath.FromSlash(file))
+	}
+	return resolved
+}
+
+// inRepoRoot reports whether the working directory is the repository root
+func (r *Runner) inRepoRoot() bool {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return false
+	}
+	if filepath.Clean(cwd) == filepath.Clean(r.repoRoot) {
+		return true
+	}
+	cwdInfo, cwdErr := os.Stat(cwd)
+	rootInfo, rootErr := os.Stat(r.repoRoot)
+	return cwdErr == nil && rootErr == nil && os.SameFile(cwdInfo, rootInfo)
+}
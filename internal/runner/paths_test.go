@@ -0,0 +1,141 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+func TestNormalizeFiles(t *testing.T) {
+	root := t.TempDir()
+	r := New(&config.Config{}, root)
+
+	tests := []struct {
+		name string
+		file string
+		want string
+	}{
+		{name: "relative", file: "a.go", want: "a.go"},
+		{name: "dot prefix", file: "./a.go", want: "a.go"},
+		{name: "absolute inside root", file: filepath.Join(root, "a.go"), want: "a.go"},
+		{name: "absolute in subdirectory", file: filepath.Join(root, "pkg", "..", "pkg", "b.go"), want: "pkg/b.go"},
+		{name: "absolute outside root", file: filepath.Join(filepath.Dir(root), "other.go"), want: filepath.Join(filepath.Dir(root), "other.go")},
+		{name: "sibling sharing a prefix", file: root + "-other/a.go", want: root + "-other/a.go"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, r.normalizeFile(tt.file))
+		})
+	}
+
+	assert.Empty(t, r.normalizeFiles(nil))
+	assert.Equal(t, filepath.Join(root, "a.go"), New(&config.Config{}, "").normalizeFile(filepath.Join(root, "a.go")),
+		"without a root absolute paths are kept")
+}
+
+func TestNormalizeFiles_SymlinkedRoot(t *testing.T) {
+	target := t.TempDir()
+	link := filepath.Join(t.TempDir(), "repo")
+	require.NoError(t, os.Symlink(target, link))
+
+	r := New(&config.Config{}, link)
+	assert.Equal(t, "a.go", r.normalizeFile(filepath.Join(target, "a.go")), "the root reached through a symlink")
+}
+
+// newPathRunner returns a runner over a repository directory holding a.txt,
+// sub/b.txt, and vendor/c.txt, whose whitespace check reads every file it
+// is given and reports those without a trailing "ok"
+func newPathRunner(t *testing.T) (*Runner, string, *[]string) {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range map[string]string{"a.txt": "ok\n", "sub/b.txt": "bad\n", "vendor/c.txt": "bad\n"} {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Whitespace = true
+	cfg.CheckTimeouts.Whitespace = 30
+	cfg.Git.ExcludePatterns = []string{"vendor/"}
+
+	r := New(cfg, dir)
+	var checked []string
+	r.registry.Register(&mockCheck{name: checkNameWhitespace, run: func(_ context.Context, files []string) error {
+		checked = files
+		var bad []string
+		for _, file := range files {
+			data, err := os.ReadFile(file) //nolint:gosec // test file list
+			if err != nil {
+				return fmt.Errorf("reading %s: %w", file, err)
+			}
+			if !bytes.HasSuffix(data, []byte("ok\n")) {
+				bad = append(bad, file)
+			}
+		}
+		if len(bad) > 0 {
+			return &prerrors.CheckError{Err: errMockCheckFailed, Message: "bad files", Files: bad}
+		}
+		return nil
+	}})
+	return r, dir, &checked
+}
+
+func TestRun_AbsoluteAndRelativePathsBehaveAlike(t *testing.T) {
+	relative := []string{"a.txt", "./sub/b.txt", "vendor/c.txt"}
+
+	run := func(t *testing.T, absolute bool) (CheckResult, []string, string) {
+		t.Helper()
+		r, dir, checked := newPathRunner(t)
+		files := relative
+		if absolute {
+			files = make([]string, len(relative))
+			for i, file := range relative {
+				files[i] = filepath.Join(dir, file)
+			}
+		}
+		results, err := r.Run(context.Background(), Options{Files: files})
+		require.NoError(t, err)
+		assert.Equal(t, 3, results.TotalFiles)
+		require.Len(t, results.CheckResults, 1)
+		return results.CheckResults[0], *checked, dir
+	}
+
+	relResult, relChecked, relDir := run(t, false)
+	absResult, absChecked, absDir := run(t, true)
+
+	for _, tc := range []struct {
+		result  CheckResult
+		checked []string
+		dir     string
+	}{{relResult, relChecked, relDir}, {absResult, absChecked, absDir}} {
+		assert.False(t, tc.result.Success)
+		assert.Equal(t, []string{"sub/b.txt"}, tc.result.Files, "results are reported relative to the root")
+		assert.Equal(t, []string{filepath.Join(tc.dir, "a.txt"), filepath.Join(tc.dir, "sub", "b.txt")}, tc.checked,
+			"vendor/ is excluded, and checks outside the root get absolute paths they can open")
+	}
+}
+
+func TestRun_ChecksGetRepoRelativePathsFromRoot(t *testing.T) {
+	r, dir, checked := newPathRunner(t)
+	t.Chdir(dir)
+
+	for _, files := range [][]string{
+		{"a.txt", "sub/b.txt"},
+		{filepath.Join(dir, "a.txt"), filepath.Join(dir, "sub", "b.txt")},
+	} {
+		results, err := r.Run(context.Background(), Options{Files: files})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a.txt", "sub/b.txt"}, *checked, "as git reports them, whatever the input")
+		assert.Equal(t, []string{"sub/b.txt"}, results.CheckResults[0].Files)
+	}
+}
@@ -0,0 +1,68 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mrz1836/go-pre-commit/internal/checks"
+	"github.com/mrz1836/go-pre-commit/internal/tools"
+)
+
+// checkTools maps checks to the external tool they run, which
+// GO_PRE_COMMIT_TOOLS_PIN pins and --skip-missing-tools looks for
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var checkTools = map[string]string{
+	checkNameLint:       "golangci-lint",
+	checkNameFumpt:      "gofumpt",
+	checkNameGitleaks:   "gitleaks",
+	checkNameModTidy:    "go",
+	checkNameGoGenerate: "go",
+}
+
+// verifyPinnedVersion checks an installed tool against its pinned version.
+// It is a package variable so tests can fake the version output.
+//
+//nolint:gochecknoglobals // Injectable seam so tests can avoid real exec
+var verifyPinnedVersion = tools.VerifyPinnedVersion
+
+// partitionPinnedTools splits off checks whose tool is not the version pinned
+// in GO_PRE_COMMIT_TOOLS_PIN, returning the checks to run and failed results
+// for the rest. Each tool is queried once. A tool that is not installed yet is
+// left to the check, which installs the configured version.
+func (r *Runner) partitionPinnedTools(ctx context.Context, checksToRun []checks.Check) ([]checks.Check, []CheckResult) {
+	if len(r.config.ToolVersions.Pin) == 0 {
+		return checksToRun, nil
+	}
+
+	verified := make(map[string]error)
+	kept := make([]checks.Check, 0, len(checksToRun))
+	var failed []CheckResult
+	for _, check := range checksToRun {
+		tool := checkTools[check.Name()]
+		pinned, ok := r.config.ToolVersions.Pin[tool]
+		if !ok {
+			kept = append(kept, check)
+			continue
+		}
+
+		err, seen := verified[tool]
+		if !seen {
+			err = verifyPinnedVersion(ctx, tool, pinned)
+			verified[tool] = err
+		}
+		if err == nil || errors.Is(err, tools.ErrToolNotInstalled) {
+			kept = append(kept, check)
+			continue
+		}
+
+		failed = append(failed, CheckResult{
+			Name:  check.Name(),
+			Error: err.Error(),
+			Suggestion: fmt.Sprintf("Install %s %s so results match CI, or update GO_PRE_COMMIT_TOOLS_PIN",
+				tool, tools.NormalizeVersion(pinned)),
+		})
+	}
+	return kept, failed
+}
@@ -0,0 +1,119 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/checks"
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	"github.com/mrz1836/go-pre-commit/internal/tools"
+)
+
+// fakeInstalledVersions replaces the tool version lookup with installed,
+// where a missing tool is not installed, and counts the lookups per tool
+func fakeInstalledVersions(t *testing.T, installed map[string]string) map[string]int {
+	t.Helper()
+	calls := make(map[string]int)
+	original := verifyPinnedVersion
+	verifyPinnedVersion = func(_ context.Context, tool, pinned string) error {
+		calls[tool]++
+		actual, ok := installed[tool]
+		if !ok {
+			return fmt.Errorf("%w: %s", tools.ErrToolNotInstalled, tool)
+		}
+		if !tools.VersionMatches(pinned, actual) {
+			return fmt.Errorf("%w: %s is %s, pinned to %s", tools.ErrVersionMismatch, tool, actual, pinned)
+		}
+		return nil
+	}
+	t.Cleanup(func() { verifyPinnedVersion = original })
+	return calls
+}
+
+// newPinnedRunner returns a runner with lint, fumpt, gitleaks, mod-tidy, and
+// go-generate enabled as mock checks that record whether they ran
+func newPinnedRunner(t *testing.T, pins map[string]string) (*Runner, map[string]bool) {
+	t.Helper()
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Lint, cfg.CheckTimeouts.Lint = true, 30
+	cfg.Checks.Fumpt, cfg.CheckTimeouts.Fumpt = true, 30
+	cfg.Checks.Gitleaks, cfg.CheckTimeouts.Gitleaks = true, 30
+	cfg.ToolVersions.Pin = pins
+
+	ran := make(map[string]bool)
+	r := New(cfg, t.TempDir())
+	for _, name := range []string{checkNameLint, checkNameFumpt, checkNameGitleaks} {
+		r.registry.Register(&mockCheck{name: name, run: func(context.Context, []string) error {
+			ran[name] = true
+			return nil
+		}})
+	}
+	return r, ran
+}
+
+func TestRun_PinnedToolVersions(t *testing.T) {
+	calls := fakeInstalledVersions(t, map[string]string{
+		"golangci-lint": "v2.3.1",
+		"gofumpt":       "v0.8.0",
+	})
+	r, ran := newPinnedRunner(t, map[string]string{
+		"golangci-lint": "v2.4.0",
+		"gofumpt":       "v0.8",
+		"gitleaks":      "v8.29.0",
+	})
+
+	results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}, Parallel: 1})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, results.Failed)
+	assert.Equal(t, 2, results.Passed)
+	assert.False(t, ran[checkNameLint], "lint must not run with the wrong golangci-lint")
+	assert.True(t, ran[checkNameFumpt], "v0.8 accepts v0.8.0")
+	assert.True(t, ran[checkNameGitleaks], "a tool that is not installed yet is left to the check")
+
+	var lint CheckResult
+	for _, result := range results.CheckResults {
+		if result.Name == checkNameLint {
+			lint = result
+		}
+	}
+	assert.False(t, lint.Success)
+	assert.Contains(t, lint.Error, "golangci-lint is v2.3.1, pinned to v2.4.0")
+	assert.Contains(t, lint.Suggestion, "Install golangci-lint v2.4.0")
+	assert.Contains(t, lint.Suggestion, "GO_PRE_COMMIT_TOOLS_PIN")
+
+	assert.Equal(t, map[string]int{"golangci-lint": 1, "gofumpt": 1, "gitleaks": 1}, calls)
+}
+
+func TestRun_PinnedToolVersionsMatch(t *testing.T) {
+	calls := fakeInstalledVersions(t, map[string]string{"golangci-lint": "v2.4.0"})
+	r, ran := newPinnedRunner(t, map[string]string{"golangci-lint": "2.4.0"})
+
+	results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}, Parallel: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 0, results.Failed)
+	assert.Len(t, ran, 3)
+	assert.Equal(t, map[string]int{"golangci-lint": 1}, calls, "only pinned tools are queried")
+}
+
+func TestPartitionPinnedTools_SharedTool(t *testing.T) {
+	calls := fakeInstalledVersions(t, map[string]string{"go": "v1.24.5"})
+	cfg := &config.Config{}
+	cfg.ToolVersions.Pin = map[string]string{"go": "go1.25"}
+	r := &Runner{config: cfg}
+
+	kept, failed := r.partitionPinnedTools(context.Background(), []checks.Check{
+		&mockCheck{name: checkNameModTidy},
+		&mockCheck{name: checkNameGoGenerate},
+		&mockCheck{name: checkNameEOF},
+	})
+	require.Len(t, kept, 1)
+	assert.Equal(t, checkNameEOF, kept[0].Name())
+	require.Len(t, failed, 2)
+	assert.Contains(t, failed[1].Suggestion, "Install go v1.25")
+	assert.Equal(t, 1, calls["go"], "mod-tidy and go-generate share one lookup")
+}
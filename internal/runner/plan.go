@@ -0,0 +1,81 @@
+package runner
+
+import (
+	"context"
+)
+
+// PlannedCheck is one check in a run plan: whether it would run, on how many
+// files, and what it needs
+type PlannedCheck struct {
+	Name         string   `json:"name"`
+	Category     string   `json:"category,omitempty"`
+	Runs         bool     `json:"runs"`
+	SkipReason   string   `json:"skip_reason,omitempty"`
+	Files        int      `json:"files"`
+	NeedsNetwork bool     `json:"needs_network"`
+	NeedsTools   bool     `json:"needs_tools"`
+	Tools        []string `json:"tools,omitempty"`
+	EstimatedMS  int64    `json:"estimated_duration_ms"`
+	TimeoutMS    int64    `json:"timeout_ms"`
+}
+
+// Plan is the resolved check plan for a set of options, for CI to decide
+// which jobs to start without running anything
+type Plan struct {
+	TotalFiles  int            `json:"total_files"`
+	EstimatedMS int64          `json:"estimated_duration_ms"` // sum over the checks that run
+	Checks      []PlannedCheck `json:"checks"`
+}
+
+// Plan resolves which checks the options would run, the files each would
+// receive, and their metadata, applying the same selection and filtering as
+// Run without running any check
+func (r *Runner) Plan(ctx context.Context, opts Options) (*Plan, error) {
+	lists, err := r.FileLists(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{TotalFiles: len(r.dropDeletedFiles(r.dedupeFiles(r.normalizeFiles(opts.Files)))), Checks: make([]PlannedCheck, 0, len(lists))}
+	for _, list := range lists {
+		planned := PlannedCheck{
+			Name:      list.Name,
+			Runs:      true,
+			Files:     len(list.Files),
+			TimeoutMS: r.getCheckTimeout(list.Name).Milliseconds(),
+		}
+
+		requiresFiles := true
+		if metadata, ok := r.registry.GetMetadata(list.Name); ok {
+			planned.Category = metadata.Category
+			planned.NeedsNetwork = metadata.NeedsNetwork
+			planned.Tools = metadata.Dependencies
+			planned.NeedsTools = len(metadata.Dependencies) > 0
+			planned.EstimatedMS = metadata.EstimatedDuration.Milliseconds()
+			requiresFiles = metadata.RequiresFiles
+		}
+
+		switch {
+		case len(list.Files) == 0 && skippedForNoGoChanges(list):
+			planned.Runs, planned.SkipReason = false, goSkipReason
+		case len(list.Files) == 0 && requiresFiles:
+			planned.Runs, planned.SkipReason = false, noFilesSkipReason
+		}
+		if planned.Runs {
+			plan.EstimatedMS += planned.EstimatedMS
+		}
+		plan.Checks = append(plan.Checks, planned)
+	}
+	return plan, nil
+}
+
+// skippedForNoGoChanges reports whether FileLists dropped every input of a
+// Go check because the change has no Go files
+func skippedForNoGoChanges(list CheckFileList) bool {
+	for _, excluded := range list.Excluded {
+		if excluded.Reason != ReasonNoGoChanges {
+			return false
+		}
+	}
+	return len(list.Excluded) > 0
+}
@@ -0,0 +1,109 @@
+package runner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/checks"
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+// metadataCheck is a mock check that reports fixed metadata
+type metadataCheck struct {
+	mockCheck
+	metadata checks.CheckMetadata
+}
+
+func (c *metadataCheck) Metadata() any { return c.metadata }
+
+// newPlanRunner returns a runner in a repository holding main.go and
+// notes.txt, with whitespace (text files only) and fumpt enabled and lint
+// registered but disabled
+func newPlanRunner(t *testing.T) *Runner {
+	t.Helper()
+	repoRoot := t.TempDir()
+	for _, name := range []string{"main.go", "notes.txt"} {
+		require.NoError(t, os.WriteFile(filepath.Join(repoRoot, name), []byte("package main\n"), 0o600))
+	}
+
+	cfg := &config.Config{Enabled: true, Timeout: 60, MaxFileSize: 1024 * 1024}
+	cfg.Checks.Whitespace, cfg.CheckTimeouts.Whitespace = true, 10
+	cfg.Checks.Fumpt, cfg.CheckTimeouts.Fumpt = true, 45
+
+	r := New(cfg, repoRoot)
+	r.registry.Register(&textOnlyCheck{mockCheck{name: checkNameWhitespace}})
+	r.registry.Register(&metadataCheck{
+		mockCheck: mockCheck{name: checkNameFumpt},
+		metadata: checks.CheckMetadata{
+			Category:          "formatting",
+			EstimatedDuration: 3 * time.Second,
+			Dependencies:      []string{"gofumpt"},
+			FilePatterns:      []string{"*.go"},
+			NeedsNetwork:      true,
+			RequiresFiles:     true,
+		},
+	})
+	r.registry.Register(&mockCheck{name: checkNameLint})
+	return r
+}
+
+func TestPlan_EnabledChecksAndFiltering(t *testing.T) {
+	r := newPlanRunner(t)
+
+	plan, err := r.Plan(context.Background(), Options{Files: []string{"main.go", "notes.txt", "main.go"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, plan.TotalFiles, "duplicates are counted once")
+	byName := make(map[string]PlannedCheck, len(plan.Checks))
+	for _, check := range plan.Checks {
+		byName[check.Name] = check
+	}
+	assert.NotContains(t, byName, checkNameLint, "disabled checks are not planned")
+
+	require.Contains(t, byName, checkNameWhitespace)
+	whitespace := byName[checkNameWhitespace]
+	assert.True(t, whitespace.Runs)
+	assert.Equal(t, 1, whitespace.Files, "only notes.txt passes the check's filter")
+	assert.Equal(t, int64(10000), whitespace.TimeoutMS)
+
+	require.Contains(t, byName, checkNameFumpt)
+	assert.Equal(t, PlannedCheck{
+		Name:         checkNameFumpt,
+		Category:     "formatting",
+		Runs:         true,
+		Files:        2,
+		NeedsNetwork: true,
+		NeedsTools:   true,
+		Tools:        []string{"gofumpt"},
+		EstimatedMS:  3000,
+		TimeoutMS:    45000,
+	}, byName[checkNameFumpt])
+
+	assert.Equal(t, whitespace.EstimatedMS+3000, plan.EstimatedMS)
+}
+
+func TestPlan_SkippedChecks(t *testing.T) {
+	r := newPlanRunner(t)
+
+	plan, err := r.Plan(context.Background(), Options{Files: []string{"notes.txt"}, OnlyChecks: []string{checkNameFumpt}})
+	require.NoError(t, err)
+	require.Len(t, plan.Checks, 1)
+
+	fumpt := plan.Checks[0]
+	assert.False(t, fumpt.Runs)
+	assert.Equal(t, goSkipReason, fumpt.SkipReason)
+	assert.Zero(t, fumpt.Files)
+	assert.Zero(t, plan.EstimatedMS, "skipped checks add no time")
+
+	plan, err = r.Plan(context.Background(), Options{Files: []string{"main.go"}, OnlyChecks: []string{checkNameWhitespace}})
+	require.NoError(t, err)
+	require.Len(t, plan.Checks, 1)
+	assert.False(t, plan.Checks[0].Runs)
+	assert.Equal(t, noFilesSkipReason, plan.Checks[0].SkipReason)
+}
@@ -0,0 +1,60 @@
+package runner
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+func TestRun_ProfileChecks(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile []string
+		want    []string
+	}{
+		{
+			name: "no profile runs the enabled checks",
+			want: []string{checkNameWhitespace, checkNameEOF, checkNameLint},
+		},
+		{
+			name:    "fast",
+			profile: []string{checkNameWhitespace, checkNameEOF},
+			want:    []string{checkNameWhitespace, checkNameEOF},
+		},
+		{
+			name:    "full enables checks the config leaves off",
+			profile: []string{checkNameWhitespace, checkNameEOF, checkNameFumpt, checkNameLint, checkNameModTidy},
+			want:    []string{checkNameWhitespace, checkNameEOF, checkNameFumpt, checkNameLint, checkNameModTidy},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{Enabled: true, Timeout: 60}
+			cfg.Checks.Whitespace = true
+			cfg.Checks.EOF = true
+			cfg.Checks.Lint = true
+
+			r := New(cfg, t.TempDir())
+			var mu sync.Mutex
+			var ran []string
+			for _, name := range knownCheckNames() {
+				r.registry.Register(&mockCheck{name: name, run: func(context.Context, []string) error {
+					mu.Lock()
+					defer mu.Unlock()
+					ran = append(ran, name)
+					return nil
+				}})
+			}
+
+			_, err := r.Run(context.Background(), Options{Files: []string{"main.go"}, ForceAllChecks: true, ProfileChecks: tt.profile})
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.want, ran)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package runner
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// markdownFileLimit is how many file names a summary row lists before
+// collapsing the rest into a count
+const markdownFileLimit = 3
+
+// WriteMarkdownSummary renders the results as a GitHub-flavored markdown
+// table with one row per check, followed by a totals line. It is meant for
+// GitHub Actions job summaries.
+func (r *Results) WriteMarkdownSummary(w io.Writer) error {
+	var b strings.Builder
+
+	b.WriteString("### go-pre-commit\n\n")
+	b.WriteString("| Check | Status | Duration | Files |\n")
+	b.WriteString("|-------|--------|----------|-------|\n")
+	for _, result := range r.CheckResults {
+		fmt.Fprintf(&b, "| %s | %s | %s | %s |\n",
+			markdownCell(result.Name),
+			markdownStatus(result),
+			result.Duration.Round(time.Millisecond),
+			markdownFiles(result.Files))
+	}
+
+	fmt.Fprintf(&b, "\n**%d passed, %d failed, %d skipped", r.Passed, r.Failed, r.Skipped)
+	if r.Warned > 0 {
+		fmt.Fprintf(&b, ", %d warned", r.Warned)
+	}
+	fmt.Fprintf(&b, "** in %s\n\n", r.TotalDuration.Round(time.Millisecond))
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("failed to write markdown summary: %w", err)
+	}
+	return nil
+}
+
+// markdownStatus returns the status cell for a check result
+func markdownStatus(result CheckResult) string {
+	switch {
+	case result.Skipped || (result.Success && result.CanSkip):
+		return "⏭️ skipped"
+	case result.Success:
+		return "✅ passed"
+	case result.WarnOnly:
+		return "⚠️ warning"
+	case result.Fixed:
+		return "🔧 fixed, re-stage"
+	default:
+		return "❌ failed"
+	}
+}
+
+// markdownFiles lists the first few files a check ran on and counts the rest
+func markdownFiles(files []string) string {
+	if len(files) == 0 {
+		return "-"
+	}
+
+	shown := files
+	if len(shown) > markdownFileLimit {
+		shown = shown[:markdownFileLimit]
+	}
+	names := make([]string, len(shown))
+	for i, file := range shown {
+		names[i] = "`" + markdownCell(file) + "`"
+	}
+
+	cell := strings.Join(names, ", ")
+	if more := len(files) - len(shown); more > 0 {
+		cell += fmt.Sprintf(" and %d more", more)
+	}
+	return cell
+}
+
+// markdownCell keeps text from breaking out of a table cell
+func markdownCell(s string) string {
+	s = strings.Join(strings.Fields(s), " ")
+	return strings.ReplaceAll(s, "|", `\|`)
+}
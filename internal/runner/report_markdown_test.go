@@ -0,0 +1,48 @@
+package runner
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResults_WriteMarkdownSummary(t *testing.T) {
+	results := &Results{
+		CheckResults: []CheckResult{
+			{Name: checkNameWhitespace, Success: true, Duration: 5 * time.Millisecond, Files: []string{"a.go", "b.go"}},
+			{Name: checkNameLint, Error: "linting issues found", Duration: 1500 * time.Millisecond, Files: []string{"a.go", "b.go", "c.go", "d.go", "e.go"}},
+			{Name: checkNameEOF, Fixed: true, Files: []string{"docs/a|b.md"}},
+			{Name: checkNameGitleaks, Success: true, Skipped: true},
+			{Name: checkNameTestPresent, WarnOnly: true, Duration: 20 * time.Millisecond},
+		},
+		Passed:        1,
+		Failed:        2,
+		Skipped:       1,
+		Warned:        1,
+		TotalDuration: 1525 * time.Millisecond,
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, results.WriteMarkdownSummary(&buf))
+
+	lines := strings.Split(buf.String(), "\n")
+	assert.Equal(t, []string{
+		"### go-pre-commit",
+		"",
+		"| Check | Status | Duration | Files |",
+		"|-------|--------|----------|-------|",
+		"| whitespace | ✅ passed | 5ms | `a.go`, `b.go` |",
+		"| lint | ❌ failed | 1.5s | `a.go`, `b.go`, `c.go` and 2 more |",
+		"| eof | 🔧 fixed, re-stage | 0s | `docs/a\\|b.md` |",
+		"| gitleaks | ⏭️ skipped | 0s | - |",
+		"| test-presence | ⚠️ warning | 20ms | - |",
+		"",
+		"**1 passed, 2 failed, 1 skipped, 1 warned** in 1.525s",
+		"",
+		"",
+	}, lines)
+}
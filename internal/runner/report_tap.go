@@ -0,0 +1,68 @@
+package runner
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteTAP renders the results in Test Anything Protocol (version 13) format.
+// Each check becomes one test point; failures carry a YAML diagnostic block,
+// gracefully skipped checks use the "# SKIP" directive and warn-only failures
+// use "# TODO" so they do not fail the stream.
+func (r *Results) WriteTAP(w io.Writer) error {
+	var b strings.Builder
+
+	b.WriteString("TAP version 13\n")
+	fmt.Fprintf(&b, "1..%d\n", len(r.CheckResults))
+
+	for i, result := range r.CheckResults {
+		num := i + 1
+		switch {
+		case result.Skipped || (result.Success && result.CanSkip):
+			fmt.Fprintf(&b, "ok %d - %s # SKIP %s\n", num, result.Name, tapLine(result.Error))
+		case result.Success:
+			fmt.Fprintf(&b, "ok %d - %s\n", num, result.Name)
+		case result.WarnOnly:
+			// TODO points are expected failures and do not fail the TAP stream
+			fmt.Fprintf(&b, "not ok %d - %s # TODO warn-only\n", num, result.Name)
+			writeTAPDiagnostic(&b, result)
+		case result.ContinueOnError:
+			fmt.Fprintf(&b, "not ok %d - %s # TODO continue-on-error\n", num, result.Name)
+			writeTAPDiagnostic(&b, result)
+		default:
+			fmt.Fprintf(&b, "not ok %d - %s\n", num, result.Name)
+			writeTAPDiagnostic(&b, result)
+		}
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("failed to write TAP output: %w", err)
+	}
+	return nil
+}
+
+// writeTAPDiagnostic appends the indented YAML block describing a failed check.
+func writeTAPDiagnostic(b *strings.Builder, result CheckResult) {
+	b.WriteString("  ---\n")
+	fmt.Fprintf(b, "  message: %q\n", result.Error)
+	fmt.Fprintf(b, "  duration_ms: %d\n", result.Duration.Milliseconds())
+	if result.Suggestion != "" {
+		fmt.Fprintf(b, "  suggestion: %q\n", result.Suggestion)
+	}
+	if result.Command != "" {
+		fmt.Fprintf(b, "  command: %q\n", result.Command)
+	}
+	if output := strings.TrimRight(result.Output, "\n"); output != "" {
+		b.WriteString("  output: |\n")
+		for _, line := range strings.Split(output, "\n") {
+			fmt.Fprintf(b, "    %s\n", line)
+		}
+	}
+	b.WriteString("  ...\n")
+}
+
+// tapLine collapses text onto a single line so it cannot break the TAP stream.
+func tapLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,109 @@
+package runner
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResults_WriteTAP_MixedRun(t *testing.T) {
+	results := &Results{
+		CheckResults: []CheckResult{
+			{Name: checkNameWhitespace, Success: true, Duration: 5 * time.Millisecond},
+			{
+				Name:       checkNameLint,
+				Success:    false,
+				Error:      "linting issues found",
+				Output:     "main.go:1:1: unused variable\nmain.go:2:1: missing doc",
+				Suggestion: "Fix the linting issues",
+				Duration:   1500 * time.Millisecond,
+			},
+			{Name: checkNameGitleaks, Success: true, CanSkip: true, Error: "gitleaks not found\nin PATH"},
+		},
+		Passed:  1,
+		Failed:  1,
+		Skipped: 1,
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, results.WriteTAP(&buf))
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	require.GreaterOrEqual(t, len(lines), 5)
+	assert.Equal(t, "TAP version 13", lines[0])
+	assert.Equal(t, "1..3", lines[1])
+	assert.Equal(t, "ok 1 - whitespace", lines[2])
+	assert.Equal(t, "not ok 2 - lint", lines[3])
+	assert.Equal(t, "  ---", lines[4])
+
+	out := buf.String()
+	assert.Contains(t, out, `  message: "linting issues found"`)
+	assert.Contains(t, out, "  duration_ms: 1500\n")
+	assert.Contains(t, out, `  suggestion: "Fix the linting issues"`)
+	assert.Contains(t, out, "  output: |\n    main.go:1:1: unused variable\n    main.go:2:1: missing doc\n")
+	assert.Contains(t, out, "  ...\n")
+	assert.Equal(t, "ok 3 - gitleaks # SKIP gitleaks not found in PATH", lines[len(lines)-1])
+
+	// Every test point line must be numbered in order
+	var points int
+	for _, line := range lines {
+		if strings.HasPrefix(line, "ok ") || strings.HasPrefix(line, "not ok ") {
+			points++
+		}
+	}
+	assert.Equal(t, 3, points)
+}
+
+func TestResults_WriteTAP_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, (&Results{}).WriteTAP(&buf))
+	assert.Equal(t, "TAP version 13\n1..0\n", buf.String())
+}
+
+func TestResults_WriteTAP_NotApplicableCheck(t *testing.T) {
+	results := &Results{
+		CheckResults: []CheckResult{
+			{Name: checkNameFumpt, Success: true, Skipped: true, Error: goSkipReason},
+		},
+		Skipped: 1,
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, results.WriteTAP(&buf))
+	assert.Contains(t, buf.String(), "ok 1 - fumpt # SKIP "+goSkipReason+"\n")
+}
+
+func TestResults_WriteTAP_ContinueOnErrorFailure(t *testing.T) {
+	results := &Results{
+		CheckResults: []CheckResult{
+			{Name: checkNameGitleaks, Success: false, ContinueOnError: true, Error: "secrets found"},
+		},
+		Failed:    1,
+		Continued: 1,
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, results.WriteTAP(&buf))
+	out := buf.String()
+	assert.Contains(t, out, "not ok 1 - gitleaks # TODO continue-on-error\n")
+	assert.Contains(t, out, `  message: "secrets found"`)
+}
+
+func TestResults_WriteTAP_WarnOnlyFailure(t *testing.T) {
+	results := &Results{
+		CheckResults: []CheckResult{
+			{Name: checkNameLint, Success: false, WarnOnly: true, Error: "linting issues found"},
+		},
+		Warned: 1,
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, results.WriteTAP(&buf))
+	out := buf.String()
+	assert.Contains(t, out, "not ok 1 - lint # TODO warn-only\n")
+	assert.Contains(t, out, `  message: "linting issues found"`)
+}
@@ -0,0 +1,46 @@
+package runner
+
+import "slices"
+
+// RequiredCheckMiss is a check listed in GO_PRE_COMMIT_REQUIRED_CHECKS that
+// did not run, with the reason
+type RequiredCheckMiss struct {
+	Name   string
+	Reason string
+}
+
+// missedRequiredChecks returns the required checks the run did not execute:
+// those left out before the run started, and those it skipped, such as for
+// having no matching files or a missing tool. A check that ran counts
+// whether it passed, failed, or was served from the result cache.
+func (r *Runner) missedRequiredChecks(opts Options, results *Results) []RequiredCheckMiss {
+	var missed []RequiredCheckMiss
+	for _, name := range r.config.RequiredChecks {
+		index := slices.IndexFunc(results.CheckResults, func(result CheckResult) bool { return result.Name == name })
+		if index < 0 {
+			missed = append(missed, RequiredCheckMiss{Name: name, Reason: r.notRunReason(name, opts)})
+			continue
+		}
+		result := results.CheckResults[index]
+		if result.Skipped || (result.CanSkip && opts.GracefulDegradation) {
+			missed = append(missed, RequiredCheckMiss{Name: name, Reason: "skipped: " + result.Error})
+		}
+	}
+	return missed
+}
+
+// notRunReason explains why the named check has no result
+func (r *Runner) notRunReason(name string, opts Options) string {
+	switch {
+	case slices.Contains(opts.SkipChecks, name):
+		return "skipped with SKIP or --skip"
+	case len(opts.ProfileChecks) > 0 && !slices.Contains(opts.ProfileChecks, name):
+		return "not in the selected profile"
+	case len(opts.ProfileChecks) == 0 && !r.isCheckEnabled(name):
+		return "not enabled"
+	case len(opts.OnlyChecks) > 0 && !slices.Contains(opts.OnlyChecks, name):
+		return "left out by --only"
+	default:
+		return "did not run"
+	}
+}
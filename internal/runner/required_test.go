@@ -0,0 +1,62 @@
+package runner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun_RequiredChecks(t *testing.T) {
+	t.Run("every required check ran", func(t *testing.T) {
+		r, _ := newPinnedRunner(t, nil)
+		r.config.RequiredChecks = []string{checkNameLint, checkNameGitleaks}
+
+		results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}, Parallel: 1})
+		require.NoError(t, err)
+		assert.Empty(t, results.RequiredMissed)
+	})
+
+	t.Run("required check skipped for a missing tool", func(t *testing.T) {
+		fakeMissingTools(t, "golangci-lint")
+		r, ran := newPinnedRunner(t, nil)
+		r.config.RequiredChecks = []string{checkNameLint, checkNameGitleaks}
+
+		results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}, Parallel: 1, SkipMissingTools: true})
+		require.NoError(t, err)
+		assert.False(t, ran[checkNameLint])
+		assert.Zero(t, results.Failed, "the skip alone fails no check")
+		assert.Equal(t, []RequiredCheckMiss{
+			{Name: checkNameLint, Reason: "skipped: golangci-lint is not installed; skipped with --skip-missing-tools"},
+		}, results.RequiredMissed)
+	})
+
+	t.Run("required checks left out of the run", func(t *testing.T) {
+		r, _ := newPinnedRunner(t, nil)
+		r.config.Checks.Gitleaks = false
+		r.config.RequiredChecks = []string{checkNameGitleaks, checkNameLint, checkNameFumpt}
+
+		results, err := r.Run(context.Background(), Options{
+			Files:      []string{tempFile(t)},
+			Parallel:   1,
+			SkipChecks: []string{checkNameLint},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []RequiredCheckMiss{
+			{Name: checkNameGitleaks, Reason: "not enabled"},
+			{Name: checkNameLint, Reason: "skipped with SKIP or --skip"},
+		}, results.RequiredMissed)
+
+		results, err = r.Run(context.Background(), Options{
+			Files:      []string{tempFile(t)},
+			Parallel:   1,
+			OnlyChecks: []string{checkNameLint},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []RequiredCheckMiss{
+			{Name: checkNameGitleaks, Reason: "not enabled"},
+			{Name: checkNameFumpt, Reason: "left out by --only"},
+		}, results.RequiredMissed)
+	})
+}
@@ -0,0 +1,72 @@
+package runner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/checks"
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+// fileOptionalCheck is a mock check whose metadata says it runs without files
+type fileOptionalCheck struct {
+	mockCheck
+}
+
+func (c *fileOptionalCheck) Metadata() any {
+	return checks.CheckMetadata{Name: c.name, RequiresFiles: false}
+}
+
+func (c *fileOptionalCheck) FilterFiles([]string) []string { return nil }
+
+func TestRun_EmptyFilteredFiles(t *testing.T) {
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Whitespace = true
+	cfg.Checks.ModTidy = true
+	cfg.CheckTimeouts.Whitespace = 30
+	cfg.CheckTimeouts.ModTidy = 30
+
+	r := New(cfg, t.TempDir())
+	requiredRan := false
+	r.registry.Register(&textOnlyCheck{mockCheck{name: checkNameWhitespace, run: func(context.Context, []string) error {
+		requiredRan = true
+		return nil
+	}}})
+	optionalRan := false
+	var optionalFiles []string
+	r.registry.Register(&fileOptionalCheck{mockCheck{name: checkNameModTidy, run: func(_ context.Context, files []string) error {
+		optionalRan = true
+		optionalFiles = files
+		return nil
+	}}})
+
+	results, err := r.Run(context.Background(), Options{Files: []string{"main.go"}, ForceAllChecks: true})
+	require.NoError(t, err)
+
+	byName := make(map[string]CheckResult)
+	for _, result := range results.CheckResults {
+		byName[result.Name] = result
+	}
+
+	t.Run("check requiring files is skipped", func(t *testing.T) {
+		assert.False(t, requiredRan)
+		result := byName[checkNameWhitespace]
+		assert.True(t, result.Skipped)
+		assert.True(t, result.Success)
+		assert.Equal(t, noFilesSkipReason, result.Error)
+	})
+
+	t.Run("check not requiring files still runs", func(t *testing.T) {
+		assert.True(t, optionalRan)
+		assert.Empty(t, optionalFiles)
+		result := byName[checkNameModTidy]
+		assert.False(t, result.Skipped)
+		assert.True(t, result.Success)
+	})
+
+	assert.Equal(t, 1, results.Skipped)
+	assert.Equal(t, 1, results.Passed)
+}
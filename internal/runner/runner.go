@@ -5,8 +5,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
+	"path"
+	"path/filepath"
 	"runtime"
+	"runtime/debug"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -14,30 +19,83 @@ import (
 	"github.com/mrz1836/go-pre-commit/internal/checks"
 	"github.com/mrz1836/go-pre-commit/internal/config"
 	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/git"
+	"github.com/mrz1836/go-pre-commit/internal/output"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
 	"github.com/mrz1836/go-pre-commit/internal/tools"
 )
 
 // Check name constants
 const (
-	checkNameFumpt      = "fumpt"
-	checkNameGitleaks   = "gitleaks"
-	checkNameLint       = "lint"
-	checkNameModTidy    = "mod-tidy"
-	checkNameEOF        = "eof"
-	checkNameWhitespace = "whitespace"
-	envSkip             = "SKIP"
+	checkNameFumpt         = "fumpt"
+	checkNameGitleaks      = "gitleaks"
+	checkNameLint          = "lint"
+	checkNameModTidy       = "mod-tidy"
+	checkNameEOF           = "eof"
+	checkNameWhitespace    = "whitespace"
+	checkNameBuildTags     = "build-tags"
+	checkNameGoIndent      = "go-indent"
+	checkNameEmptyCommit   = "empty-commit"
+	checkNameGoVersion     = "go-version"
+	checkNameErrCompare    = "error-compare"
+	checkNameTestPresent   = "test-presence"
+	checkNameGoGenerate    = "go-generate"
+	checkNameLargeDiffs    = "large-diffs"
+	checkNameToolchain     = "toolchain"
+	checkNameModulePath    = "module-path"
+	checkNameArtifacts     = "build-artifacts"
+	checkNameOSJunk        = "os-junk"
+	checkNameCtxFirst      = "context-first"
+	checkNameForbidden     = "forbidden-imports"
+	checkNameStructTags    = "struct-tags"
+	checkNameDocComments   = "doc-comments"
+	checkNameReceiverNames = "receiver-names"
+	checkNameFilePerms     = "file-permissions"
+	checkNameEmbeddedBlobs = "embedded-blobs"
+	checkNameDataFormat    = "data-format"
+	checkNameGosec         = "gosec"
+	checkNameShellcheck    = "shellcheck"
+	checkNameHadolint      = "hadolint"
+	checkNameGoDirective   = "go-directive"
+	checkNameStubFuncs     = "stub-funcs"
+	checkNameCommitSize    = "commit-size"
+	checkNameTestPackage   = "test-package"
+	checkNameModPair       = "mod-pair"
+	checkNameErrorWrap     = "error-wrap"
+	checkNameInternalImp   = "internal-import"
+	checkNameFuncLength    = "func-length"
+	checkNameEnvAccess     = "env-access"
+	checkNameTestOnlyDep   = "test-only-deps"
+	checkNameGlobalVars    = "global-vars"
+	checkNameHeaderOrder   = "file-header-order"
+	checkNameCtxBackground = "context-background"
+	checkNameMajorVersion  = "major-version-conflict"
+	checkNameFilenameCase  = "filename-case"
+	checkNameBuildConstr   = "build-constraints"
+	checkNameRepoFiles     = "repo-files"
+	checkNameErrVarNaming  = "error-var-naming"
+	envSkip                = "SKIP"
 )
 
+// noFilesSkipReason explains why a check that requires files did not run
+const noFilesSkipReason = "no matching files to check"
+
 // ErrCheckPanicked indicates a check's Run method panicked. The runner recovers
 // from it so one faulty check or plugin degrades to a failed result instead of
 // crashing the entire pre-commit run.
 var ErrCheckPanicked = errors.New("check panicked")
 
+// ErrRunCanceled indicates the run stopped because its context was canceled,
+// for example when the user pressed Ctrl-C.
+var ErrRunCanceled = errors.New("check run canceled")
+
 // Runner executes pre-commit checks
 type Runner struct {
 	config   *config.Config
 	repoRoot string
 	registry *checks.Registry
+	cache    *resultCache
+	cleanups []func()
 }
 
 // Options configures a check run
@@ -50,6 +108,19 @@ type Options struct {
 	ProgressCallback    ProgressCallback
 	GracefulDegradation bool
 	DebugTimeout        bool
+	Interactive         bool          // prompt before auto-fixes when stdin is a terminal
+	DeclineFixes        bool          // decline every auto-fix checks ask to confirm, leaving the findings in place
+	ForceAllChecks      bool          // run Go-specific checks even when no Go files changed
+	WriteLintBaseline   bool          // record current lint issues in the baseline instead of failing
+	ProfileChecks       []string      // when set, exactly these checks are enabled instead of the configured ones
+	Offline             bool          // skip checks that need the network (also GO_PRE_COMMIT_OFFLINE)
+	MaxFailures         int           // skip checks not yet started once this many have failed (0 = unlimited)
+	SkipMissingTools    bool          // skip checks whose tool is not installed instead of failing (also GO_PRE_COMMIT_SKIP_MISSING_TOOLS)
+	HeartbeatInterval   time.Duration // report a still-running check this often (0 = never)
+	HookCallback        HookCallback
+	PlanCallback        PlanCallback
+
+	budget *commitBudget // set by Run from GO_PRE_COMMIT_MAX_COMMIT_TIME_SECONDS
 }
 
 // Results contains the results of a check run
@@ -58,8 +129,62 @@ type Results struct {
 	Passed        int
 	Failed        int
 	Skipped       int
+	Warned        int // failed checks configured as warn-only; they do not fail the run
+	Fixed         int // failed checks that fixed every finding themselves; counted in Failed too
+	Continued     int // failed checks configured to continue on error; counted in Failed but do not fail the run
 	TotalDuration time.Duration
 	TotalFiles    int
+	InputFiles    int // paths passed in
+	UniqueFiles   int // paths left after removing duplicates
+
+	// Warnings lists run-level problems that did not fail any check, such
+	// as golangci-lint formatters overlapping the standalone fumpt check
+	Warnings []string
+
+	// RequiredMissed lists the GO_PRE_COMMIT_REQUIRED_CHECKS that did not
+	// run; any entry fails the run
+	RequiredMissed []RequiredCheckMiss
+}
+
+// FixesOnly reports whether the run failed only because checks fixed files
+// that now need re-staging
+func (r *Results) FixesOnly() bool {
+	return r.Failed > 0 && r.Fixed == r.Failed
+}
+
+// NothingChecked reports whether no check ran because none had matching
+// files to check
+func (r *Results) NothingChecked() bool {
+	for _, result := range r.CheckResults {
+		if !result.Skipped || (result.Error != noFilesSkipReason && result.Error != goSkipReason) {
+			return false
+		}
+	}
+	return true
+}
+
+// Findings returns the diagnostics a failed or warn-only check reported:
+// its structured diagnostics, or else the "file:line[:col]: message" lines
+// of its output and error, as errors or, for warn-only checks, warnings.
+// Passed and skipped checks have none.
+func (r CheckResult) Findings() []output.Diagnostic {
+	if r.Success || r.Skipped {
+		return nil
+	}
+	if len(r.Diagnostics) > 0 {
+		return r.Diagnostics
+	}
+	severity := output.SeverityError
+	if r.WarnOnly {
+		severity = output.SeverityWarning
+	}
+	return output.ParseOutputLines(r.Output+"\n"+r.Error, severity)
+}
+
+// BlockingFailures returns the number of failed checks that fail the run,
+// leaving out those configured to continue on error
+func (r *Results) BlockingFailures() int {
+	return r.Failed - r.Continued
 }
 
 // CheckResult contains the result of a single check
@@ -73,12 +198,45 @@ type CheckResult struct {
 	Suggestion string
 	CanSkip    bool
 	Command    string
+	Cached     bool // true when the result was served from the blob OID result cache
+	Skipped    bool // true when the check was not run because it does not apply
+	WarnOnly   bool // true when a failure is advisory (GO_PRE_COMMIT_WARN_ONLY_CHECKS or advisory-only findings)
+	Fixed      bool // true when the check fixed every finding itself and the files only need re-staging
+
+	// ContinueOnError is true when the check failed but is listed in
+	// GO_PRE_COMMIT_CONTINUE_ON_ERROR_CHECKS, so the failure is recorded and
+	// reported as one without failing the run
+	ContinueOnError bool
+
+	// Log holds the informational output the check wrote while running,
+	// captured separately so concurrent checks never interleave
+	Log string
+
+	// Commands lists the external tool command lines the check ran
+	Commands []string
+
+	// LineLimitSkipped lists files not checked because they exceed the
+	// check's GO_PRE_COMMIT_CHECK_MAX_LINES limit
+	LineLimitSkipped []string
+
+	// InlineDisabled lists files not checked because they opt out of the
+	// check with a "go-pre-commit:disable" directive
+	InlineDisabled []string
+
+	// Diagnostics holds the findings the check reported at file positions
+	Diagnostics []output.Diagnostic
 }
 
 // ProgressCallback is called during check execution for progress updates
-// The duration parameter contains the check execution time (0 for "running" status)
+// The duration parameter contains the check execution time (0 for "running"
+// status, the time elapsed so far for "heartbeat" status)
 type ProgressCallback func(checkName, status string, duration time.Duration)
 
+// PlanCallback is called once before the checks start, with the number of
+// checks that will report a final progress status and the number of files
+// they are given
+type PlanCallback func(checks, files int)
+
 // New creates a new Runner
 func New(cfg *config.Config, repoRoot string) *Runner {
 	if cfg == nil {
@@ -89,20 +247,54 @@ func New(cfg *config.Config, repoRoot string) *Runner {
 	toolTimeout := time.Duration(cfg.ToolInstallation.Timeout) * time.Second
 	tools.SetInstallTimeout(toolTimeout)
 
-	return &Runner{
+	r := &Runner{
 		config:   cfg,
 		repoRoot: repoRoot,
 		registry: checks.NewRegistryWithConfig(cfg),
 	}
+	if cfg.Cache.Enabled {
+		r.cache = loadResultCache(resolveCachePath(repoRoot, cfg.Cache.File))
+	}
+	return r
+}
+
+// AddCleanup registers fn to run when the next Run returns, including when
+// the run is canceled. Hooks run in reverse order of registration.
+func (r *Runner) AddCleanup(fn func()) {
+	r.cleanups = append(r.cleanups, fn)
 }
 
-// Run executes checks based on the provided options
+// runCleanups runs and clears the registered cleanup hooks
+func (r *Runner) runCleanups() {
+	cleanups := r.cleanups
+	r.cleanups = nil
+	for i := len(cleanups) - 1; i >= 0; i-- {
+		cleanups[i]()
+	}
+}
+
+// Run executes checks based on the provided options. When ctx is canceled the
+// checks abort, cleanup hooks run, and the partial results are returned with
+// ErrRunCanceled.
 func (r *Runner) Run(ctx context.Context, opts Options) (*Results, error) {
 	start := time.Now()
+	defer r.runCleanups()
+
+	// Scratch workspaces checks did not remove never outlive the run
+	defer func() { _ = r.registry.SharedContext().CleanupTempWorkspaces() }()
 
 	// Process SKIP environment variables and combine with CLI skip options
 	opts.SkipChecks = r.combineSkipSources(opts.SkipChecks)
 
+	// Scripts may pass a file more than once; each file is checked once
+	inputFiles := len(opts.Files)
+	opts.Files = r.normalizeFiles(opts.Files)
+	opts.Files = r.dedupeFiles(opts.Files)
+	uniqueFiles := len(opts.Files)
+
+	// Deleted files have nothing to check
+	opts.Files = r.dropDeletedFiles(opts.Files)
+
 	// Determine which checks to run
 	checksToRun, err := r.determineChecks(opts)
 	if err != nil {
@@ -112,47 +304,172 @@ func (r *Runner) Run(ctx context.Context, opts Options) (*Results, error) {
 	// Determine parallelism
 	parallel := r.resolveParallelism(opts)
 
-	// Create context with timeout
-	globalTimeout := time.Duration(r.config.Timeout) * time.Second
+	// The overall deadline is the parent of every check's context, so
+	// reaching it cancels all of them
+	globalTimeout := r.overallTimeout()
 	ctxWithTimeout, cancel := context.WithTimeout(ctx, globalTimeout)
 	defer cancel()
 
+	// Ask before applying auto-fixes when the user can answer prompts;
+	// without a terminal the checks fix files as usual
+	switch {
+	case opts.DeclineFixes:
+		ctxWithTimeout = shared.WithFixConfirm(ctxWithTimeout, func(string, string) bool { return false })
+	case opts.Interactive && output.IsInputTTY():
+		ctxWithTimeout = shared.WithFixConfirm(ctxWithTimeout, output.NewFixPrompter(os.Stdin, os.Stderr).Confirm)
+	}
+
+	if opts.WriteLintBaseline {
+		ctxWithTimeout = shared.WithLintBaselineWrite(ctxWithTimeout)
+	}
+
 	// Debug timeout information
 	if opts.DebugTimeout {
 		r.debugTimeoutInfo(globalTimeout)
 	}
 
+	// Pre-run commands prepare the environment; if one fails nothing is checked
+	if err = r.runPreRunHooks(ctxWithTimeout, opts); err != nil {
+		return nil, err
+	}
+
 	// Run checks
 	results := &Results{
 		CheckResults: make([]CheckResult, 0, len(checksToRun)),
 		TotalFiles:   len(opts.Files),
+		InputFiles:   inputFiles,
+		UniqueFiles:  uniqueFiles,
 	}
 
-	if opts.FailFast {
-		r.runSequential(ctxWithTimeout, checksToRun, opts, results)
-	} else {
-		r.runParallel(ctxWithTimeout, checksToRun, parallel, opts, results)
+	// Under auto-selection, only checks that handle some of the files run
+	checksToRun = r.autoSelectChecks(checksToRun, opts)
+	if opts.PlanCallback != nil {
+		opts.PlanCallback(len(checksToRun), len(opts.Files))
+	}
+
+	// Skip Go-specific checks when the change contains no Go files
+	checksToRun, skippedResults := r.partitionGoChecks(ctx, checksToRun, opts)
+	for _, result := range skippedResults {
+		r.tallyResult(result, opts, results)
+	}
+
+	// Skip checks that need the network when running offline
+	checksToRun, skippedResults = r.partitionNetworkChecks(checksToRun, opts)
+	for _, result := range skippedResults {
+		r.tallyResult(result, opts, results)
+	}
+
+	// Warn about, or avoid, formatting the same code twice
+	checksToRun, skippedResults, results.Warnings = r.resolveFormatterConflicts(checksToRun)
+	for _, result := range skippedResults {
+		r.tallyResult(result, opts, results)
+	}
+
+	// Skip checks whose tool is missing instead of installing it or failing
+	checksToRun, skippedResults, warnings := r.partitionMissingTools(checksToRun, opts)
+	results.Warnings = append(results.Warnings, warnings...)
+	for _, result := range skippedResults {
+		r.tallyResult(result, opts, results)
+	}
+
+	// Refuse to run checks with a tool other than the pinned version
+	checksToRun, skippedResults = r.partitionPinnedTools(ctxWithTimeout, checksToRun)
+	for _, result := range skippedResults {
+		r.tallyResult(result, opts, results)
+	}
+
+	// Checks that inspect what the fixers left behind run after everything else
+	checksToRun, finalChecks := partitionFinalChecks(checksToRun)
+
+	// Under a commit time budget, quick checks go first and slow ones that
+	// no longer fit are skipped
+	opts.budget = r.newCommitBudget(start, checksToRun, opts)
+	checksToRun = opts.budget.fastestFirst(checksToRun)
+
+	// Formatting checks finish before the checks that read what they rewrote
+	for i, phase := range r.fixPhases(checksToRun) {
+		if i > 0 && opts.FailFast && results.BlockingFailures() > 0 {
+			break
+		}
+		if opts.FailFast {
+			r.runSequential(ctxWithTimeout, phase, opts, results)
+		} else {
+			r.runParallel(ctxWithTimeout, phase, parallel, opts, results)
+		}
+	}
+
+	if len(finalChecks) > 0 && ctx.Err() == nil && (!opts.FailFast || results.BlockingFailures() == 0) {
+		if maxFailuresReached(opts, results.BlockingFailures()) {
+			for _, check := range finalChecks {
+				r.tallyResult(maxFailuresSkipResult(check.Name(), opts.MaxFailures), opts, results)
+			}
+		} else {
+			r.runSequential(ctxWithTimeout, finalChecks, opts, results)
+		}
+	}
+
+	// Post-run commands see the outcome but cannot change it
+	if ctx.Err() == nil {
+		r.runPostRunHooks(ctx, opts, results)
+	}
+
+	// Persist cached passes; the cache is best-effort and never fails the run
+	if r.cache != nil {
+		if saveErr := r.cache.save(); saveErr != nil && opts.DebugTimeout {
+			fmt.Fprintf(os.Stderr, "🐛 [DEBUG-TIMEOUT] Failed to save result cache: %v\n", saveErr)
+		}
 	}
 
 	results.TotalDuration = time.Since(start)
+	results.RequiredMissed = r.missedRequiredChecks(opts, results)
+
+	// Only cancellation of the caller's context counts; the global timeout
+	// is reported through the individual check results
+	if ctx.Err() != nil {
+		return results, fmt.Errorf("%w: %w", ErrRunCanceled, context.Cause(ctx))
+	}
 	return results, nil
 }
 
+// partitionFinalChecks separates checks that must observe the repository
+// after every fixer has run (such as empty-commit) from the rest.
+func partitionFinalChecks(checksToRun []checks.Check) ([]checks.Check, []checks.Check) {
+	var regular, final []checks.Check
+	for _, check := range checksToRun {
+		if check.Name() == checkNameEmptyCommit {
+			final = append(final, check)
+			continue
+		}
+		regular = append(regular, check)
+	}
+	return regular, final
+}
+
 // resolveParallelism determines the worker count, preferring the explicit
-// option, then the configured value, then the host CPU count.
+// option, then the configured value, then the host CPU count. With
+// GO_PRE_COMMIT_LOAD_AWARE set, the configured or automatic count is reduced
+// while the system is under CPU or memory pressure; an explicit option is
+// always honored.
 func (r *Runner) resolveParallelism(opts Options) int {
 	if opts.Parallel > 0 {
 		return opts.Parallel
 	}
+	workers := runtime.NumCPU()
 	if r.config.Performance.ParallelWorkers > 0 {
-		return r.config.Performance.ParallelWorkers
+		workers = r.config.Performance.ParallelWorkers
 	}
-	return runtime.NumCPU()
+	if r.config.Performance.LoadAware {
+		workers = loadAwareWorkers(workers, currentSystemLimits())
+	}
+	return workers
 }
 
 // debugTimeoutInfo prints timeout diagnostics to stderr when --debug-timeout is set.
 func (r *Runner) debugTimeoutInfo(globalTimeout time.Duration) {
 	fmt.Fprintf(os.Stderr, "🐛 [DEBUG-TIMEOUT] Global timeout set to: %v\n", globalTimeout)
+	if perCheck := r.perCheckTimeout(); perCheck > 0 {
+		fmt.Fprintf(os.Stderr, "🐛 [DEBUG-TIMEOUT] Per-check timeout set to: %v\n", perCheck)
+	}
 	fmt.Fprintf(os.Stderr, "🐛 [DEBUG-TIMEOUT] Tool installation timeout: %v\n", time.Duration(r.config.ToolInstallation.Timeout)*time.Second)
 	if r.config.Environment.IsCI {
 		fmt.Fprintf(os.Stderr, "🐛 [DEBUG-TIMEOUT] CI environment detected: %s (auto-adjust: %t)\n", r.config.Environment.CIProvider, r.config.Environment.AutoAdjustTimers)
@@ -162,8 +479,11 @@ func (r *Runner) debugTimeoutInfo(globalTimeout time.Duration) {
 // runSequential executes checks one at a time, stopping at the first hard failure.
 func (r *Runner) runSequential(ctx context.Context, checksToRun []checks.Check, opts Options, results *Results) {
 	for _, check := range checksToRun {
-		r.notifyProgress(opts, check.Name(), "running", 0)
-		result := r.runCheck(ctx, check, opts.Files, opts.GracefulDegradation, opts.DebugTimeout)
+		if !opts.budget.allows(check.Name()) {
+			r.tallyResult(opts.budget.skipResult(check.Name()), opts, results)
+			continue
+		}
+		result := r.runCheckWithProgress(ctx, check, opts)
 		if r.tallyResult(result, opts, results) {
 			break // Stop on first failure
 		}
@@ -171,11 +491,17 @@ func (r *Runner) runSequential(ctx context.Context, checksToRun []checks.Check,
 }
 
 // runParallel executes checks concurrently, bounded by the given worker count.
+// Once opts.MaxFailures checks have failed, or a check no longer fits the
+// commit time budget, checks that have not started yet are skipped; checks
+// already running finish.
 func (r *Runner) runParallel(ctx context.Context, checksToRun []checks.Check, parallel int, opts Options, results *Results) {
 	resultsChan := make(chan CheckResult, len(checksToRun))
 	var wg sync.WaitGroup
 	semaphore := make(chan struct{}, parallel)
 
+	var failuresMu sync.Mutex
+	failures := results.BlockingFailures()
+
 	for _, check := range checksToRun {
 		wg.Add(1)
 		go func(c checks.Check) {
@@ -184,8 +510,25 @@ func (r *Runner) runParallel(ctx context.Context, checksToRun []checks.Check, pa
 			semaphore <- struct{}{}
 			defer func() { <-semaphore }()
 
-			r.notifyProgress(opts, c.Name(), "running", 0)
-			resultsChan <- r.runCheck(ctx, c, opts.Files, opts.GracefulDegradation, opts.DebugTimeout)
+			failuresMu.Lock()
+			limitReached := maxFailuresReached(opts, failures)
+			failuresMu.Unlock()
+			if limitReached {
+				resultsChan <- maxFailuresSkipResult(c.Name(), opts.MaxFailures)
+				return
+			}
+			if !opts.budget.allows(c.Name()) {
+				resultsChan <- opts.budget.skipResult(c.Name())
+				return
+			}
+
+			result := r.runCheckWithProgress(ctx, c, opts)
+			if r.isHardFailure(result, opts) {
+				failuresMu.Lock()
+				failures++
+				failuresMu.Unlock()
+			}
+			resultsChan <- result
 		}(check)
 	}
 
@@ -201,22 +544,53 @@ func (r *Runner) runParallel(ctx context.Context, checksToRun []checks.Check, pa
 // emits the matching progress callback. It reports whether the result counted
 // as a hard failure (used to drive fail-fast termination).
 func (r *Runner) tallyResult(result CheckResult, opts Options, results *Results) (failed bool) {
-	results.CheckResults = append(results.CheckResults, result)
 	switch {
+	case result.Skipped:
+		results.Skipped++
+		r.notifyProgress(opts, result.Name, "skipped", result.Duration)
 	case result.Success:
 		results.Passed++
 		r.notifyProgress(opts, result.Name, "passed", result.Duration)
 	case result.CanSkip && opts.GracefulDegradation:
 		results.Skipped++
 		r.notifyProgress(opts, result.Name, "skipped", result.Duration)
+	case result.WarnOnly || r.isWarnOnly(result.Name):
+		result.WarnOnly = true
+		results.Warned++
+		r.notifyProgress(opts, result.Name, "warning", result.Duration)
 	default:
 		results.Failed++
+		if result.Fixed {
+			results.Fixed++
+		}
 		r.notifyProgress(opts, result.Name, "failed", result.Duration)
+		if r.continuesOnError(result.Name) {
+			result.ContinueOnError = true
+			results.Continued++
+			break
+		}
 		failed = true
 	}
+	results.CheckResults = append(results.CheckResults, result)
 	return failed
 }
 
+// isWarnOnly reports whether failures of the named check are advisory
+func (r *Runner) isWarnOnly(name string) bool {
+	for _, warnOnly := range r.config.CheckBehaviors.WarnOnly {
+		if warnOnly == name {
+			return true
+		}
+	}
+	return false
+}
+
+// continuesOnError reports whether failures of the named check are recorded
+// without failing the run
+func (r *Runner) continuesOnError(name string) bool {
+	return slices.Contains(r.config.CheckBehaviors.ContinueOnError, name)
+}
+
 // notifyProgress invokes the progress callback when one is configured.
 func (r *Runner) notifyProgress(opts Options, name, status string, duration time.Duration) {
 	if opts.ProgressCallback != nil {
@@ -237,24 +611,86 @@ func (r *Runner) runCheck(ctx context.Context, check checks.Check, files []strin
 	// Apply configured exclude patterns, then filter files for this check
 	nonExcludedFiles := r.applyExcludePatterns(files)
 	filteredFiles := check.FilterFiles(nonExcludedFiles)
-	if len(filteredFiles) == 0 {
+	filteredFiles, inlineDisabled := r.applyInlineDisables(check.Name(), filteredFiles)
+	filteredFiles, overLimit := r.applyLineLimit(check.Name(), filteredFiles)
+	if len(filteredFiles) == 0 && (len(overLimit) > 0 || len(inlineDisabled) > 0 || r.registry.RequiresFiles(check)) {
+		result := CheckResult{
+			Name:             check.Name(),
+			Success:          true,
+			Skipped:          true,
+			Duration:         time.Since(start),
+			Files:            filteredFiles,
+			LineLimitSkipped: overLimit,
+			InlineDisabled:   inlineDisabled,
+			Error:            noFilesSkipReason,
+		}
+		if len(overLimit) > 0 {
+			result.Error = lineLimitSkipReason(len(overLimit), r.config.CheckBehaviors.MaxLines[check.Name()])
+			result.Suggestion = "Raise or remove the limit in GO_PRE_COMMIT_CHECK_MAX_LINES to check these files"
+		}
+		return result
+	}
+
+	// Serve from the result cache when the inputs of every file already passed
+	if r.lookupCache(ctx, check, filteredFiles) {
 		return CheckResult{
-			Name:     check.Name(),
-			Success:  true,
-			Duration: time.Since(start),
-			Files:    filteredFiles,
+			Name:             check.Name(),
+			Success:          true,
+			Duration:         time.Since(start),
+			Files:            filteredFiles,
+			Cached:           true,
+			LineLimitSkipped: overLimit,
+			InlineDisabled:   inlineDisabled,
+		}
+	}
+
+	// Bound the check with its own child context so a per-check timeout
+	// stops only this check
+	runCtx := ctx
+	perCheckTimeout := r.perCheckTimeout()
+	if perCheckTimeout > 0 {
+		var cancel context.CancelFunc
+		runCtx, cancel = context.WithTimeout(ctx, perCheckTimeout)
+		defer cancel()
+	}
+
+	// Resolve the configured working directory before starting the check
+	workDir, err := r.checkWorkDir(check.Name())
+	if err != nil {
+		return CheckResult{
+			Name:       check.Name(),
+			Duration:   time.Since(start),
+			Files:      filteredFiles,
+			Error:      err.Error(),
+			Suggestion: "Create the directory or fix its entry in GO_PRE_COMMIT_CHECK_WORKDIR",
 		}
 	}
 
 	// Run the check, recovering from panics so a single faulty check (or plugin)
-	// becomes a failed result rather than crashing the whole run.
-	err := r.safeCheckRun(ctx, check, filteredFiles)
+	// becomes a failed result rather than crashing the whole run. A check
+	// still waiting for a worker when the overall deadline passes is not started.
+	var log, commands checkLog
+	checkCtx := shared.WithCommandLog(shared.WithCheckOutput(runCtx, &log), &commands)
+	if workDir != "" {
+		checkCtx = shared.WithWorkDir(checkCtx, workDir)
+	}
+	err = runCtx.Err()
+	if err == nil {
+		err = r.safeCheckRun(checkCtx, check, r.checkPaths(r.scopeInputs(check, filteredFiles)))
+	}
+	if err == nil {
+		r.recordCache(ctx, check, filteredFiles)
+	}
 
 	result := CheckResult{
-		Name:     check.Name(),
-		Success:  err == nil,
-		Duration: time.Since(start),
-		Files:    filteredFiles,
+		Name:             check.Name(),
+		Success:          err == nil,
+		Duration:         time.Since(start),
+		Files:            filteredFiles,
+		Log:              log.String(),
+		Commands:         commands.Lines(),
+		LineLimitSkipped: overLimit,
+		InlineDisabled:   inlineDisabled,
 	}
 
 	if err != nil {
@@ -267,10 +703,19 @@ func (r *Runner) runCheck(ctx context.Context, check checks.Check, files []strin
 			if debugTimeout {
 				fmt.Fprintf(os.Stderr, "🐛 [DEBUG-TIMEOUT] Check '%s' failed with TimeoutError: %v\n", check.Name(), timeoutErr.Error())
 			}
-		} else if errors.Is(ctx.Err(), context.DeadlineExceeded) {
-			// General timeout - create a timeout error with context
-			timeout := time.Duration(r.config.Timeout) * time.Second
+		} else if errors.Is(runCtx.Err(), context.DeadlineExceeded) {
+			// Overall or per-check timeout - create a timeout error with context
+			timeout := perCheckTimeout
+			if ctx.Err() != nil {
+				timeout = r.overallTimeout()
+			}
 			timeoutErr := prerrors.NewCheckTimeoutError(check.Name(), timeout, result.Duration)
+			switch {
+			case ctx.Err() == nil:
+				timeoutErr.ConfigVar = "GO_PRE_COMMIT_PER_CHECK_TIMEOUT_SECONDS"
+			case r.config.Runner.OverallTimeout > 0:
+				timeoutErr.ConfigVar = "GO_PRE_COMMIT_OVERALL_TIMEOUT_SECONDS"
+			}
 			result.Error = timeoutErr.Error()
 			result.Suggestion = timeoutErr.Error()
 			if debugTimeout {
@@ -282,8 +727,18 @@ func (r *Runner) runCheck(ctx context.Context, check checks.Check, files []strin
 			if errors.As(err, &checkErr) {
 				result.Suggestion = checkErr.Suggestion
 				result.CanSkip = checkErr.CanSkip
+				result.WarnOnly = checkErr.WarnOnly
+				result.Fixed = checkErr.Fixed
 				result.Command = checkErr.Command
 				result.Output = checkErr.Output
+				// Tools and modules report in their own order; every
+				// reporter reads the diagnostics sorted by position
+				output.SortDiagnostics(checkErr.Diagnostics)
+				result.Diagnostics = checkErr.Diagnostics
+				if len(checkErr.Files) > 0 {
+					// Narrow to the files the check reported as offending
+					result.Files = r.normalizeFiles(checkErr.Files)
+				}
 
 				// If graceful degradation is enabled and this error can be skipped
 				if gracefulDegradation && checkErr.CanSkip {
@@ -296,17 +751,79 @@ func (r *Runner) runCheck(ctx context.Context, check checks.Check, files []strin
 	return result
 }
 
+// lookupCache reports whether the files already passed the check under the
+// current configuration with their current inputs. Only checks whose metadata
+// marks them cacheable are served from the cache.
+func (r *Runner) lookupCache(ctx context.Context, check checks.Check, files []string) bool {
+	// Regenerating the lint baseline must actually run lint
+	if r.cache == nil || shared.LintBaselineWriteRequested(ctx) {
+		return false
+	}
+	keys, ok := r.cacheKeys(ctx, check, files)
+	return ok && r.cache.allPassed(keys)
+}
+
+// recordCache stores a pass of a cacheable check for the files' current
+// inputs. They are hashed after the run so content rewritten by auto-fixing
+// checks is recorded.
+func (r *Runner) recordCache(ctx context.Context, check checks.Check, files []string) {
+	if r.cache == nil || shared.LintBaselineWriteRequested(ctx) {
+		return
+	}
+	if keys, ok := r.cacheKeys(ctx, check, files); ok {
+		r.cache.recordPass(keys)
+	}
+}
+
 // safeCheckRun executes check.Run, converting any panic into an error so a faulty
 // check or plugin degrades to a failed result instead of crashing the process.
+// The error carries the stack trace as its output so the failure can be diagnosed.
 func (r *Runner) safeCheckRun(ctx context.Context, check checks.Check, files []string) (err error) {
 	defer func() {
 		if rec := recover(); rec != nil {
-			err = fmt.Errorf("%w in %s: %v", ErrCheckPanicked, check.Name(), rec)
+			err = &prerrors.CheckError{
+				Err:     ErrCheckPanicked,
+				Message: fmt.Sprintf("%v in %s: %v", ErrCheckPanicked, check.Name(), rec),
+				Suggestion: fmt.Sprintf("This is a bug in the %s check; please report it with the stack trace (run with -vvv to see it in full) and use --skip %s meanwhile",
+					check.Name(), check.Name()),
+				Output: string(debug.Stack()),
+			}
 		}
 	}()
 	return check.Run(ctx, files)
 }
 
+// checkLog buffers one check's output. Writes are locked because a check may
+// write from several goroutines of its own.
+type checkLog struct {
+	mu  sync.Mutex
+	buf strings.Builder
+}
+
+// Write appends p to the log
+func (l *checkLog) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.buf.Write(p)
+}
+
+// String returns everything written so far
+func (l *checkLog) String() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.buf.String()
+}
+
+// Lines returns everything written so far split into lines, or nil when
+// nothing was written
+func (l *checkLog) Lines() []string {
+	text := strings.TrimRight(l.String(), "\n")
+	if text == "" {
+		return nil
+	}
+	return strings.Split(text, "\n")
+}
+
 // determineChecks figures out which checks to run based on options and config
 func (r *Runner) determineChecks(opts Options) ([]checks.Check, error) {
 	// Get all available checks
@@ -318,8 +835,16 @@ func (r *Runner) determineChecks(opts Options) ([]checks.Check, error) {
 	for _, check := range allChecks {
 		name := check.Name()
 
-		// Skip if disabled in config
-		if !r.isCheckEnabled(name) {
+		// Skip if disabled in config, or left out of the selected profile or
+		// the checks auto-selection may pick
+		enabled := r.isCheckEnabled(name)
+		switch {
+		case len(opts.ProfileChecks) > 0:
+			enabled = slices.Contains(opts.ProfileChecks, name)
+		case r.config.Runner.AutoSelect && len(r.config.Runner.AutoSelectChecks) > 0:
+			enabled = slices.Contains(r.config.Runner.AutoSelectChecks, name)
+		}
+		if !enabled {
 			continue
 		}
 
@@ -361,8 +886,26 @@ func (r *Runner) determineChecks(opts Options) ([]checks.Check, error) {
 	return checksToRun, nil
 }
 
+// overallTimeout returns the deadline for the whole run:
+// GO_PRE_COMMIT_OVERALL_TIMEOUT_SECONDS when set, else GO_PRE_COMMIT_TIMEOUT_SECONDS
+func (r *Runner) overallTimeout() time.Duration {
+	if r.config.Runner.OverallTimeout > 0 {
+		return time.Duration(r.config.Runner.OverallTimeout) * time.Second
+	}
+	return time.Duration(r.config.Timeout) * time.Second
+}
+
+// perCheckTimeout returns the deadline the runner applies to each check on
+// its own, or 0 when checks are bounded only by their own timeouts
+func (r *Runner) perCheckTimeout() time.Duration {
+	return time.Duration(r.config.Runner.PerCheckTimeout) * time.Second
+}
+
 // getCheckTimeout returns the timeout for a specific check
 func (r *Runner) getCheckTimeout(checkName string) time.Duration {
+	if _, external := r.config.ExternalChecks[checkName]; external {
+		return time.Duration(r.config.Plugins.Timeout) * time.Second
+	}
 	switch checkName {
 	case checkNameFumpt:
 		return time.Duration(r.config.CheckTimeouts.Fumpt) * time.Second
@@ -376,6 +919,88 @@ func (r *Runner) getCheckTimeout(checkName string) time.Duration {
 		return time.Duration(r.config.CheckTimeouts.Whitespace) * time.Second
 	case checkNameEOF:
 		return time.Duration(r.config.CheckTimeouts.EOF) * time.Second
+	case checkNameBuildTags:
+		return time.Duration(r.config.CheckTimeouts.BuildTags) * time.Second
+	case checkNameGoIndent:
+		return time.Duration(r.config.CheckTimeouts.GoIndent) * time.Second
+	case checkNameEmptyCommit:
+		return time.Duration(r.config.CheckTimeouts.EmptyCommit) * time.Second
+	case checkNameGoVersion:
+		return time.Duration(r.config.CheckTimeouts.GoVersion) * time.Second
+	case checkNameErrCompare:
+		return time.Duration(r.config.CheckTimeouts.ErrorCompare) * time.Second
+	case checkNameTestPresent:
+		return time.Duration(r.config.CheckTimeouts.TestPresence) * time.Second
+	case checkNameGoGenerate:
+		return time.Duration(r.config.CheckTimeouts.GoGenerate) * time.Second
+	case checkNameLargeDiffs:
+		return time.Duration(r.config.CheckTimeouts.LargeDiffs) * time.Second
+	case checkNameToolchain:
+		return time.Duration(r.config.CheckTimeouts.Toolchain) * time.Second
+	case checkNameModulePath:
+		return time.Duration(r.config.CheckTimeouts.ModulePath) * time.Second
+	case checkNameArtifacts:
+		return time.Duration(r.config.CheckTimeouts.BuildArtifacts) * time.Second
+	case checkNameOSJunk:
+		return time.Duration(r.config.CheckTimeouts.OSJunk) * time.Second
+	case checkNameCtxFirst:
+		return time.Duration(r.config.CheckTimeouts.ContextFirst) * time.Second
+	case checkNameForbidden:
+		return time.Duration(r.config.CheckTimeouts.ForbiddenImports) * time.Second
+	case checkNameStructTags:
+		return time.Duration(r.config.CheckTimeouts.StructTags) * time.Second
+	case checkNameDocComments:
+		return time.Duration(r.config.CheckTimeouts.DocComments) * time.Second
+	case checkNameReceiverNames:
+		return time.Duration(r.config.CheckTimeouts.ReceiverNames) * time.Second
+	case checkNameFilePerms:
+		return time.Duration(r.config.CheckTimeouts.FilePermissions) * time.Second
+	case checkNameEmbeddedBlobs:
+		return time.Duration(r.config.CheckTimeouts.EmbeddedBlobs) * time.Second
+	case checkNameDataFormat:
+		return time.Duration(r.config.CheckTimeouts.DataFormat) * time.Second
+	case checkNameGosec:
+		return time.Duration(r.config.CheckTimeouts.Gosec) * time.Second
+	case checkNameShellcheck:
+		return time.Duration(r.config.CheckTimeouts.Shellcheck) * time.Second
+	case checkNameHadolint:
+		return time.Duration(r.config.CheckTimeouts.Hadolint) * time.Second
+	case checkNameGoDirective:
+		return time.Duration(r.config.CheckTimeouts.GoDirective) * time.Second
+	case checkNameStubFuncs:
+		return time.Duration(r.config.CheckTimeouts.StubFuncs) * time.Second
+	case checkNameCommitSize:
+		return time.Duration(r.config.CheckTimeouts.CommitSize) * time.Second
+	case checkNameTestPackage:
+		return time.Duration(r.config.CheckTimeouts.TestPackage) * time.Second
+	case checkNameModPair:
+		return time.Duration(r.config.CheckTimeouts.ModPair) * time.Second
+	case checkNameErrorWrap:
+		return time.Duration(r.config.CheckTimeouts.ErrorWrap) * time.Second
+	case checkNameInternalImp:
+		return time.Duration(r.config.CheckTimeouts.InternalImport) * time.Second
+	case checkNameFuncLength:
+		return time.Duration(r.config.CheckTimeouts.FuncLength) * time.Second
+	case checkNameEnvAccess:
+		return time.Duration(r.config.CheckTimeouts.EnvAccess) * time.Second
+	case checkNameTestOnlyDep:
+		return time.Duration(r.config.CheckTimeouts.TestOnlyDeps) * time.Second
+	case checkNameGlobalVars:
+		return time.Duration(r.config.CheckTimeouts.GlobalVars) * time.Second
+	case checkNameHeaderOrder:
+		return time.Duration(r.config.CheckTimeouts.FileHeaderOrder) * time.Second
+	case checkNameCtxBackground:
+		return time.Duration(r.config.CheckTimeouts.ContextBackground) * time.Second
+	case checkNameMajorVersion:
+		return time.Duration(r.config.CheckTimeouts.MajorVersionConflict) * time.Second
+	case checkNameFilenameCase:
+		return time.Duration(r.config.CheckTimeouts.FilenameCase) * time.Second
+	case checkNameBuildConstr:
+		return time.Duration(r.config.CheckTimeouts.BuildConstraints) * time.Second
+	case checkNameRepoFiles:
+		return time.Duration(r.config.CheckTimeouts.RepoFiles) * time.Second
+	case checkNameErrVarNaming:
+		return time.Duration(r.config.CheckTimeouts.ErrorVarNaming) * time.Second
 	default:
 		return time.Duration(r.config.Timeout) * time.Second
 	}
@@ -396,34 +1021,154 @@ func (r *Runner) isCheckEnabled(name string) bool {
 		return r.config.Checks.ModTidy
 	case checkNameWhitespace:
 		return r.config.Checks.Whitespace
+	case checkNameBuildTags:
+		return r.config.Checks.BuildTags
+	case checkNameGoIndent:
+		return r.config.Checks.GoIndent
+	case checkNameEmptyCommit:
+		return r.config.Checks.EmptyCommit
+	case checkNameGoVersion:
+		return r.config.Checks.GoVersion
+	case checkNameErrCompare:
+		return r.config.Checks.ErrorCompare
+	case checkNameTestPresent:
+		return r.config.Checks.TestPresence
+	case checkNameGoGenerate:
+		return r.config.Checks.GoGenerate
+	case checkNameLargeDiffs:
+		return r.config.Checks.LargeDiffs
+	case checkNameToolchain:
+		return r.config.Checks.Toolchain
+	case checkNameModulePath:
+		return r.config.Checks.ModulePath
+	case checkNameArtifacts:
+		return r.config.Checks.BuildArtifacts
+	case checkNameOSJunk:
+		return r.config.Checks.OSJunk
+	case checkNameCtxFirst:
+		return r.config.Checks.ContextFirst
+	case checkNameForbidden:
+		return r.config.Checks.ForbiddenImports
+	case checkNameStructTags:
+		return r.config.Checks.StructTags
+	case checkNameDocComments:
+		return r.config.Checks.DocComments
+	case checkNameReceiverNames:
+		return r.config.Checks.ReceiverNames
+	case checkNameFilePerms:
+		return r.config.Checks.FilePermissions
+	case checkNameEmbeddedBlobs:
+		return r.config.Checks.EmbeddedBlobs
+	case checkNameDataFormat:
+		return r.config.Checks.DataFormat
+	case checkNameGosec:
+		return r.config.Checks.Gosec
+	case checkNameShellcheck:
+		return r.config.Checks.Shellcheck
+	case checkNameHadolint:
+		return r.config.Checks.Hadolint
+	case checkNameGoDirective:
+		return r.config.Checks.GoDirective
+	case checkNameStubFuncs:
+		return r.config.Checks.StubFuncs
+	case checkNameCommitSize:
+		return r.config.Checks.CommitSize
+	case checkNameTestPackage:
+		return r.config.Checks.TestPackage
+	case checkNameModPair:
+		return r.config.Checks.ModPair
+	case checkNameErrorWrap:
+		return r.config.Checks.ErrorWrap
+	case checkNameInternalImp:
+		return r.config.Checks.InternalImport
+	case checkNameFuncLength:
+		return r.config.Checks.FuncLength
+	case checkNameEnvAccess:
+		return r.config.Checks.EnvAccess
+	case checkNameTestOnlyDep:
+		return r.config.Checks.TestOnlyDeps
+	case checkNameGlobalVars:
+		return r.config.Checks.GlobalVars
+	case checkNameHeaderOrder:
+		return r.config.Checks.FileHeaderOrder
+	case checkNameCtxBackground:
+		return r.config.Checks.ContextBackground
+	case checkNameMajorVersion:
+		return r.config.Checks.MajorVersionConflict
+	case checkNameFilenameCase:
+		return r.config.Checks.FilenameCase
+	case checkNameBuildConstr:
+		return r.config.Checks.BuildConstraints
+	case checkNameRepoFiles:
+		return r.config.Checks.RepoFiles
+	case checkNameErrVarNaming:
+		return r.config.Checks.ErrorVarNaming
 	default:
-		return false
+		// External checks are enabled by being configured
+		_, external := r.config.ExternalChecks[name]
+		return external
 	}
 }
 
-// applyExcludePatterns filters out files matching configured exclude patterns
+// applyExcludePatterns filters out files under excluded directories or
+// matching configured exclude patterns, and hidden files when
+// GO_PRE_COMMIT_SKIP_DOTFILES is set
 func (r *Runner) applyExcludePatterns(files []string) []string {
-	patterns := r.config.Git.ExcludePatterns
-	if len(patterns) == 0 {
+	if len(r.config.Git.ExcludePatterns) == 0 && len(r.config.Git.ExcludeDirs) == 0 && !r.config.Git.SkipDotfiles {
 		return files
 	}
 
 	filtered := make([]string, 0, len(files))
 	for _, file := range files {
-		excluded := false
-		for _, pattern := range patterns {
-			if matchesExcludePattern(file, pattern) {
-				excluded = true
-				break
-			}
-		}
-		if !excluded {
+		if r.excludeReason(file) == "" {
 			filtered = append(filtered, file)
 		}
 	}
 	return filtered
 }
 
+// excludeReason returns why applyExcludePatterns drops file, or an empty
+// string when the file is kept. Excluded directories come first: nothing,
+// not even a dotfile include, brings their files back.
+func (r *Runner) excludeReason(file string) string {
+	if r.inExcludedDir(file) {
+		return git.ReasonExcludedDir
+	}
+	if git.NewFileClassifier(r.config).IsSkippedDotfile(r.repoRelative(file)) {
+		return git.ReasonDotfile
+	}
+	for _, pattern := range r.config.Git.ExcludePatterns {
+		if matchesExcludePattern(file, pattern) {
+			return git.ReasonExcludedPattern
+		}
+	}
+	return ""
+}
+
+// inExcludedDir reports whether file is inside one of the excluded
+// directories, which are relative to the repository root
+func (r *Runner) inExcludedDir(file string) bool {
+	rel := path.Clean(filepath.ToSlash(r.repoRelative(file)))
+	for _, dir := range r.config.Git.ExcludeDirs {
+		if rel == dir || strings.HasPrefix(rel, dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// repoRelative returns file relative to the repository root, so hidden
+// directories above the repository do not count as part of its path
+func (r *Runner) repoRelative(file string) string {
+	if !filepath.IsAbs(file) || r.repoRoot == "" {
+		return file
+	}
+	if rel, err := filepath.Rel(r.repoRoot, file); err == nil {
+		return rel
+	}
+	return file
+}
+
 // matchesExcludePattern checks if a file path matches an exclude pattern
 func matchesExcludePattern(filePath, pattern string) bool {
 	// Empty pattern matches nothing
@@ -490,21 +1235,14 @@ func (r *Runner) parseSkipValue(value string) []string {
 
 	// Handle special values
 	if strings.ToLower(value) == "all" {
-		return []string{checkNameFumpt, checkNameGitleaks, checkNameLint, checkNameModTidy, checkNameWhitespace, checkNameEOF}
+		return append(validCheckNames(), slices.Sorted(maps.Keys(r.config.ExternalChecks))...)
 	}
 
 	// Split by comma and clean up
 	parts := strings.Split(value, ",")
 	var skips []string
 	var hasContent bool // Track if we found any non-empty content
-	validChecks := map[string]bool{
-		checkNameFumpt:      true,
-		checkNameGitleaks:   true,
-		checkNameLint:       true,
-		checkNameModTidy:    true,
-		checkNameWhitespace: true,
-		checkNameEOF:        true,
-	}
+	validChecks := validCheckSet(r.config.ExternalChecks)
 	for _, part := range parts {
 		if cleaned := strings.TrimSpace(part); cleaned != "" {
 			hasContent = true // Found non-empty content
@@ -533,14 +1271,7 @@ func (r *Runner) deduplicateAndValidateSkips(skips []string) []string {
 	seen := make(map[string]bool)
 	result := make([]string, 0, len(skips))
 
-	validChecks := map[string]bool{
-		checkNameFumpt:      true,
-		checkNameGitleaks:   true,
-		checkNameLint:       true,
-		checkNameModTidy:    true,
-		checkNameWhitespace: true,
-		checkNameEOF:        true,
-	}
+	validChecks := validCheckSet(r.config.ExternalChecks)
 
 	for _, skip := range skips {
 		skip = strings.TrimSpace(skip)
@@ -566,3 +1297,70 @@ func (r *Runner) deduplicateAndValidateSkips(skips []string) []string {
 
 	return result
 }
+
+// validCheckNames returns every built-in check name that can be skipped
+func validCheckNames() []string {
+	return []string{
+		checkNameFumpt,
+		checkNameGitleaks,
+		checkNameLint,
+		checkNameModTidy,
+		checkNameWhitespace,
+		checkNameEOF,
+		checkNameBuildTags,
+		checkNameGoIndent,
+		checkNameEmptyCommit,
+		checkNameGoVersion,
+		checkNameErrCompare,
+		checkNameTestPresent,
+		checkNameGoGenerate,
+		checkNameLargeDiffs,
+		checkNameToolchain,
+		checkNameModulePath,
+		checkNameArtifacts,
+		checkNameOSJunk,
+		checkNameCtxFirst,
+		checkNameForbidden,
+		checkNameStructTags,
+		checkNameDocComments,
+		checkNameReceiverNames,
+		checkNameFilePerms,
+		checkNameEmbeddedBlobs,
+		checkNameDataFormat,
+		checkNameGosec,
+		checkNameShellcheck,
+		checkNameHadolint,
+		checkNameGoDirective,
+		checkNameStubFuncs,
+		checkNameCommitSize,
+		checkNameTestPackage,
+		checkNameModPair,
+		checkNameErrorWrap,
+		checkNameInternalImp,
+		checkNameFuncLength,
+		checkNameEnvAccess,
+		checkNameTestOnlyDep,
+		checkNameGlobalVars,
+		checkNameHeaderOrder,
+		checkNameCtxBackground,
+		checkNameMajorVersion,
+		checkNameFilenameCase,
+		checkNameBuildConstr,
+		checkNameRepoFiles,
+		checkNameErrVarNaming,
+	}
+}
+
+// validCheckSet returns validCheckNames and the configured external checks
+// as a lookup set
+func validCheckSet(external map[string]string) map[string]bool {
+	names := validCheckNames()
+	set := make(map[string]bool, len(names)+len(external))
+	for _, name := range names {
+		set[name] = true
+	}
+	for name := range external {
+		set[name] = true
+	}
+	return set
+}
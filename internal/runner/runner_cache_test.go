@@ -0,0 +1,338 @@
+package runner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/checks"
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+func newCachedRunner(t *testing.T, root string, runs *int64) *Runner {
+	t.Helper()
+	return newScopedCachedRunner(t, root, runs, checks.CheckMetadata{Cacheable: true})
+}
+
+// newScopedCachedRunner returns a runner with the result cache enabled and
+// lint registered as a passing check with metadata, counting its runs
+func newScopedCachedRunner(t *testing.T, root string, runs *int64, metadata checks.CheckMetadata) *Runner {
+	t.Helper()
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Lint = true
+	cfg.Cache.Enabled = true
+	cfg.Cache.File = "cache.json"
+
+	r := New(cfg, root)
+	r.registry.Register(&metadataCheck{
+		mockCheck: mockCheck{name: checkNameLint, run: func(context.Context, []string) error {
+			atomic.AddInt64(runs, 1)
+			return nil
+		}},
+		metadata: metadata,
+	})
+	return r
+}
+
+// writeCacheFiles writes each file, relative to root, with its content
+func writeCacheFiles(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+}
+
+// assertCachedRun runs r on files and checks whether the result was served
+// from the cache
+func assertCachedRun(t *testing.T, r *Runner, files []string, cached bool) {
+	t.Helper()
+	results, err := r.Run(context.Background(), Options{Files: files})
+	require.NoError(t, err)
+	require.Len(t, results.CheckResults, 1)
+	assert.Equal(t, cached, results.CheckResults[0].Cached)
+}
+
+func TestResultCache_ModifiedFileIsRechecked(t *testing.T) {
+	root := t.TempDir()
+	untouched := filepath.Join(root, "untouched.go")
+	modified := filepath.Join(root, "modified.go")
+	require.NoError(t, os.WriteFile(untouched, []byte("package a\n"), 0o600))
+	require.NoError(t, os.WriteFile(modified, []byte("package b\n"), 0o600))
+
+	var runs int64
+	ctx := context.Background()
+
+	// First run populates the cache for both files
+	results, err := newCachedRunner(t, root, &runs).Run(ctx, Options{Files: []string{untouched, modified}})
+	require.NoError(t, err)
+	assert.False(t, results.CheckResults[0].Cached)
+	assert.Equal(t, int64(1), atomic.LoadInt64(&runs))
+
+	oidsBefore, err := hashObjects(ctx, root, []string{untouched, modified})
+	require.NoError(t, err)
+
+	// Modifying a file changes its OID
+	require.NoError(t, os.WriteFile(modified, []byte("package b\n\nvar x = 1\n"), 0o600))
+	oidsAfter, err := hashObjects(ctx, root, []string{untouched, modified})
+	require.NoError(t, err)
+	assert.Equal(t, oidsBefore[0], oidsAfter[0])
+	assert.NotEqual(t, oidsBefore[1], oidsAfter[1])
+
+	// The untouched file alone is served from cache
+	results, err = newCachedRunner(t, root, &runs).Run(ctx, Options{Files: []string{untouched}})
+	require.NoError(t, err)
+	assert.True(t, results.CheckResults[0].Cached)
+	assert.Equal(t, int64(1), atomic.LoadInt64(&runs))
+
+	// The modified file forces a re-check
+	results, err = newCachedRunner(t, root, &runs).Run(ctx, Options{Files: []string{modified}})
+	require.NoError(t, err)
+	assert.False(t, results.CheckResults[0].Cached)
+	assert.Equal(t, int64(2), atomic.LoadInt64(&runs))
+}
+
+func TestResultCache_InvalidatedOnConfigChange(t *testing.T) {
+	root := t.TempDir()
+	file := filepath.Join(root, "main.go")
+	require.NoError(t, os.WriteFile(file, []byte("package main\n"), 0o600))
+
+	var runs int64
+	ctx := context.Background()
+
+	_, err := newCachedRunner(t, root, &runs).Run(ctx, Options{Files: []string{file}})
+	require.NoError(t, err)
+
+	// Settings of other checks and of the output leave the pass in place
+	r := newCachedRunner(t, root, &runs)
+	r.config.UI.ColorOutput = true
+	r.config.Reporting.GitHubReview = true
+	r.config.CheckBehaviors.WhitespaceAutoStage = true
+	r.config.CheckTimeouts.Fumpt = 5
+	results, err := r.Run(ctx, Options{Files: []string{file}})
+	require.NoError(t, err)
+	assert.True(t, results.CheckResults[0].Cached)
+
+	for name, change := range map[string]func(cfg *config.Config){
+		"behavior": func(cfg *config.Config) { cfg.CheckBehaviors.LintPackageThreshold = 5 },
+		"timeout":  func(cfg *config.Config) { cfg.CheckTimeouts.Lint = 5 },
+		"workdir":  func(cfg *config.Config) { cfg.CheckBehaviors.WorkDir = map[string]string{checkNameLint: "."} },
+		"pin":      func(cfg *config.Config) { cfg.ToolVersions.Pin = map[string]string{"golangci-lint": "v2.0.0"} },
+	} {
+		r = newCachedRunner(t, root, &runs)
+		change(r.config)
+		results, err = r.Run(ctx, Options{Files: []string{file}})
+		require.NoError(t, err)
+		assert.False(t, results.CheckResults[0].Cached, name)
+	}
+	assert.Equal(t, int64(5), atomic.LoadInt64(&runs))
+}
+
+func TestResultCache_InvalidatedOnToolUpgrade(t *testing.T) {
+	root := t.TempDir()
+	writeCacheFiles(t, root, map[string]string{"main.go": "package main\n"})
+
+	version := "v2.4.0"
+	original := installedToolVersion
+	installedToolVersion = func(_ context.Context, tool string) (string, error) {
+		assert.Equal(t, "golangci-lint", tool)
+		return version, nil
+	}
+	t.Cleanup(func() { installedToolVersion = original })
+
+	metadata := checks.CheckMetadata{Cacheable: true, Dependencies: []string{"lint"}}
+	var runs int64
+	assertCachedRun(t, newScopedCachedRunner(t, root, &runs, metadata), []string{"main.go"}, false)
+	assertCachedRun(t, newScopedCachedRunner(t, root, &runs, metadata), []string{"main.go"}, true)
+
+	version = "v2.5.0"
+	assertCachedRun(t, newScopedCachedRunner(t, root, &runs, metadata), []string{"main.go"}, false)
+}
+
+func TestCheckSettings(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Checks.ModTidy = true
+	cfg.CheckBehaviors.ModTidyDiff = "always"
+	cfg.CheckBehaviors.EOFSeverity = "warning"
+
+	settings := checkSettings(cfg, checkNameModTidy)
+	assert.Contains(t, settings, "Checks.ModTidy=true")
+	assert.Contains(t, settings, "CheckTimeouts.ModTidy=0")
+	assert.Contains(t, settings, "CheckBehaviors.ModTidyDiff=always")
+	assert.Contains(t, settings, "pin go=")
+	assert.NotContains(t, settings, "EOFSeverity")
+}
+
+func TestResultCache_FailuresAreNotCached(t *testing.T) {
+	root := t.TempDir()
+	file := filepath.Join(root, "main.go")
+	require.NoError(t, os.WriteFile(file, []byte("package main\n"), 0o600))
+
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Lint = true
+	cfg.Cache.Enabled = true
+	cfg.Cache.File = "cache.json"
+
+	var runs int64
+	for i := 0; i < 2; i++ {
+		r := New(cfg, root)
+		r.registry.Register(&mockCheck{name: checkNameLint, run: func(context.Context, []string) error {
+			atomic.AddInt64(&runs, 1)
+			return errMockCheckFailed
+		}})
+		results, err := r.Run(context.Background(), Options{Files: []string{file}})
+		require.NoError(t, err)
+		assert.Equal(t, 1, results.Failed)
+	}
+	assert.Equal(t, int64(2), atomic.LoadInt64(&runs))
+}
+
+func TestResultCache_NonCacheableChecksAlwaysRun(t *testing.T) {
+	root := t.TempDir()
+	writeCacheFiles(t, root, map[string]string{"main.go": "package main\n"})
+
+	var runs int64
+	for i := 0; i < 2; i++ {
+		assertCachedRun(t, newScopedCachedRunner(t, root, &runs, checks.CheckMetadata{}), []string{"main.go"}, false)
+	}
+	assert.Equal(t, int64(2), atomic.LoadInt64(&runs))
+}
+
+func TestResultCache_PackageScope(t *testing.T) {
+	root := t.TempDir()
+	writeCacheFiles(t, root, map[string]string{
+		"go.mod":       "module example.com/m\n",
+		"a/a.go":       "package a\n",
+		"a/b.go":       "package a\n",
+		"other/o.go":   "package other\n",
+		"a/notes.txt":  "notes\n",
+		"a/sub/sub.go": "package sub\n",
+	})
+	metadata := checks.CheckMetadata{Cacheable: true, CacheScope: checks.CacheScopePackage}
+	var runs int64
+	run := func(cached bool) {
+		t.Helper()
+		assertCachedRun(t, newScopedCachedRunner(t, root, &runs, metadata), []string{"a/a.go"}, cached)
+	}
+
+	run(false)
+	run(true)
+
+	// Files outside the package, or not Go files, leave the pass in place
+	writeCacheFiles(t, root, map[string]string{"other/o.go": "package other\n\nvar x = 1\n", "a/notes.txt": "more\n", "a/sub/sub.go": "package sub\n\nvar y = 2\n"})
+	run(true)
+
+	// A sibling in the package invalidates it
+	writeCacheFiles(t, root, map[string]string{"a/b.go": "package a\n\nvar z = 3\n"})
+	run(false)
+	run(true)
+
+	// So does go.mod
+	writeCacheFiles(t, root, map[string]string{"go.mod": "module example.com/m\n\ngo 1.24\n"})
+	run(false)
+}
+
+func TestResultCache_ModuleScope(t *testing.T) {
+	root := t.TempDir()
+	writeCacheFiles(t, root, map[string]string{
+		"go.mod":             "module example.com/m\n",
+		"main.go":            "package main\n",
+		"pkg/p.go":           "package pkg\n",
+		"testdata/t.go":      "package testdata\n",
+		"nested/go.mod":      "module example.com/nested\n",
+		"nested/n.go":        "package nested\n",
+		".golangci.yml":      "version: \"2\"\n",
+		"unrelated/file.txt": "text\n",
+	})
+	metadata := checks.CheckMetadata{Cacheable: true, CacheScope: checks.CacheScopeModule, ConfigFiles: []string{".golangci.yml"}}
+	var runs int64
+	run := func(cached bool) {
+		t.Helper()
+		assertCachedRun(t, newScopedCachedRunner(t, root, &runs, metadata), []string{"main.go"}, cached)
+	}
+
+	run(false)
+	run(true)
+
+	// Nested modules, ignored directories, and other files leave the pass in place
+	writeCacheFiles(t, root, map[string]string{"nested/n.go": "package nested\n\nvar x = 1\n", "testdata/t.go": "broken", "unrelated/file.txt": "more\n"})
+	run(true)
+
+	// Any package of the module invalidates it
+	writeCacheFiles(t, root, map[string]string{"pkg/p.go": "package pkg\n\nvar y = 2\n"})
+	run(false)
+	run(true)
+
+	// So do go.sum and the configuration files
+	writeCacheFiles(t, root, map[string]string{"go.sum": "example.com/dep v1.0.0 h1:abc=\n"})
+	run(false)
+	run(true)
+	writeCacheFiles(t, root, map[string]string{".golangci.yml": "version: \"2\"\nlinters:\n  default: all\n"})
+	run(false)
+	run(true)
+}
+
+func TestResultCache_BuiltinCheckScopes(t *testing.T) {
+	r := New(&config.Config{Enabled: true, Timeout: 60}, t.TempDir())
+
+	scopes := map[string]string{
+		checkNameWhitespace: "", checkNameEOF: "", checkNameStructTags: "",
+		checkNameFumpt: checks.CacheScopePackage, checkNameTestPackage: checks.CacheScopePackage,
+		checkNameLint: checks.CacheScopeModule, checkNameModTidy: checks.CacheScopeModule,
+	}
+	for name, scope := range scopes {
+		metadata, ok := r.registry.GetMetadata(name)
+		require.True(t, ok, name)
+		assert.True(t, metadata.Cacheable, name)
+		assert.Equal(t, scope, metadata.CacheScope, name)
+	}
+	metadata, _ := r.registry.GetMetadata(checkNameLint)
+	assert.Contains(t, metadata.ConfigFiles, ".golangci.yml")
+
+	// Checks reading the index, the commit, or other tools' state always run
+	for _, name := range []string{checkNameGitleaks, checkNameEmptyCommit, checkNameModPair, checkNameGosec} {
+		metadata, ok := r.registry.GetMetadata(name)
+		require.True(t, ok, name)
+		assert.False(t, metadata.Cacheable, name)
+	}
+}
+
+func TestResultCache_PruneDropsLeastRecentlyUsed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.json")
+	cache := loadResultCache(path)
+	cache.Entries = map[string]int64{"old": 1, "older": 0, "recent": 3, "newest": 4}
+
+	cache.prune(2)
+	assert.Equal(t, map[string]int64{"recent": 3, "newest": 4}, cache.Entries)
+
+	cache.recordPass([]string{"fresh"})
+	require.NoError(t, cache.save())
+	assert.Len(t, loadResultCache(path).Entries, 3)
+}
+
+func TestLoadResultCache_OutdatedVersionYieldsEmptyCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"version":1,"entries":{"oid":{"lint":"fp"}}}`), 0o600))
+
+	assert.Empty(t, loadResultCache(path).Entries)
+}
+
+func TestLoadResultCache_CorruptFileYieldsEmptyCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.json")
+	require.NoError(t, os.WriteFile(path, []byte("{not json"), 0o600))
+
+	cache := loadResultCache(path)
+	require.NotNil(t, cache)
+	assert.Empty(t, cache.Entries)
+}
+
+func TestResolveCachePath(t *testing.T) {
+	assert.Equal(t, filepath.Join("/repo", ".git", "c.json"), resolveCachePath("/repo", ".git/c.json"))
+	assert.Equal(t, "/abs/c.json", resolveCachePath("/repo", "/abs/c.json"))
+}
@@ -23,6 +23,47 @@ func TestGetCheckTimeout(t *testing.T) {
 	cfg.CheckTimeouts.ModTidy = 50
 	cfg.CheckTimeouts.Whitespace = 20
 	cfg.CheckTimeouts.EOF = 15
+	cfg.CheckTimeouts.BuildTags = 25
+	cfg.CheckTimeouts.GoIndent = 35
+	cfg.CheckTimeouts.EmptyCommit = 5
+	cfg.CheckTimeouts.GoVersion = 15
+	cfg.CheckTimeouts.ErrorCompare = 40
+	cfg.CheckTimeouts.TestPresence = 12
+	cfg.CheckTimeouts.GoGenerate = 90
+	cfg.CheckTimeouts.LargeDiffs = 8
+	cfg.CheckTimeouts.Toolchain = 6
+	cfg.CheckTimeouts.ModulePath = 7
+	cfg.CheckTimeouts.BuildArtifacts = 4
+	cfg.CheckTimeouts.OSJunk = 3
+	cfg.CheckTimeouts.ContextFirst = 12
+	cfg.CheckTimeouts.ForbiddenImports = 14
+	cfg.CheckTimeouts.StructTags = 16
+	cfg.CheckTimeouts.DocComments = 17
+	cfg.CheckTimeouts.ReceiverNames = 18
+	cfg.CheckTimeouts.FilePermissions = 19
+	cfg.CheckTimeouts.EmbeddedBlobs = 20
+	cfg.CheckTimeouts.DataFormat = 21
+	cfg.CheckTimeouts.Gosec = 22
+	cfg.CheckTimeouts.Shellcheck = 23
+	cfg.CheckTimeouts.Hadolint = 24
+	cfg.CheckTimeouts.GoDirective = 25
+	cfg.CheckTimeouts.StubFuncs = 26
+	cfg.CheckTimeouts.CommitSize = 27
+	cfg.CheckTimeouts.TestPackage = 28
+	cfg.CheckTimeouts.ModPair = 29
+	cfg.CheckTimeouts.ErrorWrap = 30
+	cfg.CheckTimeouts.InternalImport = 31
+	cfg.CheckTimeouts.FuncLength = 32
+	cfg.CheckTimeouts.EnvAccess = 33
+	cfg.CheckTimeouts.TestOnlyDeps = 34
+	cfg.CheckTimeouts.GlobalVars = 35
+	cfg.CheckTimeouts.FileHeaderOrder = 36
+	cfg.CheckTimeouts.ContextBackground = 37
+	cfg.CheckTimeouts.MajorVersionConflict = 38
+	cfg.CheckTimeouts.FilenameCase = 39
+	cfg.CheckTimeouts.BuildConstraints = 40
+	cfg.CheckTimeouts.RepoFiles = 12
+	cfg.CheckTimeouts.ErrorVarNaming = 45
 
 	runner := New(cfg, "/tmp")
 
@@ -68,6 +109,252 @@ func TestGetCheckTimeout(t *testing.T) {
 			expectedTime: 15 * time.Second,
 			description:  "Should return configured eof timeout",
 		},
+		{
+			name:         "Build tags timeout",
+			checkName:    checkNameBuildTags,
+			expectedTime: 25 * time.Second,
+			description:  "Should return configured build-tags timeout",
+		},
+		{
+			name:         "Go indent timeout",
+			checkName:    checkNameGoIndent,
+			expectedTime: 35 * time.Second,
+			description:  "Should return configured go-indent timeout",
+		},
+		{
+			name:         "Empty commit timeout",
+			checkName:    checkNameEmptyCommit,
+			expectedTime: 5 * time.Second,
+			description:  "Should return configured empty-commit timeout",
+		},
+		{
+			name:         "Go version timeout",
+			checkName:    checkNameGoVersion,
+			expectedTime: 15 * time.Second,
+			description:  "Should return configured go-version timeout",
+		},
+		{
+			name:         "Error compare timeout",
+			checkName:    checkNameErrCompare,
+			expectedTime: 40 * time.Second,
+			description:  "Should return configured error-compare timeout",
+		},
+		{
+			name:         "Test presence timeout",
+			checkName:    checkNameTestPresent,
+			expectedTime: 12 * time.Second,
+			description:  "Should return configured test-presence timeout",
+		},
+		{
+			name:         "Go generate timeout",
+			checkName:    checkNameGoGenerate,
+			expectedTime: 90 * time.Second,
+			description:  "Should return configured go-generate timeout",
+		},
+		{
+			name:         "Large diffs timeout",
+			checkName:    checkNameLargeDiffs,
+			expectedTime: 8 * time.Second,
+			description:  "Should return configured large-diffs timeout",
+		},
+		{
+			name:         "Toolchain timeout",
+			checkName:    checkNameToolchain,
+			expectedTime: 6 * time.Second,
+			description:  "Should return configured toolchain timeout",
+		},
+		{
+			name:         "Module path timeout",
+			checkName:    checkNameModulePath,
+			expectedTime: 7 * time.Second,
+			description:  "Should return configured module-path timeout",
+		},
+		{
+			name:         "Build artifacts timeout",
+			checkName:    checkNameArtifacts,
+			expectedTime: 4 * time.Second,
+			description:  "Should return configured build-artifacts timeout",
+		},
+		{
+			name:         "OS junk timeout",
+			checkName:    checkNameOSJunk,
+			expectedTime: 3 * time.Second,
+			description:  "Should return configured os-junk timeout",
+		},
+		{
+			name:         "Context first timeout",
+			checkName:    checkNameCtxFirst,
+			expectedTime: 12 * time.Second,
+			description:  "Should return configured context-first timeout",
+		},
+		{
+			name:         "Forbidden imports timeout",
+			checkName:    checkNameForbidden,
+			expectedTime: 14 * time.Second,
+			description:  "Should return configured forbidden-imports timeout",
+		},
+		{
+			name:         "Struct tags timeout",
+			checkName:    checkNameStructTags,
+			expectedTime: 16 * time.Second,
+			description:  "Should return configured struct-tags timeout",
+		},
+		{
+			name:         "Doc comments timeout",
+			checkName:    checkNameDocComments,
+			expectedTime: 17 * time.Second,
+			description:  "Should return configured doc-comments timeout",
+		},
+		{
+			name:         "Receiver names timeout",
+			checkName:    checkNameReceiverNames,
+			expectedTime: 18 * time.Second,
+			description:  "Should return configured receiver-names timeout",
+		},
+		{
+			name:         "File permissions timeout",
+			checkName:    checkNameFilePerms,
+			expectedTime: 19 * time.Second,
+			description:  "Should return configured file-permissions timeout",
+		},
+		{
+			name:         "Embedded blobs timeout",
+			checkName:    checkNameEmbeddedBlobs,
+			expectedTime: 20 * time.Second,
+			description:  "Should return configured embedded-blobs timeout",
+		},
+		{
+			name:         "Data format timeout",
+			checkName:    checkNameDataFormat,
+			expectedTime: 21 * time.Second,
+			description:  "Should return configured data-format timeout",
+		},
+		{
+			name:         "Gosec timeout",
+			checkName:    checkNameGosec,
+			expectedTime: 22 * time.Second,
+			description:  "Should return configured gosec timeout",
+		},
+		{
+			name:         "Shellcheck timeout",
+			checkName:    checkNameShellcheck,
+			expectedTime: 23 * time.Second,
+			description:  "Should return configured shellcheck timeout",
+		},
+		{
+			name:         "Hadolint timeout",
+			checkName:    checkNameHadolint,
+			expectedTime: 24 * time.Second,
+			description:  "Should return configured hadolint timeout",
+		},
+		{
+			name:         "Go directive timeout",
+			checkName:    checkNameGoDirective,
+			expectedTime: 25 * time.Second,
+			description:  "Should return configured go-directive timeout",
+		},
+		{
+			name:         "Stub funcs timeout",
+			checkName:    checkNameStubFuncs,
+			expectedTime: 26 * time.Second,
+			description:  "Should return configured stub-funcs timeout",
+		},
+		{
+			name:         "Commit size timeout",
+			checkName:    checkNameCommitSize,
+			expectedTime: 27 * time.Second,
+			description:  "Should return configured commit-size timeout",
+		},
+		{
+			name:         "Test package timeout",
+			checkName:    checkNameTestPackage,
+			expectedTime: 28 * time.Second,
+			description:  "Should return configured test-package timeout",
+		},
+		{
+			name:         "Mod pair timeout",
+			checkName:    checkNameModPair,
+			expectedTime: 29 * time.Second,
+			description:  "Should return configured mod-pair timeout",
+		},
+		{
+			name:         "Error wrap timeout",
+			checkName:    checkNameErrorWrap,
+			expectedTime: 30 * time.Second,
+			description:  "Should return configured error-wrap timeout",
+		},
+		{
+			name:         "Internal import timeout",
+			checkName:    checkNameInternalImp,
+			expectedTime: 31 * time.Second,
+			description:  "Should return configured internal-import timeout",
+		},
+		{
+			name:         "Function length timeout",
+			checkName:    checkNameFuncLength,
+			expectedTime: 32 * time.Second,
+			description:  "Should return configured func-length timeout",
+		},
+		{
+			name:         "Environment access timeout",
+			checkName:    checkNameEnvAccess,
+			expectedTime: 33 * time.Second,
+			description:  "Should return configured env-access timeout",
+		},
+		{
+			name:         "Test-only dependency timeout",
+			checkName:    checkNameTestOnlyDep,
+			expectedTime: 34 * time.Second,
+			description:  "Should return configured test-only-deps timeout",
+		},
+		{
+			name:         "Global variable timeout",
+			checkName:    checkNameGlobalVars,
+			expectedTime: 35 * time.Second,
+			description:  "Should return configured global-vars timeout",
+		},
+		{
+			name:         "File header order timeout",
+			checkName:    checkNameHeaderOrder,
+			expectedTime: 36 * time.Second,
+			description:  "Should return configured file-header-order timeout",
+		},
+		{
+			name:         "Context background timeout",
+			checkName:    checkNameCtxBackground,
+			expectedTime: 37 * time.Second,
+			description:  "Should return configured context-background timeout",
+		},
+		{
+			name:         "Major version conflict timeout",
+			checkName:    checkNameMajorVersion,
+			expectedTime: 38 * time.Second,
+			description:  "Should return configured major-version-conflict timeout",
+		},
+		{
+			name:         "Filename case timeout",
+			checkName:    checkNameFilenameCase,
+			expectedTime: 39 * time.Second,
+			description:  "Should return configured filename-case timeout",
+		},
+		{
+			name:         "Build constraints timeout",
+			checkName:    checkNameBuildConstr,
+			expectedTime: 40 * time.Second,
+			description:  "Should return configured build-constraints timeout",
+		},
+		{
+			name:         "Repo files timeout",
+			checkName:    checkNameRepoFiles,
+			expectedTime: 12 * time.Second,
+			description:  "Should return configured repo-files timeout",
+		},
+		{
+			name:         "Error var naming timeout",
+			checkName:    checkNameErrVarNaming,
+			expectedTime: 45 * time.Second,
+			description:  "Should return configured error-var-naming timeout",
+		},
 		{
 			name:         "Unknown check defaults to global timeout",
 			checkName:    "unknown-check",
@@ -204,23 +491,11 @@ func TestIsCheckEnabledAllEnabled(t *testing.T) {
 	cfg := &config.Config{
 		Enabled: true,
 	}
-	cfg.Checks.EOF = true
-	cfg.Checks.Fumpt = true
-	cfg.Checks.Gitleaks = true
-	cfg.Checks.Lint = true
-	cfg.Checks.ModTidy = true
-	cfg.Checks.Whitespace = true
+	enableAllChecks(cfg)
 
 	runner := New(cfg, "/tmp")
 
-	allChecks := []string{
-		checkNameEOF,
-		checkNameFumpt,
-		checkNameGitleaks,
-		checkNameLint,
-		checkNameModTidy,
-		checkNameWhitespace,
-	}
+	allChecks := knownCheckNames()
 
 	for _, checkName := range allChecks {
 		t.Run(checkName, func(t *testing.T) {
@@ -243,14 +518,7 @@ func TestIsCheckEnabledAllDisabled(t *testing.T) {
 
 	runner := New(cfg, "/tmp")
 
-	allChecks := []string{
-		checkNameEOF,
-		checkNameFumpt,
-		checkNameGitleaks,
-		checkNameLint,
-		checkNameModTidy,
-		checkNameWhitespace,
-	}
+	allChecks := knownCheckNames()
 
 	for _, checkName := range allChecks {
 		t.Run(checkName, func(t *testing.T) {
@@ -3,8 +3,11 @@ package runner
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -13,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/mrz1836/go-pre-commit/internal/config"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
 )
 
 // mockCheck is a configurable Check used for concurrency and panic tests.
@@ -40,6 +44,15 @@ func knownCheckNames() []string {
 	return []string{
 		checkNameFumpt, checkNameGitleaks,
 		checkNameLint, checkNameModTidy, checkNameEOF, checkNameWhitespace,
+		checkNameBuildTags, checkNameGoIndent, checkNameEmptyCommit,
+		checkNameGoVersion, checkNameErrCompare, checkNameTestPresent,
+		checkNameGoGenerate, checkNameLargeDiffs, checkNameToolchain,
+		checkNameModulePath, checkNameArtifacts, checkNameOSJunk, checkNameCtxFirst,
+		checkNameForbidden, checkNameStructTags, checkNameDocComments, checkNameReceiverNames, checkNameFilePerms, checkNameEmbeddedBlobs,
+		checkNameDataFormat, checkNameGosec, checkNameShellcheck, checkNameHadolint,
+		checkNameGoDirective, checkNameStubFuncs, checkNameCommitSize, checkNameTestPackage,
+		checkNameModPair, checkNameErrorWrap, checkNameInternalImp, checkNameFuncLength, checkNameEnvAccess, checkNameTestOnlyDep,
+		checkNameGlobalVars, checkNameHeaderOrder, checkNameCtxBackground, checkNameMajorVersion, checkNameFilenameCase, checkNameBuildConstr, checkNameRepoFiles, checkNameErrVarNaming,
 	}
 }
 
@@ -50,11 +63,52 @@ func enableAllChecks(cfg *config.Config) {
 	cfg.Checks.ModTidy = true
 	cfg.Checks.EOF = true
 	cfg.Checks.Whitespace = true
+	cfg.Checks.BuildTags = true
+	cfg.Checks.GoIndent = true
+	cfg.Checks.EmptyCommit = true
+	cfg.Checks.GoVersion = true
+	cfg.Checks.ErrorCompare = true
+	cfg.Checks.TestPresence = true
+	cfg.Checks.GoGenerate = true
+	cfg.Checks.LargeDiffs = true
+	cfg.Checks.Toolchain = true
+	cfg.Checks.ModulePath = true
+	cfg.Checks.BuildArtifacts = true
+	cfg.Checks.OSJunk = true
+	cfg.Checks.ContextFirst = true
+	cfg.Checks.ForbiddenImports = true
+	cfg.Checks.StructTags = true
+	cfg.Checks.DocComments = true
+	cfg.Checks.ReceiverNames = true
+	cfg.Checks.FilePermissions = true
+	cfg.Checks.EmbeddedBlobs = true
+	cfg.Checks.DataFormat = true
+	cfg.Checks.Gosec = true
+	cfg.Checks.Shellcheck = true
+	cfg.Checks.Hadolint = true
+	cfg.Checks.GoDirective = true
+	cfg.Checks.StubFuncs = true
+	cfg.Checks.CommitSize = true
+	cfg.Checks.TestPackage = true
+	cfg.Checks.ModPair = true
+	cfg.Checks.ErrorWrap = true
+	cfg.Checks.InternalImport = true
+	cfg.Checks.FuncLength = true
+	cfg.Checks.EnvAccess = true
+	cfg.Checks.TestOnlyDeps = true
+	cfg.Checks.GlobalVars = true
+	cfg.Checks.FileHeaderOrder = true
+	cfg.Checks.ContextBackground = true
+	cfg.Checks.MajorVersionConflict = true
+	cfg.Checks.FilenameCase = true
+	cfg.Checks.BuildConstraints = true
+	cfg.Checks.RepoFiles = true
+	cfg.Checks.ErrorVarNaming = true
 }
 
 func tempFile(t *testing.T) string {
 	t.Helper()
-	f := filepath.Join(t.TempDir(), "f.txt")
+	f := filepath.Join(t.TempDir(), "f.go")
 	require.NoError(t, os.WriteFile(f, []byte("x\n"), 0o600))
 	return f
 }
@@ -136,3 +190,222 @@ func TestRunParallel_MixedPanicAndSuccess(t *testing.T) {
 	assert.Equal(t, 1, results.Passed) // whitespace
 	assert.Equal(t, 2, results.Failed) // lint (panic) + eof
 }
+
+func TestRun_InteractiveWithoutTTYAppliesFixes(t *testing.T) {
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.EOF = true
+
+	r := New(cfg, t.TempDir())
+	var fixAllowed bool
+	r.registry.Register(&mockCheck{name: checkNameEOF, run: func(ctx context.Context, _ []string) error {
+		fixAllowed = shared.ConfirmFix(ctx, checkNameEOF, "f.txt")
+		return nil
+	}})
+
+	// Tests never run with a terminal on stdin, so interactive mode must fall
+	// back to applying fixes without prompting.
+	results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}, Interactive: true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, results.Passed)
+	assert.True(t, fixAllowed)
+}
+
+func TestRun_EmptyCommitRunsAfterOtherChecks(t *testing.T) {
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Whitespace = true
+	cfg.Checks.EOF = true
+	cfg.Checks.EmptyCommit = true
+
+	var whitespaceDone, eofDone atomic.Bool
+	var sawFixers atomic.Bool
+	r := New(cfg, t.TempDir())
+	r.registry.Register(&mockCheck{name: checkNameEmptyCommit, run: func(context.Context, []string) error {
+		sawFixers.Store(whitespaceDone.Load() && eofDone.Load())
+		return nil
+	}})
+	r.registry.Register(&mockCheck{name: checkNameWhitespace, run: func(context.Context, []string) error {
+		time.Sleep(20 * time.Millisecond)
+		whitespaceDone.Store(true)
+		return nil
+	}})
+	r.registry.Register(&mockCheck{name: checkNameEOF, run: func(context.Context, []string) error {
+		time.Sleep(20 * time.Millisecond)
+		eofDone.Store(true)
+		return nil
+	}})
+
+	results, err := r.Run(context.Background(), Options{Files: []string{"main.go"}, Parallel: 3})
+	require.NoError(t, err)
+	assert.Equal(t, 3, results.Passed)
+	assert.True(t, sawFixers.Load(), "empty-commit must observe the index after every fixer")
+}
+
+func TestRun_PlanCallbackCountsChecksBeforeTheyStart(t *testing.T) {
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Whitespace = true
+	cfg.Checks.EOF = true
+
+	r := New(cfg, t.TempDir())
+	var started atomic.Bool
+	r.registry.Register(&mockCheck{name: checkNameWhitespace, run: func(context.Context, []string) error {
+		started.Store(true)
+		return nil
+	}})
+	r.registry.Register(&mockCheck{name: checkNameEOF})
+
+	var plannedChecks, plannedFiles int
+	var finished []string
+	_, err := r.Run(context.Background(), Options{
+		Files:    []string{"a.go", "b.go"},
+		Parallel: 1,
+		PlanCallback: func(checks, files int) {
+			assert.False(t, started.Load(), "planned before any check runs")
+			plannedChecks, plannedFiles = checks, files
+		},
+		ProgressCallback: func(name, status string, _ time.Duration) {
+			if status != "running" {
+				finished = append(finished, name)
+			}
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, plannedChecks)
+	assert.Equal(t, 2, plannedFiles)
+	assert.Len(t, finished, plannedChecks, "every planned check reports a final status")
+}
+
+func TestRun_EmptyCommitSkippedAfterFailFastFailure(t *testing.T) {
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Whitespace = true
+	cfg.Checks.EmptyCommit = true
+
+	var ran atomic.Bool
+	r := New(cfg, t.TempDir())
+	r.registry.Register(&mockCheck{name: checkNameEmptyCommit, run: func(context.Context, []string) error {
+		ran.Store(true)
+		return nil
+	}})
+	r.registry.Register(&mockCheck{name: checkNameWhitespace, run: func(context.Context, []string) error {
+		return errMockCheckFailed
+	}})
+
+	results, err := r.Run(context.Background(), Options{Files: []string{"main.go"}, FailFast: true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, results.Failed)
+	assert.False(t, ran.Load())
+}
+
+func TestRun_CanceledContextReturnsPromptlyAndCleansUp(t *testing.T) {
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Whitespace = true
+	cfg.Checks.EOF = true
+
+	tempDir, err := os.MkdirTemp("", "runner-cancel")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(tempDir) })
+
+	started := make(chan struct{}, 2)
+	blockUntilCanceled := func(ctx context.Context, _ []string) error {
+		started <- struct{}{}
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	r := New(cfg, t.TempDir())
+	r.registry.Register(&mockCheck{name: checkNameEOF, run: blockUntilCanceled})
+	r.registry.Register(&mockCheck{name: checkNameWhitespace, run: blockUntilCanceled})
+
+	var order []string
+	r.AddCleanup(func() {
+		order = append(order, "temp-dir")
+		_ = os.RemoveAll(tempDir)
+	})
+	r.AddCleanup(func() { order = append(order, "last-registered") })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		<-started
+		cancel()
+	}()
+
+	begin := time.Now()
+	results, err := r.Run(ctx, Options{Files: []string{"main.go"}, Parallel: 2})
+	require.ErrorIs(t, err, ErrRunCanceled)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, time.Since(begin), 5*time.Second)
+	require.NotNil(t, results)
+	assert.Equal(t, 2, results.Failed)
+
+	assert.Equal(t, []string{"last-registered", "temp-dir"}, order)
+	assert.NoDirExists(t, tempDir)
+}
+
+func TestRun_CleanupRunsOnceAfterSuccessfulRun(t *testing.T) {
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Whitespace = true
+
+	r := New(cfg, t.TempDir())
+	r.registry.Register(&mockCheck{name: checkNameWhitespace})
+
+	calls := 0
+	r.AddCleanup(func() { calls++ })
+
+	_, err := r.Run(context.Background(), Options{Files: []string{"main.go"}})
+	require.NoError(t, err)
+	_, err = r.Run(context.Background(), Options{Files: []string{"main.go"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, calls)
+}
+
+func TestRunParallel_CheckOutputDoesNotInterleave(t *testing.T) {
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Whitespace = true
+	cfg.Checks.EOF = true
+
+	// Both checks write their first line, wait for each other, then write
+	// their second, so the writes are interleaved in time
+	var started sync.WaitGroup
+	started.Add(2)
+	chatty := func(name string) *mockCheck {
+		return &mockCheck{name: name, run: func(ctx context.Context, _ []string) error {
+			out := shared.CheckOutput(ctx)
+			_, _ = fmt.Fprintf(out, "%s: line 1\n", name)
+			started.Done()
+			started.Wait()
+			_, _ = fmt.Fprintf(out, "%s: line 2\n", name)
+			return nil
+		}}
+	}
+
+	r := New(cfg, t.TempDir())
+	r.registry.Register(chatty(checkNameWhitespace))
+	r.registry.Register(chatty(checkNameEOF))
+
+	results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}, Parallel: 2})
+	require.NoError(t, err)
+	require.Len(t, results.CheckResults, 2)
+	for _, result := range results.CheckResults {
+		assert.Equal(t, result.Name+": line 1\n"+result.Name+": line 2\n", result.Log)
+	}
+}
+
+func TestRunCheck_RecordsCommands(t *testing.T) {
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Whitespace = true
+
+	r := New(cfg, t.TempDir())
+	r.registry.Register(&mockCheck{name: checkNameWhitespace, run: func(ctx context.Context, _ []string) error {
+		shared.LogCommand(ctx, exec.CommandContext(ctx, "gofumpt", "-l", "main.go"))
+		cmd := exec.CommandContext(ctx, "go", "mod", "tidy")
+		cmd.Dir = "sub"
+		shared.LogCommand(ctx, cmd)
+		return nil
+	}})
+
+	results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}})
+	require.NoError(t, err)
+	require.Len(t, results.CheckResults, 1)
+	assert.Equal(t, []string{"gofumpt -l main.go", "(cd sub && go mod tidy)"}, results.CheckResults[0].Commands)
+}
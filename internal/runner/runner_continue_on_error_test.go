@@ -0,0 +1,96 @@
+package runner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+func TestRun_ContinueOnErrorFailureIsRecorded(t *testing.T) {
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Lint = true
+	cfg.Checks.Gitleaks = true
+	cfg.Checks.Whitespace = true
+	cfg.CheckBehaviors.ContinueOnError = []string{checkNameGitleaks}
+
+	r := New(cfg, t.TempDir())
+	failing := func(context.Context, []string) error { return errMockCheckFailed }
+	r.registry.Register(&mockCheck{name: checkNameGitleaks, run: failing})
+	r.registry.Register(&mockCheck{name: checkNameWhitespace})
+	r.registry.Register(&mockCheck{name: checkNameLint})
+
+	var statuses []string
+	results, err := r.Run(context.Background(), Options{
+		Files: []string{tempFile(t)},
+		ProgressCallback: func(name, status string, _ time.Duration) {
+			if name == checkNameGitleaks && status != "running" {
+				statuses = append(statuses, status)
+			}
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, results.Failed, "the failure is recorded as one")
+	assert.Equal(t, 1, results.Continued)
+	assert.Zero(t, results.Warned, "unlike warn-only, nothing is downgraded to a warning")
+	assert.Zero(t, results.BlockingFailures(), "so the CLI exits 0")
+	assert.Equal(t, []string{"failed"}, statuses)
+
+	for _, result := range results.CheckResults {
+		if result.Name != checkNameGitleaks {
+			continue
+		}
+		assert.False(t, result.Success)
+		assert.True(t, result.ContinueOnError)
+		assert.False(t, result.WarnOnly)
+	}
+}
+
+func TestRun_NormalFailureBlocks(t *testing.T) {
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Lint = true
+	cfg.Checks.Gitleaks = true
+	cfg.CheckBehaviors.ContinueOnError = []string{checkNameGitleaks}
+
+	r := New(cfg, t.TempDir())
+	failing := func(context.Context, []string) error { return errMockCheckFailed }
+	r.registry.Register(&mockCheck{name: checkNameGitleaks, run: failing})
+	r.registry.Register(&mockCheck{name: checkNameLint, run: failing})
+
+	results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, results.Failed)
+	assert.Equal(t, 1, results.Continued)
+	assert.Equal(t, 1, results.BlockingFailures(), "lint still fails the run")
+
+	for _, result := range results.CheckResults {
+		assert.Equal(t, result.Name == checkNameGitleaks, result.ContinueOnError, result.Name)
+	}
+}
+
+func TestRun_ContinueOnErrorDoesNotTriggerFailFast(t *testing.T) {
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Lint = true
+	cfg.Checks.Whitespace = true
+	cfg.CheckBehaviors.ContinueOnError = []string{checkNameLint, checkNameWhitespace}
+
+	r := New(cfg, t.TempDir())
+	failing := func(context.Context, []string) error { return errMockCheckFailed }
+	r.registry.Register(&mockCheck{name: checkNameLint, run: failing})
+	r.registry.Register(&mockCheck{name: checkNameWhitespace, run: failing})
+
+	results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}, FailFast: true})
+	require.NoError(t, err)
+	assert.Len(t, results.CheckResults, 2, "continue-on-error failures must not stop the run")
+	assert.Equal(t, 2, results.Continued)
+
+	results, err = r.Run(context.Background(), Options{Files: []string{tempFile(t)}, MaxFailures: 1, Parallel: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 2, results.Failed, "nor count toward --max-failures")
+	assert.Zero(t, results.Skipped)
+}
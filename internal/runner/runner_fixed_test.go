@@ -0,0 +1,65 @@
+package runner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+func TestRun_FixedFailuresAreCounted(t *testing.T) {
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Whitespace = true
+	cfg.Checks.EOF = true
+
+	fixed := func(_ context.Context, files []string) error {
+		return prerrors.NewFixedError(errMockCheckFailed, files)
+	}
+
+	r := New(cfg, t.TempDir())
+	r.registry.Register(&mockCheck{name: checkNameWhitespace, run: fixed})
+	r.registry.Register(&mockCheck{name: checkNameEOF, run: fixed})
+
+	results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, results.Failed)
+	assert.Equal(t, 2, results.Fixed)
+	assert.True(t, results.FixesOnly(), "every failure only needs re-staging")
+	for _, result := range results.CheckResults {
+		assert.True(t, result.Fixed, result.Name)
+	}
+
+	r.registry.Register(&mockCheck{name: checkNameEOF, run: func(context.Context, []string) error {
+		return errMockCheckFailed
+	}})
+	results, err = r.Run(context.Background(), Options{Files: []string{tempFile(t)}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, results.Failed)
+	assert.Equal(t, 1, results.Fixed)
+	assert.False(t, results.FixesOnly(), "an unfixed failure needs attention")
+}
+
+func TestResults_FixesOnly(t *testing.T) {
+	assert.False(t, (&Results{}).FixesOnly(), "a clean run has nothing to re-stage")
+	assert.True(t, (&Results{Failed: 1, Fixed: 1}).FixesOnly())
+	assert.False(t, (&Results{Failed: 2, Fixed: 1}).FixesOnly())
+}
+
+func TestResults_NothingChecked(t *testing.T) {
+	assert.True(t, (&Results{}).NothingChecked())
+	assert.True(t, (&Results{CheckResults: []CheckResult{
+		{Name: "whitespace", Skipped: true, Error: noFilesSkipReason},
+		{Name: "mod-tidy", Skipped: true, Error: goSkipReason},
+	}}).NothingChecked())
+	assert.False(t, (&Results{CheckResults: []CheckResult{
+		{Name: "whitespace", Skipped: true, Error: noFilesSkipReason},
+		{Name: "eof", Success: true, Files: []string{"a.txt"}},
+	}}).NothingChecked(), "a check ran")
+	assert.False(t, (&Results{CheckResults: []CheckResult{
+		{Name: "lint", Skipped: true, Error: "golangci-lint not installed"},
+	}}).NothingChecked(), "skipped for another reason")
+}
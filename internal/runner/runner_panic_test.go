@@ -0,0 +1,61 @@
+package runner
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+// parseStructTagsMalformed stands in for a check's parser hitting a bug on
+// malformed input; its name must appear in the captured stack trace
+func parseStructTagsMalformed() {
+	var fields []string
+	_ = fields[3] //nolint:gosec // deliberate out-of-range panic
+}
+
+func TestRun_PanickingCheck(t *testing.T) {
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.StructTags, cfg.CheckTimeouts.StructTags = true, 30
+	cfg.Checks.Whitespace, cfg.CheckTimeouts.Whitespace = true, 30
+	cfg.Checks.EOF, cfg.CheckTimeouts.EOF = true, 30
+
+	var completed atomic.Int32
+	r := New(cfg, t.TempDir())
+	r.registry.Register(&mockCheck{name: checkNameStructTags, run: func(context.Context, []string) error {
+		parseStructTagsMalformed()
+		return nil
+	}})
+	for _, name := range []string{checkNameWhitespace, checkNameEOF} {
+		r.registry.Register(&mockCheck{name: name, run: func(context.Context, []string) error {
+			completed.Add(1)
+			return nil
+		}})
+	}
+
+	for _, parallel := range []int{1, 3} {
+		completed.Store(0)
+		results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}, Parallel: parallel})
+		require.NoError(t, err, "a panic must not abort the run")
+
+		assert.Equal(t, int32(2), completed.Load(), "the other checks still complete (parallel=%d)", parallel)
+		assert.Equal(t, 2, results.Passed)
+		assert.Equal(t, 1, results.Failed)
+
+		var panicked CheckResult
+		for _, result := range results.CheckResults {
+			if result.Name == checkNameStructTags {
+				panicked = result
+			}
+		}
+		assert.False(t, panicked.Success)
+		assert.Contains(t, panicked.Error, ErrCheckPanicked.Error()+" in struct-tags")
+		assert.Contains(t, panicked.Error, "index out of range")
+		assert.Contains(t, panicked.Output, "parseStructTagsMalformed", "the stack trace is captured")
+		assert.Contains(t, panicked.Suggestion, "--skip struct-tags")
+	}
+}
@@ -90,12 +90,12 @@ func (s *SkipFunctionalityTestSuite) TestParseSkipValue() {
 		{
 			name:     "Special Value All",
 			input:    "all",
-			expected: []string{checkNameFumpt, checkNameGitleaks, checkNameLint, checkNameModTidy, checkNameWhitespace, checkNameEOF},
+			expected: validCheckNames(),
 		},
 		{
 			name:     "Special Value ALL (case insensitive)",
 			input:    "ALL",
-			expected: []string{checkNameFumpt, checkNameGitleaks, checkNameLint, checkNameModTidy, checkNameWhitespace, checkNameEOF},
+			expected: validCheckNames(),
 		},
 		{
 			name:     "With Spaces",
@@ -487,7 +487,7 @@ func (s *SkipFunctionalityTestSuite) TestSkipEdgeCases() {
 		{
 			name:        "Mixed Case All",
 			skipValue:   "All",
-			expected:    []string{checkNameFumpt, checkNameGitleaks, checkNameLint, checkNameModTidy, checkNameWhitespace, checkNameEOF},
+			expected:    validCheckNames(),
 			description: "Should handle mixed case 'all' keyword",
 		},
 		{
@@ -3,6 +3,7 @@ package runner
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -12,6 +13,9 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+	"github.com/mrz1836/go-pre-commit/internal/git"
+	"github.com/mrz1836/go-pre-commit/internal/output"
 )
 
 func TestNew(t *testing.T) {
@@ -48,10 +52,12 @@ func TestRunner_Run_NoFiles(t *testing.T) {
 	results, err := r.Run(context.Background(), opts)
 	require.NoError(t, err)
 	assert.NotNil(t, results)
-	// When no files are provided, checks still run but succeed immediately
-	assert.Equal(t, 1, results.Passed)
+	// fumpt requires files, so with none it is reported as skipped
+	assert.Equal(t, 0, results.Passed)
+	assert.Equal(t, 1, results.Skipped)
 	assert.Equal(t, 0, results.Failed)
-	assert.Len(t, results.CheckResults, 1)
+	require.Len(t, results.CheckResults, 1)
+	assert.True(t, results.CheckResults[0].Skipped)
 }
 
 func TestRunner_Run_BasicFlow(t *testing.T) {
@@ -202,6 +208,20 @@ func TestCheckResult(t *testing.T) {
 	assert.Len(t, result.Files, 2)
 }
 
+func TestCheckResultFindings(t *testing.T) {
+	structured := []output.Diagnostic{{File: "a.go", Line: 3, Severity: output.SeverityError, Message: "unused"}}
+	assert.Equal(t, structured, CheckResult{Output: "b.go:1: ignored", Diagnostics: structured}.Findings())
+
+	assert.Equal(t, []output.Diagnostic{
+		{File: "b.go", Line: 1, Severity: output.SeverityWarning, Message: "mutable"},
+		{File: "c.go", Line: 2, Col: 4, Severity: output.SeverityWarning, Message: "shadowed"},
+	}, CheckResult{WarnOnly: true, Output: "b.go:1: mutable", Error: "c.go:2:4: shadowed"}.Findings())
+
+	assert.Equal(t, output.SeverityError, CheckResult{Error: "d.go:5: broken"}.Findings()[0].Severity)
+	assert.Empty(t, CheckResult{Success: true, Output: "a.go:1: passed"}.Findings())
+	assert.Empty(t, CheckResult{Skipped: true, Output: "a.go:1: skipped"}.Findings())
+}
+
 // Comprehensive test suite for runner functionality
 
 type RunnerTestSuite struct {
@@ -853,6 +873,64 @@ func TestMatchesExcludePattern(t *testing.T) {
 
 // TestApplyExcludePatterns tests the Runner's exclude pattern application
 func TestApplyExcludePatterns(t *testing.T) {
+	t.Run("dotfiles skipped when configured", func(t *testing.T) {
+		cfg := &config.Config{
+			Enabled: true,
+		}
+		cfg.Git.SkipDotfiles = true
+		cfg.Git.DotfileIncludes = []string{".golangci.yml"}
+
+		r := New(cfg, "/home/dev/.work/repo")
+
+		files := []string{
+			testFileSrcMainGo,
+			".editorconfig",
+			".golangci.yml",
+			"/home/dev/.work/repo/cmd/main.go",
+			"/home/dev/.work/repo/.env",
+		}
+		result := r.applyExcludePatterns(files)
+
+		expected := []string{testFileSrcMainGo, ".golangci.yml", "/home/dev/.work/repo/cmd/main.go"}
+		assert.Equal(t, expected, result, "hidden directories above the repository root must not count")
+	})
+
+	t.Run("excluded directories", func(t *testing.T) {
+		cfg := &config.Config{
+			Enabled: true,
+		}
+		cfg.Git.ExcludeDirs = []string{"third_party", "web/dist"}
+		cfg.Git.SkipDotfiles = true
+		cfg.Git.DotfileIncludes = []string{"third_party/.golangci.yml"}
+
+		r := New(cfg, "/repo")
+
+		files := []string{
+			testFileSrcMainGo,
+			"third_party/lib/lib.go",
+			"third_party/.golangci.yml",
+			"third_party_tools/tool.go",
+			"/repo/web/dist/app.js",
+			"/repo/web/src/app.ts",
+		}
+		result := r.applyExcludePatterns(files)
+
+		expected := []string{testFileSrcMainGo, "third_party_tools/tool.go", "/repo/web/src/app.ts"}
+		assert.Equal(t, expected, result, "excluded directories win over dotfile includes and never match siblings")
+		assert.Equal(t, git.ReasonExcludedDir, r.excludeReason("third_party/.golangci.yml"))
+	})
+
+	t.Run("dotfiles kept by default", func(t *testing.T) {
+		cfg := &config.Config{
+			Enabled: true,
+		}
+
+		r := New(cfg, "/test")
+
+		files := []string{testFileSrcMainGo, ".editorconfig"}
+		assert.Equal(t, files, r.applyExcludePatterns(files))
+	})
+
 	t.Run("no patterns configured", func(t *testing.T) {
 		cfg := &config.Config{
 			Enabled: true,
@@ -1047,3 +1125,51 @@ func (s *RunnerTestSuite) TestExcludePatternsIntegration() {
 			"check %s should process at most 1 file after exclusion", checkResult.Name)
 	}
 }
+
+func TestRunner_Run_ReportsCheckErrorFiles(t *testing.T) {
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Fumpt = true
+
+	dir := t.TempDir()
+	for _, name := range []string{"a.go", "b.go"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("package a\n"), 0o600))
+	}
+
+	r := New(cfg, dir)
+	r.registry.Register(&mockCheck{name: checkNameFumpt, run: func(context.Context, []string) error {
+		return &prerrors.CheckError{Err: prerrors.ErrFmtIssues, Message: "needs formatting", Files: []string{"b.go"}}
+	}})
+
+	results, err := r.Run(context.Background(), Options{Files: []string{"a.go", "b.go"}})
+	require.NoError(t, err)
+	require.Len(t, results.CheckResults, 1)
+	assert.Equal(t, []string{"b.go"}, results.CheckResults[0].Files)
+}
+
+func TestRunner_Run_ExternalCheck(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("GO_PRE_COMMIT_REPO_ROOT", dir)
+	script := "#!/bin/sh\nwhile read -r file; do\n\tprintf '{\"diagnostics\": [{\"file\": \"%s\", \"line\": 1, \"rule\": \"LH1\", \"message\": \"missing license header\"}]}' \"$file\"\ndone\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "check.sh"), []byte(script), 0o755)) //nolint:gosec // Test script must be executable
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.go"), []byte("package a\n"), 0o600))
+
+	cfg := &config.Config{Enabled: true, Timeout: 60, ExternalChecks: map[string]string{"license-headers": "./check.sh"}}
+	cfg.Plugins.Timeout = 10
+	r := New(cfg, dir)
+	assert.True(t, r.isCheckEnabled("license-headers"), "external checks are enabled by being configured")
+	assert.Equal(t, 10*time.Second, r.getCheckTimeout("license-headers"))
+	assert.Equal(t, []string{"license-headers"}, r.parseSkipValue("license-headers"))
+
+	results, err := r.Run(context.Background(), Options{Files: []string{"a.go"}})
+	require.NoError(t, err)
+	require.Len(t, results.CheckResults, 1)
+	result := results.CheckResults[0]
+	assert.Equal(t, "license-headers", result.Name)
+	assert.False(t, result.Success)
+	assert.Equal(t, []output.Diagnostic{
+		{File: "a.go", Line: 1, Severity: output.SeverityError, Rule: "LH1", Message: "missing license header"},
+	}, result.Diagnostics)
+
+	_, err = r.Run(context.Background(), Options{Files: []string{"a.go"}, SkipChecks: []string{"license-headers"}})
+	require.ErrorIs(t, err, prerrors.ErrNoChecksToRun)
+}
@@ -0,0 +1,142 @@
+package runner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+func TestRun_WarnOnlyFailureDoesNotFailRun(t *testing.T) {
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Lint = true
+	cfg.Checks.Whitespace = true
+	cfg.CheckBehaviors.WarnOnly = []string{checkNameLint}
+
+	r := New(cfg, t.TempDir())
+	r.registry.Register(&mockCheck{name: checkNameLint, run: func(context.Context, []string) error {
+		return errMockCheckFailed
+	}})
+	r.registry.Register(&mockCheck{name: checkNameWhitespace})
+
+	var statuses []string
+	results, err := r.Run(context.Background(), Options{
+		Files: []string{tempFile(t)},
+		ProgressCallback: func(name, status string, _ time.Duration) {
+			if name == checkNameLint && status != "running" {
+				statuses = append(statuses, status)
+			}
+		},
+	})
+	require.NoError(t, err)
+
+	// The CLI exits non-zero only when Failed > 0
+	assert.Equal(t, 0, results.Failed)
+	assert.Equal(t, 1, results.Warned)
+	assert.Equal(t, 1, results.Passed)
+	assert.Equal(t, []string{"warning"}, statuses)
+
+	var lint *CheckResult
+	for i := range results.CheckResults {
+		if results.CheckResults[i].Name == checkNameLint {
+			lint = &results.CheckResults[i]
+		}
+	}
+	require.NotNil(t, lint)
+	assert.False(t, lint.Success, "advisory failures are still recorded as failed")
+	assert.True(t, lint.WarnOnly)
+	assert.Contains(t, lint.Error, errMockCheckFailed.Error())
+}
+
+func TestRun_WarnOnlyFixSeverity(t *testing.T) {
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Whitespace, cfg.CheckTimeouts.Whitespace = true, 30
+	cfg.Checks.EOF, cfg.CheckTimeouts.EOF = true, 30
+
+	fixable := func(t *testing.T) string {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), "notes.txt")
+		require.NoError(t, os.WriteFile(path, []byte("trailing  \nno newline"), 0o600))
+		return path
+	}
+
+	t.Run("error blocks the commit", func(t *testing.T) {
+		cfg.CheckBehaviors.WhitespaceSeverity = config.LintSeverityError
+		cfg.CheckBehaviors.EOFSeverity = config.LintSeverityError
+
+		results, err := New(cfg, t.TempDir()).Run(context.Background(), Options{Files: []string{fixable(t)}, Parallel: 1})
+		require.NoError(t, err)
+		assert.Equal(t, 2, results.BlockingFailures())
+		assert.True(t, results.FixesOnly())
+	})
+
+	t.Run("warning fixes without blocking", func(t *testing.T) {
+		cfg.CheckBehaviors.WhitespaceSeverity = config.LintSeverityWarning
+		cfg.CheckBehaviors.EOFSeverity = config.LintSeverityWarning
+		path := fixable(t)
+
+		results, err := New(cfg, t.TempDir()).Run(context.Background(), Options{Files: []string{path}, Parallel: 1})
+		require.NoError(t, err)
+
+		// The CLI exits non-zero only when there are blocking failures
+		assert.Equal(t, 0, results.BlockingFailures())
+		assert.Equal(t, 2, results.Warned)
+		for _, result := range results.CheckResults {
+			assert.True(t, result.WarnOnly, result.Name)
+			assert.True(t, result.Fixed, result.Name)
+			assert.Contains(t, result.Error, path, "the warning lists the changed files")
+		}
+
+		content, err := os.ReadFile(path) //nolint:gosec // test file path is controlled
+		require.NoError(t, err)
+		assert.Equal(t, "trailing\nno newline\n", string(content))
+	})
+}
+
+func TestRun_WarnOnlyDoesNotTriggerFailFast(t *testing.T) {
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Lint = true
+	cfg.Checks.Whitespace = true
+	cfg.CheckBehaviors.WarnOnly = []string{checkNameLint, checkNameWhitespace}
+
+	r := New(cfg, t.TempDir())
+	failing := func(context.Context, []string) error { return errMockCheckFailed }
+	r.registry.Register(&mockCheck{name: checkNameLint, run: failing})
+	r.registry.Register(&mockCheck{name: checkNameWhitespace, run: failing})
+
+	results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}, FailFast: true})
+	require.NoError(t, err)
+	assert.Len(t, results.CheckResults, 2, "warn-only failures must not stop the run")
+	assert.Equal(t, 2, results.Warned)
+	assert.Equal(t, 0, results.Failed)
+}
+
+func TestRun_AdvisoryCheckErrorDoesNotFailRun(t *testing.T) {
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Whitespace = true
+	cfg.Checks.EOF = true
+
+	r := New(cfg, t.TempDir())
+	r.registry.Register(&mockCheck{name: checkNameWhitespace, run: func(context.Context, []string) error {
+		return &prerrors.CheckError{Err: errMockCheckFailed, Message: "style findings", WarnOnly: true}
+	}})
+	r.registry.Register(&mockCheck{name: checkNameEOF, run: func(context.Context, []string) error {
+		return &prerrors.CheckError{Err: errMockCheckFailed, Message: "security findings"}
+	}})
+
+	results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, results.Warned, "advisory findings warn")
+	assert.Equal(t, 1, results.Failed, "blocking findings still fail")
+
+	for _, result := range results.CheckResults {
+		assert.Equal(t, result.Name == checkNameWhitespace, result.WarnOnly, result.Name)
+	}
+}
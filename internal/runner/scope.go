@@ -0,0 +1,62 @@
+package runner
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/mrz1836/go-pre-commit/internal/checks"
+)
+
+// scopeInputs turns the files a check accepted into the input its scope
+// asks for: module-scoped checks get the root of each Go module holding one
+// of the files, once per module, and repo-scoped checks get the repository
+// root. Files outside every module are passed to a module-scoped check as
+// they are.
+func (r *Runner) scopeInputs(check checks.Check, files []string) []string {
+	if len(files) == 0 {
+		return files
+	}
+	switch r.registry.Scope(check) {
+	case checks.ScopeModule:
+		return r.moduleRoots(files)
+	case checks.ScopeRepo:
+		return []string{"."}
+	default:
+		return files
+	}
+}
+
+// moduleRoots returns the repository-relative root of the module holding
+// each file, in first-seen order with duplicates removed
+func (r *Runner) moduleRoots(files []string) []string {
+	seen := make(map[string]bool)
+	var roots []string
+	for _, file := range files {
+		root, ok := r.moduleRootOf(file)
+		if !ok {
+			root = file
+		}
+		if !seen[root] {
+			seen[root] = true
+			roots = append(roots, root)
+		}
+	}
+	return roots
+}
+
+// moduleRootOf returns the nearest directory at or above file's directory,
+// within the repository, that holds a go.mod
+func (r *Runner) moduleRootOf(file string) (string, bool) {
+	if r.repoRoot == "" || filepath.IsAbs(file) {
+		return "", false
+	}
+	for dir := path.Dir(file); ; dir = path.Dir(dir) {
+		if _, err := os.Stat(filepath.Join(r.repoRoot, filepath.FromSlash(dir), "go.mod")); err == nil {
+			return dir, true
+		}
+		if dir == "." || dir == "/" {
+			return "", false
+		}
+	}
+}
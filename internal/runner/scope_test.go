@@ -0,0 +1,99 @@
+package runner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/checks"
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+// newScopeRunner returns a runner in a repository with a root module and a
+// nested module in sub/, with mod-tidy registered under scope and recording
+// the input of each run
+func newScopeRunner(t *testing.T, scope string) (*Runner, *[][]string) {
+	t.Helper()
+	dir := t.TempDir()
+	for _, name := range []string{"go.mod", "main.go", "pkg/a.go", "pkg/b.go", "sub/go.mod", "sub/x.go", "sub/y/z.go"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0o750))
+		writeRepoFile(t, dir, name)
+	}
+	t.Chdir(dir)
+
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.ModTidy = true
+	r := New(cfg, dir)
+
+	var mu sync.Mutex
+	var runs [][]string
+	r.registry.Register(&metadataCheck{
+		mockCheck: mockCheck{name: checkNameModTidy, run: func(_ context.Context, files []string) error {
+			mu.Lock()
+			defer mu.Unlock()
+			runs = append(runs, files)
+			return nil
+		}},
+		metadata: checks.CheckMetadata{Scope: scope},
+	})
+	return r, &runs
+}
+
+func TestRun_ModuleScopedCheckRunsOncePerModule(t *testing.T) {
+	r, runs := newScopeRunner(t, checks.ScopeModule)
+
+	_, err := r.Run(context.Background(), Options{
+		Files:      []string{"main.go", "pkg/a.go", "pkg/b.go", "sub/x.go", "sub/y/z.go", "sub/go.mod"},
+		OnlyChecks: []string{checkNameModTidy},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{".", "sub"}}, *runs)
+}
+
+func TestRun_ModuleScopedCheckOnlyAffectedModules(t *testing.T) {
+	r, runs := newScopeRunner(t, checks.ScopeModule)
+
+	_, err := r.Run(context.Background(), Options{
+		Files:      []string{"sub/x.go", "sub/y/z.go"},
+		OnlyChecks: []string{checkNameModTidy},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"sub"}}, *runs)
+}
+
+func TestRun_RepoScopedCheckRunsOnce(t *testing.T) {
+	r, runs := newScopeRunner(t, checks.ScopeRepo)
+
+	_, err := r.Run(context.Background(), Options{
+		Files:      []string{"main.go", "pkg/a.go", "sub/x.go"},
+		OnlyChecks: []string{checkNameModTidy},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"."}}, *runs)
+}
+
+func TestRun_FileScopedCheckGetsFiles(t *testing.T) {
+	r, runs := newScopeRunner(t, "")
+
+	_, err := r.Run(context.Background(), Options{
+		Files:      []string{"main.go", "sub/x.go"},
+		OnlyChecks: []string{checkNameModTidy},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"main.go", "sub/x.go"}}, *runs)
+}
+
+func TestModuleRoots_FilesOutsideModules(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "lib"), 0o750))
+	writeRepoFile(t, dir, "lib/go.mod")
+
+	r := New(&config.Config{Enabled: true}, dir)
+	assert.Equal(t, []string{"lib", "go.work", "tools/gen.go"},
+		r.moduleRoots([]string{"lib/a.go", "go.work", "lib/b.go", "tools/gen.go"}))
+}
@@ -0,0 +1,63 @@
+package runner
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+// newWorkspaceRunner returns a runner whose whitespace check creates a
+// temporary workspace it never removes, then runs after
+func newWorkspaceRunner(t *testing.T, after func(ctx context.Context) error) (*Runner, *string) {
+	t.Helper()
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Whitespace = true
+	cfg.Performance.TempDir = t.TempDir()
+
+	var workspace string
+	r := New(cfg, t.TempDir())
+	r.registry.Register(&mockCheck{name: checkNameWhitespace, run: func(ctx context.Context, _ []string) error {
+		dir, err := r.registry.SharedContext().TempWorkspace("test")
+		if err != nil {
+			return err
+		}
+		workspace = dir
+		return after(ctx)
+	}})
+	return r, &workspace
+}
+
+func TestRun_RemovesTempWorkspaces(t *testing.T) {
+	r, workspace := newWorkspaceRunner(t, func(context.Context) error { return nil })
+
+	results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}, Parallel: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 1, results.Passed)
+
+	require.NotEmpty(t, *workspace)
+	assert.Equal(t, r.config.Performance.TempDir, filepath.Dir(*workspace), "workspaces use GO_PRE_COMMIT_TEMP_DIR")
+	assert.NoDirExists(t, *workspace)
+	assert.Empty(t, r.registry.SharedContext().TempWorkspaces())
+}
+
+func TestRun_RemovesTempWorkspacesOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r, workspace := newWorkspaceRunner(t, func(checkCtx context.Context) error {
+		cancel()
+		<-checkCtx.Done()
+		return checkCtx.Err()
+	})
+
+	_, err := r.Run(ctx, Options{Files: []string{tempFile(t)}, Parallel: 1})
+	require.ErrorIs(t, err, ErrRunCanceled)
+
+	require.NotEmpty(t, *workspace)
+	assert.NoDirExists(t, *workspace)
+}
@@ -0,0 +1,117 @@
+package runner
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+)
+
+// timeoutTestRunner returns a runner with whitespace, eof, and gitleaks enabled
+// and replaced by the given mock run functions
+func timeoutTestRunner(t *testing.T, cfg *config.Config, runs map[string]func(context.Context, []string) error) *Runner {
+	t.Helper()
+	cfg.Enabled = true
+	cfg.Checks.Whitespace = true
+	cfg.Checks.EOF = true
+	cfg.Checks.Gitleaks = true
+	cfg.CheckTimeouts.Whitespace = 30
+	cfg.CheckTimeouts.EOF = 30
+	cfg.CheckTimeouts.Gitleaks = 30
+
+	r := New(cfg, t.TempDir())
+	for name, run := range runs {
+		r.registry.Register(&mockCheck{name: name, run: run})
+	}
+	return r
+}
+
+// blockUntilDone waits for the check's context to end and returns its error
+func blockUntilDone(ctx context.Context, _ []string) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestOverallAndPerCheckTimeouts(t *testing.T) {
+	cfg := &config.Config{Timeout: 720}
+	r := New(cfg, t.TempDir())
+	assert.Equal(t, 720*time.Second, r.overallTimeout(), "falls back to GO_PRE_COMMIT_TIMEOUT_SECONDS")
+	assert.Zero(t, r.perCheckTimeout())
+
+	cfg.Runner.OverallTimeout = 90
+	cfg.Runner.PerCheckTimeout = 15
+	assert.Equal(t, 90*time.Second, r.overallTimeout())
+	assert.Equal(t, 15*time.Second, r.perCheckTimeout())
+}
+
+func TestRun_PerCheckTimeoutLeavesSiblingsRunning(t *testing.T) {
+	cfg := &config.Config{Timeout: 60}
+	cfg.Runner.PerCheckTimeout = 1
+
+	var siblingsDone atomic.Int32
+	sibling := func(ctx context.Context, _ []string) error {
+		time.Sleep(100 * time.Millisecond)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		siblingsDone.Add(1)
+		return nil
+	}
+	r := timeoutTestRunner(t, cfg, map[string]func(context.Context, []string) error{
+		checkNameGitleaks:   blockUntilDone,
+		checkNameWhitespace: sibling,
+		checkNameEOF:        sibling,
+	})
+
+	results, err := r.Run(context.Background(), Options{Files: []string{"main.go"}, Parallel: 3})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, results.Passed)
+	assert.Equal(t, 1, results.Failed)
+	assert.Equal(t, int32(2), siblingsDone.Load(), "the other checks complete")
+	for _, result := range results.CheckResults {
+		if result.Name == checkNameGitleaks {
+			assert.False(t, result.Success)
+			assert.Contains(t, result.Error, "timed out")
+			assert.Contains(t, result.Error, "1s")
+			assert.Contains(t, result.Error, "GO_PRE_COMMIT_PER_CHECK_TIMEOUT_SECONDS")
+		} else {
+			assert.True(t, result.Success, result.Name)
+		}
+	}
+}
+
+func TestRun_OverallTimeoutCancelsEveryCheck(t *testing.T) {
+	cfg := &config.Config{Timeout: 60}
+	cfg.Runner.OverallTimeout = 1
+	cfg.Runner.PerCheckTimeout = 30
+
+	var started atomic.Int32
+	block := func(ctx context.Context, files []string) error {
+		started.Add(1)
+		return blockUntilDone(ctx, files)
+	}
+	r := timeoutTestRunner(t, cfg, map[string]func(context.Context, []string) error{
+		checkNameGitleaks:   block,
+		checkNameWhitespace: block,
+		checkNameEOF:        block,
+	})
+
+	start := time.Now()
+	results, err := r.Run(context.Background(), Options{Files: []string{"main.go"}, Parallel: 2})
+	require.NoError(t, err, "the overall deadline is reported through the check results")
+	assert.Less(t, time.Since(start), 10*time.Second)
+
+	assert.Equal(t, 3, results.Failed)
+	assert.Equal(t, int32(2), started.Load(), "the check waiting for a worker is never started")
+	for _, result := range results.CheckResults {
+		assert.Contains(t, result.Error, "timed out", result.Name)
+		assert.Contains(t, result.Error, "1s", result.Name)
+		assert.Contains(t, result.Error, "GO_PRE_COMMIT_OVERALL_TIMEOUT_SECONDS", result.Name)
+	}
+}
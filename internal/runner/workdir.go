@@ -0,0 +1,28 @@
+package runner
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// ErrWorkDirNotFound is returned when a check's configured working directory
+// does not exist
+var ErrWorkDirNotFound = errors.New("check working directory not found")
+
+// checkWorkDir returns the absolute directory GO_PRE_COMMIT_CHECK_WORKDIR sets
+// for the named check, or "" when none is configured. The directory must exist.
+func (r *Runner) checkWorkDir(name string) (string, error) {
+	dir, ok := r.config.CheckBehaviors.WorkDir[name]
+	if !ok || dir == "" {
+		return "", nil
+	}
+
+	absDir := filepath.Join(r.repoRoot, dir)
+	info, err := os.Stat(absDir)
+	if err != nil || !info.IsDir() {
+		return "", fmt.Errorf("%w: %s (GO_PRE_COMMIT_CHECK_WORKDIR for %s)", ErrWorkDirNotFound, dir, name)
+	}
+	return absDir, nil
+}
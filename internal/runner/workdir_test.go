@@ -0,0 +1,82 @@
+package runner
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/config"
+	"github.com/mrz1836/go-pre-commit/internal/shared"
+)
+
+// newWorkDirRunner returns a runner with fumpt enabled as a mock check that
+// records the directory its command ran in
+func newWorkDirRunner(t *testing.T, workDirs map[string]string) (*Runner, *string) {
+	t.Helper()
+	cfg := &config.Config{Enabled: true, Timeout: 60}
+	cfg.Checks.Fumpt, cfg.CheckTimeouts.Fumpt = true, 30
+	cfg.CheckBehaviors.WorkDir = workDirs
+
+	repoRoot := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(repoRoot, "web", "app"), 0o750))
+
+	var ranIn string
+	r := New(cfg, repoRoot)
+	r.registry.Register(&mockCheck{name: checkNameFumpt, run: func(ctx context.Context, _ []string) error {
+		cmd := exec.CommandContext(ctx, "pwd")
+		cmd.Dir = repoRoot
+		shared.ApplyWorkDir(ctx, cmd)
+		out, err := cmd.Output()
+		ranIn = strings.TrimSpace(string(out))
+		return err
+	}})
+	return r, &ranIn
+}
+
+func TestRun_CheckWorkDir(t *testing.T) {
+	r, ranIn := newWorkDirRunner(t, map[string]string{checkNameFumpt: filepath.Join("web", "app")})
+
+	results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}, Parallel: 1})
+	require.NoError(t, err)
+	require.Equal(t, 1, results.Passed)
+
+	want, err := filepath.EvalSymlinks(filepath.Join(r.repoRoot, "web", "app"))
+	require.NoError(t, err)
+	got, err := filepath.EvalSymlinks(*ranIn)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestRun_CheckWorkDirDefault(t *testing.T) {
+	r, ranIn := newWorkDirRunner(t, map[string]string{"lint": "web"})
+
+	results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}, Parallel: 1})
+	require.NoError(t, err)
+	require.Equal(t, 1, results.Passed)
+
+	want, err := filepath.EvalSymlinks(r.repoRoot)
+	require.NoError(t, err)
+	got, err := filepath.EvalSymlinks(*ranIn)
+	require.NoError(t, err)
+	assert.Equal(t, want, got, "checks without an entry keep their own directory")
+}
+
+func TestRun_CheckWorkDirMissing(t *testing.T) {
+	r, ranIn := newWorkDirRunner(t, map[string]string{checkNameFumpt: "frontend"})
+
+	results, err := r.Run(context.Background(), Options{Files: []string{tempFile(t)}, Parallel: 1})
+	require.NoError(t, err)
+	require.Equal(t, 1, results.Failed)
+	assert.Empty(t, *ranIn, "the check must not run")
+
+	result := results.CheckResults[0]
+	assert.Contains(t, result.Error, ErrWorkDirNotFound.Error())
+	assert.Contains(t, result.Error, "frontend")
+	assert.Contains(t, result.Suggestion, "GO_PRE_COMMIT_CHECK_WORKDIR")
+}
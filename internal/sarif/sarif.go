@@ -0,0 +1,280 @@
+// Package sarif builds a SARIF 2.1.0 log from a run's findings and uploads
+// it to GitHub code scanning
+package sarif
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/output"
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+)
+
+// DefaultTimeout bounds an upload so a slow API cannot hold up the run
+const DefaultTimeout = 30 * time.Second
+
+// ToolName names the analysis in the log and in code scanning
+const ToolName = "go-pre-commit"
+
+// defaultAPIURL is used when GITHUB_API_URL is not set
+const defaultAPIURL = "https://api.github.com"
+
+// SARIF version and schema of the logs Build produces
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+var (
+	// ErrMissingTarget is returned when the environment does not name a token,
+	// repository, commit, and ref to upload for
+	ErrMissingTarget = errors.New("SARIF upload needs GITHUB_TOKEN, GITHUB_REPOSITORY, GITHUB_SHA, and GITHUB_REF")
+
+	// ErrUploadFailed is returned when the GitHub API answers with a non-2xx status
+	ErrUploadFailed = errors.New("SARIF upload failed")
+)
+
+// Log is a SARIF log holding one run
+type Log struct {
+	Schema  string `json:"$schema"`
+	Version string `json:"version"`
+	Runs    []Run  `json:"runs"`
+}
+
+// Run is the analysis of one go-pre-commit run
+type Run struct {
+	Tool    Tool     `json:"tool"`
+	Results []Result `json:"results"`
+}
+
+// Tool describes go-pre-commit and the rules its results refer to
+type Tool struct {
+	Driver Driver `json:"driver"`
+}
+
+// Driver is the tool component that produced the results
+type Driver struct {
+	Name           string `json:"name"`
+	Version        string `json:"version,omitempty"`
+	InformationURI string `json:"informationUri"`
+	Rules          []Rule `json:"rules"`
+}
+
+// Rule is a check, or a rule a check's tool reported
+type Rule struct {
+	ID string `json:"id"`
+}
+
+// Result is one finding at a file position
+type Result struct {
+	RuleID    string     `json:"ruleId"`
+	Level     string     `json:"level"`
+	Message   Message    `json:"message"`
+	Locations []Location `json:"locations"`
+}
+
+// Message is the text of a result
+type Message struct {
+	Text string `json:"text"`
+}
+
+// Location points a result at a file region
+type Location struct {
+	PhysicalLocation PhysicalLocation `json:"physicalLocation"`
+}
+
+// PhysicalLocation is a region of a repository file
+type PhysicalLocation struct {
+	ArtifactLocation ArtifactLocation `json:"artifactLocation"`
+	Region           Region           `json:"region"`
+}
+
+// ArtifactLocation is a file path relative to the repository root
+type ArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// Region is the line, and column when known, a result starts at
+type Region struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+// Build returns the SARIF log of the findings failed and warn-only checks
+// reported (see runner.CheckResult.Findings). A rule reported by a check's
+// tool becomes "check/rule". Findings of warn-only checks are at most
+// warnings. A run without findings gives a log without results, which
+// clears earlier alerts.
+func Build(results *runner.Results, version string) Log {
+	var findings []Result
+	var ruleIDs []string
+	for _, result := range results.CheckResults {
+		for _, diagnostic := range result.Findings() {
+			if diagnostic.Line <= 0 {
+				continue
+			}
+			ruleID := result.Name
+			if diagnostic.Rule != "" {
+				ruleID += "/" + diagnostic.Rule
+			}
+			if !slices.Contains(ruleIDs, ruleID) {
+				ruleIDs = append(ruleIDs, ruleID)
+			}
+			findings = append(findings, Result{
+				RuleID:  ruleID,
+				Level:   level(diagnostic.Severity, result.WarnOnly),
+				Message: Message{Text: diagnostic.Message},
+				Locations: []Location{{PhysicalLocation: PhysicalLocation{
+					ArtifactLocation: ArtifactLocation{URI: diagnostic.File},
+					Region:           Region{StartLine: diagnostic.Line, StartColumn: diagnostic.Col},
+				}}},
+			})
+		}
+	}
+
+	slices.Sort(ruleIDs)
+	rules := make([]Rule, 0, len(ruleIDs))
+	for _, id := range ruleIDs {
+		rules = append(rules, Rule{ID: id})
+	}
+	if findings == nil {
+		findings = []Result{}
+	}
+
+	return Log{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []Run{{
+			Tool: Tool{Driver: Driver{
+				Name:           ToolName,
+				Version:        version,
+				InformationURI: "https://github.com/mrz1836/go-pre-commit",
+				Rules:          rules,
+			}},
+			Results: findings,
+		}},
+	}
+}
+
+// level maps a diagnostic severity to a SARIF level, capping the findings of
+// warn-only checks at warning
+func level(severity string, warnOnly bool) string {
+	switch {
+	case severity == output.SeverityInfo:
+		return "note"
+	case severity == output.SeverityWarning || warnOnly:
+		return "warning"
+	default:
+		return "error"
+	}
+}
+
+// Target identifies the analysis to upload and the credentials to use
+type Target struct {
+	APIURL     string
+	Token      string
+	Repository string // owner/name
+	CommitSHA  string
+	Ref        string
+}
+
+// TargetFromEnv reads the upload target from the environment GitHub Actions
+// sets: GITHUB_TOKEN, GITHUB_REPOSITORY, GITHUB_SHA, and GITHUB_REF.
+// GITHUB_API_URL overrides the API endpoint for GitHub Enterprise.
+func TargetFromEnv(getenv func(string) string) (Target, error) {
+	target := Target{
+		APIURL:     strings.TrimSuffix(getenv("GITHUB_API_URL"), "/"),
+		Token:      getenv("GITHUB_TOKEN"),
+		Repository: getenv("GITHUB_REPOSITORY"),
+		CommitSHA:  getenv("GITHUB_SHA"),
+		Ref:        getenv("GITHUB_REF"),
+	}
+	if target.APIURL == "" {
+		target.APIURL = defaultAPIURL
+	}
+	if target.Token == "" || !strings.Contains(target.Repository, "/") || target.CommitSHA == "" || target.Ref == "" {
+		return Target{}, ErrMissingTarget
+	}
+	return target, nil
+}
+
+// uploadRequest is the body of an upload analysis request
+type uploadRequest struct {
+	CommitSHA string `json:"commit_sha"`
+	Ref       string `json:"ref"`
+	SARIF     string `json:"sarif"`
+	ToolName  string `json:"tool_name"`
+}
+
+// Encode returns log gzipped and base64 encoded, as the code scanning API
+// expects it
+func Encode(log Log) (string, error) {
+	data, err := json.Marshal(log)
+	if err != nil {
+		return "", fmt.Errorf("encoding SARIF log: %w", err)
+	}
+
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	if _, err = writer.Write(data); err != nil {
+		return "", fmt.Errorf("compressing SARIF log: %w", err)
+	}
+	if err = writer.Close(); err != nil {
+		return "", fmt.Errorf("compressing SARIF log: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(compressed.Bytes()), nil
+}
+
+// Upload sends log to the code scanning API of the target repository,
+// giving up after DefaultTimeout. GitHub processes the analysis after
+// accepting it; processing errors are not reported here. A nil client uses
+// http.DefaultClient.
+func Upload(ctx context.Context, client *http.Client, target Target, log Log, version string) error {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	encoded, err := Encode(log)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(uploadRequest{CommitSHA: target.CommitSHA, Ref: target.Ref, SARIF: encoded, ToolName: ToolName})
+	if err != nil {
+		return fmt.Errorf("encoding upload request: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultTimeout)
+	defer cancel()
+
+	url := fmt.Sprintf("%s/repos/%s/code-scanning/sarifs", target.APIURL, target.Repository)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating upload request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+target.Token)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "go-pre-commit/"+version)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("uploading SARIF: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%w: %s", ErrUploadFailed, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package sarif
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/output"
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+)
+
+// envMap returns a getenv function backed by vars
+func envMap(vars map[string]string) func(string) string {
+	return func(key string) string { return vars[key] }
+}
+
+// decode reverses Encode
+func decode(t *testing.T, encoded string) Log {
+	t.Helper()
+	compressed, err := base64.StdEncoding.DecodeString(encoded)
+	require.NoError(t, err)
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	require.NoError(t, err)
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+
+	var log Log
+	require.NoError(t, json.Unmarshal(data, &log))
+	return log
+}
+
+func TestBuild(t *testing.T) {
+	results := &runner.Results{CheckResults: []runner.CheckResult{
+		{Name: "fumpt", Success: true, Output: "main.go:1:1: ignored because the check passed"},
+		{Name: "lint", Output: "internal/app/app.go:12:5: text form is ignored", Diagnostics: []output.Diagnostic{
+			{File: "internal/app/app.go", Line: 12, Col: 5, Severity: output.SeverityError, Rule: "ineffassign", Message: "ineffectual assignment to err"},
+			{File: "go.mod", Severity: output.SeverityError, Message: "no line to point at"},
+		}},
+		{Name: "global-vars", WarnOnly: true, Output: "internal/s/s.go:3: global variable registry is mutable"},
+		{Name: "vet", Error: "app.go:7: printf call has arguments but no formatting directives"},
+		{Name: "mod-tidy", Skipped: true, Output: "go.mod:1: skipped"},
+	}}
+
+	log := Build(results, "v1.2.3")
+	assert.Equal(t, "2.1.0", log.Version)
+	assert.Equal(t, sarifSchema, log.Schema)
+	require.Len(t, log.Runs, 1)
+
+	driver := log.Runs[0].Tool.Driver
+	assert.Equal(t, ToolName, driver.Name)
+	assert.Equal(t, "v1.2.3", driver.Version)
+	assert.Equal(t, []Rule{{ID: "global-vars"}, {ID: "lint/ineffassign"}, {ID: "vet"}}, driver.Rules)
+
+	location := func(uri string, line, col int) []Location {
+		return []Location{{PhysicalLocation: PhysicalLocation{ArtifactLocation: ArtifactLocation{URI: uri}, Region: Region{StartLine: line, StartColumn: col}}}}
+	}
+	assert.Equal(t, []Result{
+		{RuleID: "lint/ineffassign", Level: "error", Message: Message{Text: "ineffectual assignment to err"}, Locations: location("internal/app/app.go", 12, 5)},
+		{RuleID: "global-vars", Level: "warning", Message: Message{Text: "global variable registry is mutable"}, Locations: location("internal/s/s.go", 3, 0)},
+		{RuleID: "vet", Level: "error", Message: Message{Text: "printf call has arguments but no formatting directives"}, Locations: location("app.go", 7, 0)},
+	}, log.Runs[0].Results)
+}
+
+func TestBuild_NoFindings(t *testing.T) {
+	data, err := json.Marshal(Build(&runner.Results{CheckResults: []runner.CheckResult{{Name: "eof", Success: true}}}, "dev"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"results":[]`, "an empty result list clears earlier alerts")
+	assert.Contains(t, string(data), `"rules":[]`)
+}
+
+func TestLevel(t *testing.T) {
+	assert.Equal(t, "error", level(output.SeverityError, false))
+	assert.Equal(t, "warning", level(output.SeverityError, true))
+	assert.Equal(t, "warning", level(output.SeverityWarning, false))
+	assert.Equal(t, "note", level(output.SeverityInfo, true))
+}
+
+func TestTargetFromEnv(t *testing.T) {
+	target, err := TargetFromEnv(envMap(map[string]string{
+		"GITHUB_API_URL":    "https://ghe.example.com/api/v3/",
+		"GITHUB_TOKEN":      "secret",
+		"GITHUB_REPOSITORY": "example/project",
+		"GITHUB_SHA":        "abc123",
+		"GITHUB_REF":        "refs/heads/main",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, Target{APIURL: "https://ghe.example.com/api/v3", Token: "secret", Repository: "example/project", CommitSHA: "abc123", Ref: "refs/heads/main"}, target)
+
+	target, err = TargetFromEnv(envMap(map[string]string{
+		"GITHUB_TOKEN": "secret", "GITHUB_REPOSITORY": "example/project", "GITHUB_SHA": "abc123", "GITHUB_REF": "refs/pull/7/merge",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, defaultAPIURL, target.APIURL)
+
+	for name, vars := range map[string]map[string]string{
+		"no token":      {"GITHUB_REPOSITORY": "example/project", "GITHUB_SHA": "abc123", "GITHUB_REF": "refs/heads/main"},
+		"no repository": {"GITHUB_TOKEN": "secret", "GITHUB_SHA": "abc123", "GITHUB_REF": "refs/heads/main"},
+		"no commit":     {"GITHUB_TOKEN": "secret", "GITHUB_REPOSITORY": "example/project", "GITHUB_REF": "refs/heads/main"},
+		"no ref":        {"GITHUB_TOKEN": "secret", "GITHUB_REPOSITORY": "example/project", "GITHUB_SHA": "abc123"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := TargetFromEnv(envMap(vars))
+			require.ErrorIs(t, err, ErrMissingTarget)
+		})
+	}
+}
+
+func TestUpload(t *testing.T) {
+	var request *http.Request
+	var body uploadRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"id":"47177e22","url":"https://api.github.com/repos/example/project/code-scanning/sarifs/47177e22"}`))
+	}))
+	defer server.Close()
+
+	target := Target{APIURL: server.URL, Token: "secret", Repository: "example/project", CommitSHA: "abc123", Ref: "refs/heads/main"}
+	log := Build(&runner.Results{CheckResults: []runner.CheckResult{{Name: "vet", Error: "app.go:7: unreachable code"}}}, "v1.2.3")
+	require.NoError(t, Upload(context.Background(), nil, target, log, "v1.2.3"))
+
+	require.NotNil(t, request)
+	assert.Equal(t, http.MethodPost, request.Method)
+	assert.Equal(t, "/repos/example/project/code-scanning/sarifs", request.URL.Path)
+	assert.Equal(t, "Bearer secret", request.Header.Get("Authorization"))
+	assert.Equal(t, "application/vnd.github+json", request.Header.Get("Accept"))
+	assert.Equal(t, "go-pre-commit/v1.2.3", request.Header.Get("User-Agent"))
+
+	assert.Equal(t, "abc123", body.CommitSHA)
+	assert.Equal(t, "refs/heads/main", body.Ref)
+	assert.Equal(t, ToolName, body.ToolName)
+	assert.Equal(t, log, decode(t, body.SARIF))
+}
+
+func TestUpload_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	target := Target{APIURL: server.URL, Token: "secret", Repository: "example/project", CommitSHA: "abc123", Ref: "refs/heads/main"}
+	err := Upload(context.Background(), nil, target, Build(&runner.Results{}, "dev"), "dev")
+	require.ErrorIs(t, err, ErrUploadFailed)
+	assert.Contains(t, err.Error(), "403")
+}
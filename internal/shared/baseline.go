@@ -0,0 +1,19 @@
+package shared
+
+import "context"
+
+// lintBaselineWriteKey marks a context whose run regenerates the lint baseline
+type lintBaselineWriteKey struct{}
+
+// WithLintBaselineWrite returns a context asking the lint check to record
+// every issue it finds in the baseline file instead of failing on them
+func WithLintBaselineWrite(ctx context.Context) context.Context {
+	return context.WithValue(ctx, lintBaselineWriteKey{}, true)
+}
+
+// LintBaselineWriteRequested reports whether ctx asks for the lint baseline
+// to be regenerated
+func LintBaselineWriteRequested(ctx context.Context) bool {
+	write, _ := ctx.Value(lintBaselineWriteKey{}).(bool)
+	return write
+}
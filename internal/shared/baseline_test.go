@@ -0,0 +1,13 @@
+package shared
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLintBaselineWriteRequested(t *testing.T) {
+	assert.False(t, LintBaselineWriteRequested(context.Background()))
+	assert.True(t, LintBaselineWriteRequested(WithLintBaselineWrite(context.Background())))
+}
@@ -0,0 +1,56 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+)
+
+// commandLogKey is the context key for the running check's command log
+type commandLogKey struct{}
+
+// WithCommandLog returns a context carrying w, where a check records the
+// command line of every external tool it runs
+func WithCommandLog(ctx context.Context, w io.Writer) context.Context {
+	return context.WithValue(ctx, commandLogKey{}, w)
+}
+
+// workDirKey is the context key for the running check's working directory
+type workDirKey struct{}
+
+// WithWorkDir returns a context carrying dir, the directory the running
+// check's commands should run in
+func WithWorkDir(ctx context.Context, dir string) context.Context {
+	return context.WithValue(ctx, workDirKey{}, dir)
+}
+
+// WorkDir returns the working directory configured for the running check, or
+// "" when none is
+func WorkDir(ctx context.Context) string {
+	dir, _ := ctx.Value(workDirKey{}).(string)
+	return dir
+}
+
+// ApplyWorkDir points cmd at the working directory configured for the running
+// check, leaving cmd.Dir unchanged when none is
+func ApplyWorkDir(ctx context.Context, cmd *exec.Cmd) {
+	if dir := WorkDir(ctx); dir != "" {
+		cmd.Dir = dir
+	}
+}
+
+// LogCommand records cmd's command line, and its directory when set, in the
+// command log of ctx. Without a log in the context it does nothing.
+func LogCommand(ctx context.Context, cmd *exec.Cmd) {
+	w, ok := ctx.Value(commandLogKey{}).(io.Writer)
+	if !ok || w == nil {
+		return
+	}
+	line := strings.Join(cmd.Args, " ")
+	if cmd.Dir != "" {
+		line = fmt.Sprintf("(cd %s && %s)", cmd.Dir, line)
+	}
+	_, _ = fmt.Fprintln(w, line)
+}
@@ -0,0 +1,39 @@
+package shared
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogCommand(t *testing.T) {
+	cmd := exec.CommandContext(context.Background(), "go", "mod", "tidy")
+
+	// Without a log the command is not recorded anywhere
+	LogCommand(context.Background(), cmd)
+
+	var buf bytes.Buffer
+	ctx := WithCommandLog(context.Background(), &buf)
+	LogCommand(ctx, cmd)
+	cmd.Dir = "/repo/sub"
+	LogCommand(ctx, cmd)
+	assert.Equal(t, "go mod tidy\n(cd /repo/sub && go mod tidy)\n", buf.String())
+}
+
+func TestApplyWorkDir(t *testing.T) {
+	cmd := exec.CommandContext(context.Background(), "npm", "run", "build")
+	cmd.Dir = "/repo"
+
+	// Without a configured directory the command keeps its own
+	ApplyWorkDir(context.Background(), cmd)
+	assert.Equal(t, "/repo", cmd.Dir)
+	assert.Empty(t, WorkDir(context.Background()))
+
+	ctx := WithWorkDir(context.Background(), "/repo/web")
+	assert.Equal(t, "/repo/web", WorkDir(ctx))
+	ApplyWorkDir(ctx, cmd)
+	assert.Equal(t, "/repo/web", cmd.Dir)
+}
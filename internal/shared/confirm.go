@@ -0,0 +1,33 @@
+package shared
+
+import "context"
+
+// FixConfirmFunc decides whether an auto-fix may be applied to a file
+type FixConfirmFunc func(checkName, file string) bool
+
+// fixConfirmKey is the context key for the active FixConfirmFunc
+type fixConfirmKey struct{}
+
+// WithFixConfirm returns a context carrying confirm, which auto-fixing checks
+// consult before rewriting a file
+func WithFixConfirm(ctx context.Context, confirm FixConfirmFunc) context.Context {
+	return context.WithValue(ctx, fixConfirmKey{}, confirm)
+}
+
+// ConfirmsFixes reports whether ctx carries a confirmer, so a check that
+// fixes many files with one command knows to list them for confirmation first
+func ConfirmsFixes(ctx context.Context) bool {
+	confirm, ok := ctx.Value(fixConfirmKey{}).(FixConfirmFunc)
+	return ok && confirm != nil
+}
+
+// ConfirmFix reports whether the fix for file may be applied. Without a
+// confirmer in the context every fix is allowed, preserving the default
+// non-interactive behavior.
+func ConfirmFix(ctx context.Context, checkName, file string) bool {
+	confirm, ok := ctx.Value(fixConfirmKey{}).(FixConfirmFunc)
+	if !ok || confirm == nil {
+		return true
+	}
+	return confirm(checkName, file)
+}
@@ -0,0 +1,26 @@
+package shared
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfirmFix_NoConfirmerAllowsFix(t *testing.T) {
+	assert.True(t, ConfirmFix(context.Background(), "eof", "main.go"))
+	assert.False(t, ConfirmsFixes(context.Background()))
+}
+
+func TestConfirmFix_UsesConfirmer(t *testing.T) {
+	var gotCheck, gotFile string
+	ctx := WithFixConfirm(context.Background(), func(checkName, file string) bool {
+		gotCheck, gotFile = checkName, file
+		return false
+	})
+
+	assert.True(t, ConfirmsFixes(ctx))
+	assert.False(t, ConfirmFix(ctx, "whitespace", "README.md"))
+	assert.Equal(t, "whitespace", gotCheck)
+	assert.Equal(t, "README.md", gotFile)
+}
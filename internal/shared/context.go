@@ -3,8 +3,6 @@ package shared
 
 import (
 	"context"
-	"os/exec"
-	"strings"
 	"sync"
 	"time"
 )
@@ -14,6 +12,22 @@ type Context struct {
 	repoRoot     string
 	repoRootOnce sync.Once
 	repoRootErr  error
+
+	installMu sync.Mutex
+	installs  map[string]*toolInstall
+
+	tempMu   sync.Mutex
+	tempRoot string
+	tempDirs []string
+
+	packagesMu sync.Mutex
+	packages   map[string]*packagesEntry
+}
+
+// toolInstall tracks the in-flight installations of a single tool
+type toolInstall struct {
+	active int
+	done   chan struct{}
 }
 
 // NewContext creates a new shared context for checks
@@ -21,21 +35,80 @@ func NewContext() *Context {
 	return &Context{}
 }
 
-// GetRepoRoot returns the repository root, caching the result
+// GetRepoRoot returns the repository root, caching the result.
+// GO_PRE_COMMIT_REPO_ROOT takes precedence over git.
 func (sc *Context) GetRepoRoot(ctx context.Context) (string, error) {
 	sc.repoRootOnce.Do(func() {
+		if root, err := RepoRootOverride(); err != nil || root != "" {
+			sc.repoRoot, sc.repoRootErr = root, err
+			return
+		}
+
 		// Add timeout for git command
 		timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
 		defer cancel()
 
-		cmd := exec.CommandContext(timeoutCtx, "git", "rev-parse", "--show-toplevel")
-		output, err := cmd.Output()
-		if err != nil {
-			sc.repoRootErr = err
-			return
-		}
-		sc.repoRoot = strings.TrimSpace(string(output))
+		sc.repoRoot, sc.repoRootErr = GitRepoRoot(timeoutCtx)
 	})
 
 	return sc.repoRoot, sc.repoRootErr
 }
+
+// SetRepoRoot fixes the root GetRepoRoot returns, for checks run over a copy
+// of the repository. It has no effect once the root has been resolved.
+func (sc *Context) SetRepoRoot(root string) {
+	sc.repoRootOnce.Do(func() {
+		sc.repoRoot = root
+	})
+}
+
+// BeginInstall records that tool is being installed so concurrent checks can
+// wait for it instead of failing. The returned function marks the install as
+// finished and must be called exactly once.
+func (sc *Context) BeginInstall(tool string) func() {
+	sc.installMu.Lock()
+	defer sc.installMu.Unlock()
+
+	if sc.installs == nil {
+		sc.installs = make(map[string]*toolInstall)
+	}
+	install, ok := sc.installs[tool]
+	if !ok {
+		install = &toolInstall{done: make(chan struct{})}
+		sc.installs[tool] = install
+	}
+	install.active++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			sc.installMu.Lock()
+			defer sc.installMu.Unlock()
+
+			install.active--
+			if install.active == 0 {
+				close(install.done)
+				delete(sc.installs, tool)
+			}
+		})
+	}
+}
+
+// WaitForInstall blocks until no installation of tool is in progress.
+// It reports whether an installation was in progress when called.
+func (sc *Context) WaitForInstall(ctx context.Context, tool string) (bool, error) {
+	sc.installMu.Lock()
+	install, ok := sc.installs[tool]
+	sc.installMu.Unlock()
+
+	if !ok {
+		return false, nil
+	}
+
+	select {
+	case <-install.done:
+		return true, nil
+	case <-ctx.Done():
+		return true, ctx.Err()
+	}
+}
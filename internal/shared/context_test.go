@@ -332,3 +332,49 @@ func BenchmarkGetRepoRootConcurrent(b *testing.B) {
 		}
 	})
 }
+
+func TestInstallTracking(t *testing.T) {
+	sc := NewContext()
+	ctx := context.Background()
+
+	waited, err := sc.WaitForInstall(ctx, "gofumpt")
+	require.NoError(t, err)
+	assert.False(t, waited, "no install in progress")
+
+	first := sc.BeginInstall("gofumpt")
+	second := sc.BeginInstall("gofumpt")
+
+	result := make(chan bool)
+	go func() {
+		w, _ := sc.WaitForInstall(ctx, "gofumpt")
+		result <- w
+	}()
+
+	first()
+	first() // calling done twice is harmless
+	select {
+	case <-result:
+		t.Fatal("wait returned while an install was still running")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	second()
+	assert.True(t, <-result)
+
+	waited, err = sc.WaitForInstall(ctx, "gofumpt")
+	require.NoError(t, err)
+	assert.False(t, waited)
+}
+
+func TestWaitForInstallContextCanceled(t *testing.T) {
+	sc := NewContext()
+	done := sc.BeginInstall("golangci-lint")
+	defer done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	waited, err := sc.WaitForInstall(ctx, "golangci-lint")
+	assert.True(t, waited)
+	require.ErrorIs(t, err, context.Canceled)
+}
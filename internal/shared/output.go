@@ -0,0 +1,26 @@
+package shared
+
+import (
+	"context"
+	"io"
+)
+
+// checkOutputKey is the context key for the running check's output writer
+type checkOutputKey struct{}
+
+// WithCheckOutput returns a context carrying w, where a check writes
+// informational output it wants shown alongside its result
+func WithCheckOutput(ctx context.Context, w io.Writer) context.Context {
+	return context.WithValue(ctx, checkOutputKey{}, w)
+}
+
+// CheckOutput returns the output writer for the running check. Checks must
+// not write to stdout directly, since concurrent checks would interleave;
+// without a writer in the context the output is discarded.
+func CheckOutput(ctx context.Context) io.Writer {
+	w, ok := ctx.Value(checkOutputKey{}).(io.Writer)
+	if !ok || w == nil {
+		return io.Discard
+	}
+	return w
+}
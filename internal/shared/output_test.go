@@ -0,0 +1,18 @@
+package shared
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckOutput(t *testing.T) {
+	assert.Equal(t, io.Discard, CheckOutput(context.Background()))
+
+	var buf bytes.Buffer
+	_, _ = io.WriteString(CheckOutput(WithCheckOutput(context.Background(), &buf)), "hello")
+	assert.Equal(t, "hello", buf.String())
+}
@@ -0,0 +1,150 @@
+package shared
+
+import (
+	"bufio"
+	"bytes"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ChangedPackage is a Go package containing changed files
+type ChangedPackage struct {
+	Dir        string   // directory of the changed files, as they were given
+	ImportPath string   // empty when the directory is outside any Go module
+	ModuleRoot string   // absolute directory holding the package's go.mod; empty outside modules
+	Files      []string // changed .go files in the package, as given
+}
+
+// packagesEntry caches the packages computed for one file set
+type packagesEntry struct {
+	once     sync.Once
+	packages []ChangedPackage
+}
+
+// ChangedPackages maps the .go files among files to the packages containing
+// them, sorted by directory. Relative paths are resolved against repoRoot.
+// The mapping is computed once per file set and shared by every check that
+// asks for it during the run, so callers must not modify the result. It is
+// safe for concurrent use; a nil Context computes without caching.
+func (sc *Context) ChangedPackages(repoRoot string, files []string) []ChangedPackage {
+	var goFiles []string
+	for _, file := range files {
+		if strings.HasSuffix(file, ".go") {
+			goFiles = append(goFiles, file)
+		}
+	}
+	if len(goFiles) == 0 {
+		return nil
+	}
+	if sc == nil {
+		return computeChangedPackages(repoRoot, goFiles)
+	}
+
+	key := repoRoot + "\x00" + strings.Join(slices.Sorted(slices.Values(goFiles)), "\x00")
+	sc.packagesMu.Lock()
+	if sc.packages == nil {
+		sc.packages = make(map[string]*packagesEntry)
+	}
+	entry, ok := sc.packages[key]
+	if !ok {
+		entry = &packagesEntry{}
+		sc.packages[key] = entry
+	}
+	sc.packagesMu.Unlock()
+
+	entry.once.Do(func() {
+		entry.packages = computeChangedPackages(repoRoot, goFiles)
+	})
+	return entry.packages
+}
+
+// computeChangedPackages groups files by directory and resolves each
+// directory's import path from the nearest go.mod
+func computeChangedPackages(repoRoot string, files []string) []ChangedPackage {
+	byDir := make(map[string]*ChangedPackage)
+	modulePaths := make(map[string]string) // module root -> module path
+	for _, file := range files {
+		dir := filepath.Dir(file)
+		pkg, ok := byDir[dir]
+		if !ok {
+			pkg = &ChangedPackage{Dir: dir}
+			absDir := dir
+			if !filepath.IsAbs(absDir) && repoRoot != "" {
+				absDir = filepath.Join(repoRoot, absDir)
+			}
+			pkg.ModuleRoot = findModuleRoot(absDir, repoRoot)
+			if pkg.ModuleRoot != "" {
+				modulePath, cached := modulePaths[pkg.ModuleRoot]
+				if !cached {
+					modulePath, _ = ReadModulePath(filepath.Join(pkg.ModuleRoot, "go.mod"))
+					modulePaths[pkg.ModuleRoot] = modulePath
+				}
+				pkg.ImportPath = importPath(modulePath, pkg.ModuleRoot, absDir)
+			}
+			byDir[dir] = pkg
+		}
+		pkg.Files = append(pkg.Files, file)
+	}
+
+	packages := make([]ChangedPackage, 0, len(byDir))
+	for _, dir := range slices.Sorted(maps.Keys(byDir)) {
+		packages = append(packages, *byDir[dir])
+	}
+	return packages
+}
+
+// findModuleRoot walks up from dir to the nearest directory with a go.mod,
+// staying within repoRoot, and returns "" when there is none
+func findModuleRoot(dir, repoRoot string) string {
+	for current := dir; ; {
+		if _, err := os.Stat(filepath.Join(current, "go.mod")); err == nil {
+			return current
+		}
+		parent := filepath.Dir(current)
+		if parent == current || !strings.HasPrefix(parent, repoRoot) {
+			return ""
+		}
+		current = parent
+	}
+}
+
+// importPath joins modulePath with dir's location inside moduleRoot
+func importPath(modulePath, moduleRoot, dir string) string {
+	if modulePath == "" {
+		return ""
+	}
+	rel, err := filepath.Rel(moduleRoot, dir)
+	if err != nil || rel == "." {
+		return modulePath
+	}
+	return path.Join(modulePath, filepath.ToSlash(rel))
+}
+
+// ReadModulePath returns the module path declared in the go.mod file at
+// goModPath, or "" when the file has no module directive
+func ReadModulePath(goModPath string) (string, error) {
+	content, err := os.ReadFile(goModPath) //nolint:gosec // path comes from the checked file list
+	if err != nil {
+		return "", err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "//")
+		fields := strings.Fields(line)
+		if len(fields) != 2 || fields[0] != "module" {
+			continue
+		}
+		if unquoted, unquoteErr := strconv.Unquote(fields[1]); unquoteErr == nil {
+			return unquoted, nil
+		}
+		return fields[1], nil
+	}
+	return "", scanner.Err()
+}
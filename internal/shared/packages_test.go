@@ -0,0 +1,149 @@
+package shared
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writePackagesRepo creates a repository with a root module, a nested module,
+// and a directory outside both
+func writePackagesRepo(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	files := map[string]string{
+		"go.mod":                  "module github.com/acme/app\n\ngo 1.22\n",
+		"main.go":                 "package main\n",
+		"internal/store/store.go": "package store\n",
+		"internal/store/sql.go":   "package store\n",
+		"tools/go.mod":            "module \"github.com/acme/app/tools\" // separate module\n",
+		"tools/gen/gen.go":        "package gen\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+	return root
+}
+
+func TestChangedPackages(t *testing.T) {
+	root := writePackagesRepo(t)
+	sc := NewContext()
+
+	packages := sc.ChangedPackages(root, []string{
+		"internal/store/store.go",
+		"tools/gen/gen.go",
+		"main.go",
+		"README.md",
+		"internal/store/sql.go",
+	})
+
+	assert.Equal(t, []ChangedPackage{
+		{Dir: ".", ImportPath: "github.com/acme/app", ModuleRoot: root, Files: []string{"main.go"}},
+		{
+			Dir:        "internal/store",
+			ImportPath: "github.com/acme/app/internal/store",
+			ModuleRoot: root,
+			Files:      []string{"internal/store/store.go", "internal/store/sql.go"},
+		},
+		{
+			Dir:        "tools/gen",
+			ImportPath: "github.com/acme/app/tools/gen",
+			ModuleRoot: filepath.Join(root, "tools"),
+			Files:      []string{"tools/gen/gen.go"},
+		},
+	}, packages)
+
+	assert.Nil(t, sc.ChangedPackages(root, []string{"README.md"}), "no Go files")
+}
+
+func TestChangedPackages_OutsideModule(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "scripts"), 0o750))
+
+	packages := NewContext().ChangedPackages(root, []string{"scripts/run.go"})
+	assert.Equal(t, []ChangedPackage{{Dir: "scripts", Files: []string{"scripts/run.go"}}}, packages)
+}
+
+func TestChangedPackages_ComputedOnce(t *testing.T) {
+	root := writePackagesRepo(t)
+	sc := NewContext()
+
+	first := sc.ChangedPackages(root, []string{"main.go", "tools/gen/gen.go"})
+	require.Len(t, first, 2)
+
+	// Later checks read the cached mapping, even in a different order and
+	// with non-Go files mixed in, rather than going back to the go.mod files
+	require.NoError(t, os.Remove(filepath.Join(root, "tools", "go.mod")))
+	second := sc.ChangedPackages(root, []string{"tools/gen/gen.go", "go.sum", "main.go"})
+	assert.Equal(t, first, second)
+	assert.Equal(t, "github.com/acme/app/tools/gen", second[1].ImportPath)
+
+	// A different file set is computed afresh
+	other := sc.ChangedPackages(root, []string{"tools/gen/gen.go"})
+	assert.Equal(t, "github.com/acme/app/tools/gen", other[0].ImportPath)
+	assert.Equal(t, root, other[0].ModuleRoot, "now part of the root module")
+}
+
+func TestChangedPackages_Concurrent(t *testing.T) {
+	root := writePackagesRepo(t)
+	sc := NewContext()
+	files := []string{"main.go", "internal/store/store.go"}
+
+	results := make([][]ChangedPackage, 20)
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = sc.ChangedPackages(root, files)
+		}()
+	}
+	wg.Wait()
+
+	for _, packages := range results {
+		assert.Equal(t, results[0], packages)
+	}
+	assert.Len(t, sc.packages, 1)
+}
+
+func TestChangedPackages_NilContext(t *testing.T) {
+	root := writePackagesRepo(t)
+
+	var sc *Context
+	packages := sc.ChangedPackages(root, []string{filepath.Join(root, "internal", "store", "sql.go")})
+	require.Len(t, packages, 1)
+	assert.Equal(t, "github.com/acme/app/internal/store", packages[0].ImportPath)
+}
+
+func TestReadModulePath(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{name: "plain", content: "module github.com/acme/app\n\ngo 1.22\n", want: "github.com/acme/app"},
+		{name: "quoted", content: "module \"github.com/acme/app\"\n", want: "github.com/acme/app"},
+		{name: "with comment", content: "// Deprecated: use v2\nmodule github.com/acme/app // legacy\n", want: "github.com/acme/app"},
+		{name: "absent", content: "go 1.22\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "go.mod")
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+
+			got, err := ReadModulePath(path)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := ReadModulePath(filepath.Join(t.TempDir(), "go.mod"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
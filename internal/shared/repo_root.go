@@ -0,0 +1,156 @@
+package shared
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+// RepoRootEnv overrides git-based repository root detection; the --repo-root
+// flag sets it for the rest of the process
+const RepoRootEnv = "GO_PRE_COMMIT_REPO_ROOT"
+
+// RepoRootOverride returns the absolute repository root set with
+// GO_PRE_COMMIT_REPO_ROOT, or "" when it is unset. It fails when the path is
+// not an existing directory, so checkouts git cannot place (unusual worktrees,
+// submodules, exported trees without .git) still get a root.
+func RepoRootOverride() (string, error) {
+	root := os.Getenv(RepoRootEnv)
+	if root == "" {
+		return "", nil
+	}
+
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s=%q: %w", prerrors.ErrInvalidRepoRoot, RepoRootEnv, root, err)
+	}
+	info, err := os.Stat(abs)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s=%q: %w", prerrors.ErrInvalidRepoRoot, RepoRootEnv, root, err)
+	}
+	if !info.IsDir() {
+		return "", fmt.Errorf("%w: %s=%q", prerrors.ErrInvalidRepoRoot, RepoRootEnv, root)
+	}
+	return realPath(abs), nil
+}
+
+// GitRepoRoot returns the root of the worktree holding the working directory.
+// It asks git first and falls back to walking up to the nearest .git entry,
+// so a linked worktree is found even when git cannot run. Symlinks are
+// resolved, making the root comparable with resolved file paths.
+func GitRepoRoot(ctx context.Context) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "--show-toplevel")
+	output, err := cmd.Output()
+	if err == nil {
+		if root := strings.TrimSpace(string(output)); root != "" {
+			return realPath(root), nil
+		}
+		err = prerrors.ErrRepositoryRootNotFound
+	}
+	if ctx.Err() != nil {
+		return "", err
+	}
+
+	cwd, cwdErr := os.Getwd()
+	if cwdErr != nil {
+		return "", err
+	}
+	root, walkErr := FindWorktreeRoot(cwd)
+	if walkErr != nil {
+		return "", err
+	}
+	return root, nil
+}
+
+// FindWorktreeRoot walks up from dir, after resolving symlinks, to the first
+// directory holding .git: a directory in a regular checkout, or a file with
+// a "gitdir:" redirect in a linked worktree or submodule
+func FindWorktreeRoot(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for current := realPath(abs); ; {
+		if _, gitErr := ResolveGitDir(current); gitErr == nil {
+			return current, nil
+		}
+		parent := filepath.Dir(current)
+		if parent == current {
+			return "", fmt.Errorf("%w: no .git above %s", prerrors.ErrRepositoryRootNotFound, dir)
+		}
+		current = parent
+	}
+}
+
+// ResolveGitDir returns the git directory of the worktree at root. In a
+// linked worktree or submodule .git is a file whose "gitdir:" line points at
+// the real git directory, relative to root unless absolute. Either way the
+// git directory must hold a HEAD file.
+func ResolveGitDir(root string) (string, error) {
+	dotGit := filepath.Join(root, ".git")
+	info, err := os.Stat(dotGit)
+	if err != nil {
+		return "", err
+	}
+	if info.IsDir() {
+		if _, headErr := os.Stat(filepath.Join(dotGit, "HEAD")); headErr != nil {
+			return "", fmt.Errorf("%w: %s: %w", prerrors.ErrNotGitRepository, dotGit, headErr)
+		}
+		return dotGit, nil
+	}
+
+	content, err := os.ReadFile(dotGit) //nolint:gosec // .git file of the repository
+	if err != nil {
+		return "", err
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		target, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "gitdir:")
+		if !ok {
+			continue
+		}
+		target = filepath.FromSlash(strings.TrimSpace(target))
+		if !filepath.IsAbs(target) {
+			target = filepath.Join(root, target)
+		}
+		if _, statErr := os.Stat(filepath.Join(target, "HEAD")); statErr != nil {
+			return "", fmt.Errorf("%w: %s points to %s: %w", prerrors.ErrNotGitRepository, dotGit, target, statErr)
+		}
+		return realPath(target), nil
+	}
+	return "", fmt.Errorf("%w: %s has no gitdir line", prerrors.ErrNotGitRepository, dotGit)
+}
+
+// CommonGitDir returns the directory shared by all worktrees of the
+// repository, where hooks and config live. A linked worktree's git directory
+// names it in its commondir file; otherwise gitDir is the common directory.
+func CommonGitDir(gitDir string) string {
+	content, err := os.ReadFile(filepath.Join(gitDir, "commondir")) //nolint:gosec // file inside the git directory
+	if err != nil {
+		return gitDir
+	}
+	common := filepath.FromSlash(strings.TrimSpace(string(content)))
+	if common == "" {
+		return gitDir
+	}
+	if !filepath.IsAbs(common) {
+		common = filepath.Join(gitDir, common)
+	}
+	return realPath(common)
+}
+
+// realPath resolves symlinks in path, returning it cleaned but unresolved
+// when that fails
+func realPath(path string) string {
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		return resolved
+	}
+	return filepath.Clean(path)
+}
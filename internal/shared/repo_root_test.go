@@ -0,0 +1,184 @@
+package shared
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	prerrors "github.com/mrz1836/go-pre-commit/internal/errors"
+)
+
+func TestRepoRootOverride(t *testing.T) {
+	t.Run("unset", func(t *testing.T) {
+		t.Setenv(RepoRootEnv, "")
+		root, err := RepoRootOverride()
+		require.NoError(t, err)
+		assert.Empty(t, root)
+	})
+
+	t.Run("relative directory is made absolute", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "checkout"), 0o750))
+		t.Chdir(dir)
+		t.Setenv(RepoRootEnv, "checkout")
+
+		root, err := RepoRootOverride()
+		require.NoError(t, err)
+		assert.True(t, filepath.IsAbs(root))
+		assert.Equal(t, "checkout", filepath.Base(root))
+	})
+
+	t.Run("missing directory", func(t *testing.T) {
+		t.Setenv(RepoRootEnv, filepath.Join(t.TempDir(), "missing"))
+		_, err := RepoRootOverride()
+		require.ErrorIs(t, err, prerrors.ErrInvalidRepoRoot)
+		assert.Contains(t, err.Error(), RepoRootEnv)
+	})
+
+	t.Run("file", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "file.txt")
+		require.NoError(t, os.WriteFile(file, []byte("x\n"), 0o600))
+		t.Setenv(RepoRootEnv, file)
+		_, err := RepoRootOverride()
+		require.ErrorIs(t, err, prerrors.ErrInvalidRepoRoot)
+	})
+}
+
+func TestContextGetRepoRootOverride(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(t.TempDir())
+	t.Setenv(RepoRootEnv, dir)
+
+	root, err := NewContext().GetRepoRoot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, dir, root)
+}
+
+// makeWorktreeLayout lays out a main checkout and a linked worktree the way
+// git worktree add does, without running git: the worktree's .git is a file
+// redirecting to main/.git/worktrees/wt, whose commondir points back at
+// main/.git
+func makeWorktreeLayout(t *testing.T) (mainRoot, worktreeRoot string) {
+	t.Helper()
+
+	base := t.TempDir()
+	mainRoot = filepath.Join(base, "main")
+	worktreeRoot = filepath.Join(base, "wt")
+	gitDir := filepath.Join(mainRoot, ".git", "worktrees", "wt")
+	require.NoError(t, os.MkdirAll(gitDir, 0o750))
+	require.NoError(t, os.MkdirAll(filepath.Join(worktreeRoot, "pkg", "sub"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(gitDir, "commondir"), []byte("../..\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(gitDir, "HEAD"), []byte("ref: refs/heads/wt\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(mainRoot, ".git", "HEAD"), []byte("ref: refs/heads/main\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(worktreeRoot, ".git"), []byte("gitdir: ../main/.git/worktrees/wt\n"), 0o600))
+	return mainRoot, worktreeRoot
+}
+
+func TestFindWorktreeRoot(t *testing.T) {
+	mainRoot, worktreeRoot := makeWorktreeLayout(t)
+
+	t.Run("regular checkout", func(t *testing.T) {
+		root, err := FindWorktreeRoot(filepath.Join(mainRoot, ".git", "worktrees"))
+		require.NoError(t, err)
+		assert.Equal(t, mainRoot, root)
+	})
+
+	t.Run("linked worktree with a .git file", func(t *testing.T) {
+		root, err := FindWorktreeRoot(filepath.Join(worktreeRoot, "pkg", "sub"))
+		require.NoError(t, err)
+		assert.Equal(t, worktreeRoot, root, "the worktree, not the main checkout")
+	})
+
+	t.Run("symlinked working directory", func(t *testing.T) {
+		link := filepath.Join(t.TempDir(), "link")
+		require.NoError(t, os.Symlink(filepath.Join(worktreeRoot, "pkg"), link))
+
+		root, err := FindWorktreeRoot(filepath.Join(link, "sub"))
+		require.NoError(t, err)
+		assert.Equal(t, worktreeRoot, root, "resolved to the real worktree root")
+	})
+
+	t.Run("broken gitdir redirect", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, ".git"), []byte("gitdir: missing\n"), 0o600))
+		_, err := ResolveGitDir(dir)
+		require.ErrorIs(t, err, prerrors.ErrNotGitRepository)
+	})
+
+	t.Run(".git directory without HEAD", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.Mkdir(filepath.Join(dir, ".git"), 0o750))
+		_, err := ResolveGitDir(dir)
+		require.ErrorIs(t, err, prerrors.ErrNotGitRepository)
+	})
+
+	t.Run("outside any repository", func(t *testing.T) {
+		_, err := FindWorktreeRoot(t.TempDir())
+		require.ErrorIs(t, err, prerrors.ErrRepositoryRootNotFound)
+	})
+}
+
+func TestResolveGitDir(t *testing.T) {
+	mainRoot, worktreeRoot := makeWorktreeLayout(t)
+
+	gitDir, err := ResolveGitDir(mainRoot)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(mainRoot, ".git"), gitDir)
+	assert.Equal(t, gitDir, CommonGitDir(gitDir))
+
+	gitDir, err = ResolveGitDir(worktreeRoot)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(mainRoot, ".git", "worktrees", "wt"), gitDir)
+	assert.Equal(t, filepath.Join(mainRoot, ".git"), CommonGitDir(gitDir))
+}
+
+func TestContextGetRepoRootWorktree(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	t.Setenv(RepoRootEnv, "")
+
+	base := t.TempDir()
+	mainRoot := filepath.Join(base, "main")
+	worktreeRoot := filepath.Join(base, "wt")
+	runGit := func(dir string, args ...string) {
+		cmd := exec.CommandContext(context.Background(), "git", args...) //nolint:gosec // test code with controlled input
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	require.NoError(t, os.MkdirAll(mainRoot, 0o750))
+	runGit(mainRoot, "init", "-q")
+	runGit(mainRoot, "-c", "user.email=test@example.com", "-c", "user.name=Test", "commit", "-q", "--allow-empty", "-m", "initial")
+	runGit(mainRoot, "worktree", "add", "-q", worktreeRoot)
+	require.NoError(t, os.MkdirAll(filepath.Join(worktreeRoot, "pkg"), 0o750))
+
+	// Enter the worktree through a symlink, as a symlinked home or
+	// workspace directory would
+	link := filepath.Join(t.TempDir(), "link")
+	require.NoError(t, os.Symlink(worktreeRoot, link))
+	t.Chdir(filepath.Join(link, "pkg"))
+
+	want, err := filepath.EvalSymlinks(worktreeRoot)
+	require.NoError(t, err)
+
+	root, err := NewContext().GetRepoRoot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, want, root)
+}
+
+func TestGitRepoRootFallsBackToWalking(t *testing.T) {
+	// The simulated layout has no HEAD or objects, so git rejects it and the
+	// root comes from the .git file
+	_, worktreeRoot := makeWorktreeLayout(t)
+	t.Chdir(filepath.Join(worktreeRoot, "pkg", "sub"))
+
+	root, err := GitRepoRoot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, worktreeRoot, root)
+}
@@ -0,0 +1,162 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// ErrSnapshotNotRegular is returned when a snapshot is asked to copy
+// something other than a regular file
+var ErrSnapshotNotRegular = errors.New("snapshot only copies regular files")
+
+// Snapshot holds copies of files taken before a check rewrites them in
+// place, so a check that fails or is canceled part way can put every file
+// back rather than leave some of its changes applied. The copies live in a
+// temporary workspace until Discard.
+type Snapshot struct {
+	sc    *Context
+	dir   string
+	files []snapshotFile
+}
+
+// snapshotFile is one file a Snapshot restores
+type snapshotFile struct {
+	path string      // the file as given
+	copy string      // its copy in the workspace; empty when it did not exist
+	mode fs.FileMode // its permissions when copied
+}
+
+// TakeSnapshot copies each of paths that exists into a temporary workspace.
+// Paths that do not exist are recorded too, and Restore removes them if the
+// check creates them. A nil Context takes an untracked snapshot.
+func (sc *Context) TakeSnapshot(paths ...string) (*Snapshot, error) {
+	dir, err := sc.TempWorkspace("snapshot")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Snapshot{sc: sc, dir: dir}
+	for i, path := range paths {
+		file, err := snapshotCopy(path, filepath.Join(dir, strconv.Itoa(i)))
+		if err != nil {
+			_ = s.Discard()
+			return nil, err
+		}
+		s.files = append(s.files, file)
+	}
+	return s, nil
+}
+
+// Restore puts every file back as it was when the snapshot was taken. Each
+// file is replaced with a rename, so a reader sees either the check's version
+// or the original. It keeps going past a file it cannot restore and returns
+// all the errors.
+func (s *Snapshot) Restore() error {
+	var errs []error
+	for _, file := range s.files {
+		if file.copy == "" {
+			if err := os.Remove(file.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				errs = append(errs, fmt.Errorf("failed to remove %s: %w", file.path, err))
+			}
+			continue
+		}
+		if err := replaceFile(file.copy, file.path, file.mode); err != nil {
+			errs = append(errs, fmt.Errorf("failed to restore %s: %w", file.path, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Discard removes the copies, keeping the files as the check left them
+func (s *Snapshot) Discard() error {
+	return s.sc.RemoveTempWorkspace(s.dir)
+}
+
+// Transact snapshots paths, runs fn, and restores the files when fn returns
+// an error or ctx is done by then, so fn's changes are applied completely or
+// not at all. It returns fn's error, or ctx's when fn ignored the
+// cancellation, joined with any error restoring the files.
+func (sc *Context) Transact(ctx context.Context, paths []string, fn func() error) error {
+	snapshot, err := sc.TakeSnapshot(paths...)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = snapshot.Discard() }()
+
+	err = fn()
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		return errors.Join(err, snapshot.Restore())
+	}
+	return nil
+}
+
+// snapshotCopy copies path to dst, returning an entry with no copy when path
+// does not exist
+func snapshotCopy(path, dst string) (snapshotFile, error) {
+	info, err := os.Lstat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return snapshotFile{path: path}, nil
+	}
+	if err != nil {
+		return snapshotFile{}, fmt.Errorf("failed to snapshot %s: %w", path, err)
+	}
+	if !info.Mode().IsRegular() {
+		return snapshotFile{}, fmt.Errorf("%w: %s", ErrSnapshotNotRegular, path)
+	}
+	if err := copyFileContents(path, dst); err != nil {
+		return snapshotFile{}, fmt.Errorf("failed to snapshot %s: %w", path, err)
+	}
+	return snapshotFile{path: path, copy: dst, mode: info.Mode().Perm()}, nil
+}
+
+// replaceFile copies src next to dst and renames the copy over dst
+func replaceFile(src, dst string, mode fs.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(dst), "."+filepath.Base(dst)+".restore-")
+	if err != nil {
+		return err
+	}
+	tmpName := tmp.Name()
+	_ = tmp.Close()
+
+	if err := copyFileContents(src, tmpName); err != nil {
+		_ = os.Remove(tmpName)
+		return err
+	}
+	if err := os.Chmod(tmpName, mode); err != nil {
+		_ = os.Remove(tmpName)
+		return err
+	}
+	if err := os.Rename(tmpName, dst); err != nil {
+		_ = os.Remove(tmpName)
+		return err
+	}
+	return nil
+}
+
+// copyFileContents writes the contents of src to dst, creating or truncating it
+func copyFileContents(src, dst string) error {
+	in, err := os.Open(src) //nolint:gosec // path chosen by the calling check
+	if err != nil {
+		return err
+	}
+	defer func() { _ = in.Close() }()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600) //nolint:gosec // path chosen by the calling check
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,130 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errGenerateFailed = errors.New("generator exited with status 1")
+
+// generatedFiles returns a directory holding a.go and b.go, the paths of
+// both, and the path of c.go, which does not exist yet
+func generatedFiles(t *testing.T) (a, b, c string) {
+	t.Helper()
+	dir := t.TempDir()
+	a, b, c = filepath.Join(dir, "a.go"), filepath.Join(dir, "b.go"), filepath.Join(dir, "c.go")
+	require.NoError(t, os.WriteFile(a, []byte("package a\n"), 0o600))
+	require.NoError(t, os.WriteFile(b, []byte("package b\n"), 0o700))
+	return a, b, c
+}
+
+// generate rewrites a.go, removes b.go, and creates c.go, as a generator
+// that stops part way might
+func generate(t *testing.T, a, b, c string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(a, []byte("package a // generated\n"), 0o600))
+	require.NoError(t, os.Remove(b))
+	require.NoError(t, os.WriteFile(c, []byte("package c\n"), 0o600))
+}
+
+// assertUnchanged fails unless a.go and b.go hold their original contents
+// and modes and c.go does not exist
+func assertUnchanged(t *testing.T, a, b, c string) {
+	t.Helper()
+	content, err := os.ReadFile(a) //nolint:gosec // test file
+	require.NoError(t, err)
+	assert.Equal(t, "package a\n", string(content))
+
+	content, err = os.ReadFile(b) //nolint:gosec // test file
+	require.NoError(t, err)
+	assert.Equal(t, "package b\n", string(content))
+	info, err := os.Stat(b)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o700), info.Mode().Perm())
+
+	assert.NoFileExists(t, c)
+	entries, err := os.ReadDir(filepath.Dir(a))
+	require.NoError(t, err)
+	assert.Len(t, entries, 2, "no temporary files are left behind")
+}
+
+func TestTransact_CanceledCheckLeavesFilesUnchanged(t *testing.T) {
+	a, b, c := generatedFiles(t)
+	sc := NewContext()
+	sc.SetTempRoot(t.TempDir())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	err := sc.Transact(ctx, []string{a, b, c}, func() error {
+		generate(t, a, b, c)
+		cancel() // canceled part way through, as by Ctrl+C or a timeout
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	require.ErrorIs(t, err, context.Canceled)
+
+	assertUnchanged(t, a, b, c)
+	assert.Empty(t, sc.TempWorkspaces(), "the copies are removed")
+}
+
+func TestTransact_CancellationIgnoredByCheck(t *testing.T) {
+	a, b, c := generatedFiles(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	err := NewContext().Transact(ctx, []string{a, b, c}, func() error {
+		generate(t, a, b, c)
+		cancel()
+		return nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	assertUnchanged(t, a, b, c)
+}
+
+func TestTransact_FailedCheckLeavesFilesUnchanged(t *testing.T) {
+	a, b, c := generatedFiles(t)
+
+	err := NewContext().Transact(context.Background(), []string{a, b, c}, func() error {
+		generate(t, a, b, c)
+		return errGenerateFailed
+	})
+	require.ErrorIs(t, err, errGenerateFailed)
+	assertUnchanged(t, a, b, c)
+}
+
+func TestTransact_SuccessKeepsChanges(t *testing.T) {
+	a, b, c := generatedFiles(t)
+	sc := NewContext()
+	sc.SetTempRoot(t.TempDir())
+
+	require.NoError(t, sc.Transact(context.Background(), []string{a, b, c}, func() error {
+		generate(t, a, b, c)
+		return nil
+	}))
+
+	content, err := os.ReadFile(a) //nolint:gosec // test file
+	require.NoError(t, err)
+	assert.Equal(t, "package a // generated\n", string(content))
+	assert.NoFileExists(t, b)
+	assert.FileExists(t, c)
+	assert.Empty(t, sc.TempWorkspaces())
+}
+
+func TestTakeSnapshot(t *testing.T) {
+	a, b, c := generatedFiles(t)
+
+	_, err := NewContext().TakeSnapshot(filepath.Dir(a))
+	require.ErrorIs(t, err, ErrSnapshotNotRegular)
+
+	snapshot, err := NewContext().TakeSnapshot(a, b, c)
+	require.NoError(t, err)
+	generate(t, a, b, c)
+	require.NoError(t, snapshot.Restore())
+	require.NoError(t, snapshot.Restore(), "restoring twice is harmless")
+	require.NoError(t, snapshot.Discard())
+	assertUnchanged(t, a, b, c)
+}
@@ -0,0 +1,83 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+)
+
+// SetTempRoot sets the directory temporary workspaces are created in. An
+// empty root uses the system temporary directory.
+func (sc *Context) SetTempRoot(root string) {
+	sc.tempMu.Lock()
+	defer sc.tempMu.Unlock()
+	sc.tempRoot = root
+}
+
+// TempWorkspace creates a temporary directory for a check that needs a scratch
+// copy of files. The directory is tracked and removed by
+// CleanupTempWorkspaces at the end of the run if the check does not remove it
+// first with RemoveTempWorkspace. It is safe for concurrent use; a nil
+// Context creates an untracked directory in the system temporary directory.
+func (sc *Context) TempWorkspace(name string) (string, error) {
+	pattern := "go-pre-commit-" + name + "-"
+	if sc == nil {
+		return makeTempWorkspace("", pattern)
+	}
+
+	sc.tempMu.Lock()
+	defer sc.tempMu.Unlock()
+
+	dir, err := makeTempWorkspace(sc.tempRoot, pattern)
+	if err != nil {
+		return "", err
+	}
+	sc.tempDirs = append(sc.tempDirs, dir)
+	return dir, nil
+}
+
+// makeTempWorkspace creates a temporary directory in root
+func makeTempWorkspace(root, pattern string) (string, error) {
+	dir, err := os.MkdirTemp(root, pattern)
+	if err != nil {
+		return "", fmt.Errorf("failed to create temporary workspace: %w", err)
+	}
+	return dir, nil
+}
+
+// RemoveTempWorkspace removes a directory TempWorkspace created and stops
+// tracking it
+func (sc *Context) RemoveTempWorkspace(dir string) error {
+	if sc != nil {
+		sc.tempMu.Lock()
+		sc.tempDirs = slices.DeleteFunc(sc.tempDirs, func(tracked string) bool { return tracked == dir })
+		sc.tempMu.Unlock()
+	}
+
+	return os.RemoveAll(dir)
+}
+
+// TempWorkspaces returns the temporary workspaces not yet removed
+func (sc *Context) TempWorkspaces() []string {
+	sc.tempMu.Lock()
+	defer sc.tempMu.Unlock()
+	return slices.Clone(sc.tempDirs)
+}
+
+// CleanupTempWorkspaces removes every temporary workspace still tracked. The
+// runner calls it when a run ends, including when the run is canceled.
+func (sc *Context) CleanupTempWorkspaces() error {
+	sc.tempMu.Lock()
+	dirs := sc.tempDirs
+	sc.tempDirs = nil
+	sc.tempMu.Unlock()
+
+	var errs []error
+	for _, dir := range dirs {
+		if err := os.RemoveAll(dir); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,81 @@
+package shared
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTempWorkspace(t *testing.T) {
+	root := t.TempDir()
+	sc := NewContext()
+	sc.SetTempRoot(root)
+
+	first, err := sc.TempWorkspace("generate")
+	require.NoError(t, err)
+	second, err := sc.TempWorkspace("vendor")
+	require.NoError(t, err)
+
+	assert.Equal(t, root, filepath.Dir(first))
+	assert.True(t, strings.HasPrefix(filepath.Base(first), "go-pre-commit-generate-"))
+	assert.DirExists(t, first)
+	assert.Equal(t, []string{first, second}, sc.TempWorkspaces())
+
+	require.NoError(t, sc.RemoveTempWorkspace(first))
+	assert.NoDirExists(t, first)
+	assert.Equal(t, []string{second}, sc.TempWorkspaces())
+
+	require.NoError(t, os.WriteFile(filepath.Join(second, "copy.go"), []byte("package p\n"), 0o600))
+	require.NoError(t, sc.CleanupTempWorkspaces())
+	assert.NoDirExists(t, second)
+	assert.Empty(t, sc.TempWorkspaces())
+}
+
+func TestTempWorkspace_Concurrent(t *testing.T) {
+	sc := NewContext()
+	sc.SetTempRoot(t.TempDir())
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := sc.TempWorkspace("parallel")
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	dirs := sc.TempWorkspaces()
+	assert.Len(t, dirs, 20)
+	require.NoError(t, sc.CleanupTempWorkspaces())
+	for _, dir := range dirs {
+		assert.NoDirExists(t, dir)
+	}
+}
+
+func TestTempWorkspace_Errors(t *testing.T) {
+	sc := NewContext()
+	sc.SetTempRoot(filepath.Join(t.TempDir(), "missing"))
+
+	_, err := sc.TempWorkspace("generate")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to create temporary workspace")
+	assert.Empty(t, sc.TempWorkspaces())
+}
+
+func TestTempWorkspace_NilContext(t *testing.T) {
+	var sc *Context
+
+	dir, err := sc.TempWorkspace("generate")
+	require.NoError(t, err)
+	assert.DirExists(t, dir)
+
+	require.NoError(t, sc.RemoveTempWorkspace(dir))
+	assert.NoDirExists(t, dir)
+}
@@ -257,6 +257,24 @@ func retryWithBackoff(ctx context.Context, operation string, fn func() error) er
 	return lastErr
 }
 
+// InstallHint returns the command that installs toolName at its configured
+// version, such as "go install mvdan.cc/gofumpt@latest", or "" for a tool
+// go-pre-commit does not manage
+func InstallHint(toolName string) string {
+	toolsMu.RLock()
+	tool, exists := tools[toolName]
+	toolsMu.RUnlock()
+	if !exists {
+		return ""
+	}
+
+	version := tool.Version
+	if version == "" {
+		version = toolVersionLatest
+	}
+	return fmt.Sprintf("go install %s@%s", tool.ImportPath, version)
+}
+
 // IsInstalled checks if a tool is installed and available in PATH
 func IsInstalled(toolName string) bool {
 	installMu.Lock()
@@ -284,6 +302,27 @@ func IsInstalled(toolName string) bool {
 	return installed
 }
 
+// IsAvailable reports whether a tool can be run without installing it. A
+// tool go-pre-commit manages must be installed; any other name, such as "go",
+// must be on PATH. Lookups share the cache used by IsInstalled.
+func IsAvailable(toolName string) bool {
+	toolsMu.RLock()
+	_, managed := tools[toolName]
+	toolsMu.RUnlock()
+	if managed {
+		return IsInstalled(toolName)
+	}
+
+	installMu.Lock()
+	defer installMu.Unlock()
+	if available, ok := installedTools[toolName]; ok {
+		return available
+	}
+	_, err := exec.LookPath(toolName)
+	installedTools[toolName] = err == nil
+	return err == nil
+}
+
 // EnsureInstalled ensures a tool is installed, installing it if necessary
 func EnsureInstalled(ctx context.Context, toolName string) error {
 	// Ensure versions are loaded from environment
@@ -334,10 +373,12 @@ func InstallTool(ctx context.Context, tool *Tool) error {
 		_ = err
 	}
 
-	// Build install command
-	installPath := tool.ImportPath
+	// Build install command. Without a pinned version the package resolves in
+	// the current module, installing the version its go.mod requires;
+	// -mod=readonly keeps a GOFLAGS=-mod=mod from adding it to go.mod instead
+	installArgs := []string{"install", "-mod=readonly", tool.ImportPath}
 	if tool.Version != "" && tool.Version != toolVersionLatest {
-		installPath = fmt.Sprintf("%s@%s", tool.ImportPath, tool.Version)
+		installArgs = []string{"install", fmt.Sprintf("%s@%s", tool.ImportPath, tool.Version)}
 	}
 
 	// Create timeout context using configurable timeout
@@ -374,7 +415,7 @@ func InstallTool(ctx context.Context, tool *Tool) error {
 
 	err := retryWithBackoff(installCtx, fmt.Sprintf("installing %s", tool.Name), func() error {
 		var cmdErr error
-		output, cmdErr = runInstallCommand(installCtx, append(os.Environ(), "GO111MODULE=on"), "go", "install", installPath)
+		output, cmdErr = runInstallCommand(installCtx, append(os.Environ(), "GO111MODULE=on"), "go", installArgs...)
 		return cmdErr
 	})
 
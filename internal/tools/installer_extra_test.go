@@ -538,3 +538,37 @@ func (e *testNetworkError) Timeout() bool {
 func (e *testNetworkError) Temporary() bool {
 	return true
 }
+
+func TestInstallHint(t *testing.T) {
+	assert.Contains(t, InstallHint("goimports"), "go install golang.org/x/tools/cmd/goimports@")
+	assert.Contains(t, InstallHint("gofumpt"), "go install mvdan.cc/gofumpt@")
+	assert.Empty(t, InstallHint("nonexistent-tool-xyz-12345"))
+}
+
+func TestInstallTool_InstallArgs(t *testing.T) {
+	for _, version := range []string{"", toolVersionLatest, "v1.2.3"} {
+		t.Run("version "+version, func(t *testing.T) {
+			var installArgs []string
+			fakeInstall(t, func(_ context.Context, _ []string, _ string, args ...string) ([]byte, error) {
+				installArgs = args
+				return nil, nil
+			})
+
+			tool := &Tool{
+				Name:       "test-tool-versioned",
+				ImportPath: "example.com/test-tool-versioned",
+				Binary:     "test-tool-versioned",
+				Version:    version,
+			}
+			// The fake installs nothing, so the PATH lookup afterward fails
+			_ = InstallTool(context.Background(), tool)
+
+			if version == "v1.2.3" {
+				assert.Equal(t, []string{"install", "example.com/test-tool-versioned@v1.2.3"}, installArgs)
+				return
+			}
+			// Unpinned tools install the version go.mod requires, without editing it
+			assert.Equal(t, []string{"install", "-mod=readonly", "example.com/test-tool-versioned"}, installArgs)
+		})
+	}
+}
@@ -114,6 +114,23 @@ func (s *InstallerTestSuite) TestIsInstalled() {
 	s.True(IsInstalled("test-tool"))
 }
 
+func (s *InstallerTestSuite) TestIsAvailable() {
+	// Tools go-pre-commit does not manage are looked up on PATH
+	s.True(IsAvailable("go"))
+	s.False(IsAvailable("go-pre-commit-no-such-tool"))
+
+	// Managed tools go through the installed tools cache
+	installMu.Lock()
+	installedTools["gofumpt"] = false
+	installMu.Unlock()
+	s.False(IsAvailable("gofumpt"))
+
+	installMu.Lock()
+	installedTools["gofumpt"] = true
+	installMu.Unlock()
+	s.True(IsAvailable("gofumpt"))
+}
+
 func (s *InstallerTestSuite) TestGetToolPath() {
 	// Test getting path for known tool
 	toolsMu.Lock()
@@ -267,8 +267,11 @@ func TestInstallTool_RealScenario(t *testing.T) {
 
 	ctx := context.Background()
 
-	// Clean up before test
-	_ = exec.CommandContext(ctx, "go", "clean", "-i", realTool.ImportPath).Run() //nolint:gosec // Test cleanup command with known input
+	// Clean up before test, outside this module: with GOFLAGS=-mod=mod, naming
+	// the package from inside it would add a requirement to go.mod
+	cleanCmd := exec.CommandContext(ctx, "go", "clean", "-i", realTool.ImportPath) //nolint:gosec // Test cleanup command with known input
+	cleanCmd.Dir = t.TempDir()
+	_ = cleanCmd.Run()
 
 	err := InstallTool(ctx, realTool)
 	// This might still fail due to network issues, but shouldn't timeout
@@ -0,0 +1,94 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+)
+
+// runVersionCommand runs a tool's version command and returns its combined
+// output. It is a package variable so tests can substitute canned output.
+//
+//nolint:gochecknoglobals // Injectable seam so tests can avoid real exec
+var runVersionCommand = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, name, args...) //nolint:gosec // binary and arguments come from versionCommands
+	return cmd.CombinedOutput()
+}
+
+// versionCommands holds the binary and arguments that print each pinnable
+// tool's version
+//
+//nolint:gochecknoglobals // Read-only lookup table
+var versionCommands = map[string][]string{
+	"go":             {"go", "version"},
+	"gofumpt":        {"gofumpt", "--version"},
+	"gitleaks":       {"gitleaks", "version"},
+	toolGolangciLint: {toolGolangciLint, "--version"},
+}
+
+// versionPattern finds the first dotted version number in version output,
+// such as "2.4.0" in "golangci-lint has version 2.4.0 built with go1.25.0"
+var versionPattern = regexp.MustCompile(`\d+\.\d+(?:\.\d+)?`)
+
+var (
+	// ErrVersionUnknown is returned when a tool's version output has no version
+	ErrVersionUnknown = errors.New("could not determine tool version")
+	// ErrVersionMismatch is returned when an installed tool differs from its pinned version
+	ErrVersionMismatch = errors.New("tool version does not match the pinned version")
+)
+
+// InstalledVersion runs toolName's version command and returns the version it
+// reports in vMAJOR.MINOR.PATCH form. A missing binary yields
+// ErrToolNotInstalled.
+func InstalledVersion(ctx context.Context, toolName string) (string, error) {
+	command, ok := versionCommands[toolName]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownTool, toolName)
+	}
+
+	output, err := runVersionCommand(ctx, command[0], command[1:]...)
+	if errors.Is(err, exec.ErrNotFound) {
+		return "", fmt.Errorf("%w: %s", ErrToolNotInstalled, toolName)
+	}
+	if err != nil {
+		return "", fmt.Errorf("%s %s failed: %w", command[0], strings.Join(command[1:], " "), err)
+	}
+
+	version := versionPattern.FindString(string(output))
+	if version == "" {
+		return "", fmt.Errorf("%w: %s printed %q", ErrVersionUnknown, toolName, strings.TrimSpace(string(output)))
+	}
+	return NormalizeVersion(version), nil
+}
+
+// NormalizeVersion returns version with a single "v" prefix, so "2.4.0",
+// "v2.4.0", and "go1.25.0" compare as versions
+func NormalizeVersion(version string) string {
+	version = strings.TrimSpace(version)
+	version = strings.TrimPrefix(version, "go")
+	return "v" + strings.TrimPrefix(version, "v")
+}
+
+// VersionMatches reports whether actual satisfies pinned. A pin naming fewer
+// components matches any release under it, so v2.4 accepts v2.4.0 and v2.4.1
+// but not v2.40.0.
+func VersionMatches(pinned, actual string) bool {
+	pinned, actual = NormalizeVersion(pinned), NormalizeVersion(actual)
+	return actual == pinned || strings.HasPrefix(actual, pinned+".")
+}
+
+// VerifyPinnedVersion checks that the installed toolName matches pinned,
+// returning ErrVersionMismatch with both versions when it does not
+func VerifyPinnedVersion(ctx context.Context, toolName, pinned string) error {
+	actual, err := InstalledVersion(ctx, toolName)
+	if err != nil {
+		return err
+	}
+	if !VersionMatches(pinned, actual) {
+		return fmt.Errorf("%w: %s is %s, pinned to %s", ErrVersionMismatch, toolName, actual, NormalizeVersion(pinned))
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeVersionOutput replaces the version-command runner with canned output
+// keyed by binary; a binary without output is reported as not found
+func fakeVersionOutput(t *testing.T, outputs map[string]string) {
+	t.Helper()
+	orig := runVersionCommand
+	t.Cleanup(func() { runVersionCommand = orig })
+	runVersionCommand = func(_ context.Context, name string, args ...string) ([]byte, error) {
+		out, ok := outputs[name]
+		if !ok {
+			return nil, &exec.Error{Name: name, Err: exec.ErrNotFound}
+		}
+		if strings.HasPrefix(out, "error:") {
+			return []byte(out), fmt.Errorf("%s %s: exit status 1", name, strings.Join(args, " ")) //nolint:err113 // simulated exit
+		}
+		return []byte(out), nil
+	}
+}
+
+func TestInstalledVersion(t *testing.T) {
+	fakeVersionOutput(t, map[string]string{
+		"golangci-lint": "golangci-lint has version 2.4.0 built with go1.25.0 from abc123 on 2025-08-14\n",
+		"gofumpt":       "v0.8.0 (go1.25.0)\n",
+		"gitleaks":      "8.29.0\n",
+		"go":            "go version go1.25.0 linux/amd64\n",
+	})
+
+	for tool, want := range map[string]string{
+		"golangci-lint": "v2.4.0",
+		"gofumpt":       "v0.8.0",
+		"gitleaks":      "v8.29.0",
+		"go":            "v1.25.0",
+	} {
+		got, err := InstalledVersion(context.Background(), tool)
+		require.NoError(t, err, tool)
+		assert.Equal(t, want, got, tool)
+	}
+}
+
+func TestInstalledVersion_Errors(t *testing.T) {
+	fakeVersionOutput(t, map[string]string{
+		"gofumpt":  "(devel)\n",
+		"gitleaks": "error: unknown command\n",
+	})
+
+	_, err := InstalledVersion(context.Background(), "golangci-lint")
+	require.ErrorIs(t, err, ErrToolNotInstalled)
+
+	_, err = InstalledVersion(context.Background(), "gofumpt")
+	require.ErrorIs(t, err, ErrVersionUnknown)
+	assert.Contains(t, err.Error(), "(devel)")
+
+	_, err = InstalledVersion(context.Background(), "gitleaks")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "gitleaks version failed")
+
+	_, err = InstalledVersion(context.Background(), "goimports")
+	require.ErrorIs(t, err, ErrUnknownTool)
+}
+
+func TestVersionMatches(t *testing.T) {
+	tests := []struct {
+		pinned, actual string
+		want           bool
+	}{
+		{"v2.4.0", "v2.4.0", true},
+		{"2.4.0", "v2.4.0", true},
+		{"v2.4", "v2.4.1", true},
+		{"v2", "v2.4.1", true},
+		{"go1.25.0", "v1.25.0", true},
+		{"v2.4", "v2.40.0", false},
+		{"v2.4.0", "v2.4.1", false},
+		{"v2.4.1", "v2.4", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, VersionMatches(tt.pinned, tt.actual), "%s vs %s", tt.pinned, tt.actual)
+	}
+}
+
+func TestVerifyPinnedVersion(t *testing.T) {
+	fakeVersionOutput(t, map[string]string{
+		"golangci-lint": "golangci-lint has version 2.3.1 built with go1.24.5\n",
+		"gofumpt":       "v0.8.0 (go1.25.0)\n",
+	})
+
+	require.NoError(t, VerifyPinnedVersion(context.Background(), "gofumpt", "v0.8.0"))
+
+	err := VerifyPinnedVersion(context.Background(), "golangci-lint", "2.4.0")
+	require.ErrorIs(t, err, ErrVersionMismatch)
+	assert.Contains(t, err.Error(), "golangci-lint is v2.3.1, pinned to v2.4.0")
+
+	err = VerifyPinnedVersion(context.Background(), "gitleaks", "v8.29.0")
+	require.ErrorIs(t, err, ErrToolNotInstalled)
+}
@@ -0,0 +1,215 @@
+package update
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/version"
+)
+
+// Release download constants
+const (
+	// releaseDownloadURL is where GitHub serves the release assets
+	releaseDownloadURL = "https://github.com/" + gitHubOwner + "/" + gitHubRepo + "/releases/download"
+
+	// binaryName is the executable inside each release archive
+	binaryName = "go-pre-commit"
+
+	// downloadTimeout bounds each release asset download
+	downloadTimeout = 2 * time.Minute
+
+	// maxAssetSize caps how much of an asset or archived binary is read
+	maxAssetSize = 256 << 20
+)
+
+var (
+	// ErrDownloadFailed is returned when a release asset cannot be downloaded
+	ErrDownloadFailed = errors.New("release download failed")
+	// ErrChecksumNotFound is returned when the checksums file does not list the archive
+	ErrChecksumNotFound = errors.New("release archive not listed in checksums")
+	// ErrChecksumMismatch is returned when the downloaded archive does not match its checksum
+	ErrChecksumMismatch = errors.New("release archive checksum mismatch")
+	// ErrBinaryNotInArchive is returned when the release archive holds no go-pre-commit binary
+	ErrBinaryNotInArchive = errors.New("binary not found in release archive")
+)
+
+// Downloader installs go-pre-commit release binaries from GitHub releases
+type Downloader struct {
+	BaseURL string             // Release download URL; defaults to GitHub releases
+	Client  version.HTTPClient // Defaults to an http.Client with downloadTimeout
+	GOOS    string             // Defaults to runtime.GOOS
+	GOARCH  string             // Defaults to runtime.GOARCH
+}
+
+// NewDownloader creates a downloader for the current platform
+func NewDownloader() *Downloader {
+	return &Downloader{
+		BaseURL: releaseDownloadURL,
+		Client:  &http.Client{Timeout: downloadTimeout},
+		GOOS:    runtime.GOOS,
+		GOARCH:  runtime.GOARCH,
+	}
+}
+
+// ArchiveName returns the release archive name for a version and platform,
+// matching the goreleaser name template
+func ArchiveName(releaseVersion, goos, goarch string) string {
+	return fmt.Sprintf("%s_%s_%s_%s.tar.gz", gitHubRepo, strings.TrimPrefix(releaseVersion, "v"), goos, goarch)
+}
+
+// checksumsName returns the name of the release's sha256 checksums file
+func checksumsName(releaseVersion string) string {
+	return fmt.Sprintf("%s_%s_checksums.txt", gitHubRepo, strings.TrimPrefix(releaseVersion, "v"))
+}
+
+// Install downloads the release archive for releaseVersion, verifies it
+// against the release checksums, and atomically replaces target with the
+// binary it contains
+func (d *Downloader) Install(ctx context.Context, releaseVersion, target string) error {
+	tag := "v" + strings.TrimPrefix(releaseVersion, "v")
+	archive := ArchiveName(tag, d.GOOS, d.GOARCH)
+
+	sums, err := d.download(ctx, tag, checksumsName(tag))
+	if err != nil {
+		return err
+	}
+	want, err := lookupChecksum(sums, archive)
+	if err != nil {
+		return err
+	}
+
+	data, err := d.download(ctx, tag, archive)
+	if err != nil {
+		return err
+	}
+	if got := sha256.Sum256(data); hex.EncodeToString(got[:]) != want {
+		return fmt.Errorf("%w: %s", ErrChecksumMismatch, archive)
+	}
+
+	name := binaryName
+	if d.GOOS == "windows" {
+		name += ".exe"
+	}
+	binary, err := extractBinary(data, name)
+	if err != nil {
+		return err
+	}
+	return ReplaceBinary(target, binary)
+}
+
+// download fetches one asset of the release tagged tag
+func (d *Downloader) download(ctx context.Context, tag, asset string) ([]byte, error) {
+	url := fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(d.BaseURL, "/"), tag, asset)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("User-Agent", fmt.Sprintf("go-pre-commit/%s (%s/%s)", tag, d.GOOS, d.GOARCH))
+
+	resp, err := d.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrDownloadFailed, asset, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %s: status %d", ErrDownloadFailed, asset, resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxAssetSize))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrDownloadFailed, asset, err)
+	}
+	return data, nil
+}
+
+// lookupChecksum finds archive's sha256 in a "<hex>  <name>" checksums file
+func lookupChecksum(sums []byte, archive string) (string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(sums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && strings.TrimPrefix(fields[1], "*") == archive {
+			return strings.ToLower(fields[0]), nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s", ErrChecksumNotFound, archive)
+}
+
+// extractBinary returns the contents of the file called name in a .tar.gz archive
+func extractBinary(archive []byte, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, fmt.Errorf("reading release archive: %w", err)
+	}
+	defer func() { _ = gz.Close() }()
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: %s", ErrBinaryNotInArchive, name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading release archive: %w", err)
+		}
+		if header.Typeflag == tar.TypeReg && filepath.Base(header.Name) == name {
+			return io.ReadAll(io.LimitReader(tr, maxAssetSize))
+		}
+	}
+}
+
+// ReplaceBinary atomically replaces target with binary: the new binary is
+// written to a temporary file beside target and renamed over it, so target
+// is never left half-written. Windows cannot replace a running executable,
+// so there the old one is first moved aside to target.old.
+func ReplaceBinary(target string, binary []byte) error {
+	mode := os.FileMode(0o755)
+	if info, err := os.Stat(target); err == nil {
+		mode = info.Mode().Perm()
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(target), "."+filepath.Base(target)+".*")
+	if err != nil {
+		return fmt.Errorf("creating temporary binary: %w", err)
+	}
+	tmpName := tmp.Name()
+	defer func() { _ = os.Remove(tmpName) }()
+
+	if _, err = tmp.Write(binary); err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("writing temporary binary: %w", err)
+	}
+	if err = os.Chmod(tmpName, mode); err != nil {
+		return fmt.Errorf("setting binary permissions: %w", err)
+	}
+
+	if runtime.GOOS == "windows" {
+		old := target + ".old"
+		_ = os.Remove(old)
+		if err = os.Rename(target, old); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("moving old binary aside: %w", err)
+		}
+	}
+	if err = os.Rename(tmpName, target); err != nil {
+		return fmt.Errorf("replacing binary: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package update
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newBinary is the content of the go-pre-commit binary the fake release ships
+var newBinary = []byte("#!/bin/sh\necho go-pre-commit v1.1.0\n") //nolint:gochecknoglobals // test fixture
+
+// buildArchive returns a .tar.gz holding the given files
+func buildArchive(t *testing.T, files map[string][]byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o755, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+// fakeReleaseServer serves the checksums file and linux/amd64 archive of
+// testVersionLatest, listing checksum as the archive's sha256
+func fakeReleaseServer(t *testing.T, archive []byte, checksum string) *Downloader {
+	t.Helper()
+	archiveName := ArchiveName(testVersionLatest, "linux", "amd64")
+	sums := fmt.Sprintf("%s  %s\n%s  %s\n",
+		"0000000000000000000000000000000000000000000000000000000000000000", ArchiveName(testVersionLatest, "darwin", "arm64"),
+		checksum, archiveName)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/"+testVersionLatest+"/"+checksumsName(testVersionLatest), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(sums))
+	})
+	mux.HandleFunc("/"+testVersionLatest+"/"+archiveName, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(archive)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return &Downloader{BaseURL: server.URL, Client: server.Client(), GOOS: "linux", GOARCH: "amd64"}
+}
+
+// sha256Hex returns the hex sha256 of data
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestArchiveName(t *testing.T) {
+	assert.Equal(t, "go-pre-commit_1.1.0_linux_amd64.tar.gz", ArchiveName("v1.1.0", "linux", "amd64"))
+	assert.Equal(t, "go-pre-commit_1.1.0_windows_arm64.tar.gz", ArchiveName("1.1.0", "windows", "arm64"))
+	assert.Equal(t, "go-pre-commit_1.1.0_checksums.txt", checksumsName("v1.1.0"))
+}
+
+func TestDownloaderInstall(t *testing.T) {
+	archive := buildArchive(t, map[string][]byte{
+		"README.md":     []byte("readme"),
+		"go-pre-commit": newBinary,
+	})
+
+	t.Run("verified binary replaces the target", func(t *testing.T) {
+		target := filepath.Join(t.TempDir(), "go-pre-commit")
+		require.NoError(t, os.WriteFile(target, []byte("old"), 0o700)) //nolint:gosec // executable test fixture
+
+		d := fakeReleaseServer(t, archive, sha256Hex(archive))
+		require.NoError(t, d.Install(context.Background(), testVersionLatest, target))
+
+		got, err := os.ReadFile(target) //nolint:gosec // test path
+		require.NoError(t, err)
+		assert.Equal(t, newBinary, got)
+
+		info, err := os.Stat(target)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o700), info.Mode().Perm(), "the target's permissions are kept")
+
+		entries, err := os.ReadDir(filepath.Dir(target))
+		require.NoError(t, err)
+		assert.Len(t, entries, 1, "no temporary file is left behind")
+	})
+
+	t.Run("checksum mismatch leaves the target alone", func(t *testing.T) {
+		target := filepath.Join(t.TempDir(), "go-pre-commit")
+		require.NoError(t, os.WriteFile(target, []byte("old"), 0o700)) //nolint:gosec // executable test fixture
+
+		d := fakeReleaseServer(t, archive, sha256Hex([]byte("tampered")))
+		err := d.Install(context.Background(), testVersionLatest, target)
+		require.ErrorIs(t, err, ErrChecksumMismatch)
+
+		got, err := os.ReadFile(target) //nolint:gosec // test path
+		require.NoError(t, err)
+		assert.Equal(t, []byte("old"), got)
+	})
+
+	t.Run("archive missing from checksums", func(t *testing.T) {
+		d := fakeReleaseServer(t, archive, sha256Hex(archive))
+		d.GOARCH = "386"
+		err := d.Install(context.Background(), testVersionLatest, filepath.Join(t.TempDir(), "go-pre-commit"))
+		require.ErrorIs(t, err, ErrChecksumNotFound)
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		d := fakeReleaseServer(t, archive, sha256Hex(archive))
+		err := d.Install(context.Background(), "v9.9.9", filepath.Join(t.TempDir(), "go-pre-commit"))
+		require.ErrorIs(t, err, ErrDownloadFailed)
+	})
+
+	t.Run("archive without the binary", func(t *testing.T) {
+		empty := buildArchive(t, map[string][]byte{"README.md": []byte("readme")})
+		d := fakeReleaseServer(t, empty, sha256Hex(empty))
+		err := d.Install(context.Background(), testVersionLatest, filepath.Join(t.TempDir(), "go-pre-commit"))
+		require.ErrorIs(t, err, ErrBinaryNotInArchive)
+	})
+}
+
+func TestReplaceBinary(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "go-pre-commit")
+
+	require.NoError(t, ReplaceBinary(target, newBinary), "a missing target is created")
+	info, err := os.Stat(target)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o755), info.Mode().Perm())
+
+	err = ReplaceBinary(filepath.Join(dir, "missing", "go-pre-commit"), newBinary)
+	require.Error(t, err)
+}
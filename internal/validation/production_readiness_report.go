@@ -134,10 +134,30 @@ type SkipFunctionality struct {
 	Score             int      `json:"score"` // 0-100
 }
 
+// Default parallel-scaling criteria: the parallel run may take at most 150%
+// of the time of the single-worker run
+const (
+	defaultSingleWorkers    = 1
+	defaultParallelWorkers  = 4
+	defaultScalingTolerance = 150
+)
+
+// ParallelScalingCriteria decides the parallel-scaling pass: the same files
+// are run with SingleWorkers and with ParallelWorkers workers, and the
+// parallel run passes when it takes at most TolerancePercent of the time of
+// the single run. Zero or negative fields use the defaults.
+type ParallelScalingCriteria struct {
+	SingleWorkers    int // default 1
+	ParallelWorkers  int // default 4
+	TolerancePercent int // default 150
+}
+
 // ProductionReadinessValidator validates the system for production readiness
 type ProductionReadinessValidator struct {
-	tempDir string
-	envFile string
+	tempDir         string
+	envFile         string
+	reportTime      time.Time
+	parallelScaling ParallelScalingCriteria
 }
 
 // NewProductionReadinessValidator creates a new validator
@@ -178,6 +198,48 @@ GO_PRE_COMMIT_EOF_TIMEOUT=30
 	}, nil
 }
 
+// SetReportTime fixes the time reports are stamped with, so reports of the
+// same tree are byte-for-byte comparable. The zero time restores the clock.
+func (v *ProductionReadinessValidator) SetReportTime(t time.Time) {
+	v.reportTime = t
+}
+
+// ReportTime returns the time a report generated now is stamped with
+func (v *ProductionReadinessValidator) ReportTime() time.Time {
+	if v.reportTime.IsZero() {
+		return time.Now()
+	}
+	return v.reportTime
+}
+
+// SetParallelScaling sets the criteria of the parallel-scaling pass, so teams
+// can calibrate it for their hardware
+func (v *ProductionReadinessValidator) SetParallelScaling(criteria ParallelScalingCriteria) {
+	v.parallelScaling = criteria
+}
+
+// ParallelScaling returns the parallel-scaling criteria in effect, with
+// defaults filled in
+func (v *ProductionReadinessValidator) ParallelScaling() ParallelScalingCriteria {
+	criteria := v.parallelScaling
+	if criteria.SingleWorkers <= 0 {
+		criteria.SingleWorkers = defaultSingleWorkers
+	}
+	if criteria.ParallelWorkers <= 0 {
+		criteria.ParallelWorkers = defaultParallelWorkers
+	}
+	if criteria.TolerancePercent <= 0 {
+		criteria.TolerancePercent = defaultScalingTolerance
+	}
+	return criteria
+}
+
+// Scales reports whether a parallel run of the given duration is within the
+// tolerance of the single run
+func (c ParallelScalingCriteria) Scales(single, parallel time.Duration) bool {
+	return parallel <= single*time.Duration(c.TolerancePercent)/100
+}
+
 // Cleanup cleans up temporary resources
 func (v *ProductionReadinessValidator) Cleanup() {
 	_ = os.RemoveAll(v.tempDir)
@@ -186,7 +248,7 @@ func (v *ProductionReadinessValidator) Cleanup() {
 // GenerateReport generates a comprehensive production readiness report
 func (v *ProductionReadinessValidator) GenerateReport() (*ProductionReadinessReport, error) {
 	report := &ProductionReadinessReport{
-		GeneratedAt: time.Now(),
+		GeneratedAt: v.ReportTime(),
 		Version:     "1.0.0",
 		Environment: "validation",
 	}
@@ -529,12 +591,12 @@ func (v *ProductionReadinessValidator) measureWarmRun(cfg *config.Config, files
 // Simple test implementations (these would be more comprehensive in a real implementation)
 
 func (v *ProductionReadinessValidator) testParallelScaling(cfg *config.Config, files []string) bool {
-	// Test with 1 vs 4 workers - 4 workers should not be significantly slower
-	single, _ := v.measurePerformanceWithWorkers(cfg, files, 1)
-	parallel, _ := v.measurePerformanceWithWorkers(cfg, files, 4)
+	// More workers should not be significantly slower than fewer
+	criteria := v.ParallelScaling()
+	single, _ := v.measurePerformanceWithWorkers(cfg, files, criteria.SingleWorkers)
+	parallel, _ := v.measurePerformanceWithWorkers(cfg, files, criteria.ParallelWorkers)
 
-	// Parallel should not be more than 150% of single-threaded time
-	return parallel <= single*150/100
+	return criteria.Scales(single, parallel)
 }
 
 func (v *ProductionReadinessValidator) measurePerformanceWithWorkers(cfg *config.Config, files []string, workers int) (time.Duration, error) {
@@ -169,13 +169,54 @@ func (s *ProductionReadinessTestSuite) TestPerformanceValidation() {
 	cfg := &config.Config{
 		Enabled: true,
 		Checks: struct {
-			Fumpt            bool
-			Lint             bool
-			ModTidy          bool
-			Whitespace       bool
-			EOF              bool
-			Gitleaks         bool
-			GitleaksAllFiles bool
+			Fumpt                bool
+			Lint                 bool
+			ModTidy              bool
+			Whitespace           bool
+			EOF                  bool
+			Gitleaks             bool
+			GitleaksAllFiles     bool
+			BuildTags            bool
+			GoIndent             bool
+			EmptyCommit          bool
+			GoVersion            bool
+			ErrorCompare         bool
+			TestPresence         bool
+			GoGenerate           bool
+			LargeDiffs           bool
+			Toolchain            bool
+			ModulePath           bool
+			BuildArtifacts       bool
+			OSJunk               bool
+			ContextFirst         bool
+			ForbiddenImports     bool
+			StructTags           bool
+			DocComments          bool
+			ReceiverNames        bool
+			FilePermissions      bool
+			EmbeddedBlobs        bool
+			DataFormat           bool
+			Gosec                bool
+			Shellcheck           bool
+			Hadolint             bool
+			GoDirective          bool
+			StubFuncs            bool
+			CommitSize           bool
+			TestPackage          bool
+			ModPair              bool
+			ErrorWrap            bool
+			InternalImport       bool
+			FuncLength           bool
+			EnvAccess            bool
+			TestOnlyDeps         bool
+			GlobalVars           bool
+			FileHeaderOrder      bool
+			ContextBackground    bool
+			MajorVersionConflict bool
+			FilenameCase         bool
+			BuildConstraints     bool
+			RepoFiles            bool
+			ErrorVarNaming       bool
 		}{
 			Whitespace: true,
 			EOF:        true,
@@ -223,13 +264,54 @@ func (s *ProductionReadinessTestSuite) TestParallelScaling() {
 	cfg := &config.Config{
 		Enabled: true,
 		Checks: struct {
-			Fumpt            bool
-			Lint             bool
-			ModTidy          bool
-			Whitespace       bool
-			EOF              bool
-			Gitleaks         bool
-			GitleaksAllFiles bool
+			Fumpt                bool
+			Lint                 bool
+			ModTidy              bool
+			Whitespace           bool
+			EOF                  bool
+			Gitleaks             bool
+			GitleaksAllFiles     bool
+			BuildTags            bool
+			GoIndent             bool
+			EmptyCommit          bool
+			GoVersion            bool
+			ErrorCompare         bool
+			TestPresence         bool
+			GoGenerate           bool
+			LargeDiffs           bool
+			Toolchain            bool
+			ModulePath           bool
+			BuildArtifacts       bool
+			OSJunk               bool
+			ContextFirst         bool
+			ForbiddenImports     bool
+			StructTags           bool
+			DocComments          bool
+			ReceiverNames        bool
+			FilePermissions      bool
+			EmbeddedBlobs        bool
+			DataFormat           bool
+			Gosec                bool
+			Shellcheck           bool
+			Hadolint             bool
+			GoDirective          bool
+			StubFuncs            bool
+			CommitSize           bool
+			TestPackage          bool
+			ModPair              bool
+			ErrorWrap            bool
+			InternalImport       bool
+			FuncLength           bool
+			EnvAccess            bool
+			TestOnlyDeps         bool
+			GlobalVars           bool
+			FileHeaderOrder      bool
+			ContextBackground    bool
+			MajorVersionConflict bool
+			FilenameCase         bool
+			BuildConstraints     bool
+			RepoFiles            bool
+			ErrorVarNaming       bool
 		}{
 			Whitespace: true,
 			EOF:        true,
@@ -251,6 +333,26 @@ func (s *ProductionReadinessTestSuite) TestParallelScaling() {
 	s.Greater(parallel, time.Duration(0))
 }
 
+// Test the parallel-scaling criteria and their defaults
+func (s *ProductionReadinessTestSuite) TestParallelScalingCriteria() {
+	s.Equal(ParallelScalingCriteria{SingleWorkers: 1, ParallelWorkers: 4, TolerancePercent: 150}, s.validator.ParallelScaling())
+
+	defaults := s.validator.ParallelScaling()
+	s.True(defaults.Scales(time.Second, 1500*time.Millisecond), "exactly at the tolerance")
+	s.False(defaults.Scales(time.Second, 1501*time.Millisecond))
+
+	s.validator.SetParallelScaling(ParallelScalingCriteria{ParallelWorkers: 8, TolerancePercent: 200})
+	s.T().Cleanup(func() { s.validator.SetParallelScaling(ParallelScalingCriteria{}) })
+	criteria := s.validator.ParallelScaling()
+	s.Equal(ParallelScalingCriteria{SingleWorkers: 1, ParallelWorkers: 8, TolerancePercent: 200}, criteria)
+	s.True(criteria.Scales(time.Second, 1800*time.Millisecond), "passes under the wider tolerance")
+	s.False(criteria.Scales(time.Second, 2100*time.Millisecond))
+
+	strict := ParallelScalingCriteria{TolerancePercent: 80}
+	s.True(strict.Scales(time.Second, 800*time.Millisecond))
+	s.False(strict.Scales(time.Second, 900*time.Millisecond), "parallel must be faster under a strict tolerance")
+}
+
 // Test memory efficiency
 func (s *ProductionReadinessTestSuite) TestMemoryEfficiency() {
 	// Change to temp directory
@@ -269,13 +371,54 @@ func (s *ProductionReadinessTestSuite) TestMemoryEfficiency() {
 	cfg := &config.Config{
 		Enabled: true,
 		Checks: struct {
-			Fumpt            bool
-			Lint             bool
-			ModTidy          bool
-			Whitespace       bool
-			EOF              bool
-			Gitleaks         bool
-			GitleaksAllFiles bool
+			Fumpt                bool
+			Lint                 bool
+			ModTidy              bool
+			Whitespace           bool
+			EOF                  bool
+			Gitleaks             bool
+			GitleaksAllFiles     bool
+			BuildTags            bool
+			GoIndent             bool
+			EmptyCommit          bool
+			GoVersion            bool
+			ErrorCompare         bool
+			TestPresence         bool
+			GoGenerate           bool
+			LargeDiffs           bool
+			Toolchain            bool
+			ModulePath           bool
+			BuildArtifacts       bool
+			OSJunk               bool
+			ContextFirst         bool
+			ForbiddenImports     bool
+			StructTags           bool
+			DocComments          bool
+			ReceiverNames        bool
+			FilePermissions      bool
+			EmbeddedBlobs        bool
+			DataFormat           bool
+			Gosec                bool
+			Shellcheck           bool
+			Hadolint             bool
+			GoDirective          bool
+			StubFuncs            bool
+			CommitSize           bool
+			TestPackage          bool
+			ModPair              bool
+			ErrorWrap            bool
+			InternalImport       bool
+			FuncLength           bool
+			EnvAccess            bool
+			TestOnlyDeps         bool
+			GlobalVars           bool
+			FileHeaderOrder      bool
+			ContextBackground    bool
+			MajorVersionConflict bool
+			FilenameCase         bool
+			BuildConstraints     bool
+			RepoFiles            bool
+			ErrorVarNaming       bool
 		}{
 			Whitespace: true,
 			EOF:        true,
@@ -791,13 +934,54 @@ func TestPerformanceMeasurementWithContext(t *testing.T) {
 	cfg := &config.Config{
 		Enabled: true,
 		Checks: struct {
-			Fumpt            bool
-			Lint             bool
-			ModTidy          bool
-			Whitespace       bool
-			EOF              bool
-			Gitleaks         bool
-			GitleaksAllFiles bool
+			Fumpt                bool
+			Lint                 bool
+			ModTidy              bool
+			Whitespace           bool
+			EOF                  bool
+			Gitleaks             bool
+			GitleaksAllFiles     bool
+			BuildTags            bool
+			GoIndent             bool
+			EmptyCommit          bool
+			GoVersion            bool
+			ErrorCompare         bool
+			TestPresence         bool
+			GoGenerate           bool
+			LargeDiffs           bool
+			Toolchain            bool
+			ModulePath           bool
+			BuildArtifacts       bool
+			OSJunk               bool
+			ContextFirst         bool
+			ForbiddenImports     bool
+			StructTags           bool
+			DocComments          bool
+			ReceiverNames        bool
+			FilePermissions      bool
+			EmbeddedBlobs        bool
+			DataFormat           bool
+			Gosec                bool
+			Shellcheck           bool
+			Hadolint             bool
+			GoDirective          bool
+			StubFuncs            bool
+			CommitSize           bool
+			TestPackage          bool
+			ModPair              bool
+			ErrorWrap            bool
+			InternalImport       bool
+			FuncLength           bool
+			EnvAccess            bool
+			TestOnlyDeps         bool
+			GlobalVars           bool
+			FileHeaderOrder      bool
+			ContextBackground    bool
+			MajorVersionConflict bool
+			FilenameCase         bool
+			BuildConstraints     bool
+			RepoFiles            bool
+			ErrorVarNaming       bool
 		}{
 			Whitespace: true,
 		},
@@ -849,6 +1033,23 @@ func TestReportGenerationWithErrors(t *testing.T) {
 	assert.Nil(t, report)
 }
 
+func TestReportTime(t *testing.T) {
+	validator := &ProductionReadinessValidator{}
+	before := time.Now()
+	assert.False(t, validator.ReportTime().Before(before), "the clock without an override")
+
+	fixed := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	validator.SetReportTime(fixed)
+	assert.Equal(t, fixed, validator.ReportTime())
+	assert.Equal(t, fixed, validator.ReportTime(), "stable across calls")
+
+	report := &ProductionReadinessReport{GeneratedAt: validator.ReportTime()}
+	assert.Contains(t, report.FormatReport(), "Generated: 2026-01-02T03:04:05Z")
+
+	validator.SetReportTime(time.Time{})
+	assert.False(t, validator.ReportTime().Before(before), "the zero time restores the clock")
+}
+
 // Test cleanup functionality
 func TestCleanup(t *testing.T) {
 	validator, err := NewProductionReadinessValidator()
@@ -0,0 +1,167 @@
+// Package webhook posts pre-commit run results to an HTTP endpoint for
+// centralized dashboards
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/mrz1836/go-pre-commit/internal/output"
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+)
+
+// DefaultTimeout bounds a webhook delivery so a slow endpoint cannot hold up
+// the commit
+const DefaultTimeout = 5 * time.Second
+
+// Check statuses reported in the payload
+const (
+	StatusPassed  = "passed"
+	StatusFailed  = "failed"
+	StatusSkipped = "skipped"
+	StatusWarning = "warning"
+)
+
+var (
+	// ErrInvalidURL is returned when a webhook URL is not an absolute http(s) URL
+	ErrInvalidURL = errors.New("webhook URL must be an absolute http or https URL")
+
+	// ErrDeliveryFailed is returned when the endpoint answers with a non-2xx status
+	ErrDeliveryFailed = errors.New("webhook delivery failed")
+)
+
+// Metadata identifies the repository state a run checked
+type Metadata struct {
+	Repository string `json:"repository"`
+	Branch     string `json:"branch,omitempty"`
+	Commit     string `json:"commit,omitempty"`
+}
+
+// RunResult is the JSON document posted to the webhook
+type RunResult struct {
+	Metadata
+
+	Version    string        `json:"version"`
+	Timestamp  time.Time     `json:"timestamp"`
+	Success    bool          `json:"success"`
+	Passed     int           `json:"passed"`
+	Failed     int           `json:"failed"`
+	Skipped    int           `json:"skipped"`
+	Warned     int           `json:"warned"`
+	DurationMS int64         `json:"duration_ms"`
+	TotalFiles int           `json:"total_files"`
+	Checks     []CheckResult `json:"checks"`
+}
+
+// CheckResult is the outcome of one check in a RunResult
+type CheckResult struct {
+	Name        string              `json:"name"`
+	Status      string              `json:"status"`
+	DurationMS  int64               `json:"duration_ms"`
+	Error       string              `json:"error,omitempty"`
+	Files       []string            `json:"files,omitempty"`
+	Cached      bool                `json:"cached,omitempty"`
+	Diagnostics []output.Diagnostic `json:"diagnostics,omitempty"`
+}
+
+// NewRunResult builds the webhook payload for results. The run succeeded when
+// no check failed; warn-only and skipped checks do not count as failures.
+func NewRunResult(results *runner.Results, meta Metadata, version string, now time.Time) RunResult {
+	payload := RunResult{
+		Metadata:   meta,
+		Version:    version,
+		Timestamp:  now.UTC(),
+		Success:    results.BlockingFailures() == 0,
+		Passed:     results.Passed,
+		Failed:     results.Failed,
+		Skipped:    results.Skipped,
+		Warned:     results.Warned,
+		DurationMS: results.TotalDuration.Milliseconds(),
+		TotalFiles: results.TotalFiles,
+		Checks:     make([]CheckResult, 0, len(results.CheckResults)),
+	}
+
+	for _, result := range results.CheckResults {
+		check := CheckResult{
+			Name:       result.Name,
+			Status:     checkStatus(result),
+			DurationMS: result.Duration.Milliseconds(),
+			Cached:     result.Cached,
+		}
+		if check.Status != StatusPassed {
+			check.Error = result.Error
+			check.Files = result.Files
+			check.Diagnostics = result.Diagnostics
+		}
+		payload.Checks = append(payload.Checks, check)
+	}
+	return payload
+}
+
+// checkStatus maps a check result to its payload status
+func checkStatus(result runner.CheckResult) string {
+	switch {
+	case result.Skipped:
+		return StatusSkipped
+	case result.Success:
+		return StatusPassed
+	case result.WarnOnly:
+		return StatusWarning
+	default:
+		return StatusFailed
+	}
+}
+
+// ValidateURL reports whether rawURL can be used as a webhook endpoint
+func ValidateURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: %q", ErrInvalidURL, rawURL)
+	}
+	return nil
+}
+
+// Post sends payload as JSON to rawURL, giving up after DefaultTimeout. A nil
+// client uses http.DefaultClient.
+func Post(ctx context.Context, client *http.Client, rawURL string, payload RunResult) error {
+	if err := ValidateURL(rawURL); err != nil {
+		return err
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("encoding webhook payload: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rawURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "go-pre-commit/"+payload.Version)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("posting webhook: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%w: %s", ErrDeliveryFailed, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/go-pre-commit/internal/output"
+	"github.com/mrz1836/go-pre-commit/internal/runner"
+)
+
+func testResults() *runner.Results {
+	return &runner.Results{
+		CheckResults: []runner.CheckResult{
+			{Name: "fumpt", Success: true, Duration: 120 * time.Millisecond, Cached: true},
+			{Name: "lint", Error: "2 issues", Files: []string{"main.go"}, Duration: 2 * time.Second, Diagnostics: []output.Diagnostic{
+				{File: "main.go", Line: 4, Col: 2, Severity: output.SeverityError, Rule: "errcheck", Message: "unchecked error"},
+			}},
+			{Name: "gitleaks", Error: "1 finding", WarnOnly: true},
+			{Name: "mod-tidy", Success: true, Skipped: true},
+		},
+		Passed:        1,
+		Failed:        1,
+		Skipped:       1,
+		Warned:        1,
+		TotalDuration: 2500 * time.Millisecond,
+		TotalFiles:    3,
+	}
+}
+
+func TestPost_PayloadShape(t *testing.T) {
+	var (
+		body        []byte
+		contentType string
+		method      string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		contentType = r.Header.Get("Content-Type")
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	meta := Metadata{Repository: "git@github.com:example/project.git", Branch: "main", Commit: "0123abcd"}
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	require.NoError(t, Post(context.Background(), server.Client(), server.URL, NewRunResult(testResults(), meta, "v1.2.3", now)))
+
+	assert.Equal(t, http.MethodPost, method)
+	assert.Equal(t, "application/json", contentType)
+
+	var payload map[string]any
+	require.NoError(t, json.Unmarshal(body, &payload))
+	assert.Equal(t, "git@github.com:example/project.git", payload["repository"])
+	assert.Equal(t, "main", payload["branch"])
+	assert.Equal(t, "0123abcd", payload["commit"])
+	assert.Equal(t, "v1.2.3", payload["version"])
+	assert.Equal(t, "2026-01-02T03:04:05Z", payload["timestamp"])
+	assert.Equal(t, false, payload["success"])
+	assert.InDelta(t, 1, payload["passed"], 0)
+	assert.InDelta(t, 1, payload["failed"], 0)
+	assert.InDelta(t, 1, payload["skipped"], 0)
+	assert.InDelta(t, 1, payload["warned"], 0)
+	assert.InDelta(t, 2500, payload["duration_ms"], 0)
+	assert.InDelta(t, 3, payload["total_files"], 0)
+
+	var decoded RunResult
+	require.NoError(t, json.Unmarshal(body, &decoded))
+	assert.Equal(t, []CheckResult{
+		{Name: "fumpt", Status: StatusPassed, DurationMS: 120, Cached: true},
+		{Name: "lint", Status: StatusFailed, DurationMS: 2000, Error: "2 issues", Files: []string{"main.go"}, Diagnostics: []output.Diagnostic{
+			{File: "main.go", Line: 4, Col: 2, Severity: output.SeverityError, Rule: "errcheck", Message: "unchecked error"},
+		}},
+		{Name: "gitleaks", Status: StatusWarning, Error: "1 finding"},
+		{Name: "mod-tidy", Status: StatusSkipped},
+	}, decoded.Checks)
+}
+
+func TestNewRunResult_SuccessIgnoresWarnings(t *testing.T) {
+	results := testResults()
+	results.Failed = 0
+	assert.True(t, NewRunResult(results, Metadata{}, "dev", time.Now()).Success)
+}
+
+func TestPost_NonSuccessStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	err := Post(context.Background(), server.Client(), server.URL, RunResult{})
+	require.ErrorIs(t, err, ErrDeliveryFailed)
+	assert.Contains(t, err.Error(), "502")
+}
+
+func TestPost_UnreachableEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	require.Error(t, Post(context.Background(), nil, url, RunResult{}))
+}
+
+func TestValidateURL(t *testing.T) {
+	for _, valid := range []string{"http://localhost:8080/hook", "https://dashboard.example.com/hooks/pre-commit"} {
+		require.NoError(t, ValidateURL(valid), valid)
+	}
+	for _, invalid := range []string{"", "dashboard.example.com/hook", "ftp://example.com/hook", "https://", "://bad"} {
+		require.ErrorIs(t, ValidateURL(invalid), ErrInvalidURL, invalid)
+	}
+}